@@ -0,0 +1,188 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"errors"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+var errBlockRangesReset = errors.New("block ranges were reset")
+
+// blockRanges coordinates the block range requests of the catchup service. The fetch of a round from a peer which
+// advertised range support requests the blocks of the following rounds as well, and the fetches of these rounds wait
+// for their blocks to arrive rather than requesting them again.
+type blockRanges struct {
+	mu deadlock.Mutex
+
+	// size is the number of blocks we'd like to request at once
+	size uint64
+
+	// peers maps the addresses of the peers which advertised range support to the number of blocks they would return at once
+	peers map[string]uint64
+
+	// pending holds the blocks of the rounds which are being fetched by range requests, until they get consumed
+	pending map[basics.Round]*rangeBlock
+}
+
+// rangeBlock is the block of a single round, fetched by a range request
+type rangeBlock struct {
+	// done is closed once the block was received, or once the range request failed before receiving it
+	done    chan struct{}
+	data    []byte
+	address string
+	err     error
+	// source is the peer the range request was made to, which is ranked for the block rather than the peer of the
+	// fetch which consumes it
+	source *peerSelectorPeer
+}
+
+func makeBlockRanges(size uint64) *blockRanges {
+	return &blockRanges{
+		size:    size,
+		peers:   make(map[string]uint64),
+		pending: make(map[basics.Round]*rangeBlock),
+	}
+}
+
+// reset drops the blocks which were fetched but never consumed.
+func (br *blockRanges) reset() {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	for _, rb := range br.pending {
+		if rb.data == nil && rb.err == nil {
+			rb.err = errBlockRangesReset
+			close(rb.done)
+		}
+	}
+	br.pending = make(map[basics.Round]*rangeBlock)
+}
+
+// setSize changes the number of blocks we'd like to request at once.
+func (br *blockRanges) setSize(size uint64) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	br.size = size
+}
+
+// setPeerLimit records the number of blocks a peer advertised it would return for a range request.
+func (br *blockRanges) setPeerLimit(address string, limit uint64) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	if limit > 1 {
+		br.peers[address] = limit
+	} else {
+		delete(br.peers, address)
+	}
+}
+
+// wait waits for the block of the given round if it's being fetched by a range request, and consumes it. It returns
+// nil if there is no such request, or if it failed to fetch the block.
+func (br *blockRanges) wait(ctx context.Context, round basics.Round) (*rangeBlock, error) {
+	br.mu.Lock()
+	rb := br.pending[round]
+	br.mu.Unlock()
+	if rb == nil {
+		return nil, nil
+	}
+
+	select {
+	case <-rb.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	br.mu.Lock()
+	if br.pending[round] == rb {
+		delete(br.pending, round)
+	}
+	br.mu.Unlock()
+	if rb.err != nil {
+		return nil, nil
+	}
+	return rb, nil
+}
+
+// release drops the blocks of the rounds up to the given one, which were written to the ledger, so that the blocks
+// which came in for them but were never consumed don't stay around.
+func (br *blockRanges) release(upTo basics.Round) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	for round, rb := range br.pending {
+		if round > upTo {
+			continue
+		}
+		if rb.data == nil && rb.err == nil {
+			rb.err = errBlockRangesReset
+			close(rb.done)
+		}
+		delete(br.pending, round)
+	}
+}
+
+// start returns the number of blocks to request from the peer, starting with the given round, and registers the
+// pending blocks of the following rounds. It returns 1 if the peer doesn't support range requests, or if the
+// following round is already being fetched.
+func (br *blockRanges) start(round basics.Round, address string) uint64 {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	count := br.peers[address]
+	if count > br.size {
+		count = br.size
+	}
+	if count <= 1 {
+		return 1
+	}
+	for i := uint64(1); i < count; i++ {
+		if _, has := br.pending[round+basics.Round(i)]; has {
+			count = i
+			break
+		}
+		br.pending[round+basics.Round(i)] = &rangeBlock{done: make(chan struct{})}
+	}
+	return count
+}
+
+// deliver provides the block of a round which was registered by start, received from the source peer.
+func (br *blockRanges) deliver(round basics.Round, data []byte, address string, source *peerSelectorPeer) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	if rb := br.pending[round]; rb != nil && rb.data == nil && rb.err == nil {
+		rb.data = data
+		rb.address = address
+		rb.source = source
+		close(rb.done)
+	}
+}
+
+// fail releases the waiters of the rounds which were registered by start, but weren't delivered.
+func (br *blockRanges) fail(from basics.Round, to basics.Round, err error) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+	for round := from; round < to; round++ {
+		if rb := br.pending[round]; rb != nil && rb.data == nil && rb.err == nil {
+			rb.err = err
+			close(rb.done)
+			// let a later fetch of this round request it again.
+			delete(br.pending, round)
+		}
+	}
+}
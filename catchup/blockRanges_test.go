@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBlockRanges(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	br := makeBlockRanges(8)
+	ctx := context.Background()
+
+	// peers which didn't advertise range support get single block requests
+	require.Equal(t, uint64(1), br.start(10, "peer"))
+	br.setPeerLimit("peer", 4)
+	br.setPeerLimit("other", 16)
+	require.Equal(t, uint64(4), br.start(10, "peer"))
+
+	// the ranges are limited by the rounds already being fetched, and by the size
+	require.Equal(t, uint64(1), br.start(12, "other"))
+	require.Equal(t, uint64(8), br.start(14, "other"))
+
+	// delivered blocks are consumed once, and tell the peer they came from
+	source := &peerSelectorPeer{}
+	br.deliver(11, []byte{11}, "peer", source)
+	rb, err := br.wait(ctx, 11)
+	require.NoError(t, err)
+	require.NotNil(t, rb)
+	require.Equal(t, []byte{11}, rb.data)
+	require.Equal(t, "peer", rb.address)
+	require.Same(t, source, rb.source)
+	rb, err = br.wait(ctx, 11)
+	require.NoError(t, err)
+	require.Nil(t, rb)
+
+	// a broken range releases the waiters of the rounds which weren't delivered, so they get fetched again
+	br.deliver(13, []byte{13}, "peer", source)
+	br.fail(12, 14, errNoBlockForRound)
+	rb, err = br.wait(ctx, 12)
+	require.NoError(t, err)
+	require.Nil(t, rb)
+	rb, err = br.wait(ctx, 13)
+	require.NoError(t, err)
+	require.Equal(t, []byte{13}, rb.data)
+
+	// waiting is aborted with the context
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = br.wait(canceledCtx, 15)
+	require.ErrorIs(t, err, context.Canceled)
+
+	// and the pending rounds are released on reset
+	br.setPeerLimit("peer", 0)
+	require.Equal(t, uint64(1), br.start(30, "peer"))
+	br.reset()
+	rb, err = br.wait(ctx, 15)
+	require.NoError(t, err)
+	require.Nil(t, rb)
+}
+
+func TestBlockRangesRelease(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	br := makeBlockRanges(8)
+	ctx := context.Background()
+	br.setPeerLimit("peer", 8)
+	require.Equal(t, uint64(8), br.start(10, "peer"))
+
+	// the blocks of the written rounds are dropped whether they were consumed or not, and their waiters released
+	br.deliver(11, []byte{11}, "peer", nil)
+	waited := make(chan *rangeBlock)
+	go func() {
+		rb, _ := br.wait(ctx, 12)
+		waited <- rb
+	}()
+	br.release(12)
+	require.Nil(t, <-waited)
+	require.Len(t, br.pending, 5)
+	rb, err := br.wait(ctx, 11)
+	require.NoError(t, err)
+	require.Nil(t, rb)
+
+	// the following rounds are still pending
+	br.deliver(13, []byte{13}, "peer", nil)
+	rb, err = br.wait(ctx, 13)
+	require.NoError(t, err)
+	require.Equal(t, []byte{13}, rb.data)
+}
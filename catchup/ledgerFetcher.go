@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"path"
 	"strconv"
 	"time"
@@ -81,13 +82,32 @@ func (lf *ledgerFetcher) downloadLedger(ctx context.Context, peer network.Peer,
 	return lf.getPeerLedger(ctx, httpPeer, round)
 }
 
-func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round) error {
+// peerLedgerURL returns the url from which the catchpoint file of the given round could be downloaded off the peer.
+func (lf *ledgerFetcher) peerLedgerURL(peer network.HTTPPeer, round basics.Round) (*url.URL, error) {
 	parsedURL, err := network.ParseHostOrURL(peer.GetAddress())
 	if err != nil {
-		return err
+		return nil, err
 	}
-
 	parsedURL.Path = lf.net.SubstituteGenesisID(path.Join(parsedURL.Path, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36)))
+	return parsedURL, nil
+}
+
+// chunkDownloadDuration returns the maximum amount of time we would wait to download a single chunk off a catchpoint file
+func (lf *ledgerFetcher) chunkDownloadDuration() time.Duration {
+	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
+	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
+	} else {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
+	}
+	return maxCatchpointFileChunkDownloadDuration
+}
+
+func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round) error {
+	parsedURL, err := lf.peerLedgerURL(peer, round)
+	if err != nil {
+		return err
+	}
 	ledgerURL := parsedURL.String()
 	lf.log.Debugf("ledger GET %#v peer %#v %T", ledgerURL, peer, peer)
 	request, err := http.NewRequest(http.MethodGet, ledgerURL, nil)
@@ -128,15 +148,7 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		return err
 	}
 
-	// maxCatchpointFileChunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
-	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
-	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
-	} else {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
-	}
-
-	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
+	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, lf.chunkDownloadDuration())
 	defer watchdogReader.Close()
 	tarReader := tar.NewReader(watchdogReader)
 	var downloadProgress ledger.CatchpointCatchupAccessorProgress
@@ -175,6 +187,12 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		}
 		start := time.Now()
 		err = lf.processBalancesBlock(ctx, header.Name, balancesBlockBytes, &downloadProgress)
+		if errors.Is(err, ledger.ErrCatchpointChunkProof) {
+			// the chunk we've received doesn't match the catchpoint label; rather than dropping the whole
+			// download, see if any of the other relays could provide us with a valid copy of that chunk.
+			lf.log.Warnf("getPeerLedger : chunk %s received from %s failed verification : %v", header.Name, peer.GetAddress(), err)
+			err = lf.refetchChunk(ctx, peer, round, header.Name, &downloadProgress)
+		}
 		if err != nil {
 			return err
 		}
@@ -196,3 +214,73 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 func (lf *ledgerFetcher) processBalancesBlock(ctx context.Context, sectionName string, bytes []byte, downloadProgress *ledger.CatchpointCatchupAccessorProgress) error {
 	return lf.accessor.ProcessStagingBalances(ctx, sectionName, bytes, downloadProgress)
 }
+
+// refetchChunk attempts to retrieve a single chunk of the catchpoint file from any of the relays other than the
+// given peer, and process the first copy which passes verification.
+func (lf *ledgerFetcher) refetchChunk(ctx context.Context, badPeer network.HTTPPeer, round basics.Round, sectionName string, downloadProgress *ledger.CatchpointCatchupAccessorProgress) error {
+	for _, peer := range lf.net.GetPeers(network.PeersPhonebookRelays) {
+		httpPeer, ok := peer.(network.HTTPPeer)
+		if !ok || httpPeer.GetAddress() == badPeer.GetAddress() {
+			continue
+		}
+		chunk, err := lf.getPeerLedgerChunk(ctx, httpPeer, round, sectionName)
+		if err != nil {
+			lf.log.Infof("refetchChunk : unable to retrieve chunk %s from %s : %v", sectionName, httpPeer.GetAddress(), err)
+			continue
+		}
+		err = lf.processBalancesBlock(ctx, sectionName, chunk, downloadProgress)
+		if err == nil {
+			lf.log.Infof("refetchChunk : chunk %s was retrieved from %s", sectionName, httpPeer.GetAddress())
+			return nil
+		}
+		if !errors.Is(err, ledger.ErrCatchpointChunkProof) {
+			return err
+		}
+		lf.log.Warnf("refetchChunk : chunk %s received from %s failed verification : %v", sectionName, httpPeer.GetAddress(), err)
+	}
+	return fmt.Errorf("refetchChunk : no valid copy of chunk %s could be retrieved : %w", sectionName, ledger.ErrCatchpointChunkProof)
+}
+
+// getPeerLedgerChunk downloads a single chunk of the catchpoint file off the given peer.
+func (lf *ledgerFetcher) getPeerLedgerChunk(ctx context.Context, peer network.HTTPPeer, round basics.Round, sectionName string) ([]byte, error) {
+	parsedURL, err := lf.peerLedgerURL(peer, round)
+	if err != nil {
+		return nil, err
+	}
+	parsedURL.RawQuery = url.Values{rpcs.LedgerServiceChunkParameter: []string{sectionName}}.Encode()
+	chunkURL := parsedURL.String()
+	lf.log.Debugf("ledger chunk GET %#v peer %#v %T", chunkURL, peer, peer)
+	request, err := http.NewRequest(http.MethodGet, chunkURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.chunkDownloadDuration())
+	defer timeoutContextCancel()
+	request = request.WithContext(timeoutContext)
+	network.SetUserAgentHeader(request.Header)
+	response, err := peer.GetHTTPClient().Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errNoLedgerForRound
+	default:
+		return nil, fmt.Errorf("getPeerLedgerChunk error response status code %d", response.StatusCode)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != rpcs.LedgerChunkResponseContentType {
+		return nil, fmt.Errorf("getPeerLedgerChunk : http ledger fetcher response has an invalid content type : %s", contentType)
+	}
+	chunk, err := io.ReadAll(io.LimitReader(response.Body, maxCatchpointFileChunkSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(chunk) > maxCatchpointFileChunkSize || len(chunk) < 1 {
+		return nil, fmt.Errorf("getPeerLedgerChunk received a chunk with data size of %d", len(chunk))
+	}
+	return chunk, nil
+}
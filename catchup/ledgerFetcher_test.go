@@ -17,10 +17,13 @@
 package catchup
 
 import (
+	"archive/tar"
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -30,6 +33,8 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -102,3 +107,108 @@ func TestLedgerFetcherErrorResponseHandling(t *testing.T) {
 	err = lf.getPeerLedger(context.Background(), &peer, basics.Round(0))
 	require.Equal(t, fmt.Errorf("getPeerLedger : http ledger fetcher response has an invalid content type : %s", contentTypes[0]), err)
 }
+
+type chunkRefetchTestNetwork struct {
+	mocks.MockNetwork
+	peers []network.Peer
+}
+
+func (n *chunkRefetchTestNetwork) GetPeers(options ...network.PeerOption) []network.Peer {
+	return n.peers
+}
+
+func (n *chunkRefetchTestNetwork) SubstituteGenesisID(rawURL string) string {
+	return rawURL
+}
+
+// chunkRefetchTestAccessor rejects any chunk whose content is "corrupted", the same way the catchpoint catchup
+// accessor rejects a chunk which doesn't match its merkle range proof.
+type chunkRefetchTestAccessor struct {
+	mocks.MockCatchpointCatchupAccessor
+	mu        sync.Mutex
+	processed map[string]string
+}
+
+func (a *chunkRefetchTestAccessor) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) error {
+	if string(bytes) == "corrupted" {
+		return fmt.Errorf("ProcessStagingBalances: %w", ledger.ErrCatchpointChunkProof)
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.processed[sectionName] = string(bytes)
+	return nil
+}
+
+func startChunkRefetchTestServer(t *testing.T, chunks map[string]string, order []string) (testHTTPPeer, func()) {
+	mux := http.NewServeMux()
+	s := &http.Server{
+		Handler: mux,
+	}
+	listener, err := net.Listen("tcp", "localhost:")
+	require.NoError(t, err)
+	go s.Serve(listener)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
+		if chunkName := req.URL.Query().Get(rpcs.LedgerServiceChunkParameter); chunkName != "" {
+			chunk, ok := chunks[chunkName]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", rpcs.LedgerChunkResponseContentType)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(chunk))
+			return
+		}
+		w.Header().Set("Content-Type", rpcs.LedgerResponseContentType)
+		w.WriteHeader(http.StatusOK)
+		tarWriter := tar.NewWriter(w)
+		for _, name := range order {
+			tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(chunks[name]))})
+			tarWriter.Write([]byte(chunks[name]))
+		}
+		tarWriter.Close()
+	})
+	return testHTTPPeer(listener.Addr().String()), func() {
+		s.Close()
+		listener.Close()
+	}
+}
+
+func TestLedgerFetcherChunkRefetch(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	order := []string{"content.msgpack", "balances.1.msgpack", "balances.2.msgpack"}
+	badPeer, closeBad := startChunkRefetchTestServer(t, map[string]string{
+		"content.msgpack":    "header",
+		"balances.1.msgpack": "corrupted",
+		"balances.2.msgpack": "second chunk",
+	}, order)
+	defer closeBad()
+	corruptedPeer, closeCorrupted := startChunkRefetchTestServer(t, map[string]string{
+		"balances.1.msgpack": "corrupted",
+	}, order)
+	defer closeCorrupted()
+	goodPeer, closeGood := startChunkRefetchTestServer(t, map[string]string{
+		"balances.1.msgpack": "first chunk",
+	}, order)
+	defer closeGood()
+
+	node := &chunkRefetchTestNetwork{peers: []network.Peer{&badPeer, &corruptedPeer, &goodPeer}}
+	accessor := &chunkRefetchTestAccessor{processed: make(map[string]string)}
+	lf := makeLedgerFetcher(node, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	err := lf.getPeerLedger(context.Background(), &badPeer, basics.Round(100))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"content.msgpack":    "header",
+		"balances.1.msgpack": "first chunk",
+		"balances.2.msgpack": "second chunk",
+	}, accessor.processed)
+
+	// when none of the other relays has a valid copy, the download fails.
+	node.peers = []network.Peer{&badPeer, &corruptedPeer}
+	accessor.processed = make(map[string]string)
+	err = lf.getPeerLedger(context.Background(), &badPeer, basics.Round(100))
+	require.True(t, errors.Is(err, ledger.ErrCatchpointChunkProof))
+	require.NotContains(t, accessor.processed, "balances.2.msgpack")
+}
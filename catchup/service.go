@@ -29,12 +29,15 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/verify"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/execpool"
+	"github.com/algorand/go-algorand/util/timers"
 )
 
 const catchupPeersForSync = 10
@@ -63,11 +66,15 @@ type Ledger interface {
 	IsWritingCatchpointDataFile() bool
 	Validate(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) (*ledgercore.ValidatedBlock, error)
 	AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) error
+	BlockHdrCached(basics.Round) (bookkeeping.BlockHeader, error)
+	VerifiedTransactionCache() verify.VerifiedTransactionCache
 }
 
 // Service represents the catchup service. Once started and until it is stopped, it ensures that the ledger is up to date with network.
 type Service struct {
 	syncStartNS int64 // at top of struct to keep 64 bit aligned for atomic.* ops
+	// parallelBlocks is the number of blocks we fetch in parallel, accessed atomically as it can change at runtime
+	parallelBlocks uint64 // at top of struct to keep 64 bit aligned for atomic.* ops
 	// disableSyncRound, provided externally, is the first round we will _not_ fetch from the network
 	// any round >= disableSyncRound will not be fetched. If set to 0, it will be disregarded.
 	disableSyncRound    uint64
@@ -79,10 +86,15 @@ type Service struct {
 	log                 logging.Logger
 	net                 network.GossipNode
 	auth                BlockAuthenticator
-	parallelBlocks      uint64
 	deadlineTimeout     time.Duration
 	blockValidationPool execpool.BacklogPool
 
+	// clock, if set, paces the periodic sync instead of the wall clock
+	clock timers.Clock
+
+	// ranges coordinates the block range requests of the pipelined fetch
+	ranges *blockRanges
+
 	// suspendForCatchpointWriting defines whether we've ran into a state where the ledger is currently busy writing the
 	// catchpoint file. If so, we want to suspend the catchup process until the catchpoint file writing is complete,
 	// and resume from there without stopping the catchup timer.
@@ -122,10 +134,33 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.parallelBlocks = config.CatchupParallelBlocks
 	s.deadlineTimeout = agreement.DeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
+	s.ranges = makeBlockRanges(s.parallelBlocks)
 
 	return s
 }
 
+// SetParallelBlocks changes the number of blocks fetched in parallel, starting with the next sync. Zero
+// disables the catchup.
+func (s *Service) SetParallelBlocks(parallelBlocks uint64) {
+	atomic.StoreUint64(&s.parallelBlocks, parallelBlocks)
+	s.ranges.setSize(parallelBlocks)
+}
+
+// SetClock makes the periodic sync wait, and the block requests time out, on the given clock rather
+// than on the wall clock, such as when the agreement timeouts of the node are driven by a simulated
+// clock. It has to be called before Start.
+func (s *Service) SetClock(clock timers.Clock) {
+	s.clock = clock
+}
+
+// after returns a channel which fires once d has passed on the clock of the service.
+func (s *Service) after(d time.Duration) <-chan time.Time {
+	if s.clock == nil {
+		return time.After(d)
+	}
+	return s.clock.Zero().TimeoutAt(d)
+}
+
 // Start the catchup service
 func (s *Service) Start() {
 	s.done = make(chan struct{})
@@ -187,17 +222,21 @@ func (s *Service) SynchronizingTime() time.Duration {
 var errLedgerAlreadyHasBlock = errors.New("ledger already has block")
 
 // function scope to make a bunch of defer statements better
-func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeeping.Block, cert *agreement.Certificate, ddur time.Duration, err error) {
+// ranges may be provided to fetch the blocks of the following rounds along with the block of this round, in which case
+// the block may come from the range request of another fetch: source is the peer the block came from.
+func (s *Service) innerFetch(r basics.Round, psp *peerSelectorPeer, ranges *blockRanges) (blk *bookkeeping.Block, cert *agreement.Certificate, ddur time.Duration, source *peerSelectorPeer, err error) {
 	ledgerWaitCh := s.ledger.Wait(r)
 	select {
 	case <-ledgerWaitCh:
 		// if our ledger already have this block, no need to attempt to fetch it.
-		return nil, nil, time.Duration(0), errLedgerAlreadyHasBlock
+		return nil, nil, time.Duration(0), psp, errLedgerAlreadyHasBlock
 	default:
 	}
 
 	ctx, cf := context.WithCancel(s.ctx)
 	fetcher := makeUniversalBlockFetcher(s.log, s.net, s.cfg)
+	fetcher.ranges = ranges
+	fetcher.clock = s.clock
 	defer cf()
 	stopWaitingForLedgerRound := make(chan struct{})
 	defer close(stopWaitingForLedgerRound)
@@ -208,7 +247,7 @@ func (s *Service) innerFetch(r basics.Round, peer network.Peer) (blk *bookkeepin
 			cf()
 		}
 	}()
-	blk, cert, ddur, err = fetcher.fetchBlock(ctx, r, peer)
+	blk, cert, ddur, source, err = fetcher.fetchBlockFrom(ctx, r, psp)
 	// check to see if we aborted due to ledger.
 	if err != nil {
 		select {
@@ -269,20 +308,23 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			s.log.Debugf("fetchAndWrite: was unable to obtain a peer to retrieve the block from")
 			break
 		}
-		peer := psp.Peer
 
 		// Try to fetch, timing out after retryInterval
-		block, cert, blockDownloadDuration, err := s.innerFetch(r, peer)
+		block, cert, blockDownloadDuration, source, err := s.innerFetch(r, psp, s.ranges)
+		// a block which came from the range request of another fetch is ranked against the peer which sent it, and
+		// waiting for it doesn't measure the download duration of any peer.
+		fromRange := source != psp
 
 		if err != nil {
 			if err == errLedgerAlreadyHasBlock {
 				// ledger already has the block, no need to request this block.
 				// only the agreement could have added this block into the ledger, catchup is complete
 				s.log.Infof("fetchAndWrite(%d): the block is already in the ledger. The catchup is complete", r)
+				s.ranges.release(r)
 				return false
 			}
 			s.log.Debugf("fetchAndWrite(%v): Could not fetch: %v (attempt %d)", r, err, i)
-			peerSelector.rankPeer(psp, peerRankDownloadFailed)
+			peerSelector.rankPeer(source, peerRankDownloadFailed)
 			// we've just failed to retrieve a block; wait until the previous block is fetched before trying again
 			// to avoid the usecase where the first block doesn't exists and we're making many requests down the chain
 			// for no reason.
@@ -308,7 +350,7 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 		// Check that the block's contents match the block header (necessary with an untrusted block because b.Hash() only hashes the header)
 		if s.cfg.CatchupVerifyPaysetHash() {
 			if !block.ContentsMatchHeader() {
-				peerSelector.rankPeer(psp, peerRankInvalidDownload)
+				peerSelector.rankPeer(source, peerRankInvalidDownload)
 				// Check if this mismatch is due to an unsupported protocol version
 				if _, ok := config.Consensus[block.BlockHeader.CurrentProtocol]; !ok {
 					s.log.Errorf("fetchAndWrite(%v): unsupported protocol version detected: '%v'", r, block.BlockHeader.CurrentProtocol)
@@ -337,14 +379,22 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 			err = s.auth.Authenticate(block, cert)
 			if err != nil {
 				s.log.Warnf("fetchAndWrite(%v): cert did not authenticate block (attempt %d): %v", r, i, err)
-				peerSelector.rankPeer(psp, peerRankInvalidDownload)
+				peerSelector.rankPeer(source, peerRankInvalidDownload)
 				continue // retry the fetch
 			}
 		}
 
-		peerRank := peerSelector.peerDownloadDurationToRank(psp, blockDownloadDuration)
-		r1, r2 := peerSelector.rankPeer(psp, peerRank)
-		s.log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
+		if !fromRange {
+			peerRank := peerSelector.peerDownloadDurationToRank(psp, blockDownloadDuration)
+			r1, r2 := peerSelector.rankPeer(psp, peerRank)
+			s.log.Debugf("fetchAndWrite(%d): ranked peer with %d from %d to %d", r, peerRank, r1, r2)
+		}
+
+		// verify the transaction signatures while the previous blocks are being evaluated, rather than
+		// leaving all of it to the evaluation of this block once the previous one is written.
+		if s.cfg.CatchupVerifyTransactionSignatures() || s.cfg.CatchupVerifyApplyData() {
+			s.preverifyPayset(r, block)
+		}
 
 		// Write to ledger, noting that ledger writes must be in order
 		select {
@@ -412,6 +462,7 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 					return false
 				}
 				s.log.Debugf("fetchAndWrite(%v): Wrote block to ledger", r)
+				s.ranges.release(r)
 				return true
 			}
 			s.log.Warnf("fetchAndWrite(%v): previous block doesn't exist (perhaps fetching block %v failed)", r, r-1)
@@ -421,6 +472,39 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 	return false
 }
 
+// preverifyPayset verifies the signatures of the block transactions ahead of the block evaluation, and adds the verified
+// transactions to the ledger verified transactions cache, so that the evaluation only needs to verify the ones that are
+// missing from it. Failing here isn't fatal: whatever couldn't be verified would get verified (and rejected, if invalid)
+// by the evaluation.
+func (s *Service) preverifyPayset(r basics.Round, block *bookkeeping.Block) {
+	if _, ok := config.Consensus[block.CurrentProtocol]; !ok {
+		return
+	}
+	paysetgroups, err := block.DecodePaysetGroups()
+	if err != nil {
+		s.log.Debugf("preverifyPayset(%d): unable to decode payset : %v", r, err)
+		return
+	}
+	txgroups := make([][]transactions.SignedTxn, len(paysetgroups))
+	for i, group := range paysetgroups {
+		txgroups[i] = make([]transactions.SignedTxn, len(group))
+		for j, txn := range group {
+			txgroups[i][j] = txn.SignedTxn
+		}
+	}
+
+	cache := s.ledger.VerifiedTransactionCache()
+	specialAddresses := transactions.SpecialAddresses{
+		FeeSink:     block.BlockHeader.FeeSink,
+		RewardsPool: block.BlockHeader.RewardsPool,
+	}
+	txgroups = cache.GetUnverifiedTransactionGroups(txgroups, specialAddresses, block.CurrentProtocol)
+	err = verify.PaysetGroups(s.ctx, txgroups, block.BlockHeader, s.blockValidationPool, cache, s.ledger)
+	if err != nil {
+		s.log.Debugf("preverifyPayset(%d): unable to verify payset ahead of evaluation : %v", r, err)
+	}
+}
+
 type task func() basics.Round
 
 func (s *Service) pipelineCallback(r basics.Round, thisFetchComplete chan bool, prevFetchCompleteChan chan bool, lookbackChan chan bool, peerSelector *peerSelector) func() basics.Round {
@@ -441,7 +525,7 @@ func (s *Service) pipelineCallback(r basics.Round, thisFetchComplete chan bool,
 
 // TODO the following code does not handle the following case: seedLookback upgrades during fetch
 func (s *Service) pipelinedFetch(seedLookback uint64) {
-	parallelRequests := s.parallelBlocks
+	parallelRequests := atomic.LoadUint64(&s.parallelBlocks)
 	if parallelRequests < seedLookback {
 		parallelRequests = seedLookback
 	}
@@ -462,6 +546,8 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 		s.log.Debugf("pipelinedFetch: was unable to obtain a peer to retrieve the block from")
 		return
 	}
+	// drop the blocks fetched by the previous pipelined fetch which were never written
+	s.ranges.reset()
 
 	// Invariant: len(taskCh) + (# pending writes to completed) <= N
 	wg.Add(int(parallelRequests))
@@ -555,7 +641,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 func (s *Service) periodicSync() {
 	defer close(s.done)
 	// if the catchup is disabled in the config file, just skip it.
-	if s.parallelBlocks != 0 && !s.cfg.DisableNetworking {
+	if atomic.LoadUint64(&s.parallelBlocks) != 0 && !s.cfg.DisableNetworking {
 		// The following request might be redundant, but it ensures we wait long enough for the DNS records to be loaded,
 		// which are required for the sync operation.
 		s.net.RequestConnectOutgoing(false, s.ctx.Done())
@@ -575,13 +661,13 @@ func (s *Service) periodicSync() {
 			// we want to sleep for a random duration since it would "de-syncronize" us from the ledger advance sync
 			sleepDuration = time.Duration(crypto.RandUint63()) % s.deadlineTimeout
 			continue
-		case <-time.After(sleepDuration):
+		case <-s.after(sleepDuration):
 			if sleepDuration < s.deadlineTimeout || s.cfg.DisableNetworking {
 				sleepDuration = s.deadlineTimeout
 				continue
 			}
 			// if the catchup is disabled in the config file, just skip it.
-			if s.parallelBlocks == 0 {
+			if atomic.LoadUint64(&s.parallelBlocks) == 0 {
 				continue
 			}
 			// check to see if we're currently writing a catchpoint file. If so, wait longer before attempting again.
@@ -692,10 +778,9 @@ func (s *Service) fetchRound(cert agreement.Certificate, verifier *agreement.Asy
 			s.net.RequestConnectOutgoing(true, s.ctx.Done())
 			continue
 		}
-		peer := psp.Peer
 
 		// Ask the fetcher to get the block somehow
-		block, fetchedCert, _, err := s.innerFetch(cert.Round, peer)
+		block, fetchedCert, _, _, err := s.innerFetch(cert.Round, psp, nil)
 
 		if err != nil {
 			select {
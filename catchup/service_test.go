@@ -36,6 +36,8 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/verify"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
@@ -511,6 +513,114 @@ func TestServiceFetchBlocksMultiBlocks(t *testing.T) {
 	}
 }
 
+// TestServiceFetchBlocksPreverify tests that the catchup keeps writing blocks when it validates them, even if the
+// transactions of the fetched blocks can't be verified ahead of their evaluation.
+func TestServiceFetchBlocksPreverify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Make Ledger
+	numberOfBlocks := basics.Round(10)
+	local := &mockedLedger{validate: true}
+	local.blocks = append(local.blocks, bookkeeping.Block{})
+
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, remote, blk, int(numberOfBlocks)-1)
+
+	// Create a network and block service
+	blockServiceConfig := config.GetDefaultLocal()
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), blockServiceConfig, remote, net, "test genesisID")
+
+	nodeA := basicRPCNode{}
+	nodeA.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, ls)
+	nodeA.start()
+	defer nodeA.stop()
+	rootURL := nodeA.rootURL()
+	net.addPeer(rootURL)
+
+	// Make Service, verifying the transaction signatures. The transactions of the test ledger aren't signed, so they
+	// fail the verification ahead of the evaluation, which is left to the (mocked) evaluation.
+	cfg := defaultConfig
+	cfg.CatchupBlockValidateMode = 4
+	backlogPool := execpool.MakeBacklog(nil, 0, execpool.LowPriority, nil)
+	defer backlogPool.Shutdown()
+	syncer := MakeService(logging.Base(), cfg, net, local, &mockedAuthenticator{errorRound: -1}, nil, backlogPool)
+
+	syncer.testStart()
+	syncer.sync()
+
+	require.Equal(t, numberOfBlocks, local.LastRound())
+}
+
+func TestPreverifyPayset(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	local := new(mockedLedger)
+	local.blocks = append(local.blocks, bookkeeping.Block{})
+	backlogPool := execpool.MakeBacklog(nil, 0, execpool.LowPriority, nil)
+	defer backlogPool.Shutdown()
+	s := MakeService(logging.TestingLog(t), defaultConfig, nil, local, nil, nil, backlogPool)
+	s.testStart()
+	defer s.cancel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	sender := basics.Address(secrets.SignatureVerifier)
+
+	var blk bookkeeping.Block
+	blk.BlockHeader.Round = 1
+	blk.BlockHeader.GenesisHash = crypto.Digest{0x42}
+	blk.BlockHeader.FeeSink = sinkAddr
+	blk.BlockHeader.RewardsPool = poolAddr
+	blk.CurrentProtocol = protocol.ConsensusCurrentVersion
+	makePayset := func(blk *bookkeeping.Block, count int, corrupt bool) (groups [][]transactions.SignedTxn) {
+		blk.Payset = nil
+		for i := 0; i < count; i++ {
+			tx := transactions.Transaction{
+				Type: protocol.PaymentTx,
+				Header: transactions.Header{
+					Sender:      sender,
+					Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+					FirstValid:  blk.Round(),
+					LastValid:   blk.Round(),
+					GenesisHash: blk.BlockHeader.GenesisHash,
+					Note:        []byte{byte(i)},
+				},
+				PaymentTxnFields: transactions.PaymentTxnFields{
+					Receiver: sender,
+					Amount:   basics.MicroAlgos{Raw: 1},
+				},
+			}
+			stxn := tx.Sign(secrets)
+			if corrupt {
+				stxn.Sig[0]++
+			}
+			txib, err := blk.EncodeSignedTxn(stxn, transactions.ApplyData{})
+			require.NoError(t, err)
+			blk.Payset = append(blk.Payset, txib)
+			groups = append(groups, []transactions.SignedTxn{stxn})
+		}
+		return
+	}
+	specialAddresses := transactions.SpecialAddresses{FeeSink: sinkAddr, RewardsPool: poolAddr}
+	cache := local.VerifiedTransactionCache()
+
+	// the signed transactions get added to the cache
+	groups := makePayset(&blk, 5, false)
+	require.Len(t, cache.GetUnverifiedTransactionGroups(groups, specialAddresses, protocol.ConsensusCurrentVersion), 5)
+	s.preverifyPayset(blk.Round(), &blk)
+	require.Empty(t, cache.GetUnverifiedTransactionGroups(groups, specialAddresses, protocol.ConsensusCurrentVersion))
+
+	// while transactions with invalid signatures are left for the evaluation to reject
+	blk.BlockHeader.Round++
+	groups = makePayset(&blk, 1, true)
+	s.preverifyPayset(blk.Round(), &blk)
+	require.Equal(t, groups, cache.GetUnverifiedTransactionGroups(groups, specialAddresses, protocol.ConsensusCurrentVersion))
+}
+
 func TestServiceFetchBlocksMalformed(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -707,9 +817,14 @@ func helperTestOnSwitchToUnSupportedProtocol(
 const defaultRewardUnit = 1e6
 
 type mockedLedger struct {
-	mu     deadlock.Mutex
-	blocks []bookkeeping.Block
-	chans  map[basics.Round]chan struct{}
+	mu          deadlock.Mutex
+	blocks      []bookkeeping.Block
+	chans       map[basics.Round]chan struct{}
+	verifyCache verify.VerifiedTransactionCache
+
+	// validate makes Validate and AddValidatedBlock write the validated blocks
+	// to the ledger, instead of dropping them
+	validate bool
 }
 
 func (m *mockedLedger) NextRound() basics.Round {
@@ -750,11 +865,32 @@ func (m *mockedLedger) AddBlock(blk bookkeeping.Block, cert agreement.Certificat
 }
 
 func (m *mockedLedger) Validate(ctx context.Context, blk bookkeeping.Block, executionPool execpool.BacklogPool) (*ledgercore.ValidatedBlock, error) {
-	return nil, nil
+	if !m.validate {
+		return nil, nil
+	}
+	vb := ledgercore.MakeValidatedBlock(blk, ledgercore.StateDelta{})
+	return &vb, nil
 }
 
 func (m *mockedLedger) AddValidatedBlock(vb ledgercore.ValidatedBlock, cert agreement.Certificate) error {
-	return nil
+	if !m.validate {
+		return nil
+	}
+	return m.AddBlock(vb.Block(), cert)
+}
+
+func (m *mockedLedger) BlockHdrCached(r basics.Round) (bookkeeping.BlockHeader, error) {
+	blk, err := m.Block(r)
+	return blk.BlockHeader, err
+}
+
+func (m *mockedLedger) VerifiedTransactionCache() verify.VerifiedTransactionCache {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.verifyCache == nil {
+		m.verifyCache = verify.MakeVerifiedTransactionCache(1000)
+	}
+	return m.verifyCache
 }
 
 func (m *mockedLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
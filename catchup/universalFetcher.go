@@ -17,11 +17,15 @@
 package catchup
 
 import (
+	"bufio"
 	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/algorand/go-deadlock"
@@ -34,6 +38,7 @@ import (
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/util/timers"
 )
 
 // UniversalFetcher fetches blocks either from an http peer or ws peer.
@@ -41,6 +46,12 @@ type universalBlockFetcher struct {
 	config config.Local
 	net    network.GossipNode
 	log    logging.Logger
+
+	// ranges, when set, lets the fetcher request the blocks of the following rounds from http peers which support it
+	ranges *blockRanges
+
+	// clock, if set, measures the request timeouts instead of the wall clock
+	clock timers.Clock
 }
 
 // makeUniversalFetcher returns a fetcher for http and ws peers.
@@ -54,18 +65,44 @@ func makeUniversalBlockFetcher(log logging.Logger, net network.GossipNode, confi
 // fetchBlock returns a block from the peer. The peer can be either an http or ws peer.
 func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Round, peer network.Peer) (blk *bookkeeping.Block,
 	cert *agreement.Certificate, downloadDuration time.Duration, err error) {
+	blk, cert, downloadDuration, _, err = uf.fetchBlockFrom(ctx, round, &peerSelectorPeer{Peer: peer})
+	return
+}
+
+// fetchBlockFrom returns a block, either from a pending range request, or from the peer selected to fetch it. It also
+// returns the peer the block came from, which is the one to rank for it, and has no download duration when the block
+// came from the range request of another fetch.
+func (uf *universalBlockFetcher) fetchBlockFrom(ctx context.Context, round basics.Round, psp *peerSelectorPeer) (blk *bookkeeping.Block,
+	cert *agreement.Certificate, downloadDuration time.Duration, source *peerSelectorPeer, err error) {
+
+	if uf.ranges != nil {
+		rb, waitErr := uf.ranges.wait(ctx, round)
+		if waitErr != nil {
+			return nil, nil, time.Duration(0), psp, waitErr
+		}
+		if rb != nil {
+			blk, cert, err = processBlockBytes(rb.data, round, rb.address)
+			if err != nil {
+				return nil, nil, time.Duration(0), rb.source, err
+			}
+			uf.log.Debugf("fetchBlock: got block %d from the range request to %s", uint64(round), rb.address)
+			return blk, cert, time.Duration(0), rb.source, nil
+		}
+	}
 
 	var fetchedBuf []byte
 	var address string
+	peer := psp.Peer
 	blockDownloadStartTime := time.Now()
 	if wsPeer, validWSPeer := peer.(network.UnicastPeer); validWSPeer {
 		fetcherClient := &wsFetcherClient{
 			target: wsPeer,
 			config: &uf.config,
+			clock:  uf.clock,
 		}
 		fetchedBuf, err = fetcherClient.getBlockBytes(ctx, round)
 		if err != nil {
-			return nil, nil, time.Duration(0), err
+			return nil, nil, time.Duration(0), psp, err
 		}
 		address = fetcherClient.address()
 	} else if httpPeer, validHTTPPeer := peer.(network.HTTPPeer); validHTTPPeer {
@@ -75,22 +112,57 @@ func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Ro
 			net:     uf.net,
 			client:  httpPeer.GetHTTPClient(),
 			log:     uf.log,
-			config:  &uf.config}
-		fetchedBuf, err = fetcherClient.getBlockBytes(ctx, round)
+			config:  &uf.config,
+			clock:   uf.clock}
+		fetchedBuf, address, err = uf.fetchHTTPBlockBytes(ctx, round, fetcherClient, psp)
 		if err != nil {
-			return nil, nil, time.Duration(0), err
+			return nil, nil, time.Duration(0), psp, err
 		}
-		address = fetcherClient.address()
 	} else {
-		return nil, nil, time.Duration(0), fmt.Errorf("fetchBlock: UniversalFetcher only supports HTTPPeer and UnicastPeer")
+		return nil, nil, time.Duration(0), psp, fmt.Errorf("fetchBlock: UniversalFetcher only supports HTTPPeer and UnicastPeer")
 	}
 	downloadDuration = time.Now().Sub(blockDownloadStartTime)
-	block, cert, err := processBlockBytes(fetchedBuf, round, address)
+	blk, cert, err = processBlockBytes(fetchedBuf, round, address)
 	if err != nil {
-		return nil, nil, time.Duration(0), err
+		return nil, nil, time.Duration(0), psp, err
 	}
 	uf.log.Debugf("fetchBlock: downloaded block %d in %d from %s", uint64(round), downloadDuration, address)
-	return block, cert, downloadDuration, err
+	return blk, cert, downloadDuration, psp, nil
+}
+
+// fetchHTTPBlockBytes requests the block of the given round from the http peer, along with the blocks of the following
+// rounds if the peer supports range requests, which get delivered to the fetches of these rounds as coming from psp.
+func (uf *universalBlockFetcher) fetchHTTPBlockBytes(ctx context.Context, round basics.Round, fetcherClient *HTTPFetcher, psp *peerSelectorPeer) (fetchedBuf []byte, address string, err error) {
+	address = fetcherClient.address()
+	if uf.ranges == nil {
+		fetchedBuf, err = fetcherClient.getBlockBytes(ctx, round)
+		return fetchedBuf, address, err
+	}
+
+	count := uf.ranges.start(round, address)
+	if count == 1 {
+		fetchedBuf, err = fetcherClient.getBlockBytes(ctx, round)
+	} else {
+		next := round
+		err = fetcherClient.getBlockRangeBytes(ctx, round, count, func(data []byte) {
+			if next == round {
+				fetchedBuf = data
+			} else {
+				uf.ranges.deliver(next, data, address, psp)
+			}
+			next++
+		})
+		if err == nil && next == round {
+			err = errNoBlockForRound
+		}
+		uf.ranges.fail(next, round+basics.Round(count), errNoBlockForRound)
+		if fetchedBuf != nil {
+			// the blocks which were received are good, even if the stream broke afterward.
+			err = nil
+		}
+	}
+	uf.ranges.setPeerLimit(address, fetcherClient.rangeLimit)
+	return fetchedBuf, address, err
 }
 
 func processBlockBytes(fetchedBuf []byte, r basics.Round, peerAddr string) (blk *bookkeeping.Block, cert *agreement.Certificate, err error) {
@@ -117,6 +189,7 @@ func processBlockBytes(fetchedBuf []byte, r basics.Round, peerAddr string) (blk
 type wsFetcherClient struct {
 	target network.UnicastPeer // the peer where we're going to send the request.
 	config *config.Local
+	clock  timers.Clock
 
 	mu deadlock.Mutex
 }
@@ -126,7 +199,7 @@ func (w *wsFetcherClient) getBlockBytes(ctx context.Context, r basics.Round) ([]
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	childCtx, cancelFunc := context.WithTimeout(ctx, time.Duration(w.config.CatchupGossipBlockFetchTimeoutSec)*time.Second)
+	childCtx, cancelFunc := timers.WithTimeout(ctx, w.clock, time.Duration(w.config.CatchupGossipBlockFetchTimeoutSec)*time.Second)
 	w.mu.Unlock()
 
 	defer func() {
@@ -202,33 +275,105 @@ type HTTPFetcher struct {
 
 	log    logging.Logger
 	config *config.Local
+	clock  timers.Clock
+
+	// rangeLimit is the number of blocks the peer advertised it would return for a range request, as of the last response
+	rangeLimit uint64
 }
 
 // getBlockBytes gets a block.
 // Core piece of FetcherClient interface
 func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data []byte, err error) {
+	requestCtx, requestCancel := timers.WithTimeout(ctx, hf.clock, time.Duration(hf.config.CatchupHTTPBlockFetchTimeoutSec)*time.Second)
+	defer requestCancel()
+	response, err := hf.requestBlocks(requestCtx, r, 1)
+	if err != nil {
+		return nil, err
+	}
+	return rpcs.ResponseBytes(response, hf.log, fetcherMaxBlockBytes)
+}
+
+// getBlockRangeBytes gets the blocks of up to count consecutive rounds starting with the given one, and passes them
+// to the handler as they are received. Fewer blocks are received if the peer doesn't have all of them.
+func (hf *HTTPFetcher) getBlockRangeBytes(ctx context.Context, r basics.Round, count uint64, handler func([]byte)) error {
+	// the timeout applies to each of the blocks, rather than to the entire range.
+	timeout := time.Duration(hf.config.CatchupHTTPBlockFetchTimeoutSec) * time.Second
+	requestCtx, requestCancel := context.WithCancel(ctx)
+	defer requestCancel()
+	resetTimer, stopTimer := timers.CancelAfter(hf.clock, timeout, requestCancel)
+	defer stopTimer()
+
+	response, err := hf.requestBlocks(requestCtx, r, count)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.Header.Get("Content-Type") != rpcs.BlockRangeResponseContentType {
+		// the peer returned a single block
+		data, err := rpcs.ResponseBytes(response, hf.log, fetcherMaxBlockBytes)
+		if err != nil {
+			return err
+		}
+		handler(data)
+		return nil
+	}
+
+	reader := bufio.NewReader(response.Body)
+	for i := uint64(0); i < count; i++ {
+		length, err := binary.ReadUvarint(reader)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if length > fetcherMaxBlockBytes {
+			hf.log.Errorf("block range entry too large: %d > %d", length, fetcherMaxBlockBytes)
+			return network.ErrIncomingMsgTooLarge
+		}
+		data := make([]byte, length)
+		_, err = io.ReadFull(reader, data)
+		if err != nil {
+			return err
+		}
+		resetTimer()
+		handler(data)
+	}
+	return nil
+}
+
+// requestBlocks requests the blocks of count rounds starting with the given round, and returns the response once
+// its headers were checked.
+func (hf *HTTPFetcher) requestBlocks(ctx context.Context, r basics.Round, count uint64) (response *http.Response, err error) {
 	parsedURL, err := network.ParseHostOrURL(hf.rootURL)
 	if err != nil {
 		return nil, err
 	}
 
 	parsedURL.Path = rpcs.FormatBlockQuery(uint64(r), parsedURL.Path, hf.net)
+	if count > 1 {
+		parsedURL.RawQuery = url.Values{rpcs.BlockServiceRangeCountParam: {strconv.FormatUint(count, 10)}}.Encode()
+	}
 	blockURL := parsedURL.String()
 	hf.log.Debugf("block GET %#v peer %#v %T", blockURL, hf.peer, hf.peer)
 	request, err := http.NewRequest("GET", blockURL, nil)
 	if err != nil {
 		return nil, err
 	}
-	requestCtx, requestCancel := context.WithTimeout(ctx, time.Duration(hf.config.CatchupHTTPBlockFetchTimeoutSec)*time.Second)
-	defer requestCancel()
-	request = request.WithContext(requestCtx)
+	request = request.WithContext(ctx)
 	network.SetUserAgentHeader(request.Header)
-	response, err := hf.client.Do(request)
+	response, err = hf.client.Do(request)
 	if err != nil {
 		hf.log.Debugf("GET %#v : %s", blockURL, err)
 		return nil, err
 	}
 
+	// only trust the range support advertised by the peer itself, rather than by the one we were redirected to.
+	hf.rangeLimit = 0
+	if response.Request != nil && response.Request.URL.Host == parsedURL.Host {
+		hf.rangeLimit, _ = strconv.ParseUint(response.Header.Get(rpcs.BlockServiceRangeHeader), 10, 64)
+	}
+
 	// check to see that we had no errors.
 	switch response.StatusCode {
 	case http.StatusOK:
@@ -259,13 +404,17 @@ func (hf *HTTPFetcher) getBlockBytes(ctx context.Context, r basics.Round) (data
 	// TODO: Temporarily allow old and new content types so we have time for lazy upgrades
 	// Remove this 'old' string after next release.
 	const blockResponseContentTypeOld = "application/algorand-block-v1"
-	if contentTypes[0] != rpcs.BlockResponseContentType && contentTypes[0] != blockResponseContentTypeOld {
+	validContentType := contentTypes[0] == rpcs.BlockResponseContentType || contentTypes[0] == blockResponseContentTypeOld
+	if count > 1 && contentTypes[0] == rpcs.BlockRangeResponseContentType {
+		validContentType = true
+	}
+	if !validContentType {
 		hf.log.Warnf("http block fetcher response has an invalid content type : %s", contentTypes[0])
 		response.Body.Close()
 		return nil, errHTTPResponseContentType{contentTypeCount: 1, contentType: contentTypes[0]}
 	}
 
-	return rpcs.ResponseBytes(response, hf.log, fetcherMaxBlockBytes)
+	return response, nil
 }
 
 // Address is part of FetcherClient interface.
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -312,3 +313,104 @@ func TestErrorTypes(t *testing.T) {
 	err6 := errHTTPResponseContentType{contentTypeCount: 1, contentType: "UNDEFINED"}
 	require.Equal(t, "HTTPFetcher.getBlockBytes: invalid content type: UNDEFINED", err6.Error())
 }
+
+// TestUGetBlockHTTPRange tests that the universal fetcher requests the blocks of the following rounds from http peers
+// which advertised range support, and serves them from the range requests afterward
+func TestUGetBlockHTTPRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+
+	ledger, next, b, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, ledger, b, 7)
+
+	blockServiceConfig := config.GetDefaultLocal()
+	blockServiceConfig.EnableBlockService = true
+	blockServiceConfig.EnableBlockServiceFallbackToArchiver = false
+
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), blockServiceConfig, ledger, net, "test genesisID")
+	var requests int32
+	nodeA := basicRPCNode{}
+	nodeA.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		ls.ServeHTTP(w, r)
+	}))
+	nodeA.start()
+	defer nodeA.stop()
+	net.addPeer(nodeA.rootURL())
+
+	fetcher := makeUniversalBlockFetcher(logging.TestingLog(t), net, cfg)
+	fetcher.ranges = makeBlockRanges(4)
+	fetch := func(round basics.Round) {
+		block, cert, _, err := fetcher.fetchBlock(context.Background(), round, net.GetPeers()[0])
+		require.NoError(t, err)
+		expected, err := ledger.Block(round)
+		require.NoError(t, err)
+		require.Equal(t, &expected, block)
+		require.Equal(t, round, cert.Round)
+	}
+
+	// the range support is advertised on the first response
+	fetch(next)
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// and the following blocks are requested along with the next one
+	fetch(next + 1)
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	for r := next + 2; r < next+5; r++ {
+		fetch(r)
+	}
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+
+	// the last range is shorter, as the peer doesn't have all of its blocks
+	fetch(next + 5)
+	fetch(next + 6)
+	fetch(next + 7)
+	require.Equal(t, int32(3), atomic.LoadInt32(&requests))
+	_, _, _, err = fetcher.fetchBlock(context.Background(), next+8, net.GetPeers()[0])
+	require.ErrorIs(t, err, errNoBlockForRound)
+}
+
+// TestUGetBlockHTTPRangeSource checks a block delivered through a range tells the peer which served it, whichever peer it is consumed for.
+func TestUGetBlockHTTPRangeSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+
+	ledger, next, b, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	addBlocks(t, ledger, b, 7)
+
+	blockServiceConfig := config.GetDefaultLocal()
+	blockServiceConfig.EnableBlockService = true
+	blockServiceConfig.EnableBlockServiceFallbackToArchiver = false
+
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), blockServiceConfig, ledger, net, "test genesisID")
+	nodeA := basicRPCNode{}
+	nodeA.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, ls)
+	nodeA.start()
+	defer nodeA.stop()
+	net.addPeer(nodeA.rootURL())
+
+	fetcher := makeUniversalBlockFetcher(logging.TestingLog(t), net, cfg)
+	fetcher.ranges = makeBlockRanges(4)
+	rangePeer := &peerSelectorPeer{Peer: net.GetPeers()[0], peerClass: network.PeersPhonebookArchivers}
+	otherPeer := &peerSelectorPeer{Peer: net.GetPeers()[0], peerClass: network.PeersPhonebookRelays}
+
+	// the first response advertises the range support, and the second one starts a range
+	for r := next; r < next+2; r++ {
+		_, _, _, source, err := fetcher.fetchBlockFrom(context.Background(), r, rangePeer)
+		require.NoError(t, err)
+		require.Same(t, rangePeer, source)
+	}
+
+	// the blocks of the range are the range peer's, and took no download time of the consuming fetch
+	block, _, downloadDuration, source, err := fetcher.fetchBlockFrom(context.Background(), next+2, otherPeer)
+	require.NoError(t, err)
+	require.Equal(t, next+2, block.Round())
+	require.Same(t, rangePeer, source)
+	require.Zero(t, downloadDuration)
+}
@@ -31,8 +31,11 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/passphrase"
 	"github.com/algorand/go-algorand/daemon/algod"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/gen"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/network"
@@ -55,6 +58,11 @@ var listenIP = flag.String("l", "", "Override config.EndpointAddress (REST liste
 var sessionGUID = flag.String("s", "", "Telemetry Session GUID to use")
 var telemetryOverride = flag.String("t", "", `Override telemetry setting if supported (Use "true", "false", "0" or "1")`)
 var seed = flag.String("seed", "", "input to math/rand.Seed()")
+var sandbox = flag.Bool("sandbox", false, "Run a single node developer mode sandbox, whose ledger only lives in memory and starts from a generated genesis with funded accounts")
+var sandboxAccounts = flag.Int("sandbox-accounts", 10, "Number of funded accounts of the sandbox genesis")
+var sandboxBalance = flag.Uint64("sandbox-balance", 100000000000000, "Balance of each funded account of the sandbox genesis, in MicroAlgos")
+var sandboxSeed = flag.String("sandbox-seed", "sandbox", "Input the keys of the funded sandbox accounts are derived from")
+var sandboxTimeOffset = flag.Int64("sandbox-time-offset", 1, "Number of seconds between the timestamps of consecutive sandbox blocks")
 
 func main() {
 	flag.Parse()
@@ -112,23 +120,43 @@ func run() int {
 		return 1
 	}
 
-	genesisPath := *genesisFile
-	if genesisPath == "" {
-		genesisPath = filepath.Join(dataDir, config.GenesisJSONFile)
-	}
+	var genesis bookkeeping.Genesis
+	var genesisText []byte
+	var sandboxSecrets []*crypto.SignatureSecrets
+	var err error
+	if *sandbox {
+		if *genesisFile != "" {
+			fmt.Fprintln(os.Stderr, "A sandbox generates its own genesis, -g cannot be used with -sandbox")
+			return 1
+		}
+		genesis, sandboxSecrets, err = gen.GenerateSandboxGenesis(gen.SandboxGenesisData{
+			Seed:     *sandboxSeed,
+			Accounts: *sandboxAccounts,
+			Balance:  *sandboxBalance,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot generate sandbox genesis: %v\n", err)
+			return 1
+		}
+		genesisText = protocol.EncodeJSON(genesis)
+	} else {
+		genesisPath := *genesisFile
+		if genesisPath == "" {
+			genesisPath = filepath.Join(dataDir, config.GenesisJSONFile)
+		}
 
-	// Load genesis
-	genesisText, err := os.ReadFile(genesisPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Cannot read genesis file %s: %v\n", genesisPath, err)
-		return 1
-	}
+		// Load genesis
+		genesisText, err = os.ReadFile(genesisPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot read genesis file %s: %v\n", genesisPath, err)
+			return 1
+		}
 
-	var genesis bookkeeping.Genesis
-	err = protocol.DecodeJSON(genesisText, &genesis)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Cannot parse genesis file %s: %v\n", genesisPath, err)
-		return 1
+		err = protocol.DecodeJSON(genesisText, &genesis)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Cannot parse genesis file %s: %v\n", genesisPath, err)
+			return 1
+		}
 	}
 
 	if *genesisPrint {
@@ -185,13 +213,13 @@ func run() int {
 		}
 		fmt.Fprintf(os.Stdout, "Telemetry configured from '%s'\n", telemetryConfig.FilePath)
 
-		telemetryConfig.SendToLog = telemetryConfig.SendToLog || cfg.TelemetryToLog
+		telemetryConfig.TelemetryToLog = cfg.TelemetryToLog
 
 		// Apply telemetry override.
 		telemetryConfig.Enable = logging.TelemetryOverride(*telemetryOverride, &telemetryConfig)
 		remoteTelemetryEnabled = telemetryConfig.Enable
 
-		if telemetryConfig.Enable || telemetryConfig.SendToLog {
+		if telemetryConfig.Enable || telemetryConfig.SendToLog || telemetryConfig.TelemetryToLog || len(telemetryConfig.Sinks) > 0 {
 			// If session GUID specified, use it.
 			if *sessionGUID != "" {
 				if len(*sessionGUID) == 36 {
@@ -206,8 +234,10 @@ func run() int {
 	}
 
 	s := algod.Server{
-		RootPath: absolutePath,
-		Genesis:  genesis,
+		RootPath:          absolutePath,
+		Genesis:           genesis,
+		Sandbox:           *sandbox,
+		SandboxTimeOffset: *sandboxTimeOffset,
 	}
 
 	// Generate a REST API token if one was not provided
@@ -232,28 +262,10 @@ func run() int {
 		fmt.Printf("No Admin REST API Token found. Generated token: %s\n", adminAPIToken)
 	}
 
-	// Allow overriding default listening address
-	if *listenIP != "" {
-		cfg.EndpointAddress = *listenIP
-	}
-
-	// If overriding peers, disable SRV lookup
 	telemetryDNSBootstrapID := cfg.DNSBootstrapID
 	var peerOverrideArray []string
 	if *peerOverride != "" {
 		peerOverrideArray = strings.Split(*peerOverride, ";")
-		cfg.DNSBootstrapID = ""
-
-		// The networking code waits until we have GossipFanout
-		// connections before declaring the network stack to be
-		// ready, which triggers things like catchup.  If the
-		// user explicitly specified a set of peers, make sure
-		// GossipFanout is no larger than this set, otherwise
-		// we will have to wait for a minute-long timeout until
-		// the network stack declares itself to be ready.
-		if cfg.GossipFanout > len(peerOverrideArray) {
-			cfg.GossipFanout = len(peerOverrideArray)
-		}
 
 		// make sure that the format of each entry is valid:
 		for idx, peer := range peerOverrideArray {
@@ -292,9 +304,36 @@ func run() int {
 		}
 	}
 
-	if logToStdout != nil && *logToStdout {
-		cfg.LogSizeLimit = 0
+	// overrideConfig applies the command line settings to the configuration read from disk, both at startup
+	// and when the configuration gets reloaded.
+	overrideConfig := func(cfg *config.Local) {
+		// Allow overriding default listening address
+		if *listenIP != "" {
+			cfg.EndpointAddress = *listenIP
+		}
+
+		// If overriding peers, disable SRV lookup
+		if peerOverrideArray != nil {
+			cfg.DNSBootstrapID = ""
+
+			// The networking code waits until we have GossipFanout
+			// connections before declaring the network stack to be
+			// ready, which triggers things like catchup.  If the
+			// user explicitly specified a set of peers, make sure
+			// GossipFanout is no larger than this set, otherwise
+			// we will have to wait for a minute-long timeout until
+			// the network stack declares itself to be ready.
+			if cfg.GossipFanout > len(peerOverrideArray) {
+				cfg.GossipFanout = len(peerOverrideArray)
+			}
+		}
+
+		if logToStdout != nil && *logToStdout {
+			cfg.LogSizeLimit = 0
+		}
 	}
+	overrideConfig(&cfg)
+	s.ConfigOverrides = overrideConfig
 
 	err = s.Initialize(cfg, phonebookAddresses, string(genesisText))
 	if err != nil {
@@ -307,6 +346,19 @@ func run() int {
 		return 0
 	}
 
+	if *sandbox {
+		fmt.Fprintf(os.Stdout, "Sandbox %s funded accounts:\n", genesis.ID())
+		for i, secrets := range sandboxSecrets {
+			keySeed := gen.SandboxAccountSeed(*sandboxSeed, i)
+			mnemonic, err := passphrase.KeyToMnemonic(keySeed[:])
+			if err != nil {
+				log.Errorf("cannot derive the mnemonic of a sandbox account: %v", err)
+				return 1
+			}
+			fmt.Fprintf(os.Stdout, "%s %s\n", basics.Address(secrets.SignatureVerifier), mnemonic)
+		}
+	}
+
 	deadlockState := "enabled"
 	if deadlock.Opts.Disable {
 		deadlockState = "disabled"
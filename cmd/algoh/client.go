@@ -28,4 +28,6 @@ type Client interface {
 	RawBlock(round uint64) ([]byte, error)
 	GetGoRoutines(ctx context.Context) (string, error)
 	HealthCheck() error
+	HealthDetails() (model.HealthReport, error)
+	Metrics(ctx context.Context) (string, error)
 }
@@ -19,14 +19,19 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/logging/telemetryspec"
 	"github.com/algorand/go-algorand/rpcs"
 )
 
+// readinessCheckInterval is how often the deadman polls the readiness of the node, when enabled.
+const readinessCheckInterval = 5 * time.Second
+
 type deadManWatcher struct {
 	timeout       time.Duration
 	newBlockChan  chan uint64
@@ -35,9 +40,15 @@ type deadManWatcher struct {
 	done          <-chan struct{}
 	wg            *sync.WaitGroup
 	algodConfig   config.Local
+	// failures gets the timeouts after which algod doesn't respond anymore, for the supervisor to stop it.
+	failures chan<- string
+
+	// readinessInterval, if not zero, is how often the readiness of the node is checked, in which case the
+	// deadman also triggers once the node has not been ready for the timeout.
+	readinessInterval time.Duration
 }
 
-func makeDeadManWatcher(timeout int64, client Client, uploadOnError bool, done <-chan struct{}, wg *sync.WaitGroup, algodConfig config.Local) deadManWatcher {
+func makeDeadManWatcher(timeout int64, client Client, uploadOnError bool, done <-chan struct{}, wg *sync.WaitGroup, algodConfig config.Local, failures chan<- string) deadManWatcher {
 	var deadManTime time.Duration
 	if timeout == 0 {
 		deadManTime = time.Hour * (10 * 365 * 24) // Don't fire for 10 years
@@ -53,6 +64,7 @@ func makeDeadManWatcher(timeout int64, client Client, uploadOnError bool, done <
 		done:          done,
 		wg:            wg,
 		algodConfig:   algodConfig,
+		failures:      failures,
 	}
 }
 
@@ -66,6 +78,15 @@ func (w deadManWatcher) run(initBlock uint64) {
 
 	var deadManTimeout <-chan time.Time
 
+	var readinessTicker <-chan time.Time
+	if w.readinessInterval > 0 {
+		ticker := time.NewTicker(w.readinessInterval)
+		defer ticker.Stop()
+		readinessTicker = ticker.C
+	}
+	var notReadySince time.Time
+	notReadyReported := false
+
 	for {
 		select {
 		case block := <-w.newBlockChan:
@@ -73,17 +94,38 @@ func (w deadManWatcher) run(initBlock uint64) {
 			deadManTimeout = time.After(w.timeout)
 		case <-w.done:
 			return
+		case <-readinessTicker:
+			if isReady(w.client) {
+				notReadySince = time.Time{}
+				notReadyReported = false
+				continue
+			}
+			if notReadySince.IsZero() {
+				notReadySince = time.Now()
+			}
+			// report once per period the node is not ready, as it usually takes a while to recover.
+			if notReadyReported || time.Since(notReadySince) < w.timeout {
+				continue
+			}
+			notReadyReported = true
+			w.trigger(latestBlock)
 		case <-deadManTimeout:
 			deadManTimeout = nil // Don't detect deadlock again until after we see another block
+			w.trigger(latestBlock)
+		}
+	}
+}
 
-			err := w.reportDeadManTimeout(latestBlock)
-			// If err is not nil, algod failed to respond to goroutine request
-			// This is a critical failure - hopefully telemetry and logging will capture
-			// the details, but the best thing we can do is try to shut it down.
-			if err != nil {
-				nc := getNodeController()
-				nc.FullStop()
-			}
+func (w deadManWatcher) trigger(latestBlock uint64) {
+	err := w.reportDeadManTimeout(latestBlock)
+	// If err is not nil, algod failed to respond to goroutine request
+	// This is a critical failure - hopefully telemetry and logging will capture
+	// the details, but the best thing we can do is have the supervisor stop it.
+	if err != nil {
+		failure := fmt.Sprintf("deadman: no progress past round %d for %v, and algod did not respond: %v", latestBlock, w.timeout, err)
+		select {
+		case w.failures <- failure:
+		default:
 		}
 	}
 }
@@ -95,7 +137,8 @@ func (w deadManWatcher) onBlock(block rpcs.EncodedBlockCert) {
 func (w deadManWatcher) reportDeadManTimeout(curBlock uint64) (err error) {
 	var details telemetryspec.DeadManTriggeredEventDetails
 	if w.algodConfig.EnableProfiler {
-		goRoutines, err := getGoRoutines(w.client)
+		var goRoutines string
+		goRoutines, err = getGoRoutines(w.client)
 		if err != nil {
 			goRoutines = fmt.Sprintf("Error dumping goroutines: %v", err)
 		}
@@ -105,7 +148,8 @@ func (w deadManWatcher) reportDeadManTimeout(curBlock uint64) (err error) {
 			GoRoutines:   goRoutines,
 		}
 	} else {
-		healthCheck, err := getHealthCheck(w.client)
+		var healthCheck string
+		healthCheck, err = getHealthCheck(w.client)
 		if err != nil {
 			healthCheck = fmt.Sprintf("Error performing health check : %v", err)
 		}
@@ -135,8 +179,43 @@ func getGoRoutines(client Client) (goRoutines string, err error) {
 
 func getHealthCheck(client Client) (healthCheck string, err error) {
 	err = client.HealthCheck()
-	if err == nil {
+	if err != nil {
+		return
+	}
+	report, detailsErr := client.HealthDetails()
+	if detailsErr != nil {
+		// older nodes don't provide the health details.
 		healthCheck = "Node is healthy"
+		return
 	}
-	return
+	return describeHealthReport(report), nil
+}
+
+// describeHealthReport summarizes the checks of the report which did not pass.
+func describeHealthReport(report model.HealthReport) string {
+	var problems []string
+	for _, check := range report.Checks {
+		if check.Status == model.HealthCheckResultStatusPass {
+			continue
+		}
+		problem := fmt.Sprintf("%s: %s", check.Name, check.Status)
+		if check.Message != nil && *check.Message != "" {
+			problem += fmt.Sprintf(" (%s)", *check.Message)
+		}
+		problems = append(problems, problem)
+	}
+	if len(problems) == 0 {
+		return fmt.Sprintf("Node is healthy at round %d", report.Round)
+	}
+	state := "healthy"
+	if !report.Ready {
+		state = "not ready"
+	}
+	return fmt.Sprintf("Node is %s at round %d; %s", state, report.Round, strings.Join(problems, "; "))
+}
+
+// isReady tells whether the node reports it's ready to serve requests.
+func isReady(client Client) bool {
+	report, err := client.HealthDetails()
+	return err == nil && report.Ready
 }
@@ -0,0 +1,81 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHealthCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	lag := "round 10 was made 2m0s ago"
+	client := makeMockClient(nil, nil, nil, []string{""})
+	client.health = model.HealthReport{
+		Round: 10,
+		Checks: []model.HealthCheckResult{
+			{Name: "ledger-lag", Status: model.HealthCheckResultStatusFail, Message: &lag},
+			{Name: "peers", Status: model.HealthCheckResultStatusPass},
+		},
+	}
+	healthCheck, err := getHealthCheck(&client)
+	require.NoError(t, err)
+	require.Equal(t, "Node is not ready at round 10; ledger-lag: fail (round 10 was made 2m0s ago)", healthCheck)
+	require.False(t, isReady(&client))
+
+	client.health.Ready = true
+	client.health.Checks = client.health.Checks[1:]
+	healthCheck, err = getHealthCheck(&client)
+	require.NoError(t, err)
+	require.Equal(t, "Node is healthy at round 10", healthCheck)
+	require.True(t, isReady(&client))
+
+	// nodes which don't serve the health details are only checked for liveness.
+	client.error = []error{nil, fmt.Errorf("not found")}
+	healthCheck, err = getHealthCheck(&client)
+	require.NoError(t, err)
+	require.Equal(t, "Node is healthy", healthCheck)
+}
+
+func TestDeadManTriggerReportsUnresponsiveAlgod(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	failures := make(chan string, 1)
+	client := makeMockClient(nil, nil, nil, []string{""})
+	deadMan := makeDeadManWatcher(60, &client, false, nil, nil, config.GetDefaultLocal(), failures)
+
+	// the timeout alone is only reported to telemetry.
+	deadMan.trigger(10)
+	require.Empty(t, failures)
+
+	// algod which doesn't respond anymore is left to the supervisor to stop.
+	client.error = []error{fmt.Errorf("connection refused")}
+	deadMan.trigger(10)
+	require.Len(t, failures, 1)
+	require.Contains(t, <-failures, "round 10")
+
+	// the supervisor handles one failure at a time.
+	deadMan.trigger(10)
+	deadMan.trigger(10)
+	require.Len(t, failures, 1)
+}
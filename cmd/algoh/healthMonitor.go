@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/shared/algoh"
+)
+
+// healthCheckInterval is how often the health monitor checks algod.
+const healthCheckInterval = 5 * time.Second
+
+// peersMetricName is the metric algod reports its number of connected peers with.
+const peersMetricName = "algod_network_peers"
+
+// healthMonitor keeps checking algod for the configured health checks, and reports the first one which fails.
+type healthMonitor struct {
+	checks   []algoh.HealthCheck
+	client   Client
+	interval time.Duration
+	done     <-chan struct{}
+	failures chan<- string
+
+	// lastRound and progressed are the latest round algod reported and when it last made progress.
+	lastRound  uint64
+	progressed time.Time
+	// peersOK is when algod last had enough peers for each of the checks, by index.
+	peersOK map[int]time.Time
+}
+
+func makeHealthMonitor(checks []algoh.HealthCheck, client Client, done <-chan struct{}, failures chan<- string) *healthMonitor {
+	return &healthMonitor{
+		checks:   checks,
+		client:   client,
+		interval: healthCheckInterval,
+		done:     done,
+		failures: failures,
+	}
+}
+
+func (m *healthMonitor) run() {
+	if len(m.checks) == 0 {
+		return
+	}
+	m.start(time.Now())
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case now := <-ticker.C:
+			failure := m.check(now)
+			if failure == "" {
+				continue
+			}
+			select {
+			case m.failures <- failure:
+			default:
+			}
+			return
+		}
+	}
+}
+
+// start considers algod healthy as of now, giving every check its whole timeout before it can fail.
+func (m *healthMonitor) start(now time.Time) {
+	m.progressed = now
+	m.peersOK = make(map[int]time.Time, len(m.checks))
+	for i := range m.checks {
+		m.peersOK[i] = now
+	}
+}
+
+// check polls algod, returning the failure of the first check which has been failing for its timeout, or an
+// empty string.
+func (m *healthMonitor) check(now time.Time) string {
+	var status *statusSample
+	var peers *peersSample
+	for i, hc := range m.checks {
+		timeout := time.Duration(hc.TimeoutSec) * time.Second
+		switch hc.Type {
+		case algoh.HealthCheckNoNewBlock:
+			if status == nil {
+				status = m.sampleStatus(now)
+			}
+			if now.Sub(m.progressed) >= timeout {
+				return fmt.Sprintf("%s: no new block since round %d for %v%s", hc.Type, m.lastRound, now.Sub(m.progressed).Truncate(time.Second), status.describeError())
+			}
+		case algoh.HealthCheckNoPeers:
+			if peers == nil {
+				peers = m.samplePeers()
+			}
+			minPeers := hc.MinPeers
+			if minPeers == 0 {
+				minPeers = 1
+			}
+			if peers.err == nil && peers.count >= minPeers {
+				m.peersOK[i] = now
+				continue
+			}
+			if now.Sub(m.peersOK[i]) >= timeout {
+				if peers.err != nil {
+					return fmt.Sprintf("%s: peer count unknown for %v (%v)", hc.Type, now.Sub(m.peersOK[i]).Truncate(time.Second), peers.err)
+				}
+				return fmt.Sprintf("%s: %d peers connected for %v, at least %d required", hc.Type, peers.count, now.Sub(m.peersOK[i]).Truncate(time.Second), minPeers)
+			}
+		}
+	}
+	return ""
+}
+
+type statusSample struct {
+	err error
+}
+
+func (s *statusSample) describeError() string {
+	if s.err == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (%v)", s.err)
+}
+
+// sampleStatus records whether algod progressed since the last sample. A catchpoint catchup counts as
+// progress, as the round doesn't change until it completes.
+func (m *healthMonitor) sampleStatus(now time.Time) *statusSample {
+	status, err := m.client.Status()
+	if err != nil {
+		return &statusSample{err: err}
+	}
+	if status.LastRound != m.lastRound || (status.Catchpoint != nil && *status.Catchpoint != "") {
+		m.lastRound = status.LastRound
+		m.progressed = now
+	}
+	return &statusSample{}
+}
+
+type peersSample struct {
+	count int64
+	err   error
+}
+
+func (m *healthMonitor) samplePeers() *peersSample {
+	ctx, cancel := context.WithTimeout(context.Background(), m.interval)
+	defer cancel()
+	metrics, err := m.client.Metrics(ctx)
+	if err != nil {
+		return &peersSample{err: err}
+	}
+	count, err := parseMetric(metrics, peersMetricName)
+	return &peersSample{count: count, err: err}
+}
+
+// parseMetric finds the value of a metric in the text format of the /metrics endpoint, such as
+// "algod_network_peers{} 4", ignoring its labels.
+func parseMetric(metrics string, name string) (int64, error) {
+	scanner := bufio.NewScanner(strings.NewReader(metrics))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.SplitN(fields[0], "{", 2)[0] != name {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[len(fields)-1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("metric %s has invalid value %q", name, fields[len(fields)-1])
+		}
+		return int64(value), nil
+	}
+	return 0, fmt.Errorf("metric %s not reported", name)
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/shared/algoh"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHealthMonitorNoNewBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	client := makeMockClient(nil, makeNodeStatuses(5, 6, 6, 6, 6), nil, nil)
+	monitor := makeHealthMonitor([]algoh.HealthCheck{{Type: algoh.HealthCheckNoNewBlock, TimeoutSec: 60}}, &client, nil, nil)
+	now := time.Unix(1000000, 0)
+	monitor.start(now)
+
+	for i := 0; i < 3; i++ {
+		now = now.Add(30 * time.Second)
+		require.Empty(t, monitor.check(now))
+	}
+	now = now.Add(30 * time.Second)
+	require.Equal(t, "no-new-block: no new block since round 6 for 1m0s", monitor.check(now))
+
+	// a catchpoint catchup makes progress without new blocks.
+	catchpoint := "1000#ABC"
+	client.status = []model.NodeStatusResponse{{LastRound: 6, Catchpoint: &catchpoint}}
+	require.Empty(t, monitor.check(now))
+
+	client.error = []error{fmt.Errorf("connection refused")}
+	now = now.Add(time.Minute)
+	require.Equal(t, "no-new-block: no new block since round 6 for 1m0s (connection refused)", monitor.check(now))
+}
+
+func TestHealthMonitorNoPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	client := makeMockClient(nil, nil, nil, nil)
+	client.metrics = "# HELP algod_network_peers Number of active peers.\n# TYPE algod_network_peers gauge\nalgod_network_peers{} 2\n"
+	monitor := makeHealthMonitor([]algoh.HealthCheck{
+		{Type: algoh.HealthCheckNoPeers, TimeoutSec: 60},
+		{Type: algoh.HealthCheckNoPeers, TimeoutSec: 120, MinPeers: 4},
+	}, &client, nil, nil)
+	now := time.Unix(1000000, 0)
+	monitor.start(now)
+
+	now = now.Add(time.Minute)
+	require.Empty(t, monitor.check(now))
+	require.Equal(t, 1, client.MetricsCalls)
+
+	now = now.Add(time.Minute)
+	require.Equal(t, "no-peers: 2 peers connected for 2m0s, at least 4 required", monitor.check(now))
+
+	client.metrics = "algod_network_peers{} 0\n"
+	now = now.Add(30 * time.Second)
+	require.Contains(t, monitor.check(now), "at least 4 required")
+	monitor.checks = monitor.checks[:1]
+	require.Empty(t, monitor.check(now))
+	now = now.Add(30 * time.Second)
+	require.Equal(t, "no-peers: 0 peers connected for 1m0s, at least 1 required", monitor.check(now))
+
+	client.error = []error{fmt.Errorf("timeout")}
+	require.Equal(t, "no-peers: peer count unknown for 1m0s (timeout)", monitor.check(now))
+}
+
+func TestHealthMonitorRun(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	client := makeMockClient(nil, makeNodeStatuses(5), nil, nil)
+	failures := make(chan string, 1)
+	monitor := makeHealthMonitor([]algoh.HealthCheck{{Type: algoh.HealthCheckNoNewBlock, TimeoutSec: 1}}, &client, make(chan struct{}), failures)
+	monitor.interval = 10 * time.Millisecond
+	monitor.run()
+	require.Equal(t, "no-new-block: no new block since round 5 for 1s", <-failures)
+}
+
+func TestParseMetric(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	metrics := "# HELP algod_network_peers Number of active peers.\n# TYPE algod_network_peers gauge\nalgod_network_peers{} 7\nalgod_network_peers_total 9\n"
+	value, err := parseMetric(metrics, peersMetricName)
+	require.NoError(t, err)
+	require.Equal(t, int64(7), value)
+
+	_, err = parseMetric("algod_network_incoming_peers{} 1\n", peersMetricName)
+	require.ErrorContains(t, err, "not reported")
+
+	_, err = parseMetric("algod_network_peers{} many\n", peersMetricName)
+	require.ErrorContains(t, err, "invalid value")
+}
@@ -23,7 +23,6 @@ import (
 	"os/exec"
 	"os/signal"
 	"path/filepath"
-	"sync"
 	"syscall"
 	"time"
 
@@ -70,7 +69,6 @@ func (c *stdCollector) Write(p []byte) (n int, err error) {
 }
 
 func main() {
-	blockWatcherInitialized := false
 	flag.Parse()
 	nc := getNodeController()
 
@@ -125,44 +123,6 @@ func main() {
 		reportErrorf("Error getting ExeDir: %v\n", err)
 	}
 
-	var errorOutput stdCollector
-	var output stdCollector
-	go func() {
-		args := make([]string, len(os.Args)-1)
-		copy(args, os.Args[1:]) // Copy our arguments (skip the executable)
-		if log.GetTelemetryEnabled() {
-			args = append(args, "-s", log.GetTelemetrySession())
-		}
-		algodPath := filepath.Join(exeDir, algodFileName)
-		cmd := exec.Command(algodPath, args...)
-		cmd.Stderr = &errorOutput
-		cmd.Stdout = &output
-
-		err = cmd.Start()
-		if err != nil {
-			reportErrorf("error starting algod: %v", err)
-		}
-		err = cmd.Wait()
-		if err != nil {
-			reportErrorf("error waiting for algod: %v", err)
-		}
-		close(done)
-
-		// capture logs if algod terminated prior to blockWatcher starting
-		if !blockWatcherInitialized {
-			captureErrorLogs(algohConfig, errorOutput, output, absolutePath, true)
-		}
-
-		log.Infoln("++++++++++++++++++++++++++++++++++++++++")
-		log.Infoln("algod exited.  Exiting...")
-		log.Infoln("++++++++++++++++++++++++++++++++++++++++")
-	}()
-
-	// Set up error capturing
-	defer func() {
-		captureErrorLogs(algohConfig, errorOutput, output, absolutePath, false)
-	}()
-
 	// Handle signals cleanly
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
@@ -173,31 +133,27 @@ func main() {
 		os.Exit(0)
 	}()
 
-	algodClient, err := waitForClient(nc, done)
-	if err != nil {
-		reportErrorf("error creating Rest Client: %v\n", err)
-	}
-
-	var wg sync.WaitGroup
-
-	deadMan := makeDeadManWatcher(algohConfig.DeadManTimeSec, algodClient, algohConfig.UploadOnError, done, &wg, algodConfig)
-	wg.Add(1)
-
-	listeners := []blockListener{deadMan}
-	if algohConfig.SendBlockStats {
-		// Note: Resume can be implemented here. Store blockListener state and set curBlock based on latestBlock/lastBlock.
-		listeners = append(listeners, &blockstats{log: logging.Base()})
+	bundler := makeCrashBundler(algohConfig, absolutePath, algodConfig)
+	policy := makeRestartPolicy(algohConfig)
+	for {
+		started := time.Now()
+		failure := superviseAlgod(nc, algohConfig, algodConfig, absolutePath, bundler)
+		if failure == "" {
+			break
+		}
+		delay, restart := policy.next(started, time.Now())
+		if !restart {
+			break
+		}
+		log.Warnf("restarting algod in %v", delay)
+		fmt.Fprintf(os.Stdout, "Restarting algod in %v after: %s\n", delay, failure)
+		time.Sleep(delay)
 	}
+	close(done)
 
-	delayBetweenStatusChecks := time.Duration(algohConfig.StatusDelayMS) * time.Millisecond
-	stallDetectionDelay := time.Duration(algohConfig.StallDelayMS) * time.Millisecond
-
-	runBlockWatcher(listeners, algodClient, done, &wg, delayBetweenStatusChecks, stallDetectionDelay)
-	wg.Add(1)
-
-	blockWatcherInitialized = true
-
-	wg.Wait()
+	log.Infoln("++++++++++++++++++++++++++++++++++++++++")
+	log.Infoln("algod exited.  Exiting...")
+	log.Infoln("++++++++++++++++++++++++++++++++++++++++")
 	fmt.Println("Exiting algoh normally...")
 }
 
@@ -300,6 +256,9 @@ func initTelemetry(genesis bookkeeping.Genesis, log logging.Logger, dataDirector
 
 		// Apply telemetry override.
 		telemetryConfig.Enable = logging.TelemetryOverride(*telemetryOverride, &telemetryConfig)
+		// The sinks share logging.config with algod, which owns them: we would otherwise write to the same files
+		// and collectors as algod, and both rotate the same file sink.
+		telemetryConfig.Sinks = nil
 
 		if telemetryConfig.Enable {
 			err = log.EnableTelemetry(telemetryConfig)
@@ -383,4 +342,8 @@ func validateConfig(config algoh.HostConfig) {
 	if config.DeadManTimeSec > 0 && config.DeadManTimeSec < 30 {
 		reportErrorf("Config.DeadManTimeSec should be >= 30 seconds (set to %v)\n", config.DeadManTimeSec)
 	}
+	if err := config.Validate(); err != nil {
+		reportErrorf("Invalid configuration: %v\n", err)
+		os.Exit(1)
+	}
 }
@@ -54,10 +54,14 @@ type mockClient struct {
 	BlockCalls         map[uint64]int
 	GetGoRoutinesCalls int
 	HealthCheckCalls   int
+	HealthDetailsCalls int
+	MetricsCalls       int
 	error              []error
 	status             []model.NodeStatusResponse
 	routine            []string
 	block              map[uint64]rpcs.EncodedBlockCert
+	health             model.HealthReport
+	metrics            string
 }
 
 func makeMockClient(error []error, status []model.NodeStatusResponse, block map[uint64]rpcs.EncodedBlockCert, routine []string) mockClient {
@@ -126,3 +130,17 @@ func (c *mockClient) HealthCheck() (e error) {
 	e = c.nextError()
 	return
 }
+
+func (c *mockClient) HealthDetails() (r model.HealthReport, e error) {
+	c.HealthDetailsCalls++
+	r = c.health
+	e = c.nextError()
+	return
+}
+
+func (c *mockClient) Metrics(ctx context.Context) (r string, e error) {
+	c.MetricsCalls++
+	r = c.metrics
+	e = c.nextError()
+	return
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/nodecontrol"
+	"github.com/algorand/go-algorand/shared/algoh"
+)
+
+// restartPolicy decides whether, and after which delay, algod gets restarted after a failure.
+type restartPolicy struct {
+	enabled     bool
+	minBackoff  time.Duration
+	maxBackoff  time.Duration
+	maxRestarts int
+	window      time.Duration
+
+	// restarts are the times of the restarts within the window.
+	restarts []time.Time
+	// consecutive is the number of failures since algod last ran for maxBackoff.
+	consecutive int
+}
+
+func makeRestartPolicy(cfg algoh.HostConfig) restartPolicy {
+	return restartPolicy{
+		enabled:     cfg.RestartOnFailure,
+		minBackoff:  time.Duration(cfg.RestartBackoffMinSec) * time.Second,
+		maxBackoff:  time.Duration(cfg.RestartBackoffMaxSec) * time.Second,
+		maxRestarts: int(cfg.MaxRestarts),
+		window:      time.Duration(cfg.RestartWindowSec) * time.Second,
+	}
+}
+
+// next returns the delay before restarting algod, which started at started and failed at now, or false
+// if algod should not be restarted.
+func (p *restartPolicy) next(started, now time.Time) (time.Duration, bool) {
+	if !p.enabled {
+		return 0, false
+	}
+	recent := p.restarts[:0]
+	for _, restart := range p.restarts {
+		if now.Sub(restart) < p.window {
+			recent = append(recent, restart)
+		}
+	}
+	p.restarts = recent
+	if len(p.restarts) >= p.maxRestarts {
+		return 0, false
+	}
+
+	if now.Sub(started) >= p.maxBackoff {
+		p.consecutive = 0
+	}
+	delay := p.minBackoff
+	for i := 0; i < p.consecutive && delay < p.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.maxBackoff {
+		delay = p.maxBackoff
+	}
+	p.consecutive++
+	p.restarts = append(p.restarts, now.Add(delay))
+	return delay, true
+}
+
+// algodRun is an execution of algod under algoh.
+type algodRun struct {
+	errorOutput stdCollector
+	output      stdCollector
+	// done is closed once algod exited, with err telling how.
+	done chan struct{}
+	err  error
+}
+
+func startAlgod(log logging.Logger) (*algodRun, error) {
+	args := make([]string, len(os.Args)-1)
+	copy(args, os.Args[1:]) // Copy our arguments (skip the executable)
+	if log.GetTelemetryEnabled() {
+		args = append(args, "-s", log.GetTelemetrySession())
+	}
+	algodPath := filepath.Join(exeDir, algodFileName)
+	run := &algodRun{done: make(chan struct{})}
+	cmd := exec.Command(algodPath, args...)
+	cmd.Stderr = &run.errorOutput
+	cmd.Stdout = &run.output
+
+	err := cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		run.err = cmd.Wait()
+		close(run.done)
+	}()
+	return run, nil
+}
+
+// superviseAlgod runs algod and watches it until it exits, or fails a health check or the deadman and gets stopped. It
+// returns why algod failed, or an empty string when algod exited cleanly.
+func superviseAlgod(nc nodecontrol.NodeController, algohConfig algoh.HostConfig, algodConfig config.Local, dataDir string, bundler crashBundler) (failure string) {
+	log := logging.Base()
+	run, err := startAlgod(log)
+	if err != nil {
+		reportErrorf("error starting algod: %v", err)
+		return ""
+	}
+
+	failures := make(chan string, 1)
+	var wg sync.WaitGroup
+	algodClient, err := waitForClient(nc, run.done)
+	if err == nil {
+		deadMan := makeDeadManWatcher(algohConfig.DeadManTimeSec, algodClient, algohConfig.UploadOnError, run.done, &wg, algodConfig, failures)
+		if algohConfig.DeadManReadinessCheck && algohConfig.DeadManTimeSec > 0 {
+			deadMan.readinessInterval = readinessCheckInterval
+		}
+		wg.Add(1)
+
+		listeners := []blockListener{deadMan}
+		if algohConfig.SendBlockStats {
+			// Note: Resume can be implemented here. Store blockListener state and set curBlock based on latestBlock/lastBlock.
+			listeners = append(listeners, &blockstats{log: logging.Base()})
+		}
+
+		delayBetweenStatusChecks := time.Duration(algohConfig.StatusDelayMS) * time.Millisecond
+		stallDetectionDelay := time.Duration(algohConfig.StallDelayMS) * time.Millisecond
+
+		wg.Add(1)
+		go runBlockWatcher(listeners, algodClient, run.done, &wg, delayBetweenStatusChecks, stallDetectionDelay)
+
+		health := makeHealthMonitor(algohConfig.HealthChecks, algodClient, run.done, failures)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			health.run()
+		}()
+	}
+
+	select {
+	case <-run.done:
+	case failure = <-failures:
+		// capture the artifacts while algod still runs, then stop it.
+		log.Warnf("algod failed: %s", failure)
+		saveCrashBundle(bundler, crashFailure{reason: failure, client: algodClient})
+		err = nc.StopAlgod()
+		if err != nil {
+			reportErrorf("error stopping algod: %v\n", err)
+		}
+		<-run.done
+	}
+	wg.Wait()
+
+	if failure == "" && run.err != nil {
+		reportErrorf("error waiting for algod: %v\n", run.err)
+		failure = fmt.Sprintf("algod exited: %v", run.err)
+		saveCrashBundle(bundler, crashFailure{reason: failure, output: run.output.output, errors: run.errorOutput.output})
+	}
+	captureErrorLogs(algohConfig, run.errorOutput, run.output, dataDir, failure != "")
+	return failure
+}
+
+func saveCrashBundle(bundler crashBundler, failure crashFailure) {
+	bundle, err := bundler.capture(failure, time.Now())
+	if err != nil {
+		reportErrorf("error saving crash bundle: %v\n", err)
+		return
+	}
+	if bundle != "" {
+		logging.Base().Infof("saved crash bundle %s", bundle)
+		fmt.Fprintf(os.Stdout, "Saved crash bundle %s\n", bundle)
+	}
+}
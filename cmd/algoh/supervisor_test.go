@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/shared/algoh"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRestartPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := algoh.HostConfig{
+		RestartOnFailure:     true,
+		RestartBackoffMinSec: 10,
+		RestartBackoffMaxSec: 60,
+		MaxRestarts:          4,
+		RestartWindowSec:     3600,
+	}
+	policy := makeRestartPolicy(cfg)
+	now := time.Unix(1000000, 0)
+
+	// consecutive failures back off exponentially, up to the maximum.
+	for _, expected := range []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second} {
+		delay, restart := policy.next(now.Add(-time.Second), now)
+		require.True(t, restart)
+		require.Equal(t, expected, delay)
+		now = now.Add(delay + time.Second)
+	}
+
+	// running for the maximal backoff resets it.
+	delay, restart := policy.next(now.Add(-time.Minute), now)
+	require.True(t, restart)
+	require.Equal(t, 10*time.Second, delay)
+
+	// the restarts within the window are bounded, until the earliest ones leave the window.
+	now = now.Add(delay + time.Second)
+	_, restart = policy.next(now.Add(-time.Second), now)
+	require.False(t, restart)
+	now = now.Add(time.Hour)
+	delay, restart = policy.next(now.Add(-time.Second), now)
+	require.True(t, restart)
+	require.Equal(t, 20*time.Second, delay)
+
+	cfg.RestartOnFailure = false
+	policy = makeRestartPolicy(cfg)
+	_, restart = policy.next(now, now)
+	require.False(t, restart)
+}
+
+func TestHostConfigValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg, err := algoh.LoadConfigFromFile("")
+	require.Error(t, err)
+	require.NoError(t, cfg.Validate())
+
+	cfg.HealthChecks = []algoh.HealthCheck{{Type: algoh.HealthCheckNoNewBlock, TimeoutSec: 120}, {Type: algoh.HealthCheckNoPeers, TimeoutSec: 300}}
+	require.NoError(t, cfg.Validate())
+
+	cfg.HealthChecks[1].Type = "no-votes"
+	require.ErrorContains(t, cfg.Validate(), `HealthChecks[1] has unknown type "no-votes"`)
+
+	cfg.HealthChecks[1] = algoh.HealthCheck{Type: algoh.HealthCheckNoPeers}
+	require.ErrorContains(t, cfg.Validate(), "needs a positive TimeoutSec")
+
+	cfg.HealthChecks = nil
+	cfg.RestartOnFailure = true
+	cfg.RestartBackoffMinSec = cfg.RestartBackoffMaxSec + 1
+	require.ErrorContains(t, cfg.Validate(), "exceeds RestartBackoffMaxSec")
+}
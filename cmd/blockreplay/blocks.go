@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+// blockSource provides the blocks which are replayed
+type blockSource interface {
+	block(round uint64) (bookkeeping.Block, error)
+}
+
+// blockFileName is the name of the file of an exported block, which holds the block and its
+// certificate encoded with msgpack, as returned by the /v2/blocks endpoint of algod.
+func blockFileName(round uint64) string {
+	return fmt.Sprintf("%d.block", round)
+}
+
+// dirBlockSource reads the blocks exported to a directory
+type dirBlockSource struct {
+	dir string
+}
+
+func (s dirBlockSource) block(round uint64) (bookkeeping.Block, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, blockFileName(round)))
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+	var blockCert rpcs.EncodedBlockCert
+	err = protocol.Decode(data, &blockCert)
+	if err != nil {
+		return bookkeeping.Block{}, fmt.Errorf("unable to decode block %d: %w", round, err)
+	}
+	return blockCert.Block, nil
+}
+
+// nodeBlockSource reads the blocks from the REST API of an archival node
+type nodeBlockSource struct {
+	client client.RestClient
+}
+
+func makeNodeBlockSource(algodURL, token string) (nodeBlockSource, error) {
+	u, err := url.Parse(algodURL)
+	if err != nil {
+		return nodeBlockSource{}, err
+	}
+	if u.Scheme == "" {
+		return nodeBlockSource{}, fmt.Errorf("%s is not an absolute url", algodURL)
+	}
+	return nodeBlockSource{client: client.MakeRestClient(*u, token)}, nil
+}
+
+func (s nodeBlockSource) rawBlock(round uint64) ([]byte, error) {
+	return s.client.RawBlock(round)
+}
+
+func (s nodeBlockSource) block(round uint64) (bookkeeping.Block, error) {
+	data, err := s.rawBlock(round)
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+	var blockCert rpcs.EncodedBlockCert
+	err = protocol.Decode(data, &blockCert)
+	if err != nil {
+		return bookkeeping.Block{}, fmt.Errorf("unable to decode block %d: %w", round, err)
+	}
+	return blockCert.Block, nil
+}
+
+// exportBlocks writes the blocks of the range from the node to the directory
+func exportBlocks(source nodeBlockSource, first, last uint64, dir string) error {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+	for round := first; round <= last; round++ {
+		data, err := source.rawBlock(round)
+		if err != nil {
+			return fmt.Errorf("unable to fetch block %d: %w", round, err)
+		}
+		err = os.WriteFile(filepath.Join(dir, blockFileName(round)), data, 0644)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
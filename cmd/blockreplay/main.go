@@ -0,0 +1,195 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+var algodURL string
+var algodToken string
+var blocksDir string
+var firstRound uint64
+var lastRound uint64
+var outPath string
+var planPath string
+var templatePath string
+var accountBalance uint64
+var dataDir string
+var speed float64
+
+var rootCmd = &cobra.Command{
+	Use:   "blockreplay",
+	Short: "Replay the transactions of a range of blocks into a private network",
+	Long: `Replay the transactions of a range of blocks, read from an archival node or exported to a directory,
+into a private network. The accounts of the blocks are replaced by deterministic accounts of the genesis of the
+private network, and their assets and apps by the ones the plan maps them to.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd, planCmd, runCmd)
+
+	for _, cmd := range []*cobra.Command{exportCmd, planCmd, runCmd} {
+		cmd.Flags().StringVar(&algodURL, "algod", "", "url of the REST API of the archival node to read blocks from")
+		cmd.Flags().StringVar(&algodToken, "token", "", "API token of the archival node")
+	}
+	for _, cmd := range []*cobra.Command{planCmd, runCmd} {
+		cmd.Flags().StringVar(&blocksDir, "blocks", "", "directory of the blocks exported by the export command, instead of --algod")
+	}
+	for _, cmd := range []*cobra.Command{exportCmd, planCmd} {
+		cmd.Flags().Uint64Var(&firstRound, "first", 0, "first round of the range")
+		cmd.Flags().Uint64Var(&lastRound, "last", 0, "last round of the range")
+		cmd.Flags().StringVarP(&outPath, "out", "o", "", "output directory of the blocks, or file of the plan")
+		cmd.MarkFlagRequired("first")
+		cmd.MarkFlagRequired("last")
+		cmd.MarkFlagRequired("out")
+	}
+
+	planCmd.Flags().StringVar(&templatePath, "template", "", "file to write the template of a network for replaying the plan to")
+	planCmd.Flags().Uint64Var(&accountBalance, "balance", 10e12, "microalgos of each of the accounts of the network template")
+
+	runCmd.Flags().StringVarP(&dataDir, "datadir", "d", "", "data directory of the node of the private network to submit the transactions to")
+	runCmd.Flags().StringVar(&planPath, "plan", "", "plan of the replay, written by the plan command")
+	runCmd.Flags().Float64Var(&speed, "speed", 1, "speed of the replay relative to the rate of the blocks, 0 for as fast as possible")
+	runCmd.MarkFlagRequired("plan")
+}
+
+func reportErrorf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+func openBlockSource() blockSource {
+	if (algodURL == "") == (blocksDir == "") {
+		reportErrorf("exactly one of --algod and --blocks must be set")
+	}
+	if blocksDir != "" {
+		return dirBlockSource{dir: blocksDir}
+	}
+	source, err := makeNodeBlockSource(algodURL, algodToken)
+	if err != nil {
+		reportErrorf("bad --algod: %v", err)
+	}
+	return source
+}
+
+func checkRange() {
+	if firstRound == 0 || lastRound < firstRound {
+		reportErrorf("bad range of rounds %d to %d", firstRound, lastRound)
+	}
+}
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export a range of blocks of an archival node to a directory",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		checkRange()
+		if algodURL == "" {
+			reportErrorf("--algod must be set")
+		}
+		source, err := makeNodeBlockSource(algodURL, algodToken)
+		if err != nil {
+			reportErrorf("bad --algod: %v", err)
+		}
+		err = exportBlocks(source, firstRound, lastRound, outPath)
+		if err != nil {
+			reportErrorf("%v", err)
+		}
+	},
+}
+
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Collect the accounts, assets and apps of a range of blocks into a replay plan",
+	Long: `Collect the accounts, assets and apps of a range of blocks into a replay plan. The assets and apps which exist
+before the range are given a LocalID, which the genesis of the network template creates them with. The transactions of
+the ones whose LocalID is cleared are skipped by the replay.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		checkRange()
+		plan, err := buildPlan(openBlockSource(), firstRound, lastRound)
+		if err != nil {
+			reportErrorf("%v", err)
+		}
+		err = plan.save(outPath)
+		if err != nil {
+			reportErrorf("%s: %v", outPath, err)
+		}
+		plan.writeSummary(os.Stdout)
+		if templatePath != "" {
+			err = codecs.SaveObjectToFile(templatePath, plan.networkTemplate(accountBalance), true)
+			if err != nil {
+				reportErrorf("%s: %v", templatePath, err)
+			}
+		}
+	},
+}
+
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Replay the transactions of the blocks of a plan into a private network",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if speed < 0 {
+			reportErrorf("--speed can't be negative")
+		}
+		plan, err := loadPlan(planPath)
+		if err != nil {
+			reportErrorf("%s: %v", planPath, err)
+		}
+		source := openBlockSource()
+
+		cacheDir, err := os.MkdirTemp("", "blockreplay")
+		if err != nil {
+			reportErrorf("cannot make temp dir: %v", err)
+		}
+		defer os.RemoveAll(cacheDir)
+		client, err := libgoal.MakeClient(dataDir, cacheDir, libgoal.AlgodClient)
+		if err != nil {
+			reportErrorf("%v", err)
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+		r := makeReplayer(source, &client, plan, speed, os.Stdout)
+		err = r.run(ctx, plan.FirstRound, plan.LastRound)
+		r.stats.write(os.Stdout)
+		if err != nil {
+			reportErrorf("%v", err)
+		}
+	},
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
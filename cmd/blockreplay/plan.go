@@ -0,0 +1,412 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/gen"
+	"github.com/algorand/go-algorand/netdeploy"
+	"github.com/algorand/go-algorand/netdeploy/remote"
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// creatableMapping maps an asset or an app of the replayed blocks to one of the local network
+type creatableMapping struct {
+	ID uint64
+	// LocalID of the asset or app in the local network, which the genesis of the network template
+	// creates. The transactions referencing an asset or an app without a LocalID are skipped.
+	LocalID uint64 `json:",omitempty"`
+	// CreatedInRange is set for the assets and apps which were created by the replayed blocks. They
+	// get no LocalID, and are mapped to the ones their replayed creation makes instead.
+	CreatedInRange bool `json:",omitempty"`
+	// Txns is the number of transactions which reference the asset or app
+	Txns uint64
+	// BoxRefs is the number of references to the boxes of the app
+	BoxRefs uint64 `json:",omitempty"`
+
+	// Holders of the asset and OptIns of the app are the accounts of the plan which use it without
+	// opting in first, which the genesis opts in.
+	Holders []basics.Address `json:",omitempty"`
+	OptIns  []basics.Address `json:",omitempty"`
+	// Boxes are the names of the boxes of the app referenced by the blocks, which the genesis creates
+	Boxes [][]byte `json:",omitempty"`
+}
+
+// replayPlan describes how a range of blocks is replayed into a local network
+type replayPlan struct {
+	FirstRound uint64
+	LastRound  uint64
+	// Accounts of the blocks, the i-th one being replayed by the deterministic account of index i
+	Accounts []basics.Address
+	// Assets and Apps of the blocks, the most referenced first
+	Assets []creatableMapping
+	Apps   []creatableMapping
+}
+
+func loadPlan(file string) (plan replayPlan, err error) {
+	err = codecs.LoadObjectFromFile(file, &plan)
+	return
+}
+
+func (plan replayPlan) save(file string) error {
+	return codecs.SaveObjectToFile(file, plan, true)
+}
+
+// planBuilder collects the accounts, assets and apps of the blocks
+type planBuilder struct {
+	plan     replayPlan
+	accounts map[basics.Address]bool
+	assets   map[basics.AssetIndex]*creatableMapping
+	apps     map[basics.AppIndex]*creatableMapping
+	// optedIn tells whether each of the accounts using an asset or app has to be opted in by the
+	// genesis (true) or is opted in by the blocks (false), as found by its first use.
+	optedIn map[*creatableMapping]map[basics.Address]bool
+	boxes   map[*creatableMapping]map[string]bool
+}
+
+// use records the use of an asset or an app by an account, which opts in unless it's already opted in.
+func (b *planBuilder) use(m *creatableMapping, addr basics.Address, optIn bool) {
+	if m.CreatedInRange || addr.IsZero() {
+		return
+	}
+	accounts := b.optedIn[m]
+	if accounts == nil {
+		accounts = make(map[basics.Address]bool)
+		b.optedIn[m] = accounts
+	}
+	if _, ok := accounts[addr]; !ok {
+		accounts[addr] = !optIn
+	}
+}
+
+func (b *planBuilder) box(m *creatableMapping, name []byte) {
+	if m.CreatedInRange || len(name) == 0 || b.boxes[m][string(name)] {
+		return
+	}
+	if b.boxes[m] == nil {
+		b.boxes[m] = make(map[string]bool)
+	}
+	b.boxes[m][string(name)] = true
+	m.Boxes = append(m.Boxes, name)
+}
+
+// genesisOptIns returns the accounts of the plan which the genesis opts into the asset or app, in the
+// order of the plan.
+func (b *planBuilder) genesisOptIns(m *creatableMapping) (optIns []basics.Address) {
+	for _, addr := range b.plan.Accounts {
+		if b.optedIn[m][addr] {
+			optIns = append(optIns, addr)
+		}
+	}
+	return
+}
+
+func (b *planBuilder) account(addr basics.Address) {
+	if addr.IsZero() || b.accounts[addr] {
+		return
+	}
+	b.accounts[addr] = true
+	b.plan.Accounts = append(b.plan.Accounts, addr)
+}
+
+func (b *planBuilder) asset(aidx basics.AssetIndex) *creatableMapping {
+	if aidx == 0 {
+		return nil
+	}
+	m := b.assets[aidx]
+	if m == nil {
+		m = &creatableMapping{ID: uint64(aidx)}
+		b.assets[aidx] = m
+	}
+	return m
+}
+
+func (b *planBuilder) app(aidx basics.AppIndex) *creatableMapping {
+	if aidx == 0 {
+		return nil
+	}
+	m := b.apps[aidx]
+	if m == nil {
+		m = &creatableMapping{ID: uint64(aidx)}
+		b.apps[aidx] = m
+	}
+	return m
+}
+
+func (b *planBuilder) addTxn(stxn transactions.SignedTxnWithAD) {
+	txn := stxn.Txn
+	for _, addr := range []basics.Address{
+		txn.Sender, stxn.AuthAddr, txn.RekeyTo,
+		txn.Receiver, txn.CloseRemainderTo,
+		txn.AssetSender, txn.AssetReceiver, txn.AssetCloseTo, txn.FreezeAccount,
+		txn.AssetParams.Manager, txn.AssetParams.Reserve, txn.AssetParams.Freeze, txn.AssetParams.Clawback,
+	} {
+		b.account(addr)
+	}
+	for _, addr := range txn.Accounts {
+		b.account(addr)
+	}
+
+	assets := make(map[*creatableMapping]bool)
+	for _, aidx := range append([]basics.AssetIndex{txn.ConfigAsset, txn.XferAsset, txn.FreezeAsset}, txn.ForeignAssets...) {
+		if m := b.asset(aidx); m != nil {
+			assets[m] = true
+		}
+	}
+	if created := b.asset(stxn.ApplyData.ConfigAsset); created != nil {
+		created.CreatedInRange = true
+		assets[created] = true
+	}
+	for m := range assets {
+		m.Txns++
+	}
+	if asset := b.asset(txn.XferAsset); asset != nil {
+		// sending 0 units to oneself opts in
+		optIn := txn.Sender == txn.AssetReceiver && txn.AssetAmount == 0 && txn.AssetSender.IsZero()
+		if txn.AssetSender.IsZero() {
+			b.use(asset, txn.Sender, optIn)
+		} else {
+			b.use(asset, txn.AssetSender, false)
+		}
+		b.use(asset, txn.AssetReceiver, optIn)
+		b.use(asset, txn.AssetCloseTo, false)
+	}
+	if asset := b.asset(txn.FreezeAsset); asset != nil {
+		b.use(asset, txn.FreezeAccount, false)
+	}
+
+	apps := make(map[*creatableMapping]bool)
+	called := b.app(txn.ApplicationID)
+	if created := b.app(stxn.ApplyData.ApplicationID); created != nil {
+		created.CreatedInRange = true
+		called = created
+	}
+	if called != nil {
+		apps[called] = true
+	}
+	for _, aidx := range txn.ForeignApps {
+		if m := b.app(aidx); m != nil {
+			apps[m] = true
+		}
+	}
+	for m := range apps {
+		m.Txns++
+	}
+	if called != nil {
+		// the calls of the apps of the template approve everything, so only closing out needs an opt in
+		switch txn.OnCompletion {
+		case transactions.OptInOC:
+			b.use(called, txn.Sender, true)
+		case transactions.CloseOutOC, transactions.ClearStateOC:
+			b.use(called, txn.Sender, false)
+		}
+	}
+	for _, box := range txn.Boxes {
+		owner := called
+		if box.Index > 0 && int(box.Index) <= len(txn.ForeignApps) {
+			owner = b.app(txn.ForeignApps[box.Index-1])
+		}
+		if owner != nil {
+			owner.BoxRefs++
+			b.box(owner, box.Name)
+		}
+	}
+}
+
+// sortMappings returns the mappings, the most referenced first
+func sortMappings(mappings []*creatableMapping) []creatableMapping {
+	sorted := make([]creatableMapping, 0, len(mappings))
+	for _, m := range mappings {
+		sorted = append(sorted, *m)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Txns != sorted[j].Txns {
+			return sorted[i].Txns > sorted[j].Txns
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// buildPlan collects the accounts, assets and apps of the blocks of the range
+func buildPlan(source blockSource, first, last uint64) (replayPlan, error) {
+	b := planBuilder{
+		plan:     replayPlan{FirstRound: first, LastRound: last},
+		accounts: make(map[basics.Address]bool),
+		assets:   make(map[basics.AssetIndex]*creatableMapping),
+		apps:     make(map[basics.AppIndex]*creatableMapping),
+		optedIn:  make(map[*creatableMapping]map[basics.Address]bool),
+		boxes:    make(map[*creatableMapping]map[string]bool),
+	}
+	for round := first; round <= last; round++ {
+		block, err := source.block(round)
+		if err != nil {
+			return replayPlan{}, err
+		}
+		payset, err := block.DecodePaysetFlat()
+		if err != nil {
+			return replayPlan{}, fmt.Errorf("unable to decode the transactions of block %d: %w", round, err)
+		}
+		for _, stxn := range payset {
+			b.addTxn(stxn)
+		}
+	}
+
+	// the accounts of the apps are replaced by the ones of the local apps rather than by
+	// deterministic accounts.
+	appAccounts := make(map[basics.Address]bool, len(b.apps))
+	for aidx := range b.apps {
+		appAccounts[aidx.Address()] = true
+	}
+	accounts := b.plan.Accounts[:0]
+	for _, addr := range b.plan.Accounts {
+		if !appAccounts[addr] {
+			accounts = append(accounts, addr)
+		}
+	}
+	b.plan.Accounts = accounts
+	assets := make([]*creatableMapping, 0, len(b.assets))
+	for _, m := range b.assets {
+		m.Holders = b.genesisOptIns(m)
+		assets = append(assets, m)
+	}
+	apps := make([]*creatableMapping, 0, len(b.apps))
+	for _, m := range b.apps {
+		m.OptIns = b.genesisOptIns(m)
+		apps = append(apps, m)
+	}
+	b.plan.Assets = sortMappings(assets)
+	b.plan.Apps = sortMappings(apps)
+
+	// the assets and apps which exist before the range are created by the genesis of the network
+	// template, with the indexes following each other.
+	localID := uint64(1)
+	for _, mappings := range [][]creatableMapping{b.plan.Assets, b.plan.Apps} {
+		for i := range mappings {
+			if !mappings[i].CreatedInRange {
+				mappings[i].LocalID = localID
+				localID++
+			}
+		}
+	}
+	return b.plan, nil
+}
+
+// templateCreator is the genesis wallet creating the assets and apps of the network template
+const templateCreator = "Wallet1"
+
+// templateApprovalProgram approves all the calls of the apps of the network template, whose programs
+// can't be found in the blocks.
+const templateApprovalProgram = "#pragma version 8\nint 1"
+
+// templateBoxSize is the size of the boxes of the network template, whose content can't be found in the blocks
+const templateBoxSize = 1024
+
+// networkTemplate returns the template of a network for replaying the plan, with a single node
+// and one deterministic account for each of the accounts of the plan. Its genesis creates the
+// assets and apps of the plan which have a LocalID, along with the holdings, opt ins and boxes
+// their replayed transactions need.
+func (plan replayPlan) networkTemplate(balance uint64) netdeploy.NetworkTemplate {
+	genesis := gen.DefaultGenesis
+	genesis.NetworkName = "replay"
+	genesis.Wallets = []gen.WalletData{{Name: templateCreator, Stake: 100, Online: true}}
+	genesis.DeterministicAccounts = uint64(len(plan.Accounts))
+	genesis.DeterministicAccountBalance = balance
+
+	names := make(map[basics.Address]string, len(plan.Accounts))
+	for i, addr := range plan.Accounts {
+		names[addr] = fmt.Sprintf("Deterministic%d", i)
+	}
+	for _, asset := range plan.Assets {
+		if asset.LocalID == 0 || asset.CreatedInRange {
+			continue
+		}
+		data := gen.AssetData{
+			ID:        asset.LocalID,
+			Creator:   templateCreator,
+			Total:     math.MaxUint64,
+			AssetName: fmt.Sprintf("replay of %d", asset.ID),
+		}
+		// the creator keeps as much of the supply as each of the holders
+		amount := data.Total / uint64(len(asset.Holders)+1)
+		for _, holder := range asset.Holders {
+			if name, ok := names[holder]; ok {
+				data.Holdings = append(data.Holdings, gen.AssetHoldingData{Account: name, Amount: amount})
+			}
+		}
+		genesis.Assets = append(genesis.Assets, data)
+	}
+	for _, app := range plan.Apps {
+		if app.LocalID == 0 || app.CreatedInRange {
+			continue
+		}
+		data := gen.AppData{
+			ID:                app.LocalID,
+			Creator:           templateCreator,
+			ApprovalProgram:   templateApprovalProgram,
+			ClearStateProgram: templateApprovalProgram,
+		}
+		for _, addr := range app.OptIns {
+			if name, ok := names[addr]; ok {
+				data.OptIns = append(data.OptIns, gen.AppOptInData{Account: name})
+			}
+		}
+		for _, name := range app.Boxes {
+			// the names of the genesis boxes are strings of the JSON template
+			if utf8.Valid(name) {
+				data.Boxes = append(data.Boxes, gen.BoxData{Name: string(name), Size: templateBoxSize})
+			}
+		}
+		genesis.Apps = append(genesis.Apps, data)
+	}
+
+	return netdeploy.NetworkTemplate{
+		Genesis: genesis,
+		Nodes: []remote.NodeConfigGoal{{
+			Name:    "Primary",
+			IsRelay: true,
+			Wallets: []remote.NodeWalletData{{Name: templateCreator}},
+		}},
+	}
+}
+
+// maxSummaryCreatables bounds the number of assets and apps listed by the summary of a plan
+const maxSummaryCreatables = 10
+
+// writeSummary describes the plan, listing its most referenced assets and apps
+func (plan replayPlan) writeSummary(w io.Writer) {
+	fmt.Fprintf(w, "rounds %d to %d: %d accounts, %d assets, %d apps\n", plan.FirstRound, plan.LastRound, len(plan.Accounts), len(plan.Assets), len(plan.Apps))
+	for i, app := range plan.Apps {
+		if i == maxSummaryCreatables {
+			break
+		}
+		fmt.Fprintf(w, "app %d: %d txns, %d box refs\n", app.ID, app.Txns, app.BoxRefs)
+	}
+	for i, asset := range plan.Assets {
+		if i == maxSummaryCreatables {
+			break
+		}
+		fmt.Fprintf(w, "asset %d: %d txns\n", asset.ID, asset.Txns)
+	}
+}
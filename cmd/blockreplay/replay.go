@@ -0,0 +1,272 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/shared/pingpong"
+)
+
+// submitter sends the replayed transactions to the local network. It is implemented by libgoal.Client.
+type submitter interface {
+	SuggestedParams() (model.TransactionParametersResponse, error)
+	BroadcastTransaction(stx transactions.SignedTxn) (string, error)
+	BroadcastTransactionGroup(txgroup []transactions.SignedTxn) error
+	PendingTransactionInformation(txid string) (model.PendingTransactionResponse, error)
+	WaitForRound(round uint64) (model.NodeStatusResponse, error)
+}
+
+// replayValidity is the number of rounds for which the replayed transactions are valid
+const replayValidity = 100
+
+// logPeriod is the period of the progress reports of a replay
+const logPeriod = 5 * time.Second
+
+// replayStats counts the outcomes of the replayed transactions
+type replayStats struct {
+	sent       uint64
+	rejected   uint64
+	skipped    uint64
+	rejections map[string]uint64
+	skips      map[string]uint64
+}
+
+func writeReasons(w io.Writer, verb string, reasons map[string]uint64) {
+	sorted := make([]string, 0, len(reasons))
+	for reason := range reasons {
+		sorted = append(sorted, reason)
+	}
+	sort.Strings(sorted)
+	for _, reason := range sorted {
+		fmt.Fprintf(w, "  %s %d: %s\n", verb, reasons[reason], reason)
+	}
+}
+
+func (s *replayStats) write(w io.Writer) {
+	fmt.Fprintf(w, "%d transactions sent, %d rejected, %d skipped\n", s.sent, s.rejected, s.skipped)
+	writeReasons(w, "rejected", s.rejections)
+	writeReasons(w, "skipped", s.skips)
+}
+
+// replayer submits the rewritten transactions of the blocks to the local network
+type replayer struct {
+	source   blockSource
+	client   submitter
+	rewriter *rewriter
+	// speed of the replay relative to the one of the blocks, 0 meaning as fast as possible
+	speed float64
+	stats replayStats
+	out   io.Writer
+}
+
+func makeReplayer(source blockSource, client submitter, plan replayPlan, speed float64, out io.Writer) *replayer {
+	return &replayer{
+		source:   source,
+		client:   client,
+		rewriter: makeRewriter(plan),
+		speed:    speed,
+		stats: replayStats{
+			rejections: make(map[string]uint64),
+			skips:      make(map[string]uint64),
+		},
+		out: out,
+	}
+}
+
+func (r *replayer) params() (txnParams, error) {
+	p, err := r.client.SuggestedParams()
+	if err != nil {
+		return txnParams{}, err
+	}
+	params := txnParams{
+		firstValid: basics.Round(p.LastRound),
+		lastValid:  basics.Round(p.LastRound) + replayValidity,
+		genesisID:  p.GenesisId,
+	}
+	copy(params.genesisHash[:], p.GenesisHash)
+	return params, nil
+}
+
+// scaled returns the duration of the replay of the given number of seconds of blocks
+func (r *replayer) scaled(seconds int64) time.Duration {
+	return time.Duration(float64(seconds) * float64(time.Second) / r.speed)
+}
+
+// paysetGroups splits the transactions of a block into their groups
+func paysetGroups(payset []transactions.SignedTxnWithAD) (groups [][]transactions.SignedTxnWithAD) {
+	for i := 0; i < len(payset); {
+		j := i + 1
+		if !payset[i].Txn.Group.IsZero() {
+			for j < len(payset) && payset[j].Txn.Group == payset[i].Txn.Group {
+				j++
+			}
+		}
+		groups = append(groups, payset[i:j])
+		i = j
+	}
+	return
+}
+
+func (r *replayer) replayGroup(group []transactions.SignedTxnWithAD, params txnParams) {
+	count := uint64(len(group))
+	stxns, reason := r.rewriter.rewriteGroup(group, params)
+	if reason != "" {
+		r.stats.skipped += count
+		r.stats.skips[reason] += count
+		return
+	}
+	var err error
+	if len(stxns) == 1 {
+		_, err = r.client.BroadcastTransaction(stxns[0])
+	} else {
+		err = r.client.BroadcastTransactionGroup(stxns)
+	}
+	if err != nil {
+		r.stats.rejected += count
+		r.stats.rejections[pingpong.RejectionReason(err)] += count
+		return
+	}
+	r.stats.sent += count
+	r.rewriter.rekeyed(group)
+	r.mapCreations(group, stxns, params)
+}
+
+// mapCreations waits for the replayed transactions of a group which create assets or apps to be
+// confirmed, and maps the assets and apps of the blocks to the ones they created, so that the
+// following transactions using them can be replayed.
+func (r *replayer) mapCreations(group []transactions.SignedTxnWithAD, stxns []transactions.SignedTxn, params txnParams) {
+	for i, stxn := range group {
+		createsAsset := stxn.Txn.Type == protocol.AssetConfigTx && stxn.Txn.ConfigAsset == 0 && stxn.ApplyData.ConfigAsset != 0
+		createsApp := stxn.Txn.Type == protocol.ApplicationCallTx && stxn.Txn.ApplicationID == 0 && stxn.ApplyData.ApplicationID != 0
+		if !createsAsset && !createsApp {
+			continue
+		}
+		txn, err := r.confirmed(stxns[i].ID().String(), params)
+		switch {
+		case err != nil:
+			fmt.Fprintf(r.out, "the transactions using the asset or app created by %s are skipped: %v\n", stxn.ID(), err)
+		case createsAsset && txn.AssetIndex != nil:
+			r.rewriter.createdAsset(stxn.ApplyData.ConfigAsset, basics.AssetIndex(*txn.AssetIndex))
+		case createsApp && txn.ApplicationIndex != nil:
+			r.rewriter.createdApp(stxn.ApplyData.ApplicationID, basics.AppIndex(*txn.ApplicationIndex))
+		}
+	}
+}
+
+// confirmed waits for a replayed transaction to be confirmed by the local network, until its last valid round
+func (r *replayer) confirmed(txid string, params txnParams) (model.PendingTransactionResponse, error) {
+	round := uint64(params.firstValid)
+	for {
+		txn, err := r.client.PendingTransactionInformation(txid)
+		if err != nil {
+			return model.PendingTransactionResponse{}, err
+		}
+		if txn.ConfirmedRound != nil && *txn.ConfirmedRound > 0 {
+			return txn, nil
+		}
+		if txn.PoolError != "" {
+			return model.PendingTransactionResponse{}, fmt.Errorf("transaction %s was rejected: %s", txid, txn.PoolError)
+		}
+		if round >= uint64(params.lastValid) {
+			return model.PendingTransactionResponse{}, fmt.Errorf("transaction %s expired", txid)
+		}
+		status, err := r.client.WaitForRound(round)
+		if err != nil {
+			return model.PendingTransactionResponse{}, err
+		}
+		round = status.LastRound
+	}
+}
+
+func sleepUntil(ctx context.Context, t time.Time) error {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// run replays the blocks of the range. The transactions of each block are spread evenly until
+// the time of the next block, scaled by the speed of the replay.
+func (r *replayer) run(ctx context.Context, first, last uint64) error {
+	block, err := r.source.block(first)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	firstTimestamp := block.TimeStamp
+	lastLog := start
+	for round := first; round <= last; round++ {
+		var next bookkeeping.Block
+		if round < last {
+			next, err = r.source.block(round + 1)
+			if err != nil {
+				return err
+			}
+		}
+		params, err := r.params()
+		if err != nil {
+			return fmt.Errorf("unable to get the parameters of the local network: %w", err)
+		}
+		payset, err := block.DecodePaysetFlat()
+		if err != nil {
+			return fmt.Errorf("unable to decode the transactions of block %d: %w", round, err)
+		}
+		groups := paysetGroups(payset)
+
+		var blockStart time.Time
+		var interval time.Duration
+		if r.speed > 0 {
+			blockStart = start.Add(r.scaled(block.TimeStamp - firstTimestamp))
+			if round < last && len(groups) > 0 {
+				interval = r.scaled(next.TimeStamp-block.TimeStamp) / time.Duration(len(groups))
+			}
+		}
+		for i, group := range groups {
+			if r.speed > 0 {
+				err = sleepUntil(ctx, blockStart.Add(time.Duration(i)*interval))
+			} else {
+				err = ctx.Err()
+			}
+			if err != nil {
+				return err
+			}
+			r.replayGroup(group, params)
+		}
+
+		if time.Since(lastLog) >= logPeriod {
+			fmt.Fprintf(r.out, "round %d: ", round)
+			r.stats.write(r.out)
+			lastLog = time.Now()
+		}
+		block = next
+	}
+	return nil
+}
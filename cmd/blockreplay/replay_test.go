@@ -0,0 +1,322 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/gen"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type testBlockSource map[uint64]bookkeeping.Block
+
+func (s testBlockSource) block(round uint64) (bookkeeping.Block, error) {
+	block, ok := s[round]
+	if !ok {
+		return bookkeeping.Block{}, fmt.Errorf("no block %d", round)
+	}
+	return block, nil
+}
+
+type testSubmitter struct {
+	groups [][]transactions.SignedTxn
+	reject bool
+	// txns are the accepted transactions, by id, which are confirmed with the following creatable indexes
+	txns      map[string]transactions.SignedTxn
+	nextIndex uint64
+}
+
+func (s *testSubmitter) SuggestedParams() (model.TransactionParametersResponse, error) {
+	return model.TransactionParametersResponse{LastRound: 7, GenesisId: "local", GenesisHash: []byte{2}}, nil
+}
+
+func (s *testSubmitter) BroadcastTransaction(stx transactions.SignedTxn) (string, error) {
+	return "", s.BroadcastTransactionGroup([]transactions.SignedTxn{stx})
+}
+
+func (s *testSubmitter) BroadcastTransactionGroup(txgroup []transactions.SignedTxn) error {
+	if s.reject {
+		return errors.New("HTTP 400 Bad Request: TransactionPool.Remember: transaction ABC: overspend (account XYZ)")
+	}
+	s.groups = append(s.groups, txgroup)
+	if s.txns == nil {
+		s.txns = make(map[string]transactions.SignedTxn)
+	}
+	for _, stxn := range txgroup {
+		s.txns[stxn.ID().String()] = stxn
+	}
+	return nil
+}
+
+func (s *testSubmitter) PendingTransactionInformation(txid string) (model.PendingTransactionResponse, error) {
+	stxn, ok := s.txns[txid]
+	if !ok {
+		return model.PendingTransactionResponse{}, fmt.Errorf("unknown transaction %s", txid)
+	}
+	confirmed := uint64(8)
+	index := s.nextIndex
+	s.nextIndex++
+	txn := model.PendingTransactionResponse{ConfirmedRound: &confirmed}
+	switch stxn.Txn.Type {
+	case protocol.AssetConfigTx:
+		txn.AssetIndex = &index
+	case protocol.ApplicationCallTx:
+		txn.ApplicationIndex = &index
+	}
+	return txn, nil
+}
+
+func (s *testSubmitter) WaitForRound(round uint64) (model.NodeStatusResponse, error) {
+	return model.NodeStatusResponse{LastRound: round + 1}, nil
+}
+
+func makeTestBlock(t *testing.T, round basics.Round, timestamp int64, groups ...[]transactions.SignedTxn) bookkeeping.Block {
+	var block bookkeeping.Block
+	block.BlockHeader.Round = round
+	block.BlockHeader.TimeStamp = timestamp
+	block.BlockHeader.GenesisID = "mainnet"
+	block.BlockHeader.GenesisHash = crypto.Digest{1}
+	block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	for _, group := range groups {
+		if len(group) > 1 {
+			var txGroup transactions.TxGroup
+			for _, stxn := range group {
+				txGroup.TxGroupHashes = append(txGroup.TxGroupHashes, crypto.Digest(stxn.Txn.ID()))
+			}
+			gid := crypto.HashObj(txGroup)
+			for i := range group {
+				group[i].Txn.Group = gid
+			}
+		}
+		for _, stxn := range group {
+			stib, err := block.EncodeSignedTxn(stxn, transactions.ApplyData{})
+			require.NoError(t, err)
+			block.Payset = append(block.Payset, stib)
+		}
+	}
+	return block
+}
+
+func makeTestTxn(typ protocol.TxType, sender basics.Address) transactions.SignedTxn {
+	var stxn transactions.SignedTxn
+	stxn.Txn.Type = typ
+	stxn.Txn.Sender = sender
+	stxn.Txn.Fee = basics.MicroAlgos{Raw: 1000}
+	stxn.Txn.FirstValid = 1
+	stxn.Txn.LastValid = 1000
+	stxn.Txn.GenesisID = "mainnet"
+	stxn.Txn.GenesisHash = crypto.Digest{1}
+	return stxn
+}
+
+func TestReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var a, b, c basics.Address
+	crypto.RandBytes(a[:])
+	crypto.RandBytes(b[:])
+	crypto.RandBytes(c[:])
+
+	pay := makeTestTxn(protocol.PaymentTx, a)
+	pay.Txn.Receiver = b
+	axfer := makeTestTxn(protocol.AssetTransferTx, a)
+	axfer.Txn.XferAsset = 20
+	axfer.Txn.AssetReceiver = c
+	appl := makeTestTxn(protocol.ApplicationCallTx, b)
+	appl.Txn.ApplicationID = 10
+	appl.Txn.Accounts = []basics.Address{c}
+	appl.Txn.ForeignApps = []basics.AppIndex{11}
+	appl.Txn.Boxes = []transactions.BoxRef{{Index: 0, Name: []byte("a")}, {Index: 1, Name: []byte("b")}}
+	appl.Txn.OnCompletion = transactions.CloseOutOC
+	fund := makeTestTxn(protocol.PaymentTx, b)
+	fund.Txn.Receiver = basics.AppIndex(10).Address()
+	keyreg := makeTestTxn(protocol.KeyRegistrationTx, a)
+	rekey := makeTestTxn(protocol.PaymentTx, c)
+	rekey.Txn.Receiver = c
+	rekey.Txn.RekeyTo = a
+	rekeyed := makeTestTxn(protocol.PaymentTx, c)
+	rekeyed.Txn.Receiver = b
+	rekeyed.AuthAddr = a
+
+	source := testBlockSource{
+		1: makeTestBlock(t, 1, 100, []transactions.SignedTxn{pay}, []transactions.SignedTxn{axfer}, []transactions.SignedTxn{appl, fund}),
+		2: makeTestBlock(t, 2, 104, []transactions.SignedTxn{keyreg}, []transactions.SignedTxn{rekey}, []transactions.SignedTxn{rekeyed}),
+	}
+
+	plan, err := buildPlan(source, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []basics.Address{a, b, c}, plan.Accounts)
+	// the assets and apps which exist before the range get local ids, and the accounts using them are opted in
+	require.Equal(t, []creatableMapping{{ID: 20, LocalID: 1, Txns: 1, Holders: []basics.Address{a, c}}}, plan.Assets)
+	require.Equal(t, []creatableMapping{
+		{ID: 10, LocalID: 2, Txns: 1, BoxRefs: 1, OptIns: []basics.Address{b}, Boxes: [][]byte{[]byte("a")}},
+		{ID: 11, LocalID: 3, Txns: 1, BoxRefs: 1, Boxes: [][]byte{[]byte("b")}},
+	}, plan.Apps)
+	template := plan.networkTemplate(5e12)
+	require.Equal(t, uint64(3), template.Genesis.DeterministicAccounts)
+	require.NoError(t, template.Validate())
+	require.Len(t, template.Genesis.Assets, 1)
+	require.Equal(t, uint64(1), template.Genesis.Assets[0].ID)
+	require.Equal(t, []string{"Deterministic0", "Deterministic2"}, []string{template.Genesis.Assets[0].Holdings[0].Account, template.Genesis.Assets[0].Holdings[1].Account})
+	require.Len(t, template.Genesis.Apps, 2)
+	require.Equal(t, uint64(2), template.Genesis.Apps[0].ID)
+	require.Equal(t, []gen.AppOptInData{{Account: "Deterministic1"}}, template.Genesis.Apps[0].OptIns)
+	require.Equal(t, []gen.BoxData{{Name: "a", Size: templateBoxSize}}, template.Genesis.Apps[0].Boxes)
+
+	// the unmapped app 11 makes the replay skip the group calling app 10
+	plan.Assets[0].LocalID = 1001
+	plan.Apps[0].LocalID = 2001
+	plan.Apps[1].LocalID = 0
+	client := &testSubmitter{}
+	r := makeReplayer(source, client, plan, 0, io.Discard)
+	require.NoError(t, r.run(context.Background(), 1, 2))
+	require.Equal(t, uint64(4), r.stats.sent)
+	require.Equal(t, uint64(3), r.stats.skipped)
+	require.Equal(t, map[string]uint64{skipUnmappedApp: 2, skipKeyreg: 1}, r.stats.skips)
+
+	localA, localB, localC := gen.DeterministicAccount(0), gen.DeterministicAccount(1), gen.DeterministicAccount(2)
+	require.Len(t, client.groups, 4)
+	for _, group := range client.groups {
+		require.Len(t, group, 1)
+		require.Equal(t, basics.Round(7), group[0].Txn.FirstValid)
+		require.Equal(t, basics.Round(7+replayValidity), group[0].Txn.LastValid)
+		require.Equal(t, "local", group[0].Txn.GenesisID)
+		require.Equal(t, crypto.Digest{2}, group[0].Txn.GenesisHash)
+	}
+	require.Equal(t, localA, client.groups[0][0].Txn.Sender)
+	require.Equal(t, localB, client.groups[0][0].Txn.Receiver)
+	require.Equal(t, basics.AssetIndex(1001), client.groups[1][0].Txn.XferAsset)
+	require.Equal(t, localC, client.groups[1][0].Txn.AssetReceiver)
+	require.Equal(t, localA, client.groups[2][0].Txn.RekeyTo)
+	require.True(t, client.groups[2][0].AuthAddr.IsZero())
+	// the transaction which follows the rekeying is signed by the new authorized key
+	require.Equal(t, localC, client.groups[3][0].Txn.Sender)
+	require.Equal(t, localA, client.groups[3][0].AuthAddr)
+	require.True(t, crypto.SignatureVerifier(localA).Verify(client.groups[3][0].Txn, client.groups[3][0].Sig))
+
+	// once app 11 is mapped, the group is rewritten with a new group id
+	plan.Apps[1].LocalID = 2002
+	client = &testSubmitter{}
+	r = makeReplayer(source, client, plan, 0, io.Discard)
+	require.NoError(t, r.run(context.Background(), 1, 1))
+	require.Len(t, client.groups, 3)
+	group := client.groups[2]
+	require.Len(t, group, 2)
+	require.Equal(t, basics.AppIndex(2001), group[0].Txn.ApplicationID)
+	require.Equal(t, []basics.AppIndex{2002}, group[0].Txn.ForeignApps)
+	require.Equal(t, []basics.Address{localC}, group[0].Txn.Accounts)
+	require.Equal(t, appl.Txn.Boxes, group[0].Txn.Boxes)
+	require.Equal(t, basics.AppIndex(2001).Address(), group[1].Txn.Receiver)
+	require.False(t, group[0].Txn.Group.IsZero())
+	require.Equal(t, group[0].Txn.Group, group[1].Txn.Group)
+	require.NotEqual(t, source[1].Payset[2].SignedTxn.Txn.Group, group[0].Txn.Group)
+
+	client = &testSubmitter{reject: true}
+	r = makeReplayer(source, client, plan, 0, io.Discard)
+	require.NoError(t, r.run(context.Background(), 1, 1))
+	require.Equal(t, map[string]uint64{"overspend": 4}, r.stats.rejections)
+}
+
+func TestReplayCreations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var a, b basics.Address
+	crypto.RandBytes(a[:])
+	crypto.RandBytes(b[:])
+
+	acfg := makeTestTxn(protocol.AssetConfigTx, a)
+	acfg.Txn.AssetParams.Total = 100
+	create := makeTestTxn(protocol.ApplicationCallTx, a)
+	optIn := makeTestTxn(protocol.AssetTransferTx, b)
+	optIn.Txn.XferAsset = 30
+	optIn.Txn.AssetReceiver = b
+	axfer := makeTestTxn(protocol.AssetTransferTx, a)
+	axfer.Txn.XferAsset = 30
+	axfer.Txn.AssetReceiver = b
+	axfer.Txn.AssetAmount = 10
+	call := makeTestTxn(protocol.ApplicationCallTx, b)
+	call.Txn.ApplicationID = 31
+	call.Txn.OnCompletion = transactions.OptInOC
+	call.Txn.Boxes = []transactions.BoxRef{{Name: []byte("box")}}
+	fund := makeTestTxn(protocol.PaymentTx, b)
+	fund.Txn.Receiver = basics.AppIndex(31).Address()
+
+	block1 := makeTestBlock(t, 1, 100, []transactions.SignedTxn{acfg}, []transactions.SignedTxn{create})
+	// the blocks tell the indexes of the created asset and app
+	var err error
+	block1.Payset[0], err = block1.EncodeSignedTxn(acfg, transactions.ApplyData{ConfigAsset: 30})
+	require.NoError(t, err)
+	block1.Payset[1], err = block1.EncodeSignedTxn(create, transactions.ApplyData{ApplicationID: 31})
+	require.NoError(t, err)
+	source := testBlockSource{
+		1: block1,
+		2: makeTestBlock(t, 2, 104, []transactions.SignedTxn{optIn}, []transactions.SignedTxn{axfer}, []transactions.SignedTxn{call}, []transactions.SignedTxn{fund}),
+	}
+
+	// the assets and apps created by the blocks are left to their replay rather than to the genesis
+	plan, err := buildPlan(source, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []basics.Address{a, b}, plan.Accounts)
+	require.Equal(t, []creatableMapping{{ID: 30, CreatedInRange: true, Txns: 3}}, plan.Assets)
+	require.Equal(t, []creatableMapping{{ID: 31, CreatedInRange: true, Txns: 2, BoxRefs: 1}}, plan.Apps)
+	template := plan.networkTemplate(5e12)
+	require.NoError(t, template.Validate())
+	require.Empty(t, template.Genesis.Assets)
+	require.Empty(t, template.Genesis.Apps)
+
+	// the transactions using them are replayed with the indexes of the confirmed creations
+	client := &testSubmitter{nextIndex: 5001}
+	r := makeReplayer(source, client, plan, 0, io.Discard)
+	require.NoError(t, r.run(context.Background(), 1, 2))
+	require.Equal(t, uint64(6), r.stats.sent)
+	require.Zero(t, r.stats.skipped)
+	require.Len(t, client.groups, 6)
+	require.Equal(t, basics.AssetIndex(5001), client.groups[2][0].Txn.XferAsset)
+	require.Equal(t, basics.AssetIndex(5001), client.groups[3][0].Txn.XferAsset)
+	require.Equal(t, basics.AppIndex(5002), client.groups[4][0].Txn.ApplicationID)
+	require.Equal(t, basics.AppIndex(5002).Address(), client.groups[5][0].Txn.Receiver)
+}
+
+func TestPaysetGroups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var payset []transactions.SignedTxnWithAD
+	for _, gid := range []crypto.Digest{{}, {1}, {1}, {}, {}, {2}} {
+		var stxn transactions.SignedTxnWithAD
+		stxn.Txn.Group = gid
+		payset = append(payset, stxn)
+	}
+	groups := paysetGroups(payset)
+	var sizes []int
+	for _, group := range groups {
+		sizes = append(sizes, len(group))
+	}
+	require.Equal(t, []int{1, 2, 1, 1, 1}, sizes)
+}
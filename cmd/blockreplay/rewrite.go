@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/gen"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The reasons for which transactions of the replayed blocks are skipped
+const (
+	skipKeyreg         = "key registration"
+	skipStateProof     = "state proof"
+	skipUnknownAccount = "unknown account"
+	skipUnmappedAsset  = "unmapped asset"
+	skipUnmappedApp    = "unmapped app"
+	skipRekeyToApp     = "rekey to an app account"
+)
+
+// txnParams are the parameters of the local network which the replayed transactions get
+type txnParams struct {
+	firstValid  basics.Round
+	lastValid   basics.Round
+	genesisID   string
+	genesisHash crypto.Digest
+}
+
+// rewriter maps the accounts, assets and apps of the replayed blocks to the ones of the local
+// network, and signs the rewritten transactions with the keys of the local accounts.
+type rewriter struct {
+	// accounts are the indexes of the deterministic keys of the accounts of the blocks
+	accounts map[basics.Address]uint64
+	assets   map[basics.AssetIndex]basics.AssetIndex
+	apps     map[basics.AppIndex]basics.AppIndex
+	// appAccounts maps the accounts of the apps of the blocks to the ones of the local apps
+	appAccounts map[basics.Address]basics.Address
+
+	// auth is the index of the key which is authorized to sign for each rekeyed local account
+	auth    map[uint64]uint64
+	secrets map[uint64]*crypto.SignatureSecrets
+}
+
+func makeRewriter(plan replayPlan) *rewriter {
+	rw := &rewriter{
+		accounts:    make(map[basics.Address]uint64, len(plan.Accounts)),
+		assets:      make(map[basics.AssetIndex]basics.AssetIndex),
+		apps:        make(map[basics.AppIndex]basics.AppIndex),
+		appAccounts: make(map[basics.Address]basics.Address),
+		auth:        make(map[uint64]uint64),
+		secrets:     make(map[uint64]*crypto.SignatureSecrets),
+	}
+	for i, addr := range plan.Accounts {
+		rw.accounts[addr] = uint64(i)
+	}
+	for _, asset := range plan.Assets {
+		if asset.LocalID != 0 {
+			rw.assets[basics.AssetIndex(asset.ID)] = basics.AssetIndex(asset.LocalID)
+		}
+	}
+	for _, app := range plan.Apps {
+		if app.LocalID != 0 {
+			rw.apps[basics.AppIndex(app.ID)] = basics.AppIndex(app.LocalID)
+			rw.appAccounts[basics.AppIndex(app.ID).Address()] = basics.AppIndex(app.LocalID).Address()
+		}
+	}
+	return rw
+}
+
+func (rw *rewriter) keys(index uint64) *crypto.SignatureSecrets {
+	secrets := rw.secrets[index]
+	if secrets == nil {
+		secrets = gen.DeterministicAccountSecrets(index)
+		rw.secrets[index] = secrets
+	}
+	return secrets
+}
+
+// address returns the local account of an account of the blocks
+func (rw *rewriter) address(addr *basics.Address) bool {
+	if addr.IsZero() {
+		return true
+	}
+	if local, ok := rw.appAccounts[*addr]; ok {
+		*addr = local
+		return true
+	}
+	index, ok := rw.accounts[*addr]
+	if !ok {
+		return false
+	}
+	*addr = basics.Address(rw.keys(index).SignatureVerifier)
+	return true
+}
+
+func (rw *rewriter) asset(aidx *basics.AssetIndex) bool {
+	if *aidx == 0 {
+		return true
+	}
+	local, ok := rw.assets[*aidx]
+	*aidx = local
+	return ok
+}
+
+func (rw *rewriter) app(aidx *basics.AppIndex) bool {
+	if *aidx == 0 {
+		return true
+	}
+	local, ok := rw.apps[*aidx]
+	*aidx = local
+	return ok
+}
+
+// rewriteTxn maps the accounts, assets and apps of a transaction of the blocks, and returns the
+// reason for skipping it when it can't be replayed.
+func (rw *rewriter) rewriteTxn(txn *transactions.Transaction) string {
+	switch txn.Type {
+	case protocol.KeyRegistrationTx:
+		return skipKeyreg
+	case protocol.StateProofTx:
+		return skipStateProof
+	}
+	// the accounts of apps can't sign their transactions.
+	if _, ok := rw.accounts[txn.Sender]; !ok {
+		return skipUnknownAccount
+	}
+	if _, ok := rw.appAccounts[txn.RekeyTo]; ok {
+		return skipRekeyToApp
+	}
+
+	// the slices of the transaction are shared with the block it comes from.
+	txn.Accounts = append([]basics.Address(nil), txn.Accounts...)
+	txn.ForeignAssets = append([]basics.AssetIndex(nil), txn.ForeignAssets...)
+	txn.ForeignApps = append([]basics.AppIndex(nil), txn.ForeignApps...)
+
+	addrs := []*basics.Address{
+		&txn.Sender, &txn.RekeyTo,
+		&txn.Receiver, &txn.CloseRemainderTo,
+		&txn.AssetSender, &txn.AssetReceiver, &txn.AssetCloseTo, &txn.FreezeAccount,
+		&txn.AssetParams.Manager, &txn.AssetParams.Reserve, &txn.AssetParams.Freeze, &txn.AssetParams.Clawback,
+	}
+	for i := range txn.Accounts {
+		addrs = append(addrs, &txn.Accounts[i])
+	}
+	for _, addr := range addrs {
+		if !rw.address(addr) {
+			return skipUnknownAccount
+		}
+	}
+
+	assets := []*basics.AssetIndex{&txn.ConfigAsset, &txn.XferAsset, &txn.FreezeAsset}
+	for i := range txn.ForeignAssets {
+		assets = append(assets, &txn.ForeignAssets[i])
+	}
+	for _, aidx := range assets {
+		if !rw.asset(aidx) {
+			return skipUnmappedAsset
+		}
+	}
+
+	if !rw.app(&txn.ApplicationID) {
+		return skipUnmappedApp
+	}
+	for i := range txn.ForeignApps {
+		if !rw.app(&txn.ForeignApps[i]) {
+			return skipUnmappedApp
+		}
+	}
+	return ""
+}
+
+// rewriteGroup rewrites a transaction group of the blocks into a group of the local network,
+// signed by the local accounts. It returns the reason for skipping the group when one of its
+// transactions can't be replayed.
+func (rw *rewriter) rewriteGroup(group []transactions.SignedTxnWithAD, params txnParams) ([]transactions.SignedTxn, string) {
+	txns := make([]transactions.Transaction, len(group))
+	for i := range group {
+		txn := group[i].Txn
+		if reason := rw.rewriteTxn(&txn); reason != "" {
+			return nil, reason
+		}
+		txn.FirstValid = params.firstValid
+		txn.LastValid = params.lastValid
+		txn.GenesisID = params.genesisID
+		txn.GenesisHash = params.genesisHash
+		txn.Group = crypto.Digest{}
+		txns[i] = txn
+	}
+	if len(txns) > 1 {
+		var txGroup transactions.TxGroup
+		for _, txn := range txns {
+			txGroup.TxGroupHashes = append(txGroup.TxGroupHashes, crypto.Digest(txn.ID()))
+		}
+		gid := crypto.HashObj(txGroup)
+		for i := range txns {
+			txns[i].Group = gid
+		}
+	}
+
+	stxns := make([]transactions.SignedTxn, len(txns))
+	for i, txn := range txns {
+		sender := rw.accounts[group[i].Txn.Sender]
+		signer, ok := rw.auth[sender]
+		if !ok {
+			signer = sender
+		}
+		stxns[i] = txn.Sign(rw.keys(signer))
+	}
+	return stxns, ""
+}
+
+// createdAsset maps an asset created by the replayed blocks to the one its replay created
+func (rw *rewriter) createdAsset(aidx, local basics.AssetIndex) {
+	rw.assets[aidx] = local
+}
+
+// createdApp maps an app created by the replayed blocks to the one its replay created
+func (rw *rewriter) createdApp(aidx, local basics.AppIndex) {
+	rw.apps[aidx] = local
+	rw.appAccounts[aidx.Address()] = local.Address()
+}
+
+// rekeyed records the rekeyings of a group which was accepted by the local network, so that the
+// following transactions of the rekeyed accounts are signed by their new authorized keys.
+func (rw *rewriter) rekeyed(group []transactions.SignedTxnWithAD) {
+	for _, stxn := range group {
+		if stxn.Txn.RekeyTo.IsZero() {
+			continue
+		}
+		sender := rw.accounts[stxn.Txn.Sender]
+		auth := rw.accounts[stxn.Txn.RekeyTo]
+		if auth == sender {
+			delete(rw.auth, sender)
+		} else {
+			rw.auth[sender] = auth
+		}
+	}
+}
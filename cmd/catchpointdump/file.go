@@ -265,6 +265,7 @@ func printAccountsDatabase(databaseName string, stagingTables bool, fileHeader l
 			"Balances Round: %d",
 			"Block Round: %d",
 			"Block Header Digest: %s",
+			"Balances Merkle Root: %s",
 			"Catchpoint: %s",
 			"Total Accounts: %d",
 			"Total KVs: %d",
@@ -275,6 +276,7 @@ func printAccountsDatabase(databaseName string, stagingTables bool, fileHeader l
 			fileHeader.BalancesRound,
 			fileHeader.BlocksRound,
 			fileHeader.BlockHeaderDigest.String(),
+			fileHeader.BalancesMerkleRoot.String(),
 			fileHeader.Catchpoint,
 			fileHeader.TotalAccounts,
 			fileHeader.TotalKVs,
@@ -132,6 +132,11 @@ func init() {
 	methodAppCmd.Flags().Uint64Var(&localSchemaUints, "local-ints", 0, "Maximum number of integer values that may be stored in local (per-account) key/value stores for this app. Immutable, only valid when passed with --create.")
 	methodAppCmd.Flags().Uint64Var(&localSchemaByteSlices, "local-byteslices", 0, "Maximum number of byte slices that may be stored in local (per-account) key/value stores for this app. Immutable, only valid when passed with --create.")
 	methodAppCmd.Flags().Uint32Var(&extraPages, "extra-pages", 0, "Additional program space for supporting larger TEAL assembly program. A maximum of 3 extra pages is allowed. A page is 1024 bytes. Only valid when passed with --create.")
+	methodAppCmd.Flags().StringVar(&appSpecFilename, "spec", "", "ARC-32 application spec JSON file. With a spec, --method may be a method name, missing trailing --arg values are taken from the spec's default arguments, and the on-completion is chosen from the method's call config unless --on-completion is given")
+
+	callAppCmd.Flags().StringVar(&appSpecFilename, "spec", "", "ARC-32 application spec JSON file describing the application's ABI methods")
+	callAppCmd.Flags().StringVar(&method, "method", "", "ABI method to call, by name or signature. Requires --spec")
+	callAppCmd.Flags().StringArrayVar(&methodArgs, "arg", nil, "Args to pass in for calling a method. Requires --spec")
 
 	// Can't use PersistentFlags on the root because for some reason marking
 	// a root command as required with MarkPersistentFlagRequired isn't
@@ -159,6 +164,7 @@ func init() {
 	readStateAppCmd.Flags().BoolVar(&fetchLocal, "local", false, "Fetch account-specific state for this application. `--from` address is required when using this flag")
 	readStateAppCmd.Flags().BoolVar(&fetchGlobal, "global", false, "Fetch global state for this application.")
 	readStateAppCmd.Flags().BoolVar(&guessFormat, "guess-format", false, "Format application state using heuristics to guess data encoding.")
+	readStateAppCmd.Flags().StringVar(&appSpecFilename, "spec", "", "ARC-32 application spec JSON file. Declared state is reported under its declared names and decoded with its declared types")
 
 	createAppCmd.MarkFlagRequired("creator")
 	createAppCmd.MarkFlagRequired("global-ints")
@@ -807,6 +813,21 @@ var callAppCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, _ []string) {
 		dataDir, client := getDataDirAndClient()
 
+		if method != "" || len(methodArgs) > 0 {
+			if appSpecFilename == "" {
+				reportErrorf("--method and --arg require --spec, use 'goal app method' to call a method by signature")
+			}
+			if method == "" {
+				reportErrorf("--arg requires --method")
+			}
+			onCompletionEnum := resolveMethodFromAppSpec(cmd, appStateReader(client))
+			invokeABIMethod(cmd, dataDir, client, onCompletionEnum)
+			return
+		}
+		if appSpecFilename != "" {
+			reportErrorf("--spec requires --method")
+		}
+
 		// Parse transaction parameters
 		appArgs, appAccounts, foreignApps, foreignAssets, boxes := getAppInputs()
 
@@ -957,6 +978,15 @@ var readStateAppCmd = &cobra.Command{
 			reportErrorf(errorLocalStateRequiresAccount)
 		}
 
+		var spec *appSpec
+		if appSpecFilename != "" {
+			if guessFormat {
+				reportErrorf("--spec and --guess-format are mutually exclusive")
+			}
+			loaded := mustLoadAppSpec()
+			spec = &loaded
+		}
+
 		if fetchLocal {
 			// Fetching local state. Get account information
 			ai, err := client.RawAccountApplicationInformation(account, appIdx)
@@ -973,6 +1003,10 @@ var readStateAppCmd = &cobra.Command{
 			}
 
 			kv := ai.AppLocalState.KeyValue
+			if spec != nil {
+				printDecodedState(spec.Schema.Local, kv)
+				return
+			}
 			if guessFormat {
 				kv = heuristicFormat(kv)
 			}
@@ -1003,6 +1037,10 @@ var readStateAppCmd = &cobra.Command{
 			}
 
 			kv := ai.AppParams.GlobalState
+			if spec != nil {
+				printDecodedState(spec.Schema.Global, kv)
+				return
+			}
 			if guessFormat {
 				kv = heuristicFormat(kv)
 			}
@@ -1020,6 +1058,18 @@ var readStateAppCmd = &cobra.Command{
 	},
 }
 
+func printDecodedState(schema appSpecStateSchema, kv map[string]basics.TealValue) {
+	decoded, err := decodeState(schema, kv)
+	if err != nil {
+		reportErrorf(errorMarshalingState, err)
+	}
+	enc, err := json.MarshalIndent(decoded, "", "  ")
+	if err != nil {
+		reportErrorf(errorMarshalingState, err)
+	}
+	os.Stdout.Write(enc)
+}
+
 var infoAppCmd = &cobra.Command{
 	Use:   "info",
 	Short: "Look up current parameters for an application",
@@ -1251,272 +1301,315 @@ var methodAppCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		dataDir, client := getDataDirAndClient()
 
-		// Parse transaction parameters
-		appArgsParsed, appAccounts, foreignApps, foreignAssets, boxes := getAppInputs()
-		if len(appArgsParsed) > 0 {
-			reportErrorf("--arg and --app-arg are mutually exclusive, do not use --app-arg")
+		var onCompletionEnum transactions.OnCompletion
+		if appSpecFilename != "" {
+			onCompletionEnum = resolveMethodFromAppSpec(cmd, appStateReader(client))
+		} else {
+			onCompletionEnum = mustParseOnCompletion(onCompletion)
 		}
+		invokeABIMethod(cmd, dataDir, client, onCompletionEnum)
+	},
+}
 
-		// Construct schemas from args
-		localSchema := basics.StateSchema{
-			NumUint:      localSchemaUints,
-			NumByteSlice: localSchemaByteSlices,
+// appStateReader returns an appSpecStateReader for the application being
+// called, reading local state from the sending account.
+func appStateReader(client libgoal.Client) appSpecStateReader {
+	return func(local bool) (map[string]basics.TealValue, error) {
+		if local {
+			ai, err := client.RawAccountApplicationInformation(account, appIdx)
+			if err != nil {
+				return nil, err
+			}
+			if ai.AppLocalState == nil {
+				return nil, fmt.Errorf(errorAccountNotOptedInToApp, account, appIdx)
+			}
+			return ai.AppLocalState.KeyValue, nil
 		}
-
-		globalSchema := basics.StateSchema{
-			NumUint:      globalSchemaUints,
-			NumByteSlice: globalSchemaByteSlices,
+		if appIdx == 0 {
+			return nil, fmt.Errorf("global state is not available when creating an application")
 		}
+		app, err := client.ApplicationInformation(appIdx)
+		if err != nil {
+			return nil, err
+		}
+		ai, err := client.RawAccountApplicationInformation(app.Params.Creator, appIdx)
+		if err != nil {
+			return nil, err
+		}
+		if ai.AppParams == nil {
+			return nil, fmt.Errorf(errorNoSuchApplication, appIdx)
+		}
+		return ai.AppParams.GlobalState, nil
+	}
+}
 
-		onCompletionEnum := mustParseOnCompletion(onCompletion)
+// invokeABIMethod issues the ARC-4 method call described by the --method and
+// --arg flags, along with any transaction arguments, and reports its return
+// value.
+func invokeABIMethod(cmd *cobra.Command, dataDir string, client libgoal.Client, onCompletionEnum transactions.OnCompletion) {
+	// Parse transaction parameters
+	appArgsParsed, appAccounts, foreignApps, foreignAssets, boxes := getAppInputs()
+	if len(appArgsParsed) > 0 {
+		reportErrorf("--arg and --app-arg are mutually exclusive, do not use --app-arg")
+	}
 
-		if methodCreatesApp {
-			if appIdx != 0 {
-				reportErrorf("--app-id and --create are mutually exclusive, only provide one")
-			}
+	// Construct schemas from args
+	localSchema := basics.StateSchema{
+		NumUint:      localSchemaUints,
+		NumByteSlice: localSchemaByteSlices,
+	}
 
-			switch onCompletionEnum {
-			case transactions.CloseOutOC, transactions.ClearStateOC:
-				reportWarnf("'--on-completion %s' may be ill-formed for use with --create", onCompletion)
-			}
-		} else {
-			if appIdx == 0 {
-				reportErrorf("one of --app-id or --create must be provided")
-			}
+	globalSchema := basics.StateSchema{
+		NumUint:      globalSchemaUints,
+		NumByteSlice: globalSchemaByteSlices,
+	}
 
-			if localSchema != (basics.StateSchema{}) || globalSchema != (basics.StateSchema{}) {
-				reportErrorf("--global-ints, --global-byteslices, --local-ints, and --local-byteslices must only be provided with --create")
-			}
+	if methodCreatesApp {
+		if appIdx != 0 {
+			reportErrorf("--app-id and --create are mutually exclusive, only provide one")
+		}
 
-			if extraPages != 0 {
-				reportErrorf("--extra-pages must only be provided with --create")
-			}
+		switch onCompletionEnum {
+		case transactions.CloseOutOC, transactions.ClearStateOC:
+			reportWarnf("'--on-completion %s' may be ill-formed for use with --create", onCompletion)
+		}
+	} else {
+		if appIdx == 0 {
+			reportErrorf("one of --app-id or --create must be provided")
 		}
 
-		var approvalProg, clearProg []byte
-		if methodCreatesApp || onCompletionEnum == transactions.UpdateApplicationOC {
-			approvalProg, clearProg = mustParseProgArgs()
+		if localSchema != (basics.StateSchema{}) || globalSchema != (basics.StateSchema{}) {
+			reportErrorf("--global-ints, --global-byteslices, --local-ints, and --local-byteslices must only be provided with --create")
 		}
 
-		var applicationArgs [][]byte
+		if extraPages != 0 {
+			reportErrorf("--extra-pages must only be provided with --create")
+		}
+	}
+
+	var approvalProg, clearProg []byte
+	if methodCreatesApp || onCompletionEnum == transactions.UpdateApplicationOC {
+		approvalProg, clearProg = mustParseProgArgs()
+	}
+
+	var applicationArgs [][]byte
+
+	// insert the method selector hash
+	hash := sha512.Sum512_256([]byte(method))
+	applicationArgs = append(applicationArgs, hash[0:4])
 
-		// insert the method selector hash
-		hash := sha512.Sum512_256([]byte(method))
-		applicationArgs = append(applicationArgs, hash[0:4])
+	// parse down the ABI type from method signature
+	_, argTypes, retTypeStr, err := abi.ParseMethodSignature(method)
+	if err != nil {
+		reportErrorf("cannot parse method signature: %v", err)
+	}
 
-		// parse down the ABI type from method signature
-		_, argTypes, retTypeStr, err := abi.ParseMethodSignature(method)
+	var retType *abi.Type
+	if retTypeStr != abi.VoidReturnType {
+		theRetType, err := abi.TypeOf(retTypeStr)
 		if err != nil {
-			reportErrorf("cannot parse method signature: %v", err)
+			reportErrorf("cannot cast %s to abi type: %v", retTypeStr, err)
 		}
+		retType = &theRetType
+	}
 
-		var retType *abi.Type
-		if retTypeStr != abi.VoidReturnType {
-			theRetType, err := abi.TypeOf(retTypeStr)
-			if err != nil {
-				reportErrorf("cannot cast %s to abi type: %v", retTypeStr, err)
+	if len(methodArgs) != len(argTypes) {
+		reportErrorf("incorrect number of arguments, method expected %d but got %d", len(argTypes), len(methodArgs))
+	}
+
+	var txnArgTypes []string
+	var txnArgValues []string
+	var basicArgTypes []string
+	var basicArgValues []string
+	var refArgTypes []string
+	var refArgValues []string
+	refArgIndexToBasicArgIndex := make(map[int]int)
+	for i, argType := range argTypes {
+		argValue := methodArgs[i]
+		if abi.IsTransactionType(argType) {
+			txnArgTypes = append(txnArgTypes, argType)
+			txnArgValues = append(txnArgValues, argValue)
+		} else {
+			if abi.IsReferenceType(argType) {
+				refArgIndexToBasicArgIndex[len(refArgTypes)] = len(basicArgTypes)
+				refArgTypes = append(refArgTypes, argType)
+				refArgValues = append(refArgValues, argValue)
+				// treat the reference as a uint8 for encoding purposes
+				argType = "uint8"
 			}
-			retType = &theRetType
+			basicArgTypes = append(basicArgTypes, argType)
+			basicArgValues = append(basicArgValues, argValue)
 		}
+	}
 
-		if len(methodArgs) != len(argTypes) {
-			reportErrorf("incorrect number of arguments, method expected %d but got %d", len(argTypes), len(methodArgs))
-		}
+	refArgsResolved, err := populateMethodCallReferenceArgs(account, appIdx, refArgTypes, refArgValues, &appAccounts, &foreignApps, &foreignAssets)
+	if err != nil {
+		reportErrorf("error populating reference arguments: %v", err)
+	}
+	for i, resolved := range refArgsResolved {
+		basicArgIndex := refArgIndexToBasicArgIndex[i]
+		// use the foreign array index as the encoded argument value
+		basicArgValues[basicArgIndex] = strconv.Itoa(resolved)
+	}
 
-		var txnArgTypes []string
-		var txnArgValues []string
-		var basicArgTypes []string
-		var basicArgValues []string
-		var refArgTypes []string
-		var refArgValues []string
-		refArgIndexToBasicArgIndex := make(map[int]int)
-		for i, argType := range argTypes {
-			argValue := methodArgs[i]
-			if abi.IsTransactionType(argType) {
-				txnArgTypes = append(txnArgTypes, argType)
-				txnArgValues = append(txnArgValues, argValue)
-			} else {
-				if abi.IsReferenceType(argType) {
-					refArgIndexToBasicArgIndex[len(refArgTypes)] = len(basicArgTypes)
-					refArgTypes = append(refArgTypes, argType)
-					refArgValues = append(refArgValues, argValue)
-					// treat the reference as a uint8 for encoding purposes
-					argType = "uint8"
-				}
-				basicArgTypes = append(basicArgTypes, argType)
-				basicArgValues = append(basicArgValues, argValue)
-			}
-		}
+	err = parseMethodArgJSONtoByteSlice(basicArgTypes, basicArgValues, &applicationArgs)
+	if err != nil {
+		reportErrorf("cannot parse arguments to ABI encoding: %v", err)
+	}
 
-		refArgsResolved, err := populateMethodCallReferenceArgs(account, appIdx, refArgTypes, refArgValues, &appAccounts, &foreignApps, &foreignAssets)
-		if err != nil {
-			reportErrorf("error populating reference arguments: %v", err)
-		}
-		for i, resolved := range refArgsResolved {
-			basicArgIndex := refArgIndexToBasicArgIndex[i]
-			// use the foreign array index as the encoded argument value
-			basicArgValues[basicArgIndex] = strconv.Itoa(resolved)
-		}
+	txnArgs, err := populateMethodCallTxnArgs(txnArgTypes, txnArgValues)
+	if err != nil {
+		reportErrorf("error populating transaction arguments: %v", err)
+	}
 
-		err = parseMethodArgJSONtoByteSlice(basicArgTypes, basicArgValues, &applicationArgs)
-		if err != nil {
-			reportErrorf("cannot parse arguments to ABI encoding: %v", err)
-		}
+	appCallTxn, err := client.MakeUnsignedApplicationCallTx(
+		appIdx, applicationArgs, appAccounts, foreignApps, foreignAssets, boxes,
+		onCompletionEnum, approvalProg, clearProg, globalSchema, localSchema, extraPages)
 
-		txnArgs, err := populateMethodCallTxnArgs(txnArgTypes, txnArgValues)
-		if err != nil {
-			reportErrorf("error populating transaction arguments: %v", err)
-		}
+	if err != nil {
+		reportErrorf("Cannot create application txn: %v", err)
+	}
+
+	// Fill in note and lease
+	appCallTxn.Note = parseNoteField(cmd)
+	appCallTxn.Lease = parseLease(cmd)
+
+	// Fill in rounds, fee, etc.
+	fv, lv, _, err := client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
+	if err != nil {
+		reportErrorf("Cannot determine last valid round: %s", err)
+	}
 
-		appCallTxn, err := client.MakeUnsignedApplicationCallTx(
-			appIdx, applicationArgs, appAccounts, foreignApps, foreignAssets, boxes,
-			onCompletionEnum, approvalProg, clearProg, globalSchema, localSchema, extraPages)
+	appCallTxn, err = client.FillUnsignedTxTemplate(account, fv, lv, fee, appCallTxn)
+	if err != nil {
+		reportErrorf("Cannot construct transaction: %s", err)
+	}
+	explicitFee := cmd.Flags().Changed("fee")
+	if explicitFee {
+		appCallTxn.Fee = basics.MicroAlgos{Raw: fee}
+	}
 
+	// Compile group
+	var txnGroup []transactions.Transaction
+	for i := range txnArgs {
+		txnGroup = append(txnGroup, txnArgs[i].Txn)
+	}
+	txnGroup = append(txnGroup, appCallTxn)
+	if len(txnGroup) > 1 {
+		// Only if transaction arguments are present, assign group ID
+		groupID, err := client.GroupID(txnGroup)
 		if err != nil {
-			reportErrorf("Cannot create application txn: %v", err)
+			reportErrorf("Cannot assign transaction group ID: %s", err)
+		}
+		for i := range txnGroup {
+			txnGroup[i].Group = groupID
 		}
+	}
 
-		// Fill in note and lease
-		appCallTxn.Note = parseNoteField(cmd)
-		appCallTxn.Lease = parseLease(cmd)
+	// Sign transactions
+	var signedTxnGroup []transactions.SignedTxn
+	shouldSign := sign || outFilename == ""
+	for i, unsignedTxn := range txnGroup {
+		txnFromArgs := transactions.SignedTxn{}
+		if i < len(txnArgs) {
+			txnFromArgs = txnArgs[i]
+		}
 
-		// Fill in rounds, fee, etc.
-		fv, lv, _, err := client.ComputeValidityRounds(firstValid, lastValid, numValidRounds)
-		if err != nil {
-			reportErrorf("Cannot determine last valid round: %s", err)
+		if !txnFromArgs.Lsig.Blank() {
+			signedTxnGroup = append(signedTxnGroup, transactions.SignedTxn{
+				Lsig:     txnFromArgs.Lsig,
+				AuthAddr: txnFromArgs.AuthAddr,
+				Txn:      unsignedTxn,
+			})
+			continue
 		}
 
-		appCallTxn, err = client.FillUnsignedTxTemplate(account, fv, lv, fee, appCallTxn)
+		signedTxn, err := createSignedTransaction(client, shouldSign, dataDir, walletName, unsignedTxn, txnFromArgs.AuthAddr)
 		if err != nil {
-			reportErrorf("Cannot construct transaction: %s", err)
-		}
-		explicitFee := cmd.Flags().Changed("fee")
-		if explicitFee {
-			appCallTxn.Fee = basics.MicroAlgos{Raw: fee}
+			reportErrorf(errorSigningTX, err)
 		}
 
-		// Compile group
-		var txnGroup []transactions.Transaction
-		for i := range txnArgs {
-			txnGroup = append(txnGroup, txnArgs[i].Txn)
+		signedTxnGroup = append(signedTxnGroup, signedTxn)
+	}
+
+	// Output to file
+	if outFilename != "" {
+		if dumpForDryrun {
+			err = writeDryrunReqToFile(client, signedTxnGroup, outFilename)
+		} else {
+			err = writeSignedTxnsToFile(signedTxnGroup, outFilename)
 		}
-		txnGroup = append(txnGroup, appCallTxn)
-		if len(txnGroup) > 1 {
-			// Only if transaction arguments are present, assign group ID
-			groupID, err := client.GroupID(txnGroup)
-			if err != nil {
-				reportErrorf("Cannot assign transaction group ID: %s", err)
-			}
-			for i := range txnGroup {
-				txnGroup[i].Group = groupID
-			}
+		if err != nil {
+			reportErrorf(err.Error())
 		}
+		return
+	}
 
-		// Sign transactions
-		var signedTxnGroup []transactions.SignedTxn
-		shouldSign := sign || outFilename == ""
-		for i, unsignedTxn := range txnGroup {
-			txnFromArgs := transactions.SignedTxn{}
-			if i < len(txnArgs) {
-				txnFromArgs = txnArgs[i]
-			}
+	// Broadcast
+	err = client.BroadcastTransactionGroup(signedTxnGroup)
+	if err != nil {
+		reportErrorf(errorBroadcastingTX, err)
+	}
 
-			if !txnFromArgs.Lsig.Blank() {
-				signedTxnGroup = append(signedTxnGroup, transactions.SignedTxn{
-					Lsig:     txnFromArgs.Lsig,
-					AuthAddr: txnFromArgs.AuthAddr,
-					Txn:      unsignedTxn,
-				})
-				continue
-			}
+	// Report tx details to user
+	if methodCreatesApp {
+		reportInfof("Attempting to create app (approval size %d, hash %v; clear size %d, hash %v)", len(approvalProg), crypto.HashObj(logic.Program(approvalProg)), len(clearProg), crypto.HashObj(logic.Program(clearProg)))
+	} else if onCompletionEnum == transactions.UpdateApplicationOC {
+		reportInfof("Attempting to update app (approval size %d, hash %v; clear size %d, hash %v)", len(approvalProg), crypto.HashObj(logic.Program(approvalProg)), len(clearProg), crypto.HashObj(logic.Program(clearProg)))
+	}
 
-			signedTxn, err := createSignedTransaction(client, shouldSign, dataDir, walletName, unsignedTxn, txnFromArgs.AuthAddr)
-			if err != nil {
-				reportErrorf(errorSigningTX, err)
-			}
+	reportInfof("Issued %d transaction(s):", len(signedTxnGroup))
 
-			signedTxnGroup = append(signedTxnGroup, signedTxn)
-		}
+	// remember the final txid in this variable
+	var txid string
+	for _, stxn := range signedTxnGroup {
+		txid = stxn.Txn.ID().String()
+		reportInfof("Issued transaction from account %s, txid %s (fee %d)", stxn.Txn.Sender, txid, stxn.Txn.Fee.Raw)
+	}
 
-		// Output to file
-		if outFilename != "" {
-			if dumpForDryrun {
-				err = writeDryrunReqToFile(client, signedTxnGroup, outFilename)
-			} else {
-				err = writeSignedTxnsToFile(signedTxnGroup, outFilename)
-			}
-			if err != nil {
-				reportErrorf(err.Error())
-			}
-			return
+	if !noWaitAfterSend {
+		_, err := waitForCommit(client, txid, lv)
+		if err != nil {
+			reportErrorf(err.Error())
 		}
 
-		// Broadcast
-		err = client.BroadcastTransactionGroup(signedTxnGroup)
+		resp, err := client.PendingTransactionInformation(txid)
 		if err != nil {
-			reportErrorf(errorBroadcastingTX, err)
+			reportErrorf(err.Error())
 		}
 
-		// Report tx details to user
-		if methodCreatesApp {
-			reportInfof("Attempting to create app (approval size %d, hash %v; clear size %d, hash %v)", len(approvalProg), crypto.HashObj(logic.Program(approvalProg)), len(clearProg), crypto.HashObj(logic.Program(clearProg)))
-		} else if onCompletionEnum == transactions.UpdateApplicationOC {
-			reportInfof("Attempting to update app (approval size %d, hash %v; clear size %d, hash %v)", len(approvalProg), crypto.HashObj(logic.Program(approvalProg)), len(clearProg), crypto.HashObj(logic.Program(clearProg)))
+		if methodCreatesApp && resp.ApplicationIndex != nil && *resp.ApplicationIndex != 0 {
+			reportInfof("Created app with app index %d", *resp.ApplicationIndex)
 		}
 
-		reportInfof("Issued %d transaction(s):", len(signedTxnGroup))
-
-		// remember the final txid in this variable
-		var txid string
-		for _, stxn := range signedTxnGroup {
-			txid = stxn.Txn.ID().String()
-			reportInfof("Issued transaction from account %s, txid %s (fee %d)", stxn.Txn.Sender, txid, stxn.Txn.Fee.Raw)
+		if retType == nil {
+			reportInfof("method %s succeeded", method)
+			return
 		}
 
-		if !noWaitAfterSend {
-			_, err := waitForCommit(client, txid, lv)
-			if err != nil {
-				reportErrorf(err.Error())
-			}
-
-			resp, err := client.PendingTransactionInformation(txid)
-			if err != nil {
-				reportErrorf(err.Error())
-			}
+		// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
+		var abiReturnHash = []byte{0x15, 0x1f, 0x7c, 0x75}
 
-			if methodCreatesApp && resp.ApplicationIndex != nil && *resp.ApplicationIndex != 0 {
-				reportInfof("Created app with app index %d", *resp.ApplicationIndex)
-			}
-
-			if retType == nil {
-				reportInfof("method %s succeeded", method)
-				return
-			}
-
-			// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
-			var abiReturnHash = []byte{0x15, 0x1f, 0x7c, 0x75}
-
-			if resp.Logs == nil || len(*resp.Logs) == 0 {
-				reportErrorf("method %s succeed but did not log a return value", method)
-			}
-
-			lastLog := (*resp.Logs)[len(*resp.Logs)-1]
-			if !bytes.HasPrefix(lastLog, abiReturnHash) {
-				reportErrorf("method %s succeed but did not log a return value", method)
-			}
+		if resp.Logs == nil || len(*resp.Logs) == 0 {
+			reportErrorf("method %s succeed but did not log a return value", method)
+		}
 
-			rawReturnValue := lastLog[len(abiReturnHash):]
-			decoded, err := retType.Decode(rawReturnValue)
-			if err != nil {
-				reportErrorf("method %s succeed but its return value could not be decoded.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
-			}
+		lastLog := (*resp.Logs)[len(*resp.Logs)-1]
+		if !bytes.HasPrefix(lastLog, abiReturnHash) {
+			reportErrorf("method %s succeed but did not log a return value", method)
+		}
 
-			decodedJSON, err := retType.MarshalToJSON(decoded)
-			if err != nil {
-				reportErrorf("method %s succeed but its return value could not be converted to JSON.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
-			}
+		rawReturnValue := lastLog[len(abiReturnHash):]
+		decoded, err := retType.Decode(rawReturnValue)
+		if err != nil {
+			reportErrorf("method %s succeed but its return value could not be decoded.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
+		}
 
-			reportInfof("method %s succeeded with output: %s", method, string(decodedJSON))
+		decodedJSON, err := retType.MarshalToJSON(decoded)
+		if err != nil {
+			reportErrorf("method %s succeed but its return value could not be converted to JSON.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
 		}
-	},
+
+		reportInfof("method %s succeeded with output: %s", method, string(decodedJSON))
+	}
 }
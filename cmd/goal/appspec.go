@@ -0,0 +1,463 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+var appSpecFilename string
+
+// Call config values, as defined by ARC-32. They describe whether an
+// on-completion action may be used when calling an existing application,
+// when creating one, or both.
+const (
+	appSpecCallNever  = "NEVER"
+	appSpecCallCall   = "CALL"
+	appSpecCallCreate = "CREATE"
+	appSpecCallAll    = "ALL"
+)
+
+// Default argument sources, as defined by ARC-32.
+const (
+	appSpecDefaultConstant    = "constant"
+	appSpecDefaultGlobalState = "global-state"
+	appSpecDefaultLocalState  = "local-state"
+	appSpecDefaultABIMethod   = "abi-method"
+)
+
+// Declared state value types which are not ABI types. Any other type string
+// is interpreted as an ABI type and the stored bytes are decoded with it.
+const (
+	appSpecTypeUint64 = "uint64"
+	appSpecTypeBytes  = "bytes"
+)
+
+// appSpecOnCompletions lists the on-completion actions that may be used for
+// ABI method calls, in the order they are preferred when a method allows
+// more than one of them. ClearState is not allowed to carry an ABI call.
+var appSpecOnCompletions = []struct {
+	key string
+	oc  transactions.OnCompletion
+}{
+	{"no_op", transactions.NoOpOC},
+	{"opt_in", transactions.OptInOC},
+	{"close_out", transactions.CloseOutOC},
+	{"update_application", transactions.UpdateApplicationOC},
+	{"delete_application", transactions.DeleteApplicationOC},
+}
+
+// appSpec is an ARC-32 application specification. Only the parts which goal
+// makes use of are decoded: the ARC-4 contract description, the per-method
+// hints, and the declared state schema. In addition to the global and local
+// schemas of ARC-32, a box schema may be declared in the same format.
+type appSpec struct {
+	Hints          map[string]appSpecHint `json:"hints"`
+	Schema         appSpecSchema          `json:"schema"`
+	Contract       appSpecContract        `json:"contract"`
+	BareCallConfig map[string]string      `json:"bare_call_config"`
+}
+
+type appSpecHint struct {
+	CallConfig       map[string]string            `json:"call_config"`
+	DefaultArguments map[string]appSpecDefaultArg `json:"default_arguments"`
+}
+
+type appSpecDefaultArg struct {
+	Source string          `json:"source"`
+	Data   json.RawMessage `json:"data"`
+}
+
+type appSpecSchema struct {
+	Global appSpecStateSchema `json:"global"`
+	Local  appSpecStateSchema `json:"local"`
+	Box    appSpecStateSchema `json:"box"`
+}
+
+type appSpecStateSchema struct {
+	Declared map[string]appSpecDeclaredValue `json:"declared"`
+}
+
+type appSpecDeclaredValue struct {
+	Type  string `json:"type"`
+	Key   string `json:"key"`
+	Descr string `json:"descr"`
+}
+
+type appSpecContract struct {
+	Name    string          `json:"name"`
+	Methods []appSpecMethod `json:"methods"`
+}
+
+type appSpecMethod struct {
+	Name    string             `json:"name"`
+	Desc    string             `json:"desc"`
+	Args    []appSpecMethodArg `json:"args"`
+	Returns struct {
+		Type string `json:"type"`
+	} `json:"returns"`
+}
+
+type appSpecMethodArg struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Desc string `json:"desc"`
+}
+
+// signature returns the ARC-4 method signature, e.g. "add(uint64,uint64)uint64".
+func (m appSpecMethod) signature() string {
+	argTypes := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		argTypes[i] = arg.Type
+	}
+	returns := m.Returns.Type
+	if returns == "" {
+		returns = abi.VoidReturnType
+	}
+	return fmt.Sprintf("%s(%s)%s", m.Name, strings.Join(argTypes, ","), returns)
+}
+
+func loadAppSpec(filename string) (spec appSpec, err error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(data, &spec)
+	if err != nil {
+		return
+	}
+	err = spec.validate()
+	return
+}
+
+func (spec appSpec) validate() error {
+	for _, m := range spec.Contract.Methods {
+		if err := abi.VerifyMethodSignature(m.signature()); err != nil {
+			return fmt.Errorf("method %s: %v", m.Name, err)
+		}
+	}
+	for sig, hint := range spec.Hints {
+		if err := validateCallConfig(hint.CallConfig); err != nil {
+			return fmt.Errorf("hints for %s: %v", sig, err)
+		}
+	}
+	if err := validateCallConfig(spec.BareCallConfig); err != nil {
+		return fmt.Errorf("bare_call_config: %v", err)
+	}
+	for name, schema := range map[string]appSpecStateSchema{"global": spec.Schema.Global, "local": spec.Schema.Local, "box": spec.Schema.Box} {
+		for field, declared := range schema.Declared {
+			if declared.Type == appSpecTypeUint64 || declared.Type == appSpecTypeBytes {
+				continue
+			}
+			if _, err := abi.TypeOf(declared.Type); err != nil {
+				return fmt.Errorf("%s state %s: %v", name, field, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateCallConfig(config map[string]string) error {
+	for key, value := range config {
+		if key == "clear_state" {
+			continue
+		}
+		known := false
+		for _, entry := range appSpecOnCompletions {
+			if entry.key == key {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown on-completion %s", key)
+		}
+		switch value {
+		case appSpecCallNever, appSpecCallCall, appSpecCallCreate, appSpecCallAll:
+		default:
+			return fmt.Errorf("unknown call config %s for %s", value, key)
+		}
+	}
+	return nil
+}
+
+// findMethod looks up a method either by its full signature or by its name.
+// Looking up by name fails if the contract overloads that name.
+func (spec appSpec) findMethod(nameOrSig string) (appSpecMethod, error) {
+	var found []appSpecMethod
+	for _, m := range spec.Contract.Methods {
+		if m.signature() == nameOrSig || m.Name == nameOrSig {
+			found = append(found, m)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return appSpecMethod{}, fmt.Errorf("method %s not found in application spec", nameOrSig)
+	case 1:
+		return found[0], nil
+	default:
+		sigs := make([]string, len(found))
+		for i, m := range found {
+			sigs[i] = m.signature()
+		}
+		return appSpecMethod{}, fmt.Errorf("method name %s is ambiguous, use one of the signatures: %s", nameOrSig, strings.Join(sigs, ", "))
+	}
+}
+
+func callConfigAllows(value string, create bool) bool {
+	if create {
+		return value == appSpecCallCreate || value == appSpecCallAll
+	}
+	return value == appSpecCallCall || value == appSpecCallAll
+}
+
+// onCompletionFor picks the on-completion action for calling method m. If
+// requested is non-nil it is checked against the method's call config,
+// otherwise the first allowed action is chosen. A method without a call
+// config hint is assumed to allow only NoOp calls, as ARC-32 specifies.
+func (spec appSpec) onCompletionFor(m appSpecMethod, create bool, requested *transactions.OnCompletion) (transactions.OnCompletion, error) {
+	config := spec.Hints[m.signature()].CallConfig
+	if len(config) == 0 {
+		config = map[string]string{"no_op": appSpecCallCall}
+	}
+	for _, entry := range appSpecOnCompletions {
+		if requested != nil && *requested != entry.oc {
+			continue
+		}
+		if callConfigAllows(config[entry.key], create) {
+			return entry.oc, nil
+		}
+	}
+	action := "call"
+	if create {
+		action = "create"
+	}
+	if requested != nil {
+		return 0, fmt.Errorf("method %s does not allow on-completion %s on %s", m.signature(), strings.TrimSuffix(requested.String(), "OC"), action)
+	}
+	return 0, fmt.Errorf("method %s does not allow any on-completion on %s", m.signature(), action)
+}
+
+// appSpecStateReader fetches the raw global or local (sender's) state of the
+// application being called. It is used to resolve default arguments.
+type appSpecStateReader func(local bool) (map[string]basics.TealValue, error)
+
+// methodArgs returns the command-line form of every argument of method m.
+// Arguments which were not given are filled in from the default arguments of
+// the spec, which only works for trailing arguments.
+func (spec appSpec) methodArgs(m appSpecMethod, given []string, readState appSpecStateReader) ([]string, error) {
+	if len(given) > len(m.Args) {
+		return nil, fmt.Errorf("method %s expects %d arguments but got %d", m.signature(), len(m.Args), len(given))
+	}
+	args := make([]string, len(m.Args))
+	copy(args, given)
+	defaults := spec.Hints[m.signature()].DefaultArguments
+	for i := len(given); i < len(m.Args); i++ {
+		arg := m.Args[i]
+		def, ok := defaults[arg.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing argument %s of method %s, and it has no default value", arg.Name, m.signature())
+		}
+		value, err := def.resolve(arg.Type, readState)
+		if err != nil {
+			return nil, fmt.Errorf("cannot resolve default value of argument %s: %v", arg.Name, err)
+		}
+		args[i] = value
+	}
+	return args, nil
+}
+
+func (def appSpecDefaultArg) resolve(argType string, readState appSpecStateReader) (string, error) {
+	switch def.Source {
+	case appSpecDefaultConstant:
+		// transaction and reference arguments are not JSON encoded on the
+		// command line, so strip the quotes of a string constant
+		if abi.IsTransactionType(argType) || abi.IsReferenceType(argType) {
+			var s string
+			if err := json.Unmarshal(def.Data, &s); err == nil {
+				return s, nil
+			}
+		}
+		return string(def.Data), nil
+	case appSpecDefaultGlobalState, appSpecDefaultLocalState:
+		var key string
+		if err := json.Unmarshal(def.Data, &key); err != nil {
+			return "", fmt.Errorf("state key must be a string: %v", err)
+		}
+		state, err := readState(def.Source == appSpecDefaultLocalState)
+		if err != nil {
+			return "", err
+		}
+		tv, ok := state[key]
+		if !ok {
+			return "", fmt.Errorf("key %s not found in %s", strconv.Quote(key), def.Source)
+		}
+		return tealValueToMethodArg(tv, argType)
+	case appSpecDefaultABIMethod:
+		return "", fmt.Errorf("default values from %s are not supported, pass the argument explicitly", def.Source)
+	default:
+		return "", fmt.Errorf("unknown default argument source %s", def.Source)
+	}
+}
+
+// tealValueToMethodArg converts a state value into the command-line form of
+// an argument of type argType.
+func tealValueToMethodArg(tv basics.TealValue, argType string) (string, error) {
+	if tv.Type == basics.TealUintType {
+		return strconv.FormatUint(tv.Uint, 10), nil
+	}
+	if argType == "string" {
+		encoded, err := json.Marshal(tv.Bytes)
+		return string(encoded), err
+	}
+	if abi.IsReferenceType(argType) || abi.IsTransactionType(argType) {
+		return "", fmt.Errorf("cannot use a byte slice as %s argument", argType)
+	}
+	t, err := abi.TypeOf(argType)
+	if err != nil {
+		return "", err
+	}
+	decoded, err := t.Decode([]byte(tv.Bytes))
+	if err != nil {
+		return "", err
+	}
+	encoded, err := t.MarshalToJSON(decoded)
+	return string(encoded), err
+}
+
+// decodeStateValue converts a raw value stored under a declared key into a
+// JSON friendly value of the declared type.
+func decodeStateValue(declaredType string, raw []byte) (interface{}, error) {
+	switch declaredType {
+	case appSpecTypeUint64:
+		// a uint64 stored in a box or as bytes is big-endian encoded
+		t, _ := abi.TypeOf("uint64")
+		return t.Decode(raw)
+	case appSpecTypeBytes:
+		return heuristicFormatStr(string(raw)), nil
+	}
+	t, err := abi.TypeOf(declaredType)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := t.Decode(raw)
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := t.MarshalToJSON(decoded)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(encoded), nil
+}
+
+// decodeState maps a global or local key/value store onto the declared
+// schema. Declared keys are reported under their declared names and decoded
+// with their declared types; undeclared keys are kept, with their keys and
+// byte slice values formatted as with --guess-format.
+func decodeState(schema appSpecStateSchema, kv map[string]basics.TealValue) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(kv))
+	declaredKeys := make(map[string]bool, len(schema.Declared))
+	for name, declared := range schema.Declared {
+		declaredKeys[declared.Key] = true
+		tv, ok := kv[declared.Key]
+		if !ok {
+			continue
+		}
+		if tv.Type == basics.TealUintType {
+			result[name] = tv.Uint
+			continue
+		}
+		value, err := decodeStateValue(declared.Type, []byte(tv.Bytes))
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %s as %s: %v", name, declared.Type, err)
+		}
+		result[name] = value
+	}
+	for k, v := range kv {
+		if declaredKeys[k] {
+			continue
+		}
+		if v.Type == basics.TealUintType {
+			result[heuristicFormatKey(k)] = v.Uint
+		} else {
+			result[heuristicFormatKey(k)] = heuristicFormatStr(v.Bytes)
+		}
+	}
+	return result, nil
+}
+
+// findDeclaredBox returns the name and declaration of the box schema entry
+// whose key is boxName, if there is one.
+func (spec appSpec) findDeclaredBox(boxName []byte) (string, appSpecDeclaredValue, bool) {
+	for name, declared := range spec.Schema.Box.Declared {
+		if declared.Key == string(boxName) {
+			return name, declared, true
+		}
+	}
+	return "", appSpecDeclaredValue{}, false
+}
+
+func mustLoadAppSpec() appSpec {
+	spec, err := loadAppSpec(appSpecFilename)
+	if err != nil {
+		reportErrorf("Could not load application spec %s: %v", appSpecFilename, err)
+	}
+	return spec
+}
+
+// resolveMethodFromAppSpec replaces the --method and --arg values with the
+// ones derived from the application spec, and returns the on-completion to
+// use. The method may be given by name, missing trailing arguments are filled
+// in from their default values and the on-completion is chosen from the
+// method's call config unless --on-completion was given explicitly.
+func resolveMethodFromAppSpec(cmd *cobra.Command, readState appSpecStateReader) transactions.OnCompletion {
+	spec := mustLoadAppSpec()
+	m, err := spec.findMethod(method)
+	if err != nil {
+		reportErrorf("%v", err)
+	}
+
+	var requested *transactions.OnCompletion
+	if cmd.Flags().Changed("on-completion") {
+		oc := mustParseOnCompletion(onCompletion)
+		requested = &oc
+	}
+	oc, err := spec.onCompletionFor(m, methodCreatesApp, requested)
+	if err != nil {
+		reportErrorf("%v", err)
+	}
+
+	args, err := spec.methodArgs(m, methodArgs, readState)
+	if err != nil {
+		reportErrorf("%v", err)
+	}
+
+	method = m.signature()
+	methodArgs = args
+	return oc
+}
@@ -0,0 +1,260 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testAppSpec = `{
+	"hints": {
+		"add(uint64,uint64)uint64": {
+			"call_config": {"no_op": "CALL"},
+			"default_arguments": {
+				"b": {"source": "constant", "data": 7}
+			}
+		},
+		"register(string,account)void": {
+			"call_config": {"opt_in": "ALL", "no_op": "CALL"},
+			"default_arguments": {
+				"name": {"source": "global-state", "data": "default_name"},
+				"who": {"source": "constant", "data": "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"}
+			}
+		},
+		"transfer(uint64)void": {
+			"call_config": {"delete_application": "CALL"}
+		}
+	},
+	"schema": {
+		"global": {
+			"declared": {
+				"counter": {"type": "uint64", "key": "c"},
+				"owner": {"type": "address", "key": "o"},
+				"pair": {"type": "(uint16,bool)", "key": "p"},
+				"default_name": {"type": "bytes", "key": "default_name"}
+			}
+		},
+		"local": {"declared": {}},
+		"box": {
+			"declared": {
+				"total": {"type": "uint64", "key": "total"}
+			}
+		}
+	},
+	"contract": {
+		"name": "test",
+		"methods": [
+			{"name": "add", "args": [{"type": "uint64", "name": "a"}, {"type": "uint64", "name": "b"}], "returns": {"type": "uint64"}},
+			{"name": "register", "args": [{"type": "string", "name": "name"}, {"type": "account", "name": "who"}], "returns": {"type": "void"}},
+			{"name": "transfer", "args": [{"type": "uint64", "name": "amount"}], "returns": {"type": "void"}},
+			{"name": "transfer", "args": [{"type": "address", "name": "to"}, {"type": "uint64", "name": "amount"}], "returns": {"type": "void"}}
+		]
+	},
+	"bare_call_config": {"no_op": "CREATE"}
+}`
+
+func loadTestAppSpec(t *testing.T) appSpec {
+	filename := filepath.Join(t.TempDir(), "app.json")
+	require.NoError(t, os.WriteFile(filename, []byte(testAppSpec), 0600))
+	spec, err := loadAppSpec(filename)
+	require.NoError(t, err)
+	return spec
+}
+
+func TestAppSpecFindMethod(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spec := loadTestAppSpec(t)
+
+	m, err := spec.findMethod("add")
+	require.NoError(t, err)
+	require.Equal(t, "add(uint64,uint64)uint64", m.signature())
+
+	m, err = spec.findMethod("register(string,account)void")
+	require.NoError(t, err)
+	require.Equal(t, "register", m.Name)
+
+	_, err = spec.findMethod("transfer")
+	require.ErrorContains(t, err, "ambiguous")
+
+	m, err = spec.findMethod("transfer(address,uint64)void")
+	require.NoError(t, err)
+	require.Len(t, m.Args, 2)
+
+	_, err = spec.findMethod("missing")
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestAppSpecOnCompletion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spec := loadTestAppSpec(t)
+	register, err := spec.findMethod("register")
+	require.NoError(t, err)
+
+	oc, err := spec.onCompletionFor(register, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, transactions.NoOpOC, oc)
+
+	oc, err = spec.onCompletionFor(register, true, nil)
+	require.NoError(t, err)
+	require.Equal(t, transactions.OptInOC, oc)
+
+	optIn := transactions.OptInOC
+	oc, err = spec.onCompletionFor(register, false, &optIn)
+	require.NoError(t, err)
+	require.Equal(t, transactions.OptInOC, oc)
+
+	closeOut := transactions.CloseOutOC
+	_, err = spec.onCompletionFor(register, false, &closeOut)
+	require.ErrorContains(t, err, "does not allow on-completion CloseOut on call")
+
+	transfer, err := spec.findMethod("transfer(uint64)void")
+	require.NoError(t, err)
+	oc, err = spec.onCompletionFor(transfer, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, transactions.DeleteApplicationOC, oc)
+
+	_, err = spec.onCompletionFor(transfer, true, nil)
+	require.ErrorContains(t, err, "does not allow any on-completion on create")
+
+	// a method without hints may only be called with NoOp
+	noHints := appSpecMethod{Name: "noHints"}
+	oc, err = spec.onCompletionFor(noHints, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, transactions.NoOpOC, oc)
+	_, err = spec.onCompletionFor(noHints, true, nil)
+	require.Error(t, err)
+}
+
+func TestAppSpecDefaultArgs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spec := loadTestAppSpec(t)
+	globalState := map[string]basics.TealValue{
+		"default_name": {Type: basics.TealBytesType, Bytes: "alice"},
+	}
+	readState := func(local bool) (map[string]basics.TealValue, error) {
+		require.False(t, local)
+		return globalState, nil
+	}
+
+	add, err := spec.findMethod("add")
+	require.NoError(t, err)
+
+	args, err := spec.methodArgs(add, []string{"1", "2"}, readState)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, args)
+
+	args, err = spec.methodArgs(add, []string{"1"}, readState)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "7"}, args)
+
+	_, err = spec.methodArgs(add, nil, readState)
+	require.ErrorContains(t, err, "missing argument a")
+
+	_, err = spec.methodArgs(add, []string{"1", "2", "3"}, readState)
+	require.ErrorContains(t, err, "expects 2 arguments but got 3")
+
+	register, err := spec.findMethod("register")
+	require.NoError(t, err)
+	args, err = spec.methodArgs(register, nil, readState)
+	require.NoError(t, err)
+	require.Equal(t, []string{`"alice"`, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAY5HFKQ"}, args)
+
+	// the resolved arguments must encode with the method's types
+	var encoded [][]byte
+	require.NoError(t, parseMethodArgJSONtoByteSlice([]string{"string"}, args[:1], &encoded))
+	require.Equal(t, [][]byte{{0, 5, 'a', 'l', 'i', 'c', 'e'}}, encoded)
+
+	delete(globalState, "default_name")
+	_, err = spec.methodArgs(register, nil, readState)
+	require.ErrorContains(t, err, "not found in global-state")
+}
+
+func TestAppSpecDecodeState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	spec := loadTestAppSpec(t)
+
+	var owner basics.Address
+	owner[0] = 1
+	kv := map[string]basics.TealValue{
+		"c":            {Type: basics.TealUintType, Uint: 42},
+		"o":            {Type: basics.TealBytesType, Bytes: string(owner[:])},
+		"p":            {Type: basics.TealBytesType, Bytes: string([]byte{0x01, 0x02, 0x80})},
+		"default_name": {Type: basics.TealBytesType, Bytes: "alice"},
+		"undeclared":   {Type: basics.TealUintType, Uint: 3},
+	}
+
+	decoded, err := decodeState(spec.Schema.Global, kv)
+	require.NoError(t, err)
+	enc, err := json.Marshal(decoded)
+	require.NoError(t, err)
+
+	expected := `{
+		"counter": 42,
+		"owner": "` + owner.String() + `",
+		"pair": [258, true],
+		"default_name": "alice",
+		"undeclared": 3
+	}`
+	require.JSONEq(t, expected, string(enc))
+
+	kv["p"] = basics.TealValue{Type: basics.TealBytesType, Bytes: "x"}
+	_, err = decodeState(spec.Schema.Global, kv)
+	require.ErrorContains(t, err, "cannot decode pair as (uint16,bool)")
+
+	name, declared, ok := spec.findDeclaredBox([]byte("total"))
+	require.True(t, ok)
+	require.Equal(t, "total", name)
+	value, err := decodeStateValue(declared.Type, []byte{0, 0, 0, 0, 0, 0, 1, 0})
+	require.NoError(t, err)
+	require.EqualValues(t, 256, value)
+
+	_, _, ok = spec.findDeclaredBox([]byte("other"))
+	require.False(t, ok)
+}
+
+func TestAppSpecValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var spec appSpec
+	require.NoError(t, json.Unmarshal([]byte(testAppSpec), &spec))
+	require.NoError(t, spec.validate())
+
+	spec.Hints["add(uint64,uint64)uint64"].CallConfig["no_op"] = "SOMETIMES"
+	require.ErrorContains(t, spec.validate(), "unknown call config SOMETIMES")
+
+	require.NoError(t, json.Unmarshal([]byte(testAppSpec), &spec))
+	spec.Schema.Global.Declared["bad"] = appSpecDeclaredValue{Type: "uint7", Key: "b"}
+	require.ErrorContains(t, spec.validate(), "global state bad")
+}
@@ -18,6 +18,7 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -36,6 +37,7 @@ func init() {
 
 	appBoxInfoCmd.Flags().StringVarP(&boxName, "name", "n", "", "Application box name. Use the same form as app-arg to name the box.")
 	appBoxInfoCmd.MarkFlagRequired("name")
+	appBoxInfoCmd.Flags().StringVar(&appSpecFilename, "spec", "", "ARC-32 application spec JSON file. A box declared in the spec's box schema has its value decoded with the declared type")
 
 	appBoxListCmd.Flags().Uint64VarP(&maxBoxes, "max", "m", 0, "Maximum number of boxes to list. 0 means no limit.")
 }
@@ -82,6 +84,23 @@ var appBoxInfoCmd = &cobra.Command{
 		}
 		reportInfof("Name:  %s", boxName)
 
+		if appSpecFilename != "" {
+			spec := mustLoadAppSpec()
+			if name, declared, ok := spec.findDeclaredBox(box.Name); ok {
+				value, err := decodeStateValue(declared.Type, box.Value)
+				if err != nil {
+					reportErrorf("Cannot decode box %s as %s: %v", name, declared.Type, err)
+				}
+				enc, err := json.Marshal(value)
+				if err != nil {
+					reportErrorf(errorMarshalingState, err)
+				}
+				reportInfof("Declared as: %s (%s)", name, declared.Type)
+				reportInfof("Value: %s", enc)
+				return
+			}
+		}
+
 		// Print box value
 		reportInfof("Value: %s", encodeBytesAsAppCallBytes(box.Value))
 	},
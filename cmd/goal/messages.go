@@ -73,12 +73,18 @@ const (
 	errorNodeFailedToStart             = "Algorand node failed to start: %s"
 	errorNodeRunning                   = "Node must be stopped before writing APIToken"
 	errorNodeFailGenToken              = "Cannot generate API token: %s"
+	errorNodeFailCreateToken           = "Cannot create scoped API token: %s"
+	errorNodeFailListTokens            = "Cannot list scoped API tokens: %s"
+	errorNodeFailRevokeToken           = "Cannot revoke scoped API token: %s"
 	errorNodeCreation                  = "Error during node creation: %v"
 	errorNodeManagedBySystemd          = "This node is using systemd and should be managed with systemctl. For additional information refer to https://developer.algorand.org/docs/run-a-node/setup/install/#installing-algod-as-a-systemd-service"
 	errorKill                          = "Cannot kill node: %s"
 	errorCloningNode                   = "Error cloning the node: %s"
 	infoNodeCloned                     = "Node cloned successfully to: %s"
 	infoNodeWroteToken                 = "Successfully wrote new API token: %s"
+	infoNodeCreatedToken               = "Created scoped API token '%s': %s\nThis token will not be shown again."
+	infoNodeNoTokens                   = "No scoped API tokens found. You can create one with `goal node token create`"
+	infoNodeRevokedToken               = "Revoked scoped API token '%s'"
 	infoNodePendingTxnsDescription     = "Pending Transactions (Truncated max=%d, Total in pool=%d): "
 	infoNodeNoPendingTxnsDescription   = "None"
 	infoDataDir                        = "[Data Directory: %s]"
@@ -142,6 +148,11 @@ const (
 	infoNetworkStarted       = "Network Started under %s"
 	infoNetworkStopped       = "Network Stopped under %s"
 	infoNetworkDeleted       = "Network Deleted under %s"
+	errorLoadingRehearsal    = "Error loading upgrade rehearsal: %s"
+	errorRehearsingUpgrade   = "Error rehearsing upgrade: %s"
+	errorSavingRehearsal     = "Error saving upgrade rehearsal report: %s"
+	infoRehearsalRunning     = "Network of the rehearsal left running under %s"
+	errorRehearsalFailed     = "Upgrade rehearsal failed"
 
 	multisigProgramCollision = "should have at most one of --program/-p | --program-bytes/-P | --lsig/-L"
 
@@ -177,6 +188,15 @@ const (
 	errorCouldntCreateWallet     = "Couldn't create wallet: %s"
 	errorCouldntInitializeWallet = "Couldn't initialize wallet: %s"
 	errorCouldntExportMDK        = "Couldn't export master derivation key: %s"
+	errorCouldntBackupWallet     = "Couldn't back up wallet: %s"
+	errorCouldntRestoreWallet    = "Couldn't restore wallet: %s"
+	infoWroteWalletBackup        = "Wrote the wallet backup to %s. It is encrypted with the backup passphrase, which is required to restore it"
+	infoChooseBackupPassword     = "Please choose a passphrase for the backup: "
+	infoBackupPasswordPrompt     = "Please enter the passphrase of the backup: "
+	infoChooseRestoredPassword   = "Please choose a password for the restored wallet: "
+	errorBlankBackupPassword     = "The backup passphrase must not be blank"
+	infoRestoringWallet          = "Restoring wallet..."
+	infoRestoredWallet           = "Restored wallet '%s'"
 	errorCouldntMakeMnemonic     = "Couldn't make mnemonic: %s"
 	errorCouldntListWallets      = "Couldn't list wallets: %s"
 	errorPasswordConfirmation    = "Password confirmation did not match"
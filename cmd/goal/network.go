@@ -35,6 +35,9 @@ var startNode string
 var noImportKeys bool
 var noClean bool
 var devModeOverride bool
+var rehearsalFile string
+var rehearsalReportFile string
+var rehearsalKeepRunning bool
 
 func init() {
 	networkCmd.AddCommand(networkCreateCmd)
@@ -55,6 +58,13 @@ func init() {
 	networkCmd.AddCommand(networkStopCmd)
 	networkCmd.AddCommand(networkStatusCmd)
 	networkCmd.AddCommand(networkDeleteCmd)
+
+	networkRehearseUpgradeCmd.Flags().StringVarP(&networkName, "network", "n", "", "Specify the name to use for the private network")
+	networkRehearseUpgradeCmd.Flags().StringVarP(&rehearsalFile, "plan", "p", "", "Specify the path to the upgrade rehearsal file")
+	networkRehearseUpgradeCmd.MarkFlagRequired("plan")
+	networkRehearseUpgradeCmd.Flags().StringVar(&rehearsalReportFile, "report", "", "Write the rehearsal report, as json, to this file")
+	networkRehearseUpgradeCmd.Flags().BoolVar(&rehearsalKeepRunning, "keep", false, "Leave the network running once the rehearsal is over")
+	networkCmd.AddCommand(networkRehearseUpgradeCmd)
 }
 
 var networkCmd = &cobra.Command{
@@ -214,3 +224,52 @@ var networkDeleteCmd = &cobra.Command{
 		reportInfof(infoNetworkDeleted, networkRootDir)
 	},
 }
+
+var networkRehearseUpgradeCmd = &cobra.Command{
+	Use:   "rehearse-upgrade",
+	Short: "Rehearse a consensus upgrade on a new private network",
+	Long:  `Creates a private network from the template of an upgrade rehearsal file and starts it on the protocol the upgrade starts from. As the network makes progress, nodes are restarted with other binaries as the rehearsal schedules it. The rehearsal checks that the upgrade gets proposed and voted in, and that the network switches to the new protocol on the expected round, then prints a report.`,
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		networkRootDir, err := filepath.Abs(networkRootDir)
+		if err != nil {
+			panic(err)
+		}
+		if util.FileExists(networkRootDir) && !util.IsEmpty(networkRootDir) {
+			reportErrorf(infoNetworkAlreadyExists, networkRootDir)
+		}
+
+		rehearsal, err := netdeploy.LoadUpgradeRehearsal(rehearsalFile)
+		if err != nil {
+			reportErrorf(errorLoadingRehearsal, err)
+		}
+		if rehearsal.BinDir == "" {
+			rehearsal.BinDir, err = util.ExeDir()
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		network, report, err := netdeploy.RehearseUpgrade(rehearsal, networkName, networkRootDir, os.Stdout)
+		if !rehearsalKeepRunning {
+			network.Stop(rehearsal.BinDir)
+		}
+		if err != nil {
+			reportErrorf(errorRehearsingUpgrade, err)
+		}
+
+		report.WriteText(os.Stdout)
+		if rehearsalReportFile != "" {
+			err = report.Save(rehearsalReportFile)
+			if err != nil {
+				reportErrorf(errorSavingRehearsal, err)
+			}
+		}
+		if rehearsalKeepRunning {
+			reportInfof(infoRehearsalRunning, networkRootDir)
+		}
+		if !report.Passed {
+			reportErrorf(errorRehearsalFailed)
+		}
+	},
+}
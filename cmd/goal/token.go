@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+var (
+	tokenForKmd       bool
+	tokenScopes       []string
+	tokenExpiresAfter time.Duration
+	tokenRateLimit    uint64
+	tokenAdmin        bool
+)
+
+func init() {
+	nodeCmd.AddCommand(tokenCmd)
+	tokenCmd.AddCommand(tokenCreateCmd)
+	tokenCmd.AddCommand(tokenListCmd)
+	tokenCmd.AddCommand(tokenRevokeCmd)
+
+	tokenCmd.PersistentFlags().BoolVar(&tokenForKmd, "kmd", false, "Manage the scoped tokens of kmd instead of algod")
+
+	tokenCreateCmd.Flags().StringArrayVarP(&tokenScopes, "scope", "s", nil, fmt.Sprintf("Endpoints the token may use: one of the predefined scopes (%s), or an optional HTTP method followed by a route such as /v2/accounts/:address, matching any route with its prefix if it ends with *. Can be repeated", strings.Join(tokens.SortedScopeAliases(), ", ")))
+	tokenCreateCmd.Flags().DurationVar(&tokenExpiresAfter, "expires", 0, "Duration after which the token expires, e.g. 720h (default: never)")
+	tokenCreateCmd.Flags().Uint64Var(&tokenRateLimit, "rate-limit", 0, "Maximum number of requests per second (default: unlimited)")
+	tokenCreateCmd.Flags().BoolVar(&tokenAdmin, "admin", false, "Allow the token to use the endpoints which require the admin token, within its scopes")
+	tokenCreateCmd.MarkFlagRequired("scope")
+}
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage scoped API tokens",
+	Long:  "Manage named API tokens restricted to a set of endpoints, with an optional expiry and rate limit. Changes take effect without restarting the node.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+// scopedTokensDataDir returns the data dir and the file name of the scoped tokens to manage
+func scopedTokensDataDir(dataDir string) (string, string) {
+	if tokenForKmd {
+		return resolveKmdDataDir(dataDir), tokens.KmdScopedTokensFilename
+	}
+	return dataDir, tokens.AlgodScopedTokensFilename
+}
+
+var tokenCreateCmd = &cobra.Command{
+	Use:   "create [token name]",
+	Short: "Create a scoped API token",
+	Long:  "Create a scoped API token and print it. The token is not stored: it cannot be printed again.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dir, filename := scopedTokensDataDir(ensureSingleDataDir())
+
+		desc := tokens.ScopedToken{
+			Name:      args[0],
+			Scopes:    tokenScopes,
+			Admin:     tokenAdmin,
+			RateLimit: tokenRateLimit,
+		}
+		if tokenExpiresAfter != 0 {
+			desc.Expires = time.Now().Add(tokenExpiresAfter).Unix()
+		}
+
+		token, err := tokens.CreateScopedToken(dir, filename, desc)
+		if err != nil {
+			reportErrorf(errorNodeFailCreateToken, err)
+		}
+		reportInfof(infoNodeCreatedToken, args[0], token)
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the scoped API tokens",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(func(dataDir string) {
+			dir, filename := scopedTokensDataDir(dataDir)
+			scopedTokens, err := tokens.LoadScopedTokens(dir, filename)
+			if err != nil {
+				reportErrorf(errorNodeFailListTokens, err)
+			}
+			if len(scopedTokens) == 0 {
+				reportInfoln(infoNodeNoTokens)
+				return
+			}
+
+			now := time.Now()
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSTATUS\tEXPIRES\tRATE LIMIT\tADMIN\tSCOPES")
+			for _, st := range scopedTokens {
+				status := "active"
+				expires := "never"
+				if st.Expires != 0 {
+					expiry := time.Unix(st.Expires, 0)
+					expires = expiry.Format(time.RFC3339)
+					if !now.Before(expiry) {
+						status = "expired"
+					}
+				}
+				if st.Revoked {
+					status = "revoked"
+				}
+				rateLimit := "none"
+				if st.RateLimit != 0 {
+					rateLimit = fmt.Sprintf("%d/s", st.RateLimit)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\t%s\n", st.Name, status, expires, rateLimit, st.Admin, strings.Join(st.Scopes, ", "))
+			}
+			w.Flush()
+		})
+	},
+}
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke [token name]",
+	Short: "Revoke a scoped API token",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		onDataDirs(func(dataDir string) {
+			dir, filename := scopedTokensDataDir(dataDir)
+			err := tokens.RevokeScopedToken(dir, filename, args[0])
+			if err != nil {
+				reportErrorf(errorNodeFailRevokeToken, err)
+			}
+			reportInfof(infoNodeRevokedToken, args[0])
+		})
+	},
+}
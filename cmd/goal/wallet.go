@@ -33,17 +33,23 @@ import (
 var (
 	recoverWallet     bool
 	defaultWalletName string
+	restoreWalletName string
 )
 
 func init() {
 	walletCmd.AddCommand(newWalletCmd)
 	walletCmd.AddCommand(listWalletsCmd)
+	walletCmd.AddCommand(backupWalletCmd)
+	walletCmd.AddCommand(restoreWalletCmd)
 
 	// Default wallet to use when -w not specified
 	walletCmd.Flags().StringVarP(&defaultWalletName, "default", "f", "", "Set the wallet with this name to be the default wallet")
 
 	// Should we recover the wallet?
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
+
+	backupWalletCmd.Flags().StringVarP(&walletName, "wallet", "w", "", "Set the wallet to back up")
+	restoreWalletCmd.Flags().StringVarP(&restoreWalletName, "name", "n", "", "Name of the restored wallet (default: the name of the backed up wallet)")
 }
 
 var walletCmd = &cobra.Command{
@@ -199,6 +205,91 @@ var listWalletsCmd = &cobra.Command{
 	},
 }
 
+var backupWalletCmd = &cobra.Command{
+	Use:   "backup [output file]",
+	Short: "Back up a wallet to an encrypted file",
+	Long:  "Write an encrypted backup of a wallet to a file. Unlike the backup mnemonic, the backup holds the imported keys and the multisig preimages of the wallet as well. It is encrypted with a backup passphrase, chosen separately from the wallet password, which is required to restore it with `goal wallet restore`.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		outFile := args[0]
+
+		dataDir := ensureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, walletName, true)
+
+		// Fetch a passphrase for the backup, which may not be blank
+		fmt.Printf(infoChooseBackupPassword)
+		backupPassword := ensurePassword()
+		if len(backupPassword) == 0 {
+			reportErrorln(errorBlankBackupPassword)
+		}
+		fmt.Printf(infoPasswordConfirmation)
+		passwordConfirmation := ensurePassword()
+		if !bytes.Equal(backupPassword, passwordConfirmation) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		backup, err := client.BackupWallet(wh, pw, backupPassword)
+		if err != nil {
+			reportErrorf(errorCouldntBackupWallet, err)
+		}
+
+		err = writeFile(outFile, backup, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, outFile, err)
+		}
+		reportInfof(infoWroteWalletBackup, outFile)
+	},
+}
+
+var restoreWalletCmd = &cobra.Command{
+	Use:   "restore [backup file]",
+	Short: "Restore a wallet from a backup made with `goal wallet backup`",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		inFile := args[0]
+
+		dataDir := ensureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		client := ensureKmdClient(dataDir)
+
+		backup, err := readFile(inFile)
+		if err != nil {
+			reportErrorf(fileReadError, inFile, err)
+		}
+
+		fmt.Printf(infoBackupPasswordPrompt)
+		backupPassword := ensurePassword()
+
+		// Fetch a password for the restored wallet
+		fmt.Printf(infoChooseRestoredPassword)
+		walletPassword := ensurePassword()
+		fmt.Printf(infoPasswordConfirmation)
+		passwordConfirmation := ensurePassword()
+		if !bytes.Equal(walletPassword, passwordConfirmation) {
+			reportErrorln(errorPasswordConfirmation)
+		}
+
+		reportInfoln(infoRestoringWallet)
+		walletID, name, err := client.RestoreWallet([]byte(restoreWalletName), walletPassword, backupPassword, backup)
+		if err != nil {
+			reportErrorf(errorCouldntRestoreWallet, err)
+		}
+		reportInfof(infoRestoredWallet, name)
+
+		// Check if we're the only wallet
+		wallets, err := client.ListWallets()
+		if err != nil {
+			reportErrorf(errorCouldntListWallets, err)
+		}
+
+		// We are the only wallet -- make us the default
+		if len(wallets) == 1 {
+			accountList.setDefaultWalletID(walletID)
+		}
+	},
+}
+
 func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 	accountList := makeAccountsList(dataDir)
 	defaultWalletID := string(accountList.getDefaultWalletID())
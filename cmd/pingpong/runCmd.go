@@ -90,6 +90,8 @@ var generatedAccountsCount uint64
 var generatedAccountsOffset uint64
 var generatedAccountSampleMethod string
 var configPath string
+var scenarioPath string
+var reportPath string
 
 func init() {
 	rootCmd.AddCommand(runCmd)
@@ -111,6 +113,8 @@ func init() {
 	runCmd.Flags().StringVar(&refreshTime, "refresh", "", "Duration of time (seconds) between refilling accounts with money (0 means no refresh)")
 	runCmd.Flags().StringVar(&logicProg, "program", "", "File containing the compiled program to include as a logic sig")
 	runCmd.Flags().StringVar(&configPath, "config", "", "path to read config json from, or json literal")
+	runCmd.Flags().StringVar(&scenarioPath, "scenario", "", "path to read a scenario json from, whose phases replace --tps and --run")
+	runCmd.Flags().StringVar(&reportPath, "report", "", "path to write the json report of the scenario to")
 	runCmd.Flags().BoolVar(&saveConfig, "save", false, "Save the effective configuration to disk")
 	runCmd.Flags().BoolVar(&useDefault, "reset", false, "Reset to the default configuration (not read from disk)")
 	runCmd.Flags().BoolVar(&quietish, "quiet", false, "quietish stdout logging")
@@ -439,6 +443,16 @@ var runCmd = &cobra.Command{
 		if cfg.DeterministicKeys && cfg.NumPartAccounts > uint32(len(cfg.GeneratedAccountsMnemonics)) {
 			reportErrorf("numAccounts is greater than number of account mnemonics provided")
 		}
+		if scenarioPath != "" {
+			scenario, err := pingpong.LoadScenarioFromFile(scenarioPath)
+			if err != nil {
+				reportErrorf("%s: bad scenario json, %v\n", scenarioPath, err)
+			}
+			cfg.Scenario = &scenario
+		}
+		if reportPath != "" && cfg.Scenario == nil {
+			reportErrorf("--report requires a scenario\n")
+		}
 
 		cfg.SetDefaultWeights()
 		err = cfg.Check()
@@ -469,6 +483,16 @@ var runCmd = &cobra.Command{
 
 		// Kick off the real processing
 		pps.RunPingPong(context.Background(), &ac)
+
+		if report := pps.Report(); report != nil {
+			report.WriteText(os.Stdout)
+			if reportPath != "" {
+				err = report.Save(reportPath)
+				if err != nil {
+					reportErrorf("%s: could not save report, %v\n", reportPath, err)
+				}
+			}
+		}
 	},
 }
 
@@ -76,6 +76,7 @@ type Local struct {
 	// IncomingConnectionsLimit specifies the max number of long-lived incoming
 	// connections. 0 means no connections allowed. Must be non-negative.
 	// Estimating 5MB per incoming connection, 5MB*800 = 4GB
+	// A running node can lower it when its configuration gets reloaded, but raising it requires a restart.
 	IncomingConnectionsLimit int `version[0]:"-1" version[1]:"10000" version[17]:"800"`
 
 	// BroadcastConnectionsLimit specifies the number of connections that
@@ -173,6 +174,7 @@ type Local struct {
 
 	// TxBacklogSize is the queue size used for receiving transactions. default of 26000 to approximate 1 block of transactions
 	// if EnableTxBacklogRateLimiting enabled, the over-all size will be larger by MAX_PEERS*TxBacklogReservedCapacityPerPeer
+	// A running node can shrink the over-all size when its configuration gets reloaded, but growing it requires a restart.
 	TxBacklogSize int `version[27]:"26000"`
 
 	// TxPoolSize is the number of transactions that fit in the transaction pool
@@ -485,6 +487,73 @@ type Local struct {
 	// EnableExperimentalAPI enables experimental API endpoint. Note that these endpoints have no
 	// guarantees in terms of functionality or future support.
 	EnableExperimentalAPI bool `version[26]:"false"`
+
+	// EnableVoteCompression enables the stateful compression of agreement vote messages exchanged with peers
+	// which enabled it as well. Each connection keeps a table of the recently sent addresses, digests and keys,
+	// and repeated values are replaced with short references into that table.
+	EnableVoteCompression bool `version[27]:"false"`
+
+	// EnableTxnAnnouncements enables the announce-then-request relay of transaction groups with peers which
+	// enabled it as well: such peers are sent the digests of the relayed groups, and only request the groups
+	// they have not seen yet. Peers which did not enable it keep receiving the full transaction groups.
+	EnableTxnAnnouncements bool `version[27]:"false"`
+
+	// PersistVerifiedTransactionsCache makes the ledger write the verified transactions cache to disk when it's closed,
+	// and load it back when it's opened, so that the transactions which were verified before a restart don't need to
+	// be verified again. Entries which no longer match the ledger round or consensus version are discarded on load.
+	PersistVerifiedTransactionsCache bool `version[27]:"false"`
+
+	// BlockHistoryRetainRounds makes a non-archival node keep the blocks of this many recent rounds, in addition to
+	// the blocks the ledger requires anyway. Older blocks are pruned in the background. Zero keeps only the required
+	// blocks, unless BlockHistoryMaxDiskBytes is set. It has no effect on archival nodes.
+	BlockHistoryRetainRounds uint64 `version[27]:"0"`
+
+	// BlockHistoryMaxDiskBytes limits the size of the blocks database of a non-archival node: once it's exceeded,
+	// the oldest blocks are pruned in the background, down to the blocks the ledger requires. When set without
+	// BlockHistoryRetainRounds, the node keeps as many blocks as fit in this budget. Zero disables the limit.
+	BlockHistoryMaxDiskBytes uint64 `version[27]:"0"`
+
+	// HealthMaxLedgerLagSeconds is the largest difference between the wall clock and the timestamp of the latest
+	// block for which the node is considered ready by the /ready endpoint. Zero, the default, disables the check.
+	HealthMaxLedgerLagSeconds uint64 `version[27]:"0"`
+
+	// HealthMinPeers is the smallest number of connected peers for which the node is considered ready. Zero, the
+	// default, disables the check.
+	HealthMinPeers uint64 `version[27]:"0"`
+
+	// HealthMaxTxPoolFullnessPercent is the fullness of the transaction pool, as a percentage of TxPoolSize,
+	// beyond which the node is no longer considered ready, as it would reject most of the transactions it is
+	// sent. Zero disables the check.
+	HealthMaxTxPoolFullnessPercent uint64 `version[27]:"90"`
+
+	// HealthMinDiskFreeBytes is the smallest amount of free disk space in the data directory for which the node
+	// is considered ready. Zero, the default, disables the check.
+	HealthMinDiskFreeBytes uint64 `version[27]:"0"`
+
+	// HealthParticipationKeyWarningRounds makes the health details warn about the participation keys of the
+	// registered accounts which expire within this many rounds. Keys which already expired make the node
+	// unhealthy regardless. Zero disables the warning.
+	HealthParticipationKeyWarningRounds uint64 `version[27]:"100000"`
+
+	// RestRateLimits limits the rate of the REST API requests each client makes to a group of endpoints, by the
+	// name of the group: "simulate", "dryrun", "compile", "accounts", "applications", "blocks", "submit", or
+	// "default" for the endpoints of no other group. Limits are written as "RATE/BURST", where RATE is the number
+	// of requests per second and BURST the number of requests a client can make at once, such as "0.5/5". Clients
+	// are told apart by their scoped token, or by their address. Requests beyond the limit get a 429 response with
+	// a Retry-After header. Requests made with the admin API token are not limited.
+	RestRateLimits map[string]string `version[27]:""`
+
+	// RestTrustedProxies is a comma separated list of the addresses, or CIDR ranges, of the reverse proxies in front
+	// of the REST API, such as "10.0.0.0/8,192.168.1.2". The REST rate limits tell apart the clients of the requests
+	// these proxies relay by the rightmost address of their X-Forwarded-For header which isn't a trusted proxy, and
+	// ignore the header of the other requests.
+	RestTrustedProxies string `version[27]:""`
+
+	// EnableCatchpointFileVersionV8 makes the node generate its catchpoint files in version 8, which orders the chunk
+	// entries by their merkle trie keys and proves every chunk against the balances merkle root of the catchpoint, so
+	// that a bad chunk could be fetched again on its own. Nodes which don't support this version can't catch up from
+	// these files, so it should only be enabled once the network's nodes do.
+	EnableCatchpointFileVersionV8 bool `version[27]:"false"`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
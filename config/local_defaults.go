@@ -29,6 +29,8 @@ var defaultLocal = Local{
 	AnnounceParticipationKey:                   true,
 	Archival:                                   false,
 	BaseLoggerDebugLevel:                       4,
+	BlockHistoryMaxDiskBytes:                   0,
+	BlockHistoryRetainRounds:                   0,
 	BlockServiceCustomFallbackEndpoints:        "",
 	BroadcastConnectionsLimit:                  -1,
 	CadaverSizeTarget:                          0,
@@ -57,6 +59,7 @@ var defaultLocal = Local{
 	EnableAssembleStats:                        false,
 	EnableBlockService:                         false,
 	EnableBlockServiceFallbackToArchiver:       true,
+	EnableCatchpointFileVersionV8:              false,
 	EnableCatchupFromArchiveServers:            false,
 	EnableDeveloperAPI:                         false,
 	EnableExperimentalAPI:                      false,
@@ -72,13 +75,20 @@ var defaultLocal = Local{
 	EnableRuntimeMetrics:                       false,
 	EnableTopAccountsReporting:                 false,
 	EnableTxBacklogRateLimiting:                false,
+	EnableTxnAnnouncements:                     false,
 	EnableUsageLog:                             false,
 	EnableVerbosedTransactionSyncLogging:       false,
+	EnableVoteCompression:                      false,
 	EndpointAddress:                            "127.0.0.1:0",
 	FallbackDNSResolverAddress:                 "",
 	ForceFetchTransactions:                     false,
 	ForceRelayMessages:                         false,
 	GossipFanout:                               4,
+	HealthMaxLedgerLagSeconds:                  0,
+	HealthMaxTxPoolFullnessPercent:             90,
+	HealthMinDiskFreeBytes:                     0,
+	HealthMinPeers:                             0,
+	HealthParticipationKeyWarningRounds:        100000,
 	IncomingConnectionsLimit:                   800,
 	IncomingMessageFilterBucketCount:           5,
 	IncomingMessageFilterBucketSize:            512,
@@ -104,6 +114,7 @@ var defaultLocal = Local{
 	ParticipationKeysRefreshInterval:           60000000000,
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
+	PersistVerifiedTransactionsCache:           false,
 	PriorityPeers:                              map[string]bool{},
 	ProposalAssemblyTime:                       500000000,
 	PublicAddress:                              "",
@@ -111,7 +122,9 @@ var defaultLocal = Local{
 	ReservedFDs:                                256,
 	RestConnectionsHardLimit:                   2048,
 	RestConnectionsSoftLimit:                   1024,
+	RestRateLimits:                             map[string]string{},
 	RestReadTimeoutSeconds:                     15,
+	RestTrustedProxies:                         "",
 	RestWriteTimeoutSeconds:                    120,
 	RunHosted:                                  false,
 	SuggestedFeeBlockHistory:                   3,
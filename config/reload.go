@@ -0,0 +1,75 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"reflect"
+)
+
+// runtimeReloadableFields are the fields of Local which a running node applies when its configuration
+// gets reloaded. Changes of the other fields only take effect once the node restarts.
+// Some of them are only applied within the bounds the node started with, and need a restart beyond:
+// IncomingConnectionsLimit and the transaction backlog size, which it accounts for, can only be lowered,
+// and TelemetryToLog can only be turned on if the telemetry was enabled at startup.
+var runtimeReloadableFields = map[string]bool{
+	"BaseLoggerDebugLevel":                true,
+	"IncomingConnectionsLimit":            true,
+	"RestConnectionsSoftLimit":            true,
+	"RestRateLimits":                      true,
+	"CatchupParallelBlocks":               true,
+	"TxBacklogServiceRateWindowSeconds":   true,
+	"TxBacklogReservedCapacityPerPeer":    true,
+	"EnableTxBacklogRateLimiting":         true,
+	"TxBacklogSize":                       true,
+	"TelemetryToLog":                      true,
+	"HealthMaxLedgerLagSeconds":           true,
+	"HealthMinPeers":                      true,
+	"HealthMaxTxPoolFullnessPercent":      true,
+	"HealthMinDiskFreeBytes":              true,
+	"HealthParticipationKeyWarningRounds": true,
+}
+
+// IsRuntimeReloadable tells whether changes of the named field of Local are applied by a running node
+// when its configuration gets reloaded.
+func IsRuntimeReloadable(field string) bool {
+	return runtimeReloadableFields[field]
+}
+
+// ChangedFields returns the names of the fields of Local whose values differ between cfg and updated,
+// in the order of their declaration.
+func (cfg Local) ChangedFields(updated Local) []string {
+	var changed []string
+	current := reflect.ValueOf(cfg)
+	other := reflect.ValueOf(updated)
+	localType := current.Type()
+	for i := 0; i < localType.NumField(); i++ {
+		if !reflect.DeepEqual(current.Field(i).Interface(), other.Field(i).Interface()) {
+			changed = append(changed, localType.Field(i).Name)
+		}
+	}
+	return changed
+}
+
+// CopyFields returns cfg with the values of the named fields taken from other.
+func (cfg Local) CopyFields(other Local, fields []string) Local {
+	target := reflect.ValueOf(&cfg).Elem()
+	source := reflect.ValueOf(other)
+	for _, field := range fields {
+		target.FieldByName(field).Set(source.FieldByName(field))
+	}
+	return cfg
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRuntimeReloadableFieldsExist(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	localType := reflect.TypeOf(Local{})
+	for field := range runtimeReloadableFields {
+		_, ok := localType.FieldByName(field)
+		require.True(t, ok, "%s is not a field of Local", field)
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := GetDefaultLocal()
+	require.Empty(t, cfg.ChangedFields(cfg))
+
+	updated := cfg
+	updated.CatchupParallelBlocks++
+	updated.NetAddress = ":4160"
+	updated.DNSBootstrapID = "<network>.example.com"
+	changed := cfg.ChangedFields(updated)
+	require.Equal(t, []string{"NetAddress", "DNSBootstrapID", "CatchupParallelBlocks"}, changed)
+
+	var reloadable []string
+	for _, field := range changed {
+		if IsRuntimeReloadable(field) {
+			reloadable = append(reloadable, field)
+		}
+	}
+	require.Equal(t, []string{"CatchupParallelBlocks"}, reloadable)
+
+	reloaded := cfg.CopyFields(updated, reloadable)
+	require.Equal(t, updated.CatchupParallelBlocks, reloaded.CatchupParallelBlocks)
+	require.Equal(t, cfg.NetAddress, reloaded.NetAddress)
+	require.Equal(t, []string{"NetAddress", "DNSBootstrapID"}, reloaded.ChangedFields(updated))
+}
@@ -0,0 +1,298 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+// #cgo CFLAGS: -Wall -std=c99
+// #cgo darwin,amd64 CFLAGS: -I${SRCDIR}/libs/darwin/amd64/include
+// #cgo darwin,amd64 LDFLAGS: ${SRCDIR}/libs/darwin/amd64/lib/libsodium.a
+// #cgo darwin,arm64 CFLAGS: -I${SRCDIR}/libs/darwin/arm64/include
+// #cgo darwin,arm64 LDFLAGS: ${SRCDIR}/libs/darwin/arm64/lib/libsodium.a
+// #cgo linux,amd64 CFLAGS: -I${SRCDIR}/libs/linux/amd64/include
+// #cgo linux,amd64 LDFLAGS: ${SRCDIR}/libs/linux/amd64/lib/libsodium.a
+// #cgo linux,arm64 CFLAGS: -I${SRCDIR}/libs/linux/arm64/include
+// #cgo linux,arm64 LDFLAGS: ${SRCDIR}/libs/linux/arm64/lib/libsodium.a
+// #cgo linux,arm CFLAGS: -I${SRCDIR}/libs/linux/arm/include
+// #cgo linux,arm LDFLAGS: ${SRCDIR}/libs/linux/arm/lib/libsodium.a
+// #cgo windows,amd64 CFLAGS: -I${SRCDIR}/libs/windows/amd64/include
+// #cgo windows,amd64 LDFLAGS: ${SRCDIR}/libs/windows/amd64/lib/libsodium.a
+// #include <stdint.h>
+// #include "sodium.h"
+import "C"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+)
+
+// BIP32-Ed25519 (Khovratovich and Law) derives a tree of Ed25519 keys from a
+// seed. The root key is derived from the seed the same way hardware wallets
+// do, so that the same seed and path give the same keys in both.
+
+// BIP32HardenedOffset is added to an index to derive a hardened child key
+const BIP32HardenedOffset uint32 = 1 << 31
+
+// BIP44Purpose and BIP44AlgorandCoinType are the first two, hardened, levels
+// of the BIP44 paths of Algorand keys: m/44'/283'/account'/0/index
+const (
+	BIP44Purpose          uint32 = 44
+	BIP44AlgorandCoinType uint32 = 283
+)
+
+var bip32Ed25519SeedKey = []byte("ed25519 seed")
+
+var errBIP32HardenedPublic = errors.New("cannot derive a hardened child from a public key")
+var errBIP32InvalidKey = errors.New("derived an invalid key")
+
+// BIP32Ed25519PrivateKey is an extended private key: the secret scalar kL,
+// the nonce key kR and the chain code
+type BIP32Ed25519PrivateKey struct {
+	KL        [32]byte
+	KR        [32]byte
+	ChainCode [32]byte
+}
+
+// BIP32Ed25519PublicKey is an extended public key, which can derive the public
+// keys of the non-hardened children of its private key
+type BIP32Ed25519PublicKey struct {
+	PublicKey PublicKey
+	ChainCode [32]byte
+}
+
+// BIP44AccountPath returns the path of the account key of a BIP44 account
+func BIP44AccountPath(account uint32) []uint32 {
+	return []uint32{
+		BIP44Purpose + BIP32HardenedOffset,
+		BIP44AlgorandCoinType + BIP32HardenedOffset,
+		account + BIP32HardenedOffset,
+	}
+}
+
+// BIP44Path returns the path of the key at the given index of a BIP44 account
+func BIP44Path(account uint32, index uint32) []uint32 {
+	return append(BIP44AccountPath(account), 0, index)
+}
+
+func hmacSHA512(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	for _, d := range data {
+		mac.Write(d)
+	}
+	return mac.Sum(nil)
+}
+
+// BIP32Ed25519MasterKey derives the root extended private key of a seed
+func BIP32Ed25519MasterKey(seed []byte) (k BIP32Ed25519PrivateKey) {
+	// Hash the seed until the third highest bit of kL is clear, so that the
+	// derived scalars never overflow
+	i := hmacSHA512(bip32Ed25519SeedKey, seed)
+	for i[31]&0x20 != 0 {
+		i = hmacSHA512(bip32Ed25519SeedKey, i)
+	}
+	copy(k.KL[:], i[:32])
+	copy(k.KR[:], i[32:])
+	k.KL[0] &= 0xf8
+	k.KL[31] &= 0x7f
+	k.KL[31] |= 0x40
+
+	mac := hmac.New(sha256.New, bip32Ed25519SeedKey)
+	mac.Write([]byte{1})
+	mac.Write(seed)
+	copy(k.ChainCode[:], mac.Sum(nil))
+	return
+}
+
+// reduceScalar reduces a little-endian 256-bit integer modulo the group order
+func reduceScalar(s [32]byte) (r [32]byte) {
+	var wide [64]byte
+	copy(wide[:], s[:])
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&r[0]), (*C.uchar)(&wide[0]))
+	return
+}
+
+// scalarMultBase multiplies the base point by a scalar which was reduced modulo the group order
+func scalarMultBase(s [32]byte) (p [32]byte, err error) {
+	if C.crypto_scalarmult_ed25519_base_noclamp((*C.uchar)(&p[0]), (*C.uchar)(&s[0])) != 0 {
+		err = errBIP32InvalidKey
+	}
+	return
+}
+
+// mulAddScalars returns a*b+c modulo the group order. Its running time only
+// depends on the bits of a.
+func mulAddScalars(a, b, c [32]byte) [32]byte {
+	acc := c
+	p := b
+	for i := 0; i < 253; i++ {
+		if a[i/8]>>(i%8)&1 == 1 {
+			C.crypto_core_ed25519_scalar_add((*C.uchar)(&acc[0]), (*C.uchar)(&acc[0]), (*C.uchar)(&p[0]))
+		}
+		C.crypto_core_ed25519_scalar_add((*C.uchar)(&p[0]), (*C.uchar)(&p[0]), (*C.uchar)(&p[0]))
+	}
+	return acc
+}
+
+// childTweak returns 8*zL, where zL is the first 28 bytes of z
+func childTweak(z []byte) (t [32]byte) {
+	var carry byte
+	for i := 0; i < 28; i++ {
+		t[i] = z[i]<<3 | carry
+		carry = z[i] >> 5
+	}
+	t[28] = carry
+	return
+}
+
+// addLE adds two little-endian 256-bit integers, modulo 2^256
+func addLE(a, b [32]byte) (r [32]byte) {
+	var carry uint16
+	for i := range r {
+		sum := uint16(a[i]) + uint16(b[i]) + carry
+		r[i] = byte(sum)
+		carry = sum >> 8
+	}
+	return
+}
+
+// Public returns the extended public key of the extended private key
+func (k BIP32Ed25519PrivateKey) Public() (BIP32Ed25519PublicKey, error) {
+	pk, err := scalarMultBase(reduceScalar(k.KL))
+	if err != nil {
+		return BIP32Ed25519PublicKey{}, err
+	}
+	return BIP32Ed25519PublicKey{PublicKey: PublicKey(pk), ChainCode: k.ChainCode}, nil
+}
+
+// Child derives the child extended private key with the given index, which
+// is hardened if it is at least BIP32HardenedOffset
+func (k BIP32Ed25519PrivateKey) Child(index uint32) (child BIP32Ed25519PrivateKey, err error) {
+	var idx [4]byte
+	binary.LittleEndian.PutUint32(idx[:], index)
+
+	var z, cc []byte
+	if index >= BIP32HardenedOffset {
+		z = hmacSHA512(k.ChainCode[:], []byte{0}, k.KL[:], k.KR[:], idx[:])
+		cc = hmacSHA512(k.ChainCode[:], []byte{1}, k.KL[:], k.KR[:], idx[:])
+	} else {
+		var pub BIP32Ed25519PublicKey
+		pub, err = k.Public()
+		if err != nil {
+			return
+		}
+		z = hmacSHA512(k.ChainCode[:], []byte{2}, pub.PublicKey[:], idx[:])
+		cc = hmacSHA512(k.ChainCode[:], []byte{3}, pub.PublicKey[:], idx[:])
+	}
+
+	var zR [32]byte
+	copy(zR[:], z[32:])
+	child.KL = addLE(k.KL, childTweak(z))
+	child.KR = addLE(k.KR, zR)
+	copy(child.ChainCode[:], cc[32:])
+	if reduceScalar(child.KL) == ([32]byte{}) {
+		err = errBIP32InvalidKey
+	}
+	return
+}
+
+// DerivePath derives the descendant extended private key at the given path
+func (k BIP32Ed25519PrivateKey) DerivePath(path []uint32) (child BIP32Ed25519PrivateKey, err error) {
+	child = k
+	for _, index := range path {
+		child, err = child.Child(index)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// SignBytes signs a message directly, without first hashing.
+// Caller is responsible for domain separation.
+func (k BIP32Ed25519PrivateKey) SignBytes(message []byte) (sig Signature, err error) {
+	pub, err := k.Public()
+	if err != nil {
+		return
+	}
+
+	// This is Ed25519 with kL as the secret scalar and kR as the nonce key
+	var r, h [32]byte
+	rHash := sha512.New()
+	rHash.Write(k.KR[:])
+	rHash.Write(message)
+	rWide := rHash.Sum(nil)
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&r[0]), (*C.uchar)(&rWide[0]))
+	R, err := scalarMultBase(r)
+	if err != nil {
+		return
+	}
+
+	hHash := sha512.New()
+	hHash.Write(R[:])
+	hHash.Write(pub.PublicKey[:])
+	hHash.Write(message)
+	hWide := hHash.Sum(nil)
+	C.crypto_core_ed25519_scalar_reduce((*C.uchar)(&h[0]), (*C.uchar)(&hWide[0]))
+
+	S := mulAddScalars(h, reduceScalar(k.KL), r)
+	copy(sig[:32], R[:])
+	copy(sig[32:], S[:])
+	return
+}
+
+// Sign produces a cryptographic Signature of a Hashable message, given the
+// extended private key
+func (k BIP32Ed25519PrivateKey) Sign(message Hashable) (Signature, error) {
+	return k.SignBytes(HashRep(message))
+}
+
+// Child derives the public key of the non-hardened child with the given index
+func (p BIP32Ed25519PublicKey) Child(index uint32) (child BIP32Ed25519PublicKey, err error) {
+	if index >= BIP32HardenedOffset {
+		err = errBIP32HardenedPublic
+		return
+	}
+	var idx [4]byte
+	binary.LittleEndian.PutUint32(idx[:], index)
+
+	z := hmacSHA512(p.ChainCode[:], []byte{2}, p.PublicKey[:], idx[:])
+	cc := hmacSHA512(p.ChainCode[:], []byte{3}, p.PublicKey[:], idx[:])
+
+	tweak, err := scalarMultBase(childTweak(z))
+	if err != nil {
+		return
+	}
+	if C.crypto_core_ed25519_add((*C.uchar)(&child.PublicKey[0]), (*C.uchar)(&p.PublicKey[0]), (*C.uchar)(&tweak[0])) != 0 {
+		err = errBIP32InvalidKey
+		return
+	}
+	copy(child.ChainCode[:], cc[32:])
+	return
+}
+
+// DerivePath derives the public key of the non-hardened descendant at the given path
+func (p BIP32Ed25519PublicKey) DerivePath(path []uint32) (child BIP32Ed25519PublicKey, err error) {
+	child = p
+	for _, index := range path {
+		child, err = child.Child(index)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
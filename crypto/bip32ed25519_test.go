@@ -0,0 +1,209 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package crypto
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBIP32Ed25519SignMatchesEd25519(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for i := 0; i < 10; i++ {
+		var seed Seed
+		RandBytes(seed[:])
+		secrets := GenerateSignatureSecrets(seed)
+
+		// An Ed25519 seed expands to an extended private key
+		k := bip32Ed25519KeyFromSeed(seed[:])
+
+		pub, err := k.Public()
+		require.NoError(t, err)
+		require.Equal(t, PublicKey(secrets.SignatureVerifier), pub.PublicKey)
+
+		msg := make([]byte, i*17)
+		RandBytes(msg)
+		sig, err := k.SignBytes(msg)
+		require.NoError(t, err)
+		require.Equal(t, secrets.SignBytes(msg), sig)
+	}
+}
+
+func TestBIP32Ed25519Derivation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	seed := make([]byte, 64)
+	RandBytes(seed)
+	master := BIP32Ed25519MasterKey(seed)
+	require.Equal(t, master, BIP32Ed25519MasterKey(seed))
+	require.Zero(t, master.KL[0]&0x07)
+	require.Equal(t, byte(0x40), master.KL[31]&0xe0)
+
+	account, err := master.DerivePath(BIP44AccountPath(3))
+	require.NoError(t, err)
+	accountPub, err := account.Public()
+	require.NoError(t, err)
+
+	// Hardened children can't be derived from a public key
+	_, err = accountPub.Child(BIP32HardenedOffset)
+	require.Error(t, err)
+
+	seen := make(map[PublicKey]bool)
+	for i := uint32(0); i < 5; i++ {
+		key, err := master.DerivePath(BIP44Path(3, i))
+		require.NoError(t, err)
+		pub, err := key.Public()
+		require.NoError(t, err)
+		require.False(t, seen[pub.PublicKey])
+		seen[pub.PublicKey] = true
+
+		// Public derivation gives the same public keys
+		watchPub, err := accountPub.DerivePath([]uint32{0, i})
+		require.NoError(t, err)
+		require.Equal(t, pub, watchPub)
+
+		msg := []byte("derived key signature")
+		sig, err := key.SignBytes(msg)
+		require.NoError(t, err)
+		require.True(t, SignatureVerifier(pub.PublicKey).VerifyBytes(msg, sig))
+		require.False(t, SignatureVerifier(accountPub.PublicKey).VerifyBytes(msg, sig))
+	}
+
+	// Another account derives other keys
+	other, err := master.DerivePath(BIP44Path(4, 0))
+	require.NoError(t, err)
+	otherPub, err := other.Public()
+	require.NoError(t, err)
+	require.False(t, seen[otherPub.PublicKey])
+}
+
+// bip32Ed25519KeyFromSeed expands an Ed25519 seed the way RFC 8032 does
+func bip32Ed25519KeyFromSeed(seed []byte) (k BIP32Ed25519PrivateKey) {
+	h := sha512.Sum512(seed)
+	copy(k.KL[:], h[:32])
+	copy(k.KR[:], h[32:])
+	k.KL[0] &= 0xf8
+	k.KL[31] &= 0x7f
+	k.KL[31] |= 0x40
+	return
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestBIP32Ed25519SignKnownAnswer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Test vectors 1 and 2 of RFC 8032, section 7.1
+	vectors := []struct {
+		seed, pub, msg, sig string
+	}{
+		{
+			seed: "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+			pub:  "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			msg:  "",
+			sig:  "e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+		},
+		{
+			seed: "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+			pub:  "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+			msg:  "72",
+			sig:  "92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+		},
+	}
+	for _, v := range vectors {
+		k := bip32Ed25519KeyFromSeed(mustDecodeHex(t, v.seed))
+		pub, err := k.Public()
+		require.NoError(t, err)
+		require.Equal(t, mustDecodeHex(t, v.pub), pub.PublicKey[:])
+
+		sig, err := k.SignBytes(mustDecodeHex(t, v.msg))
+		require.NoError(t, err)
+		require.Equal(t, mustDecodeHex(t, v.sig), sig[:])
+	}
+}
+
+func TestBIP32Ed25519DerivationKnownAnswer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// The expected keys were computed with a separate big-integer
+	// implementation of the derivation in the Khovratovich and Law paper, with
+	// the hardware wallet root derivation, to pin down the byte layout of the
+	// HMAC inputs, the 28-byte child tweak and the seed retry loop.
+	seed := make([]byte, 64)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	master := BIP32Ed25519MasterKey(seed)
+	require.Equal(t, mustDecodeHex(t, "78c6fea3905478df64979029b7c59fcd8227e1c1f8ddb1a85ef779c4d0da7250"), master.KL[:])
+	require.Equal(t, mustDecodeHex(t, "eb473adff635018770de7d2fa416c43701424dcdc04b6b7f0bd6f83085ed4489"), master.KR[:])
+	require.Equal(t, mustDecodeHex(t, "9df09053416e9189f3208dd7911d12a10c870372b5dbe3d2166aeddafe40e6eb"), master.ChainCode[:])
+	masterPub, err := master.Public()
+	require.NoError(t, err)
+	require.Equal(t, mustDecodeHex(t, "1da32e4e1006db0e34a34de26e0527d407e3f917597c7d34c1c5007905860fe3"), masterPub.PublicKey[:])
+
+	account, err := master.DerivePath(BIP44AccountPath(0))
+	require.NoError(t, err)
+	accountPub, err := account.Public()
+	require.NoError(t, err)
+	require.Equal(t, mustDecodeHex(t, "66865088fc8e40a2034b01ed140ddfb15ca2098f81b34d07207d1bb2f093bf4b"), accountPub.PublicKey[:])
+	require.Equal(t, mustDecodeHex(t, "72f0415233b271d5e8071876fca8ee21e9f2729aa67adf9464fb64b9a2b939da"), accountPub.ChainCode[:])
+
+	vectors := []struct {
+		index    uint32
+		pub, sig string
+	}{
+		{
+			index: 0,
+			pub:   "cefa8c73f63bd2230d3aff6031923c5f9e0534432cb13172bef66d9e49fc4700",
+			sig:   "0d8d82495fab3f18c4f7267e241c0262904a1a9d3c71fe298703dafce6fd7ee11363bee67d52e8fdae41ca0091a8cc4be76185ed769fa7357ea320407ea9b402",
+		},
+		{
+			index: 1,
+			pub:   "9b81d78755056225ec7c884e0ea9dcd9edf73f22e62c17592d240b94dc3b5728",
+			sig:   "0f31e9ffe565313f442b9c36bc1cefd5ca523fa5f364e5b70a4b891d9f6b64a05990077e0a73915d7c0b41eaf15e4e7097e240295aae2d7d19de639e21f5bf00",
+		},
+	}
+	for _, v := range vectors {
+		key, err := master.DerivePath(BIP44Path(0, v.index))
+		require.NoError(t, err)
+		pub, err := key.Public()
+		require.NoError(t, err)
+		require.Equal(t, mustDecodeHex(t, v.pub), pub.PublicKey[:])
+
+		watchPub, err := accountPub.DerivePath([]uint32{0, v.index})
+		require.NoError(t, err)
+		require.Equal(t, pub.PublicKey, watchPub.PublicKey)
+
+		sig, err := key.SignBytes([]byte("BIP32-Ed25519"))
+		require.NoError(t, err)
+		require.Equal(t, mustDecodeHex(t, v.sig), sig[:])
+	}
+}
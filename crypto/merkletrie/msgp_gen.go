@@ -0,0 +1,641 @@
+package merkletrie
+
+// Code generated by github.com/algorand/msgp DO NOT EDIT.
+
+import (
+	"github.com/algorand/msgp/msgp"
+)
+
+// The following msgp objects are implemented in this file:
+// RangeProof
+//      |-----> (*) MarshalMsg
+//      |-----> (*) CanMarshalMsg
+//      |-----> (*) UnmarshalMsg
+//      |-----> (*) CanUnmarshalMsg
+//      |-----> (*) Msgsize
+//      |-----> (*) MsgIsZero
+//
+// RangeProofChild
+//        |-----> (*) MarshalMsg
+//        |-----> (*) CanMarshalMsg
+//        |-----> (*) UnmarshalMsg
+//        |-----> (*) CanUnmarshalMsg
+//        |-----> (*) Msgsize
+//        |-----> (*) MsgIsZero
+//
+// RangeProofNode
+//        |-----> (*) MarshalMsg
+//        |-----> (*) CanMarshalMsg
+//        |-----> (*) UnmarshalMsg
+//        |-----> (*) CanUnmarshalMsg
+//        |-----> (*) Msgsize
+//        |-----> (*) MsgIsZero
+//
+// storedNodeIdentifier
+//           |-----> MarshalMsg
+//           |-----> CanMarshalMsg
+//           |-----> (*) UnmarshalMsg
+//           |-----> (*) CanUnmarshalMsg
+//           |-----> Msgsize
+//           |-----> MsgIsZero
+//
+
+// MarshalMsg implements msgp.Marshaler
+func (z *RangeProof) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(1)
+	var zb0002Mask uint8 /* 2 bits */
+	if len((*z).Nodes) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "n"
+			o = append(o, 0xa1, 0x6e)
+			if (*z).Nodes == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Nodes)))
+			}
+			for zb0001 := range (*z).Nodes {
+				o = (*z).Nodes[zb0001].MarshalMsg(o)
+			}
+		}
+	}
+	return
+}
+
+func (_ *RangeProof) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*RangeProof)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *RangeProof) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			var zb0005 bool
+			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Nodes")
+				return
+			}
+			if zb0004 > MaxRangeProofNodes {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(MaxRangeProofNodes))
+				err = msgp.WrapError(err, "struct-from-array", "Nodes")
+				return
+			}
+			if zb0005 {
+				(*z).Nodes = nil
+			} else if (*z).Nodes != nil && cap((*z).Nodes) >= zb0004 {
+				(*z).Nodes = ((*z).Nodes)[:zb0004]
+			} else {
+				(*z).Nodes = make([]RangeProofNode, zb0004)
+			}
+			for zb0001 := range (*z).Nodes {
+				bts, err = (*z).Nodes[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = RangeProof{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "n":
+				var zb0006 int
+				var zb0007 bool
+				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Nodes")
+					return
+				}
+				if zb0006 > MaxRangeProofNodes {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(MaxRangeProofNodes))
+					err = msgp.WrapError(err, "Nodes")
+					return
+				}
+				if zb0007 {
+					(*z).Nodes = nil
+				} else if (*z).Nodes != nil && cap((*z).Nodes) >= zb0006 {
+					(*z).Nodes = ((*z).Nodes)[:zb0006]
+				} else {
+					(*z).Nodes = make([]RangeProofNode, zb0006)
+				}
+				for zb0001 := range (*z).Nodes {
+					bts, err = (*z).Nodes[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Nodes", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *RangeProof) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*RangeProof)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *RangeProof) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Nodes {
+		s += (*z).Nodes[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *RangeProof) MsgIsZero() bool {
+	return (len((*z).Nodes) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *RangeProofChild) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 4 bits */
+	if len((*z).Hash) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if (*z).Index == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if (*z).Leaf == false {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "h"
+			o = append(o, 0xa1, 0x68)
+			o = msgp.AppendBytes(o, (*z).Hash)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "i"
+			o = append(o, 0xa1, 0x69)
+			o = msgp.AppendByte(o, (*z).Index)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "l"
+			o = append(o, 0xa1, 0x6c)
+			o = msgp.AppendBool(o, (*z).Leaf)
+		}
+	}
+	return
+}
+
+func (_ *RangeProofChild) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*RangeProofChild)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *RangeProofChild) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Index, bts, err = msgp.ReadByteBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Index")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Leaf, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Leaf")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0003 int
+			zb0003, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Hash")
+				return
+			}
+			if zb0003 > MaxRangeProofHashLength {
+				err = msgp.ErrOverflow(uint64(zb0003), uint64(MaxRangeProofHashLength))
+				return
+			}
+			(*z).Hash, bts, err = msgp.ReadBytesBytes(bts, (*z).Hash)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Hash")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = RangeProofChild{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "i":
+				(*z).Index, bts, err = msgp.ReadByteBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Index")
+					return
+				}
+			case "l":
+				(*z).Leaf, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Leaf")
+					return
+				}
+			case "h":
+				var zb0004 int
+				zb0004, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Hash")
+					return
+				}
+				if zb0004 > MaxRangeProofHashLength {
+					err = msgp.ErrOverflow(uint64(zb0004), uint64(MaxRangeProofHashLength))
+					return
+				}
+				(*z).Hash, bts, err = msgp.ReadBytesBytes(bts, (*z).Hash)
+				if err != nil {
+					err = msgp.WrapError(err, "Hash")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *RangeProofChild) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*RangeProofChild)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *RangeProofChild) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ByteSize + 2 + msgp.BoolSize + 2 + msgp.BytesPrefixSize + len((*z).Hash)
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *RangeProofChild) MsgIsZero() bool {
+	return ((*z).Index == 0) && ((*z).Leaf == false) && (len((*z).Hash) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *RangeProofNode) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(3)
+	var zb0002Mask uint8 /* 4 bits */
+	if len((*z).Children) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	if (*z).Leaf == false {
+		zb0002Len--
+		zb0002Mask |= 0x4
+	}
+	if len((*z).Path) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x8
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "c"
+			o = append(o, 0xa1, 0x63)
+			if (*z).Children == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Children)))
+			}
+			for zb0001 := range (*z).Children {
+				o = (*z).Children[zb0001].MarshalMsg(o)
+			}
+		}
+		if (zb0002Mask & 0x4) == 0 { // if not empty
+			// string "l"
+			o = append(o, 0xa1, 0x6c)
+			o = msgp.AppendBool(o, (*z).Leaf)
+		}
+		if (zb0002Mask & 0x8) == 0 { // if not empty
+			// string "p"
+			o = append(o, 0xa1, 0x70)
+			o = msgp.AppendBytes(o, (*z).Path)
+		}
+	}
+	return
+}
+
+func (_ *RangeProofNode) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*RangeProofNode)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *RangeProofNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			zb0004, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Path")
+				return
+			}
+			if zb0004 > MaxRangeProofPathLength {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(MaxRangeProofPathLength))
+				return
+			}
+			(*z).Path, bts, err = msgp.ReadBytesBytes(bts, (*z).Path)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Path")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			(*z).Leaf, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Leaf")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0005 int
+			var zb0006 bool
+			zb0005, zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Children")
+				return
+			}
+			if zb0005 > MaxRangeProofChildren {
+				err = msgp.ErrOverflow(uint64(zb0005), uint64(MaxRangeProofChildren))
+				err = msgp.WrapError(err, "struct-from-array", "Children")
+				return
+			}
+			if zb0006 {
+				(*z).Children = nil
+			} else if (*z).Children != nil && cap((*z).Children) >= zb0005 {
+				(*z).Children = ((*z).Children)[:zb0005]
+			} else {
+				(*z).Children = make([]RangeProofChild, zb0005)
+			}
+			for zb0001 := range (*z).Children {
+				bts, err = (*z).Children[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Children", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = RangeProofNode{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "p":
+				var zb0007 int
+				zb0007, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Path")
+					return
+				}
+				if zb0007 > MaxRangeProofPathLength {
+					err = msgp.ErrOverflow(uint64(zb0007), uint64(MaxRangeProofPathLength))
+					return
+				}
+				(*z).Path, bts, err = msgp.ReadBytesBytes(bts, (*z).Path)
+				if err != nil {
+					err = msgp.WrapError(err, "Path")
+					return
+				}
+			case "l":
+				(*z).Leaf, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Leaf")
+					return
+				}
+			case "c":
+				var zb0008 int
+				var zb0009 bool
+				zb0008, zb0009, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Children")
+					return
+				}
+				if zb0008 > MaxRangeProofChildren {
+					err = msgp.ErrOverflow(uint64(zb0008), uint64(MaxRangeProofChildren))
+					err = msgp.WrapError(err, "Children")
+					return
+				}
+				if zb0009 {
+					(*z).Children = nil
+				} else if (*z).Children != nil && cap((*z).Children) >= zb0008 {
+					(*z).Children = ((*z).Children)[:zb0008]
+				} else {
+					(*z).Children = make([]RangeProofChild, zb0008)
+				}
+				for zb0001 := range (*z).Children {
+					bts, err = (*z).Children[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Children", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *RangeProofNode) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*RangeProofNode)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *RangeProofNode) Msgsize() (s int) {
+	s = 1 + 2 + msgp.BytesPrefixSize + len((*z).Path) + 2 + msgp.BoolSize + 2 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Children {
+		s += (*z).Children[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *RangeProofNode) MsgIsZero() bool {
+	return (len((*z).Path) == 0) && ((*z).Leaf == false) && (len((*z).Children) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z storedNodeIdentifier) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendUint64(o, uint64(z))
+	return
+}
+
+func (_ storedNodeIdentifier) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(storedNodeIdentifier)
+	if !ok {
+		_, ok = (z).(*storedNodeIdentifier)
+	}
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *storedNodeIdentifier) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 uint64
+		zb0001, bts, err = msgp.ReadUint64Bytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = storedNodeIdentifier(zb0001)
+	}
+	o = bts
+	return
+}
+
+func (_ *storedNodeIdentifier) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*storedNodeIdentifier)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z storedNodeIdentifier) Msgsize() (s int) {
+	s = msgp.Uint64Size
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z storedNodeIdentifier) MsgIsZero() bool {
+	return z == 0
+}
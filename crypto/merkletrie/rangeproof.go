@@ -0,0 +1,278 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+const (
+	// MaxRangeProofPathLength is the maximal length of the path of a node in a range proof, which is the maximal
+	// length of the elements of a trie it could prove.
+	MaxRangeProofPathLength = 64
+
+	// MaxRangeProofNodes is the maximal number of nodes in a range proof: the nodes on the paths of the first and
+	// the last elements of the range.
+	MaxRangeProofNodes = 2 * (MaxRangeProofPathLength + 1)
+
+	// MaxRangeProofHashLength is the maximal length of the hash of a child in a range proof. The hash of a leaf
+	// child is the remainder of its element, and the hash of a non-leaf child is a digest.
+	MaxRangeProofHashLength = MaxRangeProofPathLength
+
+	// MaxRangeProofChildren is the maximal number of children of a node in a range proof.
+	MaxRangeProofChildren = 256
+)
+
+// ErrRangeProofMismatch is returned when the elements of a range and its proof don't add up to the expected root hash.
+var ErrRangeProofMismatch = errors.New("range proof does not match the root hash")
+
+// RangeProofChild is a child of a range proof node which lies outside of the proven range.
+type RangeProofChild struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Index byte   `codec:"i"`
+	Leaf  bool   `codec:"l"`
+	Hash  []byte `codec:"h,allocbound=MaxRangeProofHashLength"`
+}
+
+// RangeProofNode is a node on the path of either the first or the last element of a proven range. It lists the
+// children of the node which lie outside of the range; the other children are calculated from the range elements.
+type RangeProofNode struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Path     []byte            `codec:"p,allocbound=MaxRangeProofPathLength"`
+	Leaf     bool              `codec:"l"`
+	Children []RangeProofChild `codec:"c,allocbound=MaxRangeProofChildren"`
+}
+
+// RangeProof proves that a sorted list of elements is the complete list of the trie elements between the first and
+// the last of them, against the root hash of the trie.
+type RangeProof struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Nodes []RangeProofNode `codec:"n,allocbound=MaxRangeProofNodes"`
+}
+
+// RangeProof returns the proof that the elements of the trie between first and last, both included, are the
+// elements of the range. first and last are expected to be elements of the trie.
+func (mt *Trie) RangeProof(first, last []byte) (proof RangeProof, err error) {
+	if mt.root == storedNodeIdentifierNull {
+		return RangeProof{}, fmt.Errorf("unable to prove a range of an empty trie")
+	}
+	if len(first) != mt.elementLength || len(last) != mt.elementLength {
+		return RangeProof{}, ErrMismatchingElementLength
+	}
+	if bytes.Compare(first, last) > 0 {
+		return RangeProof{}, fmt.Errorf("the first element of the range %x is greater than the last one %x", first, last)
+	}
+	if mt.cache.modified {
+		if _, err = mt.Commit(); err != nil {
+			return RangeProof{}, err
+		}
+	}
+	pnode, err := mt.cache.getNode(mt.root)
+	if err != nil {
+		return RangeProof{}, err
+	}
+	err = pnode.rangeProof(mt.cache, first, last, make([]byte, 0, len(first)), true, true, &proof)
+	return proof, err
+}
+
+// rangeProof adds the current node, which lies on the path of either the first or the last element of the range,
+// to the proof, and then recursively adds its children which lie on these paths as well.
+func (n *node) rangeProof(cache *merkleTrieCache, first, last []byte, path []byte, left, right bool, proof *RangeProof) error {
+	if n.leaf() {
+		proof.Nodes = append(proof.Nodes, RangeProofNode{Path: append([]byte{}, path...), Leaf: true})
+		return nil
+	}
+	depth := len(path)
+	pnode := RangeProofNode{Path: append([]byte{}, path...)}
+	var boundaryChildren []childEntry
+	for _, child := range n.children {
+		if (left && child.hashIndex < first[depth]) || (right && child.hashIndex > last[depth]) {
+			childNode, err := cache.getNode(child.id)
+			if err != nil {
+				return err
+			}
+			pnode.Children = append(pnode.Children, RangeProofChild{
+				Index: child.hashIndex,
+				Leaf:  childNode.leaf(),
+				Hash:  append([]byte{}, childNode.hash...),
+			})
+			continue
+		}
+		if (left && child.hashIndex == first[depth]) || (right && child.hashIndex == last[depth]) {
+			boundaryChildren = append(boundaryChildren, child)
+		}
+	}
+	proof.Nodes = append(proof.Nodes, pnode)
+
+	for _, child := range boundaryChildren {
+		childNode, err := cache.getNode(child.id)
+		if err != nil {
+			return err
+		}
+		childLeft := left && child.hashIndex == first[depth]
+		childRight := right && child.hashIndex == last[depth]
+		err = childNode.rangeProof(cache, first, last, append(path, child.hashIndex), childLeft, childRight, proof)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyRangeProof verifies that the given elements, sorted in ascending order, are all the elements between the
+// first and the last of them of the trie with the given root hash.
+func VerifyRangeProof(root crypto.Digest, elements [][]byte, proof *RangeProof) error {
+	if len(elements) == 0 {
+		return fmt.Errorf("unable to verify an empty range")
+	}
+	elementLength := len(elements[0])
+	if elementLength == 0 || elementLength > MaxRangeProofPathLength {
+		return ErrMismatchingElementLength
+	}
+	for i := range elements {
+		if len(elements[i]) != elementLength {
+			return ErrMismatchingElementLength
+		}
+		if i > 0 && bytes.Compare(elements[i-1], elements[i]) >= 0 {
+			return fmt.Errorf("the range elements are not sorted: %x follows %x", elements[i], elements[i-1])
+		}
+	}
+
+	v := rangeProofVerifier{
+		first: elements[0],
+		last:  elements[len(elements)-1],
+		nodes: make(map[string]*RangeProofNode, len(proof.Nodes)),
+	}
+	for i := range proof.Nodes {
+		path := string(proof.Nodes[i].Path)
+		if _, has := v.nodes[path]; has {
+			return fmt.Errorf("the range proof has multiple nodes with the path %x", path)
+		}
+		v.nodes[path] = &proof.Nodes[i]
+	}
+
+	leaf, hash, err := v.node(make([]byte, 0, elementLength), elements, true, true)
+	if err != nil {
+		return err
+	}
+	var rootHash crypto.Digest
+	if leaf {
+		rootHash = crypto.Hash(append([]byte{0}, hash...))
+	} else {
+		rootHash = crypto.Hash(append([]byte{1}, hash...))
+	}
+	if rootHash != root {
+		return ErrRangeProofMismatch
+	}
+	return nil
+}
+
+type rangeProofVerifier struct {
+	first []byte
+	last  []byte
+	nodes map[string]*RangeProofNode
+}
+
+type rangeProofChild struct {
+	present bool
+	leaf    bool
+	hash    []byte
+}
+
+// node calculates the hash of the node at the given path, which holds the given elements of the range. The nodes on
+// the paths of the first and the last elements of the range are taken from the proof, as they may have children
+// outside of the range.
+func (v *rangeProofVerifier) node(path []byte, elements [][]byte, left, right bool) (leaf bool, hash []byte, err error) {
+	var pnode *RangeProofNode
+	if left || right {
+		pnode = v.nodes[string(path)]
+		if pnode == nil {
+			return false, nil, fmt.Errorf("the range proof is missing the node with the path %x", path)
+		}
+		if pnode.Leaf {
+			if len(elements) != 1 {
+				return false, nil, ErrRangeProofMismatch
+			}
+			return true, elements[0][len(path):], nil
+		}
+	} else if len(elements) == 1 {
+		return true, elements[0][len(path):], nil
+	}
+
+	depth := len(path)
+	if depth >= len(elements[0]) {
+		return false, nil, ErrRangeProofMismatch
+	}
+	var children [256]rangeProofChild
+	if pnode != nil {
+		for _, child := range pnode.Children {
+			if !(left && child.Index < v.first[depth]) && !(right && child.Index > v.last[depth]) {
+				return false, nil, fmt.Errorf("the range proof node with the path %x has the child %d within the range", path, child.Index)
+			}
+			if children[child.Index].present {
+				return false, nil, fmt.Errorf("the range proof node with the path %x has multiple children %d", path, child.Index)
+			}
+			children[child.Index] = rangeProofChild{present: true, leaf: child.Leaf, hash: child.Hash}
+		}
+	}
+	for start := 0; start < len(elements); {
+		index := elements[start][depth]
+		end := start + 1
+		for end < len(elements) && elements[end][depth] == index {
+			end++
+		}
+		if children[index].present {
+			return false, nil, fmt.Errorf("the range proof node with the path %x has the child %d within the range", path, index)
+		}
+		childLeft := left && index == v.first[depth]
+		childRight := right && index == v.last[depth]
+		childPath := append(path[:depth:depth], index)
+		childLeaf, childHash, err := v.node(childPath, elements[start:end], childLeft, childRight)
+		if err != nil {
+			return false, nil, err
+		}
+		children[index] = rangeProofChild{present: true, leaf: childLeaf, hash: childHash}
+		start = end
+	}
+
+	// this follows the calculation of node.calculateHash
+	hashAccumulator := make([]byte, 0, 1+len(path)+len(elements)*(3+crypto.DigestSize))
+	hashAccumulator = append(hashAccumulator, byte(len(path)))
+	hashAccumulator = append(hashAccumulator, path...)
+	for i := range children {
+		if !children[i].present {
+			continue
+		}
+		if children[i].leaf {
+			hashAccumulator = append(hashAccumulator, byte(0))
+		} else {
+			hashAccumulator = append(hashAccumulator, byte(1))
+		}
+		hashAccumulator = append(hashAccumulator, byte(len(children[i].hash)))
+		hashAccumulator = append(hashAccumulator, byte(i))
+		hashAccumulator = append(hashAccumulator, children[i].hash...)
+	}
+	nodeHash := crypto.Hash(hashAccumulator)
+	return false, nodeHash[:], nil
+}
@@ -0,0 +1,178 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeRangeProofTestTrie(t *testing.T, count int, seed int64) (*Trie, [][]byte) {
+	rnd := rand.New(rand.NewSource(seed))
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+
+	elements := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		hash := crypto.Hash([]byte{byte(i), byte(i >> 8), byte(seed)})
+		added, err := mt.Add(hash[:])
+		require.NoError(t, err)
+		require.True(t, added)
+		elements = append(elements, hash[:])
+	}
+	// delete some of the elements, so that the trie is not only the result of additions.
+	remaining := elements[:0]
+	for _, element := range elements {
+		if rnd.Intn(4) == 0 {
+			deleted, err := mt.Delete(element)
+			require.NoError(t, err)
+			require.True(t, deleted)
+			continue
+		}
+		remaining = append(remaining, element)
+	}
+	sort.Slice(remaining, func(i, j int) bool { return bytes.Compare(remaining[i], remaining[j]) < 0 })
+	return mt, remaining
+}
+
+func TestRangeProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, count := range []int{1, 2, 3, 50, 2000} {
+		mt, elements := makeRangeProofTestTrie(t, count, int64(count))
+		if len(elements) == 0 {
+			continue
+		}
+		root, err := mt.RootHash()
+		require.NoError(t, err)
+
+		rnd := rand.New(rand.NewSource(int64(count)))
+		ranges := [][2]int{{0, len(elements) - 1}, {0, 0}, {len(elements) - 1, len(elements) - 1}}
+		for i := 0; i < 20; i++ {
+			first := rnd.Intn(len(elements))
+			last := first + rnd.Intn(len(elements)-first)
+			ranges = append(ranges, [2]int{first, last})
+		}
+		for _, r := range ranges {
+			proof, err := mt.RangeProof(elements[r[0]], elements[r[1]])
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(proof.Nodes), MaxRangeProofNodes)
+
+			// the proof survives an encoding round trip.
+			var decoded RangeProof
+			require.NoError(t, protocol.DecodeReflect(protocol.EncodeReflect(&proof), &decoded))
+
+			rangeElements := elements[r[0] : r[1]+1]
+			require.NoError(t, VerifyRangeProof(root, rangeElements, &decoded), "range %v of %d", r, len(elements))
+
+			// a wrong root hash fails the verification.
+			wrongRoot := root
+			wrongRoot[0]++
+			require.Error(t, VerifyRangeProof(wrongRoot, rangeElements, &proof))
+
+			// a modified element fails the verification.
+			modified := make([][]byte, len(rangeElements))
+			copy(modified, rangeElements)
+			index := rnd.Intn(len(modified))
+			modified[index] = append([]byte{}, modified[index]...)
+			modified[index][len(modified[index])-1]++
+			if sort.SliceIsSorted(modified, func(i, j int) bool { return bytes.Compare(modified[i], modified[j]) < 0 }) {
+				require.Error(t, VerifyRangeProof(root, modified, &proof))
+			}
+
+			// an omitted element fails the verification.
+			if len(rangeElements) > 2 {
+				omitted := append(append([][]byte{}, rangeElements[:1]...), rangeElements[2:]...)
+				require.Error(t, VerifyRangeProof(root, omitted, &proof))
+			}
+		}
+	}
+}
+
+func TestRangeProofAfterUpdate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mt, elements := makeRangeProofTestTrie(t, 500, 1)
+	oldRoot, err := mt.RootHash()
+	require.NoError(t, err)
+
+	// adding an element without committing still produces a proof of the new root.
+	hash := crypto.Hash([]byte("new element"))
+	added, err := mt.Add(hash[:])
+	require.NoError(t, err)
+	require.True(t, added)
+	elements = append(elements, hash[:])
+	sort.Slice(elements, func(i, j int) bool { return bytes.Compare(elements[i], elements[j]) < 0 })
+
+	proof, err := mt.RangeProof(elements[0], elements[len(elements)-1])
+	require.NoError(t, err)
+	newRoot, err := mt.RootHash()
+	require.NoError(t, err)
+	require.NotEqual(t, oldRoot, newRoot)
+	require.NoError(t, VerifyRangeProof(newRoot, elements, &proof))
+	require.Error(t, VerifyRangeProof(oldRoot, elements, &proof))
+}
+
+func TestRangeProofErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+	hash := crypto.Hash([]byte("element"))
+	_, err = mt.RangeProof(hash[:], hash[:])
+	require.Error(t, err)
+
+	mt, elements := makeRangeProofTestTrie(t, 100, 2)
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	_, err = mt.RangeProof(elements[1], elements[0])
+	require.Error(t, err)
+	_, err = mt.RangeProof(elements[0][:10], elements[1])
+	require.ErrorIs(t, err, ErrMismatchingElementLength)
+
+	proof, err := mt.RangeProof(elements[0], elements[10])
+	require.NoError(t, err)
+	require.Error(t, VerifyRangeProof(root, nil, &proof))
+	// unsorted elements
+	unsorted := append([][]byte{}, elements[:11]...)
+	unsorted[3], unsorted[4] = unsorted[4], unsorted[3]
+	require.Error(t, VerifyRangeProof(root, unsorted, &proof))
+	// duplicate proof nodes
+	duplicated := RangeProof{Nodes: append(append([]RangeProofNode{}, proof.Nodes...), proof.Nodes[0])}
+	require.Error(t, VerifyRangeProof(root, elements[:11], &duplicated))
+	// a missing proof node
+	truncated := RangeProof{Nodes: proof.Nodes[:len(proof.Nodes)-1]}
+	require.Error(t, VerifyRangeProof(root, elements[:11], &truncated))
+	// a proof child claiming to be within the range
+	require.NotEmpty(t, proof.Nodes[0].Children)
+	inRange := RangeProof{Nodes: append([]RangeProofNode{}, proof.Nodes...)}
+	inRange.Nodes[0].Children = append([]RangeProofChild{}, inRange.Nodes[0].Children...)
+	inRange.Nodes[0].Children[0].Index = elements[5][0]
+	require.Error(t, VerifyRangeProof(root, elements[:11], &inRange))
+}
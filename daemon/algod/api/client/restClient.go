@@ -290,6 +290,13 @@ func (client RestClient) HealthCheck() error {
 	return client.get(nil, "/health", nil)
 }
 
+// HealthDetails returns the outcomes of the health checks of the node, including whether it's ready to serve
+// requests.
+func (client RestClient) HealthDetails() (response model.HealthReport, err error) {
+	err = client.get(&response, "/health/details", nil)
+	return
+}
+
 // StatusAfterBlock waits for a block to occur then returns the StatusResponse after that block
 // blocks on the node end
 // Not supported
@@ -565,6 +572,11 @@ func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string,
 	return
 }
 
+// Metrics returns the metrics of the node, in the text format of /metrics.
+func (client RestClient) Metrics(ctx context.Context) (string, error) {
+	return client.doGetWithQuery(ctx, "/metrics", nil)
+}
+
 // Compile compiles the given program and returned the compiled program
 func (client RestClient) Compile(program []byte) (compiledProgram []byte, programHash crypto.Digest, err error) {
 	var compileResponse model.CompileResponse
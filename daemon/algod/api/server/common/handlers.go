@@ -89,6 +89,51 @@ func HealthCheck(ctx lib.ReqContext, context echo.Context) {
 	json.NewEncoder(w).Encode(nil)
 }
 
+// HealthDetails is an httpHandler for route GET /health/details
+func HealthDetails(ctx lib.ReqContext, context echo.Context) {
+	// swagger:operation GET /health/details HealthDetails
+	//---
+	//     Summary: Returns the outcome of each health check of the node.
+	//     Produces:
+	//     - application/json
+	//     Schemes:
+	//     - http
+	//     Responses:
+	//       200:
+	//         description: The outcomes of the health checks of the node.
+	//       default: { description: Unknown Error }
+	w := context.Response().Writer
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(ctx.Node.Health())
+}
+
+// ReadyCheck is an httpHandler for route GET /ready
+func ReadyCheck(ctx lib.ReqContext, context echo.Context) {
+	// swagger:operation GET /ready ReadyCheck
+	//---
+	//     Summary: Returns OK if the node is ready to serve requests.
+	//     Produces:
+	//     - application/json
+	//     Schemes:
+	//     - http
+	//     Responses:
+	//       200:
+	//         description: None of the health checks of the node failed.
+	//       503:
+	//         description: Some of the health checks of the node failed.
+	//       default: { description: Unknown Error }
+	report := ctx.Node.Health()
+	status := http.StatusOK
+	if !report.Ready {
+		status = http.StatusServiceUnavailable
+	}
+	w := context.Response().Writer
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(report)
+}
+
 // VersionsHandler is an httpHandler for route GET /versions
 func VersionsHandler(ctx lib.ReqContext, context echo.Context) {
 	// swagger:route GET /versions GetVersion
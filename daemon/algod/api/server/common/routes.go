@@ -41,6 +41,20 @@ var Routes = lib.Routes{
 		HandlerFunc: HealthCheck,
 	},
 
+	lib.Route{
+		Name:        "healthdetails",
+		Method:      "GET",
+		Path:        "/health/details",
+		HandlerFunc: HealthDetails,
+	},
+
+	lib.Route{
+		Name:        "ready",
+		Method:      "GET",
+		Path:        "/ready",
+		HandlerFunc: ReadyCheck,
+	},
+
 	lib.Route{
 		Name:        "swagger.json",
 		Method:      "GET",
@@ -23,6 +23,8 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 // TokenPathParam is the name of the path parameter used by URLAuthPrefix
@@ -42,18 +44,33 @@ type AuthMiddleware struct {
 
 	// Tokens is the set of tokens which can be set to allow access.
 	tokens [][]byte
+
+	// scoped checks the scoped tokens, if not nil.
+	scoped *tokens.ScopedTokenStore
+
+	// admin is set when the tokens are the admin tokens, which scoped tokens can
+	// only replace if they have admin rights.
+	admin bool
 }
 
 // MakeAuth constructs the auth middleware function
 func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
+	return MakeScopedAuth(header, tokens, nil, false)
+}
+
+// MakeScopedAuth constructs the auth middleware function, which also accepts
+// the scoped tokens of the store allowed to use the requested endpoint.
+func MakeScopedAuth(header string, apiTokens []string, scoped *tokens.ScopedTokenStore, admin bool) echo.MiddlewareFunc {
 	apiTokenBytes := make([][]byte, 0)
-	for _, token := range tokens {
+	for _, token := range apiTokens {
 		apiTokenBytes = append(apiTokenBytes, []byte(token))
 	}
 
 	auth := AuthMiddleware{
 		header: header,
 		tokens: apiTokenBytes,
+		scoped: scoped,
+		admin:  admin,
 	}
 
 	return auth.handler
@@ -69,14 +86,12 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 		}
 
 		// Grab the apiToken from the HTTP header, or as a bearer token
-		providedToken := []byte(ctx.Request().Header.Get(auth.header))
-		if len(providedToken) == 0 {
-			// Accept tokens provided in a bearer token format.
-			authentication := strings.SplitN(ctx.Request().Header.Get("Authorization"), " ", 2)
-			if len(authentication) == 2 && strings.EqualFold("Bearer", authentication[0]) {
-				providedToken = []byte(authentication[1])
-			}
-		}
+		providedToken := []byte(requestToken(ctx, auth.header))
+
+		// Scoped tokens are checked against the matched route rather than the
+		// URL, which holds the token with /urlAuth and the values of the path
+		// parameters.
+		route := ctx.Path()
 
 		// Handle debug routes with /urlAuth/:token prefix.
 		if ctx.Param(TokenPathParam) != "" {
@@ -91,6 +106,8 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			newPath := strings.TrimPrefix(ctx.Request().URL.Path, authPrefix)
 			ctx.SetPath(newPath)
 			ctx.Request().URL.Path = newPath
+			// /urlAuth/:token/debug/pprof/* => /debug/pprof/*
+			route = strings.TrimPrefix(route, URLAuthPrefix)
 		}
 
 		// Check the tokens in constant time
@@ -101,6 +118,34 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			}
 		}
 
+		// Check the scoped tokens
+		if auth.scoped != nil && len(providedToken) > 0 {
+			err := auth.scoped.Authorize(string(providedToken), ctx.Request().Method, route, auth.admin)
+			switch err {
+			case nil:
+				return next(ctx)
+			case tokens.ErrScopedTokenForbidden:
+				return echo.NewHTTPError(http.StatusForbidden, err.Error())
+			case tokens.ErrScopedTokenRateLimited:
+				return echo.NewHTTPError(http.StatusTooManyRequests, err.Error())
+			case tokens.ErrScopedTokenExpired, tokens.ErrScopedTokenRevoked:
+				return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+			}
+		}
+
 		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
 	}
 }
+
+// requestToken returns the API token of the request, from the token header or as a bearer token.
+func requestToken(ctx echo.Context, header string) string {
+	token := ctx.Request().Header.Get(header)
+	if len(token) == 0 {
+		// Accept tokens provided in a bearer token format.
+		authentication := strings.SplitN(ctx.Request().Header.Get("Authorization"), " ", 2)
+		if len(authentication) == 2 && strings.EqualFold("Bearer", authentication[0]) {
+			token = authentication[1]
+		}
+	}
+	return token
+}
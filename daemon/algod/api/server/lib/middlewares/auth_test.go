@@ -19,9 +19,11 @@ package middlewares
 import (
 	"errors"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/tokens"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
 )
@@ -189,3 +191,67 @@ func TestAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestScopedAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	reader, err := tokens.CreateScopedToken(dir, tokens.AlgodScopedTokensFilename, tokens.ScopedToken{Name: "reader", Scopes: []string{"read"}})
+	require.NoError(t, err)
+	expired, err := tokens.CreateScopedToken(dir, tokens.AlgodScopedTokensFilename, tokens.ScopedToken{Name: "expired", Scopes: []string{"read"}, Expires: 1})
+	require.NoError(t, err)
+	limited, err := tokens.CreateScopedToken(dir, tokens.AlgodScopedTokensFilename, tokens.ScopedToken{Name: "limited", Scopes: []string{"read"}, RateLimit: 1})
+	require.NoError(t, err)
+	participation, err := tokens.CreateScopedToken(dir, tokens.AlgodScopedTokensFilename, tokens.ScopedToken{Name: "participation", Scopes: []string{"participation"}})
+	require.NoError(t, err)
+	account, err := tokens.CreateScopedToken(dir, tokens.AlgodScopedTokensFilename, tokens.ScopedToken{Name: "account", Scopes: []string{"GET /v2/accounts/:address"}})
+	require.NoError(t, err)
+	profiler, err := tokens.CreateScopedToken(dir, tokens.AlgodScopedTokensFilename, tokens.ScopedToken{Name: "profiler", Scopes: []string{"GET /debug/pprof/*"}, Admin: true})
+	require.NoError(t, err)
+	store := tokens.MakeScopedTokenStore(dir, tokens.AlgodScopedTokensFilename)
+
+	apiHandler := MakeScopedAuth(testAPIHeader, []string{"token1"}, store, false)(success)
+	adminHandler := MakeScopedAuth(testAPIHeader, []string{"admin1"}, store, true)(success)
+
+	tests := []struct {
+		name           string
+		handler        echo.HandlerFunc
+		token          string
+		method         string
+		path           string
+		route          string
+		expectResponse error
+	}{
+		{"Static token", apiHandler, "token1", "POST", "/v2/transactions", "/v2/transactions", errSuccess},
+		{"Static admin token", adminHandler, "admin1", "POST", "/v2/participation", "/v2/participation", errSuccess},
+		{"Scoped token", apiHandler, reader, "GET", "/v2/status", "/v2/status", errSuccess},
+		{"Scoped token out of scope", apiHandler, reader, "POST", "/v2/transactions", "/v2/transactions", echo.NewHTTPError(http.StatusForbidden, tokens.ErrScopedTokenForbidden.Error())},
+		{"Scoped token on admin endpoint", adminHandler, reader, "GET", "/v2/participation", "/v2/participation", echo.NewHTTPError(http.StatusForbidden, tokens.ErrScopedTokenForbidden.Error())},
+		{"Scoped admin token", adminHandler, participation, "POST", "/v2/participation", "/v2/participation", errSuccess},
+		{"Expired scoped token", apiHandler, expired, "GET", "/v2/status", "/v2/status", echo.NewHTTPError(http.StatusUnauthorized, tokens.ErrScopedTokenExpired.Error())},
+		{"Rate limited scoped token (1)", apiHandler, limited, "GET", "/v2/status", "/v2/status", errSuccess},
+		{"Rate limited scoped token (2)", apiHandler, limited, "GET", "/v2/status", "/v2/status", echo.NewHTTPError(http.StatusTooManyRequests, tokens.ErrScopedTokenRateLimited.Error())},
+		{"Unknown token", apiHandler, "invalid_token", "GET", "/v2/status", "/v2/status", invalidTokenError},
+		{"Scoped token on route", apiHandler, account, "GET", "/v2/accounts/AAAA", "/v2/accounts/:address", errSuccess},
+		{"Scoped token on other route", apiHandler, account, "GET", "/v2/accounts/AAAA/assets", "/v2/accounts/:address/assets", echo.NewHTTPError(http.StatusForbidden, tokens.ErrScopedTokenForbidden.Error())},
+		{"Scoped token in url", adminHandler, profiler, "GET", "/urlAuth/" + profiler + "/debug/pprof/heap", URLAuthPrefix + "/debug/pprof/*", errSuccess},
+		{"Scoped token in url out of scope", adminHandler, reader, "GET", "/urlAuth/" + reader + "/debug/pprof/heap", URLAuthPrefix + "/debug/pprof/*", echo.NewHTTPError(http.StatusForbidden, tokens.ErrScopedTokenForbidden.Error())},
+	}
+
+	for _, test := range tests {
+		req, _ := http.NewRequest(test.method, "http://my-node.com:80"+test.path, nil)
+		ctx := e.NewContext(req, nil)
+
+		// There is no router to update the context based on the url, so do it manually.
+		if strings.HasPrefix(test.route, URLAuthPrefix) {
+			ctx.SetParamNames(TokenPathParam)
+			ctx.SetParamValues(test.token)
+		} else {
+			req.Header.Set(testAPIHeader, test.token)
+		}
+		ctx.SetPath(test.route)
+
+		err := test.handler(ctx)
+		require.Equal(t, test.expectResponse, err, test.name)
+	}
+}
@@ -18,31 +18,45 @@ package middlewares
 
 import (
 	"net/http"
+	"sync/atomic"
 
 	"github.com/labstack/echo/v4"
 )
 
+// ConnectionLimiter limits the number of simultaneous connections, to a limit which can be changed
+// while it serves requests. All connections above the limit will be returned the 429 Too Many Requests
+// http error.
+type ConnectionLimiter struct {
+	limit  uint64
+	active uint64
+}
+
 // MakeConnectionLimiter makes an echo middleware that limits the number of
 // simultaneous connections. All connections above the limit will be returned
 // the 429 Too Many Requests http error.
 func MakeConnectionLimiter(limit uint64) echo.MiddlewareFunc {
-	sem := make(chan struct{}, limit)
-
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(ctx echo.Context) error {
-			select {
-			case sem <- struct{}{}:
-				defer func() {
-					// If we fail to read from `sem`, just continue.
-					select {
-					case <-sem:
-					default:
-					}
-				}()
-				return next(ctx)
-			default:
-				return ctx.NoContent(http.StatusTooManyRequests)
-			}
+	return MakeAdjustableConnectionLimiter(limit).Middleware
+}
+
+// MakeAdjustableConnectionLimiter makes a connection limiter whose limit can be changed by SetLimit.
+func MakeAdjustableConnectionLimiter(limit uint64) *ConnectionLimiter {
+	return &ConnectionLimiter{limit: limit}
+}
+
+// SetLimit changes the number of simultaneous connections. Lowering it doesn't interrupt the
+// connections being served.
+func (l *ConnectionLimiter) SetLimit(limit uint64) {
+	atomic.StoreUint64(&l.limit, limit)
+}
+
+// Middleware is the echo middleware enforcing the limit.
+func (l *ConnectionLimiter) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		if atomic.AddUint64(&l.active, 1) > atomic.LoadUint64(&l.limit) {
+			atomic.AddUint64(&l.active, ^uint64(0))
+			return ctx.NoContent(http.StatusTooManyRequests)
 		}
+		defer atomic.AddUint64(&l.active, ^uint64(0))
+		return next(ctx)
 	}
 }
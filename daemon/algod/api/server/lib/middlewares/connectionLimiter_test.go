@@ -98,3 +98,46 @@ func TestConnectionLimiterForwardsError(t *testing.T) {
 	err := middleware(handler)(nil)
 	assert.ErrorIs(t, err, handlerError)
 }
+
+func TestConnectionLimiterSetLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+
+	enteredCh := make(chan struct{})
+	handlerCh := make(chan struct{})
+	handler := func(c echo.Context) error {
+		enteredCh <- struct{}{}
+		<-handlerCh
+		return c.String(http.StatusOK, "test")
+	}
+	limiter := middlewares.MakeAdjustableConnectionLimiter(1)
+
+	serve := func() (*httptest.ResponseRecorder, chan error) {
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- limiter.Middleware(handler)(ctx)
+		}()
+		return rec, errCh
+	}
+
+	first, firstErr := serve()
+	<-enteredCh
+
+	rejected, rejectedErr := serve()
+	assert.NoError(t, <-rejectedErr)
+	assert.Equal(t, http.StatusTooManyRequests, rejected.Code)
+
+	limiter.SetLimit(2)
+	second, secondErr := serve()
+	<-enteredCh
+
+	handlerCh <- struct{}{}
+	handlerCh <- struct{}{}
+	assert.NoError(t, <-firstErr)
+	assert.NoError(t, <-secondErr)
+	assert.Equal(t, http.StatusOK, first.Code)
+	assert.Equal(t, http.StatusOK, second.Code)
+}
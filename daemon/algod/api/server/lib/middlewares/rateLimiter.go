@@ -0,0 +1,359 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// DefaultRateLimitGroup is the group of the endpoints which belong to no other group.
+const DefaultRateLimitGroup = "default"
+
+// rateLimitGroups are the groups of endpoints whose request rate can be limited, matched by the method
+// and the echo path of the route, in order.
+var rateLimitGroups = []struct {
+	name     string
+	method   string
+	prefixes []string
+}{
+	{"simulate", "", []string{"/v2/transactions/simulate"}},
+	{"dryrun", "", []string{"/v2/teal/dryrun"}},
+	{"compile", "", []string{"/v2/teal/compile", "/v2/teal/disassemble"}},
+	{"submit", http.MethodPost, []string{"/v2/transactions", "/v1/transactions"}},
+	{"accounts", "", []string{"/v2/accounts/", "/v1/account/"}},
+	{"applications", "", []string{"/v2/applications/", "/v2/assets/"}},
+	{"blocks", "", []string{"/v2/blocks/", "/v2/deltas/", "/v2/stateproofs/", "/v1/block/"}},
+}
+
+// rateLimitSweepInterval is how often the buckets of the clients which are back to a full burst get dropped.
+const rateLimitSweepInterval = time.Minute
+
+// maxRateLimitBuckets is the number of buckets the rate limiter keeps at most. Once it's reached, the new
+// clients share a bucket by group until the sweep makes room.
+const maxRateLimitBuckets = 100000
+
+// overflowClient is the client the new clients are counted as while the buckets are at their maximum.
+const overflowClient = "overflow"
+
+// forwardedForHeader is the header the trusted proxies list the addresses a request was relayed from in.
+const forwardedForHeader = "X-Forwarded-For"
+
+var rateLimitedRequests = metrics.NewTagCounter("algod_rest_rate_limited_{TAG}", "Number of REST {TAG} requests rejected by the rate limits", rateLimitGroupNames()...)
+var rateLimitClients = metrics.MakeGauge(metrics.MetricName{Name: "algod_rest_rate_limit_clients", Description: "Number of clients the REST rate limits track"})
+
+// RateLimit is the token bucket every client gets for a group of endpoints.
+type RateLimit struct {
+	// Rate is the number of requests per second.
+	Rate float64
+	// Burst is the number of requests which can be made at once.
+	Burst float64
+}
+
+// ParseRateLimits parses the RestRateLimits of the configuration, which are written as "RATE/BURST" by group.
+// The burst can be omitted, in which case it's the rate, rounded up.
+func ParseRateLimits(limits map[string]string) (map[string]RateLimit, error) {
+	parsed := make(map[string]RateLimit, len(limits))
+	for group, limit := range limits {
+		if !isRateLimitGroup(group) {
+			return nil, fmt.Errorf("unknown rate limit group %q, expected one of %s", group, strings.Join(rateLimitGroupNames(), ", "))
+		}
+		parts := strings.SplitN(limit, "/", 2)
+		rate := parts[0]
+		var rl RateLimit
+		var err error
+		rl.Rate, err = strconv.ParseFloat(strings.TrimSpace(rate), 64)
+		if err != nil || rl.Rate <= 0 || math.IsInf(rl.Rate, 0) {
+			return nil, fmt.Errorf("rate limit of %s has invalid rate %q", group, rate)
+		}
+		rl.Burst = math.Ceil(rl.Rate)
+		if len(parts) == 2 {
+			rl.Burst, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+			if err != nil || rl.Burst < 1 || math.IsInf(rl.Burst, 0) {
+				return nil, fmt.Errorf("rate limit of %s has invalid burst %q", group, parts[1])
+			}
+		}
+		parsed[group] = rl
+	}
+	return parsed, nil
+}
+
+// ParseTrustedProxies parses the RestTrustedProxies of the configuration, a comma separated list of addresses
+// and CIDR ranges.
+func ParseTrustedProxies(proxies string) ([]*net.IPNet, error) {
+	var parsed []*net.IPNet
+	for _, proxy := range strings.Split(proxies, ",") {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy address %q", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			parsed = append(parsed, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %q", proxy)
+		}
+		parsed = append(parsed, network)
+	}
+	return parsed, nil
+}
+
+func rateLimitGroupNames() []string {
+	names := make([]string, 0, len(rateLimitGroups)+1)
+	for _, g := range rateLimitGroups {
+		names = append(names, g.name)
+	}
+	names = append(names, DefaultRateLimitGroup)
+	sort.Strings(names)
+	return names
+}
+
+func isRateLimitGroup(name string) bool {
+	for _, known := range rateLimitGroupNames() {
+		if name == known {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitGroup returns the group of the route with the given method and echo path.
+func rateLimitGroup(method, path string) string {
+	for _, g := range rateLimitGroups {
+		if g.method != "" && g.method != method {
+			continue
+		}
+		for _, prefix := range g.prefixes {
+			if path == prefix || (strings.HasSuffix(prefix, "/") && strings.HasPrefix(path, prefix)) {
+				return g.name
+			}
+		}
+	}
+	return DefaultRateLimitGroup
+}
+
+type rateLimitKey struct {
+	group  string
+	client string
+}
+
+type rateLimitBucket struct {
+	available float64
+	last      time.Time
+}
+
+// RateLimiter limits the rate of the requests every client makes to each group of endpoints, with limits
+// which can be changed while it serves requests. Requests above the limit are returned the 429 Too Many
+// Requests http error, with a Retry-After header.
+type RateLimiter struct {
+	header         string
+	exemptTokens   [][]byte
+	sharedTokens   [][]byte
+	trustedProxies []*net.IPNet
+
+	mu        deadlock.Mutex
+	limits    map[string]RateLimit
+	buckets   map[rateLimitKey]*rateLimitBucket
+	lastSweep time.Time
+}
+
+// MakeRateLimiter makes a rate limiter. Requests with one of the exemptTokens in the token header aren't
+// limited. Clients are told apart by the token they provide, unless it's one of the sharedTokens, and by
+// their address otherwise, which is read from the X-Forwarded-For header of the requests relayed by the
+// trustedProxies.
+func MakeRateLimiter(header string, exemptTokens []string, sharedTokens []string, trustedProxies []*net.IPNet, limits map[string]RateLimit) *RateLimiter {
+	toBytes := func(tokens []string) [][]byte {
+		b := make([][]byte, 0, len(tokens))
+		for _, token := range tokens {
+			b = append(b, []byte(token))
+		}
+		return b
+	}
+	return &RateLimiter{
+		header:         header,
+		exemptTokens:   toBytes(exemptTokens),
+		sharedTokens:   toBytes(sharedTokens),
+		trustedProxies: trustedProxies,
+		limits:         limits,
+		buckets:        make(map[rateLimitKey]*rateLimitBucket),
+	}
+}
+
+// SetLimits changes the limits. The clients of the groups whose limit changed start again with a full burst.
+func (l *RateLimiter) SetLimits(limits map[string]RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key := range l.buckets {
+		if limits[key.group] != l.limits[key.group] {
+			delete(l.buckets, key)
+		}
+	}
+	l.limits = limits
+	rateLimitClients.Set(float64(len(l.buckets)))
+}
+
+// Middleware is the echo middleware enforcing the limits. It's meant to be registered with the routes,
+// after the authentication, to know the route of the request and only limit authorized requests.
+func (l *RateLimiter) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(ctx echo.Context) error {
+		req := ctx.Request()
+		if req.Method == http.MethodOptions {
+			return next(ctx)
+		}
+		token := []byte(requestToken(ctx, l.header))
+		if containsToken(l.exemptTokens, token) {
+			return next(ctx)
+		}
+		group := rateLimitGroup(req.Method, ctx.Path())
+		client := l.clientIdentity(req, token)
+		wait, ok := l.take(group, client, time.Now())
+		if !ok {
+			rateLimitedRequests.Add(group, 1)
+			ctx.Response().Header().Set("Retry-After", strconv.FormatInt(int64(math.Ceil(wait.Seconds())), 10))
+			return echo.NewHTTPError(http.StatusTooManyRequests, fmt.Sprintf("rate limit of %s requests exceeded", group))
+		}
+		return next(ctx)
+	}
+}
+
+// take consumes a request from the bucket of the client for the group, or returns how long the client
+// has to wait before its next request is allowed.
+func (l *RateLimiter) take(group, client string, now time.Time) (wait time.Duration, ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limit, limited := l.limits[group]
+	if !limited {
+		return 0, true
+	}
+	l.sweep(now)
+
+	key := rateLimitKey{group: group, client: client}
+	bucket, found := l.buckets[key]
+	if !found && len(l.buckets) >= maxRateLimitBuckets {
+		key.client = overflowClient
+		bucket, found = l.buckets[key]
+	}
+	if !found {
+		bucket = &rateLimitBucket{available: limit.Burst, last: now}
+		l.buckets[key] = bucket
+		rateLimitClients.Set(float64(len(l.buckets)))
+	}
+	bucket.available = math.Min(limit.Burst, bucket.available+now.Sub(bucket.last).Seconds()*limit.Rate)
+	bucket.last = now
+	if bucket.available < 1 {
+		return time.Duration((1 - bucket.available) / limit.Rate * float64(time.Second)), false
+	}
+	bucket.available--
+	return 0, true
+}
+
+// sweep drops the buckets which are back to a full burst, as they're no different from new ones.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, bucket := range l.buckets {
+		limit := l.limits[key.group]
+		if bucket.available+now.Sub(bucket.last).Seconds()*limit.Rate >= limit.Burst {
+			delete(l.buckets, key)
+		}
+	}
+	rateLimitClients.Set(float64(len(l.buckets)))
+}
+
+// clientIdentity tells the clients apart by their own tokens, and by their address otherwise.
+func (l *RateLimiter) clientIdentity(req *http.Request, token []byte) string {
+	if len(token) > 0 && !containsToken(l.sharedTokens, token) {
+		digest := sha256.Sum256(token)
+		return "token:" + hex.EncodeToString(digest[:8])
+	}
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	return "ip:" + l.forwardedClient(host, req.Header.Values(forwardedForHeader))
+}
+
+// forwardedClient returns the address of the client which made a request to the given remote address. The
+// X-Forwarded-For header only gets read from right to left while the addresses are trusted proxies, as
+// the rest of it is written by the client, which could make it up.
+func (l *RateLimiter) forwardedClient(remote string, forwardedFor []string) string {
+	var hops []string
+	for _, header := range forwardedFor {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	client := remote
+	for i := len(hops) - 1; i >= 0 && l.isTrustedProxy(client); i-- {
+		ip := net.ParseIP(strings.TrimSpace(hops[i]))
+		if ip == nil {
+			break
+		}
+		client = ip.String()
+	}
+	return client
+}
+
+func (l *RateLimiter) isTrustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, proxy := range l.trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsToken(tokens [][]byte, token []byte) bool {
+	if len(token) == 0 {
+		return false
+	}
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare(t, token) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	limits, err := ParseRateLimits(map[string]string{"simulate": "0.5/5", "default": "2.5", "accounts": " 10 / 20 "})
+	require.NoError(t, err)
+	require.Equal(t, map[string]RateLimit{
+		"simulate": {Rate: 0.5, Burst: 5},
+		"default":  {Rate: 2.5, Burst: 3},
+		"accounts": {Rate: 10, Burst: 20},
+	}, limits)
+
+	limits, err = ParseRateLimits(nil)
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	for limit, expected := range map[string]string{
+		"":      "invalid rate",
+		"0":     "invalid rate",
+		"-1/5":  "invalid rate",
+		"fast":  "invalid rate",
+		"1/0.5": "invalid burst",
+		"1/":    "invalid burst",
+	} {
+		_, err = ParseRateLimits(map[string]string{"dryrun": limit})
+		require.ErrorContains(t, err, expected, limit)
+	}
+	_, err = ParseRateLimits(map[string]string{"teal": "1/1"})
+	require.ErrorContains(t, err, `unknown rate limit group "teal"`)
+}
+
+func TestRateLimitGroup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, test := range []struct {
+		method, path, group string
+	}{
+		{http.MethodPost, "/v2/transactions/simulate", "simulate"},
+		{http.MethodPost, "/v2/teal/dryrun", "dryrun"},
+		{http.MethodPost, "/v2/teal/disassemble", "compile"},
+		{http.MethodPost, "/v2/transactions", "submit"},
+		{http.MethodGet, "/v2/transactions/params", DefaultRateLimitGroup},
+		{http.MethodGet, "/v2/transactions/pending/:txid", DefaultRateLimitGroup},
+		{http.MethodGet, "/v2/accounts/:address/assets/:asset-id", "accounts"},
+		{http.MethodGet, "/v2/applications/:application-id/boxes", "applications"},
+		{http.MethodGet, "/v2/blocks/:round", "blocks"},
+		{http.MethodGet, "/v2/status", DefaultRateLimitGroup},
+	} {
+		require.Equal(t, test.group, rateLimitGroup(test.method, test.path), test.path)
+	}
+}
+
+func TestRateLimiterTake(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	limiter := MakeRateLimiter("X-Algo-API-Token", nil, nil, nil, map[string]RateLimit{"simulate": {Rate: 0.5, Burst: 2}})
+	now := time.Unix(1000000, 0)
+
+	for i := 0; i < 2; i++ {
+		_, ok := limiter.take("simulate", "a", now)
+		require.True(t, ok)
+	}
+	wait, ok := limiter.take("simulate", "a", now)
+	require.False(t, ok)
+	require.Equal(t, 2*time.Second, wait)
+
+	// other clients and groups have their own buckets, and unlimited groups have none.
+	_, ok = limiter.take("simulate", "b", now)
+	require.True(t, ok)
+	for i := 0; i < 10; i++ {
+		_, ok = limiter.take("dryrun", "a", now)
+		require.True(t, ok)
+	}
+
+	now = now.Add(time.Second)
+	wait, ok = limiter.take("simulate", "a", now)
+	require.False(t, ok)
+	require.Equal(t, time.Second, wait)
+	now = now.Add(time.Second)
+	_, ok = limiter.take("simulate", "a", now)
+	require.True(t, ok)
+	require.Len(t, limiter.buckets, 2)
+
+	// the buckets which got back to a full burst are dropped.
+	now = now.Add(rateLimitSweepInterval)
+	_, ok = limiter.take("simulate", "c", now)
+	require.True(t, ok)
+	require.Len(t, limiter.buckets, 1)
+
+	// changed limits start over.
+	limiter.SetLimits(map[string]RateLimit{"simulate": {Rate: 0.5, Burst: 1}})
+	require.Empty(t, limiter.buckets)
+	_, ok = limiter.take("simulate", "c", now)
+	require.True(t, ok)
+	_, ok = limiter.take("simulate", "c", now)
+	require.False(t, ok)
+	limiter.SetLimits(nil)
+	_, ok = limiter.take("simulate", "c", now)
+	require.True(t, ok)
+}
+
+func TestRateLimiterMaxBuckets(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	limiter := MakeRateLimiter("X-Algo-API-Token", nil, nil, nil, map[string]RateLimit{"simulate": {Rate: 0.5, Burst: 1}})
+	now := time.Unix(1000000, 0)
+	for i := 0; i < maxRateLimitBuckets; i++ {
+		_, ok := limiter.take("simulate", fmt.Sprintf("client-%d", i), now)
+		require.True(t, ok)
+	}
+
+	// the new clients beyond the maximum share a bucket, while the known ones keep theirs.
+	_, ok := limiter.take("simulate", "new-1", now)
+	require.True(t, ok)
+	_, ok = limiter.take("simulate", "new-2", now)
+	require.False(t, ok)
+	require.Len(t, limiter.buckets, maxRateLimitBuckets+1)
+	_, ok = limiter.take("simulate", "client-0", now)
+	require.False(t, ok)
+
+	// the sweep makes room again.
+	now = now.Add(rateLimitSweepInterval)
+	_, ok = limiter.take("simulate", "new-2", now)
+	require.True(t, ok)
+	require.Len(t, limiter.buckets, 1)
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	proxies, err := ParseTrustedProxies("")
+	require.NoError(t, err)
+	require.Empty(t, proxies)
+
+	proxies, err = ParseTrustedProxies(" 10.0.0.0/8, 192.168.1.2 ,::1")
+	require.NoError(t, err)
+	require.Len(t, proxies, 3)
+	require.Equal(t, "10.0.0.0/8", proxies[0].String())
+	require.Equal(t, "192.168.1.2/32", proxies[1].String())
+	require.Equal(t, "::1/128", proxies[2].String())
+
+	_, err = ParseTrustedProxies("10.0.0.0/33")
+	require.ErrorContains(t, err, "invalid trusted proxy range")
+	_, err = ParseTrustedProxies("proxy.example.com")
+	require.ErrorContains(t, err, "invalid trusted proxy address")
+}
+
+func TestRateLimiterForwardedClient(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	proxies, err := ParseTrustedProxies("10.0.0.0/8")
+	require.NoError(t, err)
+	limiter := MakeRateLimiter("X-Algo-API-Token", nil, nil, proxies, nil)
+
+	for _, test := range []struct {
+		remote       string
+		forwardedFor []string
+		client       string
+	}{
+		// the header of the clients which aren't trusted proxies is ignored.
+		{"192.168.1.1", []string{"172.16.0.1"}, "192.168.1.1"},
+		{"10.0.0.1", nil, "10.0.0.1"},
+		// the client is the rightmost address which isn't a trusted proxy, whatever the client wrote before it.
+		{"10.0.0.1", []string{"172.16.0.1"}, "172.16.0.1"},
+		{"10.0.0.1", []string{"1.2.3.4, 172.16.0.1"}, "172.16.0.1"},
+		{"10.0.0.1", []string{"1.2.3.4, 172.16.0.1, 10.0.0.2"}, "172.16.0.1"},
+		{"10.0.0.1", []string{"1.2.3.4", "172.16.0.1,10.0.0.2"}, "172.16.0.1"},
+		// the addresses the proxies relayed for stop at the first one which can't be parsed.
+		{"10.0.0.1", []string{"172.16.0.1, unknown, 10.0.0.2"}, "10.0.0.2"},
+		{"10.0.0.1", []string{"10.0.0.3, 10.0.0.2"}, "10.0.0.3"},
+	} {
+		require.Equal(t, test.client, limiter.forwardedClient(test.remote, test.forwardedFor), "%s %v", test.remote, test.forwardedFor)
+	}
+
+	// without trusted proxies, the header is never read.
+	limiter = MakeRateLimiter("X-Algo-API-Token", nil, nil, nil, nil)
+	require.Equal(t, "10.0.0.1", limiter.forwardedClient("10.0.0.1", []string{"172.16.0.1"}))
+}
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const header = "X-Algo-API-Token"
+	proxies, err := ParseTrustedProxies("10.0.0.0/24")
+	require.NoError(t, err)
+	limiter := MakeRateLimiter(header, []string{"admin"}, []string{"shared"}, proxies, map[string]RateLimit{"simulate": {Rate: 0.001, Burst: 1}})
+	e := echo.New()
+	e.POST("/v2/transactions/simulate", func(ctx echo.Context) error { return ctx.NoContent(http.StatusOK) }, limiter.Middleware)
+	e.GET("/v2/status", func(ctx echo.Context) error { return ctx.NoContent(http.StatusOK) }, limiter.Middleware)
+
+	serve := func(method, path, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		for k, v := range headers {
+			req.Header.Set(k, v)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	shared := map[string]string{header: "shared"}
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, "/v2/transactions/simulate", "10.0.0.1:1234", shared).Code)
+	rec := serve(http.MethodPost, "/v2/transactions/simulate", "10.0.0.1:5678", shared)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "1000", rec.Header().Get("Retry-After"))
+	require.Contains(t, rec.Body.String(), "rate limit of simulate requests exceeded")
+
+	// clients sharing the token are told apart by their address, possibly forwarded by a trusted proxy.
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, "/v2/transactions/simulate", "10.0.0.2:1234", shared).Code)
+	proxied := map[string]string{header: "shared", "X-Forwarded-For": "1.2.3.4, 192.168.1.1, 10.0.0.9"}
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, "/v2/transactions/simulate", "10.0.0.1:1234", proxied).Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, "/v2/transactions/simulate", "10.0.0.3:1234", proxied).Code)
+	// the header of the clients which aren't trusted proxies is ignored.
+	spoofed := map[string]string{header: "shared", "X-Forwarded-For": "192.168.1.2"}
+	require.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, "/v2/transactions/simulate", "192.168.1.1:1234", spoofed).Code)
+
+	// clients with their own tokens are told apart by them.
+	scoped := map[string]string{"Authorization": "Bearer scoped"}
+	require.Equal(t, http.StatusOK, serve(http.MethodPost, "/v2/transactions/simulate", "10.0.0.1:1234", scoped).Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(http.MethodPost, "/v2/transactions/simulate", "10.0.0.4:1234", scoped).Code)
+
+	// the admin token and the endpoints of unlimited groups are not limited.
+	admin := map[string]string{header: "admin"}
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusOK, serve(http.MethodPost, "/v2/transactions/simulate", "10.0.0.1:1234", admin).Code)
+		require.Equal(t, http.StatusOK, serve(http.MethodGet, "/v2/status", "10.0.0.1:1234", shared).Code)
+	}
+}
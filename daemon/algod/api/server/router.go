@@ -25,6 +25,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/common"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
@@ -63,23 +64,52 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 }
 
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
+func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-chan struct{}, apiToken string, adminAPIToken string, scopedTokens *tokens.ScopedTokenStore, listener net.Listener, numConnectionsLimit uint64, reloadConfig func() (applied []string, restartRequired []string, err error)) *echo.Echo {
 	if err := tokens.ValidateAPIToken(apiToken); err != nil {
 		logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
 	}
 	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
 		logger.Errorf("Invalid adminAPIToken was passed to NewRouter ('%s'): %v", adminAPIToken, err)
 	}
-	adminAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken})
-	apiAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken})
+	adminAuthenticator := middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken}, scopedTokens, true)
+	apiAuthenticator := middlewares.MakeScopedAuth(TokenHeader, []string{adminAPIToken, apiToken}, scopedTokens, false)
 
 	e := echo.New()
 
 	e.Listener = listener
 	e.HideBanner = true
 
+	connectionLimiter := middlewares.MakeAdjustableConnectionLimiter(numConnectionsLimit)
+	node.AddConfigReloadHook(func(cfg config.Local) {
+		// the soft limit can't exceed the hard one, which is enforced by the listener
+		limit := cfg.RestConnectionsSoftLimit
+		if limit > cfg.RestConnectionsHardLimit {
+			limit = cfg.RestConnectionsHardLimit
+		}
+		connectionLimiter.SetLimit(limit)
+	})
+
+	rateLimits, err := middlewares.ParseRateLimits(node.Config().RestRateLimits)
+	if err != nil {
+		logger.Errorf("Invalid RestRateLimits were passed to NewRouter: %v", err)
+	}
+	trustedProxies, err := middlewares.ParseTrustedProxies(node.Config().RestTrustedProxies)
+	if err != nil {
+		logger.Errorf("Invalid RestTrustedProxies were passed to NewRouter: %v", err)
+	}
+	// the admin token isn't limited, and the clients sharing the node API token are told apart by their address.
+	rateLimiter := middlewares.MakeRateLimiter(TokenHeader, []string{adminAPIToken}, []string{apiToken}, trustedProxies, rateLimits)
+	node.AddConfigReloadHook(func(cfg config.Local) {
+		limits, err := middlewares.ParseRateLimits(cfg.RestRateLimits)
+		if err != nil {
+			logger.Warnf("Ignoring invalid RestRateLimits: %v", err)
+			return
+		}
+		rateLimiter.SetLimits(limits)
+	})
+
 	e.Pre(
-		middlewares.MakeConnectionLimiter(numConnectionsLimit),
+		connectionLimiter.Middleware,
 		middleware.RemoveTrailingSlash())
 	e.Use(
 		middlewares.MakeLogger(logger),
@@ -100,27 +130,32 @@ func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-ch
 	registerHandlers(e, "", common.Routes, ctx)
 
 	// Registering v1 routes
-	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, apiAuthenticator)
+	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, apiAuthenticator, rateLimiter.Middleware)
 
 	// Registering v2 routes
 	v2Handler := v2.Handlers{
-		Node:     apiNode{node},
+		Node:     apiNode{node, reloadConfig},
 		Log:      logger,
 		Shutdown: shutdown,
 	}
-	nppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator)
+	nppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator, rateLimiter.Middleware)
 	npprivate.RegisterHandlers(e, &v2Handler, adminAuthenticator)
-	ppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator)
+	ppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator, rateLimiter.Middleware)
 	pprivate.RegisterHandlers(e, &v2Handler, adminAuthenticator)
 
 	if node.Config().EnableExperimentalAPI {
-		experimental.RegisterHandlers(e, &v2Handler, apiAuthenticator)
+		experimental.RegisterHandlers(e, &v2Handler, apiAuthenticator, rateLimiter.Middleware)
 	}
 
 	return e
 }
 
 // apiNode wraps the AlgorandFullNode to provide v2.NodeInterface.
-type apiNode struct{ *node.AlgorandFullNode }
+type apiNode struct {
+	*node.AlgorandFullNode
+	reloadConfig func() ([]string, []string, error)
+}
 
 func (n apiNode) LedgerForAPI() v2.LedgerForAPI { return n.Ledger() }
+
+func (n apiNode) ReloadConfigFromDisk() ([]string, []string, error) { return n.reloadConfig() }
@@ -50,4 +50,17 @@ var (
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
+	errInvalidBlockTimestamp                   = "invalid block timestamp"
+	errFailedGeneratingDevModeBlock            = "failed to generate a developer mode block : %v"
+	errFailedSnapshottingLedger                = "failed to snapshot the ledger : %v"
+	errFailedRestoringLedgerSnapshot           = "failed to restore the ledger snapshot : %v"
+	errFailedDeletingLedgerSnapshot            = "failed to delete the ledger snapshot : %v"
+	errFailedAdvancingDevModeRounds            = "failed to advance developer mode rounds : %v"
+	errTooManyDevModeRounds                    = "cannot advance more than %d developer mode rounds at once"
+	errInvalidBlockTimestampOffset             = "invalid block timestamp offset"
+	errFailedSettingBlockTimestampOffset       = "failed to set the block timestamp offset : %v"
+	errFailedRetrievingBlockTimestampOffset    = "failed to retrieve the block timestamp offset : %v"
+	errBlockTimestampOffsetNotSet              = "block timestamp offset is not set, blocks follow the wall clock"
+	errBlockPruned                             = "the block of the requested round was pruned, the earliest available round is %d"
+	errFailedReloadingConfig                   = "failed to reload the configuration : %v"
 )
@@ -136,176 +136,186 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PctrLgX0HNvVV+7FCSH8mNVZW6q9hJjjZ2jstScnav5U0wZM8MjjgADwBKM/H6",
-	"v2+hAZAgCXCoR5xzbuWTrSEejUaj0ejnx1kuNpXgwLWaHX+cVVTSDWiQ+BfNc1FznbHC/FWAyiWrNBN8",
-	"duy/EaUl46vZfMbMrxXV69l8xukG2jam/3wm4R81k1DMjrWsYT5T+Ro21Aysd5Vp3Yy0zVYic0Oc2CFO",
-	"X80+jXygRSFBqSGUf+XljjCel3UBREvKFc3NJ0WumV4TvWaKuM6EcSI4ELEket1pTJYMykId+EX+owa5",
-	"C1bpJk8v6VMLYiZFCUM4X4rNgnHwUEEDVLMhRAtSwBIbrakmZgYDq2+oBVFAZb4mSyH3gGqBCOEFXm9m",
-	"x+9nCngBEncrB3aF/11KgN8g01SuQM8+zGOLW2qQmWabyNJOHfYlqLrUimBbXOOKXQEnptcBeVMrTRZA",
-	"KCfvvntJnj179sIsZEO1hsIRWXJV7ezhmmz32fGsoBr85yGt0XIlJOVF1rR/991LnP/MLXBqK6oUxA/L",
-	"iflCTl+lFuA7RkiIcQ0r3IcO9ZsekUPR/ryApZAwcU9s43vdlHD+P3RXcqrzdSUY15F9IfiV2M9RHhZ0",
-	"H+NhDQCd9pXBlDSDvj/KXnz4+GT+5OjTv70/yf7L/fnFs08Tl/+yGXcPBqIN81pK4PkuW0mgeFrWlA/x",
-	"8c7Rg1qLuizIml7h5tMNsnrXl5i+lnVe0bI2dMJyKU7KlVCEOjIqYEnrUhM/Mal5adiUGc1RO2GKVFJc",
-	"sQKKueG+12uWr0lOlR0C25FrVpaGBmsFRYrW4qsbOUyfQpQYuG6FD1zQPy8y2nXtwQRskRtkeSkUZFrs",
-	"uZ78jUN5QcILpb2r1M0uK3K+BoKTmw/2skXccUPTZbkjGve1IFQRSvzVNCdsSXaiJte4OSW7xP5uNQZr",
-	"G2KQhpvTuUfN4U2hb4CMCPIWQpRAOSLPn7shyviSrWoJilyvQa/dnSdBVYIrIGLxd8i12fb/dfbXH4mQ",
-	"5A0oRVfwluaXBHguivQeu0ljN/jflTAbvlGriuaX8eu6ZBsWAfkN3bJNvSG83ixAmv3y94MWRIKuJU8B",
-	"ZEfcQ2cbuh1Oei5rnuPmttN2BDVDSkxVJd0dkNMl2dDt10dzB44itCxJBbxgfEX0lieFNDP3fvAyKWpe",
-	"TJBhtNmw4NZUFeRsyaAgzSgjkLhp9sHD+M3gaSWrABw/SBKcZpY94HDYRmjGHF3zhVR0BQHJHJCfHOfC",
-	"r1pcAm8YHFns8FMl4YqJWjWdEjDi1OPiNRcaskrCkkVo7Myhw3AP28ax140TcHLBNWUcCsN5EWihwXKi",
-	"JEzBhOOPmeEVvaAKvnyeusDbrxN3fyn6uz6645N2Gxtl9khG7kXz1R3YuNjU6T/h8RfOrdgqsz8PNpKt",
-	"zs1VsmQlXjN/N/vn0VArZAIdRPiLR7EVp7qWcHzBH5u/SEbONOUFlYX5ZWN/elOXmp2xlfmptD+9FiuW",
-	"n7FVApkNrNHXFHbb2H/MeHF2rLfRR8NrIS7rKlxQ3nmVLnbk9FVqk+2YNyXMk+YpG74qzrf+pXHTHnrb",
-	"bGQCyCTuKmoaXsJOgoGW5kv8Z7tEeqJL+Zv5p6pK01tXyxhqDR27+xZ1A05ncFJVJcupQeI799l8NUwA",
-	"7CuBti0O8UI9/hiAWElRgdTMDkqrKitFTstMaapxpH+XsJwdz/7tsFWuHNru6jCY/LXpdYadjDxqZZyM",
-	"VtUNxnhr5Bo1wiwMg8ZPyCYs20OJiHG7iYaUmGHBJVxRrg/a90iHHzQH+L2bqcW3FWUsvnvvqyTCiW24",
-	"AGXFW9vwgSIB6gmilSBaUdpclWLR/PDwpKpaDOL3k6qy+EDREBhKXbBlSqtHuHzanqRwntNXB+T7cGyU",
-	"swUvd+ZysKKGuRuW7tZyt1ijOHJraEd8oAhup5AHZms8GowMfx8Uh2+GtSiN1LOXVkzjv7i2IZmZ3yd1",
-	"/tcgsRC3aeLCV5TDnH3A4C/By+Vhj3KGhON0OQfkpN/3dmRjRokTzK1oZXQ/7bgjeGxQeC1pZQF0X+xd",
-	"yji+wGwjC+sduelERheFOTjDAa0hVLc+a3vPQxQSJIUeDN+UIr/8C1XrezjzCz/W8PjhNGQNtABJ1lSt",
-	"D2YxKSM8Xu1oU46YaYivd7IIpjpolnhfy9uztIJqGizNwRsXSyzqsR8yPZCRt8tf8T+0JOazOduG9dth",
-	"D8g5MjBlj7OzIBTmKW8fCHYm0wBVDIJs7OudmFf3jaB82U4e36dJe/StVRi4HXKLwB0S23s/Bt+IbQyG",
-	"b8R2cATEFtR90IcZB8VIDRs1Ab5XDjKB++/QR6WkuyGScewpSDYLNKKrwtPAwxvfzNJqXk8WQt6O+/TY",
-	"CietPplQM2rAfOc9JGHTusocKUZ0UrZBb6DWhDfONPrDxzDWwcKZpr8DFpQZ9T6w0B3ovrEgNhUr4R5I",
-	"fx1l+guq4NlTcvaXky+ePP3l6RdfGpKspFhJuiGLnQZFHrq3GVF6V8Kj4crwdVSXOj76l8+9FrI7bmwc",
-	"JWqZw4ZWw6GsdtOKQLYZMe2GWOuiGVfdADjlcJ6D4eQW7cQq7g1or5gyEtZmcS+bkUJY0c5SEAdJAXuJ",
-	"6abLa6fZhUuUO1nfx1MWpBQyol/DI6ZFLsrsCqRiImIqeetaENfCi7dV/3cLLbmmipi5UfVbcxQoIpSl",
-	"t3w637dDn295i5tRzm/XG1mdm3fKvnSR7zWJilQgM73lpIBFveq8hJZSbAglBXbEO/p70Gc7nqNW7T6I",
-	"NP1M2zCOKn6143nwZjMbVUKx6mzC3d9mfax4/Zyd6oGKgGPQ8Ro/47P+FZSa3rv80p8gBvtLv5EWWFKY",
-	"hvgKfs1Wax0ImG+lEMv7hzE2SwxQ/GDF89L0GQrpP4oCzGJrdQ+XcTtYS+tmT0MKpwtRa0IJFwWgRqVW",
-	"8Ws6YZZHeyCaMXV48+u1lbgXYAgpp7VZbV0RNNINOEfbMaO5pd4MUaMSVozG/GRb2emsybeUQAvzqgdO",
-	"xMKZCpwRAxdJ0cKo/UXnhITIWerAVUmRg1JQZE5FsRc0384yET2CJwQcAW5mIUqQJZV3Bvbyai+cl7DL",
-	"0B6uyMMfflaP/gB4tdC03INYbBNDb/Pgc/agIdTTph8juP7kIdlRCcTzXPO6NAyiBA0pFN4IJ8n960M0",
-	"2MW7o+UKJFpmfleK95PcjYAaUH9ner8rtHWV8PJyD51ztkG9HadcKMgFL1R0sJIqne1jy6ZR5zVmVhBw",
-	"whgnxoETQslrqrS1JjJeoBLEXic4jxVQzBRpgJMCqRn5Zy+LDsfOzT3IVa0awVTVVSWkhiK2Bg7bkbl+",
-	"hG0zl1gGYzfSrxakVrBv5BSWgvEdsuxKLIKobpTuztw+XByqps09v4uisgNEi4gxQM58qwC7oadLAhCm",
-	"WkRbwmGqRzmNe818prSoKsMtdFbzpl8KTWe29Yn+qW07JC6q23u7EGBm1x4mB/m1xaz1cVpT84TGkcmG",
-	"XhrZAx/E1uw5hNkcxkwxnkM2RvnmWJ6ZVuER2HNIE7oI50UZzNY7HD36jRJdkgj27EJqwQnFyFsqNctZ",
-	"hZLiD7C7d8G5P0FUXU8K0JSZx3rwwQrRVdifWDt2f8zbCdKT3rBD8AeP2MhySqbwwugCfwk7fLG8tQ5S",
-	"54Fb1T28BCKjmtNNOUFAvduFEWDCJrCluS535prTa9iRa5BAVL3YMK2tx1v3oaBFlYUDRPWDIzM6Zbh1",
-	"LvI7MEU7f4ZDBcsbbsV8ZiWqcfjOe2JVBx1OkqqEKCe8vQfIiEIwyW5KKmF2nTkHS++F5ympA6QTYtAS",
-	"0jDPB6qDZlwB+T+iJjnlKLDWGpobQUhks3j9mhnMBdbM6SykLYaghA1YORy/PH7cX/jjx27PmSJLuPZe",
-	"yaZhHx2PH+Mr+K1QunO47kHTYo7baYS3o+LUXBROhuvzlP0WOjfylJ182xu80baaM6WUI1yz/DszgN7J",
-	"3E5Ze0gj06yTOO4knWgwdGzduO9nbFOX97XhS8rKWkLauHBx8X65ubj4QL6zLb1dcO6JPETHdetVvnS3",
-	"US3RM4GUzDwPpKBFTpWOqkZxkXyVNb5tKgrORhlw/ubOIeW7XhzUVBjIAnJaW6dOx7UdBK13nTqISES9",
-	"3e2jMLqQidrFutT20g6xupKirohqtt1SgaYafh9NXTt0DMrhxIFrRfsx5V1hpOxydw+3tR2ISKgkKOSt",
-	"4etU2a9iGYYvOOardkrDZqjAs11/SYi377xwOHhrCF4yDtlGcNhFI/YYhzf4Mdbb8vdEZ7xpU337wnMH",
-	"/h5Y3XmmUONd8Yu7HTC0t41b0T1sfn/cnu42DNxA3QSUFaEkLxlqLgRXWta5vuAU30bBYYuYX/2LL/1a",
-	"fumbxJ/nkdezG+qCUzS9Ny+mKF9cQoQvfwfgH82qXq1A6Z6UuAS44K4V46TmTONcG7Nfmd2wCiTaQA9s",
-	"yw3dkSUt8XH/G0hBFrXuMlf0L1favL2tItlMQ8TyglNNSjBc9Q3j51sczhtiPM1w0NdCXjZYOIiehxVw",
-	"UExlcTPx9/YrevC45a+dNw8G+9nPVvVoxm+d0HcaOgFs//fhfx6/P8n+i2a/HWUv/sfhh4/PPz16PPjx",
-	"6aevv/5/3Z+effr60X/+e2ynPOwx72cH+ekr96Y4fYWCY6t7HMD+2fROG8azKJGFFrYebZGHRvz1BPSo",
-	"Ve66Xb/gessNIV3RkhVU344c+ixucBbt6ehRTWcjemoEv9YbimN34DIkwmR6rPHW1/jQsyIeZ4DKcBc6",
-	"gOdlWXO7lbVyCnl0o/UWbrGcN7EkNob8mGCgwZp69wz359MvvpzN2wCB5vtsPnNfP0QomRXbWBhIAduY",
-	"lO0OCB6MB4pUdKdAx7kHwh415lubYjjsBszzTK1Z9fk5hdJsEedw3jnRvda3/JRbr0FzflC1vnMaO7H8",
-	"/HBrCVBApdex2NKOpICt2t0E6Jk7KymugM8JO4CD/mu5WIHybgUl0CXGOKJ6WExxtm7OgSU0TxUB1sOF",
-	"THqSxugHhVvHrT/NZ+7yV/cuj7uBY3D152z06P5vLciD7789J4eOYaoHNiLJDh3EkES0UM5NumMIN9zM",
-	"RtTbkKwLfsFfwZJxZr4fX/CCanq4oIrl6rBWIL+hJeU5HKwEOfae16+ophd8IGklk14EPu+kqhcly8ll",
-	"KBG35GkDmaPPRlquhHk49m2CQ/nVTRXlL3aC7Jrptah15iI1MwnXVBYR0FUTqYcj2zjrsVnnxI1tWbGL",
-	"BHXjx3kerSrVj9gZLr+qSrP8gAyVi0cxW0aUFtLLIkZAsdDg/v4o3MUg6bUP860VKPLrhlbvGdcfSHZR",
-	"Hx09A9IJYfnVXfmGJncVdPSVt4oo6usqceH2XQNbLWlW0VVCaaCBVrj7KC9v8JFdlgS7dUJnvGsgDtUu",
-	"wOMjvQEWjhuHAeDizmwvn3IjvgT8hFuIbYy40RqcbrtfQTDNrberF5Az2KVarzNztqOrUobE/c40kfgr",
-	"I2R5K6BiK/S0ckkLFkDyNeSXUGD8NGwqvZt3untDsxM0PetgyuYZsK7wGAyLqt0FkLoqqBPFewolg2EF",
-	"WntXr3dwCbtz0cbS3iQMsRsVp1IHFSk1kC4NsYbH1o3R33znzYC6rqrywWUYZeDJ4rihC98nfZCtyHsP",
-	"hzhGFJ2orRQiqIwgwhJ/AgW3WKgZ706kH1ueeWUs7M0XSUvgeT9xTdrHk3M8CFeDwWj2+wYwaYm4VmRB",
-	"jdwuXL4NG/kVcLFa0RUkJORQuz4xvqqjkcdB9t170ZtOLPsX2uC+iYJsG2dmzVFKAfPFkAo+ZnruJn4m",
-	"a8CxClSCabQcwhYlikmNX45lOlR2rBw2L1AKtDgBg+StwOHB6GIklGzWVPlUIJgxxZ/lSTLA7xjJOBa/",
-	"fhp4SgRpURrFt+e5/XM6eF26KHYfuu7j1cOn5YTYcyPho3NmbDsERwGogBJWduG2sSeUNqqy3SADx1+X",
-	"y5JxIFnM6YIqJXJmc7m014ybA4x8/JgQqwImk0eIkXEANhomcWDyowjPJl/dBEjuokKpHxtNmsHfEHdg",
-	"t26IRuQRlWHhjCccXj0HoM5Tp7m/ev5iOAxhfE4Mm7uipWFz7sXXDjIIo0axtRc07Uzjj1Li7IgG3l4s",
-	"N1qTvYpus5pQZvJAxwW6EYgXYpvZCJaoxLvYLgy9Rz0zMZ4mdjBtwPoDRRZii+4WeLVYT8A9sKTh8GAE",
-	"L/wtU0iv2C91m1tgxqYdl6ZiVKiQZJw6ryGXlDgxZeqEBJMil4dBDPqtAOgpO9psje7xu/eR2hVPhpd5",
-	"e6vN29wq3uk9dvxTRyi6Swn8DbUwTdS4UyG8g1zIIq2nMITKdJP+cqhecMk7Dd+YHFc+korzpPva8E+I",
-	"4c4lvAI68LTzjCDilQ3ZGEDy7bYSRrq1IR02vt8hxcqJEmykmrI6K8X4qnSCQQpNsQV7nySPcbvkNl+P",
-	"H3Ca7Bzb3MQjfwyWqorDcZOXyjuHnxEoEqe8hQPl8DtC4mL8R2H5lKaPt33RPnpQuu413cwSwVsrdjsY",
-	"8hlaM4c2UwUl4Os567w2ssuYjfvi4r0CFM3OfLdAy4f5KyjfPQp8tiSsmNLQWpuMBOsx/bn1+BTTZgmx",
-	"TK9OV3Jp1vdOiEaes3lZsGNnmZ99BVdCQ7ZkUukMTXXRJZhG3ynUPn1nmsYfFV2vMJtBkhXxSxSnvYRd",
-	"VrCyjtOrm/eHV2baHxvZQdULFEwYJ0DzNVlgxtOor+jI1NadeHTBr+2CX9N7W++002CamomlIZfuHP8i",
-	"56J3042xgwgBxohjuGtJlI5coEGE5JA7Bg8MezjxOj0YM1MMDlPhx97rX+XjNFPCnB1pZC3oGpR0zo04",
-	"5Fg/MsvU22Tn0VhGLnTWUX5E0NUoeJSmlzYep7vBfNXoVOJuU/ZdPWlo13bPgHz6eHz/cE4Izkq4gnK/",
-	"EzRFjHsFDnpG2BHQ9YZgOIH38dgv1Q93oEVYs9I+jFFqGUg3Y4bb9mnk0o+1b2skWIM7Fzg82XpnJDRP",
-	"by19D013VZUVUEI0TOdvQRwOrSoMtveNYyErZjDGC9jGwbGf5rGU5EPlfc24tukr7yszXm+c6csO88dN",
-	"QUFlM53dPPte+o0Z7FKI5vSiEkTZGAdGGTEO3rzsgmIOfepLXOO0qlix7dk97ahJ7fi9YAwvKDfYHgwE",
-	"tBELAJOgunkDW2WezV7dSdtzMAkz593sfqFME07FlK+9MERUEyC6D1fnQMsfYPezaYvLmX2az+5mJo3h",
-	"2o24B9dvm+2N4hnd8KzZrOP1cEOU06qS4oqWmTMmp0hTiitHmtjc254/s7QW53rn3568fuvA/zSf5SVQ",
-	"mTWvneSqsF31L7Mqm6IwcUB8bvc11Y1+zr6Gg81v8qqFBujrNbg82sGDepDws3UuCI6iM0gv497Ae83L",
-	"zg/CLnHEHwKqxh2iNdVZb4iuBwS9oqz0NjIPbcJzFxc37W6McoVwgDt7UoR30b2ym8Hpjp+Olrr28KRw",
-	"rpFM3xubzF4RwfvucuYVjKY3JNUNxXSd1gIyZE683qDVIFMly+P2VL7AEBtu/WRMY4KNE+9pM2LNEm5X",
-	"vGbBWKaZmqDU7gEZzBFFpk/9msLdQrgqRDVn/6iBsAK4Np8knsreQUX9qbOsD6/TuFTpBrbW+Hb4u8gY",
-	"Yara/o3nZK4xASP0yhmA+6rR+vmFNtYn80PgfnAD575wxsGVOOKY5+jDUbMNVFh3vWsmS+h7KxZ5/ZvL",
-	"mZuYI1qBiKlsKcVvEFdVoYYvEh3qk/My9Gj9DfiEkLLWktMWUmpnT253SroJLU5dh8QE1ePOBy44mCXU",
-	"W6Mpt1ttC4J0/NrjBBNGkBza8VuCcTAPom5Ker2gsRSqRsgwMAXml47dXAviO3vcOxsNc/mSD0jgN9a0",
-	"ZTZvQgWyDdwe5mC6pcBgp50sKrSSAVJtKBPMra9PqURkmJpfU27ryqA1Ao+S620e+F4hdC0kZj1RcRN/",
-	"ATnbRJVLFxfvi3xozi3YitmqKrWCoGyHG8iWo7JU5EqfWHe6FjWnS3I0DwoDud0o2BVTbFECtnhiWyyo",
-	"AqtU8Z4bvotZHnC9Vtj86YTm65oXEgq9VhaxSpBGqMPnTeOosgB9DcDJEbZ78oI8RBcdxa7gkcGiu59n",
-	"x09eoIHV/nEUuwBc+aQxblIswyDXOB2jj5IdwzBuN+pBVBtga96lGdfIabJdp5wlbOl43f6ztKGcriDu",
-	"FbrZA5Pti7uJtoAeXnhhCzYpLcWOsES4MWhq+FMi0sywPwsGycVmw/TGOXIosTH01NbksJP64Wz1J5dO",
-	"2cPlP6I/VOXdQXqPyM9r97H3W2zV6LX2I91AF61zQm2qm5K1noo+yTs59Zm0ML90k1ba4sbMZZaOYg46",
-	"Li5JJRnX+LCo9TL7iuRrKmlu2N9BCtxs8eXzSE7tbm5XfjPAPzveJSiQV3HUywTZexnC9SUPueDZxnCU",
-	"4lEb2RmcyqTjVtxFJ+UnND70VKHMjJIlya3ukBsNOPWdCI+PDHhHUmzWcyN6vPHKPjtl1jJOHrQ2O/TT",
-	"u9dOytgIGUuP2R53J3FI0JLBFfrpxzfJjHnHvZDlpF24C/R/rPHUi5yBWObPcvIhcBOLT/A2QJtP6Jl4",
-	"G2tP19LTkbmiZh984UyzgNiSkfvsHncpJtPpfBOoPIeeBl1CidAJgO1h7GYv4LurGAKTT2eHUjjqLi1G",
-	"md+IyJJ9BYLGxuMiJiN6q9QFYj4YBrVwQ81JN9v75/eo8WaRoWeH+eJhxT/6wP7BzAaR7FeQ2MSgEkV0",
-	"O4vme+BcRsk3Yjt1U3u822/sPwFqoiipWVn83OYG6RX6kJTn66izyMJ0/KUtSdgszh7maH7UNeXceiMM",
-	"dRP4SvnFv2Yi762/i6nzbBif2LZfe8Qut7e4FvAumB4oP6FBL9OlmSDEajftQhPWV65EQXCeNhlne68P",
-	"a9YElQX+UYPSsXsRP9jQAtSoLw0V2wT/wAvUYxyQ721J8TWQTq5A1B/YLE1Q+DTr1tRTV6WgxZyYcc6/",
-	"PXlN7Ky2jy2sZRPrr+y121lF2j/3Jo62Y7619xHRZ1atNKbuVJpuqliKEtPi3DfAPCihdQkf1iF2Dsgr",
-	"q9NQ/sVsJzH0sGRyAwVppnNSNdKE+Y/WNF+jsqDDUtMkP70ihKdKFVRhbaqpNcl38dwZuF1RCFsTYk6E",
-	"kRyumbKVpOEKullRmhRBTgzwWVK6y5M155ZSolLxWAqr26DdA2e9IL0BKgpZD/E3lF6cm/oNC2ScYa9o",
-	"Nst+tY1B+VWbY6OpkvXGF9ClXHCWYy7J2NXsqlJPsc5OSLsZjwxw/jZqFjlc0RofTbCGw2Ky6odnhA5x",
-	"Q/NQ8NVsqqUO+6fG8sdrqskKtHKcDYq5L1XjNNSMK3DJlLFAecAnhexYvJFDRp0oWjn5hmSEwdkJlcN3",
-	"5tuPTiGFUYuXjOPT08dI2ABJq0PGornavFeZJiuBERTuUIRrem/6HGCylgK2Hw58kV0cwxqMzbKtd8Rw",
-	"qBPvK+F8E0zbl6atTajX/tyJg7OTnlSVmzRdyCgqD+gtTyI4YvNuHL0C5Dbjh6ONkNuokxPep4bQ4Apd",
-	"JKAiLjQmUdSnFwRjhFZLUdiCWP/oaB6tqJvoa8ahLQEduSDy6JWAG4PnNdFP5ZJqKwJO4mnnQEv0i4gx",
-	"NKWdUeyuQ/U22PmTVvnMz5HexrYeUYJxNA1awY3yXVN52lB3IEy8xJL3DpHD6kIoVTkhygXXdOsNxRiH",
-	"Ydw+IWf3Ahgeg6FMZLtrSe3JuclNlEpVsqiLFeiMFkVMn/ANfiX41acrhS3kdZPFu6pIjpn5uqkKh9Tm",
-	"JsoFV/VmZC7f4I7TBQW8ItQQFhHzO4yO14sd/htLYZ3eGecedGMfe+8LVDThczeRm7sjDaReQ9OZYqts",
-	"OibwTrk7Otqpb0fobf97pfRSrLqAfOYEZWNcLtyjGH/71lwcYf6uQV52e7U06bXQHVT4sqv4bGwSw3S5",
-	"ko86HcwZZF4eV0CkCzTO8fJLxLUEul5q71dr105Ft+TJYCyqXf4ETckoC0rGpFu/Mht9jlDEdfopXzLr",
-	"SmY+D3pPkwwHcjaOPYpQ76Q4BOgH7wFNKsqc00bLLIaYdeFeaXXh2KFrN7i/CBdEldTY/XCVCnjyccA2",
-	"sqNX0u4SXFKlSsIVE7V3h/D+cv5JaH91JcWDuOLk+od+MzjVH6sGTSptz135FLtM9yb/4WfrXUmAa7n7",
-	"J1DhDjZ9UBAwlrO4Uw7QCVdRfZOeele+amoKXl5lG1GMBUz/8DN55W1Lk+4dT8ixdEuicEW4osHir10J",
-	"CN/MSJ+Tp33jOp1U1fjUiQjx4eS24U2nT6WaMudzTOv21p9fW0YxVCFE3ipBODOHrY4XTBpEw14DgW0F",
-	"mOs2CGxOZ8+YSlAuyBFfq1kJVMEIhsOsba7tRCSfb1+b9tOC7eOFLNMpZ9s0s8g8K6FYW5wnVuFyosvx",
-	"ORapDCyGw7G8v98V5FrIjh+TBLhJAl0zWVA9+c/UswlFSeOZ7el/JM3sfBbylmigojtetE2Rg1Y1NLlG",
-	"UtXbNhFm7zozc0hqmPshzA9LWqp4rbKks2sv80ngsBJJ9Bxf2GkxIdu3W8488IFgxTgi45EA1vn7vycy",
-	"rV/7/aJzULNr/FUxSLwQJA+xpZUObuBA0nhRo2SI+7UC7gprL2Oo2R8VtVxCrtnVnkQXf1sDD5IozL0m",
-	"GGFZBnkvWBNlgwlFb27naAEay0MxCk+Q2P/O4KRiRC9h90CRDjVEaz3NvXB/m1ySiAG8tYzgUQkV81K0",
-	"pivnOMZUQxmIBe8VbLtDm5U7WWQzkHNuOZcnya7EMzLllYjpvifNZbreKBMYBoykcmEMy9ylNR6vsKqg",
-	"agpg+1yUoV6QnEYKQblclpiWpLHW+qyWoPxvPgeRnaVklxCWAUXbOKZQcC2iyl6vR85G5KRB9He0ehXm",
-	"zvIzszaGYxjvG8kBjd5PeSmw8lMq3KkbNtG4eT1Q1jkUxRSsRIVwLUG6csl4M5RCQaaFd60bg2MMFdYD",
-	"9lZIUMm6Cxa4ZDbUd226V6w/Y5NlUOf4Gi6QSNhQA50MkrKm5xxD9kv73Qe4+pxce3XaDb1me7Oq+ugd",
-	"pgZIDKl+SdxtuT9w9jbqbcY5yMzbuvs+hdygMrS/VlIUde4SwQQHozEBTE5YNsJKoprhfLjKgZKvxGzg",
-	"r4M0BJewO7T6l3xN+SpIrxZCb0V7u4Ygc1lvt+9V8x9XcpYru4DVvcD5R2rP57NKiDJLGFxPh4lm+2fg",
-	"kuWXRsyuW7/3RKFN8hDtfI1HzfV65xOrVhVwKB4dEHLCbaSRd67pVjrqTc4f6LH5tzhrUdvcz06xf3DB",
-	"4yEbmNRH3pG/+WHGuZoCw/zuOJUdZE8a020iya2k15Gys0N/usnuLv1SoC1RWShiUsotU3VNOt9D5X6E",
-	"9IMqiOOvnzCTX+vFLK2NCKWltjJkV3h505p+ptVj9B32gBcqa4KKjJ4bOXD+YFfjNw1SgqUkKaGz/H36",
-	"H7fAli8FW6QwatIs0yYgtm5q3X0JlHvqZaMzi+N5qFrDtH2CY87foUpOoc3QpmENCMecS3lFy8+vVsN8",
-	"jieID1dcPr7Q8P0bItmiUt3O3+81nTR38Na9v6n5W1QD/g3MHkWNvW4oZ/xpKmF6ExmmuKclKUVbFxmH",
-	"JNc4prUOP/mSLFwUXSUhZ4r1AoyvfVWT5rmHRb6cj+VW73lf7lvnz0LfgYzdA0FU5Me2QoIWeD+0ELZH",
-	"9A9mKomTG6XyGPUNyCKCvxiPCtPZ7LkuLjtmY1txpucPKSTcs/k4cAS7ofl4mKhn6vKsidRcOrWC4Ton",
-	"39Yd3EYu6nZtU30fhsgdS6M/xWUhXh3DdEefCYsQLC1DEFTy65NfiYQl1o4U5PFjnODx47lr+uvT7mdz",
-	"nB8/jopxn81bwuLIjeHmjVKMM6YNQmFgWzGZSPr3zjF3d2Gj+Y5gB4hn5ywhWg0Gp/Z+o585FTTK3HsV",
-	"/HZprvE+fhagzC+5mSiG+59TsQvWPz8RJtM7CzUri32HshP01Fa+xbCeX1xA7h9Se/cXq8sesklX//Am",
-	"PnL9A4CIiay1M3kwVRDONCGSyXWLxC0hceW1ZHqHecK86pP9EvWp+b6xljgrcJNZxskdWlxCk2muta3U",
-	"yks23wtaoixg3jPooaiFKA/It1u6qUpwTOrrB4v/gGdfPS+Onj35j8VXR18c5fD8ixdHR/TFc/rkxbMn",
-	"8PSrL54fwZPlly8WT4unz58unj99/uUXL/Jnz58snn/54j8emDvAgGwBnfmsFLP/jQWqs5O3p9m5AbbF",
-	"Ca3YD7CztTANGfsqmzRHLggbysrZsf/pf3rudpCLTTu8/3Xmgt5na60rdXx4eH19fRB2OVyhMjXTos7X",
-	"h36eQRnOk7enTXiY9YXCHbWRP4YUcFMdKZzgt3ffnp2Tk7enBy3BzI5nRwdHB08wl3EFnFZsdjx7hj/h",
-	"6Vnjvh/6JMLHHz/NZ4droCXaxM0fG9CS5f6TuqarFcgDV27U/HT19NCLcYcfnSL509i3w7Byz+HHjr69",
-	"2NMTHV0OP/okVuOtO1minJ0h6DARirFmhwuMQJ7aFFTQOL0UfNypw4/4PEn+fujCMuMf8Zloz8ChN0rF",
-	"W3aw9FFvDay9HjnV+bquDj/if5AmA7CsE3QA7mwVs5h/D9p7hoVVRVrfvoa2TwvbfOBy5tLT2Xy9x++n",
-	"lSYDP515pRegmMthiFzCHIH2EPtop5ZFozk+yC07loXp0wdMxYLKajxWT4+O7q1i7wAXkdK9fQe8ovGd",
-	"e3705N4g6Xo0R8A45Wh8NqyIWFaLEDz/fBC8xPcvF5osGS9s+TFNkSrsFiNAX30+gDTbeKUxx9KLoJDn",
-	"f3GPFDJhX4ysREuCLe30zz7f9Gcgr1gO5Bw2lZBUsnJHfuJN3GiQxWzIO37il1xccw+5kV7qzYbKneMr",
-	"lPTPh69Sa3lMUF/aXJt0pVBrjKUvZnPrSf/hk+Nn9vQcYhKdXcvm/M877qK2SoiZ33/iCvyLw4Zr73ie",
-	"YnLY+GzH83cN5xnwD6TVz0gmZw28eILQPvtPwUL+PCx3PyzvYCOuQBF3jwXESSSYR4s1dqG3YkvDByOH",
-	"Zp687Z3mfDiTtxq0gw+u/j1nYvoudB+iI9b3SXDucZexw0+p/t9U1+/FSNipHsQ2aPYnI/iTEdwjI9C1",
-	"5MkjGtxf6EIGlUveldN8DQfTL9Edz8OXQSViSVLORpiFSw2R4hVnXV7xL/g++NzH+iXl/jx3dtz6LFBZ",
-	"MpANFVA+zNbxJxf47yM7o1zs3uBzoqEsVXj2tcCzb7XozjOYW3eEiXygXxs+9vPhx27JtY4yRK1rXYjr",
-	"oC8aL63lfagjaap1d/4+vKZMZ0shnVcw5pMedtZAy0OXdKT3axvnO/iCwcvBj4E+Jf7rYZNLL/qxr6iK",
-	"fXWKmkQjnzLKf24V1aHiFzlko/J9/8HwJ0wG65hnq8c8PjxET7u1UPpw9mn+safjDD9+aEjC52KbVZJd",
-	"YWj3h0//PwAA//9gJncgTcsAAA==",
+	"H4sIAAAAAAAC/+y9e5PcNpIg/lUQtRshW7+q7tbD3lFHTOyvLdkenSVboZY9dyfpPCgyqwrTLIBLgN1V",
+	"1vV3v8gEQIIkwGI/LM9szF9SF/FIJBKJRD4/zTK1LZUEafTs9NOs5BXfgoGK/uJZpmppFiLHv3LQWSVK",
+	"I5ScnfpvTJtKyPVsPhP4a8nNZjafSb6F2WnYfz6r4L9qUUE+OzVVDfOZzjaw5Tiw2ZfYuhlpt1irhRvi",
+	"zA7x8sXseuQDz/MKtB5C+ZMs9kzIrKhzYKbiUvMMP2l2JcyGmY3QzHVmQjIlgakVM5tOY7YSUOT6yC/y",
+	"v2qo9sEq3eTpJV23IC4qVcAQzudquxQSPFTQANVsCDOK5bCiRhtuGM6AsPqGRjENvMo2bKWqA6BaIEJ4",
+	"Qdbb2en7mQaZQ0W7lYG4pP+uKoDfYGF4tQYz+ziPLW5loFoYsY0s7aXDfgW6Loxm1JbWuBaXIBn2OmKv",
+	"a23YEhiX7O13z9mTJ0+e4UK23BjIHZElV9XOHq7Jdp+dznJuwH8e0hov1qriMl807d9+95zmP3cLnNqK",
+	"aw3xw3KGX9jLF6kF+I4REhLSwJr2oUP92CNyKNqfl7BSFUzcE9v4XjclnP8P3ZWMm2xTKiFNZF8YfWX2",
+	"c5SHBd3HeFgDQKd9iZiqcND3J4tnHz89mj86uf6392eL/+3+/OrJ9cTlP2/GPYCBaMOsriqQ2X6xroDT",
+	"adlwOcTHW0cPeqPqImcbfkmbz7fE6l1fhn0t67zkRY10IrJKnRVrpRl3ZJTDiteFYX5iVssCtKbRHLUz",
+	"oVlZqUuRQz5nQrKrjcg2LOPaDkHt2JUoCqTBWkOeorX46kYO03WIEoTrVvigBf3jIqNd1wFMwI64wSIr",
+	"lIaFUQeuJ3/jcJmz8EJp7yp9s8uKvdsAo8nxg71sCXcSaboo9szQvuaMa8aZv5rmTKzYXtXsijanEBfU",
+	"360GsbZliDTanM49ioc3hb4BMiLIWypVAJeEPH/uhiiTK7GuK9DsagNm4+68CnSppAamln+HzOC2/4/z",
+	"n35kqmKvQWu+hjc8u2AgM5Wn99hNGrvB/64VbvhWr0ueXcSv60JsRQTk13wntvWWyXq7hAr3y98PRrEK",
+	"TF3JFEB2xAN0tuW74aTvqlpmtLnttB1BDUlJ6LLg+yP2csW2fPfnk7kDRzNeFKwEmQu5ZmYnk0Iazn0Y",
+	"vEWlaplPkGEMblhwa+oSMrESkLNmlBFI3DSH4BHyZvC0klUAjpAHwBFyGjgSdhGawaOLX1jJ1xCQzBH7",
+	"2XEu+mrUBciGwbHlnj6VFVwKVeumUwJGmnpcvJbKwKKsYCUiNHbu0KEZZ7aNY69bJ+BkShouJORMSAu0",
+	"MmA5URKmYMLxx8zwil5yDV8/nV0f+jpx91eqv+ujOz5pt6nRwh7JyL2IX92BjYtNnf4THn/h3FqsF/bn",
+	"wUaK9Tu8SlaioGvm77h/Hg21JibQQYS/eLRYS27qCk4/yIf4F1uwc8Nlzqscf9nan17XhRHnYo0/Ffan",
+	"V2otsnOxTiCzgTX6mqJuW/sPjhdnx2YXfTS8UuqiLsMFZZ1X6XLPXr5IbbId86aEedY8ZcNXxbudf2nc",
+	"tIfZNRuZADKJu5JjwwvYV4DQ8mxF/+xWRE98Vf2G/5Rlgb1NuYqhFunY3bekG3A6g7OyLETGEYlv3Wf8",
+	"ikwA7CuBty2O6UI9/RSAWFaqhMoIOygvy0WhMl4stOGGRvr3Claz09m/HbfKlWPbXR8Hk7/CXufUCeVR",
+	"K+MseFneYIw3KNfoEWaBDJo+EZuwbI8kIiHtJiIpCWTBBVxyaY5m89iZbA/wezdTi28rylh8995XSYQz",
+	"23AJ2oq3tuEDzQLUM0IrI7SStLku1LL54YuzsmwxSN/PytLig0RDECR1wU5oo7+k5fP2JIXzvHxxxL4P",
+	"xyY5W6HuaAlO1MC7YeVuLXeLNYojt4Z2xAea0XaiJuZ63qBBazD3QXH0ZtioAqWeg7SCjf/i2oZkhr9P",
+	"6vzPQWIhbtPEha2Yw5x9wNAvwcvlix7lDAnH6XKO2Fm/7+3IBkeJE8ytaGV0P+24I3hsUHhV8dIC6L7Y",
+	"u1RIeoHZRhbWO3LTiYwuCnP7OaQ1gurWZ+3geYhCgh/6MHxTqOziL1xv7uHML/1Yw+NH07AN8BwqtuF6",
+	"czSLSRnh8WpHm3LEsCG93tkymOqoWeJ9Le/A0nJu+NGsD29cLLGop37E9KCKvF1+ov/wguFnPNvc+Hc5",
+	"6iQEHVEVWBByfMrbB4KdCRvgxhvFtvb1zvDVfSMon7eTx/dp0h59axUGbofcImiH1O7ej8E3aheD4Ru1",
+	"GxwBtQN9H/ShdvY/wsBWT4DvhYNM0f479PGq4vshkmnsKUjGBaLoquk0yPDGx1lazevZUlW34z49tiJZ",
+	"q09mHEcNmO+8hyRqWpcLR4oRnZRt0BuoNeGNM43+8DGMdbBwbvjvgAVteAD8HbDQHei+saC2pSjgHkh/",
+	"E2X6qCR48pid/+Xsq0ePf3381ddIkmWl1hXfsuXegGZfuLcZ02ZfwJfDlc1n9ukcH/3rp14L2R03No5W",
+	"dZXBlpfDoax204pAthnDdkOsddFMq24AnHI43wFycot2ZhX3CNoLuHytciCNxT3QYivrujUVkK/B6944",
+	"y+ESCtw+tlU5MPwfDTyk0xFhuuAGtInNcyfRGbEhNNcatst7Ic0U+eTtLDlz+5LDwaN1081up9mHG17t",
+	"q/o+HvZQVaqKaBtpI43KVLG4hEoLFTEcvXEtmGvhhf2y/7uFll1x7WgFclbLvLPT7cSo4Z58C9qh3+1k",
+	"i5vRe9CuN7I6N++Ufeki3+tVNSvRKLeTLIdlve68C1eV2uK5oY4ksXwPhgSjd2IL54Zvy59Wq9sK88Oz",
+	"ZQUkI7agcWymaHAr3vYOr1R55H6xHeKDtyYMDZmSOVrWzRU4mbGZlOSHDBeT1UZcOqD0hMPtJk+c7u/B",
+	"nO9ldnteN5lDbYUkU5Heyyx4+98To5oPzbAdevJ6XjvVAx0BBwnpL8ALs3kL5W2FsbHTFQ4efTRFJvdc",
+	"oJE5jGIPvv/2HTuugOf7B6SRsD9sqPtxDoaLQj/A5byi1Z5LXuqNuhe5yl9e2o05IlUdVP3Q/e7HQXZm",
+	"OJp8eFTbM5/5pguRGFU0N59vOoGkwlHn4zehw6bhBl5AYfi9E0h/ghiRPPf80W1Ejg1J1fZKrDcmeMW+",
+	"qZRa3T+MsVligNIHyyQL7DPUBPyocuTWptb3QJntYO0VgqQQXhx8qWrDOHFpUtvWOv4WSPj+kNMB+UqY",
+	"8HlhNvZZvwTkMhmvcbVohlGxC7ntuOCZpcOFZeaHLgjbyk5n/UoKYgJsCSCZWjp7pLOU0iI5uTEYfy7c",
+	"SyR6vAK4ykploDWqfK0i7yBovp29m80InghwAriZhWnFVry6M7AXlwfhvID9gpxuNPvih1/0l38AvEYZ",
+	"XhxALLWJobfRKgmZgHra9GME1588JDte4VVkqZYZRY+nAgykUHgjnCT3rw/RYBfvjpZLqMj8+7tSvJ/k",
+	"bgTUgPo70/tdoa3LhCup06agsI4bJrlUTvaNDga8KgRos+CXXBR8WcBiRLTwrSPmJccTN9zeDETX1pPM",
+	"kbhbmR2isiNodgUVvsJqiW52qrJ/S2XYCky2gbx5yE+k+4Jrszh0zWCjcECNOxJw9tjNQgMnUPOKa2Nd",
+	"MITMSXNskUDzWFThFGmAk+9WHPkX/2Qdjk1vFqlr3bxfdV2ihAt5bA3ot5Oe60fYNXOpVTB280g2itUa",
+	"Do2cwlIwvkOWk77pD25CUkIfpeHiyJ6Hcss+isoOEC0ixgA5960C7IbugQlAhG4RbQlH6B7lND6J85k2",
+	"qiyR+5lFLZt+KTSd29Zn5ue27ZC4uGnPXK5A05lx7R3kV/6M4SsGz6WDg235BcpSpEW0viJDmJG5LLSQ",
+	"GSzGKJ90AtgqPAIHmE5Cgetcz4PZeoejR79RoksSwYFdSC048Vh5wysjMlGS5PsD7O/9IdCfIGrjZPY1",
+	"CjkLPthHQRn2Z9b5pz/m7R4Gk1RdQ/AHuq7Icgqh6QLsAn8Be3qBvbFepe8CX9R7eNlERsXTzSUjQL2v",
+	"GgpkYRPY8cwUe2Zvu729tnS93ApjrJtw9+FjVLkIB4gaVUZmdBZE65Hpd2CKSfOchgqWN9yK+cxKiOPw",
+	"veuJiR10OMmwVKqYoBUYICMKwSRnE1Yq3HXhvNK967KnpA6QTigr9h5cZJ4PdAfNtAL2v1TNMi5JAK8N",
+	"NDeCqojN0vWLMwgdzOncSloMQQFbsO8K+vLwYX/hDx+6PReareDKh3I8fDhEx8OH9Kp/o7TpHK57UCvi",
+	"cXsZ4e1kbcKLwklufZ5y2K3BjTxlJ9/0BveT0pnS2hEuLv/ODKB3MndT1h7SyDSXDrObuPJgPdF1076/",
+	"hULx3MYw3JPiXdUmU1sbBsIyFx1h0V/RbEMuRlNA4m3QGOLp/bLhco2vGzBGyLV2sh2xSTdKjJUFlpUe",
+	"i6qAzMOLFsW3hYEcSFAZymC1gswwJTNohSk3kb4JeL2t92iKQJ2QJ87Fti7u6zxnSpPiZiVika0fPrzP",
+	"yg8fPrKfSjQCMmzNXOvmpFv7smaAz1vibt6aEB6EdaXqcu7Z2/o3QdJl81pY1qsVVNq75qA2DWXlEic7",
+	"YhQJ3PjkkR8PYgk0ztaE3XrAGqc+3JbWM39voBPV93+++M9TjObji99OFs/+v+OPn55ef/lw8OPj6z//",
+	"+f92f3py/ecv//PfY0+bFRdFXUHaf+HDh/erLWL0O9vSux7N/ZUQ4uyqDVxbOdmtrsj5kRVkeaoUzzOu",
+	"TdTeSCxBrheN+7yOgrPVCM5f3a3F5b4Xaj0VBraEjNcaAhnHQdA68OujyPuhdyD6KIwuZKLhCeP2iGUN",
+	"KJHp5hQRzyRV/++jp2+HjkE5nDjw3mw/phw48U1a7O+By9uBWAVlBRrh7+imtP2qVmGEpDvLeq8NbIfq",
+	"e9v118Rj8K1/Sg1e5koWQsJiqyTso0kBhITX9DHW20pDic4kl6b69p+aHfh7YHXnmUKNd8Uv7XZw/b9p",
+	"PJfv44rvjduz3ISxoaSZhKJknGWFAGk1HqaqM/NBctIkBIct4uHl9SNp3dJz3ySuzIromtxQHySnC73R",
+	"L0T54goifPk7aAy8ul6v7c3SSSMB8EG6VkKyWgpDc21xvxZ2w0qoyM3qyLbc8j1bYYyjUew3qBRb1qbL",
+	"XCmETRvUVFkzEk7D1OqD5IYVwLVhrwV6geBw/lb1NCPBXKnqosFC3Fa7Bgla6EXcE+17+5WchN3yN85h",
+	"GP/vOjsF7We/TT3sIk9C/vKFe4G/fEHPrNbyMID9s2lpMSozSmSh80WPttgXUpmGgL5sTTtu1z9I9MAx",
+	"CgPVRc7N7cihz+IGZ9Gejh7VdDaip3Tza73h4+UOXIZFmEyPNd76Gh86b8ZDGXEjfXQitmKrWtqtxOB+",
+	"MsdRpI4XS9Vq3oSr2jQ1p4xiGTfce4C6Px9/9fVs3sYgNt9n85n7+jFCySLfxSJNc9jF3qTugNDBeKBZ",
+	"yfcaEp4eBHvUQ856FITDbgGVGXojys/PKbQRyziH8/EPTre1ky+lDUzA80OGtb3Tb6vV54fbVAA5lGYT",
+	"S1/RkRSoVbubAD1nB4xQAjln4giO+rqlfA3a++oVwFdIoNaYMskxpzkHltA8VQRYDxcySYETox8Sbh23",
+	"vp7P3OWv710edwPH4OrPmfb5cgwTnbuufQRsGKYa0dnaD103GMO4S9pjo74/yA/yBayEFPj99IPMueHH",
+	"S65Fpo9rDdU3vOAyg6O1Yqc+uOsFN/yDHCpgUnm1grA6VtbLQmSoN4+Rp82VEn02ovYYH459j4Ch/Oqm",
+	"ivIXO8ECn/CqNguXDGJRwRWv8gjoukkGQCNT79FZ58yNTT+68ZkbP87zeFnqflDwcPllWeDyAzLULuQV",
+	"t4xpoyoviwjtoaH9/VG5i6HiVz6TSK1Bs79tefleSPORLT7UJydPgHWiZP/mrnykyX0JHZ3TrYKW+2oz",
+	"Wrh918DOVHxR8nVCaWCAk17IystbemQXBaNuIU6a6AMaql2Ax0d6AywcN440pMWd214+q1d8CfSJtpDa",
+	"oLjRmmdvu19BvO6tt6sX8zvYpdpsFni2o6vSSOJ+Z5pkP2supPY2c1SjkFbG5kVaovITsgvIKUULbEuz",
+	"n3e6q1VH0PSsQ2ibyshG21G+DTKEYIqjMudOFO8plBDDTsdKg76FC9i/U226jptkOugG3uvUQSVKDaRL",
+	"JNbw2Lox+pvvfJkQUl6WPn6dAhk9WZw2dOH7pA+yFXnv4RDHiKITGJ5CBK8iiKAOKRTcYqE43p1IP7Y8",
+	"fGUs7c0XyXzkeT9zTdrHk3PTCVfzbtN83wLlRVNXmi05yu3KpfSyweUBF6tRE5mQkENb1MQQ7o79igY5",
+	"dO9Fbzq0fncvtMF9EwXZNl7gmqOUAvgFSYUeMz1nMz+TNXdaBarVzzuELQsSkxqvPMt0eNWxCcr1GGhx",
+	"AoZKtgKHB6OLkVCy2XDts43l8+AsT5IBfsdkCWMpcl4GfkVB5rVG8e15bv+cDl6XLlGOz47jU+KET8sJ",
+	"6W3mM+eaHdsOJUkAyqGAtV24bewJpU3c0G4QwvHTalUICWwRc1HiWqtMECsKrhk3B6B8/JAxqwJmk0eI",
+	"kXEANpnxaWD2owrPplzfBEjpEk9wPzY5AAR/QzwqzDoho8ijSmThQibc3T0H4M6vrbm/et6iNAwTcs6Q",
+	"zV3yAqTxL752kEGmFhJbe3lZnCPJlylxdkQDby+WG62JetxqNaHM5IGOC3QjEC/VbmGDZKMS73K3RHqP",
+	"+mVjr+jBtDlxHmi2VDtyTqKrxfoBH4AlDYcHowWAkp3g2qlf6ja3wIxNOy5NxahQsy8a2aYll5Q4MWXq",
+	"hASTIpcvgjQ3twKgb49vcmK5x+/BR2pXPBle5u2tNm/Tt/mQl9jxTx2h6C4l8DfUwjSJaZwK4S1kqsrT",
+	"egokVGGaDNtD9YJtt0C+MTl1zUi277Pua8M/IYY7l/Ch6cDTzjOCiBc2YGsAybe7UmnQLqCLrno3uJMT",
+	"K7DB8NrqrNA4XTjBIIWm2IK9B5/HuF1ymxLQDzhNdo5tbuKRPwZLWcbhuMlL5a3DzwgUiVPewoEN7gqJ",
+	"SyM0Cst1mj7e9EX76EHptOolrwreWrHbAclnaM0c2kw1FECv50XntbG4gH1cCQAkmp37boGWj1Jkcbn/",
+	"MvBwrGAttIHW2iR0i+nPrcfnlJlTqVV6daasVri+t0o18hx1tFr8zjI/+woulYHFSlToi46muugSsNF3",
+	"mrRP32HT+KOis9nMJqkWefwSpWkxxigXRR2nVzfvDy9w2h/bYPd6SYKJkAx4tmFLSqoe9awemdo6348u",
+	"+JVd8Ct+b+uddhqwKU5cIbl05/gnORf9QOkRdhAhwBhxDHctidKRCzSIjx5yx+CBYQ8nXadHY2aKwWHK",
+	"/dgH/at8lHZKmLMjjayFXIOSruwRhxzrR2aZeltPJRrJLJVZdJQfEXQ1Ch5N7qZCMtndYLn208SD85R9",
+	"V08a2rU9MKCcPp48PJwTghcF5uk4HDLACeNegUOeEXYEcr1hFHzjfTwOS/XDHWgR1qy0D2OUWgbSzZjh",
+	"tn0auQyn7duaCBZxZ6XM6dY7lNA8vbX0PTTdleUCFQ/RoLa/BlFrvCwp5YNvHAvwwsEEuhPEwbGf5rGq",
+	"J0PlfS2k+fqpH/U+ku/2xpm+7DBF7RQUkDinb5HgN/3GDHYpRHN6UQmi9DOOM2IavHnZtdLpgPoS1zgv",
+	"S5HvenZPO2pSO34vGKMLyg12AAMBbcTCJSvQnX0PlHm2QEYnM+DRJMy86yYQDmWacCqhfXmnIaKa8PBD",
+	"uMLkWT/A/hdsS8uZXc9ndzOTxnDtRjyA6zfN9kbxTG541mzW8Xq4Icp5ic4tvFg4Y3KKNCt16UiTmnvb",
+	"82eW1uJc7923Z6/eOPDRXlcArxbNaye5KmpX/tOsymZBThwQXz5mw02jn7Ov4WDzm9StoQH6agOuVEfw",
+	"oB7kFG+dC9rxvEF6FfcGPmhedn4Qdokj/hBQNu4QramOOvc8IJrMCVaHLUZUsnZx0+7GKFcIB7izJ0V4",
+	"F90ruxmc7vjpaKnrAE8K5xopJrK19XI0U7LvLoevYJzBkip6cS/BWUCGzEnWW7IaLHQhsrg9VS4pxEZa",
+	"PxlszKhx4j2NI9Yi4XYlaxGMhc2mZJ7rARnMEUWmjubIa3G3VC7iqpbiv2pgIgdp8FNFp7J3UEl/6izr",
+	"w+s0LlW6galPMPxdZIwwG37/xnMy15iAEXrlDMB90Wj9/EIb6xOXXlq/qXNfOOPgShxxzHP04ajZBips",
+	"ut41kyX0g0URvf7NpeVPzBEtcij0YlWp3yCuqiINXySW2k1EwhT1nhBS1lpy2lqN7ezJ7U5JN8FH1nVI",
+	"TFA97XzggkMBjN4azaXdahuv2/FrjxNM0EIf2/FbgnEwD6JuCn615NlFXMhAmALzS8dubhTznT3unY1G",
+	"uJIMRyzwG2vaCptlpISqTXMwzMB2S4HBTjtZVGglA+zYkQnm1ten0CoyTC2vuDTgC03Yo+R6a7D6e+x1",
+	"pSrKEaTjJv4cMrGNKpc+fHifZ0Nzbi7WwhZuqzUElcHcQLbipaUiV13NutO1qHm5YifzoPag241cXAot",
+	"lgVQi0e2Bdq0aG3+LDddcHkgzUZT88cTmm9qmVeQm422iNWKNUIdPW8aRxWfW/WE2j16xr4gFx0tLuFL",
+	"xKK7n2enj56RgdX+cRK7AFyFxjFukq/CINc4HZOPkh0DGbcb9SiqDbBlddOMa+Q02a5TzhK1dLzu8Fna",
+	"csnXEPcK3R6Ayfal3SRbQA8vMrc1IbWp1J6JRLgxGI78KRFphuzPgsEytd0Ks3WOHFptkZ7asl92Uj+c",
+	"TQvmwsI9XP4j+UOV3h2k94j8vHYfe7/FVk1eaz/yLXTROmfcJobC8H/vqejryLCXPo8eZSBoKldY3OBc",
+	"uHQSc3ALKX28kIYeFrVZLf7Esg2veIbs7ygF7mL59dNI2Y5u+nh5M8A/O94r0FBdxlFfJcjeyxCuL8be",
+	"ycVWIKv/so3sDE5l0nErOq1J+QmNDz1VKMNRFklyqzvkxgNOfSfCkyMD3pEUm/XciB5vvLLPTpl1FScP",
+	"XuMO/fz2lZMytqqKJcdtj7uTOCowlYBLyJObhGPecS+qYtIu3AX6P9Z46kXOQCzzZzn5ELiJxSd4G5DN",
+	"J/RMvI21p2vp6chcsQ2kDxMtILYq9SG7x13q1XU63wQq12UidAklQicAtoexm72A765iCEw+nR1K4ai7",
+	"tBhlfqMiS/ZFjhobj4uYjOitUhcIfkAGtXRDzVm3oMzn96jxZpGhZwd+8bDSH31g/2BmQ0j2K0hsYlDs",
+	"KrqdefM9cC7j7Bu1m7qpPd7tN/YfADVRlNSiyH9pc4N0V7isuMw2UWeRJXb8ta163CzOHuZoduQNl9J6",
+	"IwyGs6+UX/1rJvLe+ruaOs9WyIlt++XN7HJ7i2sB74LpgfITInqFKXCCEKvdtAtNWF+xVjmjedrUte29",
+	"PiyLF5TroVRcsXuRPtjQAkO1n5GKqRMDmZMe44h9TwHQCEsnsybpD2yWJsibahdk6qnLQvF8znActEEx",
+	"O6vtY/OE2Wo1a3vtdlaR9s+9iaPtmG/tfUT02TJSi6bsTCxFCbZ45xsw0bMu0cM6xM4Re2F1Gtq/mO0k",
+	"NqFftYU8KK1jpWqiCfyPMZwybBvVYalpkp9eZslTpQ4Kvbv/Zw0l2nOHcLtKS7bQ0pwplByuBKbN2nAD",
+	"l9DNiuLB8GKAz5LSXV5VS2kpJSoVj6Wwug3aPXA0bmOAikLWQ/wNpRfnpn7DqlPn1CtGlIMSVoMK7zbH",
+	"RlOI87Wv0c+lkiKjzKuxq5kyOEyzzk5IUhuPDHD+NnoWOVzRwllNsIbDYrKU1nzWQdzQPBR8xU211GH/",
+	"NLBz+f7XYLTjbBix6KrhOQ21kBpc6nEkopBPqqpj8SYOGXWiaOXkG5IRBWcnVA7f4bcfnUIKjyC7ELbw",
+	"nUObJWhhdchUl9/ge1UYtlag3Xq6GWr0e+xzRMlacth9PPJ1/GkMazDGZVvviOFQZ95XwvkmYNvn2NYm",
+	"1Gt/7sTB2UnPytJNmq6VGM/yuZNJBEds3o2jV4DcZvxwtBFyG3VyovsUCQ3zcjJtoGQuNCZRKa8XBGOz",
+	"eSJFUQtm/aNjSIm7ib4S0ts04hdEFr0SaGPovCb66aziJtt02NAh1wjyi4gxNG2cUeyuQ/U22PmTltnM",
+	"z5HexrbIX4JxNA1awY3LPfOHAqk7ECaeY3CcdzoZluwjqcoJUS64plvEL8Y4kHH7hJzdC+BgLt6mu6l4",
+	"Bp2+E26iVKqSZZ2vwWAajJg+4Rv6yuirT1cKO6rX53LelyVDoPqpCofU5ibKlNT1dmQu3+CO0wVVMSPU",
+	"EFbm9DuMlIaqTvz3ZlmSnXvQjX3svS9Q3oTP3URu7o40kHqRpjHR62I6JuhOuTs62qlvR+ht/3ul9EKt",
+	"u4B85gRlY1wu3KMYf/sWL44wf9egioG9Wpr0WuQOqnxld3o2NolhulzJR50O5gwyL48rINI1oOd0+SXi",
+	"WgJdL7f3q7Vrp6JbsmQwFjcuf4LhbJQFJWPSrV8ZfbdQxHX6KV8y60qGnwe9p0mGAzmbxh5FqHdSHAL0",
+	"g/eAZiUXzmmjZRZDzLpwr7S6cOzQtRvcX4QLokpq7Gxp0OeYMSl1a/cS5tuUHNZ5iTrbfEuNLj9eqTaZ",
+	"QfyMbeotl5Qind6csCsLLrm/bHw0ZJ02/Ebx1qb68ClCSk4K6yteUWJQLopIjpC4xtMNlkagq706hjt9",
+	"C6zZNpN573Azo9UEeL5PGy1ksL8ORJsoPe45cqvK4f1FR6TiRKIWGhe4Ng6Le0cbjG+VXAdAH83md9p4",
+	"h3mPrkGqgxgp/HCZCh70MfX0vV85+AJcgrKygkuhau9a5H1PvXrF/kqOeJ0Y/SQvGaKOpvpjTQpJA8g7",
+	"V7jLLtNRyA+/WE9lBtJU+38Ac8hg0weldWP5vzuFdd1DJaq7NVPlzhdNdd6Ly8VW5WPJB374hb3wdtpJ",
+	"fMQTcix1mcpdOcto4oVXrviQb4YvucnTvnadzspyfOpEtoXh5LbhTadPpW3D8zmmwX7jz2+vrnuSw/nU",
+	"ABJ2Jl6qbxBZfkUXJFDe6CBJQDoTzVSCcgHDpPlZIIuFEQyHGRBd24lIfrd7he2nJa6Il4ROp29uUzYT",
+	"8yyVFm1ZuFit6Inu+++o3HNgfR+O5X1nLyEzqur4BFYAN0lGjZN52+a/0jinlY5NlIOn/5GUzfNZyFui",
+	"Qb/uePE23RRZqMl9ISKY2TYRZu86CzwkaMB3Q+APK17oeJXMpON4L4tQ4PwVSZoeX9jL/DAu/XLmgT+R",
+	"yMcRGY+qObNeOP8tkWljRO4XnYNqkeMv9EESkyART+oRcTBFlRNCab/WIMkembNVDDWHIwyprpi4PJA0",
+	"5q+25pVPSDL3VhWCZRXkkBFNxBol5725zbAFqOC3hKfg9wdOKt76AvYPNOtQQ7TK4NwL97fJy0oYoFsL",
+	"BY9SaV6kzMDOCVPohjIIC97D3naHNsN9srxzIOfcci5Pkl2JZ2TKS2XglnNh1xtl1aPgq1RemWGB1bT2",
+	"8AXVs9XO35Q3eV1DHTuaCwdF1VxeWErx03g++AyxoP1vPp+XnaUQFxAWoCY/E0pH4lokCiBam8xiRE4a",
+	"ZFKIVoKjPHR+ZtHGQw1j54d7bD0Js0JRFbVU6GA3BKlxmXygraM1iSlU1Y3gWkFVWQrAljg2LIzybqpj",
+	"cIyhQpM3+a2QoJM1TCxwyczCb9vUyVTLySaecWUZOwtkFWw5QlcFCY7Tc44h+7n97oPFIwUTE+N6el0c",
+	"zFDsI+GEHiAxpPoVc7fl4SD025iKhJRQLbzfSN8/V0IVAkc58PI6sxd0eDAac9rk5H8jrCRqZcmGqxwo",
+	"zAvKrP8qSOlxAftjq3+xBUTbVIUh9Fa0t2sIsgD2dvterWhxg0GxtgtY3wucf6Qlaj4rlSoWCeeFl8Ok",
+	"zf0zcCGw5AHDu8PHkCRKPLMvyGbeeKddbfY+SXFZgoT8yyPGzqSN2vOOat2qYb3J5QMzNv+OZs1rm0fd",
+	"GcmOPsh4+BMlyKruyN/8MONcTYPM7zyVHWR8IrNLJIzGCgTDgudD39TJrmP9ItQtUVkoYlLKLdPeTTrf",
+	"Q0NZhPSDiqLjr58wK2YbEVBZeytJS22V1a7w8ro1P02rbeo7HAAvVNa07Rpu5MD5g932XzdICZaSpITO",
+	"8g/pf9wCW74UbJGmCGRcpk3mbV0+u/sSKPf080ZnFsfzULVGKTCVpPzZQ5WcJvu7TWkcEA6ey+qSF59f",
+	"rUa5Uc8IH5C/TQs84fs3RLJFpb6d7+wrPmnugv8OU2MJw0uQfwXco6jjhBvKGX+aqrLeREblInjBCrUO",
+	"6nSjm/0VjUk7zR59zZYuIrWsIBNa9IL1r3yFoOa5RwXz7BSobR9/Xx5a5y/K3IGM7bKMKtmPbbURo+h+",
+	"aCFsj+gfzFQSJzdK5THqG5BFBH8xHhWmhjpwXVx0XDBs9aaeb7Gq4J5dMQKnyhu6YgyTXk1dHq2DLp1a",
+	"w3Cdk2/rDm4jF3W7tql+REPkjpWkmOL+E680g93J/8giBBsdMQKV/e3R31gFVI3fKPbwIU3w8OHcNf3b",
+	"4+5nPM4PH0bFuM/meWRx5MZw80YpxhnTBmFlsCtFlUig+dYxd3dhk/mOUQeIZ7otIFpZiab2Ptif9yK1",
+	"MvdBBb9dmmt8iJ8FKPNLbiaK4f6XVByQjXVJhJz1zgJGpx06lJ0AwraKNIXI/eqC2/+QOta/Wl32kE1a",
+	"WG/kb9o/AISYyFo7kwdTBaGBE6ICXbdIDCARV1ZXwuwp555XfYpfoz413zfWEmcFbrI0ObnDqAtosja2",
+	"tpVae8nme8ULkgXwPUPevgbrN7Fvd3xbFuCY1J8fLP8DnvzpaX7y5NF/LP908tVJBk+/enZywp895Y+e",
+	"PXkEj//01dMTeLT6+tnycf746ePl08dPv/7qWfbk6aPl06+f/ceD2XwmEGQL6Mx7zs3+JxV7X5y9ebl4",
+	"h8C2OOGlQIMU1ZVFMvYVa3lGXBC2XBSzU//T/++521Gmtu3w/teZSyAx2xhT6tPj46urq6Owy/GalKkL",
+	"o+psc+znGZS0PXvzsgm1tL5QtKM2ig5J4WjWksIZfXv77fk7dvbm5VFLMLPT2cnRydEjHF+VIHkpZqez",
+	"J/QTnZ4N7fuxI7bZ6afr+ezYupx1/jh26nL34xZMJTL/l/e0w//rK75eQ3XkavviT5ePj72cd/zJaZqv",
+	"x74dB3c6/tz+tRD5gZ7kCXP8yWeMG2/dScnmDBFBh4lQjDU7XqrdDZqCDhqnl0KvP338id4vyd+PXQx0",
+	"/CO9I+0hOfZWq3jLDpY+mR3C2uuRcZNt6vL4E/2HiDYAi/TW6+MKMEau/dkGIgSrmK1jlvbvwXiPsrCy",
+	"T+sT2JyJl7ltPnBVm88afqVnp++nlQcEPx2v8L9auDyixF3w6LSH3/tMtqydzPhBfuexTGjXH+czq9px",
+	"vkiPT07urWr2ABeR8tl9x7288bl7evLo3iDpRhVEwHgpyWiNLIxZFk0QPP18EDynd7NUhq2EzG0JQMOJ",
+	"KuwWE0B/+nwAGbH1ymbJKhevfz2ffXVy8vmAeCkNVJIXjFra6Z98vunPoboUGbB3sC1VxStR7NnPsond",
+	"DjIJDnnHz/JCqivpIUepp95uebV3fIWz/vnwlaItjwlqvM/mM8PRPvN+ZsvPzOY2muXjdcPPLrcqB8c+",
+	"Qz4X/n7M80suM3B8T18nG6rVyroejX0+/mT/jQyjJS/1Rhk98un4k/9v94Y50PC4Au1e7q6DZR3HlMVr",
+	"P/x5L13YaAExn4WfpQYTOs5jhxSHp8bne5m9bdjugHnSQf2MZ+S8gZfYBxm1/yH45784xd05xVvYqkvQ",
+	"zF3iAXEyPAeVsBZCcvFsafhohGPMk6KOMzcMZ/Kmlnbwgdxz4ExM34Xu633EZWESnAd8jOzwQ9XDcH/9",
+	"3vcDS+xUD2IbNPsXI/gXI7hHRmDqSiaPaHB/kd8dlC57YMazDRwdliCC2zJ8FpUqlqXpfIRZuNw0KV5x",
+	"3uUV/4SPo899rJ9z6c9zZ8etowevCgFVQwVcDtMF/YsL/Pd5ONCjwCkg5sxAUejw7BtFZ9+aHqgRE9L6",
+	"cEzkA2Wvgm3s5+NPnT+74rve1CZXV0Ffsvhad4Wh3qiJQu38fXzFhUEbjnOlpoT2w84GeHHssh71fm0T",
+	"DQy+UPaE4MdAxxT/9bhJ5hn92Ffexb465VWikc9Z5z+32v1QW04cstGTv/+I/ImyUTvm2Sp/T4+PyT1x",
+	"o7Q5nl3PP/UUw+HHjw1J+GSQs7ISlwjN9cfr/zcAQiyNTjHYAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
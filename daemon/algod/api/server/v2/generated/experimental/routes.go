@@ -8,11 +8,13 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"path"
 	"strings"
 
 	. "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/oapi-codegen/pkg/runtime"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 )
@@ -21,7 +23,7 @@ import (
 type ServerInterface interface {
 	// Simulates a raw transaction or transaction group as it would be evaluated on the network. WARNING: This endpoint is experimental and under active development. There are no guarantees in terms of functionality or future support.
 	// (POST /v2/transactions/simulate)
-	SimulateTransaction(ctx echo.Context) error
+	SimulateTransaction(ctx echo.Context, params SimulateTransactionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -35,8 +37,17 @@ func (w *ServerInterfaceWrapper) SimulateTransaction(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateTransactionParams
+	// ------------- Optional query parameter "profile" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "profile", ctx.QueryParams(), &params.Profile)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter profile: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.SimulateTransaction(ctx)
+	err = w.Handler.SimulateTransaction(ctx, params)
 	return err
 }
 
@@ -75,175 +86,188 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNrLgv4Ka96r8ccOR/JG8tapS7xQ7yepie12Wktw9y5dgyJ4ZrEiAC4DSTHz+",
-	"36/QAEiQBDkcSXF2r+4nW0N8NBqNRqM/P81SUZSCA9dqdvJpVlJJC9Ag8S+apqLiOmGZ+SsDlUpWaib4",
-	"7MR/I0pLxtez+YyZX0uqN7P5jNMCmjam/3wm4R8Vk5DNTrSsYD5T6QYKagbWu9K0rkfaJmuRuCFO7RBn",
-	"r2afRz7QLJOgVB/Kv/F8RxhP8yoDoiXliqbmkyI3TG+I3jBFXGfCOBEciFgRvWk1JisGeaYWfpH/qEDu",
-	"glW6yYeX9LkBMZEihz6cL0WxZBw8VFADVW8I0YJksMJGG6qJmcHA6htqQRRQmW7ISsg9oFogQniBV8Xs",
-	"5MNMAc9A4m6lwK7xvysJ8Dskmso16NnHeWxxKw0y0ayILO3MYV+CqnKtCLbFNa7ZNXBiei3Im0ppsgRC",
-	"OXn//Uvy7NmzF2YhBdUaMkdkg6tqZg/XZLvPTmYZ1eA/92mN5mshKc+Suv3771/i/OdugVNbUaUgflhO",
-	"zRdy9mpoAb5jhIQY17DGfWhRv+kRORTNz0tYCQkT98Q2vtdNCef/U3clpTrdlIJxHdkXgl+J/RzlYUH3",
-	"MR5WA9BqXxpMSTPoh+PkxcdPT+ZPjj//24fT5L/cn189+zxx+S/rcfdgINowraQEnu6StQSKp2VDeR8f",
-	"7x09qI2o8oxs6DVuPi2Q1bu+xPS1rPOa5pWhE5ZKcZqvhSLUkVEGK1rlmviJScVzw6bMaI7aCVOklOKa",
-	"ZZDNDfe92bB0Q1Kq7BDYjtywPDc0WCnIhmgtvrqRw/Q5RImB61b4wAX98yKjWdceTMAWuUGS5kJBosWe",
-	"68nfOJRnJLxQmrtKHXZZkYsNEJzcfLCXLeKOG5rO8x3RuK8ZoYpQ4q+mOWErshMVucHNydkV9nerMVgr",
-	"iEEabk7rHjWHdwh9PWREkLcUIgfKEXn+3PVRxldsXUlQ5GYDeuPuPAmqFFwBEcu/Q6rNtv+P87+9JUKS",
-	"N6AUXcM7ml4R4KnIhvfYTRq7wf+uhNnwQq1Lml7Fr+ucFSwC8hu6ZUVVEF4VS5Bmv/z9oAWRoCvJhwCy",
-	"I+6hs4Ju+5NeyIqnuLnNtC1BzZASU2VOdwtytiIF3X5zPHfgKELznJTAM8bXRG/5oJBm5t4PXiJFxbMJ",
-	"Mow2GxbcmqqElK0YZKQeZQQSN80+eBg/DJ5GsgrA8YMMglPPsgccDtsIzZija76Qkq4hIJkF+clxLvyq",
-	"xRXwmsGR5Q4/lRKumahU3WkARpx6XLzmQkNSSlixCI2dO3QY7mHbOPZaOAEnFVxTxiEznBeBFhosJxqE",
-	"KZhw/DHTv6KXVMHXz4cu8ObrxN1fie6uj+74pN3GRok9kpF70Xx1BzYuNrX6T3j8hXMrtk7sz72NZOsL",
-	"c5WsWI7XzN/N/nk0VAqZQAsR/uJRbM2priScXPLH5i+SkHNNeUZlZn4p7E9vqlyzc7Y2P+X2p9dizdJz",
-	"th5AZg1r9DWF3Qr7jxkvzo71NvpoeC3EVVWGC0pbr9Lljpy9GtpkO+ahhHlaP2XDV8XF1r80Du2ht/VG",
-	"DgA5iLuSmoZXsJNgoKXpCv/ZrpCe6Er+bv4py9z01uUqhlpDx+6+Rd2A0xmclmXOUmqQ+N59Nl8NEwD7",
-	"SqBNiyO8UE8+BSCWUpQgNbOD0rJMcpHSPFGaahzp3yWsZiezfztqlCtHtrs6CiZ/bXqdYycjj1oZJ6Fl",
-	"ecAY74xco0aYhWHQ+AnZhGV7KBExbjfRkBIzLDiHa8r1onmPtPhBfYA/uJkafFtRxuK7874aRDixDZeg",
-	"rHhrGz5QJEA9QbQSRCtKm+tcLOsfHp6WZYNB/H5alhYfKBoCQ6kLtkxp9QiXT5uTFM5z9mpBfgjHRjlb",
-	"8HxnLgcrapi7YeVuLXeL1Yojt4ZmxAeK4HYKuTBb49FgZPj7oDh8M2xEbqSevbRiGv/VtQ3JzPw+qfO/",
-	"BomFuB0mLnxFOczZBwz+ErxcHnYop084TpezIKfdvrcjGzNKnGBuRSuj+2nHHcFjjcIbSUsLoPti71LG",
-	"8QVmG1lY78hNJzK6KMzBGQ5oDaG69Vnbex6ikCApdGD4Nhfp1V+p2tzDmV/6sfrHD6chG6AZSLKharOY",
-	"xaSM8Hg1o005YqYhvt7JMphqUS/xvpa3Z2kZ1TRYmoM3LpZY1GM/ZHogI2+Xv+F/aE7MZ3O2Deu3wy7I",
-	"BTIwZY+zsyBk5ilvHwh2JtMAVQyCFPb1Tsyr+yAoXzaTx/dp0h59ZxUGbofcInCHxPbej8G3YhuD4Vux",
-	"7R0BsQV1H/RhxkExUkOhJsD3ykEmcP8d+qiUdNdHMo49BclmgUZ0VXgaeHjjm1kazevpUsjbcZ8OW+Gk",
-	"0ScTakYNmO+8gyRsWpWJI8WITso26AzUmPDGmUZ3+BjGWlg41/QPwIIyo94HFtoD3TcWRFGyHO6B9DdR",
-	"pr+kCp49Jed/Pf3qydNfn371tSHJUoq1pAVZ7jQo8tC9zYjSuxwe9VeGr6Mq1/HRv37utZDtcWPjKFHJ",
-	"FApa9oey2k0rAtlmxLTrY62NZlx1DeCUw3kBhpNbtBOruDegvWLKSFjF8l42YwhhWTNLRhwkGewlpkOX",
-	"10yzC5cod7K6j6csSClkRL+GR0yLVOTJNUjFRMRU8s61IK6FF2/L7u8WWnJDFTFzo+q34ihQRChLb/l0",
-	"vm+HvtjyBjejnN+uN7I6N++UfWkj32sSFSlBJnrLSQbLat16Ca2kKAglGXbEO/oH0Oc7nqJW7T6IdPiZ",
-	"VjCOKn6142nwZjMblUO2bm3C3d9mXax4/Zyd6oGKgGPQ8Ro/47P+FeSa3rv80p0gBvtLv5EWWJKZhvgK",
-	"fs3WGx0ImO+kEKv7hzE2SwxQ/GDF89z06Qvpb0UGZrGVuofLuBmsoXWzpyGF06WoNKGEiwxQo1Kp+DU9",
-	"YJZHeyCaMXV48+uNlbiXYAgppZVZbVUSNNL1OEfTMaGppd4EUaMGrBi1+cm2stNZk28ugWbmVQ+ciKUz",
-	"FTgjBi6SooVR+4vOCQmRs9SCq5QiBaUgS5yKYi9ovp1lInoETwg4AlzPQpQgKyrvDOzV9V44r2CXoD1c",
-	"kYc//qwe/QnwaqFpvgex2CaG3vrB5+xBfainTT9GcN3JQ7KjEojnueZ1aRhEDhqGUHgQTgb3rwtRbxfv",
-	"jpZrkGiZ+UMp3k9yNwKqQf2D6f2u0FblgJeXe+hcsAL1dpxyoSAVPFPRwXKqdLKPLZtGrdeYWUHACWOc",
-	"GAceEEpeU6WtNZHxDJUg9jrBeayAYqYYBnhQIDUj/+xl0f7YqbkHuapULZiqqiyF1JDF1sBhOzLXW9jW",
-	"c4lVMHYt/WpBKgX7Rh7CUjC+Q5ZdiUUQ1bXS3Znb+4tD1bS553dRVLaAaBAxBsi5bxVgN/R0GQCEqQbR",
-	"lnCY6lBO7V4znyktytJwC51UvO43hKZz2/pU/9S07RMX1c29nQkws2sPk4P8xmLW+jhtqHlC48ikoFdG",
-	"9sAHsTV79mE2hzFRjKeQjFG+OZbnplV4BPYc0gFdhPOiDGbrHI4O/UaJbpAI9uzC0IIHFCPvqNQsZSVK",
-	"ij/C7t4F5+4EUXU9yUBTZh7rwQcrRJdhf2Lt2N0xbydIT3rD9sHvPWIjy8mZwgujDfwV7PDF8s46SF0E",
-	"blX38BKIjGpON+UEAfVuF0aACZvAlqY635lrTm9gR25AAlHVsmBaW4+39kNBizIJB4jqB0dmdMpw61zk",
-	"d2CKdv4chwqW19+K+cxKVOPwXXTEqhY6nCRVCpFPeHv3kBGFYJLdlJTC7DpzDpbeC89TUgtIJ8SgJaRm",
-	"ng9UC824AvK/REVSylFgrTTUN4KQyGbx+jUzmAusntNZSBsMQQ4FWDkcvzx+3F3448duz5kiK7jxXsmm",
-	"YRcdjx/jK/idULp1uO5B02KO21mEt6Pi1FwUTobr8pT9Fjo38pSdfNcZvNa2mjOllCNcs/w7M4DOydxO",
-	"WXtII9OskzjuJJ1oMHRs3bjv56yo8vva8BVleSVh2LhweflhVVxefiTf25beLjj3RB6i46bxKl+526iS",
-	"6JlAcmaeB1LQLKVKR1WjuEi+TmrfNhUFp1AGnF/cOaR814mDmgoDWUJKK+vU6bi2g6DxrlOLiETU2d0u",
-	"CqMLmahdrHJtL+0Qq2spqpKoetstFWiq4Y/R1DVDx6DsTxy4VjQfh7wrjJSd7+7htrYDEQmlBIW8NXyd",
-	"KvtVrMLwBcd81U5pKPoKPNv11wHx9r0XDntvDcFzxiEpBIddNGKPcXiDH2O9LX8f6Iw37VDfrvDcgr8D",
-	"VnueKdR4V/zibgcM7V3tVnQPm98dt6O7DQM3UDcBeUkoSXOGmgvBlZZVqi85xbdRcNgi5lf/4ht+Lb/0",
-	"TeLP88jr2Q11ySma3usXU5QvriDCl78H8I9mVa3XoHRHSlwBXHLXinFScaZxrsLsV2I3rASJNtCFbVnQ",
-	"HVnRHB/3v4MUZFnpNnNF/3KlzdvbKpLNNESsLjnVJAfDVd8wfrHF4bwhxtMMB30j5FWNhUX0PKyBg2Iq",
-	"iZuJf7Bf0YPHLX/jvHkw2M9+tqpHM37jhL7T0Apg+98P//Pkw2nyXzT5/Th58d+OPn56/vnR496PTz9/",
-	"883/af/07PM3j/7z32M75WGPeT87yM9euTfF2SsUHBvdYw/2L6Z3KhhPokQWWtg6tEUeGvHXE9CjRrnr",
-	"dv2S6y03hHRNc5ZRfTty6LK43lm0p6NDNa2N6KgR/FoPFMfuwGVIhMl0WOOtr/G+Z0U8zgCV4S50AM/L",
-	"quJ2KyvlFPLoRust3GI1r2NJbAz5CcFAgw317hnuz6dffT2bNwEC9ffZfOa+foxQMsu2sTCQDLYxKdsd",
-	"EDwYDxQp6U6BjnMPhD1qzLc2xXDYAszzTG1Y+eU5hdJsGedw3jnRvda3/Ixbr0FzflC1vnMaO7H68nBr",
-	"CZBBqTex2NKWpICtmt0E6Jg7Symugc8JW8Ci+1rO1qC8W0EOdIUxjqgeFlOcretzYAnNU0WA9XAhk56k",
-	"MfpB4dZx68/zmbv81b3L427gGFzdOWs9uv9bC/Lgh+8uyJFjmOqBjUiyQwcxJBEtlHOTbhnCDTezEfU2",
-	"JOuSX/JXsGKcme8nlzyjmh4tqWKpOqoUyG9pTnkKi7UgJ97z+hXV9JL3JK3BpBeBzzspq2XOUnIVSsQN",
-	"edpA5uizkeZrYR6OXZtgX351U0X5i50guWF6IyqduEjNRMINlVkEdFVH6uHINs56bNY5cWNbVuwiQd34",
-	"cZ5Hy1J1I3b6yy/L3Cw/IEPl4lHMlhGlhfSyiBFQLDS4v2+FuxgkvfFhvpUCRX4raPmBcf2RJJfV8fEz",
-	"IK0Qlt/clW9ocldCS195q4iirq4SF27fNbDVkiYlXQ8oDTTQEncf5eUCH9l5TrBbK3TGuwbiUM0CPD6G",
-	"N8DCcXAYAC7u3PbyKTfiS8BPuIXYxogbjcHptvsVBNPcers6ATm9Xar0JjFnO7oqZUjc70wdib82Qpa3",
-	"Aiq2Rk8rl7RgCSTdQHoFGcZPQ1Hq3bzV3RuanaDpWQdTNs+AdYXHYFhU7S6BVGVGnSjeUSgZDCvQ2rt6",
-	"vYcr2F2IJpb2kDDEdlScGjqoSKmBdGmINTy2bozu5jtvBtR1laUPLsMoA08WJzVd+D7DB9mKvPdwiGNE",
-	"0YraGkIElRFEWOIfQMEtFmrGuxPpx5ZnXhlLe/NF0hJ43k9ck+bx5BwPwtVgMJr9XgAmLRE3iiypkduF",
-	"y7dhI78CLlYpuoYBCTnUrk+Mr2pp5HGQffde9KYTq+6F1rtvoiDbxolZc5RSwHwxpIKPmY67iZ/JGnCs",
-	"ApVgGi2HsGWOYlLtl2OZDpUtK4fNCzQEWpyAQfJG4PBgtDESSjYbqnwqEMyY4s/yJBngD4xkHItfPws8",
-	"JYK0KLXi2/Pc7jntvS5dFLsPXffx6uHTckLsuZHw0Tkzth2CowCUQQ5ru3Db2BNKE1XZbJCB42+rVc44",
-	"kCTmdEGVEimzuVyaa8bNAUY+fkyIVQGTySPEyDgAGw2TODB5K8KzydeHAMldVCj1Y6NJM/gb4g7s1g3R",
-	"iDyiNCyc8QGHV88BqPPUqe+vjr8YDkMYnxPD5q5pbtice/E1g/TCqFFs7QRNO9P4oyFxdkQDby+Wg9Zk",
-	"r6LbrCaUmTzQcYFuBOKl2CY2giUq8S63S0PvUc9MjKeJHUwbsP5AkaXYorsFXi3WE3APLMNweDCCF/6W",
-	"KaRX7Dd0m1tgxqYdl6ZiVKiQZJw6ryaXIXFiytQDEswQuTwMYtBvBUBH2dFka3SP372P1LZ40r/Mm1tt",
-	"3uRW8U7vseM/dISiuzSAv74Wpo4adyqE95AKmQ3rKQyhMl2nv+yrF1zyTsM3JseVj6TiPG2/NvwTor9z",
-	"A14BLXiaeUYQ8cqGbPQg+W5bCiPd2pAOG9/vkGLlRAk2Uk1ZnZVifJ07wWAITbEFe58kj3G75CZfjx9w",
-	"muwc29yBR/4YLGUZh+OQl8p7h58RKAZOeQMHyuF3hMTF+I/C8nmYPt51RfvoQWm717QzSwRvrdjtYMin",
-	"b83s20wV5ICv56T12kiuYjbuy8sPClA0O/fdAi0f5q+gfPco8NmSsGZKQ2NtMhKsx/SX1uNTTJslxGp4",
-	"dbqUK7O+90LU8pzNy4IdW8v84iu4FhqSFZNKJ2iqiy7BNPpeofbpe9M0/qhoe4XZDJIsi1+iOO0V7JKM",
-	"5VWcXt28P74y076tZQdVLVEwYZwATTdkiRlPo76iI1Nbd+LRBb+2C35N7229006DaWomloZc2nP8i5yL",
-	"zk03xg4iBBgjjv6uDaJ05AINIiT73DF4YNjDidfpYsxM0TtMmR97r3+Vj9McEubsSCNrQdegQefciEOO",
-	"9SOzTL1Jdh6NZeRCJy3lRwRdtYJHaXpl43HaG8zXtU4l7jZl39WThnZt9wzIp4/H9w/nhOAkh2vI9ztB",
-	"U8S4V+CgZ4QdAV1vCIYTeB+P/VJ9fwcahNUr7cIYpZaedDNmuG2eRi79WPO2RoI1uHOBw5Otd0ZC8/TW",
-	"0HffdFeWSQY5RMN0fgnicGhZYrC9bxwLWTGDMZ7BNg6O/TSPpSTvK+8rxrVNX3lfmfE640xfdpg/bgoK",
-	"Spvp7PDse8NvzGCXQjQPL2qAKGvjwCgjxsHrl11QzKFLfQPXOC1Llm07dk876qB2/F4whheUG2wPBgLa",
-	"iAWASVDtvIGNMs9mr26l7VlMwsxFO7tfKNOEUzHlay/0EVUHiO7D1QXQ/EfY/Wza4nJmn+ezu5lJY7h2",
-	"I+7B9bt6e6N4Rjc8azZreT0ciHJallJc0zxxxuQh0pTi2pEmNve25y8srcW53sV3p6/fOfA/z2dpDlQm",
-	"9WtncFXYrvyXWZVNUThwQHxu9w3VtX7OvoaDza/zqoUG6JsNuDzawYO6l/CzcS4IjqIzSK/i3sB7zcvO",
-	"D8IuccQfAsraHaIx1VlviLYHBL2mLPc2Mg/tgOcuLm7a3RjlCuEAd/akCO+ie2U3vdMdPx0Nde3hSeFc",
-	"I5m+C5vMXhHBu+5y5hWMpjck1YJiuk5rAekzJ14VaDVIVM7SuD2VLzHEhls/GdOYYOOB97QZsWIDble8",
-	"YsFYppmaoNTuABnMEUWmT/06hLulcFWIKs7+UQFhGXBtPkk8lZ2DivpTZ1nvX6dxqdINbK3xzfB3kTHC",
-	"VLXdG8/JXGMCRuiV0wP3Va318wutrU/mh8D94ADnvnDG3pU44pjn6MNRsw1U2LS9ayZL6HsrFnn9m8uZ",
-	"OzBHtAIRU8lKit8hrqpCDV8kOtQn52Xo0fo78AkhZY0lpymk1Mw+uN1D0k1ocWo7JA5QPe584IKDWUK9",
-	"NZpyu9W2IEjLrz1OMGEEyZEdvyEYB3Mv6ianN0saS6FqhAwDU2B+adnNtSC+s8e9s9Ewly95QQK/sbot",
-	"s3kTSpBN4HY/B9MtBQY77WRRoZEMkGpDmWBufX1yJSLDVPyGcltXBq0ReJRcb/PA9wqhGyEx64mKm/gz",
-	"SFkRVS5dXn7I0r45N2NrZquqVAqCsh1uIFuOylKRK31i3eka1JytyPE8KAzkdiNj10yxZQ7Y4oltsaQK",
-	"rFLFe274LmZ5wPVGYfOnE5pvKp5JyPRGWcQqQWqhDp83taPKEvQNACfH2O7JC/IQXXQUu4ZHBovufp6d",
-	"PHmBBlb7x3HsAnDlk8a4SbYKg1zjdIw+SnYMw7jdqIuoNsDWvBtmXCOnyXadcpawpeN1+89SQTldQ9wr",
-	"tNgDk+2Lu4m2gA5eeGYLNiktxY6wgXBj0NTwp4FIM8P+LBgkFUXBdOEcOZQoDD01NTnspH44W/3JpVP2",
-	"cPmP6A9VeneQziPyy9p97P0WWzV6rb2lBbTROifUprrJWeOp6JO8kzOfSQvzS9dppS1uzFxm6SjmoOPi",
-	"ipSScY0Pi0qvkr+QdEMlTQ37WwyBmyy/fh7Jqd3O7coPA/yL412CAnkdR70cIHsvQ7i+5CEXPCkMR8ke",
-	"NZGdwakcdNyKu+gM+QmNDz1VKDOjJIPkVrXIjQac+k6Ex0cGvCMp1us5iB4PXtkXp8xKxsmDVmaHfnr/",
-	"2kkZhZCx9JjNcXcShwQtGVyjn358k8yYd9wLmU/ahbtA/+caT73IGYhl/iwPPgQOsfgEbwO0+YSeibex",
-	"9rQtPS2ZK2r2wRfONAuILRm5z+5xl2Iyrc6HQOU59DToBpQIrQDYDsYOewHfXcUQmHxaOzSEo/bSYpT5",
-	"rYgs2VcgqG08LmIyorcaukDMB8Oglm6oOWlne//yHjXeLNL37DBfPKz4RxfYP5nZIJL9CgY2MahEEd3O",
-	"rP4eOJdR8q3YTt3UDu/2G/tPgJooSiqWZz83uUE6hT4k5ekm6iyyNB1/bUoS1ouzhzmaH3VDObfeCH3d",
-	"BL5SfvWvmch76+9i6jwF4xPbdmuP2OV2FtcA3gbTA+UnNOhlOjcThFhtp12ow/rytcgIztMk42zu9X7N",
-	"mqCywD8qUDp2L+IHG1qAGvWVoWKb4B94hnqMBfnBlhTfAGnlCkT9gc3SBJlPs25NPVWZC5rNiRnn4rvT",
-	"18TOavvYwlo2sf7aXrutVQz75x7iaDvmW3sfEX1m1Upj6k6laVHGUpSYFhe+AeZBCa1L+LAOsbMgr6xO",
-	"Q/kXs53E0MOKyQIyUk/npGqkCfMfrWm6QWVBi6UOk/z0ihCeKlVQhbWuplYn38VzZ+B2RSFsTYg5EUZy",
-	"uGHKVpKGa2hnRalTBDkxwGdJaS9PVpxbSolKxWMprG6Ddg+c9YL0BqgoZB3EHyi9ODf1AwtknGOvaDbL",
-	"brWNXvlVm2OjrpL1xhfQpVxwlmIuydjV7KpST7HOTki7GY8McP42ahY5XNEaH3WwhsPiYNUPzwgd4vrm",
-	"oeCr2VRLHfZPjeWPN1STNWjlOBtkc1+qxmmoGVfgkiljgfKATwrZsngjh4w6UTRy8oFkhMHZAyqH7823",
-	"t04hhVGLV4zj09PHSNgASatDxqK52rxXmSZrgREU7lCEa/pg+iwwWUsG248LX2QXx7AGY7Ns6x3RH+rU",
-	"+0o43wTT9qVpaxPqNT+34uDspKdl6SYdLmQUlQf0lg8iOGLzrh29AuTW44ejjZDbqJMT3qeG0OAaXSSg",
-	"JC40ZqCoTycIxgitlqKwBbH+0dE8WlE30deMQ1MCOnJBpNErATcGz+tAP5VKqq0IOImnXQDN0S8ixtCU",
-	"dkaxuw7V2WDnT1qmMz/H8DY29YgGGEfdoBHcKN/VlacNdQfCxEssee8Q2a8uhFKVE6JccE273lCMcRjG",
-	"7RNyti+A/jHoy0S2u5bUnpxDbqKhVCXLKluDTmiWxfQJ3+JXgl99ulLYQlrVWbzLkqSYma+dqrBPbW6i",
-	"VHBVFSNz+QZ3nC4o4BWhhrCImN9hdLxe7vDfWArr4Z1x7kEH+9h7X6CsDp87RG5uj9STeg1NJ4qtk+mY",
-	"wDvl7uhopr4doTf975XSc7FuA/KFE5SNcblwj2L87TtzcYT5u3p52e3VUqfXQndQ4cuu4rOxTgzT5ko+",
-	"6rQ3Z5B5eVwBMVygcY6X30BcS6DrpfZ+tXbtoeiWdDAYi2qXP0FTMsqCBmPSrV+ZjT5HKOI6/SFfMutK",
-	"Zj73ek+TDHtyNo49ilDvpNgH6EfvAU1KypzTRsMs+ph14V7D6sKxQ9dscHcRLohqUGP34/VQwJOPA7aR",
-	"HZ2SdlfgkiqVEq6ZqLw7hPeX809C+6srKR7EFQ+uv+83g1P9uWrQQaXthSufYpfp3uQ//my9KwlwLXf/",
-	"BCrc3qb3CgLGcha3ygE64Sqqb9JT78pXdU3Bq+ukENlYwPSPP5NX3rY06d7xhBxLtyQyV4QrGiz+2pWA",
-	"8M2M9Dl52jeu02lZjk89ECHen9w2PHT6oVRT5nyOad3e+fNryyiGKoTIWyUIZ+aw1fGCSb1o2BsgsC0B",
-	"c90Ggc3D2TOmEpQLcsTXapIDVTCC4TBrm2s7EckX29em/bRg+3ghy+GUs02aWWSepVCsKc4Tq3A50eX4",
-	"AotUBhbD/lje3+8aUi1ky49JAhySQNdMFlRP/v+pZwcUJbVntqf/kTSz81nIW6KBiu540SZFDlrV0OQa",
-	"SVVv20SYvevMzCGpYO6HMD+saK7itcoGnV07mU8Ch5VIouf4ws6yCdm+3XLmgQ8Ey8YRGY8EsM7f/28i",
-	"0/q13y86ezW7xl8VvcQLQfIQW1ppcYADSe1FjZIh7tcauCusvYqhZn9U1GoFqWbXexJd/LIBHiRRmHtN",
-	"MMKyCvJesDrKBhOKHm7naAAay0MxCk+Q2P/O4AzFiF7B7oEiLWqI1nqae+H+NrkkEQN4axnBoxQq5qVo",
-	"TVfOcYypmjIQC94r2HaHJiv3YJHNQM655VyeJNsSz8iU1yKm+540l+l6UCYwDBgZyoXRL3M3rPF4hVUF",
-	"VV0A2+eiDPWC5CxSCMrlssS0JLW11me1BOV/8zmI7Cw5u4KwDCjaxjGFgmsRVfZ6PXIyIif1or+j1asw",
-	"d5afmTUxHP1430gOaPR+SnOBlZ+Gwp3aYRO1m9cDZZ1DUUzBSlQI1wqkK5eMN0MuFCRaeNe6MTjGUGE9",
-	"YG+FBDVYd8ECN5gN9X2T7hXrz9hkGdQ5voYLJBIKaqCTQVLW4TnHkP3SfvcBrj4n116ddk2vyd6sqj56",
-	"h6keEkOqXxF3W+4PnL2NeptxDjLxtu6uTyE3qAztr6UUWZW6RDDBwahNAJMTlo2wkqhmOO2vsqfkyzEb",
-	"+OsgDcEV7I6s/iXdUL4O0quF0FvR3q4hyFzW2e171fzHlZz52i5gfS9w/pna8/msFCJPBgyuZ/1Es90z",
-	"cMXSKyNmV43f+0ChTfIQ7Xy1R83NZucTq5YlcMgeLQg55TbSyDvXtCsddSbnD/TY/FucNats7men2F9c",
-	"8njIBib1kXfkb36Yca6mwDC/O05lB9mTxnQ7kORW0ptI2dm+P91kd5duKdCGqCwUMSnllqm6Jp3vvnI/",
-	"QvpBFcTx10+Yya/xYpbWRoTSUlMZsi28vGlMP9PqMfoOe8ALlTVBRUbPjRw4f7Kr8ZsaKcFSBimhtfx9",
-	"+h+3wIYvBVukMGrSLNMmILZuau19CZR76mWtM4vjua9aw7R9gmPO375KTqHN0KZhDQjHnEt5TfMvr1bD",
-	"fI6niA9XXD6+0PD9GyLZolLdzt/vNZ00d/DWvb+p+TtUA/4CZo+ixl43lDP+1JUwvYkMU9zTnOSiqYuM",
-	"Q5IbHNNah598TZYuiq6UkDLFOgHGN76qSf3cwyJfzsdyq/e8L/et82eh70DG7oEgSvK2qZCgBd4PDYTN",
-	"Ef2TmcrAyY1SeYz6emQRwV+MR4XpbPZcF1cts7GtONPxhxQS7tl8HDiCHWg+7ifqmbo8ayI1l06loL/O",
-	"ybd1C7eRi7pZ21Tfhz5yx9LoT3FZiFfHMN3RZ8IiBEvLEASV/PbkNyJhhbUjBXn8GCd4/Hjumv72tP3Z",
-	"HOfHj6Ni3BfzlrA4cmO4eaMU44xpvVAY2JZMDiT9e++Yu7uw0XxHsAPEs3PmEK0Gg1N7v9EvnAoaZe69",
-	"Cn67NNd4Hz8LUOaXXE8Uw/3PQ7EL1j9/IEymcxYqlmf7DmUr6KmpfIthPb+6gNw/pfbur1aX3WeTrv7h",
-	"IT5y3QOAiImstTV5MFUQzjQhksl1i8QtIXGllWR6h3nCvOqT/Rr1qfmhtpY4K3CdWcbJHVpcQZ1prrGt",
-	"VMpLNj8ImqMsYN4z6KGohcgX5LstLcocHJP65sHyP+DZX55nx8+e/MfyL8dfHafw/KsXx8f0xXP65MWz",
-	"J/D0L189P4Ynq69fLJ9mT58/XT5/+vzrr16kz54/WT7/+sV/PDB3gAHZAjrzWSlm/xMLVCen786SCwNs",
-	"gxNash9hZ2thGjL2VTZpilwQCsry2Yn/6b977rZIRdEM73+duaD32UbrUp0cHd3c3CzCLkdrVKYmWlTp",
-	"5sjP0yvDefrurA4Ps75QuKM28seQAm6qI4VT/Pb+u/MLcvrubNEQzOxkdrw4XjzBXMYlcFqy2cnsGf6E",
-	"p2eD+37kkwiffPo8nx1tgOZoEzd/FKAlS/0ndUPXa5ALV27U/HT99MiLcUefnCL589i3o7Byz9Gnlr49",
-	"29MTHV2OPvkkVuOtW1minJ0h6DARirFmR0uMQJ7aFFTQeHgp+LhTR5/weTL4+5ELy4x/xGeiPQNH3igV",
-	"b9nC0ie9NbB2eqRUp5uqPPqE/0GaDMCyTtB9cK0b2JGt69//ecfT6I/9gbr15WI/H31qp21vIVRtKp2J",
-	"m6AvPoDs670/X13xq/X30Q1l2og0zrKIOan6nTXQ/MgFLnV+bXyFe1/QATr4MdiT+K9HdTx+9GOX2GNf",
-	"3WYPNPJhpyh0CRvaWnOfswzVcrZFqJiz1xso/a3IdiPlirfJknEqd+2Sxc31bj/2ZZl+QfUN2HSSXjsV",
-	"6mzxVemWEV68WlZg8+agZQF54NPj4xF4C7UuXZjMUKn0FWV5JSEphlRKl5cfVpj+6Xvb0isd5lGjGT7h",
-	"sQaYGbiJqqAkZ9dAllLQLKVqIBEVU2jHqqvmxV8ihQpTgnXKlKrpMJAlpNQ8yfQGdtba6CBo6vapCUkK",
-	"uyiMLmRKcW0XK4T+cCFWsV6BpwlfNv/54Ts/qgpuefxHgPuWZsSHhSfkDc0N2UOGBRYkOkO3AzufHz/5",
-	"ovCdcXQeMaIEsaLS5/nsqy+MpDNuBHuaE2xpIXj2RSE4B3nNUiAXUJRCUsnyHfmJ13HOQda9/tn6iV9x",
-	"ccM98EbarooC+V3NNhWhaBcJ6VPICLlSRZhudHpgoxKhGzW9IL+cvn979vaHEyuS19Kj+f+2BMkK4Jrm",
-	"aFGonDFHm3OcwTXkojSfMdWcBNRoc0HWFZWUawCXCFEW+OhcVTy1ASpM7wzQqwpLc5qrXkjLkuhaoWUG",
-	"y8vM5rMQBHOGt4nh12vgibsxkqXIdj5HqqQ3emsZxFHwzgrfLbOTD8GL5cPHzx/NN2la46dGDD85OkJD",
-	"8UYofTT7PP/UEdHDjx9r0H0qkVkp2TVGJn38/H8DAAD//5DFbRYMwgAA",
+	"H4sIAAAAAAAC/+y9fXPcNtIg/lVQ8zxVjv0bavyW7FpVW89PsZOsLrbjsrTZu7N9CYbsmcGKA3AJUJqJ",
+	"T9/9qhsACZIgh3qJs3t1f9ka4qXRaDQa/fp5lqptoSRIo2fHn2cFL/kWDJT0F09TVUmTiAz/ykCnpSiM",
+	"UHJ27L8xbUoh17P5TOCvBTeb2Xwm+RZmx2H/+ayEf1aihGx2bMoK5jOdbmDLcWCzL7B1PdIuWavEDXFi",
+	"hzh9Nbse+cCzrASt+1D+JPM9EzLNqwyYKbnUPMVPml0Js2FmIzRznZmQTElgasXMptWYrQTkmT7yi/xn",
+	"BeU+WKWbfHhJ1w2ISaly6MP5Um2XQoKHCmqg6g1hRrEMVtRoww3DGRBW39AopoGX6YatVHkAVAtECC/I",
+	"ajs7/jDTIDMoabdSEJf031UJ8BskhpdrMLNP89jiVgbKxIhtZGmnDvsl6Co3mlFbWuNaXIJk2OuIvam0",
+	"YUtgXLL3379kz549e4EL2XJjIHNENriqZvZwTbb77HiWcQP+c5/WeL5WJZdZUrd///1Lmv/MLXBqK641",
+	"xA/LCX5hp6+GFuA7RkhISANr2ocW9WOPyKFofl7CSpUwcU9s43vdlHD+P3RXUm7STaGENJF9YfSV2c9R",
+	"HhZ0H+NhNQCt9gViqsRBPzxOXnz6/GT+5PH1f3w4Sf6n+/PrZ9cTl/+yHvcABqIN06osQab7ZF0Cp9Oy",
+	"4bKPj/eOHvRGVXnGNvySNp9vidW7vgz7WtZ5yfMK6USkpTrJ10oz7sgogxWvcsP8xKySOWhNozlqZ0Kz",
+	"olSXIoNszoRkVxuRbljKtR2C2rErkedIg5WGbIjW4qsbOUzXIUoQrlvhgxb0r4uMZl0HMAE74gZJmisN",
+	"iVEHrid/43CZsfBCae4qfbPLip1vgNHk+MFetoQ7iTSd53tmaF8zxjXjzF9NcyZWbK8qdkWbk4sL6u9W",
+	"g1jbMkQabU7rHsXDO4S+HjIiyFsqlQOXhDx/7vookyuxrkrQ7GoDZuPuvBJ0oaQGppb/gNTgtv+3s5/e",
+	"MlWyN6A1X8M7nl4wkKnKhvfYTRq7wf+hFW74Vq8Lnl7Er+tcbEUE5Dd8J7bVlslqu4QS98vfD0axEkxV",
+	"yiGA7IgH6GzLd/1Jz8tKprS5zbQtQQ1JSegi5/sjdrpiW777y+O5A0cznuesAJkJuWZmJweFNJz7MHhJ",
+	"qSqZTZBhDG5YcGvqAlKxEpCxepQRSNw0h+AR8mbwNJJVAI6QB8ARcho4EnYRmsGji19YwdcQkMwR+5vj",
+	"XPTVqAuQNYNjyz19Kkq4FKrSdacBGGnqcfFaKgNJUcJKRGjszKFDM85sG8det07ASZU0XEjImJAWaGXA",
+	"cqJBmIIJxx8z/St6yTV883x2fejrxN1fqe6uj+74pN2mRok9kpF7Eb+6AxsXm1r9Jzz+wrm1WCf2595G",
+	"ivU5XiUrkdM18w/cP4+GShMTaCHCXzxarCU3VQnHH+Uj/Isl7MxwmfEyw1+29qc3VW7EmVjjT7n96bVa",
+	"i/RMrAeQWcMafU1Rt639B8eLs2Oziz4aXit1URXhgtLWq3S5Z6evhjbZjnlTwjypn7Lhq+J8518aN+1h",
+	"dvVGDgA5iLuCY8ML2JeA0PJ0Rf/sVkRPfFX+hv8URY69TbGKoRbp2N23pBtwOoOToshFyhGJ791n/IpM",
+	"AOwrgTctFnShHn8OQCxKVUBphB2UF0WSq5TniTbc0Ej/WcJqdjz7j0WjXFnY7noRTP4ae51RJ5RHrYyT",
+	"8KK4wRjvUK7RI8wCGTR9IjZh2R5JRELaTURSEsiCc7jk0hzN5rEz2RzgD26mBt9WlLH47ryvBhHObMMl",
+	"aCve2oYPNAtQzwitjNBK0uY6V8v6h69OiqLBIH0/KQqLDxINQZDUBTuhjX5Iy+fNSQrnOX11xH4IxyY5",
+	"W6HuaAlO1MC7YeVuLXeL1Yojt4ZmxAea0XaiJuZ6XqNBazD3QXH0ZtioHKWeg7SCjf/q2oZkhr9P6vzv",
+	"QWIhboeJC1sxhzn7gKFfgpfLVx3K6ROO0+UcsZNu39uRDY4SJ5hb0croftpxR/BYo/Cq5IUF0H2xd6mQ",
+	"9AKzjSysd+SmExldFObmc0hrBNWtz9rB8xCFBD90Yfg2V+nFX7ne3MOZX/qx+sePpmEb4BmUbMP15mgW",
+	"kzLC49WMNuWIYUN6vbNlMNVRvcT7Wt6BpWXc8KNZF964WGJRT/2I6UEZebv8RP/hOcPPeLa58e9y1EkI",
+	"OqIqsCBk+JS3DwQ7EzbAjTeKbe3rneGr+0ZQvmwmj+/TpD36zioM3A65RdAOqd29H4Nv1S4Gw7dq1zsC",
+	"agf6PuhD7ex/hIGtngDfKweZov136ONlyfd9JNPYU5CMC0TRVdNpkOGNj7M0mteTpSpvx306bEWyRp/M",
+	"OI4aMN95B0nUtCoSR4oRnZRt0BmoMeGNM43u8DGMtbBwZvjvgAVteAD8HbDQHui+saC2hcjhHkh/E2X6",
+	"qCR49pSd/fXk6ydPf3n69TdIkkWp1iXfsuXegGZfubcZ02afw8P+yuYz+3SOj/7Nc6+FbI8bG0erqkxh",
+	"y4v+UFa7aUUg24xhuz7W2mimVdcATjmc54Cc3KKdWcU9gvYKLt+oDEhjcQ+02Mi6bk05ZGvwujfOMriE",
+	"HLePbVUGDP9HA/fpdESYzrkBbWLz3El0RmwIzbWG7fJeSHOIfLJmloy5fcng4NG66WY30+zDDS/3ZXUf",
+	"D3soS1VGtI20kUalKk8uodRCRQxH71wL5lp4Yb/o/m6hZVdcO1qBjFUya+10MzFquCffgnbo851scDN6",
+	"D9r1Rlbn5p2yL23ke72qZgUa5XaSZbCs1q134apUWzw31JEklh/AkGB0LrZwZvi2+Gm1uq0w3z9bVkAy",
+	"Ygsax2aKBrfibefwSpVF7hfbIT54Y8LQkCqZoWXdXIGTGetJSX5IcTFpZcSlA0pPONxu8oHT/QOYs71M",
+	"b8/rJnOorZBkKtJ7mQZv/3tiVPO+GbZFT17Pa6d6oCPgICH9FXhuNu+huK0wNna6wsGjj6bI5J4L1DKH",
+	"UezBD9+ds0UJPNs/II2E/WFD3RcZGC5y/QCX85pWeyZ5oTfqXuQqf3lpN+aIVHVQ9UP3ux8H2ZnhaPLh",
+	"UW3PfOabJmJgVFHffL7pBJIKR52P34QOm4YbeAW54fdOIN0JYkTy0vNHtxEZNiRV22ux3pjgFfuuVGp1",
+	"/zDGZokBSh8sk8yxT18T8FZlyK1Npe+BMpvBmisESSG8OPhSVYZx4tKktq10/C0w4PtDTgfkK2HC54XZ",
+	"2Gf9EpDLpLzC1aIZRsUu5KZjwlNLh4ll5ocuCNvKTmf9SnJiAmwJIJlaOnuks5TSIjm5MRh/LtxLJHq8",
+	"AriKUqWgNap8rSLvIGi+nb2bzQieCHACuJ6FacVWvLwzsBeXB+G8gH1CTjeaffXjz/rhHwCvUYbnBxBL",
+	"bWLorbVKQg5APW36MYLrTh6SHS/xKrJUy4yix1MOBoZQeCOcDO5fF6LeLt4dLZdQkvn3d6V4P8ndCKgG",
+	"9Xem97tCWxUDrqROm4LCOm6Y5FI52Tc6GPAyF6BNwi+5yPkyh2REtPCtI+YlxxM33N4MRNfWk8yRuFuZ",
+	"HaK0I2h2BSW+wiqJbnaqtH9LZdgKTLqBrH7IT6T7nGuTHLpmsFE4oMYdCTh77GahgQdQ85prY10whMxI",
+	"c2yRQPNYVOEUwwAPvltx5J/9k7U/Nr1ZpK50/X7VVYESLmSxNaDfzvBcb2FXz6VWwdj1I9koVmk4NPIQ",
+	"loLxHbKc9E1/cBOSEvoo9RdH9jyUW/ZRVLaAaBAxBsiZbxVgN3QPHABE6AbRlnCE7lBO7ZM4n2mjigK5",
+	"n0kqWfcbQtOZbX1i/ta07RMXN82ZyxRoOjOuvYP8yp8xfMXguXRwsC2/QFmKtIjWV6QPMzKXRAuZQjJG",
+	"+aQTwFbhETjAdAYUuM71PJitczg69BslukEiOLALQwseeKy846URqShI8v0R9vf+EOhOELVxMvsahYwF",
+	"H+yjoAj7M+v80x3zdg+DSaquPvg9XVdkObnQdAG2gb+APb3A3lmv0vPAF/UeXjaRUfF0c8kIUO+rhgJZ",
+	"2AR2PDX5ntnbbm+vLV0tt8IY6ybcfvgYVSThAFGjysiMzoJoPTL9DkwxaZ7RUMHy+lsxn1kJcRy+846Y",
+	"2EKHkwwLpfIJWoEeMqIQTHI2YYXCXRfOK927LntKagHphLJ878FF5vlAt9BMK2D/Q1Us5ZIE8MpAfSOo",
+	"ktgsXb84g9DBnM6tpMEQ5LAF+66gL48edRf+6JHbc6HZCq58KMejR310PHpEr/p3SpvW4boHtSIet9MI",
+	"bydrE14UTnLr8pTDbg1u5Ck7+a4zuJ+UzpTWjnBx+XdmAJ2TuZuy9pBGprl0mN3ElQfria6b9v095Ipn",
+	"NobhnhTvqjKp2towEJa66AiL/pJm63MxmgIG3ga1IZ7eLxsu1/i6AWOEXGsn2xGbdKPEWFlgWemwqBLI",
+	"PJw0KL4tDORAgspQBqsVpIYpmUIjTLmJ9E3A62y9R1ME6gF54kxsq/y+znOqNCluViIW2frx44e0+Pjx",
+	"E/upQCMgw9bMta5PurUvawb4vCXu5q0J4UFYl6oq5p69rX8TJF3Wr4VltVpBqb1rDmrTUFYucLIjRpHA",
+	"tU8e+fEglkDjbHXYrQesdurDbWk88/cGWlF9/+ur/zrGaD6e/PY4efH/LT59fn798FHvx6fXf/nL/27/",
+	"9Oz6Lw//6z9jT5sVF3lVwrD/wsePH1ZbxOj3tqV3PZr7KyHE2VUTuLZysltVkvMjy8nyVCqepVybqL2R",
+	"WIJcJ7X7vI6Cs9UIzt/drcXlvhNqPRUGtoSUVxoCGcdB0Djw66PI+6FzILoojC5kouEJ4/aIZfUoken6",
+	"FBHPJFX/76Onb4aOQdmfOPDebD4OOXDimzTf3wOXtwOxEooSNMLf0k1p+1WtwghJd5b1XhvY9tX3tusv",
+	"A4/B9/4p1XuZK5kLCclWSdhHkwIICW/oY6y3lYYGOpNcOtS3+9Rswd8Bqz3PFGq8K35pt4Pr/13tuXwf",
+	"V3xn3I7lJowNJc0k5AXjLM0FSKvxMGWVmo+SkyYhOGwRDy+vHxnWLb30TeLKrIiuyQ31UXK60Gv9QpQv",
+	"riDCl7+H2sCrq/Xa3iytNBIAH6VrJSSrpDA01xb3K7EbVkBJblZHtuWW79kKYxyNYr9BqdiyMm3mSiFs",
+	"2qCmypqRcBqmVh8lNywHrg17I9ALBIfzt6qnGQnmSpUXNRbitto1SNBCJ3FPtB/sV3ISdsvfOIdh/L/r",
+	"7BS0X/w29bCLbBDy01fuBX76ip5ZjeWhB/sX09JiVGaUyELniw5tsa+kMjUBPWxMO27XP0r0wDEKA9VF",
+	"xs3tyKHL4npn0Z6ODtW0NqKjdPNrveHj5Q5chkWYTIc13voa7ztvxkMZcSN9dCK2YqtK2q3E4H4yx1Gk",
+	"jhdL1Wpeh6vaNDXHjGIZN9x7gLo/n379zWzexCDW32fzmfv6KULJItvFIk0z2MXepO6A0MF4oFnB9xoG",
+	"PD0I9qiHnPUoCIfdAioz9EYUX55TaCOWcQ7n4x+cbmsnT6UNTMDzQ4a1vdNvq9WXh9uUABkUZhNLX9GS",
+	"FKhVs5sAHWcHjFACOWfiCI66uqVsDdr76uXAV0ig1pgyyTGnPgeW0DxVBFgPFzJJgROjHxJuHbe+ns/c",
+	"5a/vXR53A8fg6s457PPlGCY6d137CNgwTDWis7Uf2m4whnGXtMdGfX+UH+UrWAkp8PvxR5lxwxdLrkWq",
+	"F5WG8luec5nC0VqxYx/c9Yob/lH2FTBDebWCsDpWVMtcpKg3j5GnzZUSfTai9hgfjl2PgL786qaK8hc7",
+	"QYJPeFWZxCWDSEq44mUWAV3XyQBoZOo9OuucubHpRzc+c+PHeR4vCt0NCu4vvyhyXH5AhtqFvOKWMW1U",
+	"6WURoT00tL9vlbsYSn7lM4lUGjT7dcuLD0KaTyz5WD1+/AxYK0r2V3flI03uC2jpnG4VtNxVm9HC7bsG",
+	"dqbkScHXA0oDA5z0QlZe3tIjO88ZdQtxUkcf0FDNAjw+hjfAwnHjSENa3Jnt5bN6xZdAn2gLqQ2KG415",
+	"9rb7FcTr3nq7OjG/vV2qzCbBsx1dlUYS9ztTJ/tZcyG1t5mjGoW0MjYv0hKVn5BeQEYpWmBbmP281V2t",
+	"WoKmZx1C21RGNtqO8m2QIQRTHBUZd6J4R6GEGHY6Vhr0PVzA/lw16TpukumgHXivhw4qUWogXSKxhsfW",
+	"jdHdfOfLhJDyovDx6xTI6MniuKYL32f4IFuR9x4OcYwoWoHhQ4jgZQQR1GEIBbdYKI53J9KPLQ9fGUt7",
+	"80UyH3nez1yT5vHk3HTC1Zxv6u9boLxo6kqzJUe5XbmUXja4POBiFWoiByTk0BY1MYS7Zb+iQQ7de9Gb",
+	"Dq3f7Qutd99EQbaNE1xzlFIAvyCp0GOm42zmZ7LmTqtAtfp5h7BlTmJS7ZVnmQ4vWzZBuR4DLU7AUMpG",
+	"4PBgtDESSjYbrn22sWwenOVJMsDvmCxhLEXOaeBXFGReqxXfnud2z2nvdekS5fjsOD4lTvi0nJDeZj5z",
+	"rtmx7VCSBKAMcljbhdvGnlCaxA3NBiEcP61WuZDAkpiLEtdapYJYUXDNuDkA5eNHjFkVMJs8QoyMA7DJ",
+	"jE8Ds7cqPJtyfRMgpUs8wf3Y5AAQ/A3xqDDrhIwijyqQhQs54O7uOQB3fm31/dXxFqVhmJBzhmzukucg",
+	"jX/xNYP0MrWQ2NrJy+IcSR4OibMjGnh7sdxoTdTjVqsJZSYPdFygG4F4qXaJDZKNSrzL3RLpPeqXjb2i",
+	"B9PmxHmg2VLtyDmJrhbrB3wAlmE4PBgNAJTsBNdO/YZucwvM2LTj0lSMCjX7qpZtGnIZEiemTD0gwQyR",
+	"y1dBmptbAdC1x9c5sdzj9+AjtS2e9C/z5labN+nbfMhL7PgPHaHoLg3gr6+FqRPTOBXCe0hVmQ3rKZBQ",
+	"hakzbPfVC7ZdgnxjcuqakWzfJ+3Xhn9C9HduwIemBU8zzwgiXtmArR4k3+0KpUG7gC666t3gTk4swQbD",
+	"a6uzQuN07gSDITTFFuw9+DzG7ZKblIB+wGmyc2xzBx75Y7AURRyOm7xU3jv8jEAxcMobOLDBXSFxaYRG",
+	"Ybkepo93XdE+elBarTrJq4K3Vux2QPLpWzP7NlMNOdDrOWm9NpIL2MeVAECi2ZnvFmj5KEUWl/uHgYdj",
+	"CWuhDTTWJqEbTH9pPT6nzJxKrYZXZ4pyhet7r1Qtz1FHq8VvLfOLr+BSGUhWokRfdDTVRZeAjb7XpH36",
+	"HpvGHxWtzWY2SbXI4pcoTYsxRpnIqzi9unl/fIXTvm2C3aslCSZCMuDphi0pqXrUs3pkaut8P7rg13bB",
+	"r/m9rXfaacCmOHGJ5NKe49/kXHQDpUfYQYQAY8TR37VBlI5coEF8dJ87Bg8MezjpOj0aM1P0DlPmxz7o",
+	"X+WjtIeEOTvSyFrINWjQlT3ikGP9yCxTb+qpRCOZpTJJS/kRQVet4NHkbiokk+0Nlms/TTw4T9l39aSh",
+	"XdsDA8rp48nDwzkhOMkxT8fhkAFOGPcKHPKMsCOQ6w2j4Bvv43FYqu/vQIOweqVdGKPU0pNuxgy3zdPI",
+	"ZTht3tZEsIg7K2VOt96hhObpraHvvumuKBJUPESD2v4eRK3xoqCUD75xLMALBxPoThAHx36ax6qe9JX3",
+	"lZDmm+d+1PtIvtsZZ/qywxS1U1BA4py+RYLf4TdmsEshmocXNUCUfsZxRkyD1y+7RjrtUd/ANc6LQmS7",
+	"jt3TjjqoHb8XjNEF5QY7gIGANmLhkiXo1r4HyjxbIKOVGfBoEmbO2wmEQ5kmnEpoX96pj6g6PPwQrjB5",
+	"1o+w/xnb0nJm1/PZ3cykMVy7EQ/g+l29vVE8kxueNZu1vB5uiHJeoHMLzxNnTB4izVJdOtKk5t72/IWl",
+	"tTjXO//u5PU7Bz7a63LgZVK/dgZXRe2Kf5tV2SzIAwfEl4/ZcFPr5+xrONj8OnVraIC+2oAr1RE8qHs5",
+	"xRvngmY8b5Bexb2BD5qXnR+EXeKIPwQUtTtEY6qjzh0PiDpzgtVhixGVrF3ctLsxyhXCAe7sSRHeRffK",
+	"bnqnO346Guo6wJPCuUaKiWxtvRzNlOy6y+ErGGewpIpe3EtwFpA+c5LVlqwGic5FGrenyiWF2EjrJ4ON",
+	"GTUeeE/jiJUYcLuSlQjGwmZTMs91gAzmiCJTR3PkNbhbKhdxVUnxzwqYyEAa/FTSqewcVNKfOst6/zqN",
+	"S5VuYOoTDH8XGSPMht+98ZzMNSZghF45PXBf1Vo/v9Da+sSll9Zv6twXzti7Ekcc8xx9OGq2gQqbtnfN",
+	"ZAn9YFFEr39zafkH5ogWORQ6WZXqN4irqkjDF4mldhORMEW9J4SUNZacplZjM/vgdg9JN8FH1nZIHKB6",
+	"2vnABYcCGL01mku71TZet+XXHieYoIVe2PEbgnEw96Jucn615OlFXMhAmALzS8tubhTznT3unY1GuJIM",
+	"RyzwG6vbCptlpICySXPQz8B2S4HBTjtZVGgkA+zYkgnm1tcn1yoyTCWvuDTgC03Yo+R6a7D6e+x1pUrK",
+	"EaTjJv4MUrGNKpc+fvyQpX1zbibWwhZuqzQElcHcQLbipaUiV13NutM1qDldscfzoPag241MXAotljlQ",
+	"iye2Bdq0aG3+LNddcHkgzUZT86cTmm8qmZWQmY22iNWK1UIdPW9qRxWfW/UxtXvygn1FLjpaXMJDxKK7",
+	"n2fHT16QgdX+8Th2AbgKjWPcJFuFQa5xOiYfJTsGMm436lFUG2DL6g4zrpHTZLtOOUvU0vG6w2dpyyVf",
+	"Q9wrdHsAJtuXdpNsAR28yMzWhNSmVHsmBsKNwXDkTwORZsj+LBgsVdutMFvnyKHVFumpKftlJ/XD2bRg",
+	"Lizcw+U/kj9U4d1BOo/IL2v3sfdbbNXktfaWb6GN1jnjNjEUhv97T0VfR4ad+jx6lIGgrlxhcYNz4dJJ",
+	"zMEtpPTxQhp6WFRmlfyZpRte8hTZ39EQuMnym+eRsh3t9PHyZoB/cbyXoKG8jKO+HCB7L0O4vhh7J5Ot",
+	"QFb/sInsDE7loONWdFoz5Cc0PvRUoQxHSQbJrWqRGw849Z0IT44MeEdSrNdzI3q88cq+OGVWZZw8eIU7",
+	"9Lf3r52UsVVlLDluc9ydxFGCKQVcQja4STjmHfeizCftwl2g/2ONp17kDMQyf5YHHwI3sfgEbwOy+YSe",
+	"ibex9rQtPS2ZK7aB9GGiBcRWpT5k97hLvbpW55tA5bpMhG5AidAKgO1g7GYv4LurGAKTT2uHhnDUXlqM",
+	"Mr9VkSX7Ike1jcdFTEb0VkMXCH5ABrV0Q81Zu6DMl/eo8WaRvmcHfvGw0h9dYP9gZkNI9isY2MSg2FV0",
+	"O7P6e+Bcxtm3ajd1Uzu822/svwBqoiipRJ793OQGaa9wWXKZbqLOIkvs+EtT9bhenD3M0ezIGy6l9Ubo",
+	"DWdfKb/410zkvfUPNXWerZAT23bLm9nldhbXAN4G0wPlJ0T0CpPjBCFW22kX6rC+fK0yRvM0qWube71f",
+	"Fi8o10OpuGL3In2woQWGaj8jFVMnBjIjPcYR+4ECoBGWVmZN0h/YLE2Q1dUuyNRTFbni2ZzhOGiDYnZW",
+	"28fmCbPVatb22m2tYtg/9yaOtmO+tfcR0WfLSCV12ZlYihJsce4bMNGxLtHDOsTOEXtldRrav5jtJDah",
+	"X7mFLCitY6Vqogn8jzGcMmwb1WKpwyQ/vcySp0odFHp3/09rSrTnDuF2lZZsoaU5Uyg5XAlMm7XhBi6h",
+	"nRXFg+HFAJ8lpb28spLSUkpUKh5LYXUbtHvgaNzaABWFrIP4G0ovzk39hlWnzqhXjCh7Jax6Fd5tjo26",
+	"EOcbX6OfSyVFSplXY1czZXCYZp2dkKQ2Hhng/G30LHK4ooWz6mANh8XBUlrzWQtxffNQ8BU31VKH/dPA",
+	"zuX7X4PRjrNhxKKrhuc01EJqcKnHkYhCPqnKlsWbOGTUiaKRk29IRhScPaBy+B6/vXUKKTyC7ELYwncO",
+	"bZaghdUhU11+g+9VYdhagXbraWeo0R+wzxEla8lg9+nI1/GnMazBGJdtvSP6Q514Xwnnm4BtX2Jbm1Cv",
+	"+bkVB2cnPSkKN+lwrcR4ls+dHERwxOZdO3oFyK3HD0cbIbdRJye6T5HQMC8n0wYK5kJjBirldYJgbDZP",
+	"pChqwax/dAwpcTfR10J6m0b8gkijVwJtDJ3XgX46LblJNy02dMg1gvwiYgxNG2cUu+tQnQ12/qRFOvNz",
+	"DG9jU+RvgHHUDRrBjcs984cCqTsQJl5icJx3OumX7COpyglRLrimXcQvxjiQcfuEnO0L4GAu3rq7KXkK",
+	"rb4TbqKhVCXLKluDwTQYMX3Ct/SV0VefrhR2VK/P5bwvCoZAdVMV9qnNTZQqqavtyFy+wR2nC6piRqgh",
+	"rMzpdxgpDVWd+O/NsiQ796Ab+9h7X6CsDp+7idzcHqkn9SJNY6LXZDom6E65OzqaqW9H6E3/e6X0XK3b",
+	"gHzhBGVjXC7coxh/+w4vjjB/V6+Kgb1a6vRa5A6qfGV3ejbWiWHaXMlHnfbmDDIvjysghmtAz+nyG4hr",
+	"CXS93N6v1q49FN2SDgZjcePyJxjORlnQYEy69Suj7xaKuE5/yJfMupLh517vaZJhT86msUcR6p0U+wD9",
+	"6D2gWcGFc9pomEUfsy7ca1hdOHbomg3uLsIFUQ1q7Gxp0JeYMWno1u4kzLcpOazzEnW2+ZZqXX68Uu1g",
+	"BvETtqm2XFKKdHpzwq7IueT+svHRkNWw4TeKtybVh08RUnBSWF/xkhKDcpFHcoTENZ5usGEEutqrY7jT",
+	"t8CabTOZ9/Y3M1pNgGf7YaOFDPbXgWgTpcc9R25VOby76IhUPJCohcYFro3D4t7RBuNbJdcB0Eez+Z02",
+	"3mHeo6uX6iBGCj9eDgUP+ph6+t6tHHwBLkFZUcKlUJV3LfK+p169Yn8lR7xWjP4gL+mjjqb6Y00KgwaQ",
+	"c1e4yy7TUciPP1tPZQbSlPt/AXNIb9N7pXVj+b9bhXXdQyWquzVT5c5XdXXei8tkq7Kx5AM//sxeeTvt",
+	"JD7iCTmWukxlrpxlNPHCa1d8yDfDl9zkad+4TidFMT71QLaF/uS24U2nH0rbhudzTIP9zp/fTl33QQ7n",
+	"UwNI2Jl4qb5eZPkVXZBAeaODJAHDmWimEpQLGCbNT4IsFkYwHGZAdG0nIvl89xrbT0tcES8JPZy+uUnZ",
+	"TMyzUFo0ZeFitaInuu+fU7nnwPreH8v7zl5CalTZ8gksAW6SjBon87bN/5fGeVjpWEc5ePofSdk8n4W8",
+	"JRr0644Xb9JNkYWa3BcigpltE2H2rrPAQ4IGfDcE/rDiuY5XyRx0HO9kEQqcvyJJ0+MLO80O49IvZx74",
+	"E4lsHJHxqJoT64XzfyUybYzI/aKzVy1y/IXeS2ISJOIZekQcTFHlhFDarzVIskdmbBVDzeEIQ6orJi4P",
+	"JI35u6155ROSzL1VhWBZBTlkRB2xRsl5b24zbADK+S3hyfn9gTMUb30B+weataghWmVw7oX72+RlJQzQ",
+	"rYWCR6E0z4fMwM4JU+iaMggL3sPedocmw/1geedAzrnlXJ4k2xLPyJSXysAt58KuN8qqR8FXQ3ll+gVW",
+	"h7WHr6ierXb+przO6xrq2NFc2Cuq5vLCUoqf2vPBZ4gF7X/z+bzsLLm4gLAANfmZUDoS12KgAKK1ySQj",
+	"clIvk0K0EhzlofMziyYeqh87399j60mY5oqqqA2FDrZDkGqXyQfaOlqTmEJV3QiuFZSlpQBsiWNDYpR3",
+	"Ux2DYwwVmrzJb4UEPVjDxAI3mFn4fZM6mWo52cQzrixja4GshC1H6MogwfHwnGPIfmm/+2DxSMHEgXE9",
+	"vSYHMxT7SDihe0gMqX7F3G15OAj9NqYiISWUifcb6frnSihD4CgHXlal9oIOD0ZtTpuc/G+ElUStLGl/",
+	"lT2FeU6Z9V8HKT0uYL+w+hdbQLRJVRhCb0V7u4YgC2Bnt+/VihY3GORru4D1vcD5R1qi5rNCqTwZcF44",
+	"7Sdt7p6BC4ElDxjeHT6GZKDEM/uKbOa1d9rVZu+TFBcFSMgeHjF2Im3UnndUa1cN60wuH5ix+Xc0a1bZ",
+	"POrOSHb0UcbDnyhBVnlH/uaHGedqGmR256nsIOMTmd1AwmisQNAveN73TZ3sOtYtQt0QlYUiJqXcMu3d",
+	"pPPdN5RFSD+oKDr++gmzYjYRAaW1t5K01FRZbQsvbxrz07Tapr7DAfBCZU3TruZGDpw/2G3/TY2UYCmD",
+	"lNBa/iH9j1tgw5eCLdIUgYzLtMm8rctne18C5Z5+WevM4njuq9YoBaaSlD+7r5LTZH+3KY0DwsFzWV7y",
+	"/Mur1Sg36gnhA7L3wwJP+P4NkWxRqW/nO/uaT5o757/D1FjC8BLk3wH3KOo44YZyxp+6qqw3kVG5CJ6z",
+	"XK2DOt3oZn9FY9JOsyffsKWLSC1KSIUWnWD9K18hqH7uUcE8OwVq28ffl4fW+bMydyBjuyyjCva2qTZi",
+	"FN0PDYTNEf2DmcrAyY1SeYz6emQRwV+MR4WpoQ5cFxctFwxbvanjW6xKuGdXjMCp8oauGP2kV1OXR+ug",
+	"S6fS0F/n5Nu6hdvIRd2sbaofUR+5YyUpprj/xCvNYHfyP7IIwUZHjEBlvz75lZVA1fiNYo8e0QSPHs1d",
+	"01+ftj/jcX70KCrGfTHPI4sjN4abN0oxzpjWCyuDXSHKgQSa7x1zdxc2me8YdYB4ptscopWVaGrvg/1l",
+	"L1Ircx9U8NulucaH+FmAMr/keqIY7n8eigOysS4DIWeds4DRaYcOZSuAsKkiTSFyv7jg9j+kjvUvVpfd",
+	"Z5MW1hv5m3YPACEmstbW5MFUQWjghKhA1y0SA0jElValMHvKuedVn+KXqE/ND7W1xFmB6yxNTu4w6gLq",
+	"rI2NbaXSXrL5QfGcZAF8z5C3r8H6Tey7Hd8WOTgm9ZcHyz/Bsz8/zx4/e/Kn5Z8ff/04hedfv3j8mL94",
+	"zp+8ePYEnv756+eP4cnqmxfLp9nT50+Xz58+/+brF+mz50+Wz7958acHs/lMIMgW0Jn3nJv9dyr2npy8",
+	"O03OEdgGJ7wQaJCiurJIxr5iLU+JC8KWi3x27H/6/z13O0rVthne/zpzCSRmG2MKfbxYXF1dHYVdFmtS",
+	"piZGVelm4efplbQ9eXdah1paXyjaURtFh6RwNGtI4YS+vf/u7JydvDs9aghmdjx7fPT46AmOrwqQvBCz",
+	"49kz+olOz4b2feGIbXb8+Xo+W1iXs9YfC6cudz9uwZQi9X95Tzv8v77i6zWUR662L/50+XTh5bzFZ6dp",
+	"vh77tgjudPy5+SsR2YGe5Amz+Owzxo23bqVkc4aIoMNEKMaaLZZqd4OmoIPGw0uh159efKb3y+DvCxcD",
+	"Hf9I70h7SBbeahVv2cLSZ7NDWDs9Um7STVUsPtN/iGgDsEhvvV6UgDFyzc82EKG/igwutyoDB8bQ7wue",
+	"XXKZguuvBwdYqNXKmvDHPi8+238jw2jJC71RRo98Wnz2/23v1IGGixK0k4BdB+s7t6BsOPv+z3uZRn/s",
+	"Y7Fb4DL28+Jz68826HpTmUxdBX3p1Ug7H9m12pO19ffiiguDcqAzx1JSvH5nAzxfuMjJzq9NsELvC0Vg",
+	"BD8GdBr/dVEnBIl+7DKA2Fd3AAYa+bh3klSVja2vWfZpRrpM2yLUZs5n9XWqZ8cfojZ7DYYZxX41ZQW/",
+	"zl2wvL3zVYF6OZYqTXHTK5FDoDzA8BpNwYSVd3fo6bapJEZYbNNslKZBRM/W0w6L/pXcU3498rfuPyso",
+	"982t6MCZhanOe0k/P1mZCLT5VtE9MlQvfpcsheTlvl0zvpEJ7ce+AHw9j6gnKJ+vV2mGyCBVhNvGUFpD",
+	"zNvEZWSOoovz6ePHI/Bu9bpwcYoNuB2XIKVN4rEUTfJJCap/ur8tpi/r30RRWL87G2a/rFYrl3Jzyw0F",
+	"KaAdqcDJXJ1YS3LgXAvcjvlc8A4SAqyRDb/4gwl93qsSksEoDEz0SOkMv7ctveJvHjVckxqNalra0AAf",
+	"JchZLrDQZal4lnI9kFhRaLIl11Vg49qArQ5TXHbKbuvpMLAlpLzS5O2wtxZ/B0FTh1ZPSLrbRWF0If2H",
+	"Yv+QudhX8kntUaI/YvQ6uZ7Pnt/8II2aY1oRbBHgvuUZ82lOEvaG50in6EntJPUQYgvfky8K36kkBy4U",
+	"55l9rlzPZ19/YSSdSjyqPGfU0kLw7ItCcAblpUiBncO2UCUvRb5nf5N13o4gi2z/bP1NXkh1JT3w+OKt",
+	"tlu6PupbWDNOtsmQPlUZIVeumTCNXr3hte0sIEfs7yfv356+/eHYPovrFxz+f1dAKbYgDc/Jqlc5gyo6",
+	"7bEMSxepAj9T6tQSyKokFVtXvOTSALjEvuWWFD+rSqY24FKYPQK9qvBsUh5FVVqWxNearKNULm02n4Ug",
+	"4BneJXitrEEmjp0nS5Xtfc7vkl+hKfWaBJ1G1xHqDkhaqbUGHz7hXU65OZ0g0zyFjxcLctbYKG0Ws+v5",
+	"584zOfz4qQb9cyNIiEuKtP10/X8GAAyHZYg/0QAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
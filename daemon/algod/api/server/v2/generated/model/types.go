@@ -21,6 +21,20 @@ const (
 	AccountSigTypeSig  AccountSigType = "sig"
 )
 
+// Defines values for HealthCheckResultStatus.
+const (
+	HealthCheckResultStatusFail HealthCheckResultStatus = "fail"
+	HealthCheckResultStatusPass HealthCheckResultStatus = "pass"
+	HealthCheckResultStatusWarn HealthCheckResultStatus = "warn"
+)
+
+// Defines values for HealthReportStatus.
+const (
+	HealthReportStatusFail HealthReportStatus = "fail"
+	HealthReportStatusPass HealthReportStatus = "pass"
+	HealthReportStatusWarn HealthReportStatus = "warn"
+)
+
 // Defines values for AddressRole.
 const (
 	FreezeTarget AddressRole = "freeze-target"
@@ -589,6 +603,34 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// HealthCheckResult The outcome of one of the health checks of the node.
+type HealthCheckResult struct {
+	// Message A human readable explanation of the status.
+	Message *string                 `json:"message,omitempty"`
+	Name    string                  `json:"name"`
+	Status  HealthCheckResultStatus `json:"status"`
+}
+
+// HealthCheckResultStatus defines model for HealthCheckResult.Status.
+type HealthCheckResultStatus string
+
+// HealthReport The outcomes of the health checks of the node.
+type HealthReport struct {
+	Checks []HealthCheckResult `json:"checks"`
+
+	// Ready Whether none of the checks failed.
+	Ready bool `json:"ready"`
+
+	// Round The latest round of the ledger of the node.
+	Round uint64 `json:"round"`
+
+	// Status The least healthy status among the checks.
+	Status HealthReportStatus `json:"status"`
+}
+
+// HealthReportStatus The least healthy status among the checks.
+type HealthReportStatus string
+
 // KvDelta A single Delta containing the key, the previous value and the current value for a single round.
 type KvDelta struct {
 	// Key The key, base64 encoded.
@@ -963,6 +1005,12 @@ type CompileResponse struct {
 	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
+// DevModeRoundResponse The round of the ledger after a developer mode operation.
+type DevModeRoundResponse struct {
+	// Round The latest round of the ledger.
+	Round uint64 `json:"round"`
+}
+
 // DisassembleResponse defines model for DisassembleResponse.
 type DisassembleResponse struct {
 	// Result disassembled Teal code
@@ -978,12 +1026,30 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// GetBlockTimeStampOffsetResponse The block timestamp offset of a developer mode node.
+type GetBlockTimeStampOffsetResponse struct {
+	// Offset The number of seconds between the timestamps of consecutive blocks.
+	Offset uint64 `json:"offset"`
+}
+
 // GetSyncRoundResponse defines model for GetSyncRoundResponse.
 type GetSyncRoundResponse struct {
 	// Round The minimum sync round for the ledger.
 	Round uint64 `json:"round"`
 }
 
+// HealthReportResponse The outcomes of the health checks of the node.
+type HealthReportResponse = HealthReport
+
+// LedgerSnapshotResponse A ledger snapshot response.
+type LedgerSnapshotResponse struct {
+	// Round The round the snapshot was taken at.
+	Round uint64 `json:"round"`
+
+	// SnapshotId The id of the snapshot.
+	SnapshotId uint64 `json:"snapshot-id"`
+}
+
 // LedgerStateDeltaResponse Contains ledger updates.
 type LedgerStateDeltaResponse = LedgerStateDelta
 
@@ -1022,6 +1088,9 @@ type NodeStatusResponse struct {
 	// CatchupTime CatchupTime in nanoseconds
 	CatchupTime uint64 `json:"catchup-time"`
 
+	// EarliestAvailableRound The earliest round for which the node has the block. The blocks of the earlier rounds were pruned, or were not fetched after a catchpoint catchup
+	EarliestAvailableRound *uint64 `json:"earliest-available-round,omitempty"`
+
 	// LastCatchpoint The last catchpoint seen by the node
 	LastCatchpoint *string `json:"last-catchpoint,omitempty"`
 
@@ -1074,8 +1143,20 @@ type PostTransactionsResponse struct {
 	TxId string `json:"txId"`
 }
 
+// ReloadConfigResponse The outcome of a configuration reload.
+type ReloadConfigResponse struct {
+	// Applied The names of the changed settings which were applied.
+	Applied []string `json:"applied"`
+
+	// RestartRequired The names of the changed settings which only take effect once the node restarts.
+	RestartRequired []string `json:"restart-required"`
+}
+
 // SimulationResponse defines model for SimulationResponse.
 type SimulationResponse struct {
+	// CostProfile \[cp\] Opcode cost profile of the programs evaluated for the transaction group, in the gzipped protocol buffers format read by pprof. Only returned when requested with the profile parameter.
+	CostProfile *[]byte `json:"cost-profile,omitempty"`
+
 	// FailureMessage \[fm\] Failure message, if the transaction would have failed during a live broadcast.
 	FailureMessage string `json:"failure-message"`
 
@@ -1236,6 +1317,12 @@ type GetTransactionProofParamsHashtype string
 // GetTransactionProofParamsFormat defines parameters for GetTransactionProof.
 type GetTransactionProofParamsFormat string
 
+// GenerateDevModeBlockParams defines parameters for GenerateDevModeBlock.
+type GenerateDevModeBlockParams struct {
+	// Timestamp Unix timestamp of the block. By default the block timestamp follows the timestamp offset of the node, or the wall clock if there is none.
+	Timestamp *uint64 `form:"timestamp,omitempty" json:"timestamp,omitempty"`
+}
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
@@ -1271,6 +1358,12 @@ type PendingTransactionInformationParams struct {
 // PendingTransactionInformationParamsFormat defines parameters for PendingTransactionInformation.
 type PendingTransactionInformationParamsFormat string
 
+// SimulateTransactionParams defines parameters for SimulateTransaction.
+type SimulateTransactionParams struct {
+	// Profile When set to `true`, returns the opcode cost profile of the programs evaluated for the transaction group, including those of inner transactions. Defaults to `false`.
+	Profile *bool `form:"profile,omitempty" json:"profile,omitempty"`
+}
+
 // TealCompileTextRequestBody defines body for TealCompile for text/plain ContentType.
 type TealCompileTextRequestBody = TealCompileTextBody
 
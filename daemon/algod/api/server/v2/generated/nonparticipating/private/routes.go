@@ -27,6 +27,30 @@ type ServerInterface interface {
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Reloads the configuration of the node.
+	// (POST /v2/config/reload)
+	ReloadConfig(ctx echo.Context) error
+	// Writes a developer mode block.
+	// (POST /v2/devmode/blocks)
+	GenerateDevModeBlock(ctx echo.Context, params GenerateDevModeBlockParams) error
+	// Advances the ledger by empty rounds.
+	// (POST /v2/devmode/blocks/advance/{rounds})
+	AdvanceDevModeRounds(ctx echo.Context, rounds uint64) error
+	// Returns the block timestamp offset.
+	// (GET /v2/devmode/blocks/offset)
+	GetBlockTimeStampOffset(ctx echo.Context) error
+	// Sets the block timestamp offset.
+	// (POST /v2/devmode/blocks/offset/{offset})
+	SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error
+	// Takes a snapshot of the ledger.
+	// (POST /v2/devmode/snapshots)
+	SnapshotLedger(ctx echo.Context) error
+	// Deletes a ledger snapshot.
+	// (DELETE /v2/devmode/snapshots/{snapshot-id})
+	DeleteLedgerSnapshot(ctx echo.Context, snapshotId uint64) error
+	// Restores a ledger snapshot.
+	// (POST /v2/devmode/snapshots/{snapshot-id}/restore)
+	RestoreLedgerSnapshot(ctx echo.Context, snapshotId uint64) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
@@ -73,6 +97,131 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// ReloadConfig converts echo context to params.
+func (w *ServerInterfaceWrapper) ReloadConfig(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReloadConfig(ctx)
+	return err
+}
+
+// GenerateDevModeBlock converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateDevModeBlock(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GenerateDevModeBlockParams
+	// ------------- Optional query parameter "timestamp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timestamp", ctx.QueryParams(), &params.Timestamp)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timestamp: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GenerateDevModeBlock(ctx, params)
+	return err
+}
+
+// AdvanceDevModeRounds converts echo context to params.
+func (w *ServerInterfaceWrapper) AdvanceDevModeRounds(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "rounds" -------------
+	var rounds uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "rounds", runtime.ParamLocationPath, ctx.Param("rounds"), &rounds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rounds: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AdvanceDevModeRounds(ctx, rounds)
+	return err
+}
+
+// GetBlockTimeStampOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockTimeStampOffset(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockTimeStampOffset(ctx)
+	return err
+}
+
+// SetBlockTimeStampOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) SetBlockTimeStampOffset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "offset" -------------
+	var offset uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "offset", runtime.ParamLocationPath, ctx.Param("offset"), &offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetBlockTimeStampOffset(ctx, offset)
+	return err
+}
+
+// SnapshotLedger converts echo context to params.
+func (w *ServerInterfaceWrapper) SnapshotLedger(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SnapshotLedger(ctx)
+	return err
+}
+
+// DeleteLedgerSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteLedgerSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "snapshot-id" -------------
+	var snapshotId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "snapshot-id", runtime.ParamLocationPath, ctx.Param("snapshot-id"), &snapshotId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter snapshot-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteLedgerSnapshot(ctx, snapshotId)
+	return err
+}
+
+// RestoreLedgerSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreLedgerSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "snapshot-id" -------------
+	var snapshotId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "snapshot-id", runtime.ParamLocationPath, ctx.Param("snapshot-id"), &snapshotId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter snapshot-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RestoreLedgerSnapshot(ctx, snapshotId)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	var err error
@@ -123,6 +272,14 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.POST(baseURL+"/v2/config/reload", wrapper.ReloadConfig, m...)
+	router.POST(baseURL+"/v2/devmode/blocks", wrapper.GenerateDevModeBlock, m...)
+	router.POST(baseURL+"/v2/devmode/blocks/advance/:rounds", wrapper.AdvanceDevModeRounds, m...)
+	router.GET(baseURL+"/v2/devmode/blocks/offset", wrapper.GetBlockTimeStampOffset, m...)
+	router.POST(baseURL+"/v2/devmode/blocks/offset/:offset", wrapper.SetBlockTimeStampOffset, m...)
+	router.POST(baseURL+"/v2/devmode/snapshots", wrapper.SnapshotLedger, m...)
+	router.DELETE(baseURL+"/v2/devmode/snapshots/:snapshot-id", wrapper.DeleteLedgerSnapshot, m...)
+	router.POST(baseURL+"/v2/devmode/snapshots/:snapshot-id/restore", wrapper.RestoreLedgerSnapshot, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
 
 }
@@ -130,175 +287,199 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3PcNtLgv4Ka/ar8uOFIfmXXqtr6TrGTrC6247K02bvP8iUYsmcGKw7AEKA0E5/+",
-	"9ys0ABIkAQ5Hmjibr/YnW0M8Go1Go9/4PEnFuhAcuJKTk8+TgpZ0DQpK/Iumqai4Slim/8pApiUrFBN8",
-	"cuK+EalKxpeT6YTpXwuqVpPphNM1NG10/+mkhF8qVkI2OVFlBdOJTFewpnpgtS1063qkTbIUiR3i1Axx",
-	"9npyO/CBZlkJUvah/IHnW8J4mlcZEFVSLmmqP0lyw9SKqBWTxHYmjBPBgYgFUatWY7JgkGdy5hb5SwXl",
-	"1lulnTy+pNsGxKQUOfThfCXWc8bBQQU1UPWGECVIBgtstKKK6Bk0rK6hEkQCLdMVWYhyB6gGCB9e4NV6",
-	"cvJxIoFnUOJupcCu8b+LEuBXSBQtl6Amn6ahxS0UlIli68DSziz2S5BVriTBtrjGJbsGTnSvGXlbSUXm",
-	"QCgnH759RZ49e/ZSL2RNlYLMEll0Vc3s/ppM98nJJKMK3Oc+rdF8KUrKs6Ru/+HbVzj/uV3g2FZUSggf",
-	"llP9hZy9ji3AdQyQEOMKlrgPLerXPQKHovl5DgtRwsg9MY0Puin+/L/rrqRUpatCMK4C+0LwKzGfgzzM",
-	"6z7Ew2oAWu0LjalSD/rxOHn56fOT6ZPj2z99PE3+y/754tntyOW/qsfdgYFgw7QqS+DpNlmWQPG0rCjv",
-	"4+ODpQe5ElWekRW9xs2na2T1ti/RfQ3rvKZ5pemEpaU4zZdCEmrJKIMFrXJF3MSk4rlmU3o0S+2ESVKU",
-	"4pplkE01971ZsXRFUirNENiO3LA81zRYSchitBZe3cBhuvVRouG6Ez5wQf+6yGjWtQMTsEFukKS5kJAo",
-	"seN6cjcO5RnxL5TmrpL7XVbkYgUEJ9cfzGWLuOOapvN8SxTua0aoJJS4q2lK2IJsRUVucHNydoX97Wo0",
-	"1tZEIw03p3WP6sMbQ18PGQHkzYXIgXJEnjt3fZTxBVtWJUhyswK1sndeCbIQXAIR839CqvS2/6/zH94R",
-	"UZK3ICVdwnuaXhHgqcjie2wnDd3g/5RCb/haLguaXoWv65ytWQDkt3TD1tWa8Go9h1Lvl7sflCAlqKrk",
-	"MYDMiDvobE03/UkvyoqnuLnNtC1BTZMSk0VOtzNytiBruvnr8dSCIwnNc1IAzxhfErXhUSFNz70bvKQU",
-	"Fc9GyDBKb5h3a8oCUrZgkJF6lAFI7DS74GF8P3gaycoDxw0SBaeeZQc4HDYBmtFHV38hBV2CRzIz8nfL",
-	"ufCrElfAawZH5lv8VJRwzUQl604RGHHqYfGaCwVJUcKCBWjs3KJDcw/TxrLXtRVwUsEVZRwyzXkRaKHA",
-	"cKIoTN6Ew8pM/4qeUwlfPY9d4M3Xkbu/EN1dH9zxUbuNjRJzJAP3ov5qD2xYbGr1H6H8+XNLtkzMz72N",
-	"ZMsLfZUsWI7XzD/1/jk0VBKZQAsR7uKRbMmpqko4ueSP9V8kIeeK8oyWmf5lbX56W+WKnbOl/ik3P70R",
-	"S5aes2UEmTWsQW0Ku63NP3q8MDtWm6DS8EaIq6rwF5S2tNL5lpy9jm2yGXNfwjytVVlfq7jYOE1j3x5q",
-	"U29kBMgo7gqqG17BtgQNLU0X+M9mgfREF+Wv+p+iyHVvVSxCqNV0bO9btA1Ym8FpUeQspRqJH+xn/VUz",
-	"ATBaAm1aHOGFevLZA7EoRQGlYmZQWhRJLlKaJ1JRhSP9RwmLycnkT0eNceXIdJdH3uRvdK9z7KTlUSPj",
-	"JLQo9hjjvZZr5ACz0AwaPyGbMGwPJSLGzSZqUmKaBedwTbmaNfpIix/UB/ijnanBtxFlDL47+lUU4cQ0",
-	"nIM04q1p+EASD/UE0UoQrShtLnMxr394eFoUDQbx+2lRGHygaAgMpS7YMKnkI1w+bU6SP8/Z6xn5zh8b",
-	"5WzB862+HIyooe+Ghb217C1WG47sGpoRH0iC2ynKmd4ahwYtwx+C4lBnWIlcSz07aUU3/ptt65OZ/n1U",
-	"5z8Gifm4jRMXalEWc0aBwV88zeVhh3L6hGNtOTNy2u17N7LRo4QJ5k60MrifZtwBPNYovClpYQC0X8xd",
-	"yjhqYKaRgfWe3HQkowvC7J1hj9YQqjuftZ3nIQgJkkIHhq9zkV79jcrVAc783I3VP344DVkBzaAkKypX",
-	"s0lIyvCPVzPamCOmG6L2TubeVLN6iYda3o6lZVRRb2kW3rBYYlCP/ZDpQRnQXX7A/9Cc6M/6bGvWb4ad",
-	"kQtkYNIcZ+tByLQqbxQEM5NugCYGQdZGeyda694LylfN5OF9GrVH3xiDgd0huwjcIbE5+DH4WmxCMHwt",
-	"Nr0jIDYgD0EfehwUIxWs5Qj4XlvIBO6/RR8tS7rtIxnHHoNkvUAtuko8Ddy/8fUsjeX1dC7Ku3GfDlvh",
-	"pLEnE6pH9ZjvtIMkbFoViSXFgE3KNOgM1LjwhplGd/gQxlpYOFf0N8CC1KMeAgvtgQ6NBbEuWA4HIP1V",
-	"kOnPqYRnT8n5305fPHn609MXX2mSLEqxLOmazLcKJHlodTMi1TaHR/2VoXZU5So8+lfPnRWyPW5oHCmq",
-	"MoU1LfpDGeumEYFMM6Lb9bHWRjOuugZwzOG8AM3JDdqJMdxr0F4zqSWs9fwgmxFDWNbMkhELSQY7iWnf",
-	"5TXTbP0lltuyOoQqC2UpyoB9DY+YEqnIk2soJRMBV8l724LYFk68Lbq/G2jJDZVEz42m34qjQBGgLLXh",
-	"4/m+GfpiwxvcDHJ+s97A6uy8Y/aljXxnSZSkgDJRG04ymFfLlia0KMWaUJJhR7yjvwN1vuUpWtUOQaRx",
-	"NW3NOJr45Zanns6mNyqHbNnahPvrZl2sOPucmeqBDICj0fEGP6Na/xpyRQ8uv3QnCMH+ym2kAZZkuiFq",
-	"wW/YcqU8AfN9KcTi8DCGZgkBih+MeJ7rPn0h/Z3IQC+2kge4jJvBGlrXe+pTOJ2LShFKuMgALSqVDF/T",
-	"Ebc8+gPRjan8m1+tjMQ9B01IKa30aquCoJOuxzmajglNDfUmiBoZ8WLU7ifTykxnXL55CTTTWj1wIubW",
-	"VWCdGLhIih5G5S46KyQEzlILrqIUKUgJWWJNFDtBc+0ME1EDeELAEeB6FiIFWdDy3sBeXe+E8wq2CfrD",
-	"JXn4/Y/y0e8ArxKK5jsQi21C6K0VPusP6kM9bvohgutO7pMdLYE4nqu1S80gclAQQ+FeOInuXxei3i7e",
-	"Hy3XUKJn5jeleDfJ/QioBvU3pvf7QlsVkSgvq+hcsDXa7TjlQkIqeCaDg+VUqmQXW9aNWtqYXoHHCUOc",
-	"GAeOCCVvqFTGm8h4hkYQc53gPEZA0VPEAY4KpHrkH50s2h871fcgl5WsBVNZFYUoFWShNXDYDMz1Djb1",
-	"XGLhjV1Lv0qQSsKukWNY8sa3yDIrMQiiqja6W3d7f3Fomtb3/DaIyhYQDSKGADl3rTzs+pEuEUCYbBBt",
-	"CIfJDuXU4TXTiVSiKDS3UEnF634xNJ2b1qfq703bPnFR1dzbmQA9u3IwWchvDGZNjNOKahUaRyZreqVl",
-	"D1SIjduzD7M+jIlkPIVkiPL1sTzXrfwjsOOQRmwRNorSm61zODr0GyS6KBHs2IXYgiOGkfe0VCxlBUqK",
-	"38P24IJzd4KguZ5koCjTyrr3wQjRhd+fGD92d8y7CdKjdNg++D0lNrCcnEm8MNrAX8EWNZb3JkDqwgur",
-	"OoAmEBhVn27KCQLqwi60AOM3gQ1NVb7V15xawZbcQAlEVvM1U8pEvLUVBSWKxB8gaB8cmNEaw01wkduB",
-	"Mdb5cxzKW15/K6YTI1ENw3fREata6LCSVCFEPkL37iEjCMEovykphN51ZgMsXRSeo6QWkFaIQU9IzTwf",
-	"yBaacQXk/4iKpJSjwFopqG8EUSKbxetXz6AvsHpO6yFtMAQ5rMHI4fjl8ePuwh8/tnvOJFnAjYtK1g27",
-	"6Hj8GLXg90Kq1uE6gKVFH7ezAG9Hw6m+KKwM1+Upuz10duQxO/m+M3htbdVnSkpLuHr592YAnZO5GbN2",
-	"n0bGeSdx3FE2UW/o0Lpx38/ZusoPteELyvKqhLhz4fLy42J9efmJfGtaOr/g1BG5j46bJqp8YW+jqsTI",
-	"BJIzrR6UgmYplSpoGsVF8mVSx7bJIDhrqcH5hz2HlG87eVBjYSBzSGllgjot17YQNNF1chaQiDq720Vh",
-	"cCEjrYtVrsyl7WN1WYqqILLedkMFiir4bSx1zdAhKPsTe6EVzcdYdIWWsvPtAW5rMxApoShBIm/1tVNp",
-	"voqFn75gma/cSgXrvgHPdP0pIt5+cMJhT9cQPGcckrXgsA1m7DEOb/FjqLfh75HOeNPG+naF5xb8HbDa",
-	"84yhxvviF3fbY2jv67CiA2x+d9yO7dZP3EDbBOQFoSTNGVouBJeqrFJ1ySnqRt5hC7hfncYX15ZfuSZh",
-	"9TygPduhLjlF13utMQX54gICfPlbAKc0y2q5BKk6UuIC4JLbVoyTijOFc631fiVmwwoo0Qc6My3XdEsW",
-	"NEfl/lcoBZlXqs1cMb5cKq17G0OynoaIxSWniuSguepbxi82OJxzxDia4aBuRHlVY2EWPA9L4CCZTMJu",
-	"4u/MV4zgsctf2WgeTPYzn43pUY/fBKFvFbQS2P7vw/88+Xia/BdNfj1OXv6Po0+fn98+etz78entX//6",
-	"/9o/Pbv966P//I/QTjnYQ9HPFvKz11anOHuNgmNje+zB/sXsTmvGkyCR+R62Dm2Rh1r8dQT0qDHu2l2/",
-	"5GrDNSFd05xlVN2NHLosrncWzenoUE1rIzpmBLfWPcWxe3AZEmAyHdZ452u8H1kRzjNAY7hNHcDzsqi4",
-	"2cpKWoM8htE6D7dYTOtcEpNDfkIw0WBFXXiG/fPpi68m0yZBoP4+mU7s108BSmbZJpQGksEmJGXbA4IH",
-	"44EkBd1KUGHugbAHnfnGp+gPuwatnskVK748p5CKzcMczgUnWm19w8+4iRrU5wdN61trsROLLw+3KgEy",
-	"KNQqlFvakhSwVbObAB13Z1GKa+BTwmYw62rL2RKkCyvIgS4wxxHNw2JMsHV9DgyhOarwsO4vZJRKGqIf",
-	"FG4tt76dTuzlLw8uj9uBQ3B156zt6O5vJciD7765IEeWYcoHJiPJDO3lkASsUDZMuuUI19zMZNSblKxL",
-	"fslfw4Jxpr+fXPKMKno0p5Kl8qiSUH5Nc8pTmC0FOXGR16+pope8J2lFi154Me+kqOY5S8mVLxE35GkS",
-	"mYNqI82XQiuOXZ9gX361UwX5i5kguWFqJSqV2EzNpIQbWmYB0GWdqYcjmzzroVmnxI5tWLHNBLXjh3ke",
-	"LQrZzdjpL78ocr18jwylzUfRW0akEqWTRbSAYqDB/X0n7MVQ0huX5ltJkOTnNS0+Mq4+keSyOj5+BqSV",
-	"wvKzvfI1TW4LaNkr75RR1LVV4sKNXgMbVdKkoMuI0UABLXD3UV5eo5Kd5wS7tVJnXGggDtUswOEjvgEG",
-	"jr3TAHBx56aXK7kRXgJ+wi3ENlrcaBxOd90vL5nmztvVScjp7VKlVok+28FVSU3ibmfqTPylFrKcF1Cy",
-	"JUZa2aIFcyDpCtIryDB/GtaF2k5b3Z2j2QqajnUwaeoMmFB4TIZF0+4cSFVk1IriHYOSxrAEpVyo1we4",
-	"gu2FaHJp90lDbGfFydhBRUr1pEtNrP6xtWN0N99GM6CtqyhcchlmGTiyOKnpwvWJH2Qj8h7gEIeIopW1",
-	"FUMELQOIMMQfQcEdFqrHuxfph5antYy5ufkCZQkc7ye2SaM82cADfzWYjGa+rwGLlogbSeZUy+3C1tsw",
-	"mV8eF6skXUJEQvat6yPzq1oWeRxk170XvOnEonuh9e6bIMimcaLXHKQU0F80qaAy0wk3cTMZB44xoBIs",
-	"o2URNs9RTKrjcgzToWXLy2HqAsVACxMwlLwROBwYbYz4ks2KSlcKBCumuLM8Sgb4DTMZh/LXz7xICa8s",
-	"Sm34djy3e0572qXNYnep6y5f3VctR+SeawkfgzND2yE4CkAZ5LA0CzeNHaE0WZXNBmk4flgscsaBJKGg",
-	"CyqlSJmp5dJcM3YO0PLxY0KMCZiMHiFExh7Y6JjEgck74Z9NvtwHSG6zQqkbG12a3t8QDmA3YYha5BGF",
-	"ZuGMRwJeHQegNlKnvr868WI4DGF8SjSbu6a5ZnNW42sG6aVRo9jaSZq2rvFHMXF2wAJvLpa91mSuorus",
-	"xpeZHNBhgW4A4rnYJCaDJSjxzjdzTe/ByEzMpwkdTJOw/kCSudhguAVeLSYScAcscTgcGJ6Gv2ES6RX7",
-	"xW5zA8zQtMPSVIgKJZKMNefV5BITJ8ZMHZFgYuTy0MtBvxMAHWNHU63RKr87ldS2eNK/zJtbbdrUVnFB",
-	"76HjHztCwV2K4K9vhamzxq0J4QOkoszidgpNqEzV5S/75gVbvFPzjdF55QOlOE/b2oZTIfo7F4kKaMHT",
-	"zDOAiNcmZaMHyTebQmjp1qR0mPx+ixQjJ5ZgMtWksVlJxpe5FQxiaAot2MUkOYybJTf1etyA42Tn0OZG",
-	"lPwhWIoiDMc+msoHi58BKCKnvIED5fB7QmJz/AdhuY3Tx/uuaB88KO3wmnZlCU/XCt0Omnz63sy+z1RC",
-	"Dqg9Jy1tI7kK+bgvLz9KQNHs3HXzrHxYv4Ly7SMvZquEJZMKGm+TlmAdpr+0HZ9i2SwhFvHVqaJc6PV9",
-	"EKKW50xdFuzYWuYXX8G1UJAsWClVgq664BJ0o28lWp++1U3DSkU7KsxUkGRZ+BLFaa9gm2Qsr8L0auf9",
-	"/rWe9l0tO8hqjoIJ4wRouiJzrHgajBUdmNqEEw8u+I1Z8Bt6sPWOOw26qZ641OTSnuMPci46N90QOwgQ",
-	"YIg4+rsWRenABeplSPa5o6dgmMOJ1+lsyE3RO0yZG3tnfJXL04wJc2akgbVgaFA0ODcQkGPiyAxTb4qd",
-	"B3MZuVBJy/gRQFdt4JGKXpl8nPYG82VtUwmHTRm9etTQtu2OAfn48fju4awQnORwDfnuIGiKGHcGHIyM",
-	"MCNg6A3BdAIX47Fbqu/vQIOweqVdGIPU0pNuhhy3jWpky481ujUSrMadTRwe7b3TEpqjt4a++667okgy",
-	"yCGYpvMPLw+HFgUm27vGoZQVPRjjGWzC4JhP01BJ8r7xvmJcmfKVh6qM1xln/LL9+nFjUFCYSmf7V9+L",
-	"65jeLvloji8qQpS1c2CQEePgtWbnPebQpb7INU6LgmWbjt/TjBq1jh8EY3hB2cF2YMCjjVACWAmyXTew",
-	"MeaZ6tWtsj2zUZi5aFf382Uafyom3dsLfUTVCaK7cHUBNP8etj/qtricye10cj83aQjXdsQduH5fb28Q",
-	"zxiGZ9xmraiHPVFOi6IU1zRPrDM5RpqluLakic2d7/kLS2thrnfxzemb9xb82+kkzYGWSa3tRFeF7Yo/",
-	"zKpMicLIAXG13VdU1fY5ow17m1/XVfMd0DcrsHW0PYW6V/CzCS7wjqJ1SC/C0cA73cs2DsIscSAeAoo6",
-	"HKJx1ZloiHYEBL2mLHc+MgdtJHIXFzfubgxyBX+Ae0dS+HfRQdlN73SHT0dDXTt4kj/XQKXvtSlmL4ng",
-	"3XA5rQWj6w1JdU2xXKfxgPSZE6/W6DVIZM7SsD+VzzHFhps4Gd2YYOOIPq1HrFgk7IpXzBtLN5MjjNod",
-	"IL05gsh0pV9juJsL+wpRxdkvFRCWAVf6U4mnsnNQ0X5qPev96zQsVdqBjTe+Gf4+MoZfqrZ741mZa0jA",
-	"8KNyeuC+rq1+bqG190n/4IUf7BHc58/YuxIHAvMsfVhqNokKq3Z0zWgJfeeLRc7+ZmvmRuYIvkDEZLIo",
-	"xa8QNlWhhS+QHeqK8zKMaP0V+IiUssaT0zyk1Mwe3e6YdON7nNoBiRGqx533QnCwSqjzRlNutto8CNKK",
-	"aw8TjJ9BcmTGbwjGwtzLusnpzZyGSqhqIUPD5LlfWn5zJYjr7HBvfTTM1kueES9urG7LTN2EAsomcbtf",
-	"g+mOAoOZdrSo0EgGSLW+TDA1sT65FIFhKn5DuXlXBr0ReJRsb63gO4PQjSix6okMu/gzSNk6aFy6vPyY",
-	"pX13bsaWzLyqUknwnu2wA5nnqAwV2adPTDhdg5qzBTmeeg8D2d3I2DWTbJ4DtnhiWsypBGNUcZEbrote",
-	"HnC1ktj86Yjmq4pnJWRqJQ1ipSC1UIfqTR2oMgd1A8DJMbZ78pI8xBAdya7hkcaivZ8nJ09eooPV/HEc",
-	"ugDs80lD3CRb+EmuYTrGGCUzhmbcdtRZ0Bpg3ryLM66B02S6jjlL2NLyut1naU05XUI4KnS9AybTF3cT",
-	"fQEdvPDMPNgkVSm2hEXSjUFRzZ8imWaa/RkwSCrWa6bWNpBDirWmp+ZNDjOpG868/mTLKTu43EeMhypc",
-	"OEhHifyyfh9zv4VWjVFr7+ga2midEmpK3eSsiVR0Rd7JmaukhfWl67LSBjd6Lr10FHMwcHFBipJxhYpF",
-	"pRbJX0i6oiVNNfubxcBN5l89D9TUbtd25fsB/sXxXoKE8jqM+jJC9k6GsH3JQy54stYcJXvUZHZ6pzIa",
-	"uBUO0YnFCQ0PPVYo06MkUXKrWuRGPU59L8LjAwPekxTr9exFj3uv7ItTZlWGyYNWeof+/uGNlTLWogyV",
-	"x2yOu5U4SlAlg2uM0w9vkh7znntR5qN24T7Q/77OUydyemKZO8tRRWAfj4+nG6DPx49MvIu3p+3paclc",
-	"QbcPajjjPCDmychdfo/7PCbT6rwPVI5Dj4MuYkRoJcB2MLafBnx/E4Pn8mntUAxH7aWFKPNrEViye4Gg",
-	"9vHYjMmA3Sp2gegPmkHN7VBT0q72/uUjapxbpB/Zob84WPGPLrC/M7NBJLsVRDbRe4kiuJ1Z/d0LLqPk",
-	"a7EZu6kd3u029l8ANUGUVCzPfmxqg3Qe+igpT1fBYJG57vhT8yRhvThzmIP1UVeUcxON0LdNoJbyk9Nm",
-	"AvrWP8XYedaMj2zbfXvELLezuAbwNpgOKDehRi9TuZ7Ax2q77EKd1pcvRUZwnqYYZ3Ov99+s8V4W+KUC",
-	"qUL3In4wqQVoUV9oKjYF/oFnaMeYke/Mk+IrIK1agWg/MFWaIHNl1o2rpypyQbMp0eNcfHP6hphZTR/z",
-	"sJYprL80125rFfH43H0CbYdiaw+R0adXLRWW7pSKrotQiRLd4sI1wDoovncJFWsfOzPy2tg0pNOYzSSa",
-	"HhasXENG6umsVI00of+jFE1XaCxosdQ4yY9/EcJRpfReYa1fU6uL7+K503DbRyHMmxBTIrTkcMOkeUka",
-	"rqFdFaUuEWTFAFclpb28suLcUEpQKh4qYXUXtDvgTBSkc0AFIesgfk/pxYap7/lAxjn2Claz7L620Xt+",
-	"1dTYqF/Jeuse0KVccJZiLcnQ1WxfpR7jnR1RdjOcGWDjbeQkcLiCb3zUyRoWi9FXPxwjtIjru4e8r3pT",
-	"DXWYPxU+f7yiiixBScvZIJu6p2qshZpxCbaYMj5Q7vFJUbY83sghg0EUjZy8JxlhcnbE5PCt/vbOGqQw",
-	"a/GKcVQ9XY6ESZA0NmR8NFdpfZUpshSYQWEPhb+mj7rPDIu1ZLD5NHOP7OIYxmGsl22iI/pDnbpYCRub",
-	"oNu+0m1NQb3m51YenJn0tCjspPGHjILygNrwKIIDPu860MtDbj2+P9oAuQ0GOeF9qgkNrjFEAgpiU2Mi",
-	"j/p0kmC00GooClsQEx8drKMVDBN9wzg0T0AHLog0eCXgxuB5jfSTaUmVEQFH8bQLoDnGRYQYmlTWKXbf",
-	"oTobbONJi3Ti5ohvY/MeUYRx1A0awY3ybf3ytKZuT5h4hU/eW0T2XxdCqcoKUTa5pv3eUIhxaMbtCnK2",
-	"L4D+MejLRKa7Kqk5OfvcRLFSJfMqW4JKaJaF7Alf41eCX125UthAWtVVvIuCpFiZr12qsE9tdqJUcFmt",
-	"B+ZyDe45nfeAV4Aa/EfE3A5j4PV8i/+GSljHd8aGB+0dY+9igbI6fW4fubk9Uk/q1TSdSLZMxmMC75T7",
-	"o6OZ+m6E3vQ/KKXnYtkG5AsXKBvicv4ehfjbN/ri8Ot39eqym6ulLq+F4aDCPbuKamNdGKbNlVzWaW9O",
-	"r/LysAEi/kDjFC+/SF6LZ+ul5n41fu1YdksaTcaiytZPUJQMsqBoTrqJKzPZ5whF2KYfiyUzoWT6c6/3",
-	"OMmwJ2fj2IMIdUGKfYC+dxHQpKDMBm00zKKPWZvuFTcXDh26ZoO7i7BJVFGL3ffXsYQnlwdsMjs6T9pd",
-	"gS2qVJRwzUTlwiFcvJxTCc2v9klxL684uv5+3AxO9fuaQaNG2wv7fIpZptXJv//RRFcS4Krc/guYcHub",
-	"3nsQMFSzuPUcoBWugvYmNfaufF2/KXh1naxFNpQw/f2P5LXzLY26dxwhh8oticw+whVMFn9jn4BwzbT0",
-	"OXrat7bTaVEMTx3JEO9PbhruO32s1JQ+n0NWt/fu/JpnFH0TQkBX8dKZOWxU+MGkXjbsDRDYFIC1br3E",
-	"5nj1jLEEZZMcUVtNcqASBjDsV22zbUci+WLzRrcfl2wffsgyXnK2KTOLzLMQkjWP84ReuBwZcnyBj1R6",
-	"HsP+WC7e7xpSJcpWHFMJsE8BXT2Z93ryv0vPRgwldWS2o/+BMrPTic9bgomK9njRpkQOetXQ5RooVW/a",
-	"BJi97cz0Ialg6obQPyxoLsNvlUWDXTuVT7yAlUCh5/DCzrIR1b7tcqZeDATLhhEZzgQwwd//PZFp4toP",
-	"i87em13DWkWv8IJXPMQ8rTTbI4CkjqJGyRD3awncPqy9CKFmd1bUYgGpYtc7Cl38YwXcK6IwdZZghGXh",
-	"1b1gdZYNFhTd38/RADRUh2IQHq+w/73BieWIXsH2gSQtagi+9TR1wv1dakkiBvDW0oJHIWQoStG4rmzg",
-	"GJM1ZSAWXFSw6Q5NVe7oI5uenHPHuRxJtiWegSmvRcj2PWou3XWvSmCYMBKrhdF/5i5u8XiNrwrK+gFs",
-	"V4vStwuSs8BDULaWJZYlqb21rqolSPebq0FkZsnZFfjPgKJvHEso2BZBY6+zIycDclIv+zv4ehXWznIz",
-	"syaHo5/vG6gBjdFPaS7w5adYulM7baIO83ogTXAoiin4EhXCtYDSPpeMN0MuJCRKuNC6ITiGUGEiYO+E",
-	"BBl9d8EAF62G+qEp94rvz5hiGdQGvvoLJCWsqYau9IqyxuccQvYr890luLqaXDtt2jW9JjurqrrsHSZ7",
-	"SPSpfkHsbbk7cfYu5m3GOZSJ83V3Ywq5RqXvfy1KkVWpLQTjHYzaBTC6YNkAKwlahtP+KntGvhyrgb/x",
-	"yhBcwfbI2F/SFeVLr7yaD70R7c0avMplnd0+qOU/bOTMl2YBy4PA+Xtaz6eTQog8iThcz/qFZrtn4Iql",
-	"V1rMrpq498hDm+Qh+vnqiJqb1dYVVi0K4JA9mhFyyk2mkQuuab901JmcP1BD829w1qwytZ+tYX92ycMp",
-	"G1jUp7wnf3PDDHM1CZr53XMqM8iOMqabSJHbkt4Enp3tx9ONDnfpPgXaEJWBIiSl3LFU16jz3TfuB0jf",
-	"ewVxWPvxK/k1Ucyl8RGhtNS8DNkWXt42rp9x7zG6DjvA84013ouMjhtZcH7nUOO3NVK8pUQpobX8XfYf",
-	"u8CGL3lbJDFrUi/TFCA2YWrtffGMe/JVbTML47lvWsOyfYJjzd++SU6iz9CUYfUIR5/L8prmX96shvUc",
-	"TxEf9nH58EJ9/ddHskGlvFu83xs6am5P1z3c1Pw9mgH/AXqPgs5eO5R1/tQvYToXGZa4pznJRfMuMg5J",
-	"bnBM4x1+8hWZ2yy6ooSUSdZJML5xr5rU6h4+8mVjLDdqh365a50/CnUPMrYKgijIu+aFBCXwfmggbI7o",
-	"78xUIic3SOUh6uuRRQB/IR7ll7PZcV1ctdzG5sWZTjykKOHA7mMvEGxP93G/UM/Y5RkXqb50Kgn9dY6+",
-	"rVu4DVzUzdrGxj70kTtURn9MyEL4dQzdHWMmDELwaRmCoJKfn/xMSljg25GCPH6MEzx+PLVNf37a/qyP",
-	"8+PHQTHui0VLGBzZMey8QYqxzrReKgxsClZGiv59sMzdXtjoviPYAcLVOXMIvgaDU7u40S9cChpl7p0G",
-	"frM023gXP/NQ5pZcTxTC/Y+x3AUTnx9Jk+mchYrl2a5D2Up6al6+xbSen2xC7u/y9u5PxpbdZ5P2/cN9",
-	"YuS6BwARE1hra3JvKi+daUQmk+0WyFtC4kqrkqkt1glzpk/2UzCm5rvaW2K9wHVlGSt3KHEFdaW5xrdS",
-	"SSfZfCdojrKA1mcwQlEJkc/INxu6LnKwTOqvD+Z/hmd/eZ4dP3vy5/lfjl8cp/D8xcvjY/ryOX3y8tkT",
-	"ePqXF8+P4cniq5fzp9nT50/nz58+/+rFy/TZ8yfz51+9/PMDfQdokA2gE1eVYvK/8YHq5PT9WXKhgW1w",
-	"Qgv2PWzNW5iajN0rmzRFLghryvLJifvpfzruNkvFuhne/TqxSe+TlVKFPDk6urm5mfldjpZoTE2UqNLV",
-	"kZun9wzn6fuzOj3MxELhjprMH00KuKmWFE7x24dvzi/I6fuzWUMwk5PJ8ex49gRrGRfAacEmJ5Nn+BOe",
-	"nhXu+5ErInzy+XY6OVoBzdEnrv9YgypZ6j7JG7pcQjmzz43qn66fHjkx7uizNSTfDn078l/uOfrcsrdn",
-	"O3pioMvRZ1fEarh1q0qU9TN4HUZCMdTsaI4ZyGObgvQax5eCyp08+ozqSfT3I5uWGf6IaqI5A0fOKRVu",
-	"2cLSZ7XRsHZ6pFSlq6o4+oz/QZq8NUwih5ALymQzUtI0nxKmCJ2LEqtHqXSl+YIrW8Ok13KClGqI/CzT",
-	"xK17vTIQuAJ1pmLvycd+ACIORNxIyAk0mTcHtTVTw4vR7+4Vka1vmlb75r75eJy8/PT5yfTJ8e2f9H1i",
-	"/3zx7HakL/lVPS45ry+LkQ0/Yc0XtIrj+X16fLzX08A9tbRZpNmkOhw5EMRgdiJZxywndqs6A5EaGTtq",
-	"U3SGDz2lfDudPN9zxYO2u1aIduBJ5K9pRlyCL8795MvNfcbRk6/5OjH31u108uJLrv6Ma5KnOcGWXrGx",
-	"/tb/nV9xccNdSy1kVOs1LbfuGMsWUyB2s/Eqo0uJltySXVOU7bjg7XL1n9B7EEqyjvAbqegd+M257vVv",
-	"fvOl+A1u0iH4TXugA/Obp3ue+T/+iv/NYf9oHPbcsLt7cVgr8Jm8tr4EaiL7j7C+2Lb/85anwR/7A3Wf",
-	"DA79fPS5/RJPS0aWq0pl4saURwleClirmea2sCMaoGuFSgniBmgCCskPNusq36LVnWVAKEa3i0o1Gq/u",
-	"7NzEjXlJj9A8J75kHCdAwz7OYiqYUi9UR0IquHl8t3MBWcjeiQz6FxBeMb9UUG6bO8bCOJm2OJAloUC9",
-	"0Hsz9D7DuN2PwNABYbxnfeKoX9xt/X10Q5nS15SN7EOM9jsroPmRLRzQ+bXJ1et9wQRE70dPJwr/elTX",
-	"wwp+7Cqboa9W2Yo0cmVf3OfG2OQbb5AkarPNx096Z7Ggo6WWxhZxcnSE0TIrIdXR5Hb6uWOn8D9+qjfT",
-	"1VOqN/X20+3/DwAA//+vckvpEccAAA==",
+	"H4sIAAAAAAAC/+y9fXPcNtIg/lVQ8zxVjv2bkeSX5Fmrauv5KXaS1cV2XJY2e3e2L4shMTNYcQAuAUoz",
+	"8em7X3U3QIIkyOFIE2ez5b9sDfHSaDQajX79NEn0OtdKKGsmp58mOS/4WlhR4F88SXSp7Eym8FcqTFLI",
+	"3EqtJqf+GzO2kGo5mU4k/Jpzu5pMJ4qvxeQ07D+dFOKfpSxEOjm1RSmmE5OsxJrDwHabQ+tqpM1sqWdu",
+	"iDMa4vzl5HbgA0/TQhjThfInlW2ZVElWpoLZgivDE/hk2I20K2ZX0jDXmUnFtBJML5hdNRqzhRRZao78",
+	"Iv9ZimIbrNJN3r+k2xrEWaEz0YXzhV7PpRIeKlEBVW0Is5qlYoGNVtwymAFg9Q2tZkbwIlmxhS52gEpA",
+	"hPAKVa4np+8nRqhUFLhbiZDX+N9FIcSvYmZ5sRR28nEaW9zCimJm5TqytHOH/UKYMrOGYVtc41JeC8Wg",
+	"1xF7XRrL5oJxxd59/4I9ffr0OSxkza0VqSOy3lXVs4drou6T00nKrfCfu7TGs6UuuEpnVft337/A+S/c",
+	"Ase24saI+GE5gy/s/GXfAnzHCAlJZcUS96FB/dAjcijqn+dioQsxck+o8UE3JZz/d92VhNtklWupbGRf",
+	"GH5l9DnKw4LuQzysAqDRPgdMFTDo+5PZ84+fHk8fn9z+x/uz2f92f3799Hbk8l9U4+7AQLRhUhaFUMl2",
+	"tiwEx9Oy4qqLj3eOHsxKl1nKVvwaN5+vkdW7vgz6Euu85lkJdCKTQp9lS20Yd2SUigUvM8v8xKxUmTAG",
+	"R3PUzqRheaGvZSrSKZOK3axksmIJNzQEtmM3MsuABksj0j5ai69u4DDdhigBuO6ED1zQvy4y6nXtwITY",
+	"IDeYJZk2Ymb1juvJ3zhcpSy8UOq7yux3WbHLlWA4OXygyxZxp4Cms2zLLO5ryrhhnPmracrkgm11yW5w",
+	"czJ5hf3dagBrawZIw81p3KNwePvQ10FGBHlzrTPBFSLPn7suytRCLstCGHazEnbl7rxCmFwrI5ie/0Mk",
+	"Frb9f1z89Ibpgr0WxvCleMuTKyZUotP+PXaTxm7wfxgNG742y5wnV/HrOpNrGQH5Nd/IdblmqlzPRQH7",
+	"5e8Hq1khbFmoPoBoxB10tuab7qSXRakS3Nx62oagBqQkTZ7x7RE7X7A13/z5ZOrAMYxnGcuFSqVaMrtR",
+	"vUIazL0bvFmhS5WOkGEsbFhwa5pcJHIhRcqqUQYgcdPsgkeq/eCpJasAHKl2gCPVOHCU2ERoBo4ufGE5",
+	"X4qAZI7YXx3nwq9WXwlVMTg23+KnvBDXUpem6tQDI049LF4rbcUsL8RCRmjswqHDMM6ojWOvayfgJFpZ",
+	"LpVImVQEtLaCOFEvTMGEw4+Z7hU950Z882xyu+vryN1f6PauD+74qN3GRjM6kpF7Eb66AxsXmxr9Rzz+",
+	"wrmNXM7o585GyuUlXCULmeE18w/YP4+G0iATaCDCXzxGLhW3ZSFOP6hH8BebsQvLVcqLFH5Z00+vy8zK",
+	"C7mEnzL66ZVeyuRCLnuQWcEafU1htzX9A+PF2bHdRB8Nr7S+KvNwQUnjVTrfsvOXfZtMY+5LmGfVUzZ8",
+	"VVxu/Etj3x52U21kD5C9uMs5NLwS20IAtDxZ4D+bBdITXxS/wj95nkFvmy9iqAU6dvct6gaczuAszzOZ",
+	"cEDiO/cZvgITEPRK4HWLY7xQTz8FIOaFzkVhJQ3K83yW6YRnM2O5xZH+sxCLyenkP45r5coxdTfHweSv",
+	"oNcFdgJ5lGScGc/zPcZ4C3KNGWAWwKDxE7IJYnsoEUlFmwikJIEFZ+KaK3s0mcbOZH2A37uZanyTKEP4",
+	"br2vehHOqOFcGBJvqeEDwwLUM0QrQ7SitLnM9Lz64auzPK8xiN/P8pzwgaKhkCh1iY001jzE5fP6JIXz",
+	"nL88Yj+EY6OcrUF3NBdO1IC7YeFuLXeLVYojt4Z6xAeG4XaCJuZ2WqHBGGEPQXH4ZljpDKSenbQCjf/i",
+	"2oZkBr+P6vzHILEQt/3EBa2Ywxw9YPCX4OXyVYtyuoTjdDlH7Kzd925kA6PECeZOtDK4nzTuAB4rFN4U",
+	"PCcA3Re6S6XCFxg1IljvyU1HMroozPXnkNYQqjuftZ3nIQoJfGjD8G2mk6u/cLM6wJmf+7G6xw+nYSvB",
+	"U1GwFTero0lMygiPVz3amCMGDfH1zubBVEfVEg+1vB1LS7nlR5M2vHGxhFCP/ZDpiSLydvkJ/8MzBp/h",
+	"bHPr3+Wgk5B4RHVgQUjhKU8PBJoJGsDGW83W9Hpn8OreC8oX9eTxfRq1R9+RwsDtkFsE7pDeHPwYfKs3",
+	"MRi+1ZvOEdAbYQ5BH3pD/5FWrM0I+F46yDTuv0MfLwq+7SIZxx6DZFggiK4GT4MKb3yYpda8ns11cTfu",
+	"02IritX6ZMZh1ID5TltIwqZlPnOkGNFJUYPWQLUJb5hptIePYayBhQvLfwMsGMsD4O+BheZAh8aCXucy",
+	"Ewcg/VWU6YOS4OkTdvGXs68fP/nlydffAEnmhV4WfM3mWysM+8q9zZix20w87K5sOqGnc3z0b555LWRz",
+	"3Ng4RpdFItY87w5F2k0SgagZg3ZdrDXRjKuuABxzOC8FcHJCOyPFPYD2Uly/1qlAjcUBaLGWdd2aMpEu",
+	"hde9cZaKa5HB9rG1TgWD/+HAXTodEKYzboWxsXnuJToDNqThxoj1/CCk2Uc+aT1Lyty+pGLn0dp3s+tp",
+	"tuGGF9uiPMTDXhSFLiLaRtxIqxOdza5FYaSOGI7euhbMtfDCft7+naBlN9w4WhEpK1Xa2Ol6YtBwj74F",
+	"aejLjapxM3gP0nojq3PzjtmXJvK9XtWwHIxyG8VSMS+XjXfhotBrODfYESWWH4RFwehSrsWF5ev8p8Xi",
+	"rsJ892yRgGTlWhgYm2kcnMTb1uFVOo3cL9QhPnhtwjAi0SoFy7q9EU5mrCZF+SGBxSSlldcOKDPicLvJ",
+	"e073D8JebFVyd143mkOtpUJTkdmqJHj7H4hRTbtm2AY9eT0vTfXARMABQvqL4JldvRP5XYWxodMVDh59",
+	"NEUm91ygkjmsZg9++O6SHReCp9sHqJGgH1bY/TgVlsvMPIDlvMLVXiiem5U+iFzlLy/jxhyQqnaqfvB+",
+	"9+MAO7McTD48qu2ZTnzTmewZVVY3n286gqTCUafDN6HDpuVWvBSZ5QcnkPYEMSJ54fmj24gUGqKq7ZVc",
+	"rmzwin1baL04PIyxWWKA4gdikhn06WoC3ugUuLUtzQEosx6svkKAFMKLg891aRlHLo1q29LE3wI9vj/o",
+	"dIC+EjZ8XtgVPevnArhMwktYLZhhdOxCrjvOeEJ0OCNmvuuCoFY0HfmVZMgE2FwIxfTc2SOdpRQXydGN",
+	"wfpz4V4i0eMVwJUXOhHGgMqXFHk7QfPt6G62A3hCwBHgahZmNFvw4t7AXl3vhPNKbGfodGPYVz/+bB7+",
+	"DvBabXm2A7HYJobeSqskVQ/U46YfIrj25CHZ8QKuIqJaZjU+njJhRR8K98JJ7/61Iers4v3Rci0KNP/+",
+	"phTvJ7kfAVWg/sb0fl9oy7zHldRpU0BYhw1TXGkn+0YHE7zIpDB2xq+5zPg8E7MB0cK3jpiXHE9ccboZ",
+	"kK7Jk8yRuFsZDVHQCIbdiAJeYaUCNztd0N9KW7YQNlmJtHrIj6T7jBs723XNQKNwQAM7EnD22M2CA/eg",
+	"5hU3llwwpEpRc0xIwHkIVTBFP8C971YY+Wf/ZO2OjW8WZUpTvV9NmYOEK9LYGsBvp3+uN2JTzaUXwdjV",
+	"I9lqVhqxa+Q+LAXjO2Q56Rv/4DYkJfBR6i4O7Xkgt2yjqGwAUSNiCJAL3yrAbuge2AOINDWiiXCkaVFO",
+	"5ZM4nRir8xy4n52VqurXh6YLan1m/1q37RIXt/WZS7UweGZcewf5jT9j8IqBc+ngYGt+BbIUahHJV6QL",
+	"MzCXmZEqEbMhykedALQKj8AOptOjwHWu58FsrcPRot8o0fUSwY5d6Ftwz2PlLS+sTGSOku+PYnvwh0B7",
+	"gqiNk9FrVKQs+ECPgjzsz8j5pz3m3R4Go1RdXfA7uq7IcjJp8AJsAn8ltvgCe0tepZeBL+oBXjaRUeF0",
+	"c8UQUO+rBgJZ2ERseGKzLaPbbkvXlinna2ktuQk3Hz5W57NwgKhRZWBGZ0Ekj0y/A2NMmhc4VLC87lZM",
+	"JyQhDsN32RITG+hwkmGudTZCK9BBRhSCUc4mLNew69J5pXvXZU9JDSCdUJZtPbjAPB+YBppxBex/6ZIl",
+	"XKEAXlpR3Qi6QDaL1y/MIE0wp3MrqTEkMrEW9K7AL48etRf+6JHbc2nYQtz4UI5Hj7roePQIX/VvtbGN",
+	"w3UAtSIct/MIb0drE1wUTnJr85Tdbg1u5DE7+bY1uJ8Uz5QxjnBh+fdmAK2TuRmz9pBGxrl02M3IlQfr",
+	"ia4b9/2dyDRPKYbhQIp3XdpErykMhCUuOoLQX+BsXS6GU4iet0FliMf3y4qrJbxuhLVSLY2T7ZBNulFi",
+	"rCywrLRYVCHQPDyrUXxXGNCBBJShTCwWIrFMq0TUwpSbyOwDXmvrPZoiUPfIExdyXWaHOs+JNqi4WchY",
+	"ZOuHD++T/MOHj+ynHIyADFoz17o66WRfNkzA8xa5m7cmhAdhWegyn3r2tvxVonRZvRbm5WIhCuNdc0Cb",
+	"BrJyDpMdMYwErnzy0I8HsCQMzFaF3XrAKqc+2JbaM39rRSOq7/989d+nEM3HZ7+ezJ7/f8cfPz27ffio",
+	"8+OT2z//+f82f3p6++eH//2fsafNgsusLES//8KHD+8Xa8Do99TSux5N/ZUQ4uymDlxbONmtLND5kWVo",
+	"eSo0TxNubNTeiCxBLWeV+7yJgrM2AM7f3K3F1bYVaj0WBjYXCS+NCGQcB0HtwG+OIu+H1oFoozC6kJGG",
+	"J4jbQ5bVoURmqlOEPBNV/b+Nnr4eOgZld+LAe7P+2OfACW/SbHsALk8DsULkhTAAf0M3ZeirXoQRku4s",
+	"m62xYt1V31PXX3oeg+/8U6rzMtcqk0rM1lqJbTQpgFTiNX6M9SZpqKczyqV9fdtPzQb8LbCa84yhxvvi",
+	"F3c7uP7fVp7Lh7jiW+O2LDdhbChqJkWWM86STApFGg9blIn9oDhqEoLDFvHw8vqRft3SC98krsyK6Jrc",
+	"UB8Uxwu90i9E+eJCRPjy96Iy8JpyuaSbpZFGQogPyrWSipVKWpxrDfs1ow3LRYFuVkfUcs23bAExjlaz",
+	"X0Wh2by0TeaKIWzGgqaKzEgwDdOLD4pblgluLHstwQsEhvO3qqcZJeyNLq4qLMRttUuhhJFmFvdE+4G+",
+	"opOwW/7KOQzD/11np6D97Leph12mvZCfv3Qv8POX+MyqLQ8d2D+blhaiMqNEFjpftGiLfaW0rQjoYW3a",
+	"cbv+QYEHjtUQqC5Tbu9GDm0W1zmLdDpaVNPYiJbSza91z8fLPbgMizCZFmu88zXedd6MhzLCRvroRGjF",
+	"FqWirYTgfjTHYaSOF0v1YlqFq1KamlOGsYwr7j1A3Z9Pvv5mMq1jEKvvk+nEff0YoWSZbmKRpqnYxN6k",
+	"7oDgwXhgWM63RvR4eiDsUQ858igIh10LUGaYlcw/P6cwVs7jHM7HPzjd1kadKwpMgPODhrWt02/rxeeH",
+	"2xZCpCK3q1j6ioakgK3q3RSi5ewAEUpCTZk8Ekdt3VK6FMb76mWCL4BAyZgyyjGnOgdEaJ4qAqyHCxml",
+	"wInRDwq3jlvfTifu8jcHl8fdwDG42nP2+3w5hgnOXbc+AjYMU43obOlD0w3GMu6S9lDU9wf1Qb0UC6kk",
+	"fD/9oFJu+fGcG5mY49KI4luecZWIo6Vmpz646yW3/IPqKmD68moFYXUsL+eZTEBvHiNPypUSfTaC9hge",
+	"jm2PgK786qaK8heaYAZPeF3amUsGMSvEDS/SCOimSgaAI2PvwVmnzI2NP7rxmRs/zvN4npt2UHB3+Xme",
+	"wfIDMjQu5BW2jBmrCy+LSOOhwf19o93FUPAbn0mkNMKwv695/l4q+5HNPpQnJ08Fa0TJ/t1d+UCT21w0",
+	"dE53Clpuq81w4fSuERtb8FnOlz1KAys46oVIXl7jIzvLGHYLcVJFH+BQ9QI8Pvo3gODYO9IQF3dBvXxW",
+	"r/gS8BNuIbYBcaM2z951v4J43TtvVyvmt7NLpV3N4GxHV2WAxP3OVMl+llwq423moEZBrQzlRZqD8lMk",
+	"VyLFFC1indvttNFdLxqCpmcd0lAqI4q2w3wbaAiBFEd5yp0o3lIoAYadjhUHfSeuxPZS1+k69sl00Ay8",
+	"N30HFSk1kC6BWMNj68Zob77zZQJIeZ77+HUMZPRkcVrRhe/Tf5BJ5D3AIY4RRSMwvA8RvIggAjv0oeAO",
+	"C4Xx7kX6seXBK2NON18k85Hn/cw1qR9Pzk0nXM3lqvq+FpgXTd8YNucgt2uX0ouCywMuVoImskdCDm1R",
+	"I0O4G/YrHGTXvRe96cD63bzQOvdNFGRqPIM1RylFwBcgFXzMtJzN/Exk7iQFKunnHcLmGYpJlVceMR1e",
+	"NGyCajkEWpyARaFqgcOD0cRIKNmsuPHZxtJpcJZHyQC/YbKEoRQ554FfUZB5rVJ8e57bPqed16VLlOOz",
+	"4/iUOOHTckR6m+nEuWbHtkMrFIBSkYklLZwae0KpEzfUGwRw/LRYZFIJNou5KHFjdCKRFQXXjJtDgHz8",
+	"iDFSAbPRI8TIOAAbzfg4MHujw7OplvsAqVziCe7HRgeA4G8RjwojJ2QQeXQOLFyqHnd3zwG482ur7q+W",
+	"tygOw6SaMmBz1zwTyvoXXz1IJ1MLiq2tvCzOkeRhnzg7oIGni2WvNWGPO60mlJk80HGBbgDiud7MKEg2",
+	"KvHON3Og96hfNvSKHkzKifPAsLneoHMSXi3kB7wDln44PBg1AJjsBNaO/fpucwJmaNphaSpGhYZ9Vck2",
+	"Nbn0iRNjpu6RYPrI5asgzc2dAGjb46ucWO7xu/OR2hRPupd5fatN6/RtPuQldvz7jlB0l3rw19XCVIlp",
+	"nArhnUh0kfbrKYBQpa0ybHfVC9RuBnxjdOqagWzfZ83Xhn9CdHeux4emAU89zwAiXlLAVgeS7za5NsK4",
+	"gC686t3gTk4sBAXDG9JZgXE6c4JBH5piC/YefB7jtOQ6JaAfcJzsHNvcnkf+ECx5Hodjn5fKO4efASh6",
+	"TnkNBzS4LyQujdAgLLf99PG2LdpHD0qjVSt5VfDWit0OQD5da2bXZmpEJvD1PGu8NmZXYhtXAggUzS58",
+	"t0DLhymyuNo+DDwcC7GUxora2iRNjenPrcfnmJlT60X/6mxeLGB977Su5DnsSFr8xjI/+wqutRWzhSzA",
+	"Fx1MddElQKPvDWqfvoem8UdFY7MZJamWafwSxWkhxiiVWRmnVzfvjy9h2jd1sHs5R8FEKiZ4smJzTKoe",
+	"9awemJqc7wcX/IoW/IofbL3jTgM0hYkLIJfmHH+Qc9EOlB5gBxECjBFHd9d6UTpwgQbx0V3uGDww6HDi",
+	"dXo0ZKboHKbUj73Tv8pHafcJczTSwFrQNajXlT3ikEN+ZMTU63oq0Uhmpe2sofyIoKtS8Bh0N5WKqeYG",
+	"q6WfJh6cp+ldPWpo13bHgGr8eGr3cE4InmWQp2N3yABHjHsFDnpG0AjoesMw+Mb7eOyW6rs7UCOsWmkb",
+	"xii1dKSbIcNt/TRyGU7rtzUSLOCOpMzx1juQ0Dy91fTdNd3l+QwUD9Ggtr8FUWs8zzHlg28cC/CCwSS4",
+	"E8TBoU/TWNWTrvK+lMp+88yPeojku61xxi87TFE7BgUozpk7JPjtf2MGuxSiuX9RPUTpZxxmxDh49bKr",
+	"pdMO9fVc4zzPZbpp2T1p1F7t+EEwhheUG2wHBgLaiIVLFsI09j1Q5lGBjEZmwKNRmLlsJhAOZZpwKml8",
+	"eacuoqrw8F24guRZP4rtz9AWlzO5nU7uZyaN4dqNuAPXb6vtjeIZ3fDIbNbwetgT5TwH5xaezZwxuY80",
+	"C33tSBObe9vzZ5bW4lzv8ruzV28d+GCvywQvZtVrp3dV2C7/w6yKsiD3HBBfPmbFbaWfo9dwsPlV6tbQ",
+	"AH2zEq5UR/Cg7uQUr50L6vG8QXoR9wbeaV52fhC0xAF/CJFX7hC1qQ47tzwgqswJpMOWAypZWty4uzHK",
+	"FcIB7u1JEd5FB2U3ndMdPx01de3gSeFcA8VE1lQvxzCt2u5y8AqGGYhUwYt7LpwFpMucVLlGq8HMZDKJ",
+	"21PVHENsFPnJQGOGjXve0zBiKXvcrlQpg7Gg2ZjMcy0ggzmiyDTRHHk17ubaRVyVSv6zFEymQln4VOCp",
+	"bB1U1J86y3r3Oo1LlW5g7BMMfx8ZI8yG377xnMw1JGCEXjkdcF9WWj+/0Mr6xJWX1vd17gtn7FyJA455",
+	"jj4cNVOgwqrpXTNaQt9ZFNHr31xa/p45okUOpZktCv2riKuqUMMXiaV2E6Ewhb1HhJTVlpy6VmM9e+92",
+	"90k3wUfWdEjsoXrc+cAFBwMYvTWaK9pqitdt+LXHCSZoYY5p/JpgHMydqJuM38x5chUXMgCmwPzSsJtb",
+	"zXxnj3tno5GuJMMRC/zGqraSsozkoqjTHHQzsN1RYKBpR4sKtWQAHRsywZR8fTKjI8OU6oYrK3yhCTpK",
+	"rrcRpL+HXje6wBxBJm7iT0Ui11Hl0ocP79Oka85N5VJS4bbSiKAymBuIKl4SFbnqauROV6PmfMFOpkHt",
+	"QbcbqbyWRs4zgS0eUwuwaeHa/FmuusDyhLIrg82fjGi+KlVaiNSuDCHWaFYJdfi8qRxVfG7VE2z3+Dn7",
+	"Cl10jLwWDwGL7n6enD5+jgZW+uMkdgG4Co1D3CRdhEGucTpGHyUaAxi3G/Uoqg2gsrr9jGvgNFHXMWcJ",
+	"Wzpet/ssrbniSxH3Cl3vgIn64m6iLaCFF5VSTUhjC71lsifcWFgO/Kkn0gzYH4HBEr1eS7t2jhxGr4Ge",
+	"6rJfNKkfjtKCubBwD5f/iP5QuXcHaT0iP6/dh+632KrRa+0NX4smWqeMU2IoCP/3noq+jgw793n0MANB",
+	"VbmCcANzwdJRzIEtxPTxUll8WJR2MfsTS1a84Amwv6M+cGfzb55FynY008er/QD/7HgvhBHFdRz1RQ/Z",
+	"exnC9YXYOzVbS2D1D+vIzuBU9jpuRae1fX5Cw0OPFcpglFkvuZUNcuMBp74X4amBAe9JitV69qLHvVf2",
+	"2SmzLOLkwUvYob++e+WkjLUuYslx6+PuJI5C2EKKa5H2bhKMec+9KLJRu3Af6H9f46kXOQOxzJ/l3ofA",
+	"Phaf4G2ANp/QM/Eu1p6mpachc8U2ED+MtIBQVepddo/71KtrdN4HKtdlJHQ9SoRGAGwLY/u9gO+vYghM",
+	"Po0d6sNRc2kxyvxWR5bsixxVNh4XMRnRW/VdIPABGNTcDTVlzYIyn9+jxptFup4d8MXDin+0gf2dmQ0i",
+	"2a+gZxODYlfR7Uyr74FzGWff6s3YTW3xbr+x/wKoiaKklFn6c50bpLnCecFVsoo6i8yh4y911eNqcXSY",
+	"o9mRV1wp8kboDEevlF/8ayby3vqHHjvPWqqRbdvlzWi5rcXVgDfB9ED5CQG90mYwQYjVZtqFKqwvW+qU",
+	"4Tx16tr6Xu+WxQvK9WAqrti9iB8otMBi7WegYuzEhEpRj3HEfsAAaIClkVkT9QeUpUmkVbULNPWUeaZ5",
+	"OmUwDtigGM1KfShPGFWrWdK121hFv3/uPo62Q761h4joozJSs6rsTCxFCbS49A2YbFmX8GEdYueIvSSd",
+	"hvEvZpqEEvoVa5EGpXVIqkaagP9YyzHDttUNltpP8uPLLHmqNEGhd/f/pKJEOncAt6u0RIWWpkyD5HAj",
+	"IW3WiltxLZpZUTwYXgzwWVKayytKpYhSolLxUAqru6DdA4fjVgaoKGQtxO8pvTg39T2rTl1grxhRdkpY",
+	"dSq8U46NqhDna1+jnyutZIKZV2NXM2ZwGGedHZGkNh4Z4PxtzCRyuKKFs6pgDYfF3lJa00kDcV3zUPAV",
+	"NpWog/60YuPy/S+FNY6zQcSiq4bnNNRSGeFSjwMRhXxSFw2LN3LIqBNFLSfvSUYYnN2jcvgevr1xCik4",
+	"guxKUuE7hzYiaEk6ZKzLb+G9Ki1bamHcepoZasx76HOEyVpSsfl45Ov44xhkMIZlk3dEd6gz7yvhfBOg",
+	"7QtoSwn16p8bcXA06Vmeu0n7ayXGs3xuVC+CIzbvytErQG41fjjaALkNOjnhfQqEBnk5mbEiZy40pqdS",
+	"XisIhrJ5AkVhC0b+0TGkxN1EX0nlbRrxCyKJXgm4MXhee/qZpOA2WTXY0C7XCPSLiDE0Y51R7L5DtTbY",
+	"+ZPmycTP0b+NdZG/HsZRNagFN662zB8KoO5AmHgBwXHe6aRbsg+lKidEueCaZhG/GOMAxu0TcjYvgJ25",
+	"eKvutuCJaPQdcRP1pSqZl+lSWEiDEdMnfItfGX716UrFBuv1uZz3ec4AqHaqwi61uYkSrUy5HpjLN7jn",
+	"dEFVzAg1hJU5/Q4DpYGqE/7dL0uycw/a28fe+wKlVfjcPnJzc6SO1As0DYleZ+MxgXfK/dFRT303Qq/7",
+	"H5TSM71sAvKZE5QNcblwj2L87Tu4OML8XZ0qBnS1VOm10B1U+8ru+GysEsM0uZKPOu3MGWReHlZA9NeA",
+	"nuLl1xPXEuh6Od2vZNfui25JeoOxuHX5EyxngyyoNyad/MrwO0ER1+n3+ZKRKxl87vQeJxl25GwcexCh",
+	"3kmxC9CP3gOa5Vw6p42aWXQx68K9+tWFQ4eu3uD2IlwQVa/GjkqDvoCMSX23dithPqXkIOcl7Ez5lipd",
+	"frxSbW8G8TO2KtdcYYp0fHOKTZ5xxf1l46Mhy37DbxRvdaoPnyIk56iwvuEFJgblMovkCIlrPN1g/Qh0",
+	"tVeHcGfugDVqM5r3djczWk2Ap9t+o4UK9teBSInS454jd6oc3l50RCruSdSC4wpurMPi1tEG42utlgHQ",
+	"R5PpvTbeYd6jq5PqIEYKP173BQ/6mHr83q4cfCVcgrK8ENdSl961yPueevUK/YqOeI0Y/V5e0kUdTvX7",
+	"mhR6DSCXrnAXLdNRyI8/k6cyE8oW238Bc0hn0zuldWP5vxuFdd1DJaq7tWPlzpdVdd6r69lap0PJB378",
+	"mb30dtpRfMQTcix1mU5dOcto4oVXrviQbwYvudHTvnadzvJ8eOqebAvdyanhvtP3pW2D8zmkwX7rz2+r",
+	"rnsvh/OpAZTY2Hipvk5k+Q1ekALzRgdJAvoz0YwlKBcwjJqfGbBYMYDhMAOiazsSyZebV9B+XOKKeEno",
+	"/vTNdcpmZJ65NrIuCxerFT3Sff8Syz0H1vfuWN539lokVhcNn8BCiH2SUcNk3rb5JY1zv9KxinLw9D+Q",
+	"snk6CXlLNOjXHS9ep5tCCzW6L0QEM2oTYfaus4RDAgZ8NwT8sOCZiVfJ7HUcb2URCpy/IknT4ws7T3fj",
+	"0i9nGvgTyXQYkfGomjPywvm3RCbFiBwWnZ1qkcMv9E4SkyART98jYmeKKieE4n4thUJ7ZMoWMdTsjjDE",
+	"umLyekfSmL9RzSufkGTqrSoIyyLIISOriDVMzru/zbAGKON3hCfjhwOnL976SmwfGNaghmiVwakX7u+S",
+	"lxUxgLcWCB65NjzrMwM7J0xpKspALHgPe+ou6gz3veWdAznnjnN5kmxKPANTXmsr7jgXdN0rqx4GX/Xl",
+	"lekWWO3XHr7EerbG+ZvyKq9rqGMHc2GnqJrLC4spfirPB58hVhj/m8/nRbNk8kqEBajRzwTTkbgWPQUQ",
+	"ySYzG5CTOpkUopXgMA+dn1nW8VDd2PnuHpMnYZJprKLWFzrYDEGqXCYfGHK0RjEFq7ohXAtRFEQB0BLG",
+	"FjOrvZvqEBxDqDDoTX4nJJjeGiYEXG9m4Xd16mSs5USJZ1xZxsYCWSHWHKArggTH/XMOIfsFfffB4pGC",
+	"iT3jenqd7cxQ7CPhpOkgMaT6BXO35e4g9LuYiqRSoph5v5G2f64SRQgc5sBLy4Qu6PBgVOa00cn/BlhJ",
+	"1MqSdFfZUZhnmFn/VZDS40psj0n/QgVE61SFIfQk2tMagiyArd0+qBUtbjDIlrSA5UHg/D0tUdNJrnU2",
+	"63FeOO8mbW6fgSsJJQ8Y3B0+hqSnxDP7Cm3mlXfazWrrkxTnuVAifXjE2JmiqD3vqNasGtaaXD2wQ/Nv",
+	"cNa0pDzqzkh29EHFw58wQVZxT/7mhxnmakao9N5T0SDDE9lNT8JoqEDQLXje9U0d7TrWLkJdExVBEZNS",
+	"7pj2btT57hrKIqQfVBQdfv2EWTHriICC7K0oLdVVVpvCy+va/DSutqnvsAO8UFlTt6u4kQPnd3bbf10h",
+	"JVhKLyU0lr9L/+MWWPOlYIsMRiDDMimZN7l8NvclUO6ZF5XOLI7nrmoNU2Bqhfmzuyo5g/Z3SmkcEA6c",
+	"y+KaZ59frYa5Uc8QHyJ91y/whO/fEMmESnM339lXfNTcGf8NpoYShtdC/U3AHkUdJ9xQzvhTVZX1JjIs",
+	"F8EzlullUKcb3OxvcEzcafb4GzZ3Eal5IRJpZCtY/8ZXCKqee1gwj6YAbfvw+3LXOn/W9h5kTMuyOmdv",
+	"6mojVuP9UENYH9Hfman0nNwolceor0MWEfzFeFSYGmrHdXHVcMGg6k0t32JdiAO7YgROlXu6YnSTXo1d",
+	"Hq4DL53SiO46R9/WDdxGLup6bWP9iLrIHSpJMcb9J15pBrqj/xEhBBodMQSV/f3x31khsBq/1ezRI5zg",
+	"0aOpa/r3J83PcJwfPYqKcZ/N84hw5MZw80YpxhnTOmFlYpPLoieB5jvH3N2FjeY7hh1EPNNtJqKVlXBq",
+	"74P9eS9Skrl3Kvhpaa7xLn4WoMwvuZoohvuf++KAKNalJ+SsdRYgOm3XoWwEENZVpDFE7hcX3P671LH+",
+	"hXTZXTZJsO7lb9o+AIiYyFobkwdTBaGBI6ICXbdIDCASV1IW0m4x555Xfcpfoj41P1TWEmcFrrI0ObnD",
+	"6itRZW2sbSul8ZLND5pnKAvAewa9fS3Ub2Lfbfg6z4RjUn9+MP8v8fRPz9KTp4//a/6nk69PEvHs6+cn",
+	"J/z5M/74+dPH4smfvn52Ih4vvnk+f5I+efZk/uzJs2++fp48ffZ4/uyb5//1YDKdSACZAJ14z7nJ/8Ri",
+	"77Ozt+ezSwC2xgnPJRiksK4skLGvWMsT5IJizWU2OfU//f+eux0lel0P73+duAQSk5W1uTk9Pr65uTkK",
+	"uxwvUZk6s7pMVsd+nk5J27O351WoJflC4Y5SFB2QwtGkJoUz/Pbuu4tLdvb2/KgmmMnp5OTo5OgxjK9z",
+	"oXguJ6eTp/gTnp4V7vuxI7bJ6afb6eSYXM4afxw7dbn7cS1sIRP/l/e0g/+bG75ciuLI1faFn66fHHs5",
+	"7/iT0zTfDn07Du50+Ln+aybTHT3RE+b4k88YN9y6kZLNGSKCDiOhGGp2PNebPZoKEzTuXwq+/szxJ3y/",
+	"9P5+7GKg4x/xHUmH5NhbreItG1j6ZDcAa6tHwm2yKvPjT/gfJNpb4iKZiNmoKHSYs7r5lEkLNqECU7XZ",
+	"ZAWMw+eIkiZoOZlOqlNwngL1Q68XBIHPBknpsU/fdz0UcSDmR0JWAeegPsmNmWpmjYb5IGNzdRU12tcX",
+	"0vuT2fOPnx5PH5/c/gdcOO7Pr5/ejjQ2v6jGZRfVbTKy4cfphJRFzrvpycnJXnW4O+/WepG0SZXvf8TL",
+	"gXZi1usJ7baqNRCrkLHDYbU1fKxu+e108mzPFQ8q9xrxEJH649/ylPloepz78eeb+1yhqR8YP6OL7XY6",
+	"+fpzrv5cAcnzjGHLILNfd+v/qq6UvlG+JUgh5XrNi60/xqbBFJjbbLzrOBhH3k/yQl5zFP6UVs3aEB/R",
+	"vGDsaH5jLL8Dv7mAXl/4zefiN7hJh+A3zYEOzG+e7Hnm//gr/sJh/2gc9oLY3b04rBf4MOvxcSEyzfF1",
+	"HOe77wRP3cMUO5TERtlCZiIMjqFS99O6gLczwHrrvGvqKtD74tQJV64F/JCJerg6NwjlIa2OQCaNDYdO",
+	"22Oiq4wzfU6r0BSthG+AqfuwgBJ53DGtkmDmQtClctS5Nt4hrihd9OSg/LIVuMZbyKZN6nF0Ej32Ebic",
+	"TB0ftRNX+wXtOizNarZzVxj22I476mg8miJQ93LGL9xpf+5E5yPGLdpRdHvwKQp2776UU3G91qlwT91+",
+	"Bva3QlphvPsnmd/aWU98SESudTZ1ZOmcZ5VWwmnIKmWONES0dfYhrbDa+LXI4HhCWE+92CYP8Xq4l+L6",
+	"tU4F2qZ2iaB/VXITJIhyyCR3VvZtlTC7/jVovNBZpm9oT8IhFkbYcFumzDkC3ICJOsFRyBOQit8jHrz4",
+	"+89SFNta/g3Dh2qK7Dimfjw4y4wFbFLS9s5uVLvQ5aJ3Cgwd4XpL436Rvf5NuFvFSFqUVbuV78XVQu51",
+	"zNNrrhLhuJy53cnOsMAXPomDMCV0g6MRgYtpExx54zhBlEkQOzgkmzujBTkuh4Y8s4vNNf2gCRPoiEdj",
+	"TRm3bK2NZY9PTk6O4k/xws/U/wz/wpe+8KV/K60bHQ8TEtt867gBnYd7cidiDwDVMhZI94NwD7L68BqR",
+	"aDi9vtZIQ/rABwFWX09KCHdyLOuwUhYF40KOzguY8ydawsFPepyT0huuB9LmIa9xO8QM74TP3ZzATf6F",
+	"FURYwbOTZ58Pgjfasu+RLf9hn362LJwHQ490cQgedPyJ/h0QkF7zK9F97jDIT7t1MhAYChyQ7WaN5CbU",
+	"Js9KGo+mnjKpjBW8unfrB9MhOdhFLwfbQ4a6C9uoxSxojcWgsIpsUog1+aVk+iYo8gEDKFOaKuFvj2Sm",
+	"aw58X8msueSffpx8kVv+iLpsLzYcll8YxXOz0tYM6bQTXaRNdyvyp26I0lNmNRVQoRKkKHUXBz3kDlbK",
+	"zHNY6eSsStzuJhkwR+0MaQSkVONAEBfoaxXjfeFKrumsL4ZcVtzTNx0hrYSjTr+8Yv69uMElXtu8prLu",
+	"q/Y+zOD4U0A9g45N3+sC84Hz9vk55MF/iZO7hFxu/DFXO52bLmjxK7d1YL7cu18eCb/rGSeqN3H6PeDx",
+	"PnY39pAEUD9XHCw+SBblAGnZiqd1fbfu3TdlqTQJL1LvHk3Ss0t9baRK6rSIfaamQ3KUd7TkPxpL+aJk",
+	"/cI0vzDNYc2KobIS9+aa1P0Yy3Rua0O6/3mrkuiPXdN73sjWFP/5+FPjz6b3u1mVNtU3qp9BX+QikTxz",
+	"9ZFR8VCxSauZH6D2KmA/ueTl2RYDbmUqGMdHpS5tHewCnX2GiDqyDEZgZuVibpdS4QSAPYazeLbS0axE",
+	"nnQOsjc6FV3222c916Vt2M4rSjmZHp6VdjnS7X6EhHckBc53iaPKh9z4+/iGSwsOqC6pF2K029kKnh27",
+	"+jutX+uU950vmMc/+DG4buO/HldlJaMf22Eksa8ujKKnka+e5j/XcWZh3BaSRBWx9f4j7CzWRXbUUoch",
+	"nR4fY6KclTb2eHI7/dQKUQo/fqw205clrDb19uPt/xsA2gFVHrv2AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -548,229 +548,241 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XfbtpLov4Kj3XPysaKcz+6tz+nZ58Rtr7dJmhP79u7eOq+FyJGEawrgBUBbal7+",
-	"93cwAEiQBCXKVpym9U+JRRIYDAaD+Z4Po1QsC8GBazU6/DAqqKRL0CDxL5qmouQ6YZn5KwOVSlZoJvjo",
-	"0D8jSkvG56PxiJlfC6oXo/GI0yXU75jvxyMJ/yqZhGx0qGUJ45FKF7CkZmC9Lszb1UirZC4SN8SRHeLk",
-	"ePRxwwOaZRKU6kL5I8/XhPE0LzMgWlKuaGoeKXLF9ILoBVPEfUwYJ4IDETOiF42XyYxBnqmJX+S/SpDr",
-	"YJVu8v4lfaxBTKTIoQvnS7GcMg4eKqiAqjaEaEEymOFLC6qJmcHA6l/UgiigMl2QmZBbQLVAhPACL5ej",
-	"w59HCngGEncrBXaJ/51JgN8g0VTOQY/ej2OLm2mQiWbLyNJOHPYlqDLXiuC7uMY5uwROzFcT8rpUmkyB",
-	"UE7effeSPH369GuzkCXVGjJHZL2rqmcP12Q/Hx2OMqrBP+7SGs3nQlKeJdX77757ifOfugUOfYsqBfHD",
-	"cmSekJPjvgX4DyMkxLiGOe5Dg/rNF5FDUf88hZmQMHBP7Mt73ZRw/s+6KynV6aIQjOvIvhB8SuzjKA8L",
-	"Pt/EwyoAGu8XBlPSDPrzo+Tr9x8ejx8/+vhvPx8l/3B/Pn/6ceDyX1bjbsFA9MW0lBJ4uk7mEiielgXl",
-	"XXy8c/SgFqLMM7Kgl7j5dIms3n1LzLeWdV7SvDR0wlIpjvK5UIQ6MspgRstcEz8xKXlu2JQZzVE7YYoU",
-	"UlyyDLKx4b5XC5YuSEqVHQLfI1cszw0NlgqyPlqLr27DYfoYosTAdS184IJ+v8io17UFE7BCbpCkuVCQ",
-	"aLHlevI3DuUZCS+U+q5Su11W5GwBBCc3D+xli7jjhqbzfE007mtGqCKU+KtpTNiMrEVJrnBzcnaB37vV",
-	"GKwtiUEabk7jHjWHtw99HWREkDcVIgfKEXn+3HVRxmdsXkpQ5GoBeuHuPAmqEFwBEdN/QqrNtv/36Y9v",
-	"iJDkNShF5/CWphcEeCqy/j12k8Zu8H8qYTZ8qeYFTS/i13XOliwC8mu6YstySXi5nII0++XvBy2IBF1K",
-	"3geQHXELnS3pqjvpmSx5iptbT9sQ1AwpMVXkdD0hJzOypKtvHo0dOIrQPCcF8IzxOdEr3iukmbm3g5dI",
-	"UfJsgAyjzYYFt6YqIGUzBhmpRtkAiZtmGzyM7wZPLVkF4PhBesGpZtkCDodVhGbM0TVPSEHnEJDMhPzN",
-	"cS58qsUF8IrBkekaHxUSLpkoVfVRD4w49WbxmgsNSSFhxiI0durQYbiHfcex16UTcFLBNWUcMsN5EWih",
-	"wXKiXpiCCTcrM90rekoVfPWs7wKvnw7c/Zlo7/rGHR+02/hSYo9k5F40T92BjYtNje8HKH/h3IrNE/tz",
-	"ZyPZ/MxcJTOW4zXzT7N/Hg2lQibQQIS/eBSbc6pLCYfn/KH5iyTkVFOeUZmZX5b2p9dlrtkpm5ufcvvT",
-	"KzFn6Smb9yCzgjWqTeFnS/uPGS/OjvUqqjS8EuKiLMIFpQ2tdLomJ8d9m2zH3JUwjypVNtQqzlZe09j1",
-	"C72qNrIHyF7cFdS8eAFrCQZams7wn9UM6YnO5G/mn6LIzde6mMVQa+jY3bdoG3A2g6OiyFlKDRLfucfm",
-	"qWECYLUEWr9xgBfq4YcAxEKKAqRmdlBaFEkuUponSlONI/27hNnocPRvB7Vx5cB+rg6CyV+Zr07xIyOP",
-	"WhknoUWxwxhvjVyjNjALw6DxEbIJy/ZQImLcbqIhJWZYcA6XlOtJrY80+EF1gH92M9X4tqKMxXdLv+pF",
-	"OLEvTkFZ8da+eE+RAPUE0UoQrShtznMxrX64f1QUNQbx+VFRWHygaAgMpS5YMaXVA1w+rU9SOM/J8YR8",
-	"H46Ncrbg+dpcDlbUMHfDzN1a7harDEduDfWI9xTB7RRyYrbGo8HI8PugONQZFiI3Us9WWjEv/9W9G5KZ",
-	"+X3Qx18GiYW47Scu1KIc5qwCg78Emsv9FuV0CcfZcibkqP3t9cjGjBInmGvRysb9tONuwGOFwitJCwug",
-	"e2LvUsZRA7MvWVhvyE0HMroozMEZDmgNobr2Wdt6HqKQICm0YHiRi/Tir1Qt9nDmp36s7vHDacgCaAaS",
-	"LKhaTEYxKSM8XvVoQ46YeRG1dzINpppUS9zX8rYsLaOaBktz8MbFEot6/A6ZHsiI7vIj/ofmxDw2Z9uw",
-	"fjvshJwhA1P2ODsPQmZUeasg2JnMC2hiEGRptXditO6doHxZTx7fp0F79K01GLgdcovAHRKrvR+DF2IV",
-	"g+GFWHWOgFiB2gd9mHFQjNSwVAPgO3aQCdx/hz4qJV13kYxjD0GyWaARXRWeBh7e+GaW2vJ6NBXyetyn",
-	"xVY4qe3JhJpRA+Y7biEJXy2LxJFixCZlX2gNVLvwNjON9vAxjDWwcKrpJ8CCMqPuAwvNgfaNBbEsWA57",
-	"IP1FlOlPqYKnT8jpX4+eP37yy5PnXxmSLKSYS7ok07UGRe473Ywovc7hQXdlqB2VuY6P/tUzb4Vsjhsb",
-	"R4lSprCkRXcoa920IpB9jZj3ulhrohlXXQE45HCegeHkFu3EGu4NaMdMGQlrOd3LZvQhLKtnyYiDJIOt",
-	"xLTr8upp1uES5VqW+1BlQUohI/Y1PGJapCJPLkEqJiKukrfuDeLe8OJt0f7dQkuuqCJmbjT9lhwFighl",
-	"6RUfzvft0GcrXuNmI+e3642szs07ZF+ayPeWREUKkIlecZLBtJw3NKGZFEtCSYYf4h39PejTNU/RqrYP",
-	"Iu1X05aMo4lfrXka6Gxmo3LI5o1NuLlu1saKt8/Zqe6pCDgGHa/wMar1x5Brunf5pT1BDPaXfiMtsCQz",
-	"L6IW/IrNFzoQMN9KIWb7hzE2SwxQfGDF89x80xXS34gMzGJLtYfLuB6spnWzpyGF06koNaGEiwzQolKq",
-	"+DXd45ZHfyC6MXV48+uFlbinYAgppaVZbVkQdNJ1OEf9YUJTS70Jokb1eDEq95N9y05nXb65BJoZrR44",
-	"EVPnKnBODFwkRQ+j9hedExIiZ6kBVyFFCkpBljgTxVbQ/HuWiegNeELAEeBqFqIEmVF5Y2AvLrfCeQHr",
-	"BP3hitz/4Sf14DPAq4Wm+RbE4jsx9FYKn/MHdaEeNv0mgmtPHpIdlUA8zzXapWEQOWjoQ+FOOOndvzZE",
-	"nV28OVouQaJn5pNSvJ/kZgRUgfqJ6f2m0JZFT5SXU3TO2BLtdpxyoSAVPFPRwXKqdLKNLZuXGtqYWUHA",
-	"CWOcGAfuEUpeUaWtN5HxDI0g9jrBeayAYqboB7hXIDUj/+Rl0e7YqbkHuSpVJZiqsiiE1JDF1sBhtWGu",
-	"N7Cq5hKzYOxK+tWClAq2jdyHpWB8hyy7Eosgqiuju3O3dxeHpmlzz6+jqGwAUSNiEyCn/q0Au2GkSw8g",
-	"TNWItoTDVItyqvCa8UhpURSGW+ik5NV3fWg6tW8f6b/V73aJi+r63s4EmNm1h8lBfmUxa2OcFtSo0Dgy",
-	"WdILI3ugQmzdnl2YzWFMFOMpJJso3xzLU/NWeAS2HNIeW4SLogxmax2OFv1Gia6XCLbsQt+Cewwjb6nU",
-	"LGUFSoo/wHrvgnN7gqi5nmSgKTPKevDACtFF+D2xfuz2mNcTpAfpsF3wO0psZDk5U3hhNIG/gDVqLG9t",
-	"gNRZEFa1B00gMqo53ZQTBNSHXRgBJnwFVjTV+dpcc3oBa3IFEogqp0umtY14ayoKWhRJOEDUPrhhRmcM",
-	"t8FFfgeGWOdPcahged2tGI+sRLUZvrOWWNVAh5OkCiHyAbp3BxlRCAb5TUkhzK4zF2Dpo/A8JTWAdEIM",
-	"ekIq5nlPNdCMKyD/K0qSUo4Ca6mhuhGERDaL16+ZwVxg1ZzOQ1pjCHJYgpXD8cnDh+2FP3zo9pwpMoMr",
-	"H5VsXmyj4+FD1ILfCqUbh2sPlhZz3E4ivB0Np+aicDJcm6ds99C5kYfs5NvW4JW11ZwppRzhmuXfmAG0",
-	"TuZqyNpDGhnmncRxB9lEg6Fj68Z9P2XLMt/Xhs8oy0sJ/c6F8/OfZ8vz8/fkO/um9wuOPZGH6Liqo8pn",
-	"7jYqJUYmkJwZ9UAKmqVU6ahpFBfJ50kV26ai4CyVAefv7hxSvm7lQQ2FgUwhpaUN6nRc20FQR9epSUQi",
-	"au1uG4XRhQy0Lpa5tpd2iNW5FGVBVLXtlgo01fBpLHX10DEouxMHoRX1w77oCiNl5+s93NZ2ICKhkKCQ",
-	"t4baqbJPxSxMX3DMV62VhmXXgGc//aVHvH3nhcOOriF4zjgkS8FhHc3YYxxe48PY15a/93yMN23ft23h",
-	"uQF/C6zmPEOo8ab4xd0OGNrbKqxoD5vfHrdluw0TN9A2AXlBKElzhpYLwZWWZarPOUXdKDhsEfer1/j6",
-	"teWX/pW4eh7Rnt1Q55yi673SmKJ8cQYRvvwdgFeaVTmfg9ItKXEGcM7dW4yTkjONcy3NfiV2wwqQ6AOd",
-	"2DeXdE1mNEfl/jeQgkxL3WSuGF+utNG9rSHZTEPE7JxTTXIwXPU142crHM47YjzNcNBXQl5UWJhEz8Mc",
-	"OCimkrib+Hv7FCN43PIXLpoHk/3sY2t6NOPXQehrDY0Etv97/78Ofz5K/kGT3x4lX//HwfsPzz4+eNj5",
-	"8cnHb775f82fnn785sF//XtspzzssehnB/nJsdMpTo5RcKxtjx3Yb83utGQ8iRJZ6GFr0Ra5b8RfT0AP",
-	"auOu2/VzrlfcENIlzVlG9fXIoc3iOmfRno4W1TQ2omVG8GvdURy7AZchESbTYo3Xvsa7kRXxPAM0hrvU",
-	"ATwvs5LbrSyVM8hjGK33cIvZuMolsTnkhwQTDRbUh2e4P588/2o0rhMEquej8cg9fR+hZJatYmkgGaxi",
-	"UrY7IHgw7ilS0LUCHeceCHvUmW99iuGwSzDqmVqw4vY5hdJsGudwPjjRaesrfsJt1KA5P2haXzuLnZjd",
-	"PtxaAmRQ6EUst7QhKeBb9W4CtNydhRSXwMeETWDS1pazOSgfVpADnWGOI5qHxZBg6+ocWELzVBFgPVzI",
-	"IJU0Rj8o3Dpu/XE8cpe/2rs87gaOwdWes7Kj+7+1IPe+//aMHDiGqe7ZjCQ7dJBDErFCuTDphiPccDOb",
-	"UW9Tss75OT+GGePMPD885xnV9GBKFUvVQalAvqA55SlM5oIc+sjrY6rpOe9IWr1FL4KYd1KU05yl5CKU",
-	"iGvytInMUbWR5nNhFMe2T7Arv7qpovzFTpBcMb0QpU5cpmYi4YrKLAK6qjL1cGSbZ71p1jFxY1tW7DJB",
-	"3fhxnkeLQrUzdrrLL4rcLD8gQ+XyUcyWEaWF9LKIEVAsNLi/b4S7GCS98mm+pQJFfl3S4mfG9XuSnJeP",
-	"Hj0F0khh+dVd+YYm1wU07JXXyihq2ypx4VavgZWWNCnovMdooIEWuPsoLy9Ryc5zgp81Umd8aCAOVS/A",
-	"46N/AywcO6cB4OJO7Ve+5EZ8CfgItxDfMeJG7XC67n4FyTTX3q5WQk5nl0q9SMzZjq5KGRL3O1Nl4s+N",
-	"kOW9gIrNMdLKFS2YAkkXkF5AhvnTsCz0etz43DuanaDpWQdTts6ADYXHZFg07U6BlEVGnSjeMigZDCvQ",
-	"2od6vYMLWJ+JOpd2lzTEZlac6juoSKmBdGmINTy2boz25rtoBrR1FYVPLsMsA08WhxVd+G/6D7IVefdw",
-	"iGNE0cja6kMElRFEWOLvQcE1FmrGuxHpx5ZntIypvfkiZQk87yfulVp5coEH4WowGc0+XwIWLRFXikyp",
-	"kduFq7dhM78CLlYqOoceCTm0rg/Mr2pY5HGQbfde9KYTs/aF1rlvoiDblxOz5iilgHliSAWVmVa4iZ/J",
-	"OnCsAZVgGS2HsGmOYlIVl2OZDpUNL4etC9QHWpyAQfJa4PBgNDESSjYLqnwpEKyY4s/yIBngE2Yybspf",
-	"PwkiJYKyKJXh2/Pc9jntaJcui92nrvt89VC1HJB7biR8DM6MbYfgKABlkMPcLty+7AmlzqqsN8jA8eNs",
-	"ljMOJIkFXVClRMpsLZf6mnFzgJGPHxJiTcBk8AgxMg7ARsckDkzeiPBs8vkuQHKXFUr92OjSDP6GeAC7",
-	"DUM0Io8oDAtnvCfg1XMA6iJ1qvurFS+GwxDGx8SwuUuaGzbnNL56kE4aNYqtraRp5xp/0CfObrDA24tl",
-	"pzXZq+g6qwllJg90XKDbAPFUrBKbwRKVeKerqaH3aGQm5tPEDqZNWL+nyFSsMNwCrxYbCbgFln44PBiB",
-	"hr9iCukVv+u7zS0wm6bdLE3FqFAhyThzXkUufeLEkKl7JJg+crkf5KBfC4CWsaOu1uiU361KalM86V7m",
-	"9a02rmur+KD32PHvO0LRXerBX9cKU2WNOxPCO0iFzPrtFIZQma7KX3bNC654p+Ebg/PKN5TiPGpqG16F",
-	"6O5cT1RAA556ng2IOLYpGx1Ivl0Vwki3NqXD5vc7pFg5UYLNVFPWZqUYn+dOMOhDU2zBPibJY9wuua7X",
-	"4wccJjvHNrdHyd8ES1HE4dhFU3nn8LMBip5TXsOBcvgNIXE5/hth+dhPH2/bon30oDTDa5qVJQJdK3Y7",
-	"GPLpejO7PlMFOaD2nDS0jeQi5uM+P/9ZAYpmp/6zwMqH9SsoXz8IYrYkzJnSUHubjATrMX3bdnyKZbOE",
-	"mPWvThdyZtb3TohKnrN1WfDDxjJvfQWXQkMyY1LpBF110SWYl75TaH36zrwaVyqaUWG2giTL4pcoTnsB",
-	"6yRjeRmnVzfvD8dm2jeV7KDKKQomjBOg6YJMseJpNFZ0w9Q2nHjjgl/ZBb+ie1vvsNNgXjUTS0MuzTm+",
-	"kHPRuuk2sYMIAcaIo7trvSjdcIEGGZJd7hgoGPZw4nU62eSm6BymzI+9Nb7K52n2CXN2pA1rwdCg3uDc",
-	"SECOjSOzTL0udh7NZeRCJw3jRwRdlYFHaXph83GaG8znlU0lHjZl9epBQ7t3twzIh4/Htw/nhOAkh0vI",
-	"twdBU8S4N+BgZIQdAUNvCKYT+BiP7VJ9dwdqhFUrbcMYpZaOdLPJcVurRq78WK1bI8Ea3LnE4cHeOyOh",
-	"eXqr6bvruiuKJIMcomk6fw/ycGhRYLK9fzmWsmIGYzyDVRwc+2gcK0neNd6XjGtbvnJflfFa4wxfdlg/",
-	"bggKClvpbPfqe/06ZrBLIZr7F9VDlJVzYCMjxsErzS5o5tCmvp5rnBYFy1Ytv6cdtdc6vheM4QXlBtuC",
-	"gYA2YglgElSzbmBtzLPVqxtleyaDMHPWrO4XyjThVEz53gtdRFUJottwdQY0/wHWP5l3cTmjj+PRzdyk",
-	"MVy7Ebfg+m21vVE8YxiedZs1oh52RDktCikuaZ44Z3IfaUpx6UgTX/e+51uW1uJc7+zbo1dvHfgfx6M0",
-	"ByqTStvpXRW+V3wxq7IlCnsOiK/tvqC6ss9ZbTjY/KquWuiAvlqAq6MdKNSdgp91cEFwFJ1DehaPBt7q",
-	"XnZxEHaJG+IhoKjCIWpXnY2GaEZA0EvKcu8j89D2RO7i4obdjVGuEA5w40iK8C7aK7vpnO746aipawtP",
-	"CufaUOl7aYvZKyJ4O1zOaMHoekNSXVIs12k9IF3mxMsleg0SlbM07k/lU0yx4TZOxrxM8OUefdqMWLKe",
-	"sCtesmAs85oaYNRuARnMEUWmL/3ah7upcF2ISs7+VQJhGXBtHkk8la2DivZT51nvXqdxqdINbL3x9fA3",
-	"kTHCUrXtG8/JXJsEjDAqpwPucWX18wutvE/mhyD8YIfgvnDGzpW4ITDP0YejZpuosGhG1wyW0Ld2LPL2",
-	"N1czt2eOaAcippKZFL9B3FSFFr5IdqgvzsswovU34ANSympPTt1IqZ69d7v7pJvQ49QMSOyhetz5IAQH",
-	"q4R6bzTldqttQ5BGXHucYMIMkgM7fk0wDuZO1k1Or6Y0VkLVCBkGpsD90vCba0H8xx73zkfDXL3kCQni",
-	"xqp3ma2bUICsE7e7NZiuKTDYaQeLCrVkgFQbygRjG+uTKxEZpuRXlNu+MuiNwKPkvjYKvjcIXQmJVU9U",
-	"3MWfQcqWUePS+fnPWdp152ZszmxXlVJB0LbDDWTbUVkqcq1PbDhdjZqTGXk0DhoDud3I2CVTbJoDvvHY",
-	"vjGlCqxRxUdu+E/M8oDrhcLXnwx4fVHyTEKmF8oiVglSCXWo3lSBKlPQVwCcPML3Hn9N7mOIjmKX8MBg",
-	"0d3Po8PHX6OD1f7xKHYBuPZJm7hJNguTXON0jDFKdgzDuN2ok6g1wPa862dcG06T/XTIWcI3Ha/bfpaW",
-	"lNM5xKNCl1tgst/ibqIvoIUXntmGTUpLsSasJ90YNDX8qSfTzLA/CwZJxXLJ9NIFciixNPRU9+Swk/rh",
-	"bPcnV07Zw+UfYjxU4cNBWkrk7fp97P0WWzVGrb2hS2iidUyoLXWTszpS0Rd5Jye+khbWl67KSlvcmLnM",
-	"0lHMwcDFGSkk4xoVi1LPkr+QdEElTQ37m/SBm0y/ehapqd2s7cp3A/zW8S5BgbyMo172kL2XIdy35D4X",
-	"PFkajpI9qDM7g1PZG7gVD9HpixPaPPRQocyMkvSSW9kgNxpw6hsRHt8w4A1JsVrPTvS488punTJLGScP",
-	"Wpod+tu7V07KWAoZK49ZH3cncUjQksElxunHN8mMecO9kPmgXbgJ9J/XeepFzkAs82e5VxHYxeMT6Abo",
-	"8wkjE6/j7Wl6ehoyV9TtgxrOMA+IbRm5ze9xk2YyjY93gcpz6GHQ9RgRGgmwLYztpgHf3MQQuHwaO9SH",
-	"o+bSYpT5QkSW7DsQVD4elzEZsVv1XSDmgWFQUzfUmDSrvd9+RI13i3QjO8wTDyv+0Qb2MzMbRLJfQc8m",
-	"Bp0ootuZVc+D4DJKXojV0E1t8W6/sb8D1ERRUrI8+6muDdJq9CEpTxfRYJGp+fCXuiVhtTh7mKP1UReU",
-	"cxuN0LVNoJbyi9dmIvrWP8XQeZaMD3y33XvELre1uBrwJpgeKD+hQS/TuZkgxGqz7EKV1pfPRUZwnroY",
-	"Z32vd3vWBJ0F/lWC0rF7ER/Y1AK0qM8MFdsC/8AztGNMyPe2pfgCSKNWINoPbJUmyHyZdevqKYtc0GxM",
-	"zDhn3x69InZW+41trGUL68/ttdtYRX987i6Btptia/eR0WdWrTSW7lSaLotYiRLzxpl/AeughN4lVKxD",
-	"7EzIsbVpKK8x20kMPcyYXEJGqumcVI00Yf6jNU0XaCxosNR+kh/eEcJTpQq6sFbd1Kriu3juDNyuKYTt",
-	"CTEmwkgOV0zZTtJwCc2qKFWJICcG+CopzeXJknNLKVGpeFMJq+ug3QNnoyC9AyoKWQvxO0ovLkx9xwYZ",
-	"p/hVtJplu9tGp/2qrbFRdcl67RvoUi44S7GWZOxqdl2ph3hnB5TdjGcGuHgbNYocrmiPjypZw2Gxt+uH",
-	"Z4QOcV33UPDUbKqlDvunxvbHC6rJHLRynA2ysW9V4yzUjCtwxZSxQXnAJ4VseLyRQ0aDKGo5eUcywuTs",
-	"HpPDd+bZG2eQwqzFC8ZR9fQ5EjZB0tqQsWmuNvoq02QuMIPCHYpwTT+bbyZYrCWD1fuJb7KLY1iHsVm2",
-	"jY7oDnXkYyVcbIJ596V51xbUq39u5MHZSY+Kwk3a38goKg/oFe9FcMTnXQV6Bcitxg9H20BuG4Oc8D41",
-	"hAaXGCIBBXGpMT1NfVpJMEZotRSFbxAbHx2toxUNE33FONQtoCMXRBq9EnBj8Lz2fKdSSbUVAQfxtDOg",
-	"OcZFxBia0s4pdtOhWhvs4kmLdOTn6N/Guh9RD+OoXqgFN8rXVedpQ92BMPESW947RHa7C6FU5YQol1zT",
-	"7DcUYxyGcfuCnM0LoHsMujKR/VxLak/OLjdRX6mSaZnNQSc0y2L2hBf4lOBTX64UVpCWVRXvoiApVuZr",
-	"lirsUpubKBVclcsNc/kXbjhd0MArQg1hEzG/wxh4PV3jv7ES1v0748KDdo6x97FAWZU+t4vc3BypI/Ua",
-	"mk4UmyfDMYF3ys3RUU99PUKvv98rpedi3gTklguUbeJy4R7F+Nu35uII63d16rLbq6Uqr4XhoMK3XUW1",
-	"sSoM0+RKPuu0M2dQeXmzAaK/QeMYL7+evJbA1kvt/Wr92n3ZLWlvMhbVrn6CpmQjC+rNSbdxZTb7HKGI",
-	"2/T7YslsKJl53Pl6mGTYkbNx7I0I9UGKXYB+8BHQpKDMBW3UzKKLWZfu1W8u3HTo6g1uL8IlUfVa7H64",
-	"7Et48nnANrOj1dLuAlxRpULCJROlD4fw8XJeJbS/upbiQV5x7/q7cTM41ec1g/Yabc9c+xS7TKeT//CT",
-	"ja4kwLVc/w5MuJ1N7zQEjNUsbrQDdMJV1N6kh96Vx1VPwYvLZCmyTQnTP/xEjr1vadC94wk5Vm5JZK4J",
-	"VzRZ/JVrAeFfM9Ln4Glfu4+OimLz1D0Z4t3J7Yu7Tt9Xasqcz01Wt7f+/No2iqEJIaKrBOnMHFY63jCp",
-	"kw17BQRWBWCt2yCxub96xlCCckmOqK0mOVAFGzAcVm1z7w5E8tnqlXl/WLJ9vJFlf8nZuswsMs9CKFY3",
-	"54l1uBwYcnyGTSoDj2F3LB/vdwmpFrIRxyQBdimgayYLuifflZ7tMZRUkdme/jeUmR2PQt4STVR0x4vW",
-	"JXLQq4Yu10ipevtOhNm7j5k5JCWM/RDmhxnNVbxXWW+wa6vySRCwEin0HF/YSTag2rdbzjiIgWDZZkTG",
-	"MwFs8PcfE5k2rn2/6Oz07NqsVXQKLwTFQ2xrpckOASRVFDVKhrhfc+CusfYshprtWVGzGaSaXW4pdPH3",
-	"BfCgiMLYW4IRlllQ94JVWTZYUHR3P0cN0KY6FBvhCQr73xicvhzRC1jfU6RBDdFeT2Mv3F+nliRiAG8t",
-	"I3gUQsWiFK3rygWOMVVRBmLBRwXbz6Guyt3bZDOQc645lyfJpsSzYcpLEbN9D5rLfLpTJTBMGOmrhdFt",
-	"c9dv8TjGroKqaoDta1GGdkFyEmkE5WpZYlmSylvrq1qC8r/5GkR2lpxdQNgGFH3jWELBvRE19no7crJB",
-	"Tupkf0e7V2HtLD8zq3M4uvm+kRrQGP2U5gI7P/WlOzXTJqowr3vKBoeimIKdqBCuGUjXLhlvhlwoSLTw",
-	"oXWb4NiEChsBey0kqN6+Cxa43mqo7+pyr9h/xhbLoC7wNVwgkbCkBjoZFGXtn3MTsl/a5z7B1dfk2mrT",
-	"rug12VpV1WfvMNVBYkj1M+Juy+2Js9cxbzPOQSbe192OKeQGlaH/tZAiK1NXCCY4GJULYHDBsg2sJGoZ",
-	"Trur7Bj5cqwG/iooQ3AB6wNrf0kXlM+D8moh9Fa0t2sIKpe1dnuvlv+4kTOf2wXM9wLn57Sej0eFEHnS",
-	"43A96RaabZ+BC5ZeGDG7rOPeexptkvvo56siaq4Wa19YtSiAQ/ZgQsgRt5lGPrim2emoNTm/pzfNv8JZ",
-	"s9LWfnaG/ck5j6dsYFEfeUP+5ofZzNUUGOZ3w6nsIFvKmK56itxKehVpO9uNpxsc7tJuBVoTlYUiJqVc",
-	"s1TXoPPdNe5HSD/ogrhZ+wkr+dVRzNL6iFBaqjtDNoWX17XrZ1g/Rv/BFvBCY03QkdFzIwfOZw41fl0h",
-	"JVhKLyU0lr/N/uMWWPOlYIsUZk2aZdoCxDZMrbkvgXFPvaxsZnE8d01rWLZPcKz52zXJKfQZ2jKsAeGY",
-	"cykvaX77ZjWs53iE+HDN5eMLDfXfEMkWlep68X6v6KC5A113f1Pzt2gG/DuYPYo6e91QzvlTdcL0LjIs",
-	"cU9zkou6LzIOSa5wTOsdfvwVmbosukJCyhRrJRhf+a4mlbqHTb5cjOVKb9Evt63zJ6FvQMZOQRAFeVN3",
-	"SNAC74cawvqIfmam0nNyo1Qeo74OWUTwF+NRYTmbLdfFRcNtbDvOtOIhhYQ9u4+DQLAd3cfdQj1Dl2dd",
-	"pObSKRV01zn4tm7gNnJR12sbGvvQRe6mMvpDQhbi3THM5xgzYRGCrWUIgkp+ffwrkTDD3pGCPHyIEzx8",
-	"OHav/vqk+dgc54cPo2LcrUVLWBy5Mdy8UYpxzrROKgysCiZ7iv69c8zdXdjoviP4AcSrc+YQ7QaDU/u4",
-	"0VsuBY0y91YDv12ae3kbPwtQ5pdcTRTD/U99uQs2Pr8nTaZ1FkqWZ9sOZSPpqe58i2k9v7iE3M/Se/cX",
-	"a8vusknX/3CXGLn2AUDERNbamDyYKkhnGpDJ5D6L5C0hcaWlZHqNdcK86ZP9Eo2p+b7yljgvcFVZxskd",
-	"WlxAVWmu9q2Uyks23wuaoyxg9BmMUNRC5BPy7Youixwck/rm3vQ/4elfnmWPnj7+z+lfHj1/lMKz518/",
-	"ekS/fkYff/30MTz5y/Nnj+Dx7Kuvp0+yJ8+eTJ89efbV86/Tp88eT5999fV/3jN3gAHZAjryVSlG/4MN",
-	"qpOjtyfJmQG2xgkt2A+wtr0wDRn7Lps0RS4IS8ry0aH/6f947jZJxbIe3v86cknvo4XWhTo8OLi6upqE",
-	"nxzM0ZiaaFGmiwM/T6cN59Hbkyo9zMZC4Y7azB9DCripjhSO8Nm7b0/PyNHbk0lNMKPD0aPJo8ljrGVc",
-	"AKcFGx2OnuJPeHoWuO8Hvojw4YeP49HBAmiOPnHzxxK0ZKl/pK7ofA5y4tqNmp8unxx4Me7ggzMkfzSj",
-	"zmN+U5voFmQ3dbtwOqcURgvbRLZGVyvlSkyPq15nzs7DM8w/srZZw+IrZJ1kdRr5Sc2ofLkzW//18OdI",
-	"QNOMzUuJxqM6PbsK1XSNEJki/3364xsiJHHq5FuaXoSxW0iQ/ypBrmuCcawsLFzq+1K5TKClmhfNsPma",
-	"pUdUi2g7U5zZ7HNAqZVPp+ZE6HUOm0BXfNXwykfJ1+8/PP/Lx9EAQNDBqADL2vxK8/xXcsWwKyZ6aZqp",
-	"7Woc6cGEqsm49hHgB/U2jTHuv3oattms3mlmm/3KBYdf+7bBARbdB5rn5kXBIbYH77HwClICHqInjx7t",
-	"rT9vlWBpsweqUTxJXGOgLoexj6o+v1eSFvag+YILmK6KdgW/UOxK/GyPC22GR994ue3hOot+QTNsfQhK",
-	"26U8/mKXcsLRx284PrE32sfx6PkXvDcn3PAcmhN8M6hq1r1F/sYvuLji/k0jzZTLJZVrlFWC/qyt5G06",
-	"V2guRhZpz3azJP77j71X2kHYcO7gQ8NNnN3owuv02jw53nIH3lN9nLNbE7jVz85V4bc1OtCR6Jr2YQM1",
-	"9WBCvg+/Ru6NJXZsAZtScheo5GxTLDN82CkkvhJhDds9FcYfRW/kwPZ+dzl/0sv5qGkWahSVjQHTIPGN",
-	"MHXiSG56O3YT8PbRJiFoG3eNgvyftCdqSzO0M72PKW5bufAd7npw1ycDBfBW4lCzi9mn57s+4aW6Jhr3",
-	"wSfkyl+4RPea5oZOguW2igHYSst3kt6fRtKrQgvnVvRyzQVuJvthhs3BB189ew/ynqsePkDSa5SDq78N",
-	"qjvfb7GTBxPXPTJ453o8w8USbpXhsKb5nfT2qaW3bjOAGBh1iffPJ7HdpGZio5HvTiUHv1AR7U+MrF6Z",
-	"zFUd3SKNXYM3diQtx4k/Gc/8Q0pYDml3stWfWraqwvdvJF012nm4hJDAu3Qju1vbrsZ0JWY1UzgCzoYp",
-	"JYahuCM8rluPGRaDNbd8uRU19mofejatRmg3a9xRCrvy0/cQap8v1ifH20SnL8iIM7j2Y+QWiO/Np+al",
-	"UYfBu9txGAzjTc8ePbs9CMJdeCM0+Q5v8U/MIT8pS4uT1a4sbBNHOpjaqtSbuBJvsSVkFHW16YBHYbuZ",
-	"sKK1DZS47/qUh1VCHkyIr32tqh4zLl1/LgyD8jW4qJzbjwyPM0gg9/yfhzj+vQn5TkjCuFZjjLXTrgEJ",
-	"uce4Pnz85Okz94qkVzaUrf3e9Ktnh0fffONeq2vwW/2m87rS8nABeS7cB+5u6I5rHhz+z//+YzKZ3NvK",
-	"TsXqxfqNLSv4e+GpXbUu3Pi+3frCNymmpbtyj1tRdysO9xdiFeX+YnV3+3y228dg/w9x60ybZOQU0Mo8",
-	"2UgD3uMtZI/JLvfQ2FcON3ynukwm5I1wFRnKnEoiZAbSNeWal1RSrgGyiadUMsPUa8xAT3MGXBuFEdsM",
-	"yUSxDGwi67yUkJGcLbEPt4RLTBHA6VGXb0CwndFjUO/vlsm/pqsgS3taXdNauCVjzvuSrnyjM2zlIyT+",
-	"9M035NG41lry3AyQVIiJMdclXY1u0dpXEdug8Ptmx4etMbI49hDLUS392J6StFle/s/Nub9Yid2Su9vY",
-	"PXHOnb05tbcmtB+4ugcbLQdWsLNt0LAv15pUeclGyvMiVJzFmRmGGgV+x76BrSbpqPLZRu/dIb5T/m/E",
-	"StoEtSPbwKRbdfABfRkhz+icW0wa/AP5QAOHkBRL7xESZAY6Xbhk5BZeI7zHN5PoZzybmtzuW2TBLerW",
-	"Mg9rHWLz1YFFCoI8UfTKgYxQ6I++rrN5zGZYaqJqFOJ7OaO/ifn2hlVnQ9f/lSkfXu9zls0u7gTly3ry",
-	"rrSFaNmHU/MOwbshuMP5vvXNyhBjbhF/hAB8rycm5I2oU+Jdn4w/oj/xU17bn3pBbwQH6zg3Yq2lxTsf",
-	"aSVToH0ekeJroVjlpKpYfm354sC33dsoZPzVNr3bKGgMub3NZF/kFf7XaKv1xi1j1jbZmhhdjzaEOZsX",
-	"bb3lZqXlz6iifBZ++jvUWz4Hx7odFoOH1PMZJxbw/TIdLC9kifmgKmbax4HidcsHcyMtqtiyaKnxKeSC",
-	"z9XvkxVtoo44XiJUUlV0j5dt//Od3ZdYuYgLXyTU1bJSjKdg20piRxymyJIp5SIgnz36y+1BqNnS1//j",
-	"YSrpZ+Yuzx89vb3pT0FeshTIGSwLIalk+Zr8jVctQG/C7bD4d1Vbzpt6o30I0JXUrHmWhgWars8EG/Fo",
-	"H/SKZR+3M8OgPuGOfJDxgA+GNSdpUQCV12eA2/1SZ60ZT47DkN9GTeqqWlgEFIOiHaPe/2M00O6EWehi",
-	"5i6/kltAfWUzxyZcPK6YjavIFyMFiNkhOecPiVrQ54+f/PLk+Vf+zyfPv+qxnJl5XEGiru2sHsg8tsMM",
-	"MaD9fm19+xXJK+Qd3vZW7rZD4xHLVtECtHXzk/BcuMAc5BP3FCnourdudbGleUs4bN3I5farNCrNpouo",
-	"8uR1m6qX8Ql/Uam4tpSg63ly17SlJ90hYCKG0OruLRXWNzdy2SAqtsiy6kxw25pnnRZgbzGPPNm6UD6r",
-	"FKs/lwaaoAIK3EstTbR8PoERiySPA0d11R0eo07KohBSV6dbTQbJctDncGuIcn2Eu5OkllKdLsri4AP+",
-	"B8tjfaxTBWw/1sBD5363HekOrP99kxB3at+44Z3Ykpat1182mZOv1OZiAsSMvGapFEdYe9tdN2qtNCy7",
-	"fYTsp79s6skfvZoEzxmHZCl4rMjbj/j0NT7sbbPW9zG2Vev7tt02qAF/C6zmPEM4403x+zvRs29kH2qt",
-	"VoI5xnXDJEv/Ox41f2jWPO2epDVPu8es0bWp5+eDD40/XfSNe1MtSp2Jq+Bb1O4sLxrieA8Kfw83ilcK",
-	"T6uAtiIZKEO0X54FKsBD7MRUTyPVv4Ly7r0FwP6kNqkZ41mLSFCiTMUlSFVZK6QPlLkzTP1xDFOD930n",
-	"HmtLWW7jaKXar0TyRmRgx21Wj40lenKRgau42RVEKhksru/7W6l+r6WBpbScLzQpC6JFTNerP0xoapms",
-	"7eumtjXCsm/5hi+XQGgugWZrMgXgREzNopsNBQlVGORetU20kma8n1MNVyFFCkpBlvjE1m2gVXVMUb3U",
-	"G/CEgCPA1SxECTKj8sbAXlxuhbOqu67I/R9+Ug8+A7xWFNyMWBtaG0FvFeHjpL0u1MOm30Rw7clDsqMS",
-	"iBcN0L4llkUOzsIVQeFOOOndvzZEnV28OVrQBMQ+McX7SW5GQBWon5jebwptWWDD7UjHOfv0jC1REuOU",
-	"CwWp4Jnq7wu5jS1j75NgLcqsIOCEMU6MA/conK+o0u+cJyNsnxX0WDFTbGhk2Vdj3oz8U1VhvjN2au5D",
-	"rkpVlaF3BgzIYmvgsNow1xtYVXOhK8mPXVlItCClgm0j92EpGN8hS4WdKXXgA8IOKN3FYTUS6gwUXVQ2",
-	"gKgRsQmQU/9WgN3QP9EDCFM1oqt2c03KCfoUKy2KwnALnZS8+q4PTaf27SP9t/rdLnG5pg54b2cCVGi9",
-	"cpBfWcwqTLdYUEUcHGRJL5yBa+6qNXVhNocxQa9zsonyzbE8NW+FR2DLIW0bQ8Lj3zhnrcPRot8o0fUS",
-	"wZZd6FtwzPzyRWYztb1enzBep2l+CsTnyXVUg4MrynQyE9L1M6YzDTJiCWlVYadM+2Qpa3/WwnmTCY7g",
-	"uI4bx/XFrSsOuJaGFgTiWzGyZaSAiZnqOyEHZTw0Q38o06TkmuVB1melaPz+zC13KtSdCnWnQt2pUHcq",
-	"1J0KdadC3alQdyrUnQp1ExXqcyWJJJ5f++g6LnjCYU41u4Qqe+SuaMUfKqi6OulepUMl0KhgrgQcoZ6L",
-	"4pOb5ZRooDnigOW2aadQvbU1sIeqEqVMgaQGQsZJkVMjWcFKVwWJmqXufPFN10UVq+dRBU+fkNO/Hvnw",
-	"0IULY2y+e983z1R6ncMDlxVctdrz6cHADdJddjD1CrEvXOTKOLEciDLo/RbfPoZLyI1yZyPPiFFPuwrz",
-	"GdD8pcPNFn250UzNjPbruKGmO7QtaRF0i8a1UkUohhK3eqHNaK76m6HZ8Za0iNUOqli71aSRm7wQ2bp1",
-	"QsyuHeAGNs9GHSTKOJXrSPR350R0SEMLw68cYXVNAR/3HsrcJdoumW2jsJiwI0FFz/EmKo/G8FYb1hnK",
-	"xpHPWnQS7QTaDlwdVQAOCb8y9Oz3hLyz333eLEiEyB2xmpn/bqJWmm9WTAPfNTKYYz1fasqiR3z09OLZ",
-	"HxvCzsoUCNOK+Gjo7dfLeLRKzEhz4IljQMlUZOukwb5GjVsoY4oqBcvp9pso5J+uWqa7fMyTzffU57lG",
-	"joPFbeLJIdGsEseAe7izDeEfxpsrbOGIjj0HGP/ULLqPjYYgEMefYjp5u0fBjkyvnmZ9x/juGF9wGlsS",
-	"AeMue6TNRCafkPHJtSx5P8/7dgVpaYALT/J9NG6iRwNWuuEWymBazudY9bPj4jBLAxyPCf6ZWKFd7lAu",
-	"uBsF2cGrSnA3rU/SHq7LXYJMiftCkrkUZfHAtjfha7QFLwvK195jBoliyzK3OLQ1lfbLaG2CR6y9vbfs",
-	"9RsF33rbX2D6cldt83eLFnJFlWtzDhkpeebi1jtpYCs+vOKoHfpsxWs2vbHmqF1vZHVu3iFXhN9lF2Bd",
-	"eQkLkIlecXugmmWBbbqZPbmTu2qHf45r461tI9TDYLupUzVD2NPtIQO+htdHkP1eJ2I0e7TYDlJ9Ycth",
-	"Krx9c6++987wTRd80L/JupggLwj1pahTwZWWZarPOUUTd7CwSdc97w33/fztpX8l7mWJOEHcUOecYqXi",
-	"yvAd5XMziLi0vgPwbFSV8zkowytDIpkBnHP3FuOk5EbTEjOyZKkUiU2CMmfIyCcT++aSrsmM5uij+Q2k",
-	"IFNzswe7bg3GSrM8d/EAZhoiZuecapIDVZq8ZobLmuF8mZoqEAb0lZAXFRbiydNz4KCYSuLGl+/tU8xP",
-	"dsv3Rj40WNrHdV7h7SYme9hZ1gv5ybGBm2KdhZwpXbuQO7DfmvtwyXgSJbKzBRAXUdOmLXLfMF5PQA9q",
-	"H73b9XNubjgtCHJ1qq9HDm03T+cs2tPRoprGRrS8QX6tg1S8vXAZEmEyd66VP1BaUEAHhsarjcceBu29",
-	"39GNsrEtWuypK1bT85JTEsA/tqcI73izLEhLyfQa/RC0YL9cgPn/+4/vzTN56V0UpcxHh6OF1sXhwQH2",
-	"O1sIpQ9GH8fhM9V6+L5a+QfvbSgku8QKqe8//v8AAAD//59AZYRkPwEA",
+	"H4sIAAAAAAAC/+y9a3Mbt5IA+ldQ3K3yY0nK7z1RVWqvYuWhje24LJ+c3Y18E3AGJHE0BOYAGImMr/77",
+	"rW4AM5gZzHAo0XKc6JMtDh6NRqPR6OfHUSJXuRRMGD06/DjKqaIrZpjCv2iSyEKYCU/hr5TpRPHccClG",
+	"h/4b0UZxsRiNRxx+zalZjsYjQVdsdBj2H48U+1fBFUtHh0YVbDzSyZKtKAxsNjm0LkdaTxZy4oY4skOc",
+	"HI+uej7QNFVM6zaUP4lsQ7hIsiJlxCgqNE3gkyaX3CyJWXJNXGfCBZGCETknZllrTOacZame+kX+q2Bq",
+	"E6zSTd69pKsKxImSGWvD+VKuZlwwDxUrgSo3hBhJUjbHRktqCMwAsPqGRhLNqEqWZC7VFlAtECG8TBSr",
+	"0eEvI81EyhTuVsL4Bf53rhj7nU0MVQtmRh/GscXNDVMTw1eRpZ047Cumi8xogm1xjQt+wQSBXlPyutCG",
+	"zBihgrz77iV5+vTpV7CQFTWGpY7IOldVzR6uyXYfHY5Sapj/3KY1mi2koiKdlO3fffcS5z91CxzaimrN",
+	"4oflCL6Qk+OuBfiOERLiwrAF7kON+qFH5FBUP8/YXCo2cE9s471uSjj/Z92VhJpkmUsuTGRfCH4l9nOU",
+	"hwXd+3hYCUCtfQ6YUjDoL48mX334+Hj8+NHVv/1yNPk/9+fzp1cDl/+yHHcLBqINk0IpJpLNZKEYxdOy",
+	"pKKNj3eOHvRSFllKlvQCN5+ukNW7vgT6WtZ5QbMC6IQnSh5lC6kJdWSUsjktMkP8xKQQGdMaR3PUTrgm",
+	"uZIXPGXpmHBBLpc8WZKEajsEtiOXPMuABgvN0i5ai6+u5zBdhSgBuK6FD1zQHxcZ1bq2YIKtkRtMkkxq",
+	"NjFyy/XkbxwqUhJeKNVdpXe7rMj7JSM4OXywly3iTgBNZ9mGGNzXlFBNKPFX05jwOdnIglzi5mT8HPu7",
+	"1QDWVgSQhptTu0fh8Hahr4WMCPJmUmaMCkSeP3dtlIk5XxSKaXK5ZGbp7jzFdC6FZkTO/skSA9v+36c/",
+	"vSFSkddMa7pgb2lyTphIZNq9x27S2A3+Ty1hw1d6kdPkPH5dZ3zFIyC/pmu+KlZEFKsZU7Bf/n4wkihm",
+	"CiW6ALIjbqGzFV23J32vCpHg5lbT1gQ1ICWu84xupuRkTlZ0/fWjsQNHE5plJGci5WJBzFp0Cmkw93bw",
+	"JkoWIh0gwxjYsODW1DlL+JyzlJSj9EDiptkGDxe7wVNJVgE4XGwBh4th4Ai2jtAMHF34QnK6YAHJTMnf",
+	"HefCr0aeM1EyODLb4KdcsQsuC1126oARp+4Xr4U0bJIrNucRGjt16NCEEtvGsdeVE3ASKQzlgqWECwu0",
+	"NMxyok6Yggn7HzPtK3pGNXvxbHS17evA3Z/L5q737vig3cZGE3skI/cifHUHNi421foPePyFc2u+mNif",
+	"WxvJF+/hKpnzDK+Zf8L+eTQUGplADRH+4tF8IagpFDs8Ew/hLzIhp4aKlKoUflnZn14XmeGnfAE/Zfan",
+	"V3LBk1O+6EBmCWv0NYXdVvYfGC/Ojs06+mh4JeV5kYcLSmqv0tmGnBx3bbIdc1fCPCqfsuGr4v3avzR2",
+	"7WHW5UZ2ANmJu5xCw3O2UQygpckc/1nPkZ7oXP0O/+R5Br1NPo+hFujY3beoG3A6g6M8z3hCAYnv3Gf4",
+	"CkyA2VcCrVoc4IV6+DEAMVcyZ8pwOyjN80kmE5pNtKEGR/p3xeajw9G/HVTKlQPbXR8Ek7+CXqfYCeRR",
+	"K+NMaJ7vMMZbkGt0D7MABo2fkE1YtocSERd2E4GUOLDgjF1QYaajcexMVgf4FzdThW8rylh8N95XnQgn",
+	"tuGMaSve2ob3NAlQTxCtBNGK0uYik7Pyh/tHeV5hEL8f5bnFB4qGjKPUxdZcG/0Al0+rkxTOc3I8Jd+H",
+	"Y6OcLUF3NGNO1IC7Ye5uLXeLlYojt4ZqxHua4HaCJuZqXKJBa2b2QXH4ZljKDKSerbQCjX9wbUMyg98H",
+	"df4ySCzEbTdxQSviMGcfMPhL8HK536CcNuE4Xc6UHDX7Xo9sYJQ4wVyLVnr3047bg8cShZeK5hZA98Xe",
+	"pVzgC8w2srDekJsOZHRRmKvPIa0hVNc+a1vPQxQS+NCE4ZtMJuc/UL3cw5mf+bHaxw+nIUtGU6bIkurl",
+	"dBSTMsLjVY025IhBQ3y9k1kw1bRc4r6Wt2VpKTV0OmrCGxdLLOqxHzI9piJvl5/wPzQj8BnONjX+XQ46",
+	"CY5HVAYWhBSe8vaBYGeCBrDxRpKVfb0TeHXvBOXLavL4Pg3ao2+twsDtkFsE7pBc7/0YfCPXMRi+kevW",
+	"EZBrpvdBH3Jt/8MNW+kB8B07yCTuv0MfVYpu2kjGsYcgGRYIoqvG0yDCGx9mqTSvRzOprsd9GmxFkEqf",
+	"TCiMGjDfcQNJ2LTIJ44UIzop26AxUGXC62cazeFjGKth4dTQT4AFbWgA/A2wUB9o31iQq5xnbA+kv4wy",
+	"fVASPH1CTn84ev74ya9Pnr8AksyVXCi6IrONYZrcd28zos0mYw/aKxuP7NM5PvqLZ14LWR83No6WhUrY",
+	"iubtoax204pAthmBdm2s1dGMqy4BHHI43zPg5BbtxCruAbRjdvFapgw1FnugxUrWdWvKWLpgXvdGScou",
+	"WAbbR1YyZQT+hwO36bRHmM6oYdrE5rmR6AzY4JpqzVazvZBmF/mk1SwpcfuSsq1Ha9fNrqbZhBuuNqrY",
+	"x8OeKSVVRNuIG2lkIrPJBVOay4jh6K1rQVwLL+znzd8ttOSSakcrLCWFSGs7XU0MGu7Bt6Ad+v1aVLjp",
+	"vQfteiOrc/MO2Zc68r1eVZMcjHJrQVI2Kxa1d+FcyRWcG+yIEsv3zKBg9J6v2Kmhq/yn+fy6wnz7bFkB",
+	"yfAV0zA2kTi4FW8bh1fINHK/2A7xwSsThmaJFClY1s0lczJjOSnKDwksJikMv3BA6QGH203ecbq/Z+Z0",
+	"I5Lr87rBHGrFBZqK9EYkwdt/T4xq3DbD1ujJ63ntVPd0BBwgpB8YzczyHcuvK4z1na5w8OijKTK55wKl",
+	"zGEkuff9t+/JgWI03dxDjYT9YYndD1JmKM/0PVjOK1ztqaC5Xsq9yFX+8tJuzB6paqvqB+93Pw6wM0PB",
+	"5EOj2p7xyDed8I5ReXnz+aYDSCocddx/EzpsGmrYMcsM3TuBNCeIEclLzx/dRqTQEFVtr/hiaYJX7Fsl",
+	"5Xz/MMZmiQGKHyyTzKBPWxPwRqbArU2h90CZ1WDVFQKkEF4cdCYLQyhyaVTbFjr+Fujw/UGnA/SVMOHz",
+	"wizts37GgMsktIDVghlGxi7kquOEJpYOJ5aZb7sgbCs7nfUryZAJkBljgsiZs0c6SykukqIbg/Hnwr1E",
+	"oscrgCtXMmFag8rXKvK2gubb2bvZ9OAJAUeAy1mIlmRO1Y2BPb/YCuc520zQ6UaT+z/+rB98BniNNDTb",
+	"glhsE0NvqVXiogPqYdP3EVxz8pDsqIKryFItMRIfTxkzrAuFO+Gkc/+aELV28eZouWAKzb+flOL9JDcj",
+	"oBLUT0zvN4W2yDtcSZ02BYR12DBBhXSyb3QwRlXGmTYTekF5RmcZm/SIFr51xLzkeOKS2psB6dp6kjkS",
+	"dyuzQyg7giaXTMErrBDgZieV/VtIQ+bMJEuWlg/5gXSfUW0m264ZaBQOqGFHAs4eu1lw4A7UvKLaWBcM",
+	"LlLUHFsk4DwWVTBFN8Cd71YY+Wf/ZG2PjW8WoQtdvl91kYOEy9LYGsBvp3uuN2xdziXnwdjlI9lIUmi2",
+	"beQuLAXjO2Q56Rv/oCYkJfBRai8O7Xkgt2yiqKwBUSGiD5BT3yrAbuge2AEI1xWiLeFw3aCc0idxPNJG",
+	"5jlwPzMpRNmvC02ntvWR+XvVtk1c1FRnLpVM45lx7R3kl/6MwSsGzqWDg6zoOchSqEW0viJtmIG5TDQX",
+	"CZv0UT7qBKBVeAS2MJ0OBa5zPQ9maxyOBv1Gia6TCLbsQteCOx4rb6kyPOE5Sr4/ss3eHwLNCaI2TmJf",
+	"oywlwQf7KMjD/sQ6/zTHvN7DYJCqqw1+S9cVWU7GNV6AdeDP2QZfYG+tV+n7wBd1Dy+byKhwuqkgCKj3",
+	"VQOBLGzC1jQx2YbY225jry1dzFbcGOsmXH/4GJlPwgGiRpWeGZ0F0Xpk+h0YYtI8xaGC5bW3YjyyEmI/",
+	"fO8bYmINHU4yzKXMBmgFWsiIQjDI2YTkEnadO69077rsKakGpBPKso0HF5jnPV1DM66A/K8sSEIFCuCF",
+	"YeWNIBWyWbx+YQaugzmdW0mFIZaxFbPvCvzy8GFz4Q8fuj3nmszZpQ/lePiwjY6HD/FV/1ZqUztce1Ar",
+	"wnE7ifB2tDbBReEktyZP2e7W4EYespNvG4P7SfFMae0IF5Z/YwbQOJnrIWsPaWSYS4dZD1x5sJ7ounHf",
+	"37FM0tTGMOxJ8S4Lk8iVDQMhiYuOsOhXOFubi+EUrONtUBri8f2ypGIBrxtmDBcL7WQ7ZJNulBgrCywr",
+	"DRalGJqHJxWKrwsDOpCAMpSw+ZwlhkiRsEqYchPpXcBrbL1HUwTqDnnilK+KbF/nOZEaFTdzHotsPTv7",
+	"JcnPzj6Qn3IwAhJoTVzr8qRb+7ImDJ63yN28NSE8CAsli3zs2dvid47SZflamBXzOVPau+aANg1k5Rwm",
+	"mxKMBC598tCPB7DENMxWht16wEqnPtiWyjN/Y1gtqu//vf9fhxDNRye/P5p89R8HHz4+u3rwsPXjk6uv",
+	"v/7/6j89vfr6wX/9e+xpM6c8KxTr9l84O/tlvgKMfmdbetejsb8SQpxdVoFrcye7FQqdH0mGliclaZpQ",
+	"baL2RmQJYjEp3ed1FJyVBnD+4W4tKjaNUOuhMJAZS2ihWSDjOAgqB349jbwfGgeiicLoQgYaniBuD1lW",
+	"ixKJLk8R8kxU9X8aPX01dAzK9sSB92b1scuBE96k2WYPXN4ORBTLFdMAf003pe1XOQ8jJN1Z1htt2Kqt",
+	"vrddf+14DL7zT6nWy1yKjAs2WUnBNtGkAFyw1/gx1ttKQx2dUS7t6tt8atbgb4BVn2cINd4Uv7jbwfX/",
+	"tvRc3scV3xi3YbkJY0NRM8mynFCSZJwJq/EwqkjMmaCoSQgOW8TDy+tHunVLL32TuDIromtyQ50Jihd6",
+	"qV+I8sU5i/Dl71hp4NXFYmFvlloaCcbOhGvFBSkENzjXCvZrYjcsZwrdrKa25YpuyBxiHI0kvzMlyaww",
+	"deaKIWzagKbKmpFgGiLnZ4IakjGqDXnNwQsEhvO3qqcZwcylVOclFuK22gUTTHM9iXuifW+/opOwW/7S",
+	"OQzD/11np6C99dvUw87TTshPjt0L/OQYn1mV5aEF+61paSEqM0pkofNFg7bIfSFNSUAPKtOO2/UzAR44",
+	"RkKgOk+puR45NFlc6yza09GgmtpGNJRufq07Pl5uwGVIhMk0WOO1r/G282Y8lBE20kcnQisyL4TdSgju",
+	"R3McRup4sVTOx2W4qk1Tc0gwlnFJvQeo+/PJ8xejcRWDWH4fjUfu64cIJfN0HYs0Tdk69iZ1BwQPxj1N",
+	"crrRrMPTA2GPeshZj4Jw2BUDZYZe8vz2OYU2fBbncD7+wem21uJE2MAEOD9oWNs4/bac3z7cRjGWstws",
+	"Y+krapICtqp2k7GGswNEKDExJnzKpk3dUrpg2vvqZYzOgUCtMWWQY055DiyheaoIsB4uZJACJ0Y/KNw6",
+	"bn01HrnLX+9dHncDx+Bqztnt8+UYJjh3XfkI2DBMNaKztR/qbjCGUJe0x0Z9n4kzcczmXHD4fngmUmro",
+	"wYxqnuiDQjP1Dc2oSNh0IcmhD+46poaeibYCpiuvVhBWR/JilvEE9OYx8rS5UqLPRtAew8Ox6RHQll/d",
+	"VFH+YieYwBNeFmbikkFMFLukKo2ArstkADgy9u6ddUzc2PijG5+48eM8j+a5bgYFt5ef5xksPyBD7UJe",
+	"YcuINlJ5WYRrDw3u7xvpLgZFL30mkUIzTX5b0fwXLswHMjkrHj16ykgtSvY3d+UDTW5yVtM5XStouak2",
+	"w4Xbdw1bG0UnOV10KA0Mo6gXsvLyCh/ZWUawW4iTMvoAh6oW4PHRvQEWjp0jDXFxp7aXz+oVXwJ+wi3E",
+	"NiBuVObZ6+5XEK977e1qxPy2dqkwywmc7eiqNJC435ky2c+CcqG9zRzUKKiVsXmRZqD8ZMk5SzFFC1vl",
+	"ZjOudZfzmqDpWQfXNpWRjbbDfBtoCIEUR3lKnSjeUCgBhp2OFQd9x87Z5r2s0nXskumgHnivuw4qUmog",
+	"XQKxhsfWjdHcfOfLBJDSPPfx6xjI6MnisKQL36f7IFuRdw+HOEYUtcDwLkRQFUEEduhCwTUWCuPdiPRj",
+	"y4NXxszefJHMR573E9ekejw5N51wNe+X5fcVw7xo8lKTGQW5XbqUXja4POBiBWgiOyTk0BY1MIS7Zr/C",
+	"Qbbde9GbDqzf9Qutdd9EQbaNJ7DmKKUw+AKkgo+ZhrOZn8maO60C1ernHcJmGYpJpVeeZTpU1WyCYtEH",
+	"WpyAmRKVwOHBqGMklGyWVPtsY+k4OMuDZIBPmCyhL0XOSeBXFGReKxXfnuc2z2nrdekS5fjsOD4lTvi0",
+	"HJDeZjxyrtmx7ZACBaCUZWxhF24be0KpEjdUGwRw/DSfZ1wwMom5KFGtZcKRFQXXjJuDgXz8kBCrAiaD",
+	"R4iRcQA2mvFxYPJGhmdTLHYBUrjEE9SPjQ4Awd8sHhVmnZBB5JE5sHAuOtzdPQegzq+tvL8a3qI4DOFi",
+	"TIDNXdCMCeNffNUgrUwtKLY28rI4R5IHXeJsjwbeXiw7rQl7XGs1oczkgY4LdD0Qz+R6YoNkoxLvbD0D",
+	"eo/6ZUOv6MG0OXHuaTKTa3ROwqvF+gFvgaUbDg9GBQAmO4G1Y7+u29wC0zdtvzQVo0JN7peyTUUuXeLE",
+	"kKk7JJgucrkfpLm5FgBNe3yZE8s9frc+UuviSfsyr261cZW+zYe8xI5/1xGK7lIH/tpamDIxjVMhvGOJ",
+	"VGm3ngIIlZsyw3ZbvWDbTYBvDE5d05Pt+6j+2vBPiPbOdfjQ1OCp5ulBxLEN2GpB8u06l5ppF9CFV70b",
+	"3MmJitlgeG11VmCczpxg0IWm2IK9B5/HuF1ylRLQDzhMdo5tbscjvw+WPI/DsctL5Z3DTw8UHae8ggMa",
+	"3BQSl0aoF5arbvp42xTtowel1qqRvCp4a8VuByCftjWzbTPVLGP4ep7UXhuTc7aJKwEYimanvlug5cMU",
+	"WVRsHgQejootuDassjZxXWH6tvX4FDNzSjnvXp3J1RzW907KUp7DjlaLX1vmra/gQho2mXMFvuhgqosu",
+	"ARp9p1H79B00jT8qaptNbJJqnsYvUZwWYoxSnhVxenXz/ngM076pgt2LGQomXBBGkyWZYVL1qGd1z9TW",
+	"+b53wa/sgl/Rva132GmApjCxAnKpz/GFnItmoHQPO4gQYIw42rvWidKeCzSIj25zx+CBYQ8nXqfTPjNF",
+	"6zClfuyt/lU+SrtLmLMj9awFXYM6XdkjDjnWj8wy9aqeSjSSWUgzqSk/IugqFTwa3U25IKK+wWLhp4kH",
+	"50n7rh40tGu7ZUAxfDyxfTgnBE8yyNOxPWSAIsa9Agc9I+wI6HpDMPjG+3hsl+rbO1AhrFxpE8YotbSk",
+	"mz7DbfU0chlOq7c1EizgzkqZw613IKF5eqvou226y/MJKB6iQW3/CKLWaJ5jygffOBbgBYNxcCeIg2M/",
+	"jWNVT9rK+4IL8+KZH3UfyXcb4wxfdpiidggKUJzT10jw2/3GDHYpRHP3ojqI0s/Yz4hx8PJlV0mnLerr",
+	"uMZpnvN03bB72lE7teN7wRheUG6wLRgIaCMWLqmYru17oMyzBTJqmQGngzDzvp5AOJRpwqm49uWd2ogq",
+	"w8O34QqSZ/3INj9DW1zO6Go8upmZNIZrN+IWXL8ttzeKZ3TDs2azmtfDjiinOTi30GzijMldpKnkhSNN",
+	"bO5tz7csrcW53vtvj169deCDvS5jVE3K107nqrBd/sWsymZB7jggvnzMkppSP2dfw8Hml6lbQwP05ZK5",
+	"Uh3Bg7qVU7xyLqjG8wbpedwbeKt52flB2CX2+EOwvHSHqEx12LnhAVFmTrA6bN6jkrWLG3Y3RrlCOMCN",
+	"PSnCu2iv7KZ1uuOno6KuLTwpnKunmMjK1svRRIqmuxy8gmEGS6rgxT1jzgLSZk6iWKHVYKIznsTtqWKG",
+	"ITbC+slAY4KNO97TMGLBO9yuRMGDsaDZkMxzDSCDOaLI1NEceRXuZtJFXBWC/6tghKdMGPik8FQ2Dirq",
+	"T51lvX2dxqVKNzD2CYa/iYwRZsNv3nhO5uoTMEKvnBa4x6XWzy+0tD5R4aX1XZ37whlbV2KPY56jD0fN",
+	"NlBhWfeuGSyhby2K6PVvLi1/xxzRIodcT+ZK/s7iqirU8EViqd1EKExh7wEhZZUlp6rVWM3eud1d0k3w",
+	"kdQdEjuoHnc+cMHBAEZvjabCbrWN1635tccJJmihD+z4FcE4mFtRNxm9nNHkPC5kAEyB+aVmNzeS+M4e",
+	"985Gw11JhikJ/MbKttxmGcmZqtIctDOwXVNgsNMOFhUqyQA61mSCsfX1ybSMDFOISyoM84Um7FFyvTWz",
+	"+nvodSkV5gjScRN/yhK+iiqXzs5+SZO2OTflC24LtxWaBZXB3EC24qWlIlddzbrTVag5mZNH46D2oNuN",
+	"lF9wzWcZwxaPbQuwaeHa/Fkuu8DymDBLjc2fDGi+LESqWGqW2iJWS1IKdfi8KR1VfG7VR9ju8VfkPrro",
+	"aH7BHgAW3f08Onz8FRpY7R+PYheAq9DYx03SeRjkGqdj9FGyYwDjdqNOo9oAW1a3m3H1nCbbdchZwpaO",
+	"120/Sysq6ILFvUJXW2CyfXE30RbQwItIbU1IbZTcEN4RbswMBf7UEWkG7M+CQRK5WnGzco4cWq6Anqqy",
+	"X3ZSP5xNC+bCwj1c/iP6Q+XeHaTxiLxdu4+932KrRq+1N3TF6mgdE2oTQ0H4v/dU9HVkyInPo4cZCMrK",
+	"FRY3MBcsHcUc2EJMH8+FwYdFYeaTv5FkSRVNgP1Nu8CdzF48i5TtqKePF7sBfut4V0wzdRFHveogey9D",
+	"uL4QeycmKw6s/kEV2Rmcyk7Hrei0pstPqH/ooUIZjDLpJLeiRm404NQ3IjzRM+ANSbFcz070uPPKbp0y",
+	"CxUnD1rADv393SsnZaykiiXHrY67kzgUM4qzC5Z2bhKMecO9UNmgXbgJ9J/XeOpFzkAs82e58yGwi8Un",
+	"eBugzSf0TLyOtadu6anJXLENxA8DLSC2KvU2u8dN6tXVOu8ClesyELoOJUItALaBsd1ewDdXMQQmn9oO",
+	"deGovrQYZX4jI0v2RY5KG4+LmIzorbouEPgADGrmhhqTekGZ2/eo8WaRtmcHfPGw4h9NYD8zs0Ek+xV0",
+	"bGJQ7Cq6nWn5PXAuo+QbuR66qQ3e7Tf2D4CaKEoKnqU/V7lB6iucKSqSZdRZZAYdf62qHpeLs4c5mh15",
+	"SYWw3git4ewr5Vf/mom8t/4ph86z4mJg22Z5M7vcxuIqwOtgeqD8hIBebjKYIMRqPe1CGdaXLWRKcJ4q",
+	"dW11r7fL4gXlejAVV+xexA82tMBg7WegYuxEmEhRjzEl32MANMBSy6yJ+gObpYmlZbULNPUUeSZpOiYw",
+	"DtigiJ3V9rF5wmy1moW9dmur6PbP3cXRts+3dh8RfbaM1KQsOxNLUQIt3vsGhDesS/iwDrEzJcdWp6H9",
+	"i9lOYhP6qRVLg9I6VqpGmoD/GEMxw7aRNZbaTfLDyyx5qtRBoXf3/6SkRHvuAG5XackWWhoTCZLDJYe0",
+	"WUtq2AWrZ0XxYHgxwGdJqS9PFUJYSolKxX0prK6Ddg8cjlsaoKKQNRC/o/Ti3NR3rDp1ir1iRNkqYdWq",
+	"8G5zbJSFOF/7Gv1USMETzLwau5oxg8Mw6+yAJLXxyADnb6NHkcMVLZxVBms4LHaW0hqPaohrm4eCr7Cp",
+	"ljrsn4atXb7/BTPacTaIWHTV8JyGmgvNXOpxIKKQT0pVs3gjh4w6UVRy8o5khMHZHSqH7+DbG6eQgiNI",
+	"zrktfOfQZgmaWx0y1uU38F7lhiwk02499Qw1+hfoM8VkLSlbf5j6Ov44hjUYw7Ktd0R7qCPvK+F8E6Dt",
+	"S2hrE+pVP9fi4OykR3nuJu2ulRjP8rkWnQiO2LxLR68AueX44Wg95Nbr5IT3KRAa5OUk2rCcuNCYjkp5",
+	"jSAYm80TKApbEOsfHUNK3E30FRfephG/IJLolYAbg+e1o59OFDXJssaGtrlGoF9EjKFp44xiNx2qscHO",
+	"nzRPRn6O7m2sivx1MI6yQSW4UbEh/lAAdQfCxEsIjvNOJ+2SfShVOSHKBdfUi/jFGAcwbp+Qs34BbM3F",
+	"W3Y3iias1nfATdSVqmRWpAtmIA1GTJ/wDX4l+NWnK2VrrNfnct7nOQGgmqkK29TmJkqk0MWqZy7f4IbT",
+	"BVUxI9QQVub0OwyUBqpO+He3LMnOPWhnH3vvC5SW4XO7yM31kVpSL9A0JHqdDMcE3ik3R0c19fUIveq/",
+	"V0rP5KIOyC0nKOvjcuEexfjbt3BxhPm7WlUM7NVSptdCd1DpK7vjs7FMDFPnSj7qtDVnkHm5XwHRXQN6",
+	"jJdfR1xLoOul9n61du2u6JakMxiLGpc/wVDSy4I6Y9KtXxl+t1DEdfpdvmTWlQw+t3oPkwxbcjaO3YtQ",
+	"76TYBuhH7wFNcsqd00bFLNqYdeFe3erCvkNXbXBzES6IqlNjZ0uDvoSMSV23diNhvk3JYZ2XsLPNt1Tq",
+	"8uOVajsziB+RZbGiAlOk45uTrfOMCuovGx8NWXQbfqN4q1J9+BQhOUWF9SVVmBiU8iySIySu8XSDdSPQ",
+	"1V7tw52+BtZsm8G8t72Z0WoCNN10Gy1EsL8ORJsoPe45cq3K4c1FR6TijkQtOC6j2jgsbhxtELqSYhEA",
+	"PR2Nb7TxDvMeXa1UBzFS+PGiK3jQx9Tj92bl4HPmEpTlil1wWXjXIu976tUr9ld0xKvF6HfykjbqcKrP",
+	"a1LoNIC8d4W77DIdhfz4s/VUJkwYtfkDmENam94qrRvL/10rrOseKlHdrRkqdx6X1XnPLyYrmfYlH/jx",
+	"Z3Ls7bSD+Ign5FjqMpm6cpbRxAuvXPEh3wxecoOnfe06HeV5/9Qd2Rbak9uGu07flbYNzmefBvutP7+N",
+	"uu6dHM6nBhBsbeKl+lqR5Zd4QTLMGx0kCejORDOUoFzAMGp+JsBiWQ+GwwyIru1AJL9fv4L2wxJXxEtC",
+	"d6dvrlI2I/PMpeZVWbhYreiB7vvvsdxzYH1vj+V9Zy9YYqSq+QQqxnZJRg2TedvmXRrnbqVjGeXg6b8n",
+	"ZfN4FPKWaNCvO160SjeFFmp0X4gIZrZNhNm7zhwOCRjw3RDww5xmOl4ls9NxvJFFKHD+iiRNjy/sJN2O",
+	"S7+cceBPxNN+RMajao6sF86fEpk2RmS/6GxVi+x/obeSmASJeLoeEVtTVDkhFPdrwQTaI1Myj6Fme4Qh",
+	"1hXjF1uSxvzD1rzyCUnG3qqCsMyDHDK8jFjD5Ly72wwrgDJ6TXgyuj9wuuKtz9nmniY1aohWGRx74f46",
+	"eVkRA3hrgeCRS02zLjOwc8LkuqQMxIL3sLfdWZXhvrO8cyDnXHMuT5J1iadnygtp2DXngq47ZdXD4Kuu",
+	"vDLtAqvd2sNjrGernb8pLfO6hjp2MBe2iqq5vLCY4qf0fPAZYpn2v/l8XnaWjJ+zsAA1+plgOhLXoqMA",
+	"orXJTHrkpFYmhWglOMxD52fmVTxUO3a+vcfWkzDJJFZR6wodrIcglS6T97R1tEYxBau6IVxzppSlAGgJ",
+	"Y7OJkd5NtQ+OPlRo9Ca/FhJ0Zw0TC1xnZuF3VepkrOVkE8+4soy1BRLFVhSgU0GC4+45+5D90n73weKR",
+	"gokd43p6nWzNUOwj4bhuITGk+jlxt+X2IPTrmIq4EExNvN9I0z9XMBUChznw0iKxF3R4MEpz2uDkfz2s",
+	"JGplSdqrbCnMM8ys/ypI6XHONgdW/2ILiFapCkPorWhv1xBkAWzs9l6taHGDQbawC1jsBc7PaYkaj3Ip",
+	"s0mH88JJO2lz8wyccyh5QODu8DEkHSWeyX20mZfeaZfLjU9SnOdMsPTBlJAjYaP2vKNavWpYY3Jxz/TN",
+	"v8ZZ08LmUXdGsumZiIc/YYIsdUP+5ofp52qaifTGU9lB+icy646E0VCBoF3wvO2bOth1rFmEuiIqC0VM",
+	"Srlm2rtB57ttKIuQflBRtP/1E2bFrCIClLW3orRUVVmtCy+vK/PTsNqmvsMW8EJlTdWu5EYOnM/stv+6",
+	"REqwlE5KqC1/m/7HLbDiS8EWaYxAhmXaZN7W5bO+L4FyT78sdWZxPLdVa5gCUwrMn91WyWm0v9uUxgHh",
+	"wLlUFzS7fbUa5kY9Qnyw9F23wBO+f0MkW1Tq6/nOvqKD5s7oJ5gaShheMPEPBnsUdZxwQznjT1lV1pvI",
+	"sFwEzUgmF0GdbnCzv8QxcafJ4xdk5iJSc8USrnkjWP/SVwgqn3tYMM9OAdr2/vfltnX+LM0NyNguy8ic",
+	"vKmqjRiJ90MFYXVEPzNT6Ti5USqPUV+LLCL4i/GoMDXUluvivOaCYas3NXyLpWJ7dsUInCp3dMVoJ70a",
+	"ujxcB146hWbtdQ6+rWu4jVzU1dqG+hG1kdtXkmKI+0+80gx0R/8jixBoNCUIKvnt8W9EMazGbyR5+BAn",
+	"ePhw7Jr+9qT+GY7zw4dRMe7WPI8sjtwYbt4oxThjWiusjK1zrjoSaL5zzN1d2Gi+I9iBxTPdZixaWQmn",
+	"9j7Yt3uRWpl7q4LfLs013sbPApT5JZcTxXD/c1cckI116Qg5a5wFiE7bdihrAYRVFWkMkfvVBbd/ljrW",
+	"v1pddptNWlh38jdtHgBETGSttcmDqYLQwAFRga5bJAYQiSspFDcbzLnnVZ/816hPzfeltcRZgcssTU7u",
+	"MPKclVkbK9tKob1k872kGcoC8J5Bb18D9ZvIt2u6yjPmmNTX92b/yZ7+7Vn66Onj/5z97dHzRwl79vyr",
+	"R4/oV8/o46+ePmZP/vb82SP2eP7iq9mT9MmzJ7NnT569eP5V8vTZ49mzF1/9573ReMQBZAvoyHvOjf4H",
+	"i71Pjt6eTN4DsBVOaM7BIIV1ZYGMfcVamiAXZCvKs9Gh/+n/8dxtmshVNbz/deQSSIyWxuT68ODg8vJy",
+	"GnY5WKAydWJkkSwP/DytkrZHb0/KUEvrC4U7aqPogBSmo4oUjvDbu29P35OjtyfTimBGh6NH00fTxzC+",
+	"zJmgOR8djp7iT3h6lrjvB47YRocfr8ajA+tyVvvjwKnL3Y8rZhRP/F/e0w7+ry/pYsHU1NX2hZ8unhx4",
+	"Oe/go9M0X8G0i5hh1UaVBqGE7ZK3zmqFrvk2arRWQk47X7lxWVjQKYKEdby0yls9Go9KbJ6kVc6Gk4qT",
+	"+dyCNtny4S8Rj6c5XxQKtUtVLoTSL9qeNsI1+e/Tn94QqYh7b76FVGuBcxdS7L8KpjYVRVkoRmGWYO/o",
+	"58LuVnqR12NUKp4feXtEawfjzEAI1cSV0adiVWiWDiCpGC8w00eTrz58fP63q9EAQNACqZkhRpLfaJb9",
+	"Ri45lqBFM049j4QeRwqe4dtlXBkRsEO1TWMMsim/Bt2rNvXQzt+EFOy3rm1wgEX3gWYZNJSCxfbgw3jk",
+	"KQFP2ZNHj/ZWDLuMZr4a10bxJHGNgdosyH4qi2pfKprbg+a+2NhwVDz4hWIJ8Gd7XGg9FuHGy20O11r0",
+	"NzQlygXG41Ief7FLORHoBABXArFX3tV49PwL3psTATyHZgRbBikE27fI38W5kJfCtwRxp1itqNqgMBMU",
+	"Q25kSqBggfllZFmkPdv1+hMfrjqvtINg9fBz9deEpze68FqFbU+Ot9yB93QX52wn4G4Uj3QlL2xCHLQ0",
+	"ugqZWK1QP5iS78PeyL0xn5XNFlUo4TyZnPKKg87Y4ahM+1nBdk+HDkrRGzlQzt9dzp/0cj6q641qGZxj",
+	"wNRIvBemlqPJTW/HdrTrPmqSBDUar1H94pMWIG48He1MH2Ivu61c+A53HbjrkoECeEtxqF4y8NPzXR8R",
+	"U14TtfvgE3LlL1yie00zoJNguY3MGyfHd5LeX0rSK30PF1b0yvM9yH4YgnPw0aeq34O851L1D5D0arkX",
+	"q76VeIRlEkN28mBKjpptrscznLPhVhkOCwjcSW+fWnprV96IgVHVU/h8EttNEpTWqmbvlN/zCxXR/sLI",
+	"6pTJXIrfLdLYNXhjS9JynPiT8cw/pYTlkHYnW/2lZavSv/9G0lWtdo6LGAmsSzfSuzX1atyUYlb4qcbZ",
+	"MOYEGIo7wuOqzh+wGExw53Mb6bF/9sEn9yK0mzVuPQrb8tP3LHx9frM5Od4mOn1BSpzBiVYjt0B8bz41",
+	"L40aDN7djsFgGG969ujZ7UEQ7sIbach3eIt/Yg75SVlanKx2ZWF9HOlgJtfbuJJosCVkFFVq94BHYW2n",
+	"MH289aS4j+UQ62lEHkyJTzSvy4JOLp5/IWlWJbyjamE7AY8DJJB7/s9DHP/elHwnFeHC6DE64xlX7Yfc",
+	"48IcPn7y9JlrAq7/6OfVbDd78ezw6OuvXbOq4IV937Saa6MOlyzLpOvg7ob2uPDh8H/+9/+m0+m9rexU",
+	"rr/ZvLG5j/4oPLX9rAs3vmu3vvBNir3Shd2Xrai7FYM7lG2IcX+5vrt9PtvtA9j/U9w6szoZuQdoqZ6s",
+	"xQnv8RZietd7aOzuHQxFKS+TKXkjXcqGIqOKSJUy5SrgLQqqqDAM6h85SsVEa9qGqCcZZ8IQqQjW9FIT",
+	"zVNGEq/9A1/BFRa9V+wCGtrpYew6BNsZPdN/ZCb/mq6DMO5ZeU0b6ZaMQfEruvZVBbFullT409dfQ9nI",
+	"8tWSZTDApERMjLmu6Hp0i9q+ktgG+efXy6tsdaLFsYdojirpxxZwpfVaDn9tzv3FSuyW3N3G7olz7mzN",
+	"qaw1of4Af9yiObCCna05iEXwNqQMXKZZJULFWRzMMFQp8Ae2DWxVSUcfn0303h3iu8f/jVhJk6B2ZBsY",
+	"lasPPqItI+QZrXOLUYV/IhtoYBBScuUtQpLMmQE1BKy2idcI7/G5Z7sZT19F6X2LLLhF7cIBYTJErHQ8",
+	"MItBEEiKVjkWy6P8k0+iDp/B+EQNK6vy+MLpaG/ivpZoWUbUzgQNnHu9D2qGXdwJypfV5G1pK5M1mri+",
+	"UfMOwbshuMX5vvWVARFjbhF/Bgd8/06ckDeyipm3z6M/pT3xU17bn3pBb6Rg1nAOYq2lRVzT4y+XCOE6",
+	"c6dq7u5cpEiW+ixdVJNcFYK5C69MBHRnHS6lKVMi0aeJsc+yMpn7tSWrA1/ds1e8+oHq5TYRa4jcApN9",
+	"kcLLDw5LPfcrrG26NWa8Gm3ItQQNbSrqehLqz/g4+yw3yR/wxfY5ePUXxFxvhxsiP/Es0f4kxX75IyaJ",
+	"sufuoExJ28Us49nnBzNOI0sHwGjC+BnLpFjoPybX7KOOOF4iVFLm5Y8n3//rsZmXmH9KSJ/q1WUk01wk",
+	"zBbaxRphXJMV19q5qT579Lfbg9Dwlc/iKMJ438/MXZ4/enp7058ydcETRt6zVS4VVTzbkL+LsijyTbgd",
+	"pnAvMwR6fXy0mgTa++qZ65Iwzdb1mWDNafCjWYPRcyszDLJM7sgHuQj4YDA3mCEYVddngNuNh+8bM54c",
+	"h37ZtcziZc63CCiAoh1DE/5jNFA5CI2ARdrLrxAWUJ+fzrEJ5zQt5+PSPclW6TokZ+Ih0Uv6/PGTX588",
+	"f+H/fPL8RYd6E+ZxaaXaCs5qIPhshxmi5fzjKmT3+3ookXd421u52w6NRzxdR9MIVyVswnPhvKeQT9zT",
+	"JKebzuzj+ZYSPOGwVTme28+1qQ2fLaPvPP8MK6u7n4hvyte4TQjpKtfcld7piEkJmAgQWlWDp8R6fzme",
+	"HlGxQZZlfYnbfiRXsRv2FvPIU40L5bNKseZzPZYn+FZmwkstdbTcvZwHyLYMWo4Dx4dcSSMTmVkvpiLP",
+	"pTIlI9LTQWIn6zLg1qTOrjO2k1CZUJMsi/zgI/4H87FdVaEn1lHtQLFM0rT62dbYDgzB5e8XK5kyJ6l2",
+	"/X5A0wsqEub6684BDuR8bmP6+j4ffLT/RobRguZ6KY3u+XTw0f/XOcIMa3igmHbJXV0HWxbywPq49Mng",
+	"p7bFDUWaxmMHxySqfrf4dIkWJjhVr3mi5BEmwHfSgt5ow1btYl62668dEZI++W9bspAi44JNVlLEMi3+",
+	"hF9f48fOWoddnbG2YVffZu2uGvwNsOrzDLnYborfP4ia5EbqvcZqFdZKrqqWWfrfkf34Q7MRSfskbUTS",
+	"5jF5rRpW/OeDj7U/6wdbLwuTysugLz7OLX8e4twSZN8fbn4p36uNLPaapEwD0X55CsQAD7ETU36NZNir",
+	"PnYn2fuLqhTnXKQNIsEHQSIvmNKlskl5Z7Q7veKfR684eN934rFVEfY+jlbo/Uokb2TK7Lj1FM6xYGoQ",
+	"4V1W27YgUsqlcXWNv5Wqdo0HdEIL0MsWOTEy9lSvOk5oYpnspBRge6vR2Va+6tIFIzTDXMFkxpggcgaL",
+	"rlf1JPCAoaosE+Gk73hRtQquXMmEaQ1JLlzw+DbQfDv/TurGEwKOAJezEC3JnKobA3t+sRXOsviBJvd/",
+	"/Fk/+AzwWlGwH7HYJobe0ouOiw6oh03fR3DNyUOyo8q+krkrewgMLWOGdQCzG046968JUWsXb44W1ODx",
+	"T0zxfpKbEVAJ6iem95tCW+RY9T5S9tF+fc9XKIkJKqRmiRRpvJAEoyrjTJtJeef1lX30rSMJdRxPXFJd",
+	"6a3BS5V5Encrs0MoO4K25T6tGghDxPBvFJ2YSZYsJXRumCJ0KN1jsdlt1ww0CgfUsCMBZ4/dLDhwB2qg",
+	"ks47Z1gLa/IFhZtgim6AL7oKV8DIP5dlK1pjJ1JoJnShy9oWTknF0tgaoFpR91xv2LqcS86DsUstmJGk",
+	"0GzbyF1YCsZ3yNJhuVsTkhKUVWovDjMYUadwaaOyBkSFiD5ATn2rALuhuawDEK4rRJc1LOuUExQ/10bm",
+	"OXA/MylE2a8LTae29ZH5e9W2TVyuUgyeuVQyHWooHeSX/oxRkeK5dHCQFT13SsyFy/DWhhmYywSdICZ9",
+	"lA9s5hRahUdgC9NpKndCdlY7Z43D0aDfKNF1EsGWXehacEyd9EVGQDaNsJ/QfayuTgueA9PrPHUOLik3",
+	"EJLgiqTjfRDR7DQqN1BufIAl9sM6p+jc4G4UHIC4cVyx7SpLiauTakEg7rABibQjG2Gq76QaFCVV90Sj",
+	"3JBCGJ4FkeLlw+mPpz66exLePQnvnoR3T8K7J+Hdk/DuSXj3JLx7Et49Ce+ehNWT8HOFi038/eOdV4UU",
+	"E8EW1PALVsaR3SXu+VPFLJQn3T9R8U6EJ6VLg0mo56L45WYhW4bRDHHAM1vZWOrO/EJYaFrLQiWMJAAh",
+	"FyTPKBfEsLUpk7LV0336BMSu1DRmEKWaPX1CTn848t7XS+clXG9731cY1maTsQcuM0JZj9SnSGACkO4y",
+	"JFD/wPfJ21wqO54xogG932LrY3bBMpkzZR07CTy32woAqMD90uFmy/u/VlASRvttXFM7OLStaB6U1Me1",
+	"Uk0oeuo36kHOaaa7C0La8VY0j+VPK1m71QwgN/lGppvGCYFdO8ANrJ+NygebC6o2keCKtk9okzSMBH7l",
+	"CKut2rjae6RAm2jbZLaNwmLCjmI6eo77qDw2TrVhraFsmMa8QSfRcslNv/BRCeAQ9zigZ78n5J3t93nj",
+	"oREid8QqZv6H8SqqtyyZBrYV0njW86VGBHvER08vnv0xEHZaJIxwo4mjuAHXC2SdgZEWTEwcA5rMZLqZ",
+	"1NjXqHYLpVxTrdlqtv0mCvmnyxjsLh+zjCyndk99nmvkOFhcH08OiWY9cQy4gzvbCJlhvLnEFo7o2HOA",
+	"8U/NorvYaAgCcfwp9iZv8L5dmV41zeaO8d0xvuA0NiQCLlxwVpOJTD8h41MbVYhunvftmiUFABee5Puo",
+	"rEULDagtQjNXymbFYoGZj1smG1gaw/Egh8znYYV2uUO54G4UZAcvs2HeNFNRc7g2dwmie+5LRRZKFvkD",
+	"3A4qNqjbXuVUbLwFENQOqyKzOLR55fbLaG1QUtsuPB55zV63UvCtaxGqvtxVW//dogUDvez+spQUInVx",
+	"Bc2JzVoMz7psh36/FhWb7s27bNcbWZ2bd8gV4XfZbkJl9cyZmpi1sAeqnhrdRnPakzu9y/j617g23tpS",
+	"ah0Mth3uVzGEPd0eKuBreH1UkwXBc/U6VbaKXpdbeZhpwrbcqy9Ba/i6S0FQw86azFiWE+rT8SdSaKOK",
+	"xJwJiiruYGHTtruBV9x387eXvkncyhIxgrihzgRFm1ap+I7yuTmLmOi+Y8yzUV0sFjZONiSSOWNnwrXi",
+	"ghSCG5xrxRMlJzZIDc4QyCdT23JFN2QOGceNJL8zJcmsMOGYrq6ONmBCsf4NMA2R8zNBDckY1Ya85sBl",
+	"YTifBap07GHmUqrzEgvx3AQLJpjmehJXvnxvv2L4v1u+V/LB/13nKhb2duP+Pew87YT85BjgppjGJOPa",
+	"VCbxFuy3Zj5ccTGJEhnYOZ2HUJO2yH0hTUlADyqfA7frZwJuOCMJcnVqrkcOTTNP6yza09GgmtpGNKxB",
+	"fq2Dnnh74TIkwmTuTCt/orCtgA6AxsuNxzouzb3f0YzSWxoy9tXlgupo5B4JZdy6PUV4x8OyWFIobjZo",
+	"h6A5/xVKPR/+8gHU/baAjTVRFCobHY6WxuSHBwdY83EptTkYXY3Db7rx8UO58o/e2pArfgHQXH24+v8H",
+	"ACdaP9k4UAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -158,177 +158,187 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3PbONLgv4LS91XlcaLtPCa7cdXUd048M+vLY1OxZ/Zu49wMRLYkrCmAC4C2NDn/",
-	"71doACRIghRle5zdr/JTYhGPRqPR6De+TFKxKgQHrtXk8MukoJKuQIPEv2iaipLrhGXmrwxUKlmhmeCT",
-	"Q/+NKC0ZX0ymE2Z+LaheTqYTTldQtzH9pxMJ/yyZhGxyqGUJ04lKl7CiZmC9KUzraqR1shCJG+LIDnFy",
-	"PLke+ECzTIJSXSj/yvMNYTzNywyIlpQrmppPilwxvSR6yRRxnQnjRHAgYk70stGYzBnkmdrzi/xnCXIT",
-	"rNJN3r+k6xrERIocunC+FqsZ4+ChggqoakOIFiSDOTZaUk3MDAZW31ALooDKdEnmQm4B1QIRwgu8XE0O",
-	"P00U8Awk7lYK7BL/O5cAv0OiqVyAnnyexhY31yATzVaRpZ047EtQZa4Vwba4xgW7BE5Mrz3yrlSazIBQ",
-	"Tj7++Jo8e/bspVnIimoNmSOy3lXVs4drst0nh5OMavCfu7RG84WQlGdJ1f7jj69x/lO3wLGtqFIQPyxH",
-	"5gs5Oe5bgO8YISHGNSxwHxrUb3pEDkX98wzmQsLIPbGN73RTwvm/6q6kVKfLQjCuI/tC8Cuxn6M8LOg+",
-	"xMMqABrtC4MpaQb9dJC8/PzlyfTJwfV/fDpK/u7+/O7Z9cjlv67G3YKBaMO0lBJ4ukkWEiieliXlXXx8",
-	"dPSglqLMM7Kkl7j5dIWs3vUlpq9lnZc0Lw2dsFSKo3whFKGOjDKY0zLXxE9MSp4bNmVGc9ROmCKFFJcs",
-	"g2xquO/VkqVLklJlh8B25IrluaHBUkHWR2vx1Q0cpusQJQauG+EDF/Svi4x6XVswAWvkBkmaCwWJFluu",
-	"J3/jUJ6R8EKp7yq122VFzpZAcHLzwV62iDtuaDrPN0TjvmaEKkKJv5qmhM3JRpTkCjcnZxfY363GYG1F",
-	"DNJwcxr3qDm8fejrICOCvJkQOVCOyPPnrosyPmeLUoIiV0vQS3fnSVCF4AqImP0DUm22/X+d/vU9EZK8",
-	"A6XoAj7Q9IIAT0XWv8du0tgN/g8lzIav1KKg6UX8us7ZikVAfkfXbFWuCC9XM5Bmv/z9oAWRoEvJ+wCy",
-	"I26hsxVddyc9kyVPcXPraRuCmiElpoqcbvbIyZys6Pr7g6kDRxGa56QAnjG+IHrNe4U0M/d28BIpSp6N",
-	"kGG02bDg1lQFpGzOICPVKAOQuGm2wcP4bvDUklUAjh+kF5xqli3gcFhHaMYcXfOFFHQBAcnskZ8d58Kv",
-	"WlwArxgcmW3wUyHhkolSVZ16YMSph8VrLjQkhYQ5i9DYqUOH4R62jWOvKyfgpIJryjhkhvMi0EKD5US9",
-	"MAUTDisz3St6RhW8eN53gddfR+7+XLR3fXDHR+02NkrskYzci+arO7BxsanRf4TyF86t2CKxP3c2ki3O",
-	"zFUyZzleM/8w++fRUCpkAg1E+ItHsQWnupRweM4fm79IQk415RmVmfllZX96V+aanbKF+Sm3P70VC5ae",
-	"skUPMitYo9oUdlvZf8x4cXas11Gl4a0QF2URLihtaKWzDTk57ttkO+auhHlUqbKhVnG29prGrj30utrI",
-	"HiB7cVdQ0/ACNhIMtDSd4z/rOdITncvfzT9FkZveupjHUGvo2N23aBtwNoOjoshZSg0SP7rP5qthAmC1",
-	"BFq32McL9fBLAGIhRQFSMzsoLYokFynNE6WpxpH+U8J8cjj5j/3auLJvu6v9YPK3ptcpdjLyqJVxEloU",
-	"O4zxwcg1aoBZGAaNn5BNWLaHEhHjdhMNKTHDgnO4pFzv1fpIgx9UB/iTm6nGtxVlLL5b+lUvwoltOANl",
-	"xVvb8IEiAeoJopUgWlHaXORiVv3w8Kgoagzi96OisPhA0RAYSl2wZkqrR7h8Wp+kcJ6T4z3yUzg2ytmC",
-	"5xtzOVhRw9wNc3druVusMhy5NdQjPlAEt1PIPbM1Hg1Ghr8LikOdYSlyI/VspRXT+C+ubUhm5vdRnf89",
-	"SCzEbT9xoRblMGcVGPwl0FwetiinSzjOlrNHjtp9b0Y2ZpQ4wdyIVgb30447gMcKhVeSFhZA98XepYyj",
-	"BmYbWVhvyU1HMroozMEZDmgNobrxWdt6HqKQICm0YHiVi/TiL1Qt7+DMz/xY3eOH05Al0AwkWVK13JvE",
-	"pIzweNWjjTlipiFq72QWTLVXLfGulrdlaRnVNFiagzculljUYz9keiAjustf8T80J+azOduG9dth98gZ",
-	"MjBlj7PzIGRGlbcKgp3JNEATgyArq70To3XvBOXrevL4Po3aox+swcDtkFsE7pBY3/kxeCXWMRheiXXn",
-	"CIg1qLugDzMOipEaVmoEfMcOMoH779BHpaSbLpJx7DFINgs0oqvC08DDG9/MUltej2ZC3oz7tNgKJ7U9",
-	"mVAzasB8py0kYdOySBwpRmxStkFroNqFN8w02sPHMNbAwqmmfwAWlBn1LrDQHOiusSBWBcvhDkh/GWX6",
-	"M6rg2VNy+pej7548/fXpdy8MSRZSLCRdkdlGgyIPnW5GlN7k8Ki7MtSOylzHR3/x3Fshm+PGxlGilCms",
-	"aNEdylo3rQhkmxHTrou1Jppx1RWAYw7nGRhObtFOrOHegHbMlJGwVrM72Yw+hGX1LBlxkGSwlZh2XV49",
-	"zSZcotzI8i5UWZBSyIh9DY+YFqnIk0uQiomIq+SDa0FcCy/eFu3fLbTkiipi5kbTb8lRoIhQll7z8Xzf",
-	"Dn225jVuBjm/XW9kdW7eMfvSRL63JCpSgEz0mpMMZuWioQnNpVgRSjLsiHf0T6BPNzxFq9pdEGm/mrZi",
-	"HE38asPTQGczG5VDtmhswu11szZWvH3OTvVARcAx6HiLn1GtP4Zc0zuXX9oTxGB/7TfSAksy0xC14Lds",
-	"sdSBgPlBCjG/exhjs8QAxQ9WPM9Nn66Q/l5kYBZbqju4jOvBalo3expSOJ2JUhNKuMgALSqlil/TPW55",
-	"9AeiG1OHN79eWol7BoaQUlqa1ZYFQSddh3PUHROaWupNEDWqx4tRuZ9sKzuddfnmEmhmtHrgRMycq8A5",
-	"MXCRFD2M2l90TkiInKUGXIUUKSgFWeJMFFtB8+0sE9EDeELAEeBqFqIEmVN5a2AvLrfCeQGbBP3hijx8",
-	"84t69BXg1ULTfAtisU0MvZXC5/xBXajHTT9EcO3JQ7KjEojnuUa7NAwiBw19KNwJJ73714aos4u3R8sl",
-	"SPTM/KEU7ye5HQFVoP7B9H5baMuiJ8rLKTpnbIV2O065UJAKnqnoYDlVOtnGlk2jhjZmVhBwwhgnxoF7",
-	"hJK3VGnrTWQ8QyOIvU5wHiugmCn6Ae4VSM3Iv3hZtDt2au5BrkpVCaaqLAohNWSxNXBYD8z1HtbVXGIe",
-	"jF1Jv1qQUsG2kfuwFIzvkGVXYhFEdWV0d+727uLQNG3u+U0UlQ0gakQMAXLqWwXYDSNdegBhqka0JRym",
-	"WpRThddMJ0qLojDcQiclr/r1oenUtj7SP9dtu8RFdX1vZwLM7NrD5CC/spi1MU5LalRoHJms6IWRPVAh",
-	"tm7PLszmMCaK8RSSIco3x/LUtAqPwJZD2mOLcFGUwWytw9Gi3yjR9RLBll3oW3CPYeQDlZqlrEBJ8Q1s",
-	"7lxwbk8QNdeTDDRlRlkPPlghugj7E+vHbo95M0F6lA7bBb+jxEaWkzOFF0YT+AvYoMbywQZInQVhVXeg",
-	"CURGNaebcoKA+rALI8CETWBNU51vzDWnl7AhVyCBqHK2YlrbiLemoqBFkYQDRO2DAzM6Y7gNLvI7MMY6",
-	"f4pDBcvrbsV0YiWqYfjOWmJVAx1OkiqEyEfo3h1kRCEY5TclhTC7zlyApY/C85TUANIJMegJqZjnA9VA",
-	"M66A/B9RkpRyFFhLDdWNICSyWbx+zQzmAqvmdB7SGkOQwwqsHI5fHj9uL/zxY7fnTJE5XPmoZNOwjY7H",
-	"j1EL/iCUbhyuO7C0mON2EuHtaDg1F4WT4do8ZbuHzo08Zic/tAavrK3mTCnlCNcs/9YMoHUy12PWHtLI",
-	"OO8kjjvKJhoMHVs37vspW5X5XW34nLK8lNDvXDg//zRfnZ9/Jj/alt4vOPVEHqLjqo4qn7vbqJQYmUBy",
-	"ZtQDKWiWUqWjplFcJF8kVWybioKzUgacv7lzSPmmlQc1FgYyg5SWNqjTcW0HQR1dp/YiElFrd9sojC5k",
-	"pHWxzLW9tEOsLqQoC6KqbbdUoKmGP8ZSVw8dg7I7cRBaUX/si64wUna+uYPb2g5EJBQSFPLWUDtV9quY",
-	"h+kLjvmqjdKw6hrwbNdfe8Tbj1447OgagueMQ7ISHDbRjD3G4R1+jPW2/L2nM960fX3bwnMD/hZYzXnG",
-	"UONt8Yu7HTC0D1VY0R1sfnvclu02TNxA2wTkBaEkzRlaLgRXWpapPucUdaPgsEXcr17j69eWX/smcfU8",
-	"oj27oc45Rdd7pTFF+eIcInz5RwCvNKtysQClW1LiHOCcu1aMk5IzjXOtzH4ldsMKkOgD3bMtV3RD5jRH",
-	"5f53kILMSt1krhhfrrTRva0h2UxDxPycU01yMFz1HeNnaxzOO2I8zXDQV0JeVFjYi56HBXBQTCVxN/FP",
-	"9itG8LjlL100Dyb72c/W9GjGr4PQNxoaCWz/9+F/HX46Sv5Ok98Pkpf/Y//zl+fXjx53fnx6/f33/6/5",
-	"07Pr7x/913/GdsrDHot+dpCfHDud4uQYBcfa9tiB/d7sTivGkyiRhR62Fm2Rh0b89QT0qDbuul0/53rN",
-	"DSFd0pxlVN+MHNosrnMW7eloUU1jI1pmBL/WHcWxW3AZEmEyLdZ442u8G1kRzzNAY7hLHcDzMi+53cpS",
-	"OYM8htF6D7eYT6tcEptDfkgw0WBJfXiG+/Ppdy8m0zpBoPo+mU7c188RSmbZOpYGksE6JmW7A4IH44Ei",
-	"Bd0o0HHugbBHnfnWpxgOuwKjnqklK+6fUyjNZnEO54MTnba+5ifcRg2a84Om9Y2z2In5/cOtJUAGhV7G",
-	"cksbkgK2qncToOXuLKS4BD4lbA/22tpytgDlwwpyoHPMcUTzsBgTbF2dA0tonioCrIcLGaWSxugHhVvH",
-	"ra+nE3f5qzuXx93AMbjac1Z2dP+3FuTBTz+ckX3HMNUDm5Fkhw5ySCJWKBcm3XCEG25mM+ptStY5P+fH",
-	"MGecme+H5zyjmu7PqGKp2i8VyFc0pzyFvYUghz7y+phqes47klZv0Ysg5p0U5SxnKbkIJeKaPG0ic1Rt",
-	"pPlCGMWx7RPsyq9uqih/sRMkV0wvRakTl6mZSLiiMouArqpMPRzZ5lkPzTolbmzLil0mqBs/zvNoUah2",
-	"xk53+UWRm+UHZKhcPorZMqK0kF4WMQKKhQb3971wF4OkVz7Nt1SgyG8rWnxiXH8myXl5cPAMSCOF5Td3",
-	"5Rua3BTQsFfeKKOobavEhVu9BtZa0qSgix6jgQZa4O6jvLxCJTvPCXZrpM740EAcql6Ax0f/Blg4dk4D",
-	"wMWd2l6+5EZ8CfgJtxDbGHGjdjjddL+CZJobb1crIaezS6VeJuZsR1elDIn7naky8RdGyPJeQMUWGGnl",
-	"ihbMgKRLSC8gw/xpWBV6M210945mJ2h61sGUrTNgQ+ExGRZNuzMgZZFRJ4q3DEoGwwq09qFeH+ECNmei",
-	"zqXdJQ2xmRWn+g4qUmogXRpiDY+tG6O9+S6aAW1dReGTyzDLwJPFYUUXvk//QbYi7x0c4hhRNLK2+hBB",
-	"ZQQRlvh7UHCDhZrxbkX6seUZLWNmb75IWQLP+4lrUitPLvAgXA0mo9nvK8CiJeJKkRk1crtw9TZs5lfA",
-	"xUpFF9AjIYfW9ZH5VQ2LPA6y7d6L3nRi3r7QOvdNFGTbODFrjlIKmC+GVFCZaYWb+JmsA8caUAmW0XII",
-	"m+UoJlVxOZbpUNnwcti6QH2gxQkYJK8FDg9GEyOhZLOkypcCwYop/iyPkgH+wEzGofz1kyBSIiiLUhm+",
-	"Pc9tn9OOdumy2H3qus9XD1XLEbnnRsLH4MzYdgiOAlAGOSzswm1jTyh1VmW9QQaOv87nOeNAkljQBVVK",
-	"pMzWcqmvGTcHGPn4MSHWBExGjxAj4wBsdEziwOS9CM8mX+wCJHdZodSPjS7N4G+IB7DbMEQj8ojCsHDG",
-	"ewJePQegLlKnur9a8WI4DGF8Sgybu6S5YXNO46sH6aRRo9jaSpp2rvFHfeLsgAXeXiw7rcleRTdZTSgz",
-	"eaDjAt0AxDOxTmwGS1Tina1nht6jkZmYTxM7mDZh/YEiM7HGcAu8Wmwk4BZY+uHwYAQa/poppFfs13eb",
-	"W2CGph2WpmJUqJBknDmvIpc+cWLM1D0STB+5PAxy0G8EQMvYUVdrdMrvViW1KZ50L/P6VpvWtVV80Hvs",
-	"+Pcdoegu9eCva4WpssadCeEjpEJm/XYKQ6hMV+Uvu+YFV7zT8I3ReeUDpTiPmtqGVyG6O9cTFdCAp55n",
-	"ABHHNmWjA8kP60IY6damdNj8focUKydKsJlqytqsFOOL3AkGfWiKLdjHJHmM2yXX9Xr8gONk59jm9ij5",
-	"Q7AURRyOXTSVjw4/A1D0nPIaDpTDbwmJy/EfhOW6nz4+tEX76EFphtc0K0sEulbsdjDk0/Vmdn2mCnJA",
-	"7TlpaBvJRczHfX7+SQGKZqe+W2Dlw/oVlG8eBTFbEhZMaai9TUaC9Zi+bzs+xbJZQsz7V6cLOTfr+yhE",
-	"Jc/ZuizYsbHMe1/BpdCQzJlUOkFXXXQJptGPCq1PP5qmcaWiGRVmK0iyLH6J4rQXsEkylpdxenXzvjk2",
-	"076vZAdVzlAwYZwATZdkhhVPo7GiA1PbcOLBBb+1C35L72y9406DaWomloZcmnP8m5yL1k03xA4iBBgj",
-	"ju6u9aJ04AINMiS73DFQMOzhxOt0b8hN0TlMmR97a3yVz9PsE+bsSANrwdCg3uDcSECOjSOzTL0udh7N",
-	"ZeRCJw3jRwRdlYFHaXph83GaG8wXlU0lHjZl9epRQ7u2Wwbk48fj24dzQnCSwyXk24OgKWLcG3AwMsKO",
-	"gKE3BNMJfIzHdqm+uwM1wqqVtmGMUktHuhly3NaqkSs/VuvWSLAGdy5xeLT3zkhont5q+u667ooiySCH",
-	"aJrO34I8HFoUmGzvG8dSVsxgjGewjoNjP01jJcm7xvuScW3LV95VZbzWOOOXHdaPG4OCwlY62736Xr+O",
-	"GexSiOb+RfUQZeUcGGTEOHil2QWPObSpr+cap0XBsnXL72lH7bWO3wnG8IJyg23BQEAbsQQwCapZN7A2",
-	"5tnq1Y2yPXujMHPWrO4XyjThVEz5txe6iKoSRLfh6gxo/gY2v5i2uJzJ9XRyOzdpDNduxC24/lBtbxTP",
-	"GIZn3WaNqIcdUU6LQopLmifOmdxHmlJcOtLE5t73fM/SWpzrnf1w9PaDA/96OklzoDKptJ3eVWG74t9m",
-	"VbZEYc8B8bXdl1RX9jmrDQebX9VVCx3QV0twdbQDhbpT8LMOLgiOonNIz+PRwFvdyy4Owi5xIB4Ciioc",
-	"onbV2WiIZgQEvaQs9z4yD21P5C4ubtzdGOUK4QC3jqQI76I7ZTed0x0/HTV1beFJ4VwDlb5Xtpi9IoK3",
-	"w+WMFoyuNyTVFcVyndYD0mVOvFyh1yBROUvj/lQ+wxQbbuNkTGOCjXv0aTNiyXrCrnjJgrFMMzXCqN0C",
-	"Mpgjikxf+rUPdzPhXiEqOftnCYRlwLX5JPFUtg4q2k+dZ717ncalSjew9cbXw99GxghL1bZvPCdzDQkY",
-	"YVROB9zjyurnF1p5n8wPQfjBDsF94YydK3EgMM/Rh6Nmm6iwbEbXjJbQt75Y5O1vrmZuzxzRF4iYSuZS",
-	"/A5xUxVa+CLZob44L8OI1t+Bj0gpqz059UNK9ey9290n3YQep2ZAYg/V484HIThYJdR7oym3W20fBGnE",
-	"tccJJswg2bfj1wTjYO5k3eT0akZjJVSNkGFgCtwvDb+5FsR39rh3Phrm6iXvkSBurGrLbN2EAmSduN2t",
-	"wXRDgcFOO1pUqCUDpNpQJpjaWJ9cicgwJb+i3L4rg94IPEqut1HwvUHoSkiseqLiLv4MUraKGpfOzz9l",
-	"adedm7EFs6+qlAqCZzvcQPY5KktF7ukTG05Xo+ZkTg6mwcNAbjcydskUm+WALZ7YFjOqwBpVfOSG72KW",
-	"B1wvFTZ/OqL5suSZhEwvlUWsEqQS6lC9qQJVZqCvADg5wHZPXpKHGKKj2CU8Mlh09/Pk8MlLdLDaPw5i",
-	"F4B7PmmIm2TzMMk1TscYo2THMIzbjboXtQbYN+/6GdfAabJdx5wlbOl43faztKKcLiAeFbraApPti7uJ",
-	"voAWXnhmH2xSWooNYT3pxqCp4U89mWaG/VkwSCpWK6ZXLpBDiZWhp/pNDjupH86+/uTKKXu4/EeMhyp8",
-	"OEhLibxfv4+932Krxqi193QFTbROCbWlbnJWRyr6Iu/kxFfSwvrSVVlpixszl1k6ijkYuDgnhWRco2JR",
-	"6nnyZ5IuqaSpYX97feAmsxfPIzW1m7Vd+W6A3zveJSiQl3HUyx6y9zKE60secsGTleEo2aM6szM4lb2B",
-	"W/EQnb44oeGhxwplZpSkl9zKBrnRgFPfivD4wIC3JMVqPTvR484ru3fKLGWcPGhpdujnj2+dlLESMlYe",
-	"sz7uTuKQoCWDS4zTj2+SGfOWeyHzUbtwG+i/rvPUi5yBWObPcq8isIvHJ9AN0OcTRibexNvT9PQ0ZK6o",
-	"2wc1nHEeEPtk5Da/x20ek2l03gUqz6HHQddjRGgkwLYwtpsGfHsTQ+DyaexQH46aS4tR5isRWbJ/gaDy",
-	"8biMyYjdqu8CMR8Mg5q5oaakWe39/iNqvFukG9lhvnhY8Y82sF+Z2SCS/Qp6NjF4iSK6nVn1PQguo+SV",
-	"WI/d1Bbv9hv7L4CaKEpKlme/1LVBWg99SMrTZTRYZGY6/lo/SVgtzh7maH3UJeXcRiN0bROopfzqtZmI",
-	"vvUPMXaeFeMj27bfHrHLbS2uBrwJpgfKT2jQy3RuJgix2iy7UKX15QuREZynLsZZ3+vdN2uClwX+WYLS",
-	"sXsRP9jUArSozw0V2wL/wDO0Y+yRn+yT4ksgjVqBaD+wVZog82XWraunLHJBsykx45z9cPSW2FltH/uw",
-	"li2sv7DXbmMV/fG5uwTaDsXW3kVGn1m10li6U2m6KmIlSkyLM98A66CE3iVUrEPs7JFja9NQXmO2kxh6",
-	"mDO5goxU0zmpGmnC/Edrmi7RWNBgqf0kP/5FCE+VKniFtXpNrSq+i+fOwO0ehbBvQkyJMJLDFVP2JWm4",
-	"hGZVlKpEkBMDfJWU5vJkybmllKhUPFTC6iZo98DZKEjvgIpC1kL8jtKLC1Pf8YGMU+wVrWbZfm2j8/yq",
-	"rbFRvZL1zj+gS7ngLMVakrGr2b1KPcY7O6LsZjwzwMXbqEnkcEXf+KiSNRwWe1/98IzQIa7rHgq+mk21",
-	"1GH/1Pj88ZJqsgCtHGeDbOqfqnEWasYVuGLK+EB5wCeFbHi8kUNGgyhqOXlHMsLk7B6Tw4/m23tnkMKs",
-	"xQvGUfX0ORI2QdLakPHRXG30VabJQmAGhTsU4Zo+mT57WKwlg/XnPf/ILo5hHcZm2TY6ojvUkY+VcLEJ",
-	"pu1r09YW1Kt/buTB2UmPisJN2v+QUVQe0Gvei+CIz7sK9AqQW40fjjZAboNBTnifGkKDSwyRgIK41Jie",
-	"R31aSTBGaLUUhS2IjY+O1tGKhom+ZRzqJ6AjF0QavRJwY/C89vRTqaTaioCjeNoZ0BzjImIMTWnnFLvt",
-	"UK0NdvGkRTrxc/RvY/0eUQ/jqBrUghvlm+rlaUPdgTDxGp+8d4jsvi6EUpUTolxyTfO9oRjjMIzbF+Rs",
-	"XgDdY9CViWx3Lak9ObvcRH2lSmZltgCd0CyL2RNe4VeCX325UlhDWlZVvIuCpFiZr1mqsEttbqJUcFWu",
-	"BubyDW45XfCAV4QawkfE/A5j4PVsg//GSlj374wLD9o5xt7HAmVV+twucnNzpI7Ua2g6UWyRjMcE3im3",
-	"R0c99c0Ive5/p5Sei0UTkHsuUDbE5cI9ivG3H8zFEdbv6tRlt1dLVV4Lw0GFf3YV1caqMEyTK/ms086c",
-	"QeXlYQNE/wONU7z8evJaAlsvtfer9Wv3ZbekvclYVLv6CZqSQRbUm5Nu48ps9jlCEbfp98WS2VAy87nT",
-	"e5xk2JGzcexBhPogxS5Ab3wENCkoc0EbNbPoYtale/WbC4cOXb3B7UW4JKpei92by76EJ58HbDM7Wk/a",
-	"XYArqlRIuGSi9OEQPl7Oq4T2V/ekeJBX3Lv+btwMTvV1zaC9Rtsz93yKXabTyd/8YqMrCXAtN/8CJtzO",
-	"pnceBIzVLG48B+iEq6i9SY+9K4+rNwUvLpOVyIYSpt/8Qo69b2nUveMJOVZuSWTuEa5osvhb9wSEb2ak",
-	"z9HTvnOdjopieOqeDPHu5LbhrtP3lZoy53PI6vbBn1/7jGJoQojoKkE6M4e1jj+Y1MmGvQIC6wKw1m2Q",
-	"2NxfPWMsQbkkR9RWkxyoggEMh1XbXNuRSD5bvzXtxyXbxx+y7C85W5eZReZZCMXqx3liL1yODDk+w0cq",
-	"A49hdywf73cJqRayEcckAXYpoGsmC15P/lZ6tsdQUkVme/ofKDM7nYS8JZqo6I4XrUvkoFcNXa6RUvW2",
-	"TYTZu87MHJISpn4I88Oc5ir+VllvsGur8kkQsBIp9Bxf2Ek2otq3W840iIFg2TAi45kANvj7vycybVz7",
-	"3aKz82bXsFbRKbwQFA+xTyvt7RBAUkVRo2SI+7UA7h7WnsdQsz0raj6HVLPLLYUu/rYEHhRRmHpLMMIy",
-	"D+pesCrLBguK7u7nqAEaqkMxCE9Q2P/W4PTliF7A5oEiDWqIvvU09cL9TWpJIgbw1jKCRyFULErRuq5c",
-	"4BhTFWUgFnxUsO0OdVXu3kc2AznnhnN5kmxKPANTXoqY7XvUXKbrTpXAMGGkrxZG95m7fovHMb4qqKoH",
-	"sH0tytAuSE4iD0G5WpZYlqTy1vqqlqD8b74GkZ0lZxcQPgOKvnEsoeBaRI293o6cDMhJnezv6OtVWDvL",
-	"z8zqHI5uvm+kBjRGP6W5wJef+tKdmmkTVZjXA2WDQ1FMwZeoEK45SPdcMt4MuVCQaOFD64bgGEKFjYC9",
-	"ERJU77sLFrjeaqgf63Kv+P6MLZZBXeBruEAiYUUNdDIoyto/5xCyX9vvPsHV1+TaatOu6DXZWlXVZ+8w",
-	"1UFiSPVz4m7L7YmzNzFvM85BJt7X3Y4p5AaVof+1kCIrU1cIJjgYlQtgdMGyAVYStQyn3VV2jHw5VgN/",
-	"G5QhuIDNvrW/pEvKF0F5tRB6K9rbNQSVy1q7faeW/7iRM1/YBSzuBM6vaT2fTgoh8qTH4XrSLTTbPgMX",
-	"LL0wYnZZx733PLRJHqKfr4qouVpufGHVogAO2aM9Qo64zTTywTXNl45ak/MHemj+Nc6albb2szPs753z",
-	"eMoGFvWRt+RvfphhrqbAML9bTmUH2VLGdN1T5FbSq8izs914utHhLu2nQGuislDEpJQbluoadb67xv0I",
-	"6QevIA5rP2ElvzqKWVofEUpL9cuQTeHlXe36Gfceo++wBbzQWBO8yOi5kQPnK4cav6uQEiyllxIay99m",
-	"/3ELrPlSsEUKsybNMm0BYhum1tyXwLinXlc2szieu6Y1LNsnONb87ZrkFPoMbRnWgHDMuZSXNL9/sxrW",
-	"czxCfLjH5eMLDfXfEMkWlepm8X5v6ai5A1337qbmH9AM+DcwexR19rqhnPOnegnTu8iwxD3NSS7qd5Fx",
-	"SHKFY1rv8JMXZOay6AoJKVOslWB85V81qdQ9fOTLxViu9Rb9cts6fxH6FmTsFARRkPf1Cwla4P1QQ1gf",
-	"0a/MVHpObpTKY9TXIYsI/mI8Kixns+W6uGi4je2LM614SCHhjt3HQSDYju7jbqGescuzLlJz6ZQKuusc",
-	"fVs3cBu5qOu1jY196CJ3qIz+mJCF+OsYpjvGTFiE4NMyBEElvz35jUiY49uRgjx+jBM8fjx1TX972vxs",
-	"jvPjx1Ex7t6iJSyO3Bhu3ijFOGdaJxUG1gWTPUX/Pjrm7i5sdN8R7ADx6pw5RF+Dwal93Og9l4JGmXur",
-	"gd8uzTXexs8ClPklVxPFcP9LX+6Cjc/vSZNpnYWS5dm2Q9lIeqpfvsW0nl9dQu5XeXv3V2vL7rJJ9/7h",
-	"LjFy7QOAiImstTF5MFWQzjQik8l1i+QtIXGlpWR6g3XCvOmT/RqNqfmp8pY4L3BVWcbJHVpcQFVprvat",
-	"lMpLNj8JmqMsYPQZjFDUQuR75Ic1XRU5OCb1/YPZn+DZn59nB8+e/Gn254PvDlJ4/t3LgwP68jl98vLZ",
-	"E3j65++eH8CT+YuXs6fZ0+dPZ8+fPn/x3cv02fMns+cvXv7pgbkDDMgW0ImvSjH53/hAdXL04SQ5M8DW",
-	"OKEFewMb+xamIWP/yiZNkQvCirJ8cuh/+p+eu+2lYlUP73+duKT3yVLrQh3u719dXe2FXfYXaExNtCjT",
-	"5b6fp/MM59GHkyo9zMZC4Y7azB9DCripjhSO8NvHH07PyNGHk72aYCaHk4O9g70nWMu4AE4LNjmcPMOf",
-	"8PQscd/3fRHhwy/X08n+EmiOPnHzxwq0ZKn/pK7oYgFyzz03an66fLrvxbj9L86QfD30bT98uWf/S8Pe",
-	"nm3piYEu+198Eavh1o0qUc7PEHQYCcVQs/0ZZiCPbQoqaNy/FFTu1P4XVE96f993aZnxj6gm2jOw751S",
-	"8ZYNLH3RawNrq0dKdbosi/0v+B+kyQAsGwTdBdeGge3bd/27P294Gv2xO1DnfbkFRDMtMeeR4hvo8eL9",
-	"EzwF9gCdZMjXdNsxbR+rsSZnPBxPDw52end3nJm77Q7v3hRdljC0suvp5PmOgA7asxphyxFgXtGM+KRX",
-	"nPvJ/c19wtG7bXgdsbwcIXh+fxA0Hzx5AxvyXmjyIyp819PJd/e5EyfciEA0J9gyKAbWPSI/8wsurrhv",
-	"aYSAcrWicjP6+Gi6UGhwleySOhEsLCn/GS38NhG6edSOsqxD9FYYAqVfiWwzgLGVWhQuSalGWi0LMm6W",
-	"0BV8u6/vLyESWWL9n97OzUUGk1BK07KE61vyhKY4bEA4idil0MCKz7fNffm+ANRomETbDm5HHvX+eGvw",
-	"6hWZcrZiygvh33jKN54i7fTP7m/6U5CXLAVyBqtCSCpZviE/8yrF/MY87ijLorFlzaO/lcdNJ+skFRks",
-	"gCeOgSUzkW18gdfGBBdg1b6OILP/pfnQjBUBJzb0LxY3Y36v3srvLmK2ISfHHQnHdmtz3lcbbBq8fnD4",
-	"6YvVm4xSUKs1bRA7nDEsvN/mTZ/jXHOI7M1CFkJXAZB2Ud8Y0TdGdCvhZvThGSPfRLUPW8CFdu7sqa/F",
-	"EqsPR3UXlDE6ylc9vney8V39J6bv2Bg9yEjwwSY5tNH8jUV8YxG3YxE/QeQw4ql1TCNCdLvpQ2MZBoYn",
-	"Ze3nHNFt45uXOZVEwVgzxxGO6Iwb98E17lupi+LK6nSU109DRzbwbvW8byzvG8v792F5R9sZTVMwubVm",
-	"dAGbFS0qfUgtS52Jq8CTgLDYqKquHdg9Ldn6e/+KMp3MhXQZH/hWQLezBprvu4JSrV/rGg6dL1iYIvgx",
-	"sJXHf92v6qRGP7adELGvzgjf08iXA/Sfaydk6NRD1l658z59NmwZC307rl/7qA739zGKeimU3p9cT7+0",
-	"/Ffhx88VCXyp7gpHCtefr/9/AAAA//+QMR46KdEAAA==",
+	"H4sIAAAAAAAC/+x9+3PcNtLgv4Ka76ty7JvR+JXsWlVb3yl2ktXFTlyWNnt3li/BkD0zWHEALgFKM/Hp",
+	"f7/qBkCCJMjhSIqye5WfbA3xaDQajUY/P08StcmVBGn05PjzJOcF34CBgv7iSaJKaWYixb9S0EkhciOU",
+	"nBz7b0ybQsjVZDoR+GvOzXoynUi+gclx2H86KeCfpSggnRybooTpRCdr2HAc2OxybF2NtJ2t1MwNcWKH",
+	"OH0zuRn4wNO0AK27UP4osx0TMsnKFJgpuNQ8wU+aXQuzZmYtNHOdmZBMSWBqycy60ZgtBWSpPvKL/GcJ",
+	"xS5YpZu8f0k3NYizQmXQhfO12iyEBA8VVEBVG8KMYiksqdGaG4YzIKy+oVFMAy+SNVuqYg+oFogQXpDl",
+	"ZnL8caJBplDQbiUgrui/ywLgV5gZXqzATD5NY4tbGihmRmwiSzt12C9Al5nRjNrSGlfiCiTDXkfsXakN",
+	"WwDjkn349jV78eLFK1zIhhsDqSOy3lXVs4drst0nx5OUG/Cfu7TGs5UquExnVfsP376m+c/cAse24lpD",
+	"/LCc4Bd2+qZvAb5jhISENLCifWhQP/aIHIr65wUsVQEj98Q2vtdNCef/XXcl4SZZ50pIE9kXRl+Z/Rzl",
+	"YUH3IR5WAdBonyOmChz049PZq0+fn02fPb35j48ns//t/vzyxc3I5b+uxt2DgWjDpCwKkMlutiqA02lZ",
+	"c9nFxwdHD3qtyixla35Fm883xOpdX4Z9Leu84lmJdCKSQp1kK6UZd2SUwpKXmWF+YlbKDLSm0Ry1M6FZ",
+	"XqgrkUI6ZUKy67VI1izh2g5B7di1yDKkwVJD2kdr8dUNHKabECUI163wQQv610VGva49mIAtcYNZkikN",
+	"M6P2XE/+xuEyZeGFUt9V+rDLip2vgdHk+MFetoQ7iTSdZTtmaF9TxjXjzF9NUyaWbKdKdk2bk4lL6u9W",
+	"g1jbMEQabU7jHsXD24e+DjIiyFsolQGXhDx/7rook0uxKgvQ7HoNZu3uvAJ0rqQGphb/gMTgtv+Psx9/",
+	"YKpg70BrvoL3PLlkIBOV9u+xmzR2g/9DK9zwjV7lPLmMX9eZ2IgIyO/4VmzKDZPlZgEF7pe/H4xiBZiy",
+	"kH0A2RH30NmGb7uTnhelTGhz62kbghqSktB5xndH7HTJNnz7l6dTB45mPMtYDjIVcsXMVvYKaTj3fvBm",
+	"hSplOkKGMbhhwa2pc0jEUkDKqlEGIHHT7INHyMPgqSWrABwh94Aj5DhwJGwjNINHF7+wnK8gIJkj9jfH",
+	"ueirUZcgKwbHFjv6lBdwJVSpq049MNLUw+K1VAZmeQFLEaGxM4cOzTizbRx73TgBJ1HScCEhZUJaoJUB",
+	"y4l6YQomHH7MdK/oBdfw1cvJzb6vI3d/qdq7Prjjo3abGs3skYzci/jVHdi42NToP+LxF86txWpmf+5s",
+	"pFid41WyFBldM//A/fNoKDUxgQYi/MWjxUpyUxZwfCGf4F9sxs4MlykvUvxlY396V2ZGnIkV/pTZn96q",
+	"lUjOxKoHmRWs0dcUddvYf3C8ODs22+ij4a1Sl2UeLihpvEoXO3b6pm+T7ZiHEuZJ9ZQNXxXnW//SOLSH",
+	"2VYb2QNkL+5yjg0vYVcAQsuTJf2zXRI98WXxK/6T5xn2NvkyhlqkY3ffkm7A6QxO8jwTCUckfnCf8Ssy",
+	"AbCvBF63mNOFevw5ADEvVA6FEXZQnuezTCU8m2nDDY30nwUsJ8eT/5jXypW57a7nweRvsdcZdUJ51Mo4",
+	"M57nB4zxHuUaPcAskEHTJ2ITlu2RRCSk3UQkJYEsOIMrLs3RZBo7k/UB/uhmqvFtRRmL79b7qhfhzDZc",
+	"gLbirW34SLMA9YzQygitJG2uMrWofvjiJM9rDNL3kzy3+CDREARJXbAV2ujHtHxen6RwntM3R+y7cGyS",
+	"sxXqjhbgRA28G5bu1nK3WKU4cmuoR3ykGW0namJuphUatAZzHxRHb4a1ylDq2Usr2Pivrm1IZvj7qM7/",
+	"HiQW4rafuLAVc5izDxj6JXi5fNGinC7hOF3OETtp970d2eAocYK5Fa0M7qcddwCPFQqvC55bAN0Xe5cK",
+	"SS8w28jCekduOpLRRWGuP4e0RlDd+qztPQ9RSPBDG4avM5Vc/pXr9T2c+YUfq3v8aBq2Bp5CwdZcr48m",
+	"MSkjPF71aGOOGDak1ztbBFMdVUu8r+XtWVrKDT+atOGNiyUW9dSPmB4UkbfLj/QfnjH8jGebG/8uR52E",
+	"oCOqAgtCik95+0CwM2ED3Hij2Ma+3hm+ug+C8nU9eXyfRu3RN1Zh4HbILYJ2SG3v/Rh8rbYxGL5W284R",
+	"UFvQ90Efamv/Iwxs9Aj43jjIFO2/Qx8vCr7rIpnGHoNkXCCKrppOgwxvfJyl1ryeLFRxO+7TYiuS1fpk",
+	"xnHUgPlOW0iipmU+c6QY0UnZBq2BahPeMNNoDx/DWAMLZ4b/BljQhgfA3wELzYHuGwtqk4sM7oH011Gm",
+	"j0qCF8/Z2V9Pvnz2/OfnX36FJJkXalXwDVvsDGj2hXubMW12GTzurmw6sU/n+OhfvfRayOa4sXG0KosE",
+	"NjzvDmW1m1YEss0YtutirYlmWnUF4JjDeQ7IyS3amVXcI2hv4OqdSoE0FvdAi7Ws69aUQboCr3vjLIUr",
+	"yHD72EalwPB/NHCXTgeE6Ywb0CY2z51EZ8SG0Fxr2CzuhTT7yCetZ0mZ25cU9h6tQze7nmYXbnixK8r7",
+	"eNhDUagiom2kjTQqUdnsCgotVMRw9N61YK6FF/bz9u8WWnbNtaMVSFkp08ZO1xOjhnv0LWiHPt/KGjeD",
+	"96Bdb2R1bt4x+9JEvterapajUW4rWQqLctV4Fy4LtcFzQx1JYvkODAlG52IDZ4Zv8h+Xy9sK892zZQUk",
+	"IzagcWymaHAr3rYOr1Rp5H6xHeKD1yYMDYmSKVrWzTU4mbGalOSHBBeTlEZcOaD0iMPtJu853d+BOdvJ",
+	"5Pa8bjSH2ghJpiK9k0nw9r8nRjXtmmEb9OT1vHaqRzoCDhLSX4FnZv0B8tsKY0OnKxw8+miKTO65QCVz",
+	"GMUefffNOZsXwNPdI9JI2B/W1H2eguEi049wOW9ptWeS53qt7kWu8peXdmMOSFV7VT90v/txkJ0ZjiYf",
+	"HtX2TCe+6Uz0jCqqm883HUFS4ajT4ZvQYdNwA28gM/zeCaQ9QYxIXnv+6DYixYakansrVmsTvGLfF0ot",
+	"7x/G2CwxQOmDZZIZ9ulqAn5QKXJrU+p7oMx6sPoKQVIILw6+UKVhnLg0qW1LHX8L9Pj+kNMB+UqY8Hlh",
+	"1vZZvwDkMgkvcbVohlGxC7nuOOOJpcOZZeb7Lgjbyk5n/UoyYgJsASCZWjh7pLOU0iI5uTEYfy7cSyR6",
+	"vAK48kIloDWqfK0iby9ovp29m80AnghwAriahWnFlry4M7CXV3vhvITdjJxuNPvi+5/0498BXqMMz/Yg",
+	"ltrE0FtplYTsgXrc9EME1548JDte4FVkqZYZRY+nDAz0ofAgnPTuXxuizi7eHS1XUJD59zeleD/J3Qio",
+	"AvU3pve7QlvmPa6kTpuCwjpumORSOdk3OhjwIhOgzYxfcZHxRQazAdHCt46YlxxPXHN7MxBdW08yR+Ju",
+	"ZXaIwo6g2TUU+AorJbrZqcL+LZVhSzDJGtLqIT+S7jOuzWzfNYONwgE17kjA2WM3Cw3cg5q3XBvrgiFk",
+	"SppjiwSax6IKp+gHuPfdiiP/5J+s3bHpzSJ1qav3qy5zlHAhja0B/Xb65/oBttVcahmMXT2SjWKlhn0j",
+	"92EpGN8hy0nf9Ac3ISmhj1J3cWTPQ7llF0VlA4gaEUOAnPlWAXZD98AeQISuEW0JR+gW5VQ+idOJNirP",
+	"kfuZWSmrfn1oOrOtT8zf6rZd4uKmPnOpAk1nxrV3kF/7M4avGDyXDg624ZcoS5EW0fqKdGFG5jLTQiYw",
+	"G6J80glgq/AI7GE6PQpc53oezNY6HC36jRJdLxHs2YW+Bfc8Vt7zwohE5CT5fg+7e38ItCeI2jiZfY1C",
+	"yoIP9lGQh/2Zdf5pj3m7h8EoVVcX/I6uK7KcTGi6AJvAX8KOXmDvrVfpeeCLeg8vm8ioeLq5ZASo91VD",
+	"gSxsAluemGzH7G23s9eWLhcbYYx1E24+fIzKZ+EAUaPKwIzOgmg9Mv0OjDFpntFQwfK6WzGdWAlxGL7z",
+	"lpjYQIeTDHOlshFagQ4yohCMcjZhucJdF84r3bsue0pqAOmEsmznwUXm+Ug30EwrYP9LlSzhkgTw0kB1",
+	"I6iC2CxdvziD0MGczq2kxhBksAH7rqAvT560F/7kidtzodkSrn0ox5MnXXQ8eUKv+vdKm8bhuge1Ih63",
+	"0whvJ2sTXhROcmvzlP1uDW7kMTv5vjW4n5TOlNaOcHH5d2YArZO5HbP2kEbGuXSY7ciVB+uJrpv2/QNk",
+	"iqc2huGeFO+qNIna2DAQlrjoCIv+gmbrcjGaAnreBpUhnt4vay5X+LoBY4RcaSfbEZt0o8RYWWBZabGo",
+	"Asg8PKtRfFsYyIEElaEMlktIDFMygVqYchPpQ8Brbb1HUwTqHnniTGzK7L7Oc6I0KW6WIhbZenHxMckv",
+	"Lj6xH3M0AjJszVzr6qRb+7JmgM9b4m7emhAehFWhynzq2dvqV0HSZfVaWJTLJRTau+agNg1l5RwnO2IU",
+	"CVz55JEfD2IJNM5Whd16wCqnPtyW2jN/Z6AR1fd/vvivY4zm47Nfn85e/bf5p88vbx4/6fz4/OYvf/m/",
+	"zZ9e3Pzl8X/9Z+xps+QiKwvo91+4uPi43CBGv7UtvevR1F8JIc6u68C1pZPdyoKcH1lGlqdC8TTh2kTt",
+	"jcQS5GpWuc/rKDgbjeD83d1aXO5aodZjYWALSHipIZBxHAS1A78+irwfWgeijcLoQkYanjBuj1hWhxKZ",
+	"rk4R8UxS9f82evp66BiU3YkD7836Y58DJ75Js909cHk7ECsgL0Aj/A3dlLZf1TKMkHRnWe+0gU1XfW+7",
+	"/tzzGPzgn1Kdl7mSmZAw2ygJu2hSACHhHX2M9bbSUE9nkkv7+rafmg34W2A15xlDjXfFL+12cP2/rzyX",
+	"7+OKb43bstyEsaGkmYQsZ5wlmQBpNR6mKBNzITlpEoLDFvHw8vqRft3Sa98krsyK6JrcUBeS04Ve6Rei",
+	"fHEJEb78LVQGXl2uVvZmaaSRALiQrpWQrJTC0Fwb3K+Z3bAcCnKzOrItN3zHlhjjaBT7FQrFFqVpMlcK",
+	"YdMGNVXWjITTMLW8kNywDLg27J1ALxAczt+qnmYkmGtVXFZYiNtqVyBBCz2Le6J9Z7+Sk7Bb/to5DOP/",
+	"XWenoH3w29TDLtJeyE/fuBf46Rt6ZtWWhw7sD6alxajMKJGFzhct2mJfSGUqAnpcm3bcrl9I9MAxCgPV",
+	"RcrN7cihzeI6Z9GejhbVNDaipXTzaz3w8XIHLsMiTKbFGm99jXedN+OhjLiRPjoRW7FlKe1WYnA/meMo",
+	"UseLpWo5rcJVbZqaY0axjGvuPUDdn8+//GoyrWMQq++T6cR9/RShZJFuY5GmKWxjb1J3QOhgPNIs5zsN",
+	"PZ4eBHvUQ856FITDbgCVGXot8ofnFNqIRZzD+fgHp9vaylNpAxPw/JBhbef022r58HCbAiCF3Kxj6Ssa",
+	"kgK1qncToOXsgBFKIKdMHMFRW7eUrkB7X70M+BIJ1BpTRjnmVOfAEpqnigDr4UJGKXBi9EPCrePWN9OJ",
+	"u/z1vcvjbuAYXO05+32+HMNE564bHwEbhqlGdLb2Q9MNxjDukvbYqO8LeSHfwFJIgd+PL2TKDZ8vuBaJ",
+	"npcaiq95xmUCRyvFjn1w1xtu+IXsKmD68moFYXUsLxeZSFBvHiNPmysl+mxE7TE+HNseAV351U0V5S92",
+	"ghk+4VVpZi4ZxKyAa16kEdB1lQyARqbeg7NOmRubfnTjMzd+nOfxPNftoODu8vM8w+UHZKhdyCtuGdNG",
+	"FV4WEdpDQ/v7g3IXQ8GvfSaRUoNmv2x4/lFI84nNLsqnT18Aa0TJ/uKufKTJXQ4NndOtgpbbajNauH3X",
+	"wNYUfJbzVY/SwAAnvZCVlzf0yM4yRt1CnFTRBzRUvQCPj/4NsHAcHGlIizuzvXxWr/gS6BNtIbVBcaM2",
+	"z952v4J43VtvVyvmt7NLpVnP8GxHV6WRxP3OVMl+VlxI7W3mqEYhrYzNi7RA5Sckl5BSihbY5GY3bXRX",
+	"y4ag6VmH0DaVkY22o3wbZAjBFEd5yp0o3lIoIYadjpUG/QCXsDtXdbqOQzIdNAPvdd9BJUoNpEsk1vDY",
+	"ujHam+98mRBSnuc+fp0CGT1ZHFd04fv0H2Qr8t7DIY4RRSMwvA8RvIgggjr0oeAWC8Xx7kT6seXhK2Nh",
+	"b75I5iPP+5lrUj+enJtOuJrzdfV9A5QXTV1rtuAotyuX0ssGlwdcrERNZI+EHNqiRoZwN+xXNMi+ey96",
+	"06H1u3mhde6bKMi28QzXHKUUwC9IKvSYaTmb+ZmsudMqUK1+3iFskZGYVHnlWabDi4ZNUK6GQIsTMBSy",
+	"Fjg8GE2MhJLNmmufbSydBmd5lAzwGyZLGEqRcxr4FQWZ1yrFt+e57XPaeV26RDk+O45PiRM+LUekt5lO",
+	"nGt2bDuUJAEohQxWduG2sSeUOnFDvUEIx4/LZSYksFnMRYlrrRJBrCi4ZtwcgPLxE8asCpiNHiFGxgHY",
+	"ZMangdkPKjybcnUIkNIlnuB+bHIACP6GeFSYdUJGkUflyMKF7HF39xyAO7+26v5qeYvSMEzIKUM2d8Uz",
+	"kMa/+OpBOplaSGxt5WVxjiSP+8TZAQ28vVgOWhP1uNVqQpnJAx0X6AYgXqjtzAbJRiXexXaB9B71y8Ze",
+	"0YNpc+I80myhtuScRFeL9QPeA0s/HB6MGgBKdoJrp359t7kFZmjaYWkqRoWafVHJNjW59IkTY6bukWD6",
+	"yOWLIM3NrQBo2+OrnFju8bv3kdoUT7qXeX2rTev0bT7kJXb8+45QdJd68NfVwlSJaZwK4QMkqkj79RRI",
+	"qMJUGba76gXbboZ8Y3TqmoFs3yfN14Z/QnR3rseHpgFPPc8AIt7YgK0OJN9sc6VBu4Auuurd4E5OLMAG",
+	"w2urs0LjdOYEgz40xRbsPfg8xu2S65SAfsBxsnNsc3se+UOw5HkcjkNeKh8cfgag6DnlNRzY4K6QuDRC",
+	"g7Dc9NPH+7ZoHz0ojVat5FXBWyt2OyD5dK2ZXZuphgzo9TxrvDZml7CLKwGARLMz3y3Q8lGKLC53jwMP",
+	"xwJWQhuorU1C15h+aD0+p8ycSi37V2fyYonr+6BUJc9RR6vFbyzzwVdwpQzMlqJAX3Q01UWXgI2+1aR9",
+	"+habxh8Vjc1mNkm1SOOXKE2LMUapyMo4vbp5v3+D0/5QB7uXCxJMhGTAkzVbUFL1qGf1wNTW+X5wwW/t",
+	"gt/ye1vvuNOATXHiAsmlOce/ybloB0oPsIMIAcaIo7trvSgduECD+OgudwweGPZw0nV6NGSm6Bym1I+9",
+	"17/KR2n3CXN2pIG1kGtQryt7xCHH+pFZpl7XU4lGMktlZg3lRwRdlYJHk7upkEw2N1iu/DTx4Dxl39Wj",
+	"hnZt9wwox48n9w/nhOBZhnk69ocMcMK4V+CQZ4QdgVxvGAXfeB+P/VJ9dwdqhFUrbcMYpZaOdDNkuK2f",
+	"Ri7Daf22JoJF3Fkpc7z1DiU0T281fXdNd3k+Q8VDNKjt70HUGs9zSvngG8cCvHAwge4EcXDsp2ms6klX",
+	"eV8Kab566Ue9j+S7rXHGLztMUTsGBSTO6Vsk+O1/Ywa7FKK5f1E9ROlnHGbENHj1squl0w719VzjPM9F",
+	"um3ZPe2ovdrxe8EYXVBusD0YCGgjFi5ZgG7se6DMswUyGpkBj0Zh5ryZQDiUacKphPblnbqIqsLD9+EK",
+	"k2d9D7ufsC0tZ3IzndzNTBrDtRtxD67fV9sbxTO54VmzWcPr4UCU8xydW3g2c8bkPtIs1JUjTWrubc8P",
+	"LK3Fud75Nydv3zvw0V6XAS9m1Wund1XULv+3WZXNgtxzQHz5mDU3lX7OvoaDza9St4YG6Os1uFIdwYO6",
+	"k1O8di6ox/MG6WXcG3ivedn5QdglDvhDQF65Q9SmOurc8oCoMidYHbYYUMnaxY27G6NcIRzgzp4U4V10",
+	"r+ymc7rjp6Omrj08KZxroJjIxtbL0UzJtrscvoJxBkuq6MW9AGcB6TInWW7IajDTmUji9lS5oBAbaf1k",
+	"sDGjxj3vaRyxFD1uV7IUwVjYbEzmuRaQwRxRZOpojrwadwvlIq5KKf5ZAhMpSIOfCjqVrYNK+lNnWe9e",
+	"p3Gp0g1MfYLh7yJjhNnw2zeek7mGBIzQK6cD7ptK6+cXWlmfuPTS+qHOfeGMnStxwDHP0YejZhuosG56",
+	"14yW0PcWRfT6N5eWv2eOaJFDoWfLQv0KcVUVafgisdRuIhKmqPeIkLLaklPXaqxn793uPukm+MiaDok9",
+	"VE87H7jgUACjt0Zzabfaxus2/NrjBBO00HM7fk0wDuZO1E3Grxc8uYwLGQhTYH5p2M2NYr6zx72z0QhX",
+	"kuGIBX5jVVths4zkUNRpDroZ2G4pMNhpR4sKtWSAHRsywdT6+mRaRYYp5TWXBnyhCXuUXG8NVn+Pva5V",
+	"QTmCdNzEn0IiNlHl0sXFxzTpmnNTsRK2cFupIagM5gayFS8tFbnqatadrkbN6ZI9nQa1B91upOJKaLHI",
+	"gFo8sy3QpkVr82e56oLLA2nWmpo/H9F8Xcq0gNSstUWsVqwS6uh5Uzmq+NyqT6nds1fsC3LR0eIKHiMW",
+	"3f08OX72igys9o+nsQvAVWgc4ibpMgxyjdMx+SjZMZBxu1GPotoAW1a3n3ENnCbbdcxZopaO1+0/Sxsu",
+	"+QriXqGbPTDZvrSbZAto4UWmtiakNoXaMdETbgyGI3/qiTRD9mfBYInabITZOEcOrTZIT3XZLzupH86m",
+	"BXNh4R4u/5H8oXLvDtJ6RD6s3cfeb7FVk9faD3wDTbROGbeJoTD833sq+joy7NTn0aMMBFXlCosbnAuX",
+	"TmIObiGljxfS0MOiNMvZn1my5gVPkP0d9YE7W3z1MlK2o5k+Xh4G+IPjvQANxVUc9UUP2XsZwvXF2Ds5",
+	"2whk9Y/ryM7gVPY6bkWnNX1+QsNDjxXKcJRZL7mVDXLjAae+E+HJgQHvSIrVeg6ix4NX9uCUWRZx8uAl",
+	"7tDfPrx1UsZGFbHkuPVxdxJHAaYQcAVp7ybhmHfciyIbtQt3gf73NZ56kTMQy/xZ7n0IHGLxCd4GZPMJ",
+	"PRNvY+1pWnoaMldsA+nDSAuIrUq9z+5xl3p1jc6HQOW6jISuR4nQCIBtYeywF/DdVQyByaexQ304ai4t",
+	"Rplfq8iSfZGjysbjIiYjequ+CwQ/IINauKGmrFlQ5uE9arxZpOvZgV88rPRHG9jfmdkQkv0KejYxKHYV",
+	"3c60+h44l3H2tdqO3dQW7/Yb+y+AmihKSpGlP9W5QZorXBRcJuuos8gCO/5cVz2uFmcPczQ78ppLab0R",
+	"OsPZV8rP/jUTeW/9Q42dZyPkyLbt8mZ2ua3F1YA3wfRA+QkRvcJkOEGI1WbahSqsL1uplNE8dera+l7v",
+	"lsULyvVQKq7YvUgfbGiBodrPSMXUiYFMSY9xxL6jAGiEpZFZk/QHNksTpFW1CzL1lHmmeDplOA7aoJid",
+	"1faxecJstZqVvXYbq+j3zz3E0XbIt/Y+IvpsGalZVXYmlqIEW5z7Bky0rEv0sA6xc8TeWJ2G9i9mO4lN",
+	"6FdsIA1K61ipmmgC/2MMpwzbRjVYaj/Jjy+z5KlSB4Xe3f+TihLtuUO4XaUlW2hpyhRKDtcC02atuYEr",
+	"aGZF8WB4McBnSWkuryiltJQSlYqHUljdBu0eOBq3MkBFIWsh/kDpxbmpH1h16ox6xYiyU8KqU+Hd5tio",
+	"CnG+8zX6uVRSJJR5NXY1UwaHcdbZEUlq45EBzt9GTyKHK1o4qwrWcFjsLaU1nTQQ1zUPBV9xUy112D8N",
+	"bF2+/xUY7TgbRiy6anhOQy2kBpd6HIko5JOqaFi8iUNGnShqOflAMqLg7B6Vw7f47QenkMIjyC6FLXzn",
+	"0GYJWlgdMtXlN/heFYatFGi3nmaGGv0R+xxRspYUtp+OfB1/GsMajHHZ1juiO9SJ95VwvgnY9jW2tQn1",
+	"6p8bcXB20pM8d5P210qMZ/ncyl4ER2zelaNXgNxq/HC0AXIbdHKi+xQJDfNyMm0gZy40pqdSXisIxmbz",
+	"RIqiFsz6R8eQEncTfSukt2nEL4gkeiXQxtB57emnk4KbZN1gQ/tcI8gvIsbQtHFGsbsO1dpg50+aJxM/",
+	"R/821kX+ehhH1aAW3LjcMX8okLoDYeI1Bsd5p5NuyT6SqpwQ5YJrmkX8YowDGbdPyNm8APbm4q26m4In",
+	"0Og74ibqS1WyKNMVGEyDEdMnfE1fGX316UphS/X6XM77PGcIVDtVYZfa3ESJkrrcDMzlG9xxuqAqZoQa",
+	"wsqcfoeR0lDVif8eliXZuQcd7GPvfYHSKnzuELm5OVJH6kWaxkSvs/GYoDvl7uiop74dodf975XSM7Vq",
+	"AvLACcqGuFy4RzH+9g1eHGH+rk4VA3u1VOm1yB1U+cru9GysEsM0uZKPOu3MGWReHlZA9NeAntLl1xPX",
+	"Euh6ub1frV27L7ol6Q3G4sblTzCcDbKg3ph061dG3y0UcZ1+ny+ZdSXDz53e4yTDjpxNYw8i1DspdgH6",
+	"3ntAs5wL57RRM4suZl24V7+6cOjQ1RvcXoQLourV2NnSoK8xY1Lfrd1KmG9TcljnJeps8y1Vuvx4pdre",
+	"DOInbF1uuKQU6fTmhG2eccn9ZeOjIct+w28Ub3WqD58iJOeksL7mBSUG5SKL5AiJazzdYP0IdLVXh3Cn",
+	"b4E122Y07+1uZrSaAE93/UYLGeyvA9EmSo97jtyqcnh70RGpuCdRC40LXBuHxZ2jDcY3Sq4CoI8m0ztt",
+	"vMO8R1cn1UGMFL6/6gse9DH19L1dOfgSXIKyvIAroUrvWuR9T716xf5KjniNGP1eXtJFHU31+5oUeg0g",
+	"565wl12mo5Dvf7KeygykKXb/AuaQzqZ3SuvG8n83Cuu6h0pUd2vGyp1vquq8l1ezjUqHkg98/xN74+20",
+	"o/iIJ+RY6jKVunKW0cQLb13xId8MX3Kjp33nOp3k+fDUPdkWupPbhodO35e2Dc/nkAb7vT+/rbruvRzO",
+	"pwaQsDXxUn2dyPJruiCB8kYHSQL6M9GMJSgXMEyanxmyWBjAcJgB0bUdieTz7VtsPy5xRbwkdH/65jpl",
+	"MzHPXGlRl4WL1Yoe6b5/TuWeA+t7dyzvO3sFiVFFwyewADgkGTVO5m2bf6Rx7lc6VlEOnv4HUjZPJyFv",
+	"iQb9uuPF63RTZKEm94WIYGbbRJi96yzwkKAB3w2BPyx5puNVMnsdx1tZhALnr0jS9PjCTtP9uPTLmQb+",
+	"RCIdRmQ8qubEeuH8f4lMGyNyv+jsVIscfqF3kpgEiXj6HhF7U1Q5IZT2awWS7JEpW8ZQsz/CkOqKias9",
+	"SWP+bmte+YQkU29VIViWQQ4ZUUWsUXLew22GNUAZvyU8Gb8/cPrirS9h90izBjVEqwxOvXB/m7yshAG6",
+	"tVDwyJXmWZ8Z2DlhCl1RBmHBe9jb7lBnuO8t7xzIObecy5NkU+IZmPJKGbjlXNj1oKx6FHzVl1emW2C1",
+	"X3v4hurZaudvyqu8rqGOHc2FnaJqLi8spfipPB98hljQ/jefz8vOkolLCAtQk58JpSNxLXoKIFqbzGxA",
+	"TupkUohWgqM8dH5mUcdDdWPnu3tsPQmTTFEVtb7QwWYIUuUy+UhbR2sSU6iqG8G1hKKwFIAtcWyYGeXd",
+	"VIfgGEKFJm/yWyFB99YwscD1Zhb+UKdOplpONvGMK8vYWCArYMMRuiJIcNw/5xCyX9vvPlg8UjCxZ1xP",
+	"r7O9GYp9JJzQHSSGVL9k7rbcH4R+G1ORkBKKmfcbafvnSihC4CgHXlom9oIOD0ZlThud/G+AlUStLEl3",
+	"lR2FeUaZ9d8GKT0uYTe3+hdbQLROVRhCb0V7u4YgC2Brt+/VihY3GGQru4DVvcD5e1qippNcqWzW47xw",
+	"2k3a3D4DlwJLHjC8O3wMSU+JZ/YF2cwr77Tr9c4nKc5zkJA+PmLsRNqoPe+o1qwa1ppcPjJD829p1rS0",
+	"edSdkezoQsbDnyhBVnFH/uaHGeZqGmR656nsIMMTmW1PwmisQNAteN71TR3tOtYuQl0TlYUiJqXcMu3d",
+	"qPPdNZRFSD+oKDr8+gmzYtYRAYW1t5K0VFdZbQov72rz07japr7DHvBCZU3druJGDpzf2W3/XYWUYCm9",
+	"lNBY/j79j1tgzZeCLdIUgYzLtMm8rctnc18C5Z5+XenM4njuqtYoBaaSlD+7q5LTZH+3KY0DwsFzWVzx",
+	"7OHVapQb9YTwAemHfoEnfP+GSLao1LfznX3LR82d8d9gaixheAXy74B7FHWccEM5409VVdabyKhcBM9Y",
+	"plZBnW50s7+mMWmn2bOv2MJFpOYFJEKLVrD+ta8QVD33qGCenQK17cPvy33r/EmZO5CxXZZROfuhrjZi",
+	"FN0PNYT1Ef2dmUrPyY1SeYz6OmQRwV+MR4WpofZcF5cNFwxbvanlW6wKuGdXjMCp8kBXjG7Sq7HLo3XQ",
+	"pVNq6K5z9G3dwG3koq7XNtaPqIvcoZIUY9x/4pVmsDv5H1mEYKMjRqCyX579wgqgavxGsSdPaIInT6au",
+	"6S/Pm5/xOD95EhXjHszzyOLIjeHmjVKMM6Z1wspgm4uiJ4HmB8fc3YVN5jtGHSCe6TaDaGUlmtr7YD/s",
+	"RWpl7r0Kfrs013gfPwtQ5pdcTRTD/U99cUA21qUn5Kx1FjA6bd+hbAQQ1lWkKUTuZxfc/rvUsf7Z6rK7",
+	"bNLCepC/afsAEGIia21MHkwVhAaOiAp03SIxgERcSVkIs6Oce171KX6O+tR8V1lLnBW4ytLk5A6jLqHK",
+	"2ljbVkrtJZvvFM9IFsD3DHn7GqzfxL7Z8k2egWNSf3m0+BO8+PPL9OmLZ39a/Pnpl08TePnlq6dP+auX",
+	"/NmrF8/g+Z+/fPkUni2/erV4nj5/+Xzx8vnLr758lbx4+Wzx8qtXf3o0mU4EgmwBnXjPucn/pGLvs5P3",
+	"p7NzBLbGCc8FGqSoriySsa9YyxPigrDhIpsc+5/+u+duR4na1MP7XycugcRkbUyuj+fz6+vro7DLfEXK",
+	"1JlRZbKe+3k6JW1P3p9WoZbWF4p21EbRISkcTWpSOKFvH745O2cn70+PaoKZHE+eHj09eobjqxwkz8Xk",
+	"ePKCfqLTs6Z9nztimxx/vplO5tblrPHH3KnL3Y8bMIVI/F/e0w7/r6/5agXFkavtiz9dPZ97OW/+2Wma",
+	"b4a+zYM7HX+u/5qJdE9P8oSZf/YZ44ZbN1KyOUNE0GEkFEPN5gu1PaAp6KBx/1Lo9afnn+n90vv73MVA",
+	"xz/SO9Iekrm3WsVbNrD02WwR1laPhJtkXebzz/QfItoALNJbr+YFYIxc/bMNROiuIoWrjUrBgdH3+5yn",
+	"V1wm4Prr3gHmarm0Jvyhz/PP9t/IMFryXK+V0QOf5p/9f5s7tafhvADtJGDXwfrOzSkbzq77804m0R+7",
+	"WOwUuFxBNNSbgq45y5zHU7d6yGQ6qbjOaUqXgWlb860DqdXTE0d5/vTpQYW/x9kGWrNGrtcuHx1a2c10",
+	"8vJAQAeVgI24iQgwX/OU+ah7mvvZw819KsklAC8IZi9AguDlw0HQ2D72PeywbiP7ll7JN9PJlw+5E6fS",
+	"QCF5xqhlkI2we0T+Ji+lupa+JUpO5WbDi93o42M42nU+TvJCXHEnt4Y1LT6RWcRmYmgetZM07RC9lSBB",
+	"m69VuhvA2EavchclWSOtFqCFxCV0Xws304gup7MsZo3G3jggVQqTULQ1RQk3d+QJzTcEgnAaUeaRVprq",
+	"Ry6Z6YAa9S1pGw/syN3Hzz4SrtPo6nKxEdq/XP7gKX/wlMJO/+Lhpj+D4kokwM5hk6uCFyLbsb/JKsfF",
+	"rXncSZpGHfKaR38vj0PFENqPViBnjoHNFird+QzTjQkuwb6VO4LM/HPjTydsTay/ZMzZCH9nnK0oV013",
+	"EYsdO33TkXBstzbn/XpHTYPyK8cfP9vHJr6k6rdgG8QOZwwrf7R506c41xwie1zISpnKa9Qu6g9G9Acj",
+	"upNwM/rwjJFvoq8Pm0GKd+7sqU8GFUtQyU0XlDFvlN/1+N7LxnffP7H3jtXUQMqCDzYypI3mP1jEHyzi",
+	"biziO4gcRjq1jmlEiO6w99BYhkE+XWm7nizZunzzMuMF0zBWzXFCIzrlxkNwjYd+1EVxlabee83Xpo9s",
+	"4P2+8/5geX+wvH8flneyn9E0BZM7v4wuYbfhefUe0uvSpOo6sK4QLARKRJteZRho/D2/5sKgfd6FyVCx",
+	"km5nAzybu4x2rV/rJDKdL5QZJ/gxsB/Ef51XiZqjH9uGmdhXZ5joaeTzkfrPteU2tIQSa69soB8/IVum",
+	"SgOO69eGveP5nFzP10qb+eRm+rll9As/fqpI4HN1VzhSuPl08/8GAGUcM5gN3gAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -177,186 +177,196 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNpLov4I3d1W2dUNJ/srGqkrdk+0kq4vtuCwlu3eWX4Ihe2aw4gAMAEoz8dP/",
-	"/goNgARJkMORFHuzzz/ZGuKj0Wg0Gv35cZKKVSE4cK0mRx8nBZV0BRok/kXTVJRcJywzf2WgUskKzQSf",
-	"HPlvRGnJ+GIynTDza0H1cjKdcLqCuo3pP51I+K1kErLJkZYlTCcqXcKKmoH1pjCtq5HWyUIkbohjO8TJ",
-	"y8n1wAeaZRKU6kL5I883hPE0LzMgWlKuaGo+KXLF9JLoJVPEdSaME8GBiDnRy0ZjMmeQZ2rfL/K3EuQm",
-	"WKWbvH9J1zWIiRQ5dOF8IVYzxsFDBRVQ1YYQLUgGc2y0pJqYGQysvqEWRAGV6ZLMhdwCqgUihBd4uZoc",
-	"vZ8o4BlI3K0U2CX+dy4BfodEU7kAPfkwjS1urkEmmq0iSztx2Jegylwrgm1xjQt2CZyYXvvkdak0mQGh",
-	"nLz77gV5/PjxM7OQFdUaMkdkvauqZw/XZLtPjiYZ1eA/d2mN5gshKc+Sqv27717g/KdugWNbUaUgfliO",
-	"zRdy8rJvAb5jhIQY17DAfWhQv+kRORT1zzOYCwkj98Q2vtNNCef/rLuSUp0uC8G4juwLwa/Efo7ysKD7",
-	"EA+rAGi0LwympBn0/WHy7MPHh9OHh9f/9v44+R/359PH1yOX/6IadwsGog3TUkrg6SZZSKB4WpaUd/Hx",
-	"ztGDWooyz8iSXuLm0xWyeteXmL6WdV7SvDR0wlIpjvOFUIQ6MspgTstcEz8xKXlu2JQZzVE7YYoUUlyy",
-	"DLKp4b5XS5YuSUqVHQLbkSuW54YGSwVZH63FVzdwmK5DlBi4boQPXNA/LzLqdW3BBKyRGyRpLhQkWmy5",
-	"nvyNQ3lGwgulvqvUbpcVOVsCwcnNB3vZIu64oek83xCN+5oRqggl/mqaEjYnG1GSK9ycnF1gf7cag7UV",
-	"MUjDzWnco+bw9qGvg4wI8mZC5EA5Is+fuy7K+JwtSgmKXC1BL92dJ0EVgisgYvYPSLXZ9v86/fENEZK8",
-	"BqXoAt7S9IIAT0XWv8du0tgN/g8lzIav1KKg6UX8us7ZikVAfk3XbFWuCC9XM5Bmv/z9oAWRoEvJ+wCy",
-	"I26hsxVddyc9kyVPcXPraRuCmiElpoqcbvbJyZys6Pqbw6kDRxGa56QAnjG+IHrNe4U0M/d28BIpSp6N",
-	"kGG02bDg1lQFpGzOICPVKAOQuGm2wcP4bvDUklUAjh+kF5xqli3gcFhHaMYcXfOFFHQBAcnsk58c58Kv",
-	"WlwArxgcmW3wUyHhkolSVZ16YMSph8VrLjQkhYQ5i9DYqUOH4R62jWOvKyfgpIJryjhkhvMi0EKD5US9",
-	"MAUTDj9mulf0jCr46knfBV5/Hbn7c9He9cEdH7Xb2CixRzJyL5qv7sDGxaZG/xGPv3BuxRaJ/bmzkWxx",
-	"Zq6SOcvxmvmH2T+PhlIhE2ggwl88ii041aWEo3O+Z/4iCTnVlGdUZuaXlf3pdZlrdsoW5qfc/vRKLFh6",
-	"yhY9yKxgjb6msNvK/mPGi7NjvY4+Gl4JcVEW4YLSxqt0tiEnL/s22Y65K2EeV0/Z8FVxtvYvjV176HW1",
-	"kT1A9uKuoKbhBWwkGGhpOsd/1nOkJzqXv5t/iiI3vXUxj6HW0LG7b1E34HQGx0WRs5QaJL5zn81XwwTA",
-	"vhJo3eIAL9SjjwGIhRQFSM3soLQoklykNE+UphpH+ncJ88nR5N8OauXKge2uDoLJX5lep9jJyKNWxklo",
-	"Uewwxlsj16gBZmEYNH5CNmHZHkpEjNtNNKTEDAvO4ZJyvV+/Rxr8oDrA791MNb6tKGPx3Xpf9SKc2IYz",
-	"UFa8tQ3vKRKgniBaCaIVpc1FLmbVD/ePi6LGIH4/LgqLDxQNgaHUBWumtHqAy6f1SQrnOXm5T74Px0Y5",
-	"W/B8Yy4HK2qYu2Hubi13i1WKI7eGesR7iuB2Crlvtsajwcjwd0Fx+GZYitxIPVtpxTT+q2sbkpn5fVTn",
-	"PweJhbjtJy58RTnM2QcM/hK8XO63KKdLOE6Xs0+O231vRjZmlDjB3IhWBvfTjjuAxwqFV5IWFkD3xd6l",
-	"jOMLzDaysN6Sm45kdFGYgzMc0BpCdeOztvU8RCFBUmjB8DwX6cVfqVrewZmf+bG6xw+nIUugGUiypGq5",
-	"P4lJGeHxqkcbc8RMQ3y9k1kw1X61xLta3palZVTTYGkO3rhYYlGP/ZDpgYy8XX7E/9CcmM/mbBvWb4fd",
-	"J2fIwJQ9zs6CkJmnvH0g2JlMA1QxCLKyr3diXt07Qfminjy+T6P26FurMHA75BaBOyTWd34Mnot1DIbn",
-	"Yt05AmIN6i7ow4yDYqSGlRoB30sHmcD9d+ijUtJNF8k49hgkmwUa0VXhaeDhjW9mqTWvxzMhb8Z9WmyF",
-	"k1qfTKgZNWC+0xaSsGlZJI4UIzop26A1UG3CG2Ya7eFjGGtg4VTTPwALyox6F1hoDnTXWBCrguVwB6S/",
-	"jDL9GVXw+BE5/evx04ePfnn09CtDkoUUC0lXZLbRoMh99zYjSm9yeNBdGb6OylzHR//qiddCNseNjaNE",
-	"KVNY0aI7lNVuWhHINiOmXRdrTTTjqisAxxzOMzCc3KKdWMW9Ae0lU0bCWs3uZDP6EJbVs2TEQZLBVmLa",
-	"dXn1NJtwiXIjy7t4yoKUQkb0a3jEtEhFnlyCVExETCVvXQviWnjxtmj/bqElV1QRMzeqfkuOAkWEsvSa",
-	"j+f7duizNa9xM8j57Xojq3PzjtmXJvK9JlGRAmSi15xkMCsXjZfQXIoVoSTDjnhHfw/6dMNT1KrdBZH2",
-	"P9NWjKOKX214GrzZzEblkC0am3D7t1kbK14/Z6e6pyLgGHS8ws/4rH8JuaZ3Lr+0J4jB/sJvpAWWZKYh",
-	"voJfscVSBwLmWynE/O5hjM0SAxQ/WPE8N326QvobkYFZbKnu4DKuB6tp3expSOF0JkpNKOEiA9SolCp+",
-	"TfeY5dEeiGZMHd78emkl7hkYQkppaVZbFgSNdB3OUXdMaGqpN0HUqB4rRmV+sq3sdNbkm0ugmXnVAydi",
-	"5kwFzoiBi6RoYdT+onNCQuQsNeAqpEhBKcgSp6LYCppvZ5mIHsATAo4AV7MQJcicylsDe3G5Fc4L2CRo",
-	"D1fk/g8/qwefAV4tNM23IBbbxNBbPficPagL9bjphwiuPXlIdlQC8TzXvC4Ng8hBQx8Kd8JJ7/61Iers",
-	"4u3RcgkSLTN/KMX7SW5HQBWofzC93xbasujx8nIPnTO2Qr0dp1woSAXPVHSwnCqdbGPLplHjNWZWEHDC",
-	"GCfGgXuEkldUaWtNZDxDJYi9TnAeK6CYKfoB7hVIzcg/e1m0O3Zq7kGuSlUJpqosCiE1ZLE1cFgPzPUG",
-	"1tVcYh6MXUm/WpBSwbaR+7AUjO+QZVdiEUR1pXR35vbu4lA1be75TRSVDSBqRAwBcupbBdgNPV16AGGq",
-	"RrQlHKZalFO510wnSouiMNxCJyWv+vWh6dS2PtY/1W27xEV1fW9nAszs2sPkIL+ymLU+TktqntA4MlnR",
-	"CyN74IPYmj27MJvDmCjGU0iGKN8cy1PTKjwCWw5pjy7CeVEGs7UOR4t+o0TXSwRbdqFvwT2KkbdUapay",
-	"AiXFH2Bz54Jze4Koup5koCkzj/XggxWii7A/sXbs9pg3E6RHvWG74HcesZHl5EzhhdEE/gI2+GJ5ax2k",
-	"zgK3qjt4CURGNaebcoKAercLI8CETWBNU51vzDWnl7AhVyCBqHK2Ylpbj7fmQ0GLIgkHiOoHB2Z0ynDr",
-	"XOR3YIx2/hSHCpbX3YrpxEpUw/CdtcSqBjqcJFUIkY94e3eQEYVglN2UFMLsOnMOlt4Lz1NSA0gnxKAl",
-	"pGKe91QDzbgC8t+iJCnlKLCWGqobQUhks3j9mhnMBVbN6SykNYYghxVYORy/7O21F7635/acKTKHK++V",
-	"bBq20bG3h6/gt0LpxuG6A02LOW4nEd6OilNzUTgZrs1Ttlvo3MhjdvJta/BK22rOlFKOcM3yb80AWidz",
-	"PWbtIY2Ms07iuKN0osHQsXXjvp+yVZnf1YbPKctLCf3GhfPz9/PV+fkH8p1t6e2CU0/kITquaq/yubuN",
-	"SomeCSRn5nkgBc1SqnRUNYqL5Iuk8m1TUXBWyoDzN3cOKd+04qDGwkBmkNLSOnU6ru0gqL3r1H5EImrt",
-	"bhuF0YWM1C6WubaXdojVhRRlQVS17ZYKNNXwx2jq6qFjUHYnDlwr6o993hVGys43d3Bb24GIhEKCQt4a",
-	"vk6V/SrmYfiCY75qozSsugo82/WXHvH2nRcOO28NwXPGIVkJDptoxB7j8Bo/xnpb/t7TGW/avr5t4bkB",
-	"fwus5jxjqPG2+MXdDhja28qt6A42vz1uS3cbBm6gbgLyglCS5gw1F4IrLctUn3OKb6PgsEXMr/7F1/9a",
-	"fuGbxJ/nkdezG+qcUzS9Vy+mKF+cQ4QvfwfgH82qXCxA6ZaUOAc4564V46TkTONcK7Nfid2wAiTaQPdt",
-	"yxXdkDnN8XH/O0hBZqVuMlf0L1favL2tItlMQ8T8nFNNcjBc9TXjZ2sczhtiPM1w0FdCXlRY2I+ehwVw",
-	"UEwlcTPx9/YrevC45S+dNw8G+9nPVvVoxq+d0DcaGgFs/+f+fx69P07+hya/HybP/uPgw8cn1w/2Oj8+",
-	"uv7mm//b/Onx9TcP/vPfYzvlYY95PzvIT166N8XJSxQca91jB/ZPpndaMZ5EiSy0sLVoi9w34q8noAe1",
-	"ctft+jnXa24I6ZLmLKP6ZuTQZnGds2hPR4tqGhvRUiP4te4ojt2Cy5AIk2mxxhtf413PinicASrDXegA",
-	"npd5ye1Wlsop5NGN1lu4xXxaxZLYGPIjgoEGS+rdM9yfj55+NZnWAQLV98l04r5+iFAyy9axMJAM1jEp",
-	"2x0QPBj3FCnoRoGOcw+EPWrMtzbFcNgVmOeZWrLi03MKpdkszuG8c6J7ra/5Cbdeg+b8oGp94zR2Yv7p",
-	"4dYSIINCL2OxpQ1JAVvVuwnQMncWUlwCnxK2D/vt13K2AOXdCnKgc4xxRPWwGONsXZ0DS2ieKgKshwsZ",
-	"9SSN0Q8Kt45bX08n7vJXdy6Pu4FjcLXnrPTo/m8tyL3vvz0jB45hqns2IskOHcSQRLRQzk26YQg33MxG",
-	"1NuQrHN+zl/CnHFmvh+d84xqejCjiqXqoFQgn9Oc8hT2F4Icec/rl1TTc96RtHqTXgQ+76QoZzlLyUUo",
-	"EdfkaQOZo89Gmi+EeTi2bYJd+dVNFeUvdoLkiumlKHXiIjUTCVdUZhHQVRWphyPbOOuhWafEjW1ZsYsE",
-	"dePHeR4tCtWO2Okuvyhys/yADJWLRzFbRpQW0ssiRkCx0OD+vhHuYpD0yof5lgoU+XVFi/eM6w8kOS8P",
-	"Dx8DaYSw/OqufEOTmwIa+sobRRS1dZW4cPuugbWWNCnookdpoIEWuPsoL6/wkZ3nBLs1Qme8ayAOVS/A",
-	"46N/AywcO4cB4OJObS+fciO+BPyEW4htjLhRG5xuul9BMM2Nt6sVkNPZpVIvE3O2o6tShsT9zlSR+Asj",
-	"ZHkroGIL9LRySQtmQNIlpBeQYfw0rAq9mTa6e0OzEzQ962DK5hmwrvAYDIuq3RmQssioE8VbCiWDYQVa",
-	"e1evd3ABmzNRx9LuEobYjIpTfQcVKTWQLg2xhsfWjdHefOfNgLquovDBZRhl4MniqKIL36f/IFuR9w4O",
-	"cYwoGlFbfYigMoIIS/w9KLjBQs14tyL92PLMK2Nmb75IWgLP+4lrUj+enONBuBoMRrPfV4BJS8SVIjNq",
-	"5Hbh8m3YyK+Ai5WKLqBHQg616yPjqxoaeRxk270XvenEvH2hde6bKMi2cWLWHKUUMF8MqeBjpuVu4mey",
-	"BhyrQCWYRsshbJajmFT55VimQ2XDymHzAvWBFidgkLwWODwYTYyEks2SKp8KBDOm+LM8Sgb4AyMZh+LX",
-	"TwJPiSAtSqX49jy3fU47r0sXxe5D1328evi0HBF7biR8dM6MbYfgKABlkMPCLtw29oRSR1XWG2Tg+HE+",
-	"zxkHksScLqhSImU2l0t9zbg5wMjHe4RYFTAZPUKMjAOw0TCJA5M3IjybfLELkNxFhVI/Npo0g78h7sBu",
-	"3RCNyCMKw8IZ73F49RyAOk+d6v5q+YvhMITxKTFs7pLmhs25F189SCeMGsXWVtC0M40/6BNnBzTw9mLZ",
-	"aU32KrrJakKZyQMdF+gGIJ6JdWIjWKIS72w9M/Qe9czEeJrYwbQB6/cUmYk1ulvg1WI9AbfA0g+HByN4",
-	"4a+ZQnrFfn23uQVmaNphaSpGhQpJxqnzKnLpEyfGTN0jwfSRy/0gBv1GALSUHXW2Rvf43fpIbYon3cu8",
-	"vtWmdW4V7/QeO/59Ryi6Sz3462phqqhxp0J4B6mQWb+ewhAq01X6y656wSXvNHxjdFz5QCrO4+Zrwz8h",
-	"ujvX4xXQgKeeZwARL23IRgeSb9eFMNKtDemw8f0OKVZOlGAj1ZTVWSnGF7kTDPrQFFuw90nyGLdLrvP1",
-	"+AHHyc6xze155A/BUhRxOHZ5qbxz+BmAoueU13CgHH5LSFyM/yAs1/308bYt2kcPStO9pplZInhrxW4H",
-	"Qz5da2bXZqogB3w9J43XRnIRs3Gfn79XgKLZqe8WaPkwfwXlmweBz5aEBVMaamuTkWA9pj+1Hp9i2iwh",
-	"5v2r04Wcm/W9E6KS52xeFuzYWOYnX8Gl0JDMmVQ6QVNddAmm0XcKtU/fmabxR0XTK8xmkGRZ/BLFaS9g",
-	"k2QsL+P06ub94aWZ9k0lO6hyhoIJ4wRouiQzzHga9RUdmNq6Ew8u+JVd8Ct6Z+sddxpMUzOxNOTSnONP",
-	"ci5aN90QO4gQYIw4urvWi9KBCzSIkOxyx+CBYQ8nXqf7Q2aKzmHK/Nhb/at8nGafMGdHGlgLugb1OudG",
-	"HHKsH5ll6nWy82gsIxc6aSg/IuiqFDxK0wsbj9PcYL6odCpxtyn7rh41tGu7ZUA+fjy+fTgnBCc5XEK+",
-	"3QmaIsa9Agc9I+wI6HpDMJzA+3hsl+q7O1AjrFppG8YotXSkmyHDbf00cunH6rc1EqzBnQscHm29MxKa",
-	"p7eavrumu6JIMsghGqbztyAOhxYFBtv7xrGQFTMY4xms4+DYT9NYSvKu8r5kXNv0lXeVGa81zvhlh/nj",
-	"xqCgsJnOds++1//GDHYpRHP/onqIsjIODDJiHLx62QXFHNrU13ON06Jg2bpl97Sj9mrH7wRjeEG5wbZg",
-	"IKCNWACYBNXMG1gr82z26kbanv1RmDlrZvcLZZpwKqZ87YUuoqoA0W24OgOa/wCbn01bXM7kejq5nZk0",
-	"hms34hZcv622N4pndMOzZrOG18OOKKdFIcUlzRNnTO4jTSkuHWlic297/sTSWpzrnX17/OqtA/96Oklz",
-	"oDKpXju9q8J2xZ9mVTZFYc8B8bndl1RX+jn7Gg42v8qrFhqgr5bg8mgHD+pOws/auSA4is4gPY97A281",
-	"Lzs/CLvEAX8IKCp3iNpUZ70hmh4Q9JKy3NvIPLQ9nru4uHF3Y5QrhAPc2pMivIvulN10Tnf8dNTUtYUn",
-	"hXMNZPpe2WT2igjedpczr2A0vSGpriim67QWkC5z4uUKrQaJylkat6fyGYbYcOsnYxoTbNzznjYjlqzH",
-	"7YqXLBjLNFMjlNotIIM5osj0qV/7cDcTrgpRydlvJRCWAdfmk8RT2TqoqD91lvXudRqXKt3A1hpfD38b",
-	"GSNMVdu+8ZzMNSRghF45HXBfVlo/v9DK+mR+CNwPdnDuC2fsXIkDjnmOPhw120CFZdO7ZrSEvrVikde/",
-	"uZy5PXNEKxAxlcyl+B3iqirU8EWiQ31yXoYerb8DHxFSVlty6kJK9ey9290n3YQWp6ZDYg/V484HLjiY",
-	"JdRboym3W20LgjT82uMEE0aQHNjxa4JxMHeibnJ6NaOxFKpGyDAwBeaXht1cC+I7e9w7Gw1z+ZL3SeA3",
-	"VrVlNm9CAbIO3O7mYLqhwGCnHS0q1JIBUm0oE0ytr0+uRGSYkl9RbuvKoDUCj5LrbR74XiF0JSRmPVFx",
-	"E38GKVtFlUvn5++ztGvOzdiC2aoqpYKgbIcbyJajslTkSp9Yd7oaNSdzcjgNCgO53cjYJVNslgO2eGhb",
-	"zKgCq1Txnhu+i1kecL1U2PzRiObLkmcSMr1UFrFKkEqow+dN5agyA30FwMkhtnv4jNxHFx3FLuGBwaK7",
-	"nydHD5+hgdX+cRi7AFz5pCFuks3DINc4HaOPkh3DMG436n5UG2Br3vUzroHTZLuOOUvY0vG67WdpRTld",
-	"QNwrdLUFJtsXdxNtAS288MwWbFJaig1hPeHGoKnhTz2RZob9WTBIKlYrplfOkUOJlaGnuiaHndQPZ6s/",
-	"uXTKHi7/Ef2hCu8O0npEflq7j73fYqtGr7U3dAVNtE4JtaluclZ7Kvok7+TEZ9LC/NJVWmmLGzOXWTqK",
-	"Oei4OCeFZFzjw6LU8+Rrki6ppKlhf/t94Cazr55Ecmo3c7vy3QD/5HiXoEBexlEve8jeyxCuL7nPBU9W",
-	"hqNkD+rIzuBU9jpuxV10+vyEhoceK5SZUZJecisb5EYDTn0rwuMDA96SFKv17ESPO6/sk1NmKePkQUuz",
-	"Qz+9e+WkjJWQsfSY9XF3EocELRlcop9+fJPMmLfcC5mP2oXbQP95jade5AzEMn+Wex8Cu1h8grcB2nxC",
-	"z8SbWHualp6GzBU1++ALZ5wFxJaM3Gb3uE0xmUbnXaDyHHocdD1KhEYAbAtju72Ab69iCEw+jR3qw1Fz",
-	"aTHKfC4iS/YVCCobj4uYjOit+i4Q88EwqJkbakqa2d4/vUeNN4t0PTvMFw8r/tEG9jMzG0SyX0HPJgaV",
-	"KKLbmVXfA+cySp6L9dhNbfFuv7H/BKiJoqRkefZznRukVehDUp4uo84iM9Pxl7okYbU4e5ij+VGXlHPr",
-	"jdDVTeAr5Rf/mom8t/4hxs6zYnxk23btEbvc1uJqwJtgeqD8hAa9TOdmghCrzbQLVVhfvhAZwXnqZJz1",
-	"vd6tWRNUFvitBKVj9yJ+sKEFqFGfGyq2Cf6BZ6jH2Cff25LiSyCNXIGoP7BZmiDzadatqacsckGzKTHj",
-	"nH17/IrYWW0fW1jLJtZf2Gu3sYp+/9xdHG2HfGvvIqLPrFppTN2pNF0VsRQlpsWZb4B5UELrEj6sQ+zs",
-	"k5dWp6H8i9lOYuhhzuQKMlJN56RqpAnzH61pukRlQYOl9pP8+IoQnipVUIW1qqZWJd/Fc2fgdkUhbE2I",
-	"KRFGcrhiylaShktoZkWpUgQ5McBnSWkuT5acW0qJSsVDKaxugnYPnPWC9AaoKGQtxO8ovTg39R0LZJxi",
-	"r2g2y3a1jU75VZtjo6qS9doX0KVccJZiLsnY1eyqUo+xzo5IuxmPDHD+NmoSOVzRGh9VsIbDYm/VD88I",
-	"HeK65qHgq9lUSx32T43lj5dUkwVo5TgbZFNfqsZpqBlX4JIpY4HygE8K2bB4I4eMOlHUcvKOZITB2T0q",
-	"h+/MtzdOIYVRixeM49PTx0jYAEmrQ8aiudq8V5kmC4ERFO5QhGt6b/rsY7KWDNYf9n2RXRzDGozNsq13",
-	"RHeoY+8r4XwTTNsXpq1NqFf/3IiDs5MeF4WbtL+QUVQe0Gvei+CIzbty9AqQW40fjjZAboNOTnifGkKD",
-	"S3SRgIK40Jieoj6tIBgjtFqKwhbE+kdH82hF3URfMQ51CejIBZFGrwTcGDyvPf1UKqm2IuAonnYGNEe/",
-	"iBhDU9oZxW47VGuDnT9pkU78HP3bWNcj6mEcVYNacKN8U1WeNtQdCBMvsOS9Q2S3uhBKVU6IcsE1zXpD",
-	"McZhGLdPyNm8ALrHoCsT2e5aUntydrmJ+lKVzMpsATqhWRbTJzzHrwS/+nSlsIa0rLJ4FwVJMTNfM1Vh",
-	"l9rcRKngqlwNzOUb3HK6oIBXhBrCImJ+h9HxerbBf2MprPt3xrkH7exj732Bsip8bhe5uTlSR+o1NJ0o",
-	"tkjGYwLvlNujo576ZoRe979TSs/FognIJ05QNsTlwj2K8bdvzcUR5u/q5GW3V0uVXgvdQYUvu4rPxiox",
-	"TJMr+ajTzpxB5uVhBUR/gcYpXn49cS2Brpfa+9XatfuiW9LeYCyqXf4ETckgC+qNSbd+ZTb6HKGI6/T7",
-	"fMmsK5n53Ok9TjLsyNk49iBCvZNiF6AfvAc0KShzThs1s+hi1oV79asLhw5dvcHtRbggql6N3Q+XfQFP",
-	"Pg7YRna0StpdgEuqVEi4ZKL07hDeX84/Ce2vrqR4EFfcu/6u3wxO9XnVoL1K2zNXPsUu073Jf/jZelcS",
-	"4Fpu/glUuJ1N7xQEjOUsbpQDdMJVVN+kx96VL6uagheXyUpkQwHTP/xMXnrb0qh7xxNyLN2SyFwRrmiw",
-	"+CtXAsI3M9Ln6Glfu07HRTE8dU+EeHdy23DX6ftSTZnzOaR1e+vPry2jGKoQIm+VIJyZw1rHCyZ1omGv",
-	"gMC6AMx1GwQ292fPGEtQLsgRX6tJDlTBAIbDrG2u7Ugkn61fmfbjgu3jhSz7U87WaWaReRZCsbo4T6zC",
-	"5UiX4zMsUhlYDLtjeX+/S0i1kA0/JgmwSwJdM1lQPflL6tkeRUnlme3pfyDN7HQS8pZooKI7XrROkYNW",
-	"NTS5RlLV2zYRZu86M3NISpj6IcwPc5qreK2yXmfXVuaTwGElkug5vrCTbES2b7ecaeADwbJhRMYjAazz",
-	"978mMq1f+92is1Oza/hV0Um8ECQPsaWV9ndwIKm8qFEyxP1aAHeFtecx1GyPiprPIdXsckuii78tgQdJ",
-	"FKZeE4ywzIO8F6yKssGEorvbOWqAhvJQDMITJPa/NTh9MaIXsLmnSIMaorWepl64v0kuScQA3lpG8CiE",
-	"inkpWtOVcxxjqqIMxIL3Crbdoc7K3VtkM5BzbjiXJ8mmxDMw5aWI6b5HzWW67pQJDANG+nJhdMvc9Ws8",
-	"XmJVQVUVwPa5KEO9IDmJFIJyuSwxLUllrfVZLUH533wOIjtLzi4gLAOKtnFMoeBaRJW9Xo+cDMhJnejv",
-	"aPUqzJ3lZ2Z1DEc33jeSAxq9n9JcYOWnvnCnZthE5eZ1T1nnUBRTsBIVwjUH6col482QCwWJFt61bgiO",
-	"IVRYD9gbIUH11l2wwPVmQ31Xp3vF+jM2WQZ1jq/hAomEFTXQySApa/+cQ8h+Yb/7AFefk2urTrui12Rr",
-	"VlUfvcNUB4kh1c+Juy23B87eRL3NOAeZeFt326eQG1SG9tdCiqxMXSKY4GBUJoDRCcsGWElUM5x2V9lR",
-	"8uWYDfxVkIbgAjYHVv+SLilfBOnVQuitaG/XEGQua+32nWr+40rOfGEXsLgTOD+n9nw6KYTIkx6D60k3",
-	"0Wz7DFyw9MKI2WXt995TaJPcRztf5VFztdz4xKpFARyyB/uEHHMbaeSda5qVjlqT83t6aP41zpqVNvez",
-	"U+zvn/N4yAYm9ZG35G9+mGGupsAwv1tOZQfZksZ03ZPkVtKrSNnZrj/daHeXdinQmqgsFDEp5Yapukad",
-	"765yP0L6QRXE4ddPmMmv9mKW1kaE0lJdGbIpvLyuTT/j6jH6DlvAC5U1QUVGz40cOJ/Z1fh1hZRgKb2U",
-	"0Fj+Nv2PW2DNl4ItUhg1aZZpExBbN7XmvgTKPfWi0pnF8dxVrWHaPsEx529XJafQZmjTsAaEY86lvKT5",
-	"p1erYT7HY8SHKy4fX2j4/g2RbFGpbubv94qOmjt4697d1PwtqgH/BmaPosZeN5Qz/lSVML2JDFPc05zk",
-	"oq6LjEOSKxzTWocffkVmLoqukJAyxVoBxle+qkn13MMiX87Hcq23vC+3rfNnoW9Bxu6BIArypq6QoAXe",
-	"DzWE9RH9zEyl5+RGqTxGfR2yiOAvxqPCdDZbrouLhtnYVpxp+UMKCXdsPg4cwXY0H3cT9YxdnjWRmkun",
-	"VNBd5+jbuoHbyEVdr22s70MXuUNp9Me4LMSrY5ju6DNhEYKlZQiCSn59+CuRMMfakYLs7eEEe3tT1/TX",
-	"R83P5jjv7UXFuE/mLWFx5MZw80YpxhnTOqEwsC6Y7En6984xd3dho/mOYAeIZ+fMIVoNBqf2fqOfOBU0",
-	"ytxbFfx2aa7xNn4WoMwvuZoohvuf+2IXrH9+T5hM6yyULM+2HcpG0FNd+RbDen5xAbmfpfbuL1aX3WWT",
-	"rv7hLj5y7QOAiImstTF5MFUQzjQiksl1i8QtIXGlpWR6g3nCvOqT/RL1qfm+spY4K3CVWcbJHVpcQJVp",
-	"rratlMpLNt8LmqMsYN4z6KGohcj3ybdruipycEzqm3uzv8Djr59kh48f/mX29eHTwxSePH12eEifPaEP",
-	"nz1+CI++fvrkEB7Ov3o2e5Q9evJo9uTRk6+ePksfP3k4e/LVs7/cM3eAAdkCOvFZKSZ/xwLVyfHbk+TM",
-	"AFvjhBbsB9jYWpiGjH2VTZoiF4QVZfnkyP/0vz1320/Fqh7e/zpxQe+TpdaFOjo4uLq62g+7HCxQmZpo",
-	"UabLAz9Ppwzn8duTKjzM+kLhjtrIH0MKuKmOFI7x27tvT8/I8duT/ZpgJkeTw/3D/YeYy7gATgs2OZo8",
-	"xp/w9Cxx3w98EuGjj9fTycESaI42cfPHCrRkqf+kruhiAXLflRs1P10+OvBi3MFHp0i+Hvp2EFbuOfjY",
-	"0LdnW3qio8vBR5/Earh1I0uUszOY5S5iBt3vwd0TzvUjYpdQqN60o0+JEtJp2wrJhDlJUxvdnkqgSPdC",
-	"YniWliVPrcLbTgEc//v6+O9o6Xh9/HfyDTmcuqg9hc+82PRWl1SRwElmwe6qTNXzzXFdsqROcXv0PvIk",
-	"iZZBxSNk6COg8GrEmoOhtTosHl3xY8NjD5NnHz4+/fo6did1y+97JAXGjBD1WvhET4i0FV1/04eytT0d",
-	"uIbfSpCbehErup6EAHftXxGvtjlblBI1iHWMfuWv66phMkX+6/THN0RI4nQKb2l6ETrwxcBx91kIkS9O",
-	"5sLBVmpRNGMnKhx+wMwvCAWe4keHhzsVCG45F3WpyJWVp96/rqvBUwTWNNX5hlC8fzbW1KTKWZ2lqSkK",
-	"aFEk4QDRV/LAjL6+UcyxfVclYiS4D+sIDcPXztLeQIfzjsJ6atvNqx1kRCH4ELu9w631NPJld/81drcr",
-	"DJBCmDPNMHi0vk/yrpuiCop3OHB77CP75L9FiSKbrWMJsVSTOAPakvyczsAb+LflWEW0ws7eXnvhe3tu",
-	"z5kic7hCDko5NmyjY28PC58/2ZGVDarmGxEYo87OLsN1Nus1XVcZ/ihWsOBYZvESSPDYfHL48E+7whOO",
-	"3kVG1iRWlr6eTp7+ibfshBupheYEW9rVPP7TruYU5CVLgZzBqhCSSpZvyE+8CtAP0kV22d9P/IKLK+4R",
-	"YZ6J5WpF5cZJyLTiOSUPUiYM8p+OYbaWopGL0oVCGx7Kn5NGOWG+mHy49gL+yFfDULODGWYMGtsUVNC4",
-	"/+mBxhh18BHNCb2/H7g0KvGPaNaxb9YD70QWb9l41XzUawNrq0dKdbosi4OP+B98QwZg2aDFLrg2bOMA",
-	"k8dtuj9veBr9sTtQux507OeDj80ySw2EqmWpM3EV9EWDhbW2deerKvQ2/j64okwbCcF5AmIO2W5nDTQ/",
-	"cIkGWr/WsX2dLxiwGPzYkikKYXPBNN9q7+hVKKFYaQGUfi6yzQC3WSczxvEIhiyiVoXZj933QYcxnC3B",
-	"pl73ltyIAKYFmUlBs5QqTE3qUnJ0Xn3Xt3x8tOTG9UnETodg4kO661RmDtP2gpg47hgJK9iXIKM3SrrK",
-	"qtD+YKmkA9FzmhGfPCghr2luNhwyLMMlMWQuAPmPlig+vwjwme/sT3bJPveHTxGKbjON11HD7c5WX/P+",
-	"Oe6gjrlRzRPKMIAF8MSxoGQmso1PUC/plV5bJ5s2czuo0gBGP96Bju2fW7G2TZ/2RY31RY31RdHxRY31",
-	"ZXe/qLG+KHm+KHn+v1Xy7KLZicmQTrPRL0piwlTamNc+3GgdJlax+LDZlDBdCVzdrO1M7xNyhkE41NwS",
-	"cAmS5ljZRgVRdSt0x1RlmgJkR+c8aUBinR7NxPfr/1pv0/Py8PAxkMMH7T5KszwPeXO3Lwqz+MkmDfqG",
-	"nE/OJ52RJKzEJWQ25jwMSrC9tg77v6pxf+zEN2FY6JJeQhVGQVQ5n7OUWZTngi8IXYja8crwbcIFfsEK",
-	"xi57AWF66nK/MEWuzOJd2tpm7ERTLO9KACf1Fm61drfIJW7oNoS3o5X7P8aYuP91RfCbBnTdlksOjt1h",
-	"mV9YxqdgGZ+dafzZ7YeB4u9fUoZ8cvjkT7ugUE38RmjyHbr4307WqvJ8xyLhbypF+aTxXlFXu6qGrp94",
-	"RVZOn+8/mIsAy0G527P2ZDw6OMBY26VQ+mBi7raml2P48UMFs6/GMCkku8Tkjh+u/18AAAD//yvqI+RP",
-	"3wAA",
+	"H4sIAAAAAAAC/+x9/XPcNpLov4I3d1WOdTOS/JHsWlWpe4qdZHWxE5flze49yy+LIXtmsOIAXAKUZuKn",
+	"//1VNwASJEEOR1LszZ5/sjXER6PRaDT688MkUetcSZBGT04+THJe8DUYKOgvniSqlGYmUvwrBZ0UIjdC",
+	"ycmJ/8a0KYRcTqYTgb/m3Kwm04nka5ichP2nkwL+UYoC0smJKUqYTnSygjXHgc02x9bVSJvZUs3cEKd2",
+	"iLMXk5uBDzxNC9C6C+VPMtsyIZOsTIGZgkvNE/yk2bUwK2ZWQjPXmQnJlASmFsysGo3ZQkCW6kO/yH+U",
+	"UGyDVbrJ+5d0U4M4K1QGXTifq/VcSPBQQQVUtSHMKJbCghqtuGE4A8LqGxrFNPAiWbGFKnaAaoEI4QVZ",
+	"ricn7yYaZAoF7VYC4or+uygAfoWZ4cUSzOT9NLa4hYFiZsQ6srQzh/0CdJkZzagtrXEprkAy7HXIXpXa",
+	"sDkwLtmb756zJ0+ePMOFrLkxkDoi611VPXu4Jtt9cjJJuQH/uUtrPFuqgst0VrV/891zmv/cLXBsK641",
+	"xA/LKX5hZy/6FuA7RkhISANL2ocG9WOPyKGof57DQhUwck9s43vdlHD+T7orCTfJKldCmsi+MPrK7Oco",
+	"Dwu6D/GwCoBG+xwxVeCg745nz95/eDR9dHzzb+9OZ//H/fnlk5uRy39ejbsDA9GGSVkUIJPtbFkAp9Oy",
+	"4rKLjzeOHvRKlVnKVvyKNp+vidW7vgz7WtZ5xbMS6UQkhTrNlkoz7sgohQUvM8P8xKyUGWhNozlqZ0Kz",
+	"vFBXIoV0yoRk1yuRrFjCtR2C2rFrkWVIg6WGtI/W4qsbOEw3IUoQrlvhgxb0z4uMel07MAEb4gazJFMa",
+	"ZkbtuJ78jcNlysILpb6r9H6XFXu7AkaT4wd72RLuJNJ0lm2ZoX1NGdeMM381TZlYsK0q2TVtTiYuqb9b",
+	"DWJtzRBptDmNexQPbx/6OsiIIG+uVAZcEvL8ueuiTC7EsixAs+sVmJW78wrQuZIamJr/HRKD2/5f5z/9",
+	"yFTBXoHWfAmveXLJQCYq7d9jN2nsBv+7Vrjha73MeXIZv64zsRYRkF/xjViXaybL9RwK3C9/PxjFCjBl",
+	"IfsAsiPuoLM133QnfVuUMqHNradtCGpISkLnGd8esrMFW/PN18dTB45mPMtYDjIVcsnMRvYKaTj3bvBm",
+	"hSplOkKGMbhhwa2pc0jEQkDKqlEGIHHT7IJHyP3gqSWrABwhd4Aj5DhwJGwiNINHF7+wnC8hIJlD9mfH",
+	"ueirUZcgKwbH5lv6lBdwJVSpq049MNLUw+K1VAZmeQELEaGxc4cOzTizbRx7XTsBJ1HScCEhZUJaoJUB",
+	"y4l6YQomHH7MdK/oOdfw1dPJza6vI3d/odq7Prjjo3abGs3skYzci/jVHdi42NToP+LxF86txXJmf+5s",
+	"pFi+xatkITK6Zv6O++fRUGpiAg1E+ItHi6Xkpizg5EIe4F9sxs4NlykvUvxlbX96VWZGnIsl/pTZn16q",
+	"pUjOxbIHmRWs0dcUdVvbf3C8ODs2m+ij4aVSl2UeLihpvErnW3b2om+T7Zj7EuZp9ZQNXxVvN/6lsW8P",
+	"s6k2sgfIXtzlHBtewrYAhJYnC/pnsyB64oviV/wnzzPsbfJFDLVIx+6+Jd2A0xmc5nkmEo5IfOM+41dk",
+	"AmBfCbxucUQX6smHAMS8UDkURthBeZ7PMpXwbKYNNzTSvxewmJxM/u2oVq4c2e76KJj8JfY6p04oj1oZ",
+	"Z8bzfI8xXqNcoweYBTJo+kRswrI9koiEtJuIpCSQBWdwxaU5nExjZ7I+wO/cTDW+rShj8d16X/UinNmG",
+	"c9BWvLUNH2gWoJ4RWhmhlaTNZabm1Q9fnOZ5jUH6fprnFh8kGoIgqQs2Qhv9kJbP65MUznP24pB9H45N",
+	"crZC3dEcnKiBd8PC3VruFqsUR24N9YgPNKPtRE3MzbRCg9Zg7oPi6M2wUhlKPTtpBRv/ybUNyQx/H9X5",
+	"90FiIW77iQtbMYc5+4ChX4KXyxctyukSjtPlHLLTdt/bkQ2OEieYW9HK4H7acQfwWKHwuuC5BdB9sXep",
+	"kPQCs40srHfkpiMZXRTm+nNIawTVrc/azvMQhQQ/tGH4JlPJ5Z+4Xt3DmZ/7sbrHj6ZhK+ApFGzF9epw",
+	"EpMywuNVjzbmiGFDer2zeTDVYbXE+1rejqWl3PDDSRveuFhiUU/9iOlBEXm7/ET/4RnDz3i2ufHvctRJ",
+	"CDqiKrAgpPiUtw8EOxM2wI03iq3t653hq3svKJ/Xk8f3adQefWsVBm6H3CJoh9Tm3o/BN2oTg+Ebtekc",
+	"AbUBfR/0oTb2P8LAWo+A74WDTNH+O/TxouDbLpJp7DFIxgWi6KrpNMjwxsdZas3r6VwVt+M+LbYiWa1P",
+	"ZhxHDZjvtIUkalrmM0eKEZ2UbdAaqDbhDTON9vAxjDWwcG74b4AFbXgA/B2w0BzovrGg1rnI4B5IfxVl",
+	"+qgkePKYnf/p9MtHj395/OVXSJJ5oZYFX7P51oBmX7i3GdNmm8HD7sqmE/t0jo/+1VOvhWyOGxtHq7JI",
+	"YM3z7lBWu2lFINuMYbsu1ppoplVXAI45nG8BOblFO7OKewTtBVy9UimQxuIeaLGWdd2aMkiX4HVvnKVw",
+	"BRluH1urFBj+jwbu0umAMJ1xA9rE5rmT6IzYEJprDev5vZBmH/mk9Swpc/uSws6jte9m19Nsww0vtkV5",
+	"Hw97KApVRLSNtJFGJSqbXUGhhYoYjl67Fsy18MJ+3v7dQsuuuXa0AikrZdrY6Xpi1HCPvgXt0G83ssbN",
+	"4D1o1xtZnZt3zL40ke/1qprlaJTbSJbCvFw23oWLQq3x3FBHkli+B0OC0VuxhnPD1/lPi8Vthfnu2bIC",
+	"khFr0Dg2UzS4FW9bh1eqNHK/2A7xwWsThoZEyRQt6+YanMxYTUryQ4KLSUojrhxQesThdpP3nO7vwZxv",
+	"ZXJ7XjeaQ62FJFOR3sokePvfE6Oads2wDXryel471QMdAQcJ6U/AM7N6A/lthbGh0xUOHn00RSb3XKCS",
+	"OYxiD77/9i07KoCn2wekkbA/rKj7UQqGi0w/wOW8pNWeS57rlboXucpfXtqNOSBV7VT90P3ux0F2Zjia",
+	"fHhU2zOd+KYz0TOqqG4+33QESYWjTodvQodNww28gMzweyeQ9gQxInnu+aPbiBQbkqrtpViuTPCKfV0o",
+	"tbh/GGOzxAClD5ZJZtinqwn4UaXIrU2p74Ey68HqKwRJIbw4+FyVhnHi0qS2LXX8LdDj+0NOB+QrYcLn",
+	"hVnZZ/0ckMskvMTVohlGxS7kuuOMJ5YOZ5aZ77ogbCs7nfUryYgJsDmAZGru7JHOUkqL5OTGYPy5cC+R",
+	"6PEK4MoLlYDWqPK1irydoPl29m42A3giwAngahamFVvw4s7AXl7thPMStjNyutHsix9+1g8/AbxGGZ7t",
+	"QCy1iaG30ioJ2QP1uOmHCK49eUh2vMCryFItM4oeTxkY6EPhXjjp3b82RJ1dvDtarqAg8+9vSvF+krsR",
+	"UAXqb0zvd4W2zHtcSZ02BYV13DDJpXKyb3Qw4EUmQJsZv+Ii4/MMZgOihW8dMS85nrji9mYguraeZI7E",
+	"3crsEIUdQbNrKPAVVkp0s1OF/VsqwxZgkhWk1UN+JN1nXJvZrmsGG4UDatyRgLPHbhYauAc1L7k21gVD",
+	"yJQ0xxYJNI9FFU7RD3DvuxVH/tk/Wbtj05tF6lJX71dd5ijhQhpbA/rt9M/1I2yqudQiGLt6JBvFSg27",
+	"Ru7DUjC+Q5aTvukPbkJSQh+l7uLInodyyzaKygYQNSKGADn3rQLshu6BPYAIXSPaEo7QLcqpfBKnE21U",
+	"niP3M7NSVv360HRuW5+aP9dtu8TFTX3mUgWazoxr7yC/9mcMXzF4Lh0cbM0vUZYiLaL1FenCjMxlpoVM",
+	"YDZE+aQTwFbhEdjBdHoUuM71PJitdTha9Bslul4i2LELfQvueay85oURichJ8v0Btvf+EGhPELVxMvsa",
+	"hZQFH+yjIA/7M+v80x7zdg+DUaquLvgdXVdkOZnQdAE2gb+ELb3AXluv0reBL+o9vGwio+Lp5pIRoN5X",
+	"DQWysAlseGKyLbO33dZeW7qcr4Ux1k24+fAxKp+FA0SNKgMzOgui9cj0OzDGpHlOQwXL627FdGIlxGH4",
+	"3rbExAY6nGSYK5WN0Ap0kBGFYJSzCcsV7rpwXuneddlTUgNIJ5RlWw8uMs8HuoFmWgH7b1WyhEsSwEsD",
+	"1Y2gCmKzdP3iDEIHczq3khpDkMEa7LuCvhwctBd+cOD2XGi2gGsfynFw0EXHwQG96l8rbRqH6x7Uinjc",
+	"ziK8naxNeFE4ya3NU3a7NbiRx+zk69bgflI6U1o7wsXl35kBtE7mZszaQxoZ59JhNiNXHqwnum7a9zeQ",
+	"KZ7aGIZ7Uryr0iRqbcNAWOKiIyz6C5qty8VoCuh5G1SGeHq/rLhc4usGjBFyqZ1sR2zSjRJjZYFlpcWi",
+	"CiDz8KxG8W1hIAcSVIYyWCwgMUzJBGphyk2k9wGvtfUeTRGoe+SJc7Eus/s6z4nSpLhZiFhk68XFuyS/",
+	"uHjPfsrRCMiwNXOtq5Nu7cuaAT5vibt5a0J4EJaFKvOpZ2/LXwVJl9VrYV4uFlBo75qD2jSUlXOc7JBR",
+	"JHDlk0d+PIgl0DhbFXbrAauc+nBbas/8rYFGVN///eI/TzCaj89+PZ49+4+j9x+e3jw86Pz4+Obrr/9f",
+	"86cnN18//M9/jz1tFlxkZQH9/gsXF+8Wa8Tod7aldz2a+ishxNl1Hbi2cLJbWZDzI8vI8lQoniZcm6i9",
+	"kViCXM4q93kdBWetEZy/uFuLy20r1HosDGwOCS81BDKOg6B24NeHkfdD60C0URhdyEjDE8btEcvqUCLT",
+	"1Skinkmq/t9GT18PHYOyO3HgvVl/7HPgxDdptr0HLm8HYgXkBWiEv6Gb0varWoQRku4s6602sO6q723X",
+	"X3oeg2/8U6rzMlcyExJmayVhG00KICS8oo+x3lYa6ulMcmlf3/ZTswF/C6zmPGOo8a74pd0Orv/Xlefy",
+	"fVzxrXFblpswNpQ0k5DljLMkEyCtxsMUZWIuJCdNQnDYIh5eXj/Sr1t67pvElVkRXZMb6kJyutAr/UKU",
+	"Ly4gwpe/g8rAq8vl0t4sjTQSABfStRKSlVIYmmuN+zWzG5ZDQW5Wh7blmm/ZAmMcjWK/QqHYvDRN5koh",
+	"bNqgpsqakXAaphYXkhuWAdeGvRLoBYLD+VvV04wEc62KywoLcVvtEiRooWdxT7Tv7VdyEnbLXzmHYfy/",
+	"6+wUtB/9NvWwi7QX8rMX7gV+9oKeWbXloQP7R9PSYlRmlMhC54sWbbEvpDIVAT2sTTtu1y8keuAYhYHq",
+	"IuXmduTQZnGds2hPR4tqGhvRUrr5te75eLkDl2ERJtNijbe+xrvOm/FQRtxIH52IrdiilHYrMbifzHEU",
+	"qePFUrWYVuGqNk3NCaNYxhX3HqDuz8dffjWZ1jGI1ffJdOK+vo9Qskg3sUjTFDaxN6k7IHQwHmiW862G",
+	"Hk8Pgj3qIWc9CsJh14DKDL0S+cfnFNqIeZzD+fgHp9vayDNpAxPw/JBhbev022rx8eE2BUAKuVnF0lc0",
+	"JAVqVe8mQMvZASOUQE6ZOITDtm4pXYL2vnoZ8AUSqDWmjHLMqc6BJTRPFQHWw4WMUuDE6IeEW8etb6YT",
+	"d/nre5fH3cAxuNpz9vt8OYaJzl03PgI2DFON6Gzth6YbjGHcJe2xUd8X8kK+gIWQAr+fXMiUG34051ok",
+	"+qjUUHzDMy4TOFwqduKDu15wwy9kVwHTl1crCKtjeTnPRIJ68xh52lwp0Wcjao/x4dj2COjKr26qKH+x",
+	"E8zwCa9KM3PJIGYFXPMijYCuq2QANDL1Hpx1ytzY9KMbn7nx4zyP57luBwV3l5/nGS4/IEPtQl5xy5g2",
+	"qvCyiNAeGtrfH5W7GAp+7TOJlBo0+9ua5++ENO/Z7KI8Pn4CrBEl+zd35SNNbnNo6JxuFbTcVpvRwu27",
+	"Bjam4LOcL3uUBgY46YWsvLymR3aWMeoW4qSKPqCh6gV4fPRvgIVj70hDWty57eWzesWXQJ9oC6kNihu1",
+	"efa2+xXE6956u1oxv51dKs1qhmc7uiqNJO53pkr2s+RCam8zRzUKaWVsXqQ5Kj8huYSUUrTAOjfbaaO7",
+	"WjQETc86hLapjGy0HeXbIEMIpjjKU+5E8ZZCCTHsdKw06Bu4hO1bVafr2CfTQTPwXvcdVKLUQLpEYg2P",
+	"rRujvfnOlwkh5Xnu49cpkNGTxUlFF75P/0G2Iu89HOIYUTQCw/sQwYsIIqhDHwpusVAc706kH1sevjLm",
+	"9uaLZD7yvJ+5JvXjybnphKt5u6q+r4HyoqlrzeYc5XblUnrZ4PKAi5WoieyRkENb1MgQ7ob9igbZde9F",
+	"bzq0fjcvtM59EwXZNp7hmqOUAvgFSYUeMy1nMz+TNXdaBarVzzuEzTMSkyqvPMt0eNGwCcrlEGhxAoZC",
+	"1gKHB6OJkVCyWXHts42l0+Asj5IBfsNkCUMpcs4Cv6Ig81ql+PY8t31OO69LlyjHZ8fxKXHCp+WI9DbT",
+	"iXPNjm2HkiQApZDB0i7cNvaEUiduqDcI4fhpsciEBDaLuShxrVUiiBUF14ybA1A+PmDMqoDZ6BFiZByA",
+	"TWZ8Gpj9qMKzKZf7ACld4gnuxyYHgOBviEeFWSdkFHlUjixcyB53d88BuPNrq+6vlrcoDcOEnDJkc1c8",
+	"A2n8i68epJOphcTWVl4W50jysE+cHdDA24tlrzVRj1utJpSZPNBxgW4A4rnazGyQbFTinW/mSO9Rv2zs",
+	"FT2YNifOA83makPOSXS1WD/gHbD0w+HBqAGgZCe4durXd5tbYIamHZamYlSo2ReVbFOTS584MWbqHgmm",
+	"j1y+CNLc3AqAtj2+yonlHr87H6lN8aR7mde32rRO3+ZDXmLHv+8IRXepB39dLUyVmMapEN5Aooq0X0+B",
+	"hCpMlWG7q16w7WbIN0anrhnI9n3afG34J0R353p8aBrw1PMMIOKFDdjqQPLtJlcatAvooqveDe7kxAJs",
+	"MLy2Ois0TmdOMOhDU2zB3oPPY9wuuU4J6AccJzvHNrfnkT8ES57H4djnpfLG4WcAip5TXsOBDe4KiUsj",
+	"NAjLTT99vG6L9tGD0mjVSl4VvLVitwOST9ea2bWZasiAXs+zxmtjdgnbuBIASDQ7990CLR+lyOJy+zDw",
+	"cCxgKbSB2tokdI3pj63H55SZU6lF/+pMXixwfW+UquQ56mi1+I1lfvQVXCkDs4Uo0BcdTXXRJWCj7zRp",
+	"n77DpvFHRWOzmU1SLdL4JUrTYoxRKrIyTq9u3h9e4LQ/1sHu5ZwEEyEZ8GTF5pRUPepZPTC1db4fXPBL",
+	"u+CX/N7WO+40YFOcuEByac7xOzkX7UDpAXYQIcAYcXR3rRelAxdoEB/d5Y7BA8MeTrpOD4fMFJ3DlPqx",
+	"d/pX+SjtPmHOjjSwFnIN6nVljzjkWD8yy9TreirRSGapzKyh/Iigq1LwaHI3FZLJ5gbLpZ8mHpyn7Lt6",
+	"1NCu7Y4B5fjx5O7hnBA8yzBPx+6QAU4Y9woc8oywI5DrDaPgG+/jsVuq7+5AjbBqpW0Yo9TSkW6GDLf1",
+	"08hlOK3f1kSwiDsrZY633qGE5umtpu+u6S7PZ6h4iAa1/SWIWuN5TikffONYgBcOJtCdIA6O/TSNVT3p",
+	"Ku9LIc1XT/2o95F8tzXO+GWHKWrHoIDEOX2LBL/9b8xgl0I09y+qhyj9jMOMmAavXna1dNqhvp5rnOe5",
+	"SDctu6cdtVc7fi8YowvKDbYDAwFtxMIlC9CNfQ+UebZARiMz4OEozLxtJhAOZZpwKqF9eacuoqrw8F24",
+	"wuRZP8D2Z2xLy5ncTCd3M5PGcO1G3IHr19X2RvFMbnjWbNbwetgT5TxH5xaezZwxuY80C3XlSJOae9vz",
+	"R5bW4lzv7benL1878NFelwEvZtVrp3dV1C7/3azKZkHuOSC+fMyKm0o/Z1/DweZXqVtDA/T1ClypjuBB",
+	"3ckpXjsX1ON5g/Qi7g2807zs/CDsEgf8ISCv3CFqUx11bnlAVJkTrA5bDKhk7eLG3Y1RrhAOcGdPivAu",
+	"uld20znd8dNRU9cOnhTONVBMZG3r5WimZNtdDl/BOIMlVfTinoOzgHSZkyzXZDWY6UwkcXuqnFOIjbR+",
+	"MtiYUeOe9zSOWIoetytZimAsbDYm81wLyGCOKDJ1NEdejbu5chFXpRT/KIGJFKTBTwWdytZBJf2ps6x3",
+	"r9O4VOkGpj7B8HeRMcJs+O0bz8lcQwJG6JXTAfdFpfXzC62sT1x6aX1f575wxs6VOOCY5+jDUbMNVFg1",
+	"vWtGS+g7iyJ6/ZtLy98zR7TIodCzRaF+hbiqijR8kVhqNxEJU9R7REhZbcmpazXWs/dud590E3xkTYfE",
+	"HqqnnQ9ccCiA0VujubRbbeN1G37tcYIJWugjO35NMA7mTtRNxq/nPLmMCxkIU2B+adjNjWK+s8e9s9EI",
+	"V5LhkAV+Y1VbYbOM5FDUaQ66GdhuKTDYaUeLCrVkgB0bMsHU+vpkWkWGKeU1lwZ8oQl7lFxvDVZ/j72u",
+	"VUE5gnTcxJ9CItZR5dLFxbs06ZpzU7EUtnBbqSGoDOYGshUvLRW56mrWna5GzdmCHU+D2oNuN1JxJbSY",
+	"Z0AtHtkWaNOitfmzXHXB5YE0K03NH49oviplWkBqVtoiVitWCXX0vKkcVXxu1WNq9+gZ+4JcdLS4goeI",
+	"RXc/T04ePSMDq/3jOHYBuAqNQ9wkXYRBrnE6Jh8lOwYybjfqYVQbYMvq9jOugdNku445S9TS8brdZ2nN",
+	"JV9C3Ct0vQMm25d2k2wBLbzI1NaE1KZQWyZ6wo3BcORPPZFmyP4sGCxR67Uwa+fIodUa6aku+2Un9cPZ",
+	"tGAuLNzD5T+SP1Tu3UFaj8iPa/ex91ts1eS19iNfQxOtU8ZtYigM//eeir6ODDvzefQoA0FVucLiBufC",
+	"pZOYg1tI6eOFNPSwKM1i9keWrHjBE2R/h33gzuZfPY2U7Wimj5f7Af7R8V6AhuIqjvqih+y9DOH6Yuyd",
+	"nK0FsvqHdWRncCp7Hbei05o+P6HhoccKZTjKrJfcyga58YBT34nw5MCAdyTFaj170ePeK/volFkWcfLg",
+	"Je7Qn9+8dFLGWhWx5Lj1cXcSRwGmEHAFae8m4Zh33IsiG7ULd4H+0xpPvcgZiGX+LPc+BPax+ARvA7L5",
+	"hJ6Jt7H2NC09DZkrtoH0YaQFxFal3mX3uEu9ukbnfaByXUZC16NEaATAtjC23wv47iqGwOTT2KE+HDWX",
+	"FqPMb1Rkyb7IUWXjcRGTEb1V3wWCH5BBzd1QU9YsKPPxPWq8WaTr2YFfPKz0RxvYT8xsCMl+BT2bGBS7",
+	"im5nWn0PnMs4+0Ztxm5qi3f7jf0nQE0UJaXI0p/r3CDNFc4LLpNV1Flkjh1/qaseV4uzhzmaHXnFpbTe",
+	"CJ3h7CvlF/+aiby3/q7GzrMWcmTbdnkzu9zW4mrAm2B6oPyEiF5hMpwgxGoz7UIV1pctVcponjp1bX2v",
+	"d8viBeV6KBVX7F6kDza0wFDtZ6Ri6sRApqTHOGTfUwA0wtLIrEn6A5ulCdKq2gWZeso8UzydMhwHbVDM",
+	"zmr72DxhtlrN0l67jVX0++fu42g75Ft7HxF9tozUrCo7E0tRgi3e+gZMtKxL9LAOsXPIXlidhvYvZjuJ",
+	"TehXrCENSutYqZpoAv9jDKcM20Y1WGo/yY8vs+SpUgeF3t3/k4oS7blDuF2lJVtoacoUSg7XAtNmrbiB",
+	"K2hmRfFgeDHAZ0lpLq8opbSUEpWKh1JY3QbtHjgatzJARSFrIX5P6cW5qe9ZdeqcesWIslPCqlPh3ebY",
+	"qApxvvI1+rlUUiSUeTV2NVMGh3HW2RFJauORAc7fRk8ihytaOKsK1nBY7C2lNZ00ENc1DwVfcVMtddg/",
+	"DWxcvv8lGO04G0Ysump4TkMtpAaXehyJKOSTqmhYvIlDRp0oajl5TzKi4OwelcN3+O1Hp5DCI8guhS18",
+	"59BmCVpYHTLV5Tf4XhWGLRVot55mhhr9DvscUrKWFDbvD30dfxrDGoxx2dY7ojvUqfeVcL4J2PY5trUJ",
+	"9eqfG3FwdtLTPHeT9tdKjGf53MheBEds3pWjV4DcavxwtAFyG3RyovsUCQ3zcjJtIGcuNKanUl4rCMZm",
+	"80SKohbM+kfHkBJ3E30ppLdpxC+IJHol0MbQee3pp5OCm2TVYEO7XCPILyLG0LRxRrG7DtXaYOdPmicT",
+	"P0f/NtZF/noYR9WgFty43DJ/KJC6A2HiOQbHeaeTbsk+kqqcEOWCa5pF/GKMAxm3T8jZvAB25uKtupuC",
+	"J9DoO+Im6ktVMi/TJRhMgxHTJ3xDXxl99elKYUP1+lzO+zxnCFQ7VWGX2txEiZK6XA/M5RvccbqgKmaE",
+	"GsLKnH6HkdJQ1Yn/7pcl2bkH7e1j732B0ip8bh+5uTlSR+pFmsZEr7PxmKA75e7oqKe+HaHX/e+V0jO1",
+	"bALykROUDXG5cI9i/O1bvDjC/F2dKgb2aqnSa5E7qPKV3enZWCWGaXIlH3XamTPIvDysgOivAT2ly68n",
+	"riXQ9XJ7v1q7dl90S9IbjMWNy59gOBtkQb0x6davjL5bKOI6/T5fMutKhp87vcdJhh05m8YeRKh3UuwC",
+	"9IP3gGY5F85po2YWXcy6cK9+deHQoas3uL0IF0TVq7GzpUGfY8akvlu7lTDfpuSwzkvU2eZbqnT58Uq1",
+	"vRnET9mqXHNJKdLpzQmbPOOS+8vGR0OW/YbfKN7qVB8+RUjOSWF9zQtKDMpFFskREtd4usH6Eehqrw7h",
+	"Tt8Ca7bNaN7b3cxoNQGebvuNFjLYXweiTZQe9xy5VeXw9qIjUnFPohYaF7g2DotbRxuMr5VcBkAfTqZ3",
+	"2niHeY+uTqqDGCn8cNUXPOhj6ul7u3LwJbgEZXkBV0KV3rXI+5569Yr9lRzxGjH6vbykizqa6tOaFHoN",
+	"IG9d4S67TEchP/xsPZUZSFNs/wnMIZ1N75TWjeX/bhTWdQ+VqO7WjJU7X1TVeS+vZmuVDiUf+OFn9sLb",
+	"aUfxEU/IsdRlKnXlLKOJF1664kO+Gb7kRk/7ynU6zfPhqXuyLXQntw33nb4vbRuezyEN9mt/flt13Xs5",
+	"nE8NIGFj4qX6OpHl13RBAuWNDpIE9GeiGUtQLmCYND8zZLEwgOEwA6JrOxLJbzcvsf24xBXxktD96Zvr",
+	"lM3EPHOlRV0WLlYreqT7/lsq9xxY37tjed/ZK0iMKho+gQXAPsmocTJv2/ycxrlf6VhFOXj6H0jZPJ2E",
+	"vCUa9OuOF6/TTZGFmtwXIoKZbRNh9q6zwEOCBnw3BP6w4JmOV8nsdRxvZREKnL8iSdPjCztLd+PSL2ca",
+	"+BOJdBiR8aiaU+uF8y+JTBsjcr/o7FSLHH6hd5KYBIl4+h4RO1NUOSGU9msJkuyRKVvEULM7wpDqiomr",
+	"HUlj/mJrXvmEJFNvVSFYFkEOGVFFrFFy3v1thjVAGb8lPBm/P3D64q0vYftAswY1RKsMTr1wf5u8rIQB",
+	"urVQ8MiV5lmfGdg5YQpdUQZhwXvY2+5QZ7jvLe8cyDm3nMuTZFPiGZjyShm45VzYda+sehR81ZdXpltg",
+	"tV97+ILq2Wrnb8qrvK6hjh3NhZ2iai4vLKX4qTwffIZY0P43n8/LzpKJSwgLUJOfCaUjcS16CiBam8xs",
+	"QE7qZFKIVoKjPHR+ZlHHQ3Vj57t7bD0Jk0xRFbW+0MFmCFLlMvlAW0drElOoqhvBtYCisBSALXFsmBnl",
+	"3VSH4BhChSZv8lshQffWMLHA9WYWflOnTqZaTjbxjCvL2FggK2DNEboiSHDcP+cQsp/b7z5YPFIwsWdc",
+	"T6+znRmKfSSc0B0khlS/YO623B2EfhtTkZASipn3G2n750ooQuAoB15aJvaCDg9GZU4bnfxvgJVErSxJ",
+	"d5UdhXlGmfVfBik9LmF7ZPUvtoBonaowhN6K9nYNQRbA1m7fqxUtbjDIlnYBy3uB81NaoqaTXKls1uO8",
+	"cNZN2tw+A5cCSx4wvDt8DElPiWf2BdnMK++069XWJynOc5CQPjxk7FTaqD3vqNasGtaaXD4wQ/NvaNa0",
+	"tHnUnZHs8ELGw58oQVZxR/7mhxnmahpkeuep7CDDE5lNT8JorEDQLXje9U0d7TrWLkJdE5WFIial3DLt",
+	"3ajz3TWURUg/qCg6/PoJs2LWEQGFtbeStFRXWW0KL69q89O42qa+ww7wQmVN3a7iRg6cT+y2/6pCSrCU",
+	"XkpoLH+X/sctsOZLwRZpikDGZdpk3tbls7kvgXJPP690ZnE8d1VrlAJTScqf3VXJabK/25TGAeHguSyu",
+	"ePbx1WqUG/WU8AHpm36BJ3z/hki2qNS38519yUfNnfHfYGosYXgF8i+AexR1nHBDOeNPVVXWm8ioXATP",
+	"WKaWQZ1udLO/pjFpp9mjr9jcRaTmBSRCi1aw/rWvEFQ996hgnp0Cte3D78td6/xZmTuQsV2WUTn7sa42",
+	"YhTdDzWE9RH9xEyl5+RGqTxGfR2yiOAvxqPC1FA7rovLhguGrd7U8i1WBdyzK0bgVLmnK0Y36dXY5dE6",
+	"6NIpNXTXOfq2buA2clHXaxvrR9RF7lBJijHuP/FKM9id/I8sQrDRISNQ2d8e/Y0VQNX4jWIHBzTBwcHU",
+	"Nf3b4+ZnPM4HB1Ex7qN5HlkcuTHcvFGKcca0TlgZbHJR9CTQfOOYu7uwyXzHqAPEM91mEK2sRFN7H+yP",
+	"e5FamXungt8uzTXexc8ClPklVxPFcP9zXxyQjXXpCTlrnQWMTtt1KBsBhHUVaQqR+8UFt3+SOta/WF12",
+	"l01aWPfyN20fAEJMZK2NyYOpgtDAEVGBrlskBpCIKykLYbaUc8+rPsUvUZ+a7ytribMCV1manNxh1CVU",
+	"WRtr20qpvWTzveIZyQL4niFvX4P1m9i3G77OM3BM6usH8z/Akz8+TY+fPPrD/I/HXx4n8PTLZ8fH/NlT",
+	"/ujZk0fw+I9fPj2GR4uvns0fp4+fPp4/ffz0qy+fJU+ePpo//erZHx5MphOBIFtAJ95zbvJXKvY+O319",
+	"NnuLwNY44blAgxTVlUUy9hVreUJcENZcZJMT/9P/9tztMFHrenj/68QlkJisjMn1ydHR9fX1YdjlaEnK",
+	"1JlRZbI68vN0Stqevj6rQi2tLxTtqI2iQ1I4nNSkcErf3nx7/padvj47rAlmcjI5Pjw+fITjqxwkz8Xk",
+	"ZPKEfqLTs6J9P3LENjn5cDOdHFmXs8YfR05d7n5cgylE4v/ynnb4f33Nl0soDl1tX/zp6vGRl/OOPjhN",
+	"883Qt6PgTsef679mIt3Rkzxhjj74jHHDrRsp2ZwhAvGxjFl8vwd3kTjfkIjhQpP+044+ZVoVTh2XF0Lh",
+	"UZvaVBJJAZwOhiooFtIUpUysRtxOAZL+++r0r2QKeXX6V/Y1JgazIbKa3oGx6a2yqaKRs9SC3dWp6m+2",
+	"p3V9oDqf9Mm7yJslWnOYzhgSUHAEqhFrFkfm7LBSe8WwkQkfz569//DlH29il1bnSVEhKbB2hKg3ymdV",
+	"I6St+ebrPpRt7PGhNfyjhGJbL2LNN5MQ4K6BLOL2thDLsiAVY50Qo3KOtyyXCc3+6/ynH5kqmFM6vMZ8",
+	"e4GHXwwcd+GFEHlvTxd7udbLvBmoVOHw/XTioaBj/vj4eK9q3C3voy4V4aK4ZNw74HVVfJrBhidosuN0",
+	"QW2tLUqX8zolWlNWMCqfhQNEn9EDMzp861gUyb5axkgkLRXtGoavXRKhgQ7nPkXFC3fbXzvIiELwPna9",
+	"h1vraeTz7v5r7G5XWmC5wjMtKFK7vk+yrh+jDirlOHB7DCiH7L9VSTKdLRoLsbyuNIPQwZzOAlxjCDIq",
+	"2Vth5+CgvfCDA7fnQrMFXBMH5ZIattFxcHCIO/V0T1Y2qLtvhDuNOjv7DNfZrFd8U6XT5FQuRlJN0ytg",
+	"wWv06fGj3+0KzyS5H6EwyqywfTOdfPk73rIzaaCQPGPU0q7mye92NedQXIkE2FtY56rghci27M+yyoYR",
+	"5Gbtsr8/y0uprqVHBL4jy/WaF1snIfOK55QyyE8yyH86lttaiiYuytEs/m5i5c9Jo3a3XE7e33gBf+Sr",
+	"YajZ0Vxt9mgKOmjc//Qga40++kD2ht7fj1zOovhHsvvYR+2R9zKLt2y8aj6YDcLa6pFwk6zK/OgD/Yce",
+	"mQFYtG/LowIyxdP6Zxs43F1FCldrlYIDo+/3I55ecZmA6697BzhSi4V1uR36fPTB/hsZRkue65UyeuDT",
+	"0Qf/3+ZO7Wh4VIB2GmvXwca6HFH2ym33561Moj92sdguSB/7+ehD488m6HpVmlRdB33JykM7H9m1KvKs",
+	"8ffRNRcGpSbnPklJrLudDfDsyGU6af1aBxd3vlDEdPBjS87KlU1G1Xy/vuHXodRmJSjQ5huVbgc48GY2",
+	"F5LYUsg2a/2h/dh9M91MI6Ysqv3gzd8RodQoNi8UTxOuDf7hcgJ1XsI3d3yQtWTpzVnEuElgknKh64mH",
+	"DGZ3RV4ad4zUGexLUFKApH9t9Y6/saTWgegbnjKfvWzGXvEMNxwDpNx7oIGN31rK+vRi0SeWYz6a4PGN",
+	"P3yacfI1arwYG76Ktvyjd2pyB3WMlIHPSmQAS5Azx4Jmc5VufYWMgl+bjfVMajO3oyoPafTjPegd/7mV",
+	"jbt0jJ9Ve59Ve5+VP59Ve59397Nq77Pi67Pi63+s4msfbVdMhnTann5RkjI2c2Y6Dzdex9ZVLD5sNmXC",
+	"VAJXt2yEMIcMS6cUQL7QGvP58oxKa+kgFHFNPqy6TBKA9ORCzhqQWE9RnPiL+r/WRfeiPD5+Auz4YbuP",
+	"NiLLQt7c7UvCLH2yWcu+ZheTi0lnpALWCiuWU6B+GMlhe+0c9n9V4/7UCQqjWNoVv4Iq9oTpcrEQibAo",
+	"z5RcMr5Utbca8m0mFX2hEuou5QMTZuoS5mDeVly83ZVWwElTLO9KAGf1Fu70AGiRS9z4j4S3p+X/P8aY",
+	"/f91RfDbRsHdlUsOjn0z/cwyPgHL+ORM4/duUw0Uf/+SMuTT46e/2wWFauIflWHf4WG4o6xVFRqIpQ+4",
+	"rRTlq1Z4RV3t3xv6y9IVWXnKvnuPFwHVo3O3Z+3+eXJ0RAHKK6XN0eRmGn7TrY/vK5h9OZhJXogrhObm",
+	"/c3/HwBITmxpM+wAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
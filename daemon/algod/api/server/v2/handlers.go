@@ -88,6 +88,7 @@ type LedgerForAPI interface {
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error)
 	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
+	EarliestAvailableRound() basics.Round
 }
 
 // NodeInterface represents node fns used by the handlers.
@@ -98,6 +99,7 @@ type NodeInterface interface {
 	GenesisHash() crypto.Digest
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	Simulate(txgroup []transactions.SignedTxn) (vb *ledgercore.ValidatedBlock, missingSignatures bool, err error)
+	SimulateWithProfile(txgroup []transactions.SignedTxn) (vb *ledgercore.ValidatedBlock, missingSignatures bool, profile *simulation.CostProfile, err error)
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
@@ -112,6 +114,14 @@ type NodeInterface interface {
 	SetSyncRound(rnd uint64) error
 	GetSyncRound() uint64
 	UnsetSyncRound()
+	GenerateDevModeBlock(timestamp int64) (basics.Round, error)
+	SnapshotLedger() (uint64, basics.Round, error)
+	RestoreLedgerSnapshot(id uint64) (basics.Round, error)
+	DeleteLedgerSnapshot(id uint64) error
+	SetBlockTimeStampOffset(offset int64) error
+	GetBlockTimeStampOffset() (*int64, error)
+	AdvanceDevModeRounds(rounds uint64) (basics.Round, error)
+	ReloadConfigFromDisk() (applied []string, restartRequired []string, err error)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -337,6 +347,20 @@ func (v2 *Handlers) ShutdownNode(ctx echo.Context, params model.ShutdownNodePara
 	return ctx.String(http.StatusNotImplemented, "Endpoint not implemented.")
 }
 
+// ReloadConfig reads the configuration file again, and applies the changes of the settings which can change
+// while the node is running.
+// (POST /v2/config/reload)
+func (v2 *Handlers) ReloadConfig(ctx echo.Context) error {
+	applied, restartRequired, err := v2.Node.ReloadConfigFromDisk()
+	if err != nil {
+		return internalError(ctx, err, fmt.Sprintf(errFailedReloadingConfig, err), v2.Log)
+	}
+	response := model.ReloadConfigResponse{Applied: []string{}, RestartRequired: []string{}}
+	response.Applied = append(response.Applied, applied...)
+	response.RestartRequired = append(response.RestartRequired, restartRequired...)
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // AccountInformation gets account information for a given account.
 // (GET /v2/accounts/{address})
 func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params model.AccountInformationParams) error {
@@ -608,7 +632,7 @@ func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params model.GetBlo
 		if err != nil {
 			switch err.(type) {
 			case ledgercore.ErrNoEntry:
-				return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+				return v2.blockNotFound(ctx, basics.Round(round), err)
 			default:
 				return internalError(ctx, err, err.Error(), v2.Log)
 			}
@@ -623,7 +647,7 @@ func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params model.GetBlo
 	if err != nil {
 		switch err.(type) {
 		case ledgercore.ErrNoEntry:
-			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+			return v2.blockNotFound(ctx, basics.Round(round), err)
 		default:
 			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 		}
@@ -644,6 +668,16 @@ func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params model.GetBlo
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
+// blockNotFound reports the lookup of a block which isn't in the ledger, telling the rounds which were pruned apart
+// from the ones which weren't reached yet.
+func (v2 *Handlers) blockNotFound(ctx echo.Context, round basics.Round, err error) error {
+	earliest := v2.Node.LedgerForAPI().EarliestAvailableRound()
+	if round < earliest {
+		return gone(ctx, err, fmt.Sprintf(errBlockPruned, earliest), v2.Log)
+	}
+	return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
 // GetBlockHash gets the block hash for the given round.
 // (GET /v2/blocks/{round}/hash)
 func (v2 *Handlers) GetBlockHash(ctx echo.Context, round uint64) error {
@@ -652,7 +686,7 @@ func (v2 *Handlers) GetBlockHash(ctx echo.Context, round uint64) error {
 	if err != nil {
 		switch err.(type) {
 		case ledgercore.ErrNoEntry:
-			return notFound(ctx, err, errFailedLookingUpLedger, v2.Log)
+			return v2.blockNotFound(ctx, basics.Round(round), err)
 		default:
 			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
 		}
@@ -679,7 +713,12 @@ func (v2 *Handlers) GetTransactionProof(ctx echo.Context, round uint64, txid str
 	ledger := v2.Node.LedgerForAPI()
 	block, _, err := ledger.BlockCert(basics.Round(round))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return v2.blockNotFound(ctx, basics.Round(round), err)
+		default:
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
 	}
 
 	proto := config.Consensus[block.CurrentProtocol]
@@ -791,6 +830,10 @@ func (v2 *Handlers) GetStatus(ctx echo.Context) error {
 		CatchpointTotalBlocks:       &stat.CatchpointCatchupTotalBlocks,
 		CatchpointAcquiredBlocks:    &stat.CatchpointCatchupAcquiredBlocks,
 	}
+	if stat.Catchpoint == "" {
+		earliest := uint64(v2.Node.LedgerForAPI().EarliestAvailableRound())
+		response.EarliestAvailableRound = &earliest
+	}
 
 	return ctx.JSON(http.StatusOK, response)
 }
@@ -896,7 +939,7 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 
 // SimulateTransaction simulates broadcasting a raw transaction to the network, returning relevant simulation results.
 // (POST /v2/transactions/simulate)
-func (v2 *Handlers) SimulateTransaction(ctx echo.Context) error {
+func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params model.SimulateTransactionParams) error {
 	if !v2.Node.Config().EnableExperimentalAPI {
 		// Right now this is a redundant/useless check at runtime, since experimental APIs are not registered when EnableExperimentalAPI=false.
 		// However, this endpoint won't always be experimental, so I've left this here as a reminder to have some other flag guarding its usage.
@@ -921,7 +964,13 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context) error {
 	var res model.SimulationResponse
 
 	// Simulate transaction
-	_, missingSignatures, err := v2.Node.Simulate(txgroup)
+	var missingSignatures bool
+	var profile *simulation.CostProfile
+	if params.Profile != nil && *params.Profile {
+		_, missingSignatures, profile, err = v2.Node.SimulateWithProfile(txgroup)
+	} else {
+		_, missingSignatures, err = v2.Node.Simulate(txgroup)
+	}
 	if err != nil {
 		var invalidTxErr *simulation.InvalidTxGroupError
 		var evalErr *simulation.EvalFailureError
@@ -935,6 +984,15 @@ func (v2 *Handlers) SimulateTransaction(ctx echo.Context) error {
 		}
 	}
 	res.MissingSignatures = missingSignatures
+	if profile != nil {
+		var buf bytes.Buffer
+		err = profile.WritePprof(&buf)
+		if err != nil {
+			return internalError(ctx, err, err.Error(), v2.Log)
+		}
+		costProfile := buf.Bytes()
+		res.CostProfile = &costProfile
+	}
 
 	// Return msgpack response
 	msgpack, err := encode(protocol.CodecHandle, &res)
@@ -1051,6 +1109,9 @@ func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
 func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64) error {
 	sDelta, err := v2.Node.LedgerForAPI().GetStateDeltaForRound(basics.Round(round))
 	if err != nil {
+		if basics.Round(round) < v2.Node.LedgerForAPI().EarliestAvailableRound() {
+			return v2.blockNotFound(ctx, basics.Round(round), err)
+		}
 		return internalError(ctx, err, errFailedRetrievingStateDelta, v2.Log)
 	}
 	consensusParams, err := v2.Node.LedgerForAPI().ConsensusParams(basics.Round(round))
@@ -1059,7 +1120,12 @@ func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64) error {
 	}
 	hdr, err := v2.Node.LedgerForAPI().BlockHdr(basics.Round(round))
 	if err != nil {
-		return internalError(ctx, fmt.Errorf("unable to retrieve block header for round %d", round), errInternalFailure, v2.Log)
+		switch err.(type) {
+		case ledgercore.ErrNoEntry:
+			return v2.blockNotFound(ctx, basics.Round(round), err)
+		default:
+			return internalError(ctx, fmt.Errorf("unable to retrieve block header for round %d", round), errInternalFailure, v2.Log)
+		}
 	}
 
 	response, err := stateDeltaToLedgerDelta(sDelta, consensusParams, hdr.RewardsLevel, round)
@@ -1469,6 +1535,106 @@ func (v2 *Handlers) AbortCatchup(ctx echo.Context, catchpoint string) error {
 	return v2.abortCatchup(ctx, catchpoint)
 }
 
+// devModeError writes the response for an error returned by a developer mode
+// operation of the node.
+func (v2 *Handlers) devModeError(ctx echo.Context, err error, errFormat string) error {
+	msg := fmt.Sprintf(errFormat, err)
+	switch {
+	case errors.Is(err, node.ErrNotDevMode), errors.Is(err, node.ErrInvalidDevModeArgument):
+		return badRequest(ctx, err, msg, v2.Log)
+	case errors.Is(err, node.ErrSnapshotNotFound):
+		return notFound(ctx, err, msg, v2.Log)
+	default:
+		return internalError(ctx, err, msg, v2.Log)
+	}
+}
+
+// GenerateDevModeBlock writes a developer mode block with the transactions of the pool.
+// (POST /v2/devmode/blocks)
+func (v2 *Handlers) GenerateDevModeBlock(ctx echo.Context, params model.GenerateDevModeBlockParams) error {
+	var timestamp int64
+	if params.Timestamp != nil {
+		if *params.Timestamp > math.MaxInt64 {
+			return badRequest(ctx, nil, errInvalidBlockTimestamp, v2.Log)
+		}
+		timestamp = int64(*params.Timestamp)
+	}
+	rnd, err := v2.Node.GenerateDevModeBlock(timestamp)
+	if err != nil {
+		return v2.devModeError(ctx, err, errFailedGeneratingDevModeBlock)
+	}
+	return ctx.JSON(http.StatusOK, model.DevModeRoundResponse{Round: uint64(rnd)})
+}
+
+// AdvanceDevModeRounds writes empty developer mode blocks.
+// (POST /v2/devmode/blocks/advance/{rounds})
+func (v2 *Handlers) AdvanceDevModeRounds(ctx echo.Context, rounds uint64) error {
+	if rounds > node.MaxAdvanceDevModeRounds {
+		return badRequest(ctx, nil, fmt.Sprintf(errTooManyDevModeRounds, node.MaxAdvanceDevModeRounds), v2.Log)
+	}
+	rnd, err := v2.Node.AdvanceDevModeRounds(rounds)
+	if err != nil {
+		return v2.devModeError(ctx, err, errFailedAdvancingDevModeRounds)
+	}
+	return ctx.JSON(http.StatusOK, model.DevModeRoundResponse{Round: uint64(rnd)})
+}
+
+// SetBlockTimeStampOffset sets the timestamp offset of the following developer mode blocks.
+// (POST /v2/devmode/blocks/offset/{offset})
+func (v2 *Handlers) SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error {
+	if offset > math.MaxInt64 {
+		return badRequest(ctx, nil, errInvalidBlockTimestampOffset, v2.Log)
+	}
+	err := v2.Node.SetBlockTimeStampOffset(int64(offset))
+	if err != nil {
+		return v2.devModeError(ctx, err, errFailedSettingBlockTimestampOffset)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetBlockTimeStampOffset gets the timestamp offset of developer mode blocks.
+// (GET /v2/devmode/blocks/offset)
+func (v2 *Handlers) GetBlockTimeStampOffset(ctx echo.Context) error {
+	offset, err := v2.Node.GetBlockTimeStampOffset()
+	if err != nil {
+		return v2.devModeError(ctx, err, errFailedRetrievingBlockTimestampOffset)
+	}
+	if offset == nil {
+		return notFound(ctx, fmt.Errorf("block timestamp offset is not set"), errBlockTimestampOffsetNotSet, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.GetBlockTimeStampOffsetResponse{Offset: uint64(*offset)})
+}
+
+// SnapshotLedger records the current state of the ledger of a developer mode node.
+// (POST /v2/devmode/snapshots)
+func (v2 *Handlers) SnapshotLedger(ctx echo.Context) error {
+	id, rnd, err := v2.Node.SnapshotLedger()
+	if err != nil {
+		return v2.devModeError(ctx, err, errFailedSnapshottingLedger)
+	}
+	return ctx.JSON(http.StatusOK, model.LedgerSnapshotResponse{SnapshotId: id, Round: uint64(rnd)})
+}
+
+// RestoreLedgerSnapshot returns the ledger of a developer mode node to a snapshot.
+// (POST /v2/devmode/snapshots/{snapshot-id}/restore)
+func (v2 *Handlers) RestoreLedgerSnapshot(ctx echo.Context, snapshotID uint64) error {
+	rnd, err := v2.Node.RestoreLedgerSnapshot(snapshotID)
+	if err != nil {
+		return v2.devModeError(ctx, err, errFailedRestoringLedgerSnapshot)
+	}
+	return ctx.JSON(http.StatusOK, model.DevModeRoundResponse{Round: uint64(rnd)})
+}
+
+// DeleteLedgerSnapshot forgets a ledger snapshot of a developer mode node.
+// (DELETE /v2/devmode/snapshots/{snapshot-id})
+func (v2 *Handlers) DeleteLedgerSnapshot(ctx echo.Context, snapshotID uint64) error {
+	err := v2.Node.DeleteLedgerSnapshot(snapshotID)
+	if err != nil {
+		return v2.devModeError(ctx, err, errFailedDeletingLedgerSnapshot)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
 // CompileResponseWithSourceMap overrides the sourcemap field in
 // the CompileResponse for JSON marshalling.
 type CompileResponseWithSourceMap struct {
@@ -1535,7 +1701,7 @@ func (v2 *Handlers) GetStateProof(ctx echo.Context, round uint64) error {
 
 	tx, err := GetStateProofTransactionForRound(ctxWithTimeout, ledger, basics.Round(round), ledger.Latest(), v2.Shutdown)
 	if err != nil {
-		return v2.wrapStateproofError(ctx, err)
+		return v2.wrapStateproofError(ctx, basics.Round(round), err)
 	}
 
 	response := model.StateProofResponse{
@@ -1551,7 +1717,11 @@ func (v2 *Handlers) GetStateProof(ctx echo.Context, round uint64) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
-func (v2 *Handlers) wrapStateproofError(ctx echo.Context, err error) error {
+func (v2 *Handlers) wrapStateproofError(ctx echo.Context, round basics.Round, err error) error {
+	var noEntry ledgercore.ErrNoEntry
+	if errors.As(err, &noEntry) {
+		return v2.blockNotFound(ctx, round, err)
+	}
 	if errors.Is(err, ErrNoStateProofForRound) {
 		return notFound(ctx, err, err.Error(), v2.Log)
 	}
@@ -1573,7 +1743,7 @@ func (v2 *Handlers) GetLightBlockHeaderProof(ctx echo.Context, round uint64) err
 
 	stateProof, err := GetStateProofTransactionForRound(ctxWithTimeout, ledger, basics.Round(round), ledger.Latest(), v2.Shutdown)
 	if err != nil {
-		return v2.wrapStateproofError(ctx, err)
+		return v2.wrapStateproofError(ctx, basics.Round(round), err)
 	}
 
 	lastAttestedRound := stateProof.Message.LastAttestedRound
@@ -1582,6 +1752,10 @@ func (v2 *Handlers) GetLightBlockHeaderProof(ctx echo.Context, round uint64) err
 
 	lightHeaders, err := stateproof.FetchLightHeaders(ledger, stateProofInterval, basics.Round(lastAttestedRound))
 	if err != nil {
+		// the headers are fetched from the first attested round, which may be pruned even if the requested one isn't
+		if basics.Round(firstAttestedRound) < ledger.EarliestAvailableRound() {
+			return v2.blockNotFound(ctx, basics.Round(firstAttestedRound), err)
+		}
 		return notFound(ctx, err, err.Error(), v2.Log)
 	}
 
@@ -83,6 +83,8 @@ func (l *mockLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, er
 
 func (l *mockLedger) Latest() basics.Round { return l.latest }
 
+func (l *mockLedger) EarliestAvailableRound() basics.Round { return 0 }
+
 func (l *mockLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ar ledgercore.AssetResource, err error) {
 	ad, ok := l.accounts[addr]
 	if !ok {
@@ -23,11 +23,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
+	pprofile "github.com/google/pprof/profile"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -49,6 +51,7 @@ import (
 	"github.com/algorand/go-algorand/data/stateproofmsg"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
@@ -129,6 +132,100 @@ func TestGetBlock(t *testing.T) {
 	getBlockTest(t, 0, "bad format", 400)
 }
 
+// prunedLedger is a ledger which reports the blocks of the rounds before earliest as pruned
+type prunedLedger struct {
+	v2.LedgerForAPI
+	earliest basics.Round
+}
+
+func (l prunedLedger) EarliestAvailableRound() basics.Round {
+	return l.earliest
+}
+
+func (l prunedLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if rnd < l.earliest {
+		return bookkeeping.BlockHeader{}, ledgercore.ErrNoEntry{Round: rnd, Latest: l.Latest(), Committed: l.Latest()}
+	}
+	return l.LedgerForAPI.BlockHdr(rnd)
+}
+
+func (l prunedLedger) BlockCert(rnd basics.Round) (bookkeeping.Block, agreement.Certificate, error) {
+	if rnd < l.earliest {
+		return bookkeeping.Block{}, agreement.Certificate{}, ledgercore.ErrNoEntry{Round: rnd, Latest: l.Latest(), Committed: l.Latest()}
+	}
+	return l.LedgerForAPI.BlockCert(rnd)
+}
+
+func (l prunedLedger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error) {
+	if rnd < l.earliest {
+		return ledgercore.StateDelta{}, fmt.Errorf("round %d not in deltas", rnd)
+	}
+	return l.LedgerForAPI.GetStateDeltaForRound(rnd)
+}
+
+func TestGetPrunedBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	handler.Node = makeMockNode(prunedLedger{LedgerForAPI: handler.Node.LedgerForAPI(), earliest: 5}, t.Name(), nil)
+
+	for _, format := range []string{"json", "msgpack"} {
+		for _, test := range []struct {
+			round        uint64
+			expectedCode int
+		}{{2, http.StatusGone}, {10, http.StatusNotFound}} {
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			err := handler.GetBlock(c, test.round, model.GetBlockParams{Format: (*model.GetBlockParamsFormat)(&format)})
+			require.NoError(t, err)
+			require.Equal(t, test.expectedCode, rec.Code)
+			if test.expectedCode == http.StatusGone {
+				require.Contains(t, rec.Body.String(), "the earliest available round is 5")
+			}
+		}
+	}
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	require.NoError(t, handler.GetBlockHash(c, 2))
+	require.Equal(t, http.StatusGone, rec.Code)
+}
+
+func TestGetPrunedRoundLookups(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	handler, _, _, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	insertRounds(a, handler, 10)
+	handler.Node = makeMockNode(prunedLedger{LedgerForAPI: handler.Node.LedgerForAPI(), earliest: 5}, t.Name(), nil)
+
+	var txid transactions.Txid
+	for name, lookup := range map[string]func(c echo.Context, round uint64) error{
+		"delta":        handler.GetLedgerStateDelta,
+		"state proof":  handler.GetStateProof,
+		"light header": handler.GetLightBlockHeaderProof,
+		"transaction proof": func(c echo.Context, round uint64) error {
+			return handler.GetTransactionProof(c, round, txid.String(), model.GetTransactionProofParams{})
+		},
+	} {
+		lookup := lookup
+		t.Run(name, func(t *testing.T) {
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			require.NoError(t, lookup(c, 2))
+			require.Equal(t, http.StatusGone, rec.Code)
+			require.Contains(t, rec.Body.String(), "the earliest available round is 5")
+		})
+	}
+}
+
 func TestGetLedgerStateDelta(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -223,6 +320,225 @@ func TestSyncRound(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, mockNode)
 }
 
+func TestReloadConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	// ReloadConfig 200
+	c, rec := newReq(t)
+	mockCall := mockNode.On("ReloadConfigFromDisk").Return([]string{"CatchupParallelBlocks"}, []string(nil), nil)
+	err := handler.ReloadConfig(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response model.ReloadConfigResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, model.ReloadConfigResponse{Applied: []string{"CatchupParallelBlocks"}, RestartRequired: []string{}}, response)
+	mockCall.Unset()
+	// ReloadConfig 500
+	c, rec = newReq(t)
+	mockCall = mockNode.On("ReloadConfigFromDisk").Return([]string(nil), []string(nil), fmt.Errorf("malformed config.json"))
+	err = handler.ReloadConfig(c)
+	require.NoError(t, err)
+	require.Equal(t, 500, rec.Code)
+	mockCall.Unset()
+}
+
+func TestDevModeLedgerSnapshots(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	// GenerateDevModeBlock 200
+	c, rec := newReq(t)
+	timestamp := uint64(1600000000)
+	mockCall := mockNode.On("GenerateDevModeBlock", int64(timestamp)).Return(3, nil)
+	err := handler.GenerateDevModeBlock(c, model.GenerateDevModeBlockParams{Timestamp: &timestamp})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var roundResponse model.DevModeRoundResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &roundResponse))
+	require.Equal(t, uint64(3), roundResponse.Round)
+	mockCall.Unset()
+	// GenerateDevModeBlock 400 timestamp out of range
+	c, rec = newReq(t)
+	timestamp = math.MaxUint64
+	err = handler.GenerateDevModeBlock(c, model.GenerateDevModeBlockParams{Timestamp: &timestamp})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	// GenerateDevModeBlock 400 not in developer mode
+	c, rec = newReq(t)
+	mockCall = mockNode.On("GenerateDevModeBlock", int64(0)).Return(0, node.ErrNotDevMode)
+	err = handler.GenerateDevModeBlock(c, model.GenerateDevModeBlockParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
+
+	// SnapshotLedger 200
+	c, rec = newReq(t)
+	mockCall = mockNode.On("SnapshotLedger").Return(1, 3, nil)
+	err = handler.SnapshotLedger(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var snapshotResponse model.LedgerSnapshotResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &snapshotResponse))
+	require.Equal(t, model.LedgerSnapshotResponse{SnapshotId: 1, Round: 3}, snapshotResponse)
+	mockCall.Unset()
+
+	// RestoreLedgerSnapshot 200
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RestoreLedgerSnapshot", uint64(1)).Return(3, nil)
+	err = handler.RestoreLedgerSnapshot(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	mockCall.Unset()
+	// RestoreLedgerSnapshot 404
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RestoreLedgerSnapshot", uint64(2)).Return(0, node.ErrSnapshotNotFound)
+	err = handler.RestoreLedgerSnapshot(c, 2)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+	mockCall.Unset()
+	// RestoreLedgerSnapshot 500
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RestoreLedgerSnapshot", uint64(1)).Return(0, fmt.Errorf("unknown error"))
+	err = handler.RestoreLedgerSnapshot(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 500, rec.Code)
+	mockCall.Unset()
+
+	// DeleteLedgerSnapshot 200
+	c, rec = newReq(t)
+	mockCall = mockNode.On("DeleteLedgerSnapshot", uint64(1)).Return(nil)
+	err = handler.DeleteLedgerSnapshot(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	mockCall.Unset()
+	// DeleteLedgerSnapshot 404
+	c, rec = newReq(t)
+	mockCall = mockNode.On("DeleteLedgerSnapshot", uint64(1)).Return(node.ErrSnapshotNotFound)
+	err = handler.DeleteLedgerSnapshot(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+	mockCall.Unset()
+}
+
+func TestDevModeBlockTimeStampOffset(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	// SetBlockTimeStampOffset 200
+	c, rec := newReq(t)
+	mockCall := mockNode.On("SetBlockTimeStampOffset", int64(10)).Return(nil)
+	err := handler.SetBlockTimeStampOffset(c, 10)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	mockCall.Unset()
+	// SetBlockTimeStampOffset 400 offset out of range
+	c, rec = newReq(t)
+	err = handler.SetBlockTimeStampOffset(c, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	// SetBlockTimeStampOffset 400 not in developer mode
+	c, rec = newReq(t)
+	mockCall = mockNode.On("SetBlockTimeStampOffset", int64(10)).Return(node.ErrNotDevMode)
+	err = handler.SetBlockTimeStampOffset(c, 10)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
+	// SetBlockTimeStampOffset 400 more than the protocol allows
+	c, rec = newReq(t)
+	mockCall = mockNode.On("SetBlockTimeStampOffset", int64(26)).Return(fmt.Errorf("%w: too large", node.ErrInvalidDevModeArgument))
+	err = handler.SetBlockTimeStampOffset(c, 26)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
+
+	// GetBlockTimeStampOffset 200
+	c, rec = newReq(t)
+	offset := int64(10)
+	mockCall = mockNode.On("GetBlockTimeStampOffset").Return(&offset, nil)
+	err = handler.GetBlockTimeStampOffset(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var offsetResponse model.GetBlockTimeStampOffsetResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &offsetResponse))
+	require.Equal(t, uint64(10), offsetResponse.Offset)
+	mockCall.Unset()
+	// GetBlockTimeStampOffset 404 not set
+	c, rec = newReq(t)
+	mockCall = mockNode.On("GetBlockTimeStampOffset").Return((*int64)(nil), nil)
+	err = handler.GetBlockTimeStampOffset(c)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+	mockCall.Unset()
+	// GetBlockTimeStampOffset 400 not in developer mode
+	c, rec = newReq(t)
+	mockCall = mockNode.On("GetBlockTimeStampOffset").Return((*int64)(nil), node.ErrNotDevMode)
+	err = handler.GetBlockTimeStampOffset(c)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
+
+	// AdvanceDevModeRounds 200
+	c, rec = newReq(t)
+	mockCall = mockNode.On("AdvanceDevModeRounds", uint64(5)).Return(5, nil)
+	err = handler.AdvanceDevModeRounds(c, 5)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var roundResponse model.DevModeRoundResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &roundResponse))
+	require.Equal(t, uint64(5), roundResponse.Round)
+	mockCall.Unset()
+	// AdvanceDevModeRounds 500
+	c, rec = newReq(t)
+	mockCall = mockNode.On("AdvanceDevModeRounds", uint64(5)).Return(2, fmt.Errorf("unknown error"))
+	err = handler.AdvanceDevModeRounds(c, 5)
+	require.NoError(t, err)
+	require.Equal(t, 500, rec.Code)
+	mockCall.Unset()
+	// AdvanceDevModeRounds 400 too many rounds, the node is not called
+	c, rec = newReq(t)
+	err = handler.AdvanceDevModeRounds(c, node.MaxAdvanceDevModeRounds+1)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	mockNode.AssertNotCalled(t, "AdvanceDevModeRounds", uint64(node.MaxAdvanceDevModeRounds+1))
+}
+
 func addBlockHelper(t *testing.T) (v2.Handlers, echo.Context, *httptest.ResponseRecorder, transactions.SignedTxn, func()) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
 
@@ -413,6 +729,7 @@ func TestGetStatus(t *testing.T) {
 	err := handler.GetStatus(c)
 	require.NoError(t, err)
 	stat := cannedStatusReportGolden
+	earliest := uint64(0)
 	expectedResult := model.NodeStatusResponse{
 		LastRound:                   uint64(stat.LastRound),
 		LastVersion:                 string(stat.LastVersion),
@@ -432,6 +749,7 @@ func TestGetStatus(t *testing.T) {
 		CatchpointTotalKvs:          &stat.CatchpointCatchupTotalKVs,
 		CatchpointProcessedKvs:      &stat.CatchpointCatchupProcessedKVs,
 		CatchpointVerifiedKvs:       &stat.CatchpointCatchupVerifiedKVs,
+		EarliestAvailableRound:      &earliest,
 	}
 	actualResult := model.NodeStatusResponse{}
 	err = protocol.DecodeJSON(rec.Body.Bytes(), &actualResult)
@@ -630,21 +948,37 @@ func TestPostTransaction(t *testing.T) {
 	postTransactionTest(t, 0, 200)
 }
 
-func simulateTransactionTest(t *testing.T, txnToUse, expectedCode int, enableTransactionSimulator bool) {
+func simulateTransactionTest(t *testing.T, txnToUse, expectedCode int, enableTransactionSimulator bool, profile bool) {
 	handler, c, rec, releasefunc := prepareTransactionTest(t, txnToUse, expectedCode, enableTransactionSimulator)
 	defer releasefunc()
-	err := handler.SimulateTransaction(c)
+	err := handler.SimulateTransaction(c, model.SimulateTransactionParams{Profile: &profile})
 	require.NoError(t, err)
 	require.Equal(t, expectedCode, rec.Code)
+	if expectedCode != http.StatusOK {
+		return
+	}
+
+	var res model.SimulationResponse
+	require.NoError(t, protocol.DecodeReflect(rec.Body.Bytes(), &res))
+	if !profile {
+		require.Nil(t, res.CostProfile)
+		return
+	}
+	require.NotNil(t, res.CostProfile)
+	parsed, err := pprofile.ParseData(*res.CostProfile)
+	require.NoError(t, err)
+	require.Len(t, parsed.SampleType, 2)
+	require.Equal(t, "cost", parsed.SampleType[0].Type)
 }
 
 func TestSimulateTransaction(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
 
-	simulateTransactionTest(t, -1, 400, true)
-	simulateTransactionTest(t, 0, 404, false)
-	simulateTransactionTest(t, 0, 200, true)
+	simulateTransactionTest(t, -1, 400, true, false)
+	simulateTransactionTest(t, 0, 404, false, false)
+	simulateTransactionTest(t, 0, 200, true, false)
+	simulateTransactionTest(t, 0, 200, true, true)
 }
 
 func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
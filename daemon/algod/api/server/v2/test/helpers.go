@@ -37,6 +37,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/node/indexer"
@@ -155,6 +156,46 @@ func (m *mockNode) GetSyncRound() uint64 {
 	return uint64(args.Int(0))
 }
 
+func (m *mockNode) GenerateDevModeBlock(timestamp int64) (basics.Round, error) {
+	args := m.Called(timestamp)
+	return basics.Round(args.Int(0)), args.Error(1)
+}
+
+func (m *mockNode) ReloadConfigFromDisk() ([]string, []string, error) {
+	args := m.Called()
+	return args.Get(0).([]string), args.Get(1).([]string), args.Error(2)
+}
+
+func (m *mockNode) SnapshotLedger() (uint64, basics.Round, error) {
+	args := m.Called()
+	return uint64(args.Int(0)), basics.Round(args.Int(1)), args.Error(2)
+}
+
+func (m *mockNode) RestoreLedgerSnapshot(id uint64) (basics.Round, error) {
+	args := m.Called(id)
+	return basics.Round(args.Int(0)), args.Error(1)
+}
+
+func (m *mockNode) DeleteLedgerSnapshot(id uint64) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *mockNode) SetBlockTimeStampOffset(offset int64) error {
+	args := m.Called(offset)
+	return args.Error(0)
+}
+
+func (m *mockNode) GetBlockTimeStampOffset() (*int64, error) {
+	args := m.Called()
+	return args.Get(0).(*int64), args.Error(1)
+}
+
+func (m *mockNode) AdvanceDevModeRounds(rounds uint64) (basics.Round, error) {
+	args := m.Called(rounds)
+	return basics.Round(args.Int(0)), args.Error(1)
+}
+
 func (m *mockNode) AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error {
 	m.id = id
 	m.keys = keys
@@ -195,6 +236,10 @@ func (m *mockNode) Simulate(txgroup []transactions.SignedTxn) (*ledgercore.Valid
 	return nil, false, m.err
 }
 
+func (m *mockNode) SimulateWithProfile(txgroup []transactions.SignedTxn) (*ledgercore.ValidatedBlock, bool, *simulation.CostProfile, error) {
+	return nil, false, &simulation.CostProfile{}, m.err
+}
+
 func (m *mockNode) GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
 	res = node.TxnWithStatus{}
 	found = true
@@ -57,6 +57,10 @@ func internalError(ctx echo.Context, internal error, external string, log loggin
 	return returnError(ctx, http.StatusInternalServerError, internal, external, log)
 }
 
+func gone(ctx echo.Context, internal error, external string, log logging.Logger) error {
+	return returnError(ctx, http.StatusGone, internal, external, log)
+}
+
 func notFound(ctx echo.Context, internal error, external string, log logging.Logger) error {
 	return returnError(ctx, http.StatusNotFound, internal, external, log)
 }
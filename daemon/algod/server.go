@@ -36,6 +36,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	apiServer "github.com/algorand/go-algorand/daemon/algod/api/server"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/lib"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/lib/middlewares"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/logging"
@@ -61,6 +62,14 @@ type Server struct {
 	metricCollector      *metrics.MetricService
 	metricServiceStarted bool
 	stopping             chan struct{}
+
+	// Sandbox makes the node a developer mode sandbox, see node.MakeSandbox.
+	Sandbox           bool
+	SandboxTimeOffset int64
+
+	// ConfigOverrides, if set, applies the command line settings to the configuration read from disk,
+	// when the configuration gets reloaded.
+	ConfigOverrides func(cfg *config.Local)
 }
 
 // Initialize creates a Node instance with applicable network services
@@ -171,7 +180,11 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 			NodeExporterPath:          cfg.NodeExporterPath,
 		})
 
-	s.node, err = node.MakeFull(s.log, s.RootPath, cfg, phonebookAddresses, s.Genesis)
+	if s.Sandbox {
+		s.node, err = node.MakeSandbox(s.log, s.RootPath, cfg, s.Genesis, s.SandboxTimeOffset)
+	} else {
+		s.node, err = node.MakeFull(s.log, s.RootPath, cfg, phonebookAddresses, s.Genesis)
+	}
 	if os.IsNotExist(err) {
 		return fmt.Errorf("node has not been installed: %s", err)
 	}
@@ -232,6 +245,11 @@ func (s *Server) Start() {
 		os.Exit(1)
 	}
 
+	if _, err = middlewares.ParseRateLimits(cfg.RestRateLimits); err != nil {
+		fmt.Printf("Invalid RestRateLimits: %v\n", err)
+		os.Exit(1)
+	}
+
 	s.stopping = make(chan struct{})
 
 	addr := cfg.EndpointAddress
@@ -255,8 +273,9 @@ func (s *Server) Start() {
 	}
 
 	e := apiServer.NewRouter(
-		s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
-		cfg.RestConnectionsSoftLimit)
+		s.log, s.node, s.stopping, apiToken, adminAPIToken,
+		tokens.MakeScopedTokenStore(s.RootPath, tokens.AlgodScopedTokensFilename), listener,
+		cfg.RestConnectionsSoftLimit, s.ReloadConfig)
 
 	// Set up files for our PID and our listening address
 	// before beginning to listen to prevent 'goal node start'
@@ -293,24 +312,52 @@ func (s *Server) Start() {
 	// Handle signals cleanly
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
-	signal.Ignore(syscall.SIGHUP)
+	// SIGHUP reloads the configuration
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
 
 	fmt.Printf("Node running and accepting RPC requests over HTTP on port %v. Press Ctrl-C to exit\n", addr)
-	select {
-	case err := <-errChan:
-		if err != nil {
-			s.log.Warn(err)
-		} else {
-			s.log.Info("Node exited successfully")
+	for {
+		select {
+		case err := <-errChan:
+			if err != nil {
+				s.log.Warn(err)
+			} else {
+				s.log.Info("Node exited successfully")
+			}
+			s.Stop()
+			return
+		case <-hup:
+			// the node logs the outcome of the reload
+			if _, _, err := s.ReloadConfig(); err != nil {
+				s.log.Warnf("Cannot reload config: %v", err)
+			}
+		case sig := <-c:
+			fmt.Printf("Exiting on %v\n", sig)
+			s.Stop()
+			os.Exit(0)
 		}
-		s.Stop()
-	case sig := <-c:
-		fmt.Printf("Exiting on %v\n", sig)
-		s.Stop()
-		os.Exit(0)
 	}
 }
 
+// ReloadConfig reads the configuration file again, and applies the changes of the settings which can change
+// while the node is running. It returns the names of the changed settings it applied, and of the ones which
+// only take effect once the node restarts.
+func (s *Server) ReloadConfig() (applied []string, restartRequired []string, err error) {
+	cfg, err := config.LoadConfigFromDisk(s.RootPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+	if s.ConfigOverrides != nil {
+		s.ConfigOverrides(&cfg)
+	}
+	if _, err = middlewares.ParseRateLimits(cfg.RestRateLimits); err != nil {
+		return nil, nil, fmt.Errorf("invalid RestRateLimits: %w", err)
+	}
+	applied, restartRequired = s.node.ReloadConfig(cfg)
+	return applied, restartRequired, nil
+}
+
 // Stop initiates a graceful shutdown of the node by shutting down the network server.
 func (s *Server) Stop() {
 	// close the s.stopping, which would signal the rest api router that any pending commands
@@ -20,6 +20,8 @@ import (
 	"crypto/subtle"
 	"net/http"
 
+	"github.com/gorilla/mux"
+
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/util/tokens"
 )
@@ -29,7 +31,7 @@ const (
 	KMDTokenHeader = "X-KMD-API-Token"
 )
 
-func authMiddleware(log logging.Logger, apiToken string) func(http.Handler) http.Handler {
+func authMiddleware(log logging.Logger, apiToken string, scopedTokens *tokens.ScopedTokenStore) func(http.Handler) http.Handler {
 	// Make sure no one is trying to call us with an invalid token
 	err := tokens.ValidateAPIToken(apiToken)
 	if err != nil {
@@ -51,6 +53,31 @@ func authMiddleware(log logging.Logger, apiToken string) func(http.Handler) http
 				return
 			}
 
+			// Check the scoped tokens, against the matched route like algod
+			if scopedTokens != nil && len(providedToken) > 0 {
+				route := r.URL.Path
+				if current := mux.CurrentRoute(r); current != nil {
+					if template, err := current.GetPathTemplate(); err == nil {
+						route = template
+					}
+				}
+				err := scopedTokens.Authorize(string(providedToken), r.Method, route, false)
+				switch err {
+				case nil:
+					next.ServeHTTP(w, r)
+					return
+				case tokens.ErrScopedTokenForbidden:
+					errorResponse(w, http.StatusForbidden, err)
+					return
+				case tokens.ErrScopedTokenRateLimited:
+					errorResponse(w, http.StatusTooManyRequests, err)
+					return
+				case tokens.ErrScopedTokenExpired, tokens.ErrScopedTokenRevoked:
+					errorResponse(w, http.StatusUnauthorized, err)
+					return
+				}
+			}
+
 			// Token was incorrect, return an error
 			errorResponse(w, http.StatusUnauthorized, errInvalidAPIToken)
 		})
@@ -26,3 +26,5 @@ var errCouldNotDecode = fmt.Errorf("could not decode request body")
 var errCouldNotDecodeAddress = fmt.Errorf("could not decode address")
 var errCouldNotDecodeTx = fmt.Errorf("could not decode transaction")
 var errInvalidAPIToken = fmt.Errorf("invalid API token")
+var errNotHDWalletDriver = fmt.Errorf("only the hd wallet driver accepts hd seeds and account keys")
+var errHDAccountKeyLength = fmt.Errorf("hd account key must be 64 bytes")
@@ -30,6 +30,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/tokens"
 )
 
 // reqContext is passed to each of the handlers below via wrapCtx, allowing
@@ -171,8 +172,29 @@ func postWalletHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 		walletName = walletID
 	}
 
-	// Create the wallet via its driver
-	err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	// Create the wallet via its driver. Only the hd driver creates wallets
+	// from a seed or an account key
+	if len(req.HDSeed) != 0 || len(req.HDAccountKey) != 0 {
+		hdDriver, ok := walletDriver.(*driver.HDWalletDriver)
+		if !ok {
+			errorResponse(w, http.StatusBadRequest, errNotHDWalletDriver)
+			return
+		}
+		if len(req.HDAccountKey) != 0 {
+			var accountKey crypto.BIP32Ed25519PublicKey
+			if len(req.HDAccountKey) != len(accountKey.PublicKey)+len(accountKey.ChainCode) {
+				errorResponse(w, http.StatusBadRequest, errHDAccountKeyLength)
+				return
+			}
+			copy(accountKey.PublicKey[:], req.HDAccountKey)
+			copy(accountKey.ChainCode[:], req.HDAccountKey[len(accountKey.PublicKey):])
+			err = hdDriver.CreateWatchOnlyWallet(walletName, walletID, []byte(req.WalletPassword), req.HDAccount, accountKey)
+		} else {
+			err = hdDriver.CreateWalletFromSeed(walletName, walletID, []byte(req.WalletPassword), req.HDSeed)
+		}
+	} else {
+		err = walletDriver.CreateWallet(walletName, walletID, []byte(req.WalletPassword), req.MasterDerivationKey)
+	}
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
 		return
@@ -364,6 +386,186 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	successResponse(w, resp)
 }
 
+// postAccountKeyExportHandler handles `POST /v1/account-key/export`
+func postAccountKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/account-key/export ExportAccountKey
+	//---
+	//    Summary: Export the extended public key of an hd wallet account
+	//    Description: >
+	//      Export the extended public key of a BIP44 account of an hd wallet: the public key at
+	//      m/44'/283'/account' followed by its chain code. It derives the public keys of the account,
+	//      and can create a watch-only hd wallet with `POST /v1/wallet`.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Export Account Key Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/ExportAccountKeyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/ExportAccountKeyResponse"
+	var req kmdapi.APIV1POSTAccountKeyExportRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Export the account key
+	accountKey, err := wallet.ExportAccountKey(req.Account, []byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTAccountKeyExportResponse{
+		AccountKey: append(accountKey.PublicKey[:], accountKey.ChainCode[:]...),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletBackupHandler handles `POST /v1/wallet/backup`
+func postWalletBackupHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/backup BackupWallet
+	//---
+	//    Summary: Back up a wallet
+	//    Description: >
+	//      Export an encrypted backup of the wallet, holding its master derivation key, its key derivation
+	//      index, its imported keys and its multisig preimages. The backup is encrypted with the backup
+	//      password, which must not be blank, and can be restored with `POST /v1/wallet/restore`.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Backup Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/BackupWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/BackupWalletResponse"
+	var req kmdapi.APIV1POSTWalletBackupRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Export the backup
+	backup, err := wallet.ExportBackup([]byte(req.WalletPassword), []byte(req.BackupPassword))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletBackupResponse{
+		Backup: backup,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletRestoreHandler handles `POST /v1/wallet/restore`
+func postWalletRestoreHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/restore RestoreWallet
+	//---
+	//    Summary: Restore a wallet from a backup
+	//    Description: >
+	//      Create a new wallet from a backup made with `POST /v1/wallet/backup`. The backup password must be
+	//      the one the backup was made with, and the wallet password becomes the password of the new wallet.
+	//      If the wallet name is blank, the name of the backed up wallet is used.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Restore Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RestoreWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RestoreWalletResponse"
+	var req kmdapi.APIV1POSTWalletRestoreRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet driver
+	walletDriver, err := driver.FetchWalletDriver(req.WalletDriverName)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Generate a wallet ID
+	walletID, err := wallet.GenerateWalletID()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Restore the wallet via its driver
+	err = walletDriver.RestoreWallet([]byte(req.WalletName), walletID, []byte(req.WalletPassword), []byte(req.BackupPassword), req.Backup)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet
+	wallet, err := walletDriver.FetchWallet(walletID)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Fetch metadata about the wallet we just restored
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletRestoreResponse{
+		Wallet: apiWalletFromMetadata(metadata),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postWalletReleaseHandler handles `POST /v1/wallet/release`
 func postWalletReleaseHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/wallet/release ReleaseWalletHandleToken
@@ -648,7 +850,8 @@ func postKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	//    - application/json
 	//    Description: >
 	//      Generates the next key in the deterministic key sequence (as determined by the master derivation key)
-	//      and adds it to the wallet, returning the public key.
+	//      and adds it to the wallet, returning the public key. With `hd_path`, hd wallets instead derive the key
+	//      at the BIP44 path m/44'/283'/hd_account'/0/hd_index.
 	//    Parameters:
 	//      - name: Generate Key Request
 	//        in: body
@@ -676,7 +879,12 @@ func postKeyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Generate the key
-	addr, err := wallet.GenerateKey(req.DisplayMnemonic)
+	var addr crypto.Digest
+	if req.HDPath {
+		addr, err = wallet.GenerateKeyAtPath(req.HDAccount, req.HDIndex)
+	} else {
+		addr, err = wallet.GenerateKey(req.DisplayMnemonic)
+	}
 	if err != nil {
 		errorResponse(w, http.StatusInternalServerError, err)
 		return
@@ -1280,9 +1488,9 @@ func reqCallbackMiddleware(reqCB func()) func(http.Handler) http.Handler {
 }
 
 // RegisterHandlers sets up the API handlers on the passed router
-func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, reqCB func()) {
-	// All /v1 requests require a valid auth token
-	router.Use(authMiddleware(log, apiToken))
+func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logger, apiToken string, scopedTokens *tokens.ScopedTokenStore, reqCB func()) {
+	// All /v1 requests require a valid auth token, or a scoped token allowing them
+	router.Use(authMiddleware(log, apiToken, scopedTokens))
 
 	// reqCB gets called each time a request matches a route
 	router.Use(reqCallbackMiddleware(reqCB))
@@ -1299,7 +1507,10 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/renew", wrapCtx(ctx, postWalletRenewHandler)).Methods("POST")
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
+	router.HandleFunc("/wallet/backup", wrapCtx(ctx, postWalletBackupHandler)).Methods("POST")
+	router.HandleFunc("/wallet/restore", wrapCtx(ctx, postWalletRestoreHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
+	router.HandleFunc("/account-key/export", wrapCtx(ctx, postAccountKeyExportHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
 	router.HandleFunc("/key/import", wrapCtx(ctx, postKeyImportHandler)).Methods("POST")
@@ -99,9 +99,18 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTWalletInfoRequest:
 		reqPath = "v1/wallet/info"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletBackupRequest:
+		reqPath = "v1/wallet/backup"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletRestoreRequest:
+		reqPath = "v1/wallet/restore"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTMasterKeyExportRequest:
 		reqPath = "v1/master-key/export"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTAccountKeyExportRequest:
+		reqPath = "v1/account-key/export"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTKeyImportRequest:
 		reqPath = "v1/key/import"
 		reqMethod = "POST"
@@ -47,6 +47,19 @@ func (kcl KMDClient) GenerateKey(walletHandle []byte) (resp kmdapi.APIV1POSTKeyR
 	return
 }
 
+// GenerateKeyAtPath wraps kmdapi.APIV1POSTKeyRequest for the key of an hd
+// wallet at m/44'/283'/account'/0/index
+func (kcl KMDClient) GenerateKeyAtPath(walletHandle []byte, account uint32, index uint32) (resp kmdapi.APIV1POSTKeyResponse, err error) {
+	req := kmdapi.APIV1POSTKeyRequest{
+		WalletHandleToken: string(walletHandle),
+		HDPath:            true,
+		HDAccount:         account,
+		HDIndex:           index,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // CreateWallet wraps kmdapi.APIV1POSTWalletRequest
 func (kcl KMDClient) CreateWallet(walletName []byte, walletDriverName string, walletPassword []byte, walletMDK crypto.MasterDerivationKey) (resp kmdapi.APIV1POSTWalletResponse, err error) {
 	req := kmdapi.APIV1POSTWalletRequest{
@@ -59,6 +72,33 @@ func (kcl KMDClient) CreateWallet(walletName []byte, walletDriverName string, wa
 	return
 }
 
+// CreateHDWallet wraps kmdapi.APIV1POSTWalletRequest for an hd wallet created
+// from a BIP32 seed
+func (kcl KMDClient) CreateHDWallet(walletName []byte, walletPassword []byte, seed []byte) (resp kmdapi.APIV1POSTWalletResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRequest{
+		WalletName:       string(walletName),
+		WalletDriverName: "hd",
+		WalletPassword:   string(walletPassword),
+		HDSeed:           seed,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// CreateWatchOnlyWallet wraps kmdapi.APIV1POSTWalletRequest for a watch-only
+// hd wallet created from an account key
+func (kcl KMDClient) CreateWatchOnlyWallet(walletName []byte, walletPassword []byte, account uint32, accountKey []byte) (resp kmdapi.APIV1POSTWalletResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRequest{
+		WalletName:       string(walletName),
+		WalletDriverName: "hd",
+		WalletPassword:   string(walletPassword),
+		HDAccountKey:     accountKey,
+		HDAccount:        account,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // InitWallet wraps kmdapi.APIV1POSTWalletInitRequest
 func (kcl KMDClient) InitWallet(walletID []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletInitResponse, err error) {
 	req := kmdapi.APIV1POSTWalletInitRequest{
@@ -197,6 +237,41 @@ func (kcl KMDClient) ExportMasterDerivationKey(walletHandle []byte, walletPasswo
 	return
 }
 
+// ExportAccountKey wraps kmdapi.APIV1POSTAccountKeyExportRequest
+func (kcl KMDClient) ExportAccountKey(walletHandle []byte, walletPassword []byte, account uint32) (resp kmdapi.APIV1POSTAccountKeyExportResponse, err error) {
+	req := kmdapi.APIV1POSTAccountKeyExportRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		Account:           account,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// BackupWallet wraps kmdapi.APIV1POSTWalletBackupRequest
+func (kcl KMDClient) BackupWallet(walletHandle []byte, walletPassword []byte, backupPassword []byte) (resp kmdapi.APIV1POSTWalletBackupResponse, err error) {
+	req := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		BackupPassword:    string(backupPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RestoreWallet wraps kmdapi.APIV1POSTWalletRestoreRequest
+func (kcl KMDClient) RestoreWallet(walletName []byte, walletDriverName string, walletPassword []byte, backupPassword []byte, backup []byte) (resp kmdapi.APIV1POSTWalletRestoreResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRestoreRequest{
+		WalletName:       string(walletName),
+		WalletDriverName: walletDriverName,
+		WalletPassword:   string(walletPassword),
+		BackupPassword:   string(backupPassword),
+		Backup:           backup,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignTransaction wraps kmdapi.APIV1POSTTransactionSignRequest
 func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
 	txBytes := protocol.Encode(&tx)
@@ -46,6 +46,7 @@ type KMDConfig struct {
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+	HDWalletDriverConfig     HDWalletDriverConfig     `json:"hd"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -60,6 +61,12 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// HDWalletDriverConfig is configuration specific to the HDWalletDriver. Its
+// wallet passwords use the scrypt parameters of the SQLiteWalletDriver.
+type HDWalletDriverConfig struct {
+	WalletsDir string `json:"wallets_dir"`
+}
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -95,6 +102,13 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+	// Same for an HD Wallet directory
+	hdWalletsDir := k.DriverConfig.HDWalletDriverConfig.WalletsDir
+	if hdWalletsDir != "" {
+		if !filepath.IsAbs(hdWalletsDir) {
+			return ErrHDWalletNotAbsolute
+		}
+	}
 	return nil
 }
 
@@ -22,3 +22,6 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrHDWalletNotAbsolute is returned when the passed hd wallet directory is relative
+var ErrHDWalletNotAbsolute = fmt.Errorf("hd wallets path must be absolute path")
@@ -49,6 +49,13 @@ type APIV1POSTWalletRequest struct {
 	WalletDriverName    string                   `json:"wallet_driver_name"`
 	WalletPassword      string                   `json:"wallet_password"`
 	MasterDerivationKey APIV1MasterDerivationKey `json:"master_derivation_key"`
+	// HDSeed is the BIP32 seed of an hd wallet, such as the seed of a BIP39 mnemonic
+	HDSeed []byte `json:"hd_seed"`
+	// HDAccountKey is the account extended public key of a watch-only hd wallet,
+	// as exported by `POST /v1/account-key/export`
+	HDAccountKey []byte `json:"hd_account_key"`
+	// HDAccount is the account of HDAccountKey
+	HDAccount uint32 `json:"hd_account"`
 }
 
 // APIV1POSTWalletInitRequest is the request for `POST /v1/wallet/init`
@@ -109,6 +116,41 @@ type APIV1POSTMasterKeyExportRequest struct {
 	WalletPassword    string `json:"wallet_password"`
 }
 
+// APIV1POSTAccountKeyExportRequest is the request for `POST /v1/account-key/export`
+//
+// swagger:model ExportAccountKeyRequest
+type APIV1POSTAccountKeyExportRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	Account           uint32 `json:"account"`
+}
+
+// APIV1POSTWalletBackupRequest is the request for `POST /v1/wallet/backup`
+//
+// swagger:model BackupWalletRequest
+type APIV1POSTWalletBackupRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+	BackupPassword    string `json:"backup_password"`
+}
+
+// APIV1POSTWalletRestoreRequest is the request for `POST /v1/wallet/restore`
+//
+// swagger:model RestoreWalletRequest
+type APIV1POSTWalletRestoreRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletName       string `json:"wallet_name"`
+	WalletDriverName string `json:"wallet_driver_name"`
+	WalletPassword   string `json:"wallet_password"`
+	BackupPassword   string `json:"backup_password"`
+	Backup           []byte `json:"backup"`
+}
+
 // APIV1POSTKeyImportRequest is the request for `POST /v1/key/import`
 //
 // swagger:model ImportKeyRequest
@@ -138,6 +180,10 @@ type APIV1POSTKeyRequest struct {
 
 	WalletHandleToken string `json:"wallet_handle_token"`
 	DisplayMnemonic   bool   `json:"display_mnemonic"`
+	// HDPath requests the key at m/44'/283'/HDAccount'/0/HDIndex, from an hd wallet
+	HDPath    bool   `json:"hd_path"`
+	HDAccount uint32 `json:"hd_account"`
+	HDIndex   uint32 `json:"hd_index"`
 }
 
 // APIV1DELETEKeyRequest is the request for `DELETE /v1/key`
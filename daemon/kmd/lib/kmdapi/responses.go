@@ -173,6 +173,49 @@ type exportMasterKeyResponse struct {
 	Body *APIV1POSTMasterKeyExportResponse
 }
 
+// APIV1POSTAccountKeyExportResponse is the response to `POST /v1/account-key/export`
+// friendly:ExportAccountKeyResponse
+type APIV1POSTAccountKeyExportResponse struct {
+	APIV1ResponseEnvelope
+	// AccountKey is the account public key followed by its chain code
+	AccountKey []byte `json:"account_key"`
+}
+
+// Response to `POST /v1/account-key/export`
+// swagger:response ExportAccountKeyResponse
+type exportAccountKeyResponse struct {
+	//	in:body
+	Body *APIV1POSTAccountKeyExportResponse
+}
+
+// APIV1POSTWalletBackupResponse is the response to `POST /v1/wallet/backup`
+// friendly:BackupWalletResponse
+type APIV1POSTWalletBackupResponse struct {
+	APIV1ResponseEnvelope
+	Backup []byte `json:"backup"`
+}
+
+// Response to `POST /v1/wallet/backup`
+// swagger:response BackupWalletResponse
+type backupWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletBackupResponse
+}
+
+// APIV1POSTWalletRestoreResponse is the response to `POST /v1/wallet/restore`
+// friendly:RestoreWalletResponse
+type APIV1POSTWalletRestoreResponse struct {
+	APIV1ResponseEnvelope
+	Wallet APIV1Wallet `json:"wallet"`
+}
+
+// Response to `POST /v1/wallet/restore`
+// swagger:response RestoreWalletResponse
+type restoreWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletRestoreResponse
+}
+
 // APIV1POSTKeyImportResponse is the response to `POST /v1/key/import`
 // friendly:ImportKeyResponse
 type APIV1POSTKeyImportResponse struct {
@@ -211,7 +211,7 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 	// Initialize HTTP server
 	watchdogCB := ws.makeWatchdogCallback(kill)
 	srv := http.Server{
-		Handler: api.Handler(ws.SessionManager, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
+		Handler: api.Handler(ws.SessionManager, ws.Log, ws.AllowedOrigins, ws.APIToken, tokens.MakeScopedTokenStore(ws.DataDir, tokens.KmdScopedTokensFilename), watchdogCB),
 	}
 
 	// Read the kill channel and shut down the server gracefully
@@ -28,17 +28,19 @@ import (
 var walletDrivers = map[string]Driver{
 	sqliteWalletDriverName: &SQLiteWalletDriver{},
 	ledgerWalletDriverName: &LedgerWalletDriver{},
+	hdWalletDriverName:     &HDWalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
 // compatible with kmd. In particular, wallet drivers must be able to
 // initialize themselves from a Config, create a wallet with a name, ID,
-// and password, and fetch a wallet by ID.
+// and password, restore a wallet from a backup, and fetch a wallet by ID.
 type Driver interface {
 	InitWithConfig(cfg config.KMDConfig, log logging.Logger) error
 	ListWalletMetadatas() ([]wallet.Metadata, error)
 	CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error
 	RenameWallet(newName []byte, id []byte, pw []byte) error
+	RestoreWallet(name []byte, id []byte, pw []byte, backupPW []byte, backup []byte) error
 	FetchWallet(id []byte) (wallet.Wallet, error)
 }
 
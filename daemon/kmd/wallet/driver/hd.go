@@ -0,0 +1,610 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"database/sql"
+	"path/filepath"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	hdWalletDriverName    = "hd"
+	hdWalletDriverVersion = 1
+	hdWalletsDirName      = "hd_wallets"
+	hdWalletHasMnemonicUX = false
+	hdWalletHasMasterKey  = true
+	hdMinSeedLen          = 16
+	hdMaxSeedLen          = 64
+)
+
+var hdWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+var hdDriverFormat = sqliteWalletFormat{
+	driverName:            hdWalletDriverName,
+	driverVersion:         hdWalletDriverVersion,
+	supportsMnemonicUX:    hdWalletHasMnemonicUX,
+	supportsMasterKey:     hdWalletHasMasterKey,
+	supportedTransactions: hdWalletSupportedTxs,
+}
+
+var hdWalletSchema = `
+CREATE TABLE IF NOT EXISTS metadata (
+	driver_name TEXT NOT NULL,
+	driver_version INT NOT NULL,
+	wallet_id TEXT NOT NULL UNIQUE,
+	wallet_name TEXT NOT NULL,
+	mep_encrypted BLOB NOT NULL,
+	root_encrypted BLOB NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS keys (
+	address BLOB PRIMARY KEY,
+	account INT NOT NULL,
+	key_idx INT NOT NULL,
+	UNIQUE (account, key_idx)
+);
+
+CREATE TABLE IF NOT EXISTS msig_addrs (
+	address BLOB PRIMARY KEY,
+	version INT NOT NULL,
+	threshold INT NOT NULL,
+	pks BLOB NOT NULL
+);
+`
+
+// HDWalletDriver derives the keys of its wallets with BIP32-Ed25519 along
+// BIP44 paths (m/44'/283'/account'/0/index), so that a seed gives the same
+// addresses in kmd as in hardware wallets. Wallets created from an account
+// extended public key are watch-only: they derive the public keys of their
+// account but cannot sign. Wallets are stored in sqlite 3 databases like the
+// ones of the sqlite driver, with the seed or account key as an
+// authenticated-encrypted blob.
+type HDWalletDriver struct {
+	sqliteWalletStore
+	globalCfg config.KMDConfig
+	hdCfg     config.HDWalletDriverConfig
+	scryptCfg config.ScryptParams
+}
+
+// HDWallet represents a particular HDWallet under the HDWalletDriver
+type HDWallet struct {
+	sqliteWalletDB
+	masterEncryptionKey []byte
+	root                *hdWalletRoot
+}
+
+// hdWalletRoot is what an HDWallet derives its keys from: either a seed, or
+// the extended public key of a single account for watch-only wallets
+type hdWalletRoot struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Seed       []byte                       `codec:"seed"`
+	WatchOnly  bool                         `codec:"watch"`
+	Account    uint32                       `codec:"account"`
+	AccountKey crypto.BIP32Ed25519PublicKey `codec:"acctkey"`
+}
+
+// InitWithConfig accepts a driver configuration so that the HD driver knows
+// where to read and write its wallet databases
+func (hwd *HDWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	hwd.globalCfg = cfg
+	hwd.hdCfg = cfg.DriverConfig.HDWalletDriverConfig
+	hwd.scryptCfg = cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams
+
+	// Make the wallets directory if it doesn't already exist. It is the one
+	// specified in the config, if there is one, otherwise it is a
+	// subdirectory of the global kmd data dir
+	walletsDir := hwd.hdCfg.WalletsDir
+	if walletsDir == "" {
+		walletsDir = filepath.Join(hwd.globalCfg.DataDir, hdWalletsDirName)
+	}
+	store, err := makeSQLiteWalletStore(&hdDriverFormat, walletsDir)
+	if err != nil {
+		return err
+	}
+	hwd.sqliteWalletStore = store
+	return nil
+}
+
+// createWallet creates the database of a wallet deriving its keys from root
+func (hwd *HDWalletDriver) createWallet(name []byte, id []byte, pw []byte, root hdWalletRoot) error {
+	dbPath, err := hwd.claimWalletNameID(name, id)
+	if err != nil {
+		return err
+	}
+
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	_, err = db.Exec(hdWalletSchema)
+	if err != nil {
+		return errDatabase
+	}
+
+	// Generate the master encryption password, which encrypts the root, and
+	// encrypt it using the user's password (which may be blank)
+	var masterKey [masterKeyLen]byte
+	err = fillRandomBytes(masterKey[:])
+	if err != nil {
+		return err
+	}
+	encryptedMEPBlob, err := encryptBlobWithPasswordBlankOK(masterKey[:], PTMasterKey, pw, &hwd.scryptCfg)
+	if err != nil {
+		return err
+	}
+
+	// The root is encrypted even for watch-only wallets, so that nobody can
+	// swap the account key for their own
+	encryptedRootBlob, err := encryptBlobWithKey(msgpackEncode(root), PTHDWalletRoot, masterKey[:])
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec("INSERT INTO metadata (driver_name, driver_version, wallet_id, wallet_name, mep_encrypted, root_encrypted) VALUES(?, ?, ?, ?, ?, ?)", hdDriverFormat.driverName, hdDriverFormat.driverVersion, id, name, encryptedMEPBlob, encryptedRootBlob)
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// CreateWallet creates a wallet using the master derivation key as its seed.
+// A blank master derivation key is replaced with a random one.
+func (hwd *HDWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	if mdk == (crypto.MasterDerivationKey{}) {
+		err := fillRandomBytes(mdk[:])
+		if err != nil {
+			return err
+		}
+	}
+	return hwd.createWallet(name, id, pw, hdWalletRoot{Seed: mdk[:]})
+}
+
+// CreateWalletFromSeed creates a wallet deriving its keys from a BIP32 seed,
+// such as the seed of a BIP39 mnemonic
+func (hwd *HDWalletDriver) CreateWalletFromSeed(name []byte, id []byte, pw []byte, seed []byte) error {
+	if len(seed) < hdMinSeedLen || len(seed) > hdMaxSeedLen {
+		return errHDSeedLength
+	}
+	return hwd.createWallet(name, id, pw, hdWalletRoot{Seed: seed})
+}
+
+// CreateWatchOnlyWallet creates a watch-only wallet deriving the public keys of
+// a single account from the account extended public key
+func (hwd *HDWalletDriver) CreateWatchOnlyWallet(name []byte, id []byte, pw []byte, account uint32, accountKey crypto.BIP32Ed25519PublicKey) error {
+	if account >= crypto.BIP32HardenedOffset {
+		return errHDPath
+	}
+	return hwd.createWallet(name, id, pw, hdWalletRoot{WatchOnly: true, Account: account, AccountKey: accountKey})
+}
+
+// RestoreWallet is not supported: hd wallets are recreated from their seed
+// or account key
+func (hwd *HDWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, backupPW []byte, backup []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it, failing if there's more
+// than one wallet with the given ID
+func (hwd *HDWalletDriver) FetchWallet(id []byte) (wallet.Wallet, error) {
+	hwd.mux.Lock()
+	defer hwd.mux.Unlock()
+
+	dbPath, err := hwd.findDBPathLocked(id)
+	if err != nil {
+		return nil, err
+	}
+	return &HDWallet{sqliteWalletDB: sqliteWalletDB{dbPath: dbPath, format: &hdDriverFormat}}, nil
+}
+
+// Init decrypts the master encryption password and the root of the wallet,
+// and stores them in memory for subsequent operations
+func (hw *HDWallet) Init(pw []byte) error {
+	masterEncryptionKey, err := hw.decryptAndGetMasterKey(pw)
+	if err != nil {
+		return err
+	}
+
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	var encryptedRootBlob []byte
+	err = db.Get(&encryptedRootBlob, "SELECT root_encrypted FROM metadata LIMIT 1")
+	if err != nil {
+		return errDatabase
+	}
+	rootBlob, err := decryptBlobWithPassword(encryptedRootBlob, PTHDWalletRoot, masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+	var root hdWalletRoot
+	err = msgpackDecode(rootBlob, &root)
+	if err != nil {
+		return err
+	}
+
+	hw.masterEncryptionKey = masterEncryptionKey
+	hw.root = &root
+	return hw.hashPassword(pw)
+}
+
+// ExportMasterDerivationKey returns the seed of the wallet, if it was created
+// from a master derivation key
+func (hw *HDWallet) ExportMasterDerivationKey(pw []byte) (mdk crypto.MasterDerivationKey, err error) {
+	err = hw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+	if hw.root.WatchOnly {
+		err = errHDWatchOnly
+		return
+	}
+	if len(hw.root.Seed) != len(mdk) {
+		err = errHDSeedNotMDK
+		return
+	}
+	copy(mdk[:], hw.root.Seed)
+	return
+}
+
+// ExportBackup is not supported: hd wallets are recreated from their seed or
+// account key. Like the sqlite driver, it still refuses a blank backup
+// passphrase, so that callers see the same errors from both drivers.
+func (hw *HDWallet) ExportBackup(pw []byte, backupPW []byte) ([]byte, error) {
+	if len(backupPW) == 0 {
+		return nil, errBackupBlankPassword
+	}
+	return nil, errNotSupported
+}
+
+// ExportAccountKey returns the extended public key of a BIP44 account, which
+// can create a watch-only wallet for the account
+func (hw *HDWallet) ExportAccountKey(account uint32, pw []byte) (key crypto.BIP32Ed25519PublicKey, err error) {
+	err = hw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+	if account >= crypto.BIP32HardenedOffset {
+		err = errHDPath
+		return
+	}
+	if hw.root.WatchOnly {
+		if account != hw.root.Account {
+			err = errHDWatchOnlyAccount
+			return
+		}
+		return hw.root.AccountKey, nil
+	}
+	accountKey, err := crypto.BIP32Ed25519MasterKey(hw.root.Seed).DerivePath(crypto.BIP44AccountPath(account))
+	if err != nil {
+		return
+	}
+	return accountKey.Public()
+}
+
+// derivePublicKey derives the public key at the given account and index
+func (hw *HDWallet) derivePublicKey(account uint32, index uint32) (crypto.PublicKey, error) {
+	if account >= crypto.BIP32HardenedOffset || index >= crypto.BIP32HardenedOffset {
+		return crypto.PublicKey{}, errHDPath
+	}
+	if hw.root.WatchOnly {
+		if account != hw.root.Account {
+			return crypto.PublicKey{}, errHDWatchOnlyAccount
+		}
+		key, err := hw.root.AccountKey.DerivePath([]uint32{0, index})
+		return key.PublicKey, err
+	}
+	key, err := hw.deriveSecretKey(account, index)
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	pub, err := key.Public()
+	return pub.PublicKey, err
+}
+
+// deriveSecretKey derives the extended private key at the given account and index
+func (hw *HDWallet) deriveSecretKey(account uint32, index uint32) (crypto.BIP32Ed25519PrivateKey, error) {
+	if hw.root.WatchOnly {
+		return crypto.BIP32Ed25519PrivateKey{}, errHDWatchOnly
+	}
+	return crypto.BIP32Ed25519MasterKey(hw.root.Seed).DerivePath(crypto.BIP44Path(account, index))
+}
+
+// ImportKey is not supported: every key of an hd wallet has a derivation path
+func (hw *HDWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey is not supported: derived keys are extended keys, which cannot be
+// represented as an ed25519 seed
+func (hw *HDWallet) ExportKey(addr crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey derives the key following the highest derived index of the
+// first account, or of the account of a watch-only wallet
+func (hw *HDWallet) GenerateKey(displayMnemonic bool) (addr crypto.Digest, err error) {
+	if displayMnemonic {
+		err = errNoMnemonicUX
+		return
+	}
+
+	account := uint32(0)
+	if hw.root.WatchOnly {
+		account = hw.root.Account
+	}
+	return hw.generateKey(account, nil)
+}
+
+// GenerateKeyAtPath derives the key at m/44'/283'/account'/0/index
+func (hw *HDWallet) GenerateKeyAtPath(account uint32, index uint32) (crypto.Digest, error) {
+	return hw.generateKey(account, &index)
+}
+
+// generateKey derives and inserts the key with the given index of the
+// account, or the next index if it is nil
+func (hw *HDWallet) generateKey(account uint32, index *uint32) (addr crypto.Digest, err error) {
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	// Begin an exclusive database transaction (we set _txlock=exclusive on the
+	// database connection string)
+	tx, err := db.Beginx()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	defer tx.Rollback()
+
+	var keyIdx uint32
+	if index != nil {
+		keyIdx = *index
+	} else {
+		var maxIdx sql.NullInt64
+		err = tx.Get(&maxIdx, "SELECT MAX(key_idx) FROM keys WHERE account=?", account)
+		if err != nil {
+			err = errDatabase
+			return
+		}
+		if maxIdx.Valid {
+			if maxIdx.Int64+1 >= int64(crypto.BIP32HardenedOffset) {
+				err = errTooManyKeys
+				return
+			}
+			keyIdx = uint32(maxIdx.Int64 + 1)
+		}
+	}
+
+	pk, err := hw.derivePublicKey(account, keyIdx)
+	if err != nil {
+		return
+	}
+	addr = publicKeyToAddress(pk)
+
+	_, err = tx.Exec("INSERT INTO keys (address, account, key_idx) VALUES(?, ?, ?)", addr[:], account, keyIdx)
+	err = checkDBError(err)
+	if err != nil {
+		return
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		err = errDatabase
+	}
+	return
+}
+
+// fetchSecretKey derives the extended private key of an address of the
+// wallet, from the path stored with the address
+func (hw *HDWallet) fetchSecretKey(addr crypto.Digest) (key crypto.BIP32Ed25519PrivateKey, pk crypto.PublicKey, err error) {
+	if hw.root.WatchOnly {
+		err = errHDWatchOnly
+		return
+	}
+
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(hw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var account, keyIdx uint32
+	err = db.QueryRow("SELECT account, key_idx FROM keys WHERE address=?", addr[:]).Scan(&account, &keyIdx)
+	if err != nil {
+		err = errKeyNotFound
+		return
+	}
+
+	key, err = hw.deriveSecretKey(account, keyIdx)
+	if err != nil {
+		return
+	}
+	pub, err := key.Public()
+	if err != nil {
+		return
+	}
+
+	// Ensure the derived address matches the one we used to look the key up
+	if publicKeyToAddress(pub.PublicKey) != addr {
+		err = errTampering
+		return
+	}
+	return key, pub.PublicKey, nil
+}
+
+// SignTransaction signs the passed transaction with the private key whose public key is provided, or
+// if the provided public key is zero, inferring the required private key from the transaction itself
+func (hw *HDWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) (stx []byte, err error) {
+	err = hw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	addr := crypto.Digest(tx.Src())
+	if (pk != crypto.PublicKey{}) {
+		addr = publicKeyToAddress(pk)
+	}
+	key, signer, err := hw.fetchSecretKey(addr)
+	if err != nil {
+		return
+	}
+
+	sig, err := key.Sign(tx)
+	if err != nil {
+		return
+	}
+	stxn := transactions.SignedTxn{Txn: tx, Sig: sig}
+	// Set the AuthAddr if the signing key doesn't match the transaction sender
+	if basics.Address(signer) != tx.Sender {
+		stxn.AuthAddr = basics.Address(signer)
+	}
+	stx = protocol.Encode(&stxn)
+	return
+}
+
+// SignProgram signs the passed data for the src address
+func (hw *HDWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	key, _, err := hw.fetchSecretKey(src)
+	if err != nil {
+		return nil, err
+	}
+
+	progb := logic.Program(data)
+	sig, err := key.Sign(&progb)
+	if err != nil {
+		return nil, err
+	}
+	return sig[:], nil
+}
+
+// multisigSign is crypto.MultisigSign for an extended private key: it signs
+// msg as the key pk of the multisig address addr
+func (hw *HDWallet) multisigSign(msg crypto.Hashable, addr crypto.Digest, version, threshold uint8, pks []crypto.PublicKey, pk crypto.PublicKey) (sig crypto.MultisigSig, err error) {
+	addr2, err := crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return
+	}
+	if addr != addr2 {
+		err = errMsigWrongAddr
+		return
+	}
+
+	key, _, err := hw.fetchSecretKey(publicKeyToAddress(pk))
+	if err != nil {
+		return
+	}
+	keySig, err := key.Sign(msg)
+	if err != nil {
+		return
+	}
+
+	sig.Version = version
+	sig.Threshold = threshold
+	sig.Subsigs = make([]crypto.MultisigSubsig, len(pks))
+	err = errMsigWrongKey
+	for i := range pks {
+		sig.Subsigs[i].Key = pks[i]
+		if pks[i] == pk {
+			sig.Subsigs[i].Sig = keySig
+			err = nil
+		}
+	}
+	return
+}
+
+// multisigSignPartial starts a multisig signature of msg as the multisig
+// address src, or adds to the partial multisig signature
+func (hw *HDWallet) multisigSignPartial(msg crypto.Hashable, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, signer crypto.Digest) (sig crypto.MultisigSig, err error) {
+	if partial.Version == 0 && partial.Threshold == 0 && len(partial.Subsigs) == 0 {
+		// We weren't given a partial multisig, so create a new one from the
+		// preimage in the database
+		var pks []crypto.PublicKey
+		var version, threshold uint8
+		version, threshold, pks, err = hw.LookupMultisigPreimage(src)
+		if err != nil {
+			return
+		}
+		return hw.multisigSign(msg, src, version, threshold, pks, pk)
+	}
+
+	// Check that the multisig address equals to either sender or signer
+	addr, err := crypto.MultisigAddrGenWithSubsigs(partial.Version, partial.Threshold, partial.Subsigs)
+	if err != nil {
+		return
+	}
+	if addr != src && addr != signer {
+		err = errMsigWrongAddr
+		return
+	}
+
+	// Sign, and merge the multisig into the partial
+	version, threshold, pks := partial.Preimage()
+	msig2, err := hw.multisigSign(msg, addr, version, threshold, pks, pk)
+	if err != nil {
+		return
+	}
+	return crypto.MultisigMerge(partial, msig2)
+}
+
+// MultisigSignTransaction starts a multisig signature or adds a signature to a
+// partially signed multisig transaction signature of the passed transaction
+// using the key
+func (hw *HDWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signer crypto.Digest) (crypto.MultisigSig, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	return hw.multisigSignPartial(tx, crypto.Digest(tx.Src()), pk, partial, signer)
+}
+
+// MultisigSignProgram starts a multisig signature or adds a signature to a
+// partially signed multisig program signature of the passed program using
+// the key
+func (hw *HDWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	err := hw.CheckPassword(pw)
+	if err != nil {
+		return crypto.MultisigSig{}, err
+	}
+	progb := logic.Program(data)
+	return hw.multisigSignPartial(&progb, src, pk, partial, src)
+}
@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"fmt"
+)
+
+var errHDSeedLength = fmt.Errorf("hd wallet seed must be between %d and %d bytes", hdMinSeedLen, hdMaxSeedLen)
+var errHDSeedNotMDK = fmt.Errorf("hd wallet seed is not a master derivation key")
+var errHDPath = fmt.Errorf("hd wallet accounts and key indices must be below 2^31")
+var errHDWatchOnly = fmt.Errorf("watch-only hd wallet has no private keys")
+var errHDWatchOnlyAccount = fmt.Errorf("watch-only hd wallet can only derive the keys of its account")
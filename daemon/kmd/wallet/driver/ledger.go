@@ -224,6 +224,11 @@ func (lwd *LedgerWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte
 	return errNotSupported
 }
 
+// RestoreWallet implements the Driver interface.
+func (lwd *LedgerWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, backupPW []byte, backup []byte) error {
+	return errNotSupported
+}
+
 // Init implements the wallet interface.
 func (lw *LedgerWallet) Init(pw []byte) error {
 	return nil
@@ -239,6 +244,11 @@ func (lw *LedgerWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDeriv
 	return crypto.MasterDerivationKey{}, errNotSupported
 }
 
+// ExportBackup implements the Wallet interface.
+func (lw *LedgerWallet) ExportBackup(pw []byte, backupPW []byte) ([]byte, error) {
+	return nil, errNotSupported
+}
+
 func pathToID(path string) string {
 	// The Path USB info field is platform-dependent and sometimes
 	// very long. We hash it to make the wallet name/ID less unwieldy
@@ -296,6 +306,16 @@ func (lw *LedgerWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error)
 	return crypto.Digest{}, errNotSupported
 }
 
+// GenerateKeyAtPath implements the Wallet interface.
+func (lw *LedgerWallet) GenerateKeyAtPath(account uint32, index uint32) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportAccountKey implements the Wallet interface.
+func (lw *LedgerWallet) ExportAccountKey(account uint32, pw []byte) (crypto.BIP32Ed25519PublicKey, error) {
+	return crypto.BIP32Ed25519PublicKey{}, errNotSupported
+}
+
 // DeleteKey implements the Wallet interface.
 func (lw *LedgerWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
 	return errNotSupported
@@ -17,14 +17,10 @@
 package driver
 
 import (
-	"bytes"
-	"crypto/subtle"
 	"fmt"
-	"os"
 	"path/filepath"
 	"regexp"
 
-	"github.com/algorand/go-deadlock"
 	"github.com/jmoiron/sqlx"
 	"github.com/mattn/go-sqlite3"
 
@@ -52,6 +48,14 @@ const (
 )
 
 var sqliteWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+var sqliteDriverFormat = sqliteWalletFormat{
+	driverName:            sqliteWalletDriverName,
+	driverVersion:         sqliteWalletDriverVersion,
+	supportsMnemonicUX:    sqliteWalletHasMnemonicUX,
+	supportsMasterKey:     sqliteWalletHasMasterKey,
+	supportedTransactions: sqliteWalletSupportedTxs,
+}
 var disallowedFilenameRegex = regexp.MustCompile("[^a-zA-Z0-9_-]*")
 var databaseFilenameRegex = regexp.MustCompile("^.*\\.db$")
 
@@ -83,23 +87,18 @@ CREATE TABLE IF NOT EXISTS msig_addrs (
 // SQLiteWalletDriver is the default wallet driver used by kmd. Keys are stored
 // as authenticated-encrypted blobs in a sqlite 3 database.
 type SQLiteWalletDriver struct {
+	sqliteWalletStore
 	globalCfg config.KMDConfig
 	sqliteCfg config.SQLiteWalletDriverConfig
-	mux       *deadlock.Mutex
-
-	claimedWallets [][][]byte
 }
 
 // SQLiteWallet represents a particular SQLiteWallet under the
 // SQLiteWalletDriver
 type SQLiteWallet struct {
-	masterEncryptionKey  []byte
-	masterDerivationKey  []byte
-	walletPasswordSalt   [saltLen]byte
-	walletPasswordHash   crypto.Digest
-	walletPasswordHashed bool
-	dbPath               string
-	cfg                  config.SQLiteWalletDriverConfig
+	sqliteWalletDB
+	masterEncryptionKey []byte
+	masterDerivationKey []byte
+	cfg                 config.SQLiteWalletDriverConfig
 }
 
 // The following msgpack codec interface was lifted from algod's network
@@ -151,15 +150,18 @@ func (swd *SQLiteWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.
 		}
 	}
 
-	// Make the wallets directory if it doesn't already exist
-	err := swd.maybeMakeWalletsDir()
+	// Make the wallets directory if it doesn't already exist. It is the one
+	// specified in the config, if there is one, otherwise it is a
+	// subdirectory of the global kmd data dir
+	walletsDir := swd.sqliteCfg.WalletsDir
+	if walletsDir == "" {
+		walletsDir = filepath.Join(swd.globalCfg.DataDir, sqliteWalletsDirName)
+	}
+	store, err := makeSQLiteWalletStore(&sqliteDriverFormat, walletsDir)
 	if err != nil {
 		return err
 	}
-
-	// Initialize lock. When creating a new wallet, this lock protects us from
-	// creating another with the same name or ID
-	swd.mux = &deadlock.Mutex{}
+	swd.sqliteWalletStore = store
 
 	return nil
 }
@@ -172,180 +174,6 @@ func dbConnectionURL(path string) string {
 	return fmt.Sprintf("file:%s?%s", path, sqliteWalletDBOptions)
 }
 
-// walletMetadataFromDB accepts a *sqlx.DB and extracts a wallet.Metadata from
-// it
-func walletMetadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
-	var driverName string
-	var walletID, walletName []byte
-	var driverVersion uint32
-
-	// Fetch the metadata to fill in a Metadata
-	metadataRow := db.QueryRow("SELECT driver_name, driver_version, wallet_id, wallet_name FROM metadata LIMIT 1")
-	err = metadataRow.Scan(&driverName, &driverVersion, &walletID, &walletName)
-	if err != nil {
-		err = errDatabase
-		return
-	}
-
-	// Ensure this database is the correct version + driver
-	if driverName != sqliteWalletDriverName {
-		err = errWrongDriver
-		return
-	}
-	if driverVersion != sqliteWalletDriverVersion {
-		err = errWrongDriverVer
-		return
-	}
-
-	// Build the Metadata
-	metadata = wallet.Metadata{
-		ID:                    walletID,
-		Name:                  walletName,
-		DriverName:            driverName,
-		DriverVersion:         driverVersion,
-		SupportsMnemonicUX:    sqliteWalletHasMnemonicUX,
-		SupportsMasterKey:     sqliteWalletHasMasterKey,
-		SupportedTransactions: sqliteWalletSupportedTxs,
-	}
-
-	return
-}
-
-// walletMetadataFromDBPath accepts path to a sqlite wallet database and
-// returns a Metadata struct with information about it
-func walletMetadataFromDBPath(dbPath string) (metadata wallet.Metadata, err error) {
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
-	if err != nil {
-		err = errDatabaseConnect
-		return
-	}
-	defer db.Close()
-	return walletMetadataFromDB(db)
-}
-
-// potentialWalletPaths lists paths to plausible databases in the wallets
-// directory. This means things that aren't directories and that match the
-// databaseFilenameRegex
-func (swd *SQLiteWalletDriver) potentialWalletPaths() (paths []string, err error) {
-	// List all files and folders in the wallets directory
-	wDir := swd.walletsDir()
-	files, err := os.ReadDir(wDir)
-	if err != nil {
-		return
-	}
-	for _, f := range files {
-		// Skip things that definitely aren't databases
-		if f.IsDir() || !databaseFilenameRegex.Match([]byte(f.Name())) {
-			continue
-		}
-		paths = append(paths, filepath.Join(wDir, f.Name()))
-	}
-	return
-}
-
-// ListWalletMetadatas opens everything that looks like a wallet in the
-// walletsDir() and tries to extract its metadata. It does not fail if it
-// is unable to read metadata from one of the files it attempts to open
-func (swd *SQLiteWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
-	paths, err := swd.potentialWalletPaths()
-	if err != nil {
-		return
-	}
-	for _, path := range paths {
-		// Ignore errors in case people dumped non-database files into this directory
-		walletMetadata, err := walletMetadataFromDBPath(path)
-		if err != nil {
-			continue
-		}
-		metadatas = append(metadatas, walletMetadata)
-	}
-	return metadatas, nil
-}
-
-// findDBPathsById returns the paths to wallets with the specified id
-func (swd *SQLiteWalletDriver) findDBPathsByID(id []byte) (paths []string, err error) {
-	return swd.findDBPathsByField("ID", id)
-}
-
-// findDBPathsByName returns the paths to wallets with the specified name
-func (swd *SQLiteWalletDriver) findDBPathsByName(name []byte) (paths []string, err error) {
-	return swd.findDBPathsByField("Name", name)
-}
-
-// findDBPathsByField is a helper for findDBPathsByID and findDBPathsByName. It
-// iterates over potential wallet databases and searches for the given
-// testValue in the appropriate field
-func (swd *SQLiteWalletDriver) findDBPathsByField(fieldName string, testValue []byte) (paths []string, err error) {
-	potentialPaths, err := swd.potentialWalletPaths()
-	if err != nil {
-		return nil, err
-	}
-	for _, path := range potentialPaths {
-		// Ignore errors in case people dumped non-database files into this directory
-		walletMetadata, err := walletMetadataFromDBPath(path)
-		if err != nil {
-			continue
-		}
-		// Fetch the approriate field
-		var fieldValue []byte
-		switch fieldName {
-		case "ID":
-			fieldValue = walletMetadata.ID
-		case "Name":
-			fieldValue = walletMetadata.Name
-		default:
-			panic(fmt.Sprintf("unknown fieldName %s", fieldName))
-		}
-		// Check if there's a match
-		if bytes.Equal(fieldValue, testValue) {
-			paths = append(paths, path)
-		}
-	}
-	return paths, nil
-}
-
-// maybeMakeWalletsDir tries to create the wallets directory if it doesn't
-// already exist
-func (swd SQLiteWalletDriver) maybeMakeWalletsDir() error {
-	wDir := swd.walletsDir()
-	err := os.Mkdir(wDir, sqliteWalletsDirPermissions)
-	if err != nil && !os.IsExist(err) {
-		return fmt.Errorf("couldn't create wallets directory at %s: %v", wDir, err)
-	}
-	return nil
-}
-
-// walletsDir returns the wallet directory specified in the config, if there
-// is one, otherwise it returns a subdirectory of the global kmd data dir
-func (swd SQLiteWalletDriver) walletsDir() string {
-	if swd.sqliteCfg.WalletsDir != "" {
-		return swd.sqliteCfg.WalletsDir
-	}
-	return filepath.Join(swd.globalCfg.DataDir, sqliteWalletsDirName)
-}
-
-// nameIDToPath turns a wallet name and wallet id into a path to the
-// corresponding database file to create
-func (swd SQLiteWalletDriver) nameIDToPath(name []byte, id []byte) string {
-	// It's OK if filtered names collide with each other, because ID is unique
-	safeName := disallowedFilenameRegex.ReplaceAll(name, []byte(""))
-	// wallet ID should already be safe, but filter it just in case
-	safeID := disallowedFilenameRegex.ReplaceAll(id, []byte(""))
-
-	var fileName string
-	if bytes.Equal(safeName, safeID) {
-		// If name and ID are equal, just use one of them
-		fileName = fmt.Sprintf("%s.db", safeID)
-	} else {
-		// Otherwise, append them
-		fileName = fmt.Sprintf("%s.%s.db", safeName, safeID)
-	}
-
-	// Append to dataDir
-	return filepath.Join(swd.walletsDir(), fileName)
-}
-
 // checkDBError inspects an error from the database and interprets it as a
 // "duplicate key" error, a generic database error, or a nil error
 func checkDBError(err error) error {
@@ -361,65 +189,9 @@ func checkDBError(err error) error {
 	return nil
 }
 
-func (swd *SQLiteWalletDriver) claimWalletNameID(name []byte, id []byte) (dbPath string, err error) {
-	// Grab our lock to avoid races with duplicate wallet names/ids
-	swd.mux.Lock()
-	defer swd.mux.Unlock()
-
-	for _, nameID := range swd.claimedWallets {
-		if bytes.Equal(nameID[0], name) {
-			return "", errSameName
-		}
-		if bytes.Equal(nameID[1], id) {
-			return "", errSameID
-		}
-	}
-
-	// name, id -> "/data/dir/name-id.db"
-	dbPath = swd.nameIDToPath(name, id)
-
-	// Ensure the wallet with this filename doesn't already exist, and that we
-	// have permissions to access the wallet directory
-	_, err = os.Stat(dbPath)
-	if !os.IsNotExist(err) {
-		return
-	}
-
-	// Ensure a wallet with this name doesn't already exist. swd.mux is
-	// locked above to avoid races here
-	sameNameDBPaths, err := swd.findDBPathsByName(name)
-	if err != nil {
-		return
-	}
-	if len(sameNameDBPaths) != 0 {
-		return "", errSameName
-	}
-
-	// Ensure a wallet with this id doesn't already exist. As above, we use
-	// swd.mux to avoid races
-	sameIDDBPaths, err := swd.findDBPathsByID(id)
-	if err != nil {
-		return
-	}
-	if len(sameIDDBPaths) != 0 {
-		return "", errSameID
-	}
-
-	swd.claimedWallets = append(swd.claimedWallets, [][]byte{name, id})
-	return
-}
-
 // CreateWallet ensures that a wallet of the given name/id combo doesn't exist,
 // and initializes a database with the appropriate name.
 func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
-	if len(name) > sqliteMaxWalletNameLen {
-		return errNameTooLong
-	}
-
-	if len(id) > sqliteMaxWalletIDLen {
-		return errIDTooLong
-	}
-
 	dbPath, err := swd.claimWalletNameID(name, id)
 	if err != nil {
 		return err
@@ -480,7 +252,7 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 	}
 
 	// Store the metadata row in the database
-	_, err = db.Exec("INSERT INTO metadata (driver_name, driver_version, wallet_id, wallet_name, mep_encrypted, mdk_encrypted, max_key_idx_encrypted) VALUES(?, ?, ?, ?, ?, ?, ?)", sqliteWalletDriverName, sqliteWalletDriverVersion, id, name, encryptedMEPBlob, encryptedMDKBlob, encryptedIdxBlob)
+	_, err = db.Exec("INSERT INTO metadata (driver_name, driver_version, wallet_id, wallet_name, mep_encrypted, mdk_encrypted, max_key_idx_encrypted) VALUES(?, ?, ?, ?, ?, ?, ?)", sqliteDriverFormat.driverName, sqliteDriverFormat.driverVersion, id, name, encryptedMEPBlob, encryptedMDKBlob, encryptedIdxBlob)
 	if err != nil {
 		return errDatabase
 	}
@@ -493,136 +265,23 @@ func (swd *SQLiteWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, m
 func (swd *SQLiteWalletDriver) FetchWallet(id []byte) (sqWallet wallet.Wallet, err error) {
 	swd.mux.Lock()
 	defer swd.mux.Unlock()
-	return swd.fetchWalletLocked(id)
-}
 
-// fetchWalletLocked is the guts of FetchWallet. Precondition: we must hold
-// swd.mux
-func (swd *SQLiteWalletDriver) fetchWalletLocked(id []byte) (sqWallet wallet.Wallet, err error) {
-	// We want to allow users to drop in database files from other systems and
-	// potentially rename the file, so we iterate over the wallet files instead
-	// of looking up what we would have named them to begin with.
-	dbPaths, err := swd.findDBPathsByID(id)
+	dbPath, err := swd.findDBPathLocked(id)
 	if err != nil {
 		return
 	}
 
-	// Do we have this wallet?
-	if len(dbPaths) == 0 {
-		err = errWalletNotFound
-		return
-	}
-
-	// Ensure only one wallet has this ID
-	if len(dbPaths) > 1 {
-		err = errIDConflict
-		return
-	}
-
-	// Connect to the database
-	dbPath := dbPaths[0]
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
-	if err != nil {
-		err = errDatabaseConnect
-		return
-	}
-	defer db.Close()
-
 	// Fill in the wallet details
 	sqWallet = &SQLiteWallet{
-		masterEncryptionKey: nil,
-		masterDerivationKey: nil,
-		dbPath:              dbPath,
-		cfg:                 swd.sqliteCfg,
+		sqliteWalletDB: sqliteWalletDB{
+			dbPath: dbPath,
+			format: &sqliteDriverFormat,
+		},
+		cfg: swd.sqliteCfg,
 	}
 	return
 }
 
-// RenameWallet renames the wallet with the given id to newName. It does not
-// rename the database file itself, because doing so safely is tricky
-func (swd *SQLiteWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
-	// Grab a lock so our duplicate names check can't race
-	swd.mux.Lock()
-	defer swd.mux.Unlock()
-
-	// Ensure a wallet with this name doesn't already exist
-	sameNameDBPaths, err := swd.findDBPathsByName(newName)
-	if err != nil {
-		return err
-	}
-	if len(sameNameDBPaths) != 0 {
-		return errSameName
-	}
-
-	// Fetch the wallet
-	curWallet, err := swd.fetchWalletLocked(id)
-	if err != nil {
-		return err
-	}
-	sqWallet, ok := curWallet.(*SQLiteWallet)
-	if !ok {
-		return errSQLiteWrongType
-	}
-
-	// Check the password
-	err = sqWallet.CheckPassword(pw)
-	if err != nil {
-		return err
-	}
-
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sqWallet.dbPath))
-	if err != nil {
-		return errDatabaseConnect
-	}
-	defer db.Close()
-
-	// Update the metadata row
-	_, err = db.Exec("UPDATE metadata SET wallet_name=? WHERE wallet_id=?", newName, id)
-	if err != nil {
-		return errDatabase
-	}
-
-	return nil
-}
-
-// Metadata builds a wallet.Metadata from our metadata table
-func (sw *SQLiteWallet) Metadata() (meta wallet.Metadata, err error) {
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
-	if err != nil {
-		err = errDatabaseConnect
-		return
-	}
-	defer db.Close()
-
-	return walletMetadataFromDB(db)
-}
-
-// decryptAndGetMasterKey fetches the master key from the metadata table and
-// attempts to decrypt it with the passed password
-func (sw *SQLiteWallet) decryptAndGetMasterKey(pw []byte) ([]byte, error) {
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
-	if err != nil {
-		return nil, errDatabaseConnect
-	}
-	defer db.Close()
-
-	var encryptedMEPBlob []byte
-	err = db.Get(&encryptedMEPBlob, "SELECT mep_encrypted FROM metadata LIMIT 1")
-	if err != nil {
-		return nil, errDatabase
-	}
-
-	mep, err := decryptBlobWithPassword(encryptedMEPBlob, PTMasterKey, pw)
-	if err != nil {
-		return nil, err
-	}
-
-	return mep, nil
-}
-
 // decryptAndGetMasterDerivationKey fetches the mdk from the metadata table and
 // attempts to decrypt it with the master password
 func (sw *SQLiteWallet) decryptAndGetMasterDerivationKey(pw []byte) ([]byte, error) {
@@ -665,57 +324,7 @@ func (sw *SQLiteWallet) Init(pw []byte) error {
 	// Initialize wallet
 	sw.masterEncryptionKey = masterEncryptionKey
 	sw.masterDerivationKey = masterDerivationKey
-	err = fillRandomBytes(sw.walletPasswordSalt[:])
-	if err != nil {
-		return err
-	}
-	sw.walletPasswordHash = fastHashWithSalt(pw, sw.walletPasswordSalt[:])
-	sw.walletPasswordHashed = true
-	return nil
-}
-
-// CheckPassword checks that the database can be decrypted with the password.
-// It's the same as Init but doesn't store the decrypted key
-func (sw *SQLiteWallet) CheckPassword(pw []byte) error {
-	if sw.walletPasswordHashed {
-		// Check against pre-computed password hash
-		pwhash := fastHashWithSalt(pw, sw.walletPasswordSalt[:])
-		if subtle.ConstantTimeCompare(pwhash[:], sw.walletPasswordHash[:]) == 1 {
-			return nil
-		}
-		return errDecrypt
-	}
-
-	_, err := sw.decryptAndGetMasterKey(pw)
-	return err
-}
-
-// ListKeys lists all the addresses in the wallet
-func (sw *SQLiteWallet) ListKeys() (addrs []crypto.Digest, err error) {
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
-	if err != nil {
-		return nil, errDatabaseConnect
-	}
-	defer db.Close()
-
-	var addrByteSlices [][]byte
-	var tmp crypto.Digest
-	// We can't select directly into a crypto.Digest array, unfortunately.
-	// Instead, we select into a slice of byte slices, and then convert each of
-	// those slices into a crypto.Digest.
-	err = db.Select(&addrByteSlices, "SELECT address FROM keys")
-	if err != nil {
-		err = errDatabase
-		return
-	}
-
-	for _, byteSlice := range addrByteSlices {
-		copy(tmp[:], byteSlice)
-		addrs = append(addrs, tmp)
-	}
-
-	return
+	return sw.hashPassword(pw)
 }
 
 // ExportMasterDerivationKey decrypts the encrypted MDK and returns it
@@ -974,138 +583,15 @@ func (sw *SQLiteWallet) generateKeyTxLocked(tx *sqlx.Tx) (addr crypto.Digest, er
 	return genAddr, nil
 }
 
-// DeleteKey deletes the key corresponding to the passed public key from the wallet
-func (sw *SQLiteWallet) DeleteKey(addr crypto.Digest, pw []byte) (err error) {
-	// Check the password
-	err = sw.CheckPassword(pw)
-	if err != nil {
-		return
-	}
-
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
-	if err != nil {
-		return errDatabaseConnect
-	}
-	defer db.Close()
-
-	// Delete the key
-	_, err = db.Exec("DELETE FROM keys WHERE address=?", addr[:])
-	if err != nil {
-		err = errDatabase
-	}
-	return
-}
-
-// ImportMultisigAddr imports a multisig address, taking in version, threshold,
-// and public keys
-func (sw *SQLiteWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (addr crypto.Digest, err error) {
-	addr, err = crypto.MultisigAddrGen(version, threshold, pks)
-	if err != nil {
-		return
-	}
-
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
-	if err != nil {
-		err = errDatabaseConnect
-		return
-	}
-	defer db.Close()
-
-	_, err = db.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], version, threshold, msgpackEncode(pks))
-	err = checkDBError(err)
-	if err != nil {
-		return
-	}
-	return
-}
-
-// LookupMultisigPreimage exports the preimage of a multisig address: version,
-// threshold, public keys
-func (sw *SQLiteWallet) LookupMultisigPreimage(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
-	if err != nil {
-		err = errDatabaseConnect
-		return
-	}
-	defer db.Close()
-
-	var pksCandidate []crypto.PublicKey
-	var versionCandidate, thresholdCandidate int
-	var pksBlob []byte
-
-	row := db.QueryRow("SELECT version, threshold, pks FROM msig_addrs WHERE address=?", addr[:])
-	err = row.Scan(&versionCandidate, &thresholdCandidate, &pksBlob)
-	if err != nil {
-		err = errMsigDataNotFound
-		return
-	}
-
-	// Decode the candidate
-	err = msgpackDecode(pksBlob, &pksCandidate)
-	if err != nil {
-		return
-	}
-
-	// Sanity check: make sure the preimage is correct
-	addr2, err := crypto.MultisigAddrGen(uint8(versionCandidate), uint8(thresholdCandidate), pksCandidate)
-	if addr2 != addr {
-		err = errTampering
-		return
-	}
-
-	version = uint8(versionCandidate)
-	threshold = uint8(thresholdCandidate)
-	pks = pksCandidate
-	return
-}
-
-// DeleteMultisigAddr deletes the multisig address and preimage from the database
-func (sw *SQLiteWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) (err error) {
-	// Check the password
-	err = sw.CheckPassword(pw)
-	if err != nil {
-		return
-	}
-
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
-	if err != nil {
-		return errDatabaseConnect
-	}
-	defer db.Close()
-
-	_, err = db.Exec("DELETE FROM msig_addrs WHERE address=?", addr[:])
-	if err != nil {
-		err = errDatabase
-	}
-	return
+// GenerateKeyAtPath is not supported: sqlite wallets derive their keys from
+// the master derivation key with their own scheme, not along BIP44 paths
+func (sw *SQLiteWallet) GenerateKeyAtPath(account uint32, index uint32) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
 }
 
-// ListMultisigAddrs lists the multisig addresses whose preimages we know
-func (sw *SQLiteWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
-	// Connect to the database
-	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
-	if err != nil {
-		err = errDatabaseConnect
-		return
-	}
-	defer db.Close()
-
-	var addrByteSlices [][]byte
-	var tmp crypto.Digest
-	err = db.Select(&addrByteSlices, "SELECT address FROM msig_addrs")
-	if err != nil {
-		err = errDatabase
-		return
-	}
-	for _, addr := range addrByteSlices {
-		copy(tmp[:], addr)
-		addrs = append(addrs, tmp)
-	}
-	return
+// ExportAccountKey is not supported, for the same reason as GenerateKeyAtPath
+func (sw *SQLiteWallet) ExportAccountKey(account uint32, pw []byte) (crypto.BIP32Ed25519PublicKey, error) {
+	return crypto.BIP32Ed25519PublicKey{}, errNotSupported
 }
 
 // SignTransaction signs the passed transaction with the private key whose public key is provided, or
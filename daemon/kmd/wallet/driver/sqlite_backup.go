@@ -0,0 +1,393 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"os"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+const (
+	// sqliteWalletBackupVersion is the version of the backup bundles we produce
+	sqliteWalletBackupVersion = 1
+)
+
+// walletBackupBundle is the envelope of a wallet backup. It is left in the
+// clear so that we can reject backups from other drivers or versions before
+// trying to decrypt them. The blob is an encryptedDBBlob of a
+// sqliteWalletBackup, encrypted with the backup passphrase: secretbox
+// authenticates it, so any tampering with the bundle fails to decrypt.
+type walletBackupBundle struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version    uint32 `codec:"v"`
+	DriverName string `codec:"driver"`
+	Blob       []byte `codec:"blob"`
+}
+
+// sqliteWalletBackup holds everything required to rebuild a wallet. Keys
+// derived from the master derivation key are stored as their indices and
+// derived again on restore, while imported keys are stored in full.
+type sqliteWalletBackup struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version             uint32                     `codec:"v"`
+	WalletName          []byte                     `codec:"name"`
+	MasterDerivationKey crypto.MasterDerivationKey `codec:"mdk"`
+	MaxKeyIndex         uint64                     `codec:"maxidx"`
+	DerivedKeys         []uint64                   `codec:"derived"`
+	ImportedKeys        []crypto.PrivateKey        `codec:"imported"`
+	Multisigs           []sqliteBackupMultisig     `codec:"msig"`
+}
+
+// sqliteBackupMultisig is the preimage of a multisig address
+type sqliteBackupMultisig struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Version   uint8              `codec:"v"`
+	Threshold uint8              `codec:"thr"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
+// ExportBackup checks the password and returns an encrypted bundle holding
+// the master derivation key, the key derivation index, the imported keys and
+// the multisig preimages of the wallet. The bundle is encrypted with the
+// backup passphrase, which is required again to restore it. Unlike the wallet
+// password, the backup passphrase may not be blank, since the bundle leaves
+// kmd.
+func (sw *SQLiteWallet) ExportBackup(pw []byte, backupPW []byte) (bundle []byte, err error) {
+	if len(backupPW) == 0 {
+		err = errBackupBlankPassword
+		return
+	}
+
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	// Read everything from a single transaction so that the backup is
+	// consistent
+	tx, err := db.Beginx()
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	defer tx.Rollback()
+
+	backup := sqliteWalletBackup{
+		Version: sqliteWalletBackupVersion,
+	}
+	copy(backup.MasterDerivationKey[:], sw.masterDerivationKey)
+
+	var metadata struct {
+		WalletName         []byte `db:"wallet_name"`
+		MaxKeyIdxEncrypted []byte `db:"max_key_idx_encrypted"`
+	}
+	err = tx.Get(&metadata, "SELECT wallet_name, max_key_idx_encrypted FROM metadata LIMIT 1")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	backup.WalletName = metadata.WalletName
+
+	// Decrypt and decode the highest index
+	maxKeyIdxBlob, err := decryptBlobWithPassword(metadata.MaxKeyIdxEncrypted, PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(maxKeyIdxBlob, &backup.MaxKeyIndex)
+	if err != nil {
+		return
+	}
+
+	// Collect the keys. Derived keys are checked against the master
+	// derivation key, so that we don't back up keys we couldn't restore
+	var keys []struct {
+		Address            []byte  `db:"address"`
+		SecretKeyEncrypted []byte  `db:"secret_key_encrypted"`
+		KeyIdx             *uint64 `db:"key_idx"`
+	}
+	err = tx.Select(&keys, "SELECT address, secret_key_encrypted, key_idx FROM keys ORDER BY rowid")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	for _, key := range keys {
+		var addr crypto.Digest
+		copy(addr[:], key.Address)
+		if key.KeyIdx != nil {
+			var pk crypto.PublicKey
+			pk, _, err = extractKeyWithIndex(sw.masterDerivationKey, *key.KeyIdx)
+			if err != nil {
+				return
+			}
+			if publicKeyToAddress(pk) != addr {
+				err = errTampering
+				return
+			}
+			backup.DerivedKeys = append(backup.DerivedKeys, *key.KeyIdx)
+			continue
+		}
+
+		// Decrypt and decode the imported secret key
+		var skEncoded []byte
+		skEncoded, err = decryptBlobWithPassword(key.SecretKeyEncrypted, PTSecretKey, sw.masterEncryptionKey)
+		if err != nil {
+			return
+		}
+		var sk crypto.PrivateKey
+		err = msgpackDecode(skEncoded, &sk)
+		if err != nil {
+			return
+		}
+
+		// Ensure the secret key matches its address, like fetchSecretKey does
+		var pk crypto.PublicKey
+		pk, err = crypto.SecretKeyToPublicKey(sk)
+		if err != nil {
+			err = errSKToPK
+			return
+		}
+		if publicKeyToAddress(pk) != addr {
+			err = errTampering
+			return
+		}
+		backup.ImportedKeys = append(backup.ImportedKeys, sk)
+	}
+
+	// Collect the multisig preimages
+	var msigs []struct {
+		Version   int    `db:"version"`
+		Threshold int    `db:"threshold"`
+		PKs       []byte `db:"pks"`
+	}
+	err = tx.Select(&msigs, "SELECT version, threshold, pks FROM msig_addrs ORDER BY rowid")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	for _, msig := range msigs {
+		preimage := sqliteBackupMultisig{
+			Version:   uint8(msig.Version),
+			Threshold: uint8(msig.Threshold),
+		}
+		err = msgpackDecode(msig.PKs, &preimage.PKs)
+		if err != nil {
+			return
+		}
+		backup.Multisigs = append(backup.Multisigs, preimage)
+	}
+
+	// Encrypt the backup with the backup passphrase
+	blob, err := encryptBlobWithPasswordBlankOK(msgpackEncode(backup), PTWalletBackup, backupPW, &sw.cfg.ScryptParams)
+	if err != nil {
+		return
+	}
+
+	bundle = msgpackEncode(walletBackupBundle{
+		Version:    sqliteWalletBackupVersion,
+		DriverName: sqliteWalletDriverName,
+		Blob:       blob,
+	})
+	return
+}
+
+// decryptWalletBackup checks the envelope of a backup bundle and decrypts it
+// with the backup passphrase
+func decryptWalletBackup(bundle []byte, backupPW []byte) (backup sqliteWalletBackup, err error) {
+	if len(backupPW) == 0 {
+		err = errBackupBlankPassword
+		return
+	}
+
+	var envelope walletBackupBundle
+	err = msgpackDecode(bundle, &envelope)
+	if err != nil {
+		err = errBackupMalformed
+		return
+	}
+	if envelope.DriverName != sqliteWalletDriverName {
+		err = errBackupWrongDriver
+		return
+	}
+	if envelope.Version != sqliteWalletBackupVersion {
+		err = errBackupVersion
+		return
+	}
+
+	plaintext, err := decryptBlobWithPassword(envelope.Blob, PTWalletBackup, backupPW)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(plaintext, &backup)
+	if err != nil {
+		err = errBackupMalformed
+		return
+	}
+
+	// The version is also part of the authenticated plaintext, so it can't
+	// be swapped in the envelope
+	if backup.Version != envelope.Version {
+		err = errBackupMalformed
+		return
+	}
+	return
+}
+
+// RestoreWallet decrypts a backup bundle produced by ExportBackup with the
+// backup passphrase, and creates a new wallet with the given name, id and
+// password from it. If the name is blank, the name of the backed up wallet is
+// used.
+func (swd *SQLiteWalletDriver) RestoreWallet(name []byte, id []byte, pw []byte, backupPW []byte, bundle []byte) error {
+	backup, err := decryptWalletBackup(bundle, backupPW)
+	if err != nil {
+		return err
+	}
+
+	if len(name) == 0 {
+		name = backup.WalletName
+	}
+
+	err = swd.CreateWallet(name, id, pw, backup.MasterDerivationKey)
+	if err != nil {
+		return err
+	}
+
+	w, err := swd.FetchWallet(id)
+	if err != nil {
+		return err
+	}
+	sw, ok := w.(*SQLiteWallet)
+	if !ok {
+		return errSQLiteWrongType
+	}
+
+	err = sw.Init(pw)
+	if err == nil {
+		err = sw.restoreBackup(backup)
+	}
+	if err != nil {
+		// Don't leave a partially restored wallet around
+		os.Remove(sw.dbPath)
+		return err
+	}
+	return nil
+}
+
+// restoreBackup inserts the keys and multisig preimages of a backup into a
+// freshly created wallet, and restores its key derivation index
+func (sw *SQLiteWallet) restoreBackup(backup sqliteWalletBackup) (err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	// Begin an exclusive database transaction
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	for _, idx := range backup.DerivedKeys {
+		if idx == 0 || idx >= sqliteIntOverflow || idx > backup.MaxKeyIndex {
+			return errBackupMalformed
+		}
+		var pk crypto.PublicKey
+		var sk crypto.PrivateKey
+		pk, sk, err = extractKeyWithIndex(sw.masterDerivationKey, idx)
+		if err != nil {
+			return
+		}
+		err = sw.restoreKeyTxLocked(tx, pk, sk, &idx)
+		if err != nil {
+			return
+		}
+	}
+
+	for _, rawSK := range backup.ImportedKeys {
+		// As in ImportKey, don't trust the public part of the secret key
+		var seed crypto.Seed
+		seed, err = crypto.SecretKeyToSeed(rawSK)
+		if err != nil {
+			return errSKToSeed
+		}
+		sigSecrets := crypto.GenerateSignatureSecrets(seed)
+		err = sw.restoreKeyTxLocked(tx, crypto.PublicKey(sigSecrets.SignatureVerifier), crypto.PrivateKey(sigSecrets.SK), nil)
+		if err != nil {
+			return
+		}
+	}
+
+	for _, msig := range backup.Multisigs {
+		var addr crypto.Digest
+		addr, err = crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil {
+			return
+		}
+		_, err = tx.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], msig.Version, msig.Threshold, msgpackEncode(msig.PKs))
+		err = checkDBError(err)
+		if err != nil {
+			return
+		}
+	}
+
+	if backup.MaxKeyIndex >= sqliteIntOverflow {
+		return errBackupMalformed
+	}
+	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(backup.MaxKeyIndex), PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+	_, err = tx.Exec("UPDATE metadata SET max_key_idx_encrypted = ?", encryptedIdxBlob)
+	if err != nil {
+		return errDatabase
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// restoreKeyTxLocked encrypts and inserts a key, along with its derivation
+// index if it was derived from the master derivation key
+func (sw *SQLiteWallet) restoreKeyTxLocked(tx *sqlx.Tx, pk crypto.PublicKey, sk crypto.PrivateKey, keyIdx *uint64) error {
+	skEncrypted, err := encryptBlobWithKey(msgpackEncode(sk), PTSecretKey, sw.masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+	addr := publicKeyToAddress(pk)
+	_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, keyIdx)
+	return checkDBError(err)
+}
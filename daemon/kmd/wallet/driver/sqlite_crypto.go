@@ -51,6 +51,10 @@ var (
 	PTMasterDerivationKey plaintextType = "master_derivation_key"
 	// PTMaxKeyIdx is the plaintext type for the maximum key index
 	PTMaxKeyIdx plaintextType = "max_key_idx"
+	// PTWalletBackup is the plaintext type for a wallet backup bundle
+	PTWalletBackup plaintextType = "wallet_backup"
+	// PTHDWalletRoot is the plaintext type for the seed or account key of an hd wallet
+	PTHDWalletRoot plaintextType = "hd_wallet_root"
 )
 
 // typedPlaintext prevents us from confusing differently typed data encrypted
@@ -45,3 +45,7 @@ var errNameTooLong = fmt.Errorf("wallet name too long, must be <= %d bytes", sql
 var errIDTooLong = fmt.Errorf("wallet id too long, must be <= %d bytes", sqliteMaxWalletIDLen)
 var errMsigWrongAddr = fmt.Errorf("given multisig preimage hashes to neither Sender nor AuthAddr")
 var errMsigWrongKey = fmt.Errorf("given key is not a possible signer for this multisig")
+var errBackupVersion = fmt.Errorf("unsupported wallet backup version")
+var errBackupWrongDriver = fmt.Errorf("wallet backup was made by another wallet driver")
+var errBackupMalformed = fmt.Errorf("malformed wallet backup")
+var errBackupBlankPassword = fmt.Errorf("wallet backup passphrase must not be blank")
@@ -0,0 +1,596 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// sqliteWalletFormat describes the wallet databases of a driver storing its
+// wallets in sqlite databases. The metadata table of every database holds the
+// name and version of the driver which created it, so that drivers sharing a
+// directory don't pick each other's wallets up.
+type sqliteWalletFormat struct {
+	driverName            string
+	driverVersion         uint32
+	supportsMnemonicUX    bool
+	supportsMasterKey     bool
+	supportedTransactions []protocol.TxType
+}
+
+// metadataFromDB accepts a *sqlx.DB and extracts a wallet.Metadata from it
+func (f *sqliteWalletFormat) metadataFromDB(db *sqlx.DB) (metadata wallet.Metadata, err error) {
+	var driverName string
+	var walletID, walletName []byte
+	var driverVersion uint32
+
+	// Fetch the metadata to fill in a Metadata
+	metadataRow := db.QueryRow("SELECT driver_name, driver_version, wallet_id, wallet_name FROM metadata LIMIT 1")
+	err = metadataRow.Scan(&driverName, &driverVersion, &walletID, &walletName)
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	// Ensure this database is the correct version + driver
+	if driverName != f.driverName {
+		err = errWrongDriver
+		return
+	}
+	if driverVersion != f.driverVersion {
+		err = errWrongDriverVer
+		return
+	}
+
+	// Build the Metadata
+	metadata = wallet.Metadata{
+		ID:                    walletID,
+		Name:                  walletName,
+		DriverName:            driverName,
+		DriverVersion:         driverVersion,
+		SupportsMnemonicUX:    f.supportsMnemonicUX,
+		SupportsMasterKey:     f.supportsMasterKey,
+		SupportedTransactions: f.supportedTransactions,
+	}
+
+	return
+}
+
+// metadataFromDBPath accepts path to a wallet database and returns a Metadata
+// struct with information about it
+func (f *sqliteWalletFormat) metadataFromDBPath(dbPath string) (metadata wallet.Metadata, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+	return f.metadataFromDB(db)
+}
+
+// sqliteWalletStore is the wallets directory of a driver storing its wallets
+// in sqlite databases. It finds the databases of the wallets, and claims the
+// names and ids of new wallets.
+type sqliteWalletStore struct {
+	format *sqliteWalletFormat
+	dir    string
+	mux    *deadlock.Mutex
+
+	claimedWallets [][][]byte
+}
+
+// makeSQLiteWalletStore makes the wallets directory if it doesn't already
+// exist, and returns a store for the wallets of the format in it
+func makeSQLiteWalletStore(format *sqliteWalletFormat, dir string) (sqliteWalletStore, error) {
+	err := os.Mkdir(dir, sqliteWalletsDirPermissions)
+	if err != nil && !os.IsExist(err) {
+		return sqliteWalletStore{}, fmt.Errorf("couldn't create wallets directory at %s: %v", dir, err)
+	}
+
+	// Initialize lock. When creating a new wallet, this lock protects us from
+	// creating another with the same name or ID
+	return sqliteWalletStore{format: format, dir: dir, mux: &deadlock.Mutex{}}, nil
+}
+
+// walletsDir returns the directory of the wallet databases
+func (s *sqliteWalletStore) walletsDir() string {
+	return s.dir
+}
+
+// potentialWalletPaths lists paths to plausible databases in the wallets
+// directory. This means things that aren't directories and that match the
+// databaseFilenameRegex
+func (s *sqliteWalletStore) potentialWalletPaths() (paths []string, err error) {
+	// List all files and folders in the wallets directory
+	wDir := s.walletsDir()
+	files, err := os.ReadDir(wDir)
+	if err != nil {
+		return
+	}
+	for _, f := range files {
+		// Skip things that definitely aren't databases
+		if f.IsDir() || !databaseFilenameRegex.Match([]byte(f.Name())) {
+			continue
+		}
+		paths = append(paths, filepath.Join(wDir, f.Name()))
+	}
+	return
+}
+
+// ListWalletMetadatas opens everything that looks like a wallet in the
+// walletsDir() and tries to extract its metadata. It does not fail if it
+// is unable to read metadata from one of the files it attempts to open
+func (s *sqliteWalletStore) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	paths, err := s.potentialWalletPaths()
+	if err != nil {
+		return
+	}
+	for _, path := range paths {
+		// Ignore errors in case people dumped non-database files into this directory
+		walletMetadata, err := s.format.metadataFromDBPath(path)
+		if err != nil {
+			continue
+		}
+		metadatas = append(metadatas, walletMetadata)
+	}
+	return metadatas, nil
+}
+
+// findDBPathsById returns the paths to wallets with the specified id
+func (s *sqliteWalletStore) findDBPathsByID(id []byte) (paths []string, err error) {
+	return s.findDBPathsByField("ID", id)
+}
+
+// findDBPathsByName returns the paths to wallets with the specified name
+func (s *sqliteWalletStore) findDBPathsByName(name []byte) (paths []string, err error) {
+	return s.findDBPathsByField("Name", name)
+}
+
+// findDBPathsByField is a helper for findDBPathsByID and findDBPathsByName. It
+// iterates over potential wallet databases and searches for the given
+// testValue in the appropriate field
+func (s *sqliteWalletStore) findDBPathsByField(fieldName string, testValue []byte) (paths []string, err error) {
+	potentialPaths, err := s.potentialWalletPaths()
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range potentialPaths {
+		// Ignore errors in case people dumped non-database files into this directory
+		walletMetadata, err := s.format.metadataFromDBPath(path)
+		if err != nil {
+			continue
+		}
+		// Fetch the approriate field
+		var fieldValue []byte
+		switch fieldName {
+		case "ID":
+			fieldValue = walletMetadata.ID
+		case "Name":
+			fieldValue = walletMetadata.Name
+		default:
+			panic(fmt.Sprintf("unknown fieldName %s", fieldName))
+		}
+		// Check if there's a match
+		if bytes.Equal(fieldValue, testValue) {
+			paths = append(paths, path)
+		}
+	}
+	return paths, nil
+}
+
+// findDBPathLocked returns the path to the wallet with the given id, failing
+// if there's more than one. Precondition: we must hold s.mux
+func (s *sqliteWalletStore) findDBPathLocked(id []byte) (dbPath string, err error) {
+	// We want to allow users to drop in database files from other systems and
+	// potentially rename the file, so we iterate over the wallet files instead
+	// of looking up what we would have named them to begin with.
+	dbPaths, err := s.findDBPathsByID(id)
+	if err != nil {
+		return
+	}
+
+	// Do we have this wallet?
+	if len(dbPaths) == 0 {
+		err = errWalletNotFound
+		return
+	}
+
+	// Ensure only one wallet has this ID
+	if len(dbPaths) > 1 {
+		err = errIDConflict
+		return
+	}
+	return dbPaths[0], nil
+}
+
+// nameIDToPath turns a wallet name and wallet id into a path to the
+// corresponding database file to create
+func (s *sqliteWalletStore) nameIDToPath(name []byte, id []byte) string {
+	// It's OK if filtered names collide with each other, because ID is unique
+	safeName := disallowedFilenameRegex.ReplaceAll(name, []byte(""))
+	// wallet ID should already be safe, but filter it just in case
+	safeID := disallowedFilenameRegex.ReplaceAll(id, []byte(""))
+
+	var fileName string
+	if bytes.Equal(safeName, safeID) {
+		// If name and ID are equal, just use one of them
+		fileName = fmt.Sprintf("%s.db", safeID)
+	} else {
+		// Otherwise, append them
+		fileName = fmt.Sprintf("%s.%s.db", safeName, safeID)
+	}
+
+	// Append to dataDir
+	return filepath.Join(s.walletsDir(), fileName)
+}
+
+// claimWalletNameID ensures that no other wallet has the name or the id, and
+// returns the path to the database of the new wallet
+func (s *sqliteWalletStore) claimWalletNameID(name []byte, id []byte) (dbPath string, err error) {
+	if len(name) > sqliteMaxWalletNameLen {
+		return "", errNameTooLong
+	}
+
+	if len(id) > sqliteMaxWalletIDLen {
+		return "", errIDTooLong
+	}
+
+	// Grab our lock to avoid races with duplicate wallet names/ids
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	for _, nameID := range s.claimedWallets {
+		if bytes.Equal(nameID[0], name) {
+			return "", errSameName
+		}
+		if bytes.Equal(nameID[1], id) {
+			return "", errSameID
+		}
+	}
+
+	// name, id -> "/data/dir/name-id.db"
+	dbPath = s.nameIDToPath(name, id)
+
+	// Ensure the wallet with this filename doesn't already exist, and that we
+	// have permissions to access the wallet directory
+	_, err = os.Stat(dbPath)
+	if err == nil {
+		return "", errSameID
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	// Ensure a wallet with this name doesn't already exist. s.mux is
+	// locked above to avoid races here
+	sameNameDBPaths, err := s.findDBPathsByName(name)
+	if err != nil {
+		return
+	}
+	if len(sameNameDBPaths) != 0 {
+		return "", errSameName
+	}
+
+	// Ensure a wallet with this id doesn't already exist. As above, we use
+	// s.mux to avoid races
+	sameIDDBPaths, err := s.findDBPathsByID(id)
+	if err != nil {
+		return
+	}
+	if len(sameIDDBPaths) != 0 {
+		return "", errSameID
+	}
+
+	s.claimedWallets = append(s.claimedWallets, [][]byte{name, id})
+	return
+}
+
+// RenameWallet renames the wallet with the given id to newName. It does not
+// rename the database file itself, because doing so safely is tricky
+func (s *sqliteWalletStore) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	// Grab a lock so our duplicate names check can't race
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	// Ensure a wallet with this name doesn't already exist
+	sameNameDBPaths, err := s.findDBPathsByName(newName)
+	if err != nil {
+		return err
+	}
+	if len(sameNameDBPaths) != 0 {
+		return errSameName
+	}
+
+	// Fetch the wallet
+	dbPath, err := s.findDBPathLocked(id)
+	if err != nil {
+		return err
+	}
+	walletDB := sqliteWalletDB{dbPath: dbPath, format: s.format}
+
+	// Check the password
+	err = walletDB.CheckPassword(pw)
+	if err != nil {
+		return err
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	// Update the metadata row
+	_, err = db.Exec("UPDATE metadata SET wallet_name=? WHERE wallet_id=?", newName, id)
+	if err != nil {
+		return errDatabase
+	}
+
+	return nil
+}
+
+// sqliteWalletDB is the database of a wallet stored by a sqliteWalletStore.
+// It implements the parts of wallet.Wallet which don't depend on how the
+// wallet gets its keys: the password checks, the list of addresses and the
+// multisig preimages.
+type sqliteWalletDB struct {
+	dbPath               string
+	format               *sqliteWalletFormat
+	walletPasswordSalt   [saltLen]byte
+	walletPasswordHash   crypto.Digest
+	walletPasswordHashed bool
+}
+
+// Metadata builds a wallet.Metadata from our metadata table
+func (w *sqliteWalletDB) Metadata() (meta wallet.Metadata, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	return w.format.metadataFromDB(db)
+}
+
+// decryptAndGetMasterKey fetches the master key from the metadata table and
+// attempts to decrypt it with the passed password
+func (w *sqliteWalletDB) decryptAndGetMasterKey(pw []byte) ([]byte, error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	var encryptedMEPBlob []byte
+	err = db.Get(&encryptedMEPBlob, "SELECT mep_encrypted FROM metadata LIMIT 1")
+	if err != nil {
+		return nil, errDatabase
+	}
+
+	mep, err := decryptBlobWithPassword(encryptedMEPBlob, PTMasterKey, pw)
+	if err != nil {
+		return nil, err
+	}
+
+	return mep, nil
+}
+
+// hashPassword keeps a salted hash of the password of an initialized wallet,
+// so that CheckPassword doesn't have to decrypt the master key again
+func (w *sqliteWalletDB) hashPassword(pw []byte) error {
+	err := fillRandomBytes(w.walletPasswordSalt[:])
+	if err != nil {
+		return err
+	}
+	w.walletPasswordHash = fastHashWithSalt(pw, w.walletPasswordSalt[:])
+	w.walletPasswordHashed = true
+	return nil
+}
+
+// CheckPassword checks that the database can be decrypted with the password.
+// It's the same as Init but doesn't store the decrypted key
+func (w *sqliteWalletDB) CheckPassword(pw []byte) error {
+	if w.walletPasswordHashed {
+		// Check against pre-computed password hash
+		pwhash := fastHashWithSalt(pw, w.walletPasswordSalt[:])
+		if subtle.ConstantTimeCompare(pwhash[:], w.walletPasswordHash[:]) == 1 {
+			return nil
+		}
+		return errDecrypt
+	}
+
+	_, err := w.decryptAndGetMasterKey(pw)
+	return err
+}
+
+// ListKeys lists all the addresses in the wallet
+func (w *sqliteWalletDB) ListKeys() (addrs []crypto.Digest, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.dbPath))
+	if err != nil {
+		return nil, errDatabaseConnect
+	}
+	defer db.Close()
+
+	var addrByteSlices [][]byte
+	var tmp crypto.Digest
+	// We can't select directly into a crypto.Digest array, unfortunately.
+	// Instead, we select into a slice of byte slices, and then convert each of
+	// those slices into a crypto.Digest.
+	err = db.Select(&addrByteSlices, "SELECT address FROM keys")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	for _, byteSlice := range addrByteSlices {
+		copy(tmp[:], byteSlice)
+		addrs = append(addrs, tmp)
+	}
+
+	return
+}
+
+// DeleteKey deletes the key corresponding to the passed public key from the wallet
+func (w *sqliteWalletDB) DeleteKey(addr crypto.Digest, pw []byte) (err error) {
+	// Check the password
+	err = w.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	// Delete the key
+	_, err = db.Exec("DELETE FROM keys WHERE address=?", addr[:])
+	if err != nil {
+		err = errDatabase
+	}
+	return
+}
+
+// ImportMultisigAddr imports a multisig address, taking in version, threshold,
+// and public keys
+func (w *sqliteWalletDB) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (addr crypto.Digest, err error) {
+	addr, err = crypto.MultisigAddrGen(version, threshold, pks)
+	if err != nil {
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	_, err = db.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], version, threshold, msgpackEncode(pks))
+	err = checkDBError(err)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// LookupMultisigPreimage exports the preimage of a multisig address: version,
+// threshold, public keys
+func (w *sqliteWalletDB) LookupMultisigPreimage(addr crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var pksCandidate []crypto.PublicKey
+	var versionCandidate, thresholdCandidate int
+	var pksBlob []byte
+
+	row := db.QueryRow("SELECT version, threshold, pks FROM msig_addrs WHERE address=?", addr[:])
+	err = row.Scan(&versionCandidate, &thresholdCandidate, &pksBlob)
+	if err != nil {
+		err = errMsigDataNotFound
+		return
+	}
+
+	// Decode the candidate
+	err = msgpackDecode(pksBlob, &pksCandidate)
+	if err != nil {
+		return
+	}
+
+	// Sanity check: make sure the preimage is correct
+	addr2, err := crypto.MultisigAddrGen(uint8(versionCandidate), uint8(thresholdCandidate), pksCandidate)
+	if addr2 != addr {
+		err = errTampering
+		return
+	}
+
+	version = uint8(versionCandidate)
+	threshold = uint8(thresholdCandidate)
+	pks = pksCandidate
+	return
+}
+
+// DeleteMultisigAddr deletes the multisig address and preimage from the database
+func (w *sqliteWalletDB) DeleteMultisigAddr(addr crypto.Digest, pw []byte) (err error) {
+	// Check the password
+	err = w.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	_, err = db.Exec("DELETE FROM msig_addrs WHERE address=?", addr[:])
+	if err != nil {
+		err = errDatabase
+	}
+	return
+}
+
+// ListMultisigAddrs lists the multisig addresses whose preimages we know
+func (w *sqliteWalletDB) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(w.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var addrByteSlices [][]byte
+	var tmp crypto.Digest
+	err = db.Select(&addrByteSlices, "SELECT address FROM msig_addrs")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+	for _, addr := range addrByteSlices {
+		copy(tmp[:], addr)
+		addrs = append(addrs, tmp)
+	}
+	return
+}
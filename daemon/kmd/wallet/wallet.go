@@ -36,6 +36,7 @@ type Wallet interface {
 	Init(pw []byte) error
 	CheckPassword(pw []byte) error
 	ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error)
+	ExportBackup(pw []byte, backupPW []byte) ([]byte, error)
 
 	Metadata() (Metadata, error)
 
@@ -44,6 +45,8 @@ type Wallet interface {
 	ImportKey(sk crypto.PrivateKey) (crypto.Digest, error)
 	ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error)
 	GenerateKey(displayMnemonic bool) (crypto.Digest, error)
+	GenerateKeyAtPath(account uint32, index uint32) (crypto.Digest, error)
+	ExportAccountKey(account uint32, pw []byte) (crypto.BIP32Ed25519PublicKey, error)
 	DeleteKey(pk crypto.Digest, pw []byte) error
 
 	ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error)
@@ -35,6 +35,11 @@ const (
 	// This value is used exclusively for the messagepack decoder, and has no affect on the network
 	// capabilities/capacity in any way.
 	MaxInitialGenesisAllocationSize = 100000000
+
+	// MaxInitialGenesisBoxes is the maximum number of boxes that are supported when bootstrapping a
+	// new network. Like MaxInitialGenesisAllocationSize, it is used exclusively for the messagepack
+	// decoder.
+	MaxInitialGenesisBoxes = 100000000
 )
 
 // A Genesis object defines an Algorand "universe" -- a set of nodes that can
@@ -81,6 +86,11 @@ type Genesis struct {
 	// default value for this field is "false", which makes this field empty from it's encoding, and
 	// therefore backward compatible.
 	DevMode bool `codec:"devmode"`
+
+	// Boxes lists the application boxes that exist at the genesis round. The applications owning
+	// them must be created by one of the allocations. The default value, no boxes, is omitted
+	// from the encoding and keeps the genesis hash of existing networks unchanged.
+	Boxes []GenesisBox `codec:"boxes,allocbound=MaxInitialGenesisBoxes"`
 }
 
 // LoadGenesisFromFile attempts to load a Genesis structure from a (presumably) genesis.json file.
@@ -133,7 +143,24 @@ func (genesis Genesis) Balances() (GenesisBalances, error) {
 		return GenesisBalances{}, fmt.Errorf("cannot parse rewards pool addr %s: %w", genesis.RewardsPool, err)
 	}
 
-	return MakeTimestampedGenesisBalances(genalloc, feeSink, rewardsPool, genesis.Timestamp), nil
+	for _, box := range genesis.Boxes {
+		if !genesisAppExists(genalloc, box.App) {
+			return GenesisBalances{}, fmt.Errorf("genesis box %q refers to application %d, which no allocation creates", box.Name, box.App)
+		}
+	}
+
+	genBal := MakeTimestampedGenesisBalances(genalloc, feeSink, rewardsPool, genesis.Timestamp)
+	genBal.Boxes = genesis.Boxes
+	return genBal, nil
+}
+
+func genesisAppExists(genalloc map[basics.Address]basics.AccountData, app basics.AppIndex) bool {
+	for _, data := range genalloc {
+		if _, ok := data.AppParams[app]; ok {
+			return true
+		}
+	}
+	return false
 }
 
 // Block computes the genesis block.
@@ -162,6 +189,21 @@ type GenesisAllocation struct {
 	State   basics.AccountData `codec:"state"`
 }
 
+// A GenesisBox is the content of an application box at the genesis
+// round. The account of the application is expected to account for it
+// in its TotalBoxes and TotalBoxBytes.
+type GenesisBox struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	App   basics.AppIndex `codec:"app"`
+	Name  []byte          `codec:"name,allocbound=config.MaxBytesKeyValueLen"`
+	Value []byte          `codec:"value,allocbound=maxGenesisBoxSize"`
+}
+
+// maxGenesisBoxSize bounds the decoding of a genesis box value. It matches the
+// largest box size of the consensus protocols.
+const maxGenesisBoxSize = 32768
+
 // ToBeHashed impements the crypto.Hashable interface.
 func (genesis Genesis) ToBeHashed() (protocol.HashID, []byte) {
 	return protocol.Genesis, protocol.Encode(&genesis)
@@ -173,6 +215,8 @@ type GenesisBalances struct {
 	FeeSink     basics.Address
 	RewardsPool basics.Address
 	Timestamp   int64
+	// Boxes are the application boxes that exist at the genesis round.
+	Boxes []GenesisBox
 }
 
 // MakeGenesisBalances returns the information needed to bootstrap the ledger based on the current time
@@ -227,5 +271,28 @@ func MakeGenesisBlock(proto protocol.ConsensusVersion, genesisBal GenesisBalance
 		blk.BlockHeader.GenesisHash = genesisHash
 	}
 
+	// Assets and applications created at genesis use up the creatable
+	// indices up to the largest of them, so that the ones created later on
+	// get fresh indices.
+	blk.BlockHeader.TxnCounter = genesisBal.maxCreatableIndex()
+
 	return blk, nil
 }
+
+// maxCreatableIndex returns the largest asset or application index created
+// by the genesis balances, or zero if there are none.
+func (genesisBal GenesisBalances) maxCreatableIndex() (max uint64) {
+	for _, data := range genesisBal.Balances {
+		for aidx := range data.AssetParams {
+			if uint64(aidx) > max {
+				max = uint64(aidx)
+			}
+		}
+		for aidx := range data.AppParams {
+			if uint64(aidx) > max {
+				max = uint64(aidx)
+			}
+		}
+	}
+	return max
+}
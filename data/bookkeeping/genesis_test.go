@@ -59,10 +59,14 @@ func TestGenesis_Balances(t *testing.T) {
 	allocation1 := acctWith(1000, makeAddr(1).String())
 	allocation2 := acctWith(2000, makeAddr(2).String())
 	badAllocation := acctWith(1234, "El Toro Loco")
+	appAllocation := acctWith(3000, makeAddr(3).String())
+	appAllocation.State.AppParams = map[basics.AppIndex]basics.AppParams{5: {}}
+	box := GenesisBox{App: 5, Name: []byte("name"), Value: []byte("value")}
 	type fields struct {
 		Allocation  []GenesisAllocation
 		FeeSink     string
 		RewardsPool string
+		Boxes       []GenesisBox
 	}
 	tests := []struct {
 		name    string
@@ -139,6 +143,36 @@ func TestGenesis_Balances(t *testing.T) {
 			},
 			wantErr: containsErrorFunc("repeated allocation to"),
 		},
+		{
+			name: "boxes",
+			fields: fields{
+				Allocation:  []GenesisAllocation{allocation1, appAllocation},
+				FeeSink:     goodAddr.String(),
+				RewardsPool: goodAddr.String(),
+				Boxes:       []GenesisBox{box},
+			},
+			want: GenesisBalances{
+				Balances: map[basics.Address]basics.AccountData{
+					mustAddr(allocation1.Address):   allocation1.State,
+					mustAddr(appAllocation.Address): appAllocation.State,
+				},
+				FeeSink:     goodAddr,
+				RewardsPool: goodAddr,
+				Timestamp:   0,
+				Boxes:       []GenesisBox{box},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "box of unknown application",
+			fields: fields{
+				Allocation:  []GenesisAllocation{allocation1},
+				FeeSink:     goodAddr.String(),
+				RewardsPool: goodAddr.String(),
+				Boxes:       []GenesisBox{box},
+			},
+			wantErr: containsErrorFunc("refers to application 5"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -146,6 +180,7 @@ func TestGenesis_Balances(t *testing.T) {
 				Allocation:  tt.fields.Allocation,
 				FeeSink:     tt.fields.FeeSink,
 				RewardsPool: tt.fields.RewardsPool,
+				Boxes:       tt.fields.Boxes,
 			}
 			got, err := genesis.Balances()
 			if tt.wantErr(t, err, fmt.Sprintf("Balances()")) {
@@ -54,6 +54,14 @@ import (
 //         |-----> (*) Msgsize
 //         |-----> (*) MsgIsZero
 //
+// GenesisBox
+//      |-----> (*) MarshalMsg
+//      |-----> (*) CanMarshalMsg
+//      |-----> (*) UnmarshalMsg
+//      |-----> (*) CanUnmarshalMsg
+//      |-----> (*) Msgsize
+//      |-----> (*) MsgIsZero
+//
 // LightBlockHeader
 //         |-----> (*) MarshalMsg
 //         |-----> (*) CanMarshalMsg
@@ -1737,48 +1745,52 @@ func (z *BlockHeader) MsgIsZero() bool {
 func (z *Genesis) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0002Len := uint32(9)
-	var zb0002Mask uint16 /* 10 bits */
+	zb0003Len := uint32(10)
+	var zb0003Mask uint16 /* 11 bits */
 	if len((*z).Allocation) == 0 {
-		zb0002Len--
-		zb0002Mask |= 0x2
+		zb0003Len--
+		zb0003Mask |= 0x2
+	}
+	if len((*z).Boxes) == 0 {
+		zb0003Len--
+		zb0003Mask |= 0x4
 	}
 	if (*z).Comment == "" {
-		zb0002Len--
-		zb0002Mask |= 0x4
+		zb0003Len--
+		zb0003Mask |= 0x8
 	}
 	if (*z).DevMode == false {
-		zb0002Len--
-		zb0002Mask |= 0x8
+		zb0003Len--
+		zb0003Mask |= 0x10
 	}
 	if (*z).FeeSink == "" {
-		zb0002Len--
-		zb0002Mask |= 0x10
+		zb0003Len--
+		zb0003Mask |= 0x20
 	}
 	if (*z).SchemaID == "" {
-		zb0002Len--
-		zb0002Mask |= 0x20
+		zb0003Len--
+		zb0003Mask |= 0x40
 	}
 	if (*z).Network.MsgIsZero() {
-		zb0002Len--
-		zb0002Mask |= 0x40
+		zb0003Len--
+		zb0003Mask |= 0x80
 	}
 	if (*z).Proto.MsgIsZero() {
-		zb0002Len--
-		zb0002Mask |= 0x80
+		zb0003Len--
+		zb0003Mask |= 0x100
 	}
 	if (*z).RewardsPool == "" {
-		zb0002Len--
-		zb0002Mask |= 0x100
+		zb0003Len--
+		zb0003Mask |= 0x200
 	}
 	if (*z).Timestamp == 0 {
-		zb0002Len--
-		zb0002Mask |= 0x200
+		zb0003Len--
+		zb0003Mask |= 0x400
 	}
-	// variable map header, size zb0002Len
-	o = append(o, 0x80|uint8(zb0002Len))
-	if zb0002Len != 0 {
-		if (zb0002Mask & 0x2) == 0 { // if not empty
+	// variable map header, size zb0003Len
+	o = append(o, 0x80|uint8(zb0003Len))
+	if zb0003Len != 0 {
+		if (zb0003Mask & 0x2) == 0 { // if not empty
 			// string "alloc"
 			o = append(o, 0xa5, 0x61, 0x6c, 0x6c, 0x6f, 0x63)
 			if (*z).Allocation == nil {
@@ -1790,42 +1802,54 @@ func (z *Genesis) MarshalMsg(b []byte) (o []byte) {
 				o = (*z).Allocation[zb0001].MarshalMsg(o)
 			}
 		}
-		if (zb0002Mask & 0x4) == 0 { // if not empty
+		if (zb0003Mask & 0x4) == 0 { // if not empty
+			// string "boxes"
+			o = append(o, 0xa5, 0x62, 0x6f, 0x78, 0x65, 0x73)
+			if (*z).Boxes == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Boxes)))
+			}
+			for zb0002 := range (*z).Boxes {
+				o = (*z).Boxes[zb0002].MarshalMsg(o)
+			}
+		}
+		if (zb0003Mask & 0x8) == 0 { // if not empty
 			// string "comment"
 			o = append(o, 0xa7, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74)
 			o = msgp.AppendString(o, (*z).Comment)
 		}
-		if (zb0002Mask & 0x8) == 0 { // if not empty
+		if (zb0003Mask & 0x10) == 0 { // if not empty
 			// string "devmode"
 			o = append(o, 0xa7, 0x64, 0x65, 0x76, 0x6d, 0x6f, 0x64, 0x65)
 			o = msgp.AppendBool(o, (*z).DevMode)
 		}
-		if (zb0002Mask & 0x10) == 0 { // if not empty
+		if (zb0003Mask & 0x20) == 0 { // if not empty
 			// string "fees"
 			o = append(o, 0xa4, 0x66, 0x65, 0x65, 0x73)
 			o = msgp.AppendString(o, (*z).FeeSink)
 		}
-		if (zb0002Mask & 0x20) == 0 { // if not empty
+		if (zb0003Mask & 0x40) == 0 { // if not empty
 			// string "id"
 			o = append(o, 0xa2, 0x69, 0x64)
 			o = msgp.AppendString(o, (*z).SchemaID)
 		}
-		if (zb0002Mask & 0x40) == 0 { // if not empty
+		if (zb0003Mask & 0x80) == 0 { // if not empty
 			// string "network"
 			o = append(o, 0xa7, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b)
 			o = (*z).Network.MarshalMsg(o)
 		}
-		if (zb0002Mask & 0x80) == 0 { // if not empty
+		if (zb0003Mask & 0x100) == 0 { // if not empty
 			// string "proto"
 			o = append(o, 0xa5, 0x70, 0x72, 0x6f, 0x74, 0x6f)
 			o = (*z).Proto.MarshalMsg(o)
 		}
-		if (zb0002Mask & 0x100) == 0 { // if not empty
+		if (zb0003Mask & 0x200) == 0 { // if not empty
 			// string "rwd"
 			o = append(o, 0xa3, 0x72, 0x77, 0x64)
 			o = msgp.AppendString(o, (*z).RewardsPool)
 		}
-		if (zb0002Mask & 0x200) == 0 { // if not empty
+		if (zb0003Mask & 0x400) == 0 { // if not empty
 			// string "timestamp"
 			o = append(o, 0xa9, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70)
 			o = msgp.AppendInt64(o, (*z).Timestamp)
@@ -1843,59 +1867,59 @@ func (_ *Genesis) CanMarshalMsg(z interface{}) bool {
 func (z *Genesis) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
-	var zb0002 int
-	var zb0003 bool
-	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	var zb0003 int
+	var zb0004 bool
+	zb0003, zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
 	if _, ok := err.(msgp.TypeError); ok {
-		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		zb0003, zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
 		if err != nil {
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0002 > 0 {
-			zb0002--
+		if zb0003 > 0 {
+			zb0003--
 			(*z).SchemaID, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "SchemaID")
 				return
 			}
 		}
-		if zb0002 > 0 {
-			zb0002--
+		if zb0003 > 0 {
+			zb0003--
 			bts, err = (*z).Network.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Network")
 				return
 			}
 		}
-		if zb0002 > 0 {
-			zb0002--
+		if zb0003 > 0 {
+			zb0003--
 			bts, err = (*z).Proto.UnmarshalMsg(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Proto")
 				return
 			}
 		}
-		if zb0002 > 0 {
-			zb0002--
-			var zb0004 int
-			var zb0005 bool
-			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0003 > 0 {
+			zb0003--
+			var zb0005 int
+			var zb0006 bool
+			zb0005, zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Allocation")
 				return
 			}
-			if zb0004 > MaxInitialGenesisAllocationSize {
-				err = msgp.ErrOverflow(uint64(zb0004), uint64(MaxInitialGenesisAllocationSize))
+			if zb0005 > MaxInitialGenesisAllocationSize {
+				err = msgp.ErrOverflow(uint64(zb0005), uint64(MaxInitialGenesisAllocationSize))
 				err = msgp.WrapError(err, "struct-from-array", "Allocation")
 				return
 			}
-			if zb0005 {
+			if zb0006 {
 				(*z).Allocation = nil
-			} else if (*z).Allocation != nil && cap((*z).Allocation) >= zb0004 {
-				(*z).Allocation = ((*z).Allocation)[:zb0004]
+			} else if (*z).Allocation != nil && cap((*z).Allocation) >= zb0005 {
+				(*z).Allocation = ((*z).Allocation)[:zb0005]
 			} else {
-				(*z).Allocation = make([]GenesisAllocation, zb0004)
+				(*z).Allocation = make([]GenesisAllocation, zb0005)
 			}
 			for zb0001 := range (*z).Allocation {
 				bts, err = (*z).Allocation[zb0001].UnmarshalMsg(bts)
@@ -1905,48 +1929,77 @@ func (z *Genesis) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				}
 			}
 		}
-		if zb0002 > 0 {
-			zb0002--
+		if zb0003 > 0 {
+			zb0003--
 			(*z).RewardsPool, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "RewardsPool")
 				return
 			}
 		}
-		if zb0002 > 0 {
-			zb0002--
+		if zb0003 > 0 {
+			zb0003--
 			(*z).FeeSink, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "FeeSink")
 				return
 			}
 		}
-		if zb0002 > 0 {
-			zb0002--
+		if zb0003 > 0 {
+			zb0003--
 			(*z).Timestamp, bts, err = msgp.ReadInt64Bytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Timestamp")
 				return
 			}
 		}
-		if zb0002 > 0 {
-			zb0002--
+		if zb0003 > 0 {
+			zb0003--
 			(*z).Comment, bts, err = msgp.ReadStringBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Comment")
 				return
 			}
 		}
-		if zb0002 > 0 {
-			zb0002--
+		if zb0003 > 0 {
+			zb0003--
 			(*z).DevMode, bts, err = msgp.ReadBoolBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "DevMode")
 				return
 			}
 		}
-		if zb0002 > 0 {
-			err = msgp.ErrTooManyArrayFields(zb0002)
+		if zb0003 > 0 {
+			zb0003--
+			var zb0007 int
+			var zb0008 bool
+			zb0007, zb0008, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Boxes")
+				return
+			}
+			if zb0007 > MaxInitialGenesisBoxes {
+				err = msgp.ErrOverflow(uint64(zb0007), uint64(MaxInitialGenesisBoxes))
+				err = msgp.WrapError(err, "struct-from-array", "Boxes")
+				return
+			}
+			if zb0008 {
+				(*z).Boxes = nil
+			} else if (*z).Boxes != nil && cap((*z).Boxes) >= zb0007 {
+				(*z).Boxes = ((*z).Boxes)[:zb0007]
+			} else {
+				(*z).Boxes = make([]GenesisBox, zb0007)
+			}
+			for zb0002 := range (*z).Boxes {
+				bts, err = (*z).Boxes[zb0002].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Boxes", zb0002)
+					return
+				}
+			}
+		}
+		if zb0003 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0003)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array")
 				return
@@ -1957,11 +2010,11 @@ func (z *Genesis) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0003 {
+		if zb0004 {
 			(*z) = Genesis{}
 		}
-		for zb0002 > 0 {
-			zb0002--
+		for zb0003 > 0 {
+			zb0003--
 			field, bts, err = msgp.ReadMapKeyZC(bts)
 			if err != nil {
 				err = msgp.WrapError(err)
@@ -1987,24 +2040,24 @@ func (z *Genesis) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					return
 				}
 			case "alloc":
-				var zb0006 int
-				var zb0007 bool
-				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0009 int
+				var zb0010 bool
+				zb0009, zb0010, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Allocation")
 					return
 				}
-				if zb0006 > MaxInitialGenesisAllocationSize {
-					err = msgp.ErrOverflow(uint64(zb0006), uint64(MaxInitialGenesisAllocationSize))
+				if zb0009 > MaxInitialGenesisAllocationSize {
+					err = msgp.ErrOverflow(uint64(zb0009), uint64(MaxInitialGenesisAllocationSize))
 					err = msgp.WrapError(err, "Allocation")
 					return
 				}
-				if zb0007 {
+				if zb0010 {
 					(*z).Allocation = nil
-				} else if (*z).Allocation != nil && cap((*z).Allocation) >= zb0006 {
-					(*z).Allocation = ((*z).Allocation)[:zb0006]
+				} else if (*z).Allocation != nil && cap((*z).Allocation) >= zb0009 {
+					(*z).Allocation = ((*z).Allocation)[:zb0009]
 				} else {
-					(*z).Allocation = make([]GenesisAllocation, zb0006)
+					(*z).Allocation = make([]GenesisAllocation, zb0009)
 				}
 				for zb0001 := range (*z).Allocation {
 					bts, err = (*z).Allocation[zb0001].UnmarshalMsg(bts)
@@ -2043,6 +2096,33 @@ func (z *Genesis) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					err = msgp.WrapError(err, "DevMode")
 					return
 				}
+			case "boxes":
+				var zb0011 int
+				var zb0012 bool
+				zb0011, zb0012, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Boxes")
+					return
+				}
+				if zb0011 > MaxInitialGenesisBoxes {
+					err = msgp.ErrOverflow(uint64(zb0011), uint64(MaxInitialGenesisBoxes))
+					err = msgp.WrapError(err, "Boxes")
+					return
+				}
+				if zb0012 {
+					(*z).Boxes = nil
+				} else if (*z).Boxes != nil && cap((*z).Boxes) >= zb0011 {
+					(*z).Boxes = ((*z).Boxes)[:zb0011]
+				} else {
+					(*z).Boxes = make([]GenesisBox, zb0011)
+				}
+				for zb0002 := range (*z).Boxes {
+					bts, err = (*z).Boxes[zb0002].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Boxes", zb0002)
+						return
+					}
+				}
 			default:
 				err = msgp.ErrNoField(string(field))
 				if err != nil {
@@ -2067,13 +2147,16 @@ func (z *Genesis) Msgsize() (s int) {
 	for zb0001 := range (*z).Allocation {
 		s += (*z).Allocation[zb0001].Msgsize()
 	}
-	s += 4 + msgp.StringPrefixSize + len((*z).RewardsPool) + 5 + msgp.StringPrefixSize + len((*z).FeeSink) + 10 + msgp.Int64Size + 8 + msgp.StringPrefixSize + len((*z).Comment) + 8 + msgp.BoolSize
+	s += 4 + msgp.StringPrefixSize + len((*z).RewardsPool) + 5 + msgp.StringPrefixSize + len((*z).FeeSink) + 10 + msgp.Int64Size + 8 + msgp.StringPrefixSize + len((*z).Comment) + 8 + msgp.BoolSize + 6 + msgp.ArrayHeaderSize
+	for zb0002 := range (*z).Boxes {
+		s += (*z).Boxes[zb0002].Msgsize()
+	}
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *Genesis) MsgIsZero() bool {
-	return ((*z).SchemaID == "") && ((*z).Network.MsgIsZero()) && ((*z).Proto.MsgIsZero()) && (len((*z).Allocation) == 0) && ((*z).RewardsPool == "") && ((*z).FeeSink == "") && ((*z).Timestamp == 0) && ((*z).Comment == "") && ((*z).DevMode == false)
+	return ((*z).SchemaID == "") && ((*z).Network.MsgIsZero()) && ((*z).Proto.MsgIsZero()) && (len((*z).Allocation) == 0) && ((*z).RewardsPool == "") && ((*z).FeeSink == "") && ((*z).Timestamp == 0) && ((*z).Comment == "") && ((*z).DevMode == false) && (len((*z).Boxes) == 0)
 }
 
 // MarshalMsg implements msgp.Marshaler
@@ -2204,6 +2287,198 @@ func (z *GenesisAllocation) MsgIsZero() bool {
 	return ((*z).Address == "") && ((*z).Comment == "") && ((*z).State.MsgIsZero())
 }
 
+// MarshalMsg implements msgp.Marshaler
+func (z *GenesisBox) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 4 bits */
+	if (*z).App.MsgIsZero() {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if len((*z).Name) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if len((*z).Value) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "app"
+			o = append(o, 0xa3, 0x61, 0x70, 0x70)
+			o = (*z).App.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "name"
+			o = append(o, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+			o = msgp.AppendBytes(o, (*z).Name)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "value"
+			o = append(o, 0xa5, 0x76, 0x61, 0x6c, 0x75, 0x65)
+			o = msgp.AppendBytes(o, (*z).Value)
+		}
+	}
+	return
+}
+
+func (_ *GenesisBox) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*GenesisBox)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *GenesisBox) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).App.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "App")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0003 int
+			zb0003, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+			if zb0003 > config.MaxBytesKeyValueLen {
+				err = msgp.ErrOverflow(uint64(zb0003), uint64(config.MaxBytesKeyValueLen))
+				return
+			}
+			(*z).Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Name)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0004 int
+			zb0004, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Value")
+				return
+			}
+			if zb0004 > maxGenesisBoxSize {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(maxGenesisBoxSize))
+				return
+			}
+			(*z).Value, bts, err = msgp.ReadBytesBytes(bts, (*z).Value)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Value")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = GenesisBox{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "app":
+				bts, err = (*z).App.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "App")
+					return
+				}
+			case "name":
+				var zb0005 int
+				zb0005, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+				if zb0005 > config.MaxBytesKeyValueLen {
+					err = msgp.ErrOverflow(uint64(zb0005), uint64(config.MaxBytesKeyValueLen))
+					return
+				}
+				(*z).Name, bts, err = msgp.ReadBytesBytes(bts, (*z).Name)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+			case "value":
+				var zb0006 int
+				zb0006, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Value")
+					return
+				}
+				if zb0006 > maxGenesisBoxSize {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(maxGenesisBoxSize))
+					return
+				}
+				(*z).Value, bts, err = msgp.ReadBytesBytes(bts, (*z).Value)
+				if err != nil {
+					err = msgp.WrapError(err, "Value")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *GenesisBox) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*GenesisBox)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *GenesisBox) Msgsize() (s int) {
+	s = 1 + 4 + (*z).App.Msgsize() + 5 + msgp.BytesPrefixSize + len((*z).Name) + 6 + msgp.BytesPrefixSize + len((*z).Value)
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *GenesisBox) MsgIsZero() bool {
+	return ((*z).App.MsgIsZero()) && (len((*z).Name) == 0) && (len((*z).Value) == 0)
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z *LightBlockHeader) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
@@ -27,6 +27,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
@@ -106,6 +107,12 @@ func LoadLedger(
 		Accounts:    genesisBal.Balances,
 		GenesisHash: genesisHash,
 	}
+	if len(genesisBal.Boxes) > 0 {
+		genesisInitState.Boxes = make(map[string][]byte, len(genesisBal.Boxes))
+		for _, box := range genesisBal.Boxes {
+			genesisInitState.Boxes[logic.MakeBoxKey(box.App, string(box.Name))] = box.Value
+		}
+	}
 	l.log.Debugf("Initializing Ledger(%s)", dbFilenamePrefix)
 
 	ll, err := ledger.OpenLedger(log, dbFilenamePrefix, memory, genesisInitState, cfg)
@@ -239,6 +246,16 @@ func (l *Ledger) Seed(r basics.Round) (committee.Seed, error) {
 	return blockhdr.Seed, nil
 }
 
+// RestoreSnapshot returns the ledger to the state of a snapshot, see
+// ledger.Ledger.RestoreSnapshot.
+func (l *Ledger) RestoreSnapshot(id uint64) error {
+	err := l.Ledger.RestoreSnapshot(id)
+	// the cached values may belong to discarded rounds
+	l.lastRoundCirculation.Store(roundCirculation{})
+	l.lastRoundSeed.Store(roundSeed{})
+	return err
+}
+
 // LookupDigest gives the block hash that was agreed on in a given round,
 // returning an error if we don't have that round or we have an
 // I/O error.
@@ -31,6 +31,7 @@ import (
 	basics_testing "github.com/algorand/go-algorand/data/basics/testing"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
@@ -669,3 +670,50 @@ func getEmptyBlock(afterRound basics.Round, l *ledger.Ledger, genesisID string,
 	}
 	return
 }
+
+func TestLoadLedgerGenesisCreatables(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	creator := basics.Address{0x01}
+	const asset, app = basics.AssetIndex(7), basics.AppIndex(9)
+	balances := map[basics.Address]basics.AccountData{
+		testPoolAddr: {Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: 1e12}},
+		testSinkAddr: {Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: 1e12}},
+		creator: {
+			MicroAlgos:  basics.MicroAlgos{Raw: 1e12},
+			AssetParams: map[basics.AssetIndex]basics.AssetParams{asset: {Total: 10, Manager: creator}},
+			Assets:      map[basics.AssetIndex]basics.AssetHolding{asset: {Amount: 10}},
+			AppParams: map[basics.AppIndex]basics.AppParams{app: {
+				ApprovalProgram:   []byte{0x08, 0x81, 0x01},
+				ClearStateProgram: []byte{0x08, 0x81, 0x01},
+			}},
+		},
+		app.Address(): {MicroAlgos: basics.MicroAlgos{Raw: 1e6}, TotalBoxes: 1, TotalBoxBytes: 8},
+	}
+	genBal := bookkeeping.MakeGenesisBalances(balances, testSinkAddr, testPoolAddr)
+	genBal.Boxes = []bookkeeping.GenesisBox{{App: app, Name: []byte("box"), Value: []byte("value")}}
+
+	log := logging.TestingLog(t)
+	log.SetLevel(logging.Warn)
+	l, err := LoadLedger(log, t.Name(), true, protocol.ConsensusFuture, genBal, t.Name(), crypto.Digest{}, nil, config.GetDefaultLocal())
+	require.NoError(t, err)
+	defer l.Close()
+
+	addr, ok, err := l.GetCreator(basics.CreatableIndex(asset), basics.AssetCreatable)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, creator, addr)
+	addr, ok, err = l.GetCreator(basics.CreatableIndex(app), basics.AppCreatable)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, creator, addr)
+
+	value, err := l.LookupKv(0, logic.MakeBoxKey(app, "box"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	// creatables made after genesis do not reuse the genesis indices
+	blk, err := l.Block(0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(app), blk.TxnCounter)
+}
@@ -96,6 +96,10 @@ type TransactionPool struct {
 	// stateproofOverflowed indicates that a stateproof transaction was allowed to
 	// exceed the txPoolMaxSize. This flag is reset to false OnNewBlock
 	stateproofOverflowed bool
+
+	// devModeTimestamp, if not zero, is the timestamp of the block that
+	// AssembleDevModeBlock is assembling.
+	devModeTimestamp int64
 }
 
 // BlockEvaluator defines the block evaluator interface exposed by the ledger package.
@@ -181,6 +185,11 @@ func (pool *TransactionPool) Reset() {
 	pool.numPendingWholeBlocks = 0
 	pool.pendingBlockEvaluator = nil
 	pool.statusCache.reset()
+	// the ledger may have been rolled back, forget about the round being assembled
+	pool.assemblyMu.Lock()
+	pool.assemblyRound = 0
+	pool.assemblyDeadline = time.Time{}
+	pool.assemblyMu.Unlock()
 	pool.recomputeBlockEvaluator(nil, 0)
 }
 
@@ -695,6 +704,11 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 	pool.assemblyMu.Unlock()
 
 	next := bookkeeping.MakeBlock(prev)
+	if pool.devModeTimestamp != 0 {
+		// the transactions have to be evaluated with the timestamp of the block
+		// they will be in, which the header is then validated against
+		next.TimeStamp = pool.devModeTimestamp
+	}
 	pool.numPendingWholeBlocks = 0
 	hint := pendingCount - int(knownCommitted)
 	if hint < 0 || int(knownCommitted) < 0 {
@@ -993,12 +1007,18 @@ func (pool *TransactionPool) assembleEmptyBlock(round basics.Round) (assembled *
 }
 
 // AssembleDevModeBlock assemble a new block from the existing transaction pool. The pending evaluator is being
-func (pool *TransactionPool) AssembleDevModeBlock() (assembled *ledgercore.ValidatedBlock, err error) {
+// recomputed, for a block with the given timestamp unless it is zero, in which case the block follows the wall clock.
+func (pool *TransactionPool) AssembleDevModeBlock(timestamp int64) (assembled *ledgercore.ValidatedBlock, err error) {
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 
 	// drop the current block evaluator and start with a new one.
+	pool.devModeTimestamp = timestamp
 	pool.recomputeBlockEvaluator(nil, 0)
+	pool.devModeTimestamp = 0
+	if pool.pendingBlockEvaluator == nil {
+		return nil, ErrNoPendingBlockEvaluator
+	}
 
 	// The above was already pregenerating the entire block,
 	// so there won't be any waiting on this call.
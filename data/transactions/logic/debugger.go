@@ -82,6 +82,7 @@ type DebugState struct {
 	Scratch      []basics.TealValue `codec:"scratch"`
 	Error        string             `codec:"error"`
 	OpcodeBudget int                `codec:"budget"`
+	Cost         int                `codec:"cost"`
 	CallStack    []CallFrame        `codec:"callstack"`
 
 	// global/local state changes are updated every step. Stateful TEAL only.
@@ -225,7 +226,7 @@ func (cx *EvalContext) refreshDebugState(evalError error) *DebugState {
 	ds := cx.debugState
 
 	// Update pc, line, error, stack, scratch space, callstack,
-	// and opcode budget
+	// opcode budget and cost
 	ds.PC = cx.pc
 	ds.Line = ds.PCToLine(cx.pc)
 	if evalError != nil {
@@ -245,6 +246,7 @@ func (cx *EvalContext) refreshDebugState(evalError error) *DebugState {
 	ds.Stack = stack
 	ds.Scratch = scratch
 	ds.OpcodeBudget = cx.remainingBudget()
+	ds.Cost = cx.cost
 	ds.CallStack = ds.parseCallstack(cx.callstack)
 
 	if (cx.runModeFlags & modeApp) != 0 {
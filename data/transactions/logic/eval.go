@@ -285,6 +285,11 @@ type EvalParams struct {
 	// optional debugger
 	Debugger DebuggerHook
 
+	// DebugInnerTxns attaches Debugger to the programs of inner transactions
+	// too. Debuggers which only expect the programs of the transaction group
+	// itself leave it unset.
+	DebugInnerTxns bool
+
 	// MinAvmVersion is the minimum allowed AVM version of this program.
 	// The program must reject if its version is less than this version. If
 	// MinAvmVersion is nil, we will compute it ourselves
@@ -447,6 +452,11 @@ func NewInnerEvalParams(txg []transactions.SignedTxnWithAD, caller *EvalContext)
 		}
 	}
 
+	var debugger DebuggerHook
+	if caller.DebugInnerTxns {
+		debugger = caller.Debugger
+	}
+
 	ep := &EvalParams{
 		Proto:                   caller.Proto,
 		Trace:                   caller.Trace,
@@ -455,7 +465,8 @@ func NewInnerEvalParams(txg []transactions.SignedTxnWithAD, caller *EvalContext)
 		logger:                  caller.logger,
 		SigLedger:               caller.SigLedger,
 		Ledger:                  caller.Ledger,
-		Debugger:                nil, // See #4438, where this becomes caller.Debugger
+		Debugger:                debugger,
+		DebugInnerTxns:          caller.DebugInnerTxns,
 		MinAvmVersion:           &minAvmVersion,
 		FeeCredit:               caller.FeeCredit,
 		Specials:                caller.Specials,
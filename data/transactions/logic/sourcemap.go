@@ -18,6 +18,7 @@ package logic
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 )
 
@@ -92,3 +93,53 @@ func MakeSourceMapLine(tcol, sindex, sline, scol int) string {
 	intToVLQ(scol, buf)
 	return buf.String()
 }
+
+// vlqToInts decodes a source map segment into its values
+func vlqToInts(segment string) ([]int, error) {
+	var values []int
+	value, shift := 0, 0
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(b64table, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base64 character %q in source map segment %s", segment[i], segment)
+		}
+		value += (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+		negative := value&1 != 0
+		value >>= 1
+		if negative {
+			value = -value
+		}
+		values = append(values, value)
+		value, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated source map segment %s", segment)
+	}
+	return values, nil
+}
+
+// GetOffsetToLine decodes the mappings of a source map made by GetSourceMap
+// back into a map from bytecode offset to (zero based) source line.
+func (s SourceMap) GetOffsetToLine() (map[int]int, error) {
+	offsetToLine := make(map[int]int)
+	line := 0
+	for pc, segment := range strings.Split(s.Mappings, ";") {
+		if segment == "" {
+			continue
+		}
+		values, err := vlqToInts(segment)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) < 3 {
+			return nil, fmt.Errorf("source map segment %s at pc %d has no source line", segment, pc)
+		}
+		line += values[2]
+		offsetToLine[pc] = line
+	}
+	return offsetToLine, nil
+}
@@ -66,3 +66,34 @@ func TestVLQ(t *testing.T) {
 	a.Equal("AAggBA", MakeSourceMapLine(0, 0, 512, 0))
 	a.Equal("ADggBD", MakeSourceMapLine(0, -1, 512, -1))
 }
+
+func TestGetOffsetToLine(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	offsetToLine := map[int]int{
+		1:  1,
+		2:  2,
+		5:  3,
+		6:  600,
+		40: 2,
+	}
+	sourceMap := GetSourceMap([]string{"test.teal"}, offsetToLine)
+	decoded, err := sourceMap.GetOffsetToLine()
+	a.NoError(err)
+	a.Equal(offsetToLine, decoded)
+
+	ops, err := AssembleString("#pragma version 8\nint 1\n\nb done\ndone:\nreturn")
+	a.NoError(err)
+	decoded, err = GetSourceMap([]string{"x.teal"}, ops.OffsetToLine).GetOffsetToLine()
+	a.NoError(err)
+	a.Equal(ops.OffsetToLine, decoded)
+
+	_, err = SourceMap{Mappings: ";AA!A"}.GetOffsetToLine()
+	a.ErrorContains(err, "invalid base64 character")
+	_, err = SourceMap{Mappings: "AAg"}.GetOffsetToLine()
+	a.ErrorContains(err, "truncated")
+	_, err = SourceMap{Mappings: "AA"}.GetOffsetToLine()
+	a.ErrorContains(err, "has no source line")
+}
@@ -18,9 +18,14 @@ package verify
 
 import (
 	"errors"
+	"os"
 
 	"github.com/algorand/go-deadlock"
 
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/protocol"
@@ -50,6 +55,12 @@ var errTooManyPinnedEntries = &VerifiedTxnCacheError{errors.New("Too many pinned
 // errMissingPinnedEntry is being generated when we're trying to pin a transaction that does not appear in the cache
 var errMissingPinnedEntry = &VerifiedTxnCacheError{errors.New("Missing pinned entry")}
 
+// errCacheNotPersistent is being generated when we attempt to save or load a cache implementation which doesn't support it
+var errCacheNotPersistent = &VerifiedTxnCacheError{errors.New("Cache can't be saved or loaded")}
+
+// errCacheGenesisMismatch is being generated when we attempt to load a cache file which was saved by a ledger of another network
+var errCacheGenesisMismatch = &VerifiedTxnCacheError{errors.New("Cache file genesis hash mismatch")}
+
 // VerifiedTransactionCache provides a cached store of recently verified transactions. The cache is desiged two have two separate "levels". On the
 // bottom tier, the cache would be using a cyclic buffer, where old transactions would end up overridden by new ones. In order to support transactions
 // that goes into the transaction pool, we have a higher tier of pinned cache. Pinned transactions would not be cycled-away by new incoming transactions,
@@ -256,6 +267,123 @@ func (v *verifiedTransactionCache) add(txgroup []transactions.SignedTxn, groupCt
 	}
 }
 
+// verifiedTxnCacheFile is the content of the file written by SaveVerifiedTransactionCache
+type verifiedTxnCacheFile struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	GenesisHash crypto.Digest           `codec:"gh"`
+	Round       basics.Round            `codec:"rnd"`
+	Groups      []verifiedTxnCacheGroup `codec:"grps"`
+}
+
+// verifiedTxnCacheGroup is a verified transaction group, along with the verification context it was verified in
+type verifiedTxnCacheGroup struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	SpecAddrs        transactions.SpecialAddresses `codec:"spec"`
+	ConsensusVersion protocol.ConsensusVersion     `codec:"proto"`
+	Txns             []transactions.SignedTxn      `codec:"txns"`
+}
+
+// SaveVerifiedTransactionCache writes the transaction groups of the cache to the given file, along with the genesis hash
+// and the latest round of the ledger they were verified by, so that they could be loaded back by LoadVerifiedTransactionCache
+// after a restart.
+func SaveVerifiedTransactionCache(cache VerifiedTransactionCache, filename string, genesisHash crypto.Digest, round basics.Round) error {
+	v, ok := cache.(*verifiedTransactionCache)
+	if !ok {
+		return errCacheNotPersistent
+	}
+	file := verifiedTxnCacheFile{
+		GenesisHash: genesisHash,
+		Round:       round,
+		Groups:      v.groups(),
+	}
+	tmpFilename := filename + ".tmp"
+	err := os.WriteFile(tmpFilename, protocol.EncodeReflect(&file), 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpFilename, filename)
+}
+
+// LoadVerifiedTransactionCache adds the transaction groups written to the given file by SaveVerifiedTransactionCache to
+// the cache, and returns their number along with the round they were saved at. Only the groups which could still be
+// evaluated after the given block header are added: groups that were verified under another consensus version or
+// other special addresses, or which have expired transactions, are discarded. Since the file could have been modified,
+// the signatures of the remaining groups are verified again, and the groups which fail the verification are discarded.
+func LoadVerifiedTransactionCache(cache VerifiedTransactionCache, filename string, genesisHash crypto.Digest, hdr bookkeeping.BlockHeader, ledger logic.LedgerForSignature) (loaded int, round basics.Round, err error) {
+	v, ok := cache.(*verifiedTransactionCache)
+	if !ok {
+		return 0, 0, errCacheNotPersistent
+	}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return 0, 0, err
+	}
+	var file verifiedTxnCacheFile
+	err = protocol.DecodeReflect(data, &file)
+	if err != nil {
+		return 0, 0, err
+	}
+	if file.GenesisHash != genesisHash {
+		return 0, file.Round, errCacheGenesisMismatch
+	}
+	if _, ok := config.Consensus[hdr.CurrentProtocol]; !ok {
+		return 0, file.Round, protocol.Error(hdr.CurrentProtocol)
+	}
+
+	specAddrs := transactions.SpecialAddresses{
+		FeeSink:     hdr.FeeSink,
+		RewardsPool: hdr.RewardsPool,
+	}
+
+groupsLoop:
+	for _, group := range file.Groups {
+		if len(group.Txns) == 0 || group.ConsensusVersion != hdr.CurrentProtocol || group.SpecAddrs != specAddrs {
+			continue
+		}
+		for _, txn := range group.Txns {
+			if txn.Txn.LastValid <= hdr.Round {
+				continue groupsLoop
+			}
+		}
+		// the file isn't trusted: the groups are verified again, and only the ones which pass get added to the cache.
+		groupCtx, verifyErr := TxnGroup(group.Txns, &hdr, nil, ledger)
+		if verifyErr != nil {
+			continue
+		}
+		v.Add(group.Txns, groupCtx)
+		loaded++
+	}
+	return loaded, file.Round, nil
+}
+
+// groups returns the distinct transaction groups of the cache, from the least recently verified ones to the pinned ones.
+func (v *verifiedTransactionCache) groups() (groups []verifiedTxnCacheGroup) {
+	v.bucketsLock.Lock()
+	defer v.bucketsLock.Unlock()
+	seen := make(map[*GroupContext]bool)
+	addGroups := func(entries map[transactions.Txid]*GroupContext) {
+		for _, groupCtx := range entries {
+			if seen[groupCtx] {
+				continue
+			}
+			seen[groupCtx] = true
+			groups = append(groups, verifiedTxnCacheGroup{
+				SpecAddrs:        groupCtx.specAddrs,
+				ConsensusVersion: groupCtx.consensusVersion,
+				Txns:             groupCtx.signedGroupTxns,
+			})
+		}
+	}
+	// the bucket following the base one is the oldest one.
+	for i := 1; i <= len(v.buckets); i++ {
+		addGroups(v.buckets[(v.base+i)%len(v.buckets)])
+	}
+	addGroups(v.pinned)
+	return
+}
+
 var alwaysVerifiedCache = mockedCache{true}
 var neverVerifiedCache = mockedCache{false}
 
@@ -17,11 +17,14 @@
 package verify
 
 import (
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -184,3 +187,75 @@ func TestPinningTransactions(t *testing.T) {
 	// try to pin an entry that was not added.
 	require.Error(t, impl.Pin(txnGroups[len(txnGroups)-1]))
 }
+
+func TestSaveLoadCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	size := 100
+	icache := MakeVerifiedTransactionCache(size * 10)
+	impl := icache.(*verifiedTransactionCache)
+	_, signedTxn, secrets, addrs := generateTestObjects(size*2, 10, 0, 0)
+	txnGroups := generateTransactionGroups(protoMaxGroupSize, signedTxn, secrets, addrs)
+	for i := 0; i < len(txnGroups); i++ {
+		groupCtx, err := PrepareGroupContext(txnGroups[i], blockHeader, nil)
+		require.NoError(t, err)
+		impl.Add(txnGroups[i], groupCtx)
+	}
+	require.NoError(t, impl.Pin(txnGroups[0]))
+
+	// a group which doesn't carry valid signatures, as it was modified after its signing
+	forged := append([]transactions.SignedTxn(nil), txnGroups[1]...)
+	forged[0].Txn.Note = append([]byte("forged"), forged[0].Txn.Note...)
+	groupCtx, err := PrepareGroupContext(forged, blockHeader, nil)
+	require.NoError(t, err)
+	impl.Add(forged, groupCtx)
+
+	filename := filepath.Join(t.TempDir(), "verifiedtxns")
+	genesisHash := crypto.Digest{0x42}
+	require.NoError(t, SaveVerifiedTransactionCache(icache, filename, genesisHash, 10))
+	require.Error(t, SaveVerifiedTransactionCache(GetMockedCache(true), filename, genesisHash, 10))
+
+	// all the groups are loaded before they expire
+	loaded := MakeVerifiedTransactionCache(size * 10)
+	count, round, err := LoadVerifiedTransactionCache(loaded, filename, genesisHash, *blockHeader, nil)
+	require.NoError(t, err)
+	require.Equal(t, len(txnGroups), count)
+	require.Equal(t, basics.Round(10), round)
+	require.Empty(t, loaded.GetUnverifiedTransactionGroups(txnGroups, spec, protocol.ConsensusCurrentVersion))
+	require.Len(t, loaded.GetUnverifiedTransactionGroups([][]transactions.SignedTxn{forged}, spec, protocol.ConsensusCurrentVersion), 1)
+
+	// the groups with expired transactions are discarded
+	hdr := *blockHeader
+	hdr.Round = 70
+	loaded = MakeVerifiedTransactionCache(size * 10)
+	count, _, err = LoadVerifiedTransactionCache(loaded, filename, genesisHash, hdr, nil)
+	require.NoError(t, err)
+	expired := 0
+	for _, group := range txnGroups {
+		for _, txn := range group {
+			if txn.Txn.LastValid <= hdr.Round {
+				expired++
+				break
+			}
+		}
+	}
+	require.Equal(t, len(txnGroups)-expired, count)
+	require.Len(t, loaded.GetUnverifiedTransactionGroups(txnGroups, spec, protocol.ConsensusCurrentVersion), expired)
+
+	// and so are the groups verified under another consensus version, or with other special addresses
+	hdr = *blockHeader
+	hdr.CurrentProtocol = protocol.ConsensusFuture
+	count, _, err = LoadVerifiedTransactionCache(MakeVerifiedTransactionCache(size*10), filename, genesisHash, hdr, nil)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	hdr = *blockHeader
+	hdr.FeeSink = poolAddr
+	count, _, err = LoadVerifiedTransactionCache(MakeVerifiedTransactionCache(size*10), filename, genesisHash, hdr, nil)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	// a cache of another network can't be loaded
+	_, _, err = LoadVerifiedTransactionCache(MakeVerifiedTransactionCache(size*10), filename, crypto.Digest{0x43}, *blockHeader, nil)
+	require.ErrorIs(t, err, errCacheGenesisMismatch)
+}
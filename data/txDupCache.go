@@ -49,7 +49,7 @@ func makeDigestCache(size int) *digestCache {
 }
 
 // check if digest d is in a cache.
-// locking semantic: write lock must be taken
+// locking semantic: read lock must be taken
 func (c *digestCache) check(d *crypto.Digest) bool {
 	_, found := c.cur[*d]
 	if !found {
@@ -74,6 +74,13 @@ func (c *digestCache) put(d *crypto.Digest) {
 	c.cur[*d] = struct{}{}
 }
 
+// Check returns true if digest d is in a cache
+func (c *digestCache) Check(d *crypto.Digest) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.check(d)
+}
+
 // CheckAndPut adds digest d into a cache if not found
 func (c *digestCache) CheckAndPut(d *crypto.Digest) bool {
 	c.mu.Lock()
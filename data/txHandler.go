@@ -38,6 +38,7 @@ import (
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/execpool"
 	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-deadlock"
 )
 
 var transactionMessagesHandled = metrics.MakeCounter(metrics.TransactionMessagesHandled)
@@ -57,6 +58,10 @@ var transactionMessageTxGroupFull = metrics.MakeCounter(metrics.TransactionMessa
 var transactionMessagesDupRawMsg = metrics.MakeCounter(metrics.TransactionMessagesDupRawMsg)
 var transactionMessagesDupCanonical = metrics.MakeCounter(metrics.TransactionMessagesDupCanonical)
 var transactionMessagesBacklogSizeGauge = metrics.MakeGauge(metrics.TransactionMessagesBacklogSize)
+var transactionAnnouncementsHandled = metrics.MakeCounter(metrics.TransactionAnnouncementsHandled)
+var transactionAnnouncementsDup = metrics.MakeCounter(metrics.TransactionAnnouncementsDup)
+var transactionAnnouncementsRequested = metrics.MakeCounter(metrics.TransactionAnnouncementsRequested)
+var transactionAnnouncementsMissed = metrics.MakeCounter(metrics.TransactionAnnouncementsMissed)
 
 var transactionGroupTxSyncHandled = metrics.MakeCounter(metrics.TransactionGroupTxSyncHandled)
 var transactionGroupTxSyncRemember = metrics.MakeCounter(metrics.TransactionGroupTxSyncRemember)
@@ -122,13 +127,21 @@ type TxHandler struct {
 	net                   network.GossipNode
 	msgCache              *txSaltedCache
 	txCanonicalCache      *digestCache
+	txRequestedCache      *txSaltedCache
+	txRequests            *txRequestTracker
 	cacheConfig           txHandlerConfig
 	ctx                   context.Context
 	ctxCancel             context.CancelFunc
 	streamVerifier        *verify.StreamVerifier
 	streamVerifierChan    chan *verify.UnverifiedElement
 	streamVerifierDropped chan *verify.UnverifiedElement
-	erl                   *util.ElasticRateLimiter
+
+	// backlogMu protects the settings of the backlog which can change while the handler is running
+	backlogMu deadlock.RWMutex
+	// backlogLimit is the number of messages the backlog queue holds at most, up to its capacity
+	backlogLimit int
+	erl          *util.ElasticRateLimiter
+	running      bool
 }
 
 // TxHandlerOpts is TxHandler configuration options
@@ -159,12 +172,7 @@ func MakeTxHandler(opts TxHandlerOpts) (*TxHandler, error) {
 		return nil, ErrInvalidLedger
 	}
 
-	// backlog size is big enough for each peer to have its reserved capacity in the backlog, plus the config's backlogSize as a shared capacity
-	txBacklogSize := opts.Config.TxBacklogSize
-	if opts.Config.EnableTxBacklogRateLimiting {
-		txBacklogSize += (opts.Config.IncomingConnectionsLimit * opts.Config.TxBacklogReservedCapacityPerPeer)
-	}
-
+	txBacklogSize := backlogSize(opts.Config)
 	handler := &TxHandler{
 		txPool:                opts.TxPool,
 		genesisID:             opts.GenesisID,
@@ -176,19 +184,13 @@ func MakeTxHandler(opts TxHandlerOpts) (*TxHandler, error) {
 		net:                   opts.Net,
 		msgCache:              makeSaltedCache(2 * txBacklogSize),
 		txCanonicalCache:      makeDigestCache(2 * txBacklogSize),
+		txRequestedCache:      makeSaltedCache(2 * txBacklogSize),
+		txRequests:            makeTxRequestTracker(),
 		cacheConfig:           txHandlerConfig{opts.Config.TxFilterRawMsgEnabled(), opts.Config.TxFilterCanonicalEnabled()},
 		streamVerifierChan:    make(chan *verify.UnverifiedElement),
 		streamVerifierDropped: make(chan *verify.UnverifiedElement),
-	}
-
-	if opts.Config.EnableTxBacklogRateLimiting {
-		rateLimiter := util.NewElasticRateLimiter(
-			txBacklogSize,
-			opts.Config.TxBacklogReservedCapacityPerPeer,
-			time.Duration(opts.Config.TxBacklogServiceRateWindowSeconds)*time.Second,
-			txBacklogDroppedCongestionManagement,
-		)
-		handler.erl = rateLimiter
+		backlogLimit:          txBacklogSize,
+		erl:                   makeBacklogRateLimiter(opts.Config, txBacklogSize),
 	}
 
 	// prepare the transaction stream verifer
@@ -203,6 +205,62 @@ func MakeTxHandler(opts TxHandlerOpts) (*TxHandler, error) {
 	return handler, nil
 }
 
+// backlogSize returns the size of the backlog for the given configuration. It is big enough for each peer to
+// have its reserved capacity in the backlog, plus the config's backlogSize as a shared capacity
+func backlogSize(cfg config.Local) int {
+	txBacklogSize := cfg.TxBacklogSize
+	if cfg.EnableTxBacklogRateLimiting {
+		txBacklogSize += (cfg.IncomingConnectionsLimit * cfg.TxBacklogReservedCapacityPerPeer)
+	}
+	return txBacklogSize
+}
+
+// makeBacklogRateLimiter returns the rate limiter of a backlog of the given size, or nil if the configuration
+// doesn't enable the backlog rate limiting.
+func makeBacklogRateLimiter(cfg config.Local, txBacklogSize int) *util.ElasticRateLimiter {
+	if !cfg.EnableTxBacklogRateLimiting {
+		return nil
+	}
+	return util.NewElasticRateLimiter(
+		txBacklogSize,
+		cfg.TxBacklogReservedCapacityPerPeer,
+		time.Duration(cfg.TxBacklogServiceRateWindowSeconds)*time.Second,
+		txBacklogDroppedCongestionManagement,
+	)
+}
+
+// SetBacklogConfig applies the TxBacklog settings of cfg, along with its EnableTxBacklogRateLimiting and
+// IncomingConnectionsLimit, to the backlog of the handler while it runs. The backlog queue is allocated when
+// the handler is made, so the backlog can only shrink from there: it returns an error, and leaves the backlog
+// unchanged, when cfg asks for a bigger one.
+func (handler *TxHandler) SetBacklogConfig(cfg config.Local) error {
+	txBacklogSize := backlogSize(cfg)
+	if txBacklogSize < 0 || txBacklogSize > cap(handler.backlogQueue) {
+		return fmt.Errorf("backlog size %d is outside of the range [0, %d] the handler was made with", txBacklogSize, cap(handler.backlogQueue))
+	}
+	erl := makeBacklogRateLimiter(cfg, txBacklogSize)
+
+	handler.backlogMu.Lock()
+	defer handler.backlogMu.Unlock()
+	// the capacity guards vended by the previous rate limiter are released into it, so they don't need to be moved over
+	if handler.running && handler.erl != nil {
+		handler.erl.Stop()
+	}
+	handler.backlogLimit = txBacklogSize
+	handler.erl = erl
+	if handler.running && handler.erl != nil {
+		handler.erl.Start()
+	}
+	return nil
+}
+
+// backlogSettings returns the current limit of the backlog and its rate limiter, if any.
+func (handler *TxHandler) backlogSettings() (int, *util.ElasticRateLimiter) {
+	handler.backlogMu.RLock()
+	defer handler.backlogMu.RUnlock()
+	return handler.backlogLimit, handler.erl
+}
+
 func (handler *TxHandler) droppedTxnWatcher() {
 	for unverified := range handler.streamVerifierDropped {
 		// we failed to write to the output queue, since the queue was full.
@@ -220,27 +278,36 @@ func (handler *TxHandler) droppedTxnWatcher() {
 func (handler *TxHandler) Start() {
 	handler.ctx, handler.ctxCancel = context.WithCancel(context.Background())
 	handler.msgCache.Start(handler.ctx, 60*time.Second)
+	handler.txRequestedCache.Start(handler.ctx, 60*time.Second)
 	handler.net.RegisterHandlers([]network.TaggedMessageHandler{
 		{Tag: protocol.TxnTag, MessageHandler: network.HandlerFunc(handler.processIncomingTxn)},
+		{Tag: protocol.TxnAdvertiseTag, MessageHandler: network.HandlerFunc(handler.processIncomingTxnAnnouncement)},
 	})
 	handler.backlogWg.Add(2)
 	go handler.backlogWorker()
 	go handler.backlogGaugeThread()
 	handler.streamVerifier.Start(handler.ctx)
+	handler.backlogMu.Lock()
+	handler.running = true
 	if handler.erl != nil {
 		handler.erl.Start()
 	}
+	handler.backlogMu.Unlock()
 }
 
 // Stop suspends the processing of incoming messages at the transaction handler
 func (handler *TxHandler) Stop() {
 	handler.ctxCancel()
+	handler.backlogMu.Lock()
+	handler.running = false
 	if handler.erl != nil {
 		handler.erl.Stop()
 	}
+	handler.backlogMu.Unlock()
 	handler.backlogWg.Wait()
 	handler.streamVerifier.WaitForStop()
 	handler.msgCache.WaitForStop()
+	handler.txRequestedCache.WaitForStop()
 }
 
 func reencode(stxns []transactions.SignedTxn) []byte {
@@ -559,6 +626,10 @@ func (handler *TxHandler) dedupCanonical(ntx int, unverifiedTxGroup []transactio
 //  - transactions are checked for duplicates
 
 func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) network.OutgoingMessage {
+	if handler.txRequests != nil {
+		handler.txRequests.received(rawmsg.Data)
+	}
+
 	var msgKey *crypto.Digest
 	var isDup bool
 	if handler.cacheConfig.enableFilteringRawMsg {
@@ -577,18 +648,19 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 
 	var err error
 	var capguard *util.ErlCapacityGuard
-	if handler.erl != nil {
+	backlogLimit, erl := handler.backlogSettings()
+	if erl != nil {
 		// consume a capacity unit
-		capguard, err = handler.erl.ConsumeCapacity(rawmsg.Sender.(util.ErlClient))
+		capguard, err = erl.ConsumeCapacity(rawmsg.Sender.(util.ErlClient))
 		if err != nil {
-			handler.erl.EnableCongestionControl()
+			erl.EnableCongestionControl()
 			// if there is no capacity, it is the same as if we failed to put the item onto the backlog, so report such
 			transactionMessagesDroppedFromBacklog.Inc(nil)
 			return network.OutgoingMessage{Action: network.Ignore}
 		}
 		// if the backlog Queue has 50% of its buffer back, turn congestion control off
-		if float64(cap(handler.backlogQueue))*0.5 > float64(len(handler.backlogQueue)) {
-			handler.erl.DisableCongestionControl()
+		if float64(backlogLimit)*0.5 > float64(len(handler.backlogQueue)) {
+			erl.DisableCongestionControl()
 		}
 	}
 
@@ -636,15 +708,22 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 		}
 	}
 
-	select {
-	case handler.backlogQueue <- &txBacklogMsg{
-		rawmsg:                &rawmsg,
-		unverifiedTxGroup:     unverifiedTxGroup,
-		rawmsgDataHash:        msgKey,
-		unverifiedTxGroupHash: canonicalKey,
-		capguard:              capguard,
-	}:
-	default:
+	enqueued := false
+	// the backlog might have been limited below the capacity of its queue since the handler was made
+	if len(handler.backlogQueue) < backlogLimit {
+		select {
+		case handler.backlogQueue <- &txBacklogMsg{
+			rawmsg:                &rawmsg,
+			unverifiedTxGroup:     unverifiedTxGroup,
+			rawmsgDataHash:        msgKey,
+			unverifiedTxGroupHash: canonicalKey,
+			capguard:              capguard,
+		}:
+			enqueued = true
+		default:
+		}
+	}
+	if !enqueued {
 		// if we failed here we want to increase the corresponding metric. It might suggest that we
 		// want to increase the queue size.
 		transactionMessagesDroppedFromBacklog.Inc(nil)
@@ -661,6 +740,58 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 	return network.OutgoingMessage{Action: network.Ignore}
 }
 
+// processIncomingTxnAnnouncement requests from the announcing peer the transaction groups it announced
+// which were neither received nor requested before. The announced digests are the keys of the canonical
+// dedup cache, since the relayed transaction groups are canonically encoded, and the requested ones are
+// kept in the salted txRequestedCache.
+// A group whose request goes unanswered is not requested again from other peers until txRequestedCache
+// rotates it out. Instead, txRequests reports it as missed, which makes the TxSyncer fetch it with a bloom
+// filter sync if it is still pending.
+func (handler *TxHandler) processIncomingTxnAnnouncement(rawmsg network.IncomingMessage) network.OutgoingMessage {
+	digests, err := network.UnmarshallTxnDigests(rawmsg.Data)
+	if err != nil {
+		logging.Base().Warnf("Received an invalid transaction announcement: %v", err)
+		return network.OutgoingMessage{Action: network.Disconnect}
+	}
+	transactionAnnouncementsHandled.Inc(nil)
+
+	wanted := digests[:0]
+	var wantedKeys []*crypto.Digest
+	for i := range digests {
+		if handler.cacheConfig.enableFilteringCanonical && handler.txCanonicalCache.Check(&digests[i]) {
+			transactionAnnouncementsDup.Inc(nil)
+			continue
+		}
+		key, isDup := handler.txRequestedCache.CheckAndPut(digests[i][:])
+		if isDup {
+			transactionAnnouncementsDup.Inc(nil)
+			continue
+		}
+		wanted = append(wanted, digests[i])
+		wantedKeys = append(wantedKeys, key)
+	}
+	if len(wanted) == 0 {
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+
+	peer, ok := rawmsg.Sender.(network.UnicastPeer)
+	if !ok {
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+	err = peer.Unicast(handler.ctx, network.MarshallTxnDigests(wanted), protocol.TxnRequestTag)
+	if err != nil {
+		logging.Base().Debugf("unable to request announced transactions: %v", err)
+		// give the other announcing peers a chance
+		for _, key := range wantedKeys {
+			handler.txRequestedCache.DeleteByKey(key)
+		}
+		return network.OutgoingMessage{Action: network.Ignore}
+	}
+	handler.txRequests.requested(wanted, time.Now())
+	transactionAnnouncementsRequested.AddUint64(uint64(len(wanted)), nil)
+	return network.OutgoingMessage{Action: network.Ignore}
+}
+
 // checkAlreadyCommitted test to see if the given transaction ( in the txBacklogMsg ) was already committed, and
 // whether it would qualify as a candidate for the transaction pool.
 //
@@ -734,6 +865,12 @@ func (handler *TxHandler) processDecoded(unverifiedTxGroup []transactions.Signed
 	return network.OutgoingMessage{}, false
 }
 
+// MissedTxnRequests returns a channel signaled when announced transaction groups requested from peers
+// were not received in time
+func (handler *TxHandler) MissedTxnRequests() <-chan struct{} {
+	return handler.txRequests.missed
+}
+
 // SolicitedTxHandler handles messages received through channels other than the gossip network.
 // It therefore circumvents the notion of incoming/outgoing messages
 type SolicitedTxHandler interface {
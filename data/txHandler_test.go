@@ -239,6 +239,76 @@ func TestTxHandlerProcessIncomingTxn(t *testing.T) {
 	}
 }
 
+// mockUnicastSender records the messages unicast by the transaction handler
+type mockUnicastSender struct {
+	mockSender
+	network.UnicastPeer
+	sent [][]byte
+	tags []protocol.Tag
+	err  error
+}
+
+func (m *mockUnicastSender) Unicast(ctx context.Context, data []byte, tag protocol.Tag) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, data)
+	m.tags = append(m.tags, tag)
+	return nil
+}
+
+func TestTxHandlerProcessIncomingTxnAnnouncement(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler := makeTestTxHandlerOrphanedWithContext(context.Background(), txBacklogSize, txBacklogSize, txHandlerConfig{true, true}, 0)
+	_, received := makeRandomTransactions(3)
+	_, missing := makeRandomTransactions(1)
+	action := handler.processIncomingTxn(network.IncomingMessage{Data: received, Sender: mockSender{}})
+	require.Equal(t, network.OutgoingMessage{Action: network.Ignore}, action)
+
+	// only the group which was not received is requested
+	digests := []crypto.Digest{crypto.Hash(received), crypto.Hash(missing)}
+	announcement := network.MarshallTxnDigests(digests)
+	peer := &mockUnicastSender{}
+	action = handler.processIncomingTxnAnnouncement(network.IncomingMessage{Data: announcement, Sender: peer})
+	require.Equal(t, network.OutgoingMessage{Action: network.Ignore}, action)
+	require.Equal(t, []protocol.Tag{protocol.TxnRequestTag}, peer.tags)
+	require.Equal(t, [][]byte{network.MarshallTxnDigests(digests[1:])}, peer.sent)
+
+	require.Contains(t, handler.txRequests.pending, digests[1])
+
+	// the group is not requested again from other peers
+	other := &mockUnicastSender{}
+	action = handler.processIncomingTxnAnnouncement(network.IncomingMessage{Data: announcement, Sender: other})
+	require.Equal(t, network.OutgoingMessage{Action: network.Ignore}, action)
+	require.Empty(t, other.sent)
+
+	// the requested group is received
+	handler.processIncomingTxn(network.IncomingMessage{Data: missing, Sender: peer})
+	require.Empty(t, handler.txRequests.pending)
+
+	// when the request cannot be sent, the group can be requested from other peers
+	_, missing = makeRandomTransactions(2)
+	announcement = network.MarshallTxnDigests([]crypto.Digest{crypto.Hash(missing)})
+	failing := &mockUnicastSender{err: errors.New("unicast failed")}
+	handler.processIncomingTxnAnnouncement(network.IncomingMessage{Data: announcement, Sender: failing})
+	require.Empty(t, handler.txRequests.pending)
+	handler.processIncomingTxnAnnouncement(network.IncomingMessage{Data: announcement, Sender: other})
+	require.Equal(t, [][]byte{announcement}, other.sent)
+
+	// the group is not received, the TxSyncer is signaled once the request expires
+	handler.txRequests.requested(nil, time.Now().Add(txRequestTimeout+time.Second))
+	select {
+	case <-handler.MissedTxnRequests():
+	default:
+		require.Fail(t, "missed request not signaled")
+	}
+
+	action = handler.processIncomingTxnAnnouncement(network.IncomingMessage{Data: announcement[1:], Sender: other})
+	require.Equal(t, network.OutgoingMessage{Action: network.Disconnect}, action)
+}
+
 // BenchmarkTxHandlerProcessIncomingTxn is single-threaded ProcessIncomingTxn benchmark
 func BenchmarkTxHandlerProcessIncomingTxn(b *testing.B) {
 	deadlockDisable := deadlock.Opts.Disable
@@ -605,6 +675,7 @@ func TestTxHandlerProcessIncomingGroup(t *testing.T) {
 		t.Run(fmt.Sprintf("%d-%d", check.inputSize, check.numDecoded), func(t *testing.T) {
 			handler := TxHandler{
 				backlogQueue: make(chan *txBacklogMsg, 1),
+				backlogLimit: 1,
 			}
 			stxns, blob := makeRandomTransactions(check.inputSize)
 			action := handler.processIncomingTxn(network.IncomingMessage{Data: blob})
@@ -784,11 +855,15 @@ func makeTestTxHandlerOrphanedWithContext(ctx context.Context, backlogSize int,
 	}
 	handler := &TxHandler{
 		backlogQueue:     make(chan *txBacklogMsg, backlogSize),
+		backlogLimit:     backlogSize,
 		msgCache:         makeSaltedCache(cacheSize),
 		txCanonicalCache: makeDigestCache(cacheSize),
+		txRequestedCache: makeSaltedCache(cacheSize),
+		txRequests:       makeTxRequestTracker(),
 		cacheConfig:      txHandlerConfig,
 	}
 	handler.msgCache.Start(ctx, refreshInterval)
+	handler.txRequestedCache.Start(ctx, refreshInterval)
 	return handler
 }
 
@@ -978,6 +1053,47 @@ func TestTxHandlerProcessIncomingCacheBacklogDrop(t *testing.T) {
 	require.Equal(t, initialValue+1, currentValue)
 }
 
+func TestTxHandlerSetBacklogConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	handler := makeTestTxHandlerOrphanedWithContext(context.Background(), 2, 20, txHandlerConfig{true, true}, 0)
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableTxBacklogRateLimiting = false
+	cfg.TxBacklogSize = 3
+	require.Error(t, handler.SetBacklogConfig(cfg))
+	limit, erl := handler.backlogSettings()
+	require.Equal(t, 2, limit)
+	require.Nil(t, erl)
+
+	cfg.TxBacklogSize = 1
+	require.NoError(t, handler.SetBacklogConfig(cfg))
+
+	_, blob1 := makeRandomTransactions(1)
+	handler.processIncomingTxn(network.IncomingMessage{Data: blob1})
+	require.Equal(t, 1, len(handler.backlogQueue))
+
+	// the queue has room for another message, but the backlog is limited below its capacity.
+	initialValue := transactionMessagesDroppedFromBacklog.GetUint64Value()
+	_, blob2 := makeRandomTransactions(1)
+	handler.processIncomingTxn(network.IncomingMessage{Data: blob2})
+	require.Equal(t, 1, len(handler.backlogQueue))
+	require.Equal(t, initialValue+1, transactionMessagesDroppedFromBacklog.GetUint64Value())
+
+	// the reserved capacities of the peers count in the backlog size once rate limiting is enabled.
+	cfg.EnableTxBacklogRateLimiting = true
+	cfg.IncomingConnectionsLimit = 1
+	cfg.TxBacklogReservedCapacityPerPeer = 2
+	require.Error(t, handler.SetBacklogConfig(cfg))
+	cfg.TxBacklogReservedCapacityPerPeer = 1
+	require.NoError(t, handler.SetBacklogConfig(cfg))
+	limit, erl = handler.backlogSettings()
+	require.Equal(t, 2, limit)
+	require.NotNil(t, erl)
+	require.Equal(t, 2, erl.MaxCapacity)
+	require.Equal(t, 1, erl.CapacityPerReservation)
+}
+
 func TestTxHandlerProcessIncomingCacheTxPoolDrop(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package data
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// txRequestTimeout is the time after which a transaction group requested from a peer is considered missed
+const txRequestTimeout = 5 * time.Second
+
+// maxTrackedTxRequests bounds the number of requested transaction groups waiting to be received
+const maxTrackedTxRequests = 10000
+
+type trackedTxRequest struct {
+	digest    crypto.Digest
+	requested time.Time
+}
+
+// txRequestTracker follows the announced transaction groups requested from peers until they are received.
+// The groups which are not received within txRequestTimeout are reported on the missed channel, so that
+// the TxSyncer fetches them without waiting for its next sync. The expired requests are detected when
+// new groups get requested.
+type txRequestTracker struct {
+	mu deadlock.Mutex
+	// pending holds the time of the last request of the groups which were not received yet
+	pending map[crypto.Digest]time.Time
+	// queue holds the requests in the order they were sent, including the ones since received
+	queue []trackedTxRequest
+	// count is the size of pending, read without the lock to skip hashing the received messages
+	count int32
+
+	missed chan struct{}
+}
+
+func makeTxRequestTracker() *txRequestTracker {
+	return &txRequestTracker{
+		pending: make(map[crypto.Digest]time.Time),
+		missed:  make(chan struct{}, 1),
+	}
+}
+
+// requested starts following the requested groups, and reports the expired requests
+func (t *txRequestTracker) requested(digests []crypto.Digest, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.expire(now)
+	for _, d := range digests {
+		if len(t.queue) >= maxTrackedTxRequests {
+			break
+		}
+		t.pending[d] = now
+		t.queue = append(t.queue, trackedTxRequest{digest: d, requested: now})
+	}
+	atomic.StoreInt32(&t.count, int32(len(t.pending)))
+}
+
+// received stops following the group of the transaction message data
+func (t *txRequestTracker) received(data []byte) {
+	if atomic.LoadInt32(&t.count) == 0 {
+		return
+	}
+	d := crypto.Hash(data)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.pending, d)
+	atomic.StoreInt32(&t.count, int32(len(t.pending)))
+}
+
+// expire drops the requests older than txRequestTimeout, and signals the missed channel if some
+// of them were not received.
+// locking semantic: the lock must be held
+func (t *txRequestTracker) expire(now time.Time) {
+	missed := 0
+	expired := 0
+	for ; expired < len(t.queue) && now.Sub(t.queue[expired].requested) > txRequestTimeout; expired++ {
+		req := t.queue[expired]
+		// a group requested again is followed from its last request
+		if last, ok := t.pending[req.digest]; ok && !last.After(req.requested) {
+			delete(t.pending, req.digest)
+			missed++
+		}
+	}
+	if expired == 0 {
+		return
+	}
+	t.queue = append(t.queue[:0], t.queue[expired:]...)
+	if missed > 0 {
+		transactionAnnouncementsMissed.AddUint64(uint64(missed), nil)
+		select {
+		case t.missed <- struct{}{}:
+		default:
+		}
+	}
+}
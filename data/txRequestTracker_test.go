@@ -0,0 +1,66 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxRequestTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tracker := makeTxRequestTracker()
+	received, missing, again := []byte("received"), []byte("missing"), []byte("again")
+	start := time.Now()
+	tracker.requested([]crypto.Digest{crypto.Hash(received), crypto.Hash(missing), crypto.Hash(again)}, start)
+	tracker.received(received)
+	tracker.received([]byte("unknown"))
+	require.Len(t, tracker.pending, 2)
+
+	// the group requested again is followed from its last request
+	tracker.requested([]crypto.Digest{crypto.Hash(again)}, start.Add(txRequestTimeout))
+	require.Empty(t, tracker.missed)
+	tracker.requested(nil, start.Add(txRequestTimeout+time.Second))
+	require.Len(t, tracker.missed, 1)
+	<-tracker.missed
+	require.Len(t, tracker.pending, 1)
+	require.Contains(t, tracker.pending, crypto.Hash(again))
+	require.Len(t, tracker.queue, 1)
+
+	tracker.received(again)
+	tracker.requested(nil, start.Add(3*txRequestTimeout))
+	require.Empty(t, tracker.missed)
+	require.Empty(t, tracker.pending)
+	require.Empty(t, tracker.queue)
+	require.Zero(t, tracker.count)
+
+	// the number of requests followed is bounded
+	digests := make([]crypto.Digest, maxTrackedTxRequests+1)
+	for i := range digests {
+		crypto.RandBytes(digests[i][:])
+	}
+	tracker.requested(digests, start.Add(4*txRequestTimeout))
+	require.Len(t, tracker.pending, maxTrackedTxRequests)
+	require.NotContains(t, tracker.pending, digests[maxTrackedTxRequests])
+}
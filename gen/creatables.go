@@ -0,0 +1,474 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// AssetData describes an asset that exists at the genesis round.
+type AssetData struct {
+	// ID is the asset index. Zero picks the lowest index that no other genesis asset or
+	// application uses.
+	ID uint64 `json:",omitempty"`
+	// Creator names the genesis account creating the asset. It is also the manager, reserve,
+	// freeze and clawback account of the asset, and holds the part of the supply that the
+	// Holdings do not.
+	Creator       string
+	Total         uint64
+	Decimals      uint32             `json:",omitempty"`
+	DefaultFrozen bool               `json:",omitempty"`
+	UnitName      string             `json:",omitempty"`
+	AssetName     string             `json:",omitempty"`
+	URL           string             `json:",omitempty"`
+	Holdings      []AssetHoldingData `json:",omitempty"`
+}
+
+// AssetHoldingData opts a genesis account into an asset, giving it Amount units of the
+// creator's supply.
+type AssetHoldingData struct {
+	Account string
+	Amount  uint64 `json:",omitempty"`
+	Frozen  bool   `json:",omitempty"`
+}
+
+// AppData describes an application that exists at the genesis round.
+type AppData struct {
+	// ID is the application index. Zero picks the lowest index that no other genesis asset
+	// or application uses.
+	ID uint64 `json:",omitempty"`
+	// Creator names the genesis account creating the application.
+	Creator string
+	// ApprovalProgram and ClearStateProgram are TEAL sources, assembled when the genesis is generated.
+	ApprovalProgram   string
+	ClearStateProgram string
+	ExtraProgramPages uint32 `json:",omitempty"`
+	GlobalSchema      basics.StateSchema
+	LocalSchema       basics.StateSchema
+	GlobalState       []StateData    `json:",omitempty"`
+	OptIns            []AppOptInData `json:",omitempty"`
+	Boxes             []BoxData      `json:",omitempty"`
+	// Balance is the balance of the application account on top of the minimum balance its
+	// boxes require. The application account is only funded when it has boxes or a Balance.
+	Balance uint64 `json:",omitempty"`
+}
+
+// StateData is a key/value pair of application state. An entry with a non-empty Bytes holds
+// a byte-slice value, any other entry an uint value.
+type StateData struct {
+	Key   string
+	Bytes string `json:",omitempty"`
+	Uint  uint64 `json:",omitempty"`
+}
+
+// AppOptInData opts a genesis account into an application, with the given local state.
+type AppOptInData struct {
+	Account    string
+	LocalState []StateData `json:",omitempty"`
+}
+
+// BoxData describes a box of an application. The box is Size bytes long, starting with
+// Value and zero-padded; a zero Size makes the box as long as its Value.
+type BoxData struct {
+	Name  string
+	Value string `json:",omitempty"`
+	Size  uint64 `json:",omitempty"`
+}
+
+// appAccountName is the name of the genesis allocation of an application account.
+func appAccountName(appIdx basics.AppIndex) string {
+	return fmt.Sprintf("App%d", appIdx)
+}
+
+// CheckCreatables verifies that the assets and applications of the genesis data refer to
+// accounts of the genesis, and do not claim the same index twice. The rest of their content
+// is checked against the consensus parameters when the genesis is generated.
+func (genData GenesisData) CheckCreatables() error {
+	accounts := make(map[string]bool, len(genData.Wallets))
+	for _, wallet := range genData.Wallets {
+		accounts[wallet.Name] = true
+	}
+	known := func(name string) bool {
+		if accounts[name] {
+			return true
+		}
+		if !strings.HasPrefix(name, "Deterministic") {
+			return false
+		}
+		i, err := strconv.ParseUint(strings.TrimPrefix(name, "Deterministic"), 10, 64)
+		return err == nil && name == fmt.Sprintf("Deterministic%d", i) && i < genData.DeterministicAccounts
+	}
+
+	for _, asset := range genData.Assets {
+		if !known(asset.Creator) {
+			return fmt.Errorf("asset %s: unknown creator account %s", asset.AssetName, asset.Creator)
+		}
+		for _, holding := range asset.Holdings {
+			if !known(holding.Account) {
+				return fmt.Errorf("asset %s: unknown holding account %s", asset.AssetName, holding.Account)
+			}
+		}
+	}
+	for i, app := range genData.Apps {
+		if !known(app.Creator) {
+			return fmt.Errorf("application #%d: unknown creator account %s", i, app.Creator)
+		}
+		for _, optIn := range app.OptIns {
+			if !known(optIn.Account) {
+				return fmt.Errorf("application #%d: unknown opted in account %s", i, optIn.Account)
+			}
+		}
+	}
+
+	_, _, err := genData.creatableIndices()
+	return err
+}
+
+// creatableIndices returns the indices of the genesis assets and applications, in order.
+func (genData GenesisData) creatableIndices() (assets []basics.AssetIndex, apps []basics.AppIndex, err error) {
+	taken := make(map[uint64]bool)
+	claim := func(id uint64) error {
+		if id == 0 {
+			return nil
+		}
+		if taken[id] {
+			return fmt.Errorf("index %d is used by more than one genesis asset or application", id)
+		}
+		taken[id] = true
+		return nil
+	}
+	for _, asset := range genData.Assets {
+		if err = claim(asset.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, app := range genData.Apps {
+		if err = claim(app.ID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	next := uint64(1)
+	pick := func(id uint64) uint64 {
+		if id != 0 {
+			return id
+		}
+		for taken[next] {
+			next++
+		}
+		taken[next] = true
+		return next
+	}
+	for _, asset := range genData.Assets {
+		assets = append(assets, basics.AssetIndex(pick(asset.ID)))
+	}
+	for _, app := range genData.Apps {
+		apps = append(apps, basics.AppIndex(pick(app.ID)))
+	}
+	return assets, apps, nil
+}
+
+// addGenesisCreatables creates the assets and applications of genData in the genesis records,
+// which are keyed by account name like the addresses in addrs. The funded application accounts
+// are added to both maps, and their names returned along with the boxes of the applications.
+func addGenesisCreatables(genData GenesisData, proto config.ConsensusParams, records map[string]basics.AccountData, addrs map[string]basics.Address) (appAccounts []string, boxes []bookkeeping.GenesisBox, err error) {
+	assetIndices, appIndices, err := genData.creatableIndices()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	touched := make(map[string]bool)
+	lookup := func(name string) (basics.AccountData, error) {
+		data, ok := records[name]
+		if !ok {
+			return basics.AccountData{}, fmt.Errorf("unknown genesis account %s", name)
+		}
+		touched[name] = true
+		return data, nil
+	}
+
+	for i, asset := range genData.Assets {
+		aidx := assetIndices[i]
+		err = addGenesisAsset(aidx, asset, proto, lookup, records, addrs)
+		if err != nil {
+			return nil, nil, fmt.Errorf("asset %d: %w", aidx, err)
+		}
+	}
+
+	for i, app := range genData.Apps {
+		appIdx := appIndices[i]
+		var appBoxes []bookkeeping.GenesisBox
+		appBoxes, err = addGenesisApp(appIdx, app, proto, lookup, records)
+		if err != nil {
+			return nil, nil, fmt.Errorf("application %d: %w", appIdx, err)
+		}
+		if len(appBoxes) == 0 && app.Balance == 0 {
+			continue
+		}
+
+		name := appAccountName(appIdx)
+		if _, exists := records[name]; exists {
+			return nil, nil, fmt.Errorf("application %d: genesis account %s already exists", appIdx, name)
+		}
+		data := basics.AccountData{Status: basics.Offline}
+		for _, box := range appBoxes {
+			data.TotalBoxes++
+			data.TotalBoxBytes += uint64(len(box.Name) + len(box.Value))
+		}
+		data.MicroAlgos.Raw = data.MinBalance(&proto).Raw + app.Balance
+		records[name] = data
+		addrs[name] = appIdx.Address()
+		appAccounts = append(appAccounts, name)
+		boxes = append(boxes, appBoxes...)
+	}
+
+	for name := range touched {
+		data := records[name]
+		if proto.MaxAssetsPerAccount > 0 && len(data.Assets) > proto.MaxAssetsPerAccount {
+			return nil, nil, fmt.Errorf("genesis account %s holds %d assets, more than the %d allowed", name, len(data.Assets), proto.MaxAssetsPerAccount)
+		}
+		if proto.MaxAppsCreated > 0 && len(data.AppParams) > proto.MaxAppsCreated {
+			return nil, nil, fmt.Errorf("genesis account %s creates %d applications, more than the %d allowed", name, len(data.AppParams), proto.MaxAppsCreated)
+		}
+		if proto.MaxAppsOptedIn > 0 && len(data.AppLocalStates) > proto.MaxAppsOptedIn {
+			return nil, nil, fmt.Errorf("genesis account %s opts into %d applications, more than the %d allowed", name, len(data.AppLocalStates), proto.MaxAppsOptedIn)
+		}
+		if minBalance := data.MinBalance(&proto).Raw; data.MicroAlgos.Raw < minBalance {
+			return nil, nil, fmt.Errorf("genesis account %s has a balance of %d, below the minimum balance of %d its assets and applications require", name, data.MicroAlgos.Raw, minBalance)
+		}
+	}
+	return appAccounts, boxes, nil
+}
+
+func addGenesisAsset(aidx basics.AssetIndex, asset AssetData, proto config.ConsensusParams, lookup func(string) (basics.AccountData, error), records map[string]basics.AccountData, addrs map[string]basics.Address) error {
+	if len(asset.UnitName) > proto.MaxAssetUnitNameBytes {
+		return fmt.Errorf("unit name is longer than %d bytes", proto.MaxAssetUnitNameBytes)
+	}
+	if len(asset.AssetName) > proto.MaxAssetNameBytes {
+		return fmt.Errorf("asset name is longer than %d bytes", proto.MaxAssetNameBytes)
+	}
+	if len(asset.URL) > proto.MaxAssetURLBytes {
+		return fmt.Errorf("url is longer than %d bytes", proto.MaxAssetURLBytes)
+	}
+	if asset.Decimals > proto.MaxAssetDecimals {
+		return fmt.Errorf("decimals exceed %d", proto.MaxAssetDecimals)
+	}
+
+	creator, err := lookup(asset.Creator)
+	if err != nil {
+		return err
+	}
+	creatorAddr := addrs[asset.Creator]
+	if creator.AssetParams == nil {
+		creator.AssetParams = make(map[basics.AssetIndex]basics.AssetParams)
+	}
+	creator.AssetParams[aidx] = basics.AssetParams{
+		Total:         asset.Total,
+		Decimals:      asset.Decimals,
+		DefaultFrozen: asset.DefaultFrozen,
+		UnitName:      asset.UnitName,
+		AssetName:     asset.AssetName,
+		URL:           asset.URL,
+		Manager:       creatorAddr,
+		Reserve:       creatorAddr,
+		Freeze:        creatorAddr,
+		Clawback:      creatorAddr,
+	}
+	records[asset.Creator] = creator
+
+	remaining := asset.Total
+	for _, holding := range asset.Holdings {
+		if holding.Account == asset.Creator {
+			return fmt.Errorf("creator %s holds whatever the other holdings leave of the supply", asset.Creator)
+		}
+		if holding.Amount > remaining {
+			return fmt.Errorf("holdings exceed the total supply of %d", asset.Total)
+		}
+		remaining -= holding.Amount
+
+		holder, err := lookup(holding.Account)
+		if err != nil {
+			return err
+		}
+		if _, ok := holder.Assets[aidx]; ok {
+			return fmt.Errorf("account %s holds the asset more than once", holding.Account)
+		}
+		if holder.Assets == nil {
+			holder.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+		}
+		holder.Assets[aidx] = basics.AssetHolding{Amount: holding.Amount, Frozen: holding.Frozen || asset.DefaultFrozen}
+		records[holding.Account] = holder
+	}
+
+	creator = records[asset.Creator]
+	if creator.Assets == nil {
+		creator.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+	}
+	creator.Assets[aidx] = basics.AssetHolding{Amount: remaining}
+	records[asset.Creator] = creator
+	return nil
+}
+
+func addGenesisApp(appIdx basics.AppIndex, app AppData, proto config.ConsensusParams, lookup func(string) (basics.AccountData, error), records map[string]basics.AccountData) (boxes []bookkeeping.GenesisBox, err error) {
+	approval, err := assembleGenesisProgram(app.ApprovalProgram, proto)
+	if err != nil {
+		return nil, fmt.Errorf("approval program: %w", err)
+	}
+	clearState, err := assembleGenesisProgram(app.ClearStateProgram, proto)
+	if err != nil {
+		return nil, fmt.Errorf("clear state program: %w", err)
+	}
+	if app.ExtraProgramPages > uint32(proto.MaxExtraAppProgramPages) {
+		return nil, fmt.Errorf("%d extra program pages exceed the maximum of %d", app.ExtraProgramPages, proto.MaxExtraAppProgramPages)
+	}
+	pages := 1 + int(app.ExtraProgramPages)
+	if len(approval) > pages*proto.MaxAppProgramLen || len(clearState) > pages*proto.MaxAppProgramLen ||
+		len(approval)+len(clearState) > pages*proto.MaxAppTotalProgramLen {
+		return nil, fmt.Errorf("programs do not fit in %d program pages", pages)
+	}
+	if app.GlobalSchema.NumEntries() > proto.MaxGlobalSchemaEntries {
+		return nil, fmt.Errorf("global schema exceeds %d entries", proto.MaxGlobalSchemaEntries)
+	}
+	if app.LocalSchema.NumEntries() > proto.MaxLocalSchemaEntries {
+		return nil, fmt.Errorf("local schema exceeds %d entries", proto.MaxLocalSchemaEntries)
+	}
+
+	globalState, err := makeGenesisAppState(app.GlobalState, app.GlobalSchema, proto)
+	if err != nil {
+		return nil, fmt.Errorf("global state: %w", err)
+	}
+
+	creator, err := lookup(app.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if creator.AppParams == nil {
+		creator.AppParams = make(map[basics.AppIndex]basics.AppParams)
+	}
+	creator.AppParams[appIdx] = basics.AppParams{
+		ApprovalProgram:   approval,
+		ClearStateProgram: clearState,
+		GlobalState:       globalState,
+		StateSchemas: basics.StateSchemas{
+			LocalStateSchema:  app.LocalSchema,
+			GlobalStateSchema: app.GlobalSchema,
+		},
+		ExtraProgramPages: app.ExtraProgramPages,
+	}
+	creator.TotalAppSchema = creator.TotalAppSchema.AddSchema(app.GlobalSchema)
+	creator.TotalExtraAppPages += app.ExtraProgramPages
+	records[app.Creator] = creator
+
+	for _, optIn := range app.OptIns {
+		localState, err := makeGenesisAppState(optIn.LocalState, app.LocalSchema, proto)
+		if err != nil {
+			return nil, fmt.Errorf("local state of %s: %w", optIn.Account, err)
+		}
+		holder, err := lookup(optIn.Account)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := holder.AppLocalStates[appIdx]; ok {
+			return nil, fmt.Errorf("account %s opts in more than once", optIn.Account)
+		}
+		if holder.AppLocalStates == nil {
+			holder.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState)
+		}
+		holder.AppLocalStates[appIdx] = basics.AppLocalState{Schema: app.LocalSchema, KeyValue: localState}
+		holder.TotalAppSchema = holder.TotalAppSchema.AddSchema(app.LocalSchema)
+		records[optIn.Account] = holder
+	}
+
+	names := make(map[string]bool, len(app.Boxes))
+	for _, box := range app.Boxes {
+		if proto.MaxBoxSize == 0 {
+			return nil, fmt.Errorf("boxes are not supported by the consensus protocol")
+		}
+		if len(box.Name) == 0 || len(box.Name) > proto.MaxAppKeyLen {
+			return nil, fmt.Errorf("box name %q is not between 1 and %d bytes long", box.Name, proto.MaxAppKeyLen)
+		}
+		if names[box.Name] {
+			return nil, fmt.Errorf("box %q is declared more than once", box.Name)
+		}
+		names[box.Name] = true
+
+		size := box.Size
+		if size == 0 {
+			size = uint64(len(box.Value))
+		}
+		if size < uint64(len(box.Value)) || size > proto.MaxBoxSize {
+			return nil, fmt.Errorf("box %q size of %d does not fit its value or exceeds %d bytes", box.Name, size, proto.MaxBoxSize)
+		}
+		value := make([]byte, size)
+		copy(value, box.Value)
+		boxes = append(boxes, bookkeeping.GenesisBox{App: appIdx, Name: []byte(box.Name), Value: value})
+	}
+	return boxes, nil
+}
+
+func assembleGenesisProgram(source string, proto config.ConsensusParams) ([]byte, error) {
+	ops, err := logic.AssembleString(source)
+	if err != nil {
+		return nil, err
+	}
+	if ops.Version > proto.LogicSigVersion {
+		return nil, fmt.Errorf("program version %d is not supported by the consensus protocol", ops.Version)
+	}
+	return ops.Program, nil
+}
+
+func makeGenesisAppState(entries []StateData, schema basics.StateSchema, proto config.ConsensusParams) (basics.TealKeyValue, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	kv := make(basics.TealKeyValue, len(entries))
+	for _, entry := range entries {
+		if len(entry.Key) > proto.MaxAppKeyLen {
+			return nil, fmt.Errorf("key %q is longer than %d bytes", entry.Key, proto.MaxAppKeyLen)
+		}
+		if _, ok := kv[entry.Key]; ok {
+			return nil, fmt.Errorf("key %q is declared more than once", entry.Key)
+		}
+		if entry.Bytes == "" {
+			kv[entry.Key] = basics.TealValue{Type: basics.TealUintType, Uint: entry.Uint}
+			continue
+		}
+		if entry.Uint != 0 {
+			return nil, fmt.Errorf("key %q has both a bytes and an uint value", entry.Key)
+		}
+		if len(entry.Bytes) > proto.MaxAppBytesValueLen || len(entry.Key)+len(entry.Bytes) > proto.MaxAppSumKeyValueLens {
+			return nil, fmt.Errorf("value of key %q is too long", entry.Key)
+		}
+		kv[entry.Key] = basics.TealValue{Type: basics.TealBytesType, Bytes: entry.Bytes}
+	}
+
+	used, err := kv.ToStateSchema()
+	if err != nil {
+		return nil, err
+	}
+	if used.NumUint > schema.NumUint || used.NumByteSlice > schema.NumByteSlice {
+		return nil, fmt.Errorf("%d uints and %d byte slices do not fit the schema of %d and %d", used.NumUint, used.NumByteSlice, schema.NumUint, schema.NumByteSlice)
+	}
+	return kv, nil
+}
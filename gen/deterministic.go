@@ -0,0 +1,37 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"encoding/binary"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// DeterministicAccountSecrets returns the keys of the deterministic account of the given index,
+// which are the ones netgoal and pingpong derive from the same index.
+func DeterministicAccountSecrets(index uint64) *crypto.SignatureSecrets {
+	var seed crypto.Seed
+	binary.LittleEndian.PutUint64(seed[:], index)
+	return crypto.GenerateSignatureSecrets(seed)
+}
+
+// DeterministicAccount returns the address of the deterministic account of the given index
+func DeterministicAccount(index uint64) basics.Address {
+	return basics.Address(DeterministicAccountSecrets(index).SignatureVerifier)
+}
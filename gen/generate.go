@@ -143,6 +143,10 @@ func GenerateGenesisFiles(genesisData GenesisData, consensus config.ConsensusPro
 	if err != nil {
 		return err
 	}
+	err = genesisData.CheckCreatables()
+	if err != nil {
+		return err
+	}
 
 	err = os.Mkdir(outDir, os.ModeDir|os.FileMode(0777))
 	if err != nil && os.IsNotExist(err) {
@@ -363,11 +367,33 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		Name: "RewardsPool",
 	}
 
-	alloc2 := make([]genesisAllocation, 0, len(allocation)+2)
+	alloc2 := make([]genesisAllocation, 0, len(allocation)+2+int(genData.DeterministicAccounts))
 	alloc2 = append(alloc2, poolAcct, sinkAcct)
 	alloc2 = append(alloc2, allocation...)
 	allocation = alloc2
 
+	deterministicBalance := genData.DeterministicAccountBalance
+	if deterministicBalance < protoParams.MinBalance {
+		deterministicBalance = protoParams.MinBalance
+	}
+	for i := uint64(0); i < genData.DeterministicAccounts; i++ {
+		name := fmt.Sprintf("Deterministic%d", i)
+		genesisAddrs[name] = DeterministicAccount(i)
+		records[name] = basics.AccountData{
+			Status:     basics.Offline,
+			MicroAlgos: basics.MicroAlgos{Raw: deterministicBalance},
+		}
+		allocation = append(allocation, genesisAllocation{Name: name})
+	}
+
+	appAccounts, boxes, err := addGenesisCreatables(genData, protoParams, records, genesisAddrs)
+	if err != nil {
+		return err
+	}
+	for _, name := range appAccounts {
+		allocation = append(allocation, genesisAllocation{Name: name})
+	}
+
 	g := bookkeeping.Genesis{
 		SchemaID:    schemaID + schemaVersionModifier,
 		Proto:       protoVersion,
@@ -377,6 +403,7 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		RewardsPool: rewardsPool.String(),
 		Comment:     comment,
 		DevMode:     devmode,
+		Boxes:       boxes,
 	}
 
 	for _, wallet := range allocation {
@@ -274,3 +274,144 @@ func TestGenesisJsonCreation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisDeterministicAccounts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	gd := DefaultGenesis
+	gd.NetworkName = "deterministic"
+	gd.LastPartKeyRound = 10
+	gd.Wallets = []WalletData{{Name: "Wallet1", Stake: 100, Online: true}}
+	gd.DeterministicAccounts = 3
+	gd.DeterministicAccountBalance = 5e12
+
+	outDir := t.TempDir()
+	require.NoError(t, GenerateGenesisFiles(gd, config.Consensus, outDir, nil))
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+
+	deterministic := make(map[string]bookkeeping.GenesisAllocation)
+	for _, alloc := range genesis.Allocation {
+		if strings.HasPrefix(alloc.Comment, "Deterministic") {
+			deterministic[alloc.Comment] = alloc
+		}
+	}
+	require.Len(t, deterministic, 3)
+	for i := uint64(0); i < 3; i++ {
+		alloc := deterministic[fmt.Sprintf("Deterministic%d", i)]
+		secrets := DeterministicAccountSecrets(i)
+		require.Equal(t, basics.Address(secrets.SignatureVerifier).String(), alloc.Address)
+		require.Equal(t, uint64(5e12), alloc.State.MicroAlgos.Raw)
+		require.Equal(t, basics.Offline, alloc.State.Status)
+	}
+}
+
+func creatablesGenesisData() GenesisData {
+	gd := DefaultGenesis
+	gd.NetworkName = "creatables"
+	gd.ConsensusProtocol = protocol.ConsensusFuture
+	gd.LastPartKeyRound = 10
+	gd.Wallets = []WalletData{
+		{Name: "Wallet1", Stake: 90, Online: true},
+		{Name: "Wallet2", Stake: 10},
+	}
+	gd.DeterministicAccounts = 1
+	gd.DeterministicAccountBalance = 1e6
+	gd.Assets = []AssetData{
+		{Creator: "Wallet1", Total: 1000, UnitName: "A", Holdings: []AssetHoldingData{
+			{Account: "Wallet2", Amount: 300},
+			{Account: "Deterministic0", Amount: 200, Frozen: true},
+		}},
+		{ID: 2000, Creator: "Wallet2", Total: 1, DefaultFrozen: true},
+	}
+	gd.Apps = []AppData{{
+		Creator:           "Wallet2",
+		ApprovalProgram:   "#pragma version 8\nint 1",
+		ClearStateProgram: "#pragma version 8\nint 1",
+		GlobalSchema:      basics.StateSchema{NumUint: 1, NumByteSlice: 1},
+		LocalSchema:       basics.StateSchema{NumByteSlice: 1},
+		GlobalState:       []StateData{{Key: "n", Uint: 3}, {Key: "s", Bytes: "x"}},
+		OptIns:            []AppOptInData{{Account: "Wallet1", LocalState: []StateData{{Key: "l", Bytes: "y"}}}},
+		Boxes:             []BoxData{{Name: "b1", Value: "v"}, {Name: "b2", Size: 10}},
+		Balance:           5,
+	}}
+	return gd
+}
+
+func TestGenesisCreatables(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	outDir := t.TempDir()
+	require.NoError(t, GenerateGenesisFiles(creatablesGenesisData(), config.Consensus, outDir, nil))
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+
+	states := make(map[string]basics.AccountData)
+	addrs := make(map[string]string)
+	for _, alloc := range genesis.Allocation {
+		states[alloc.Comment] = alloc.State
+		addrs[alloc.Comment] = alloc.Address
+	}
+
+	// the first asset and the application pick the lowest free indices
+	wallet1, wallet2 := states["Wallet1"], states["Wallet2"]
+	require.Equal(t, uint64(1000), wallet1.AssetParams[1].Total)
+	require.Equal(t, addrs["Wallet1"], wallet1.AssetParams[1].Manager.String())
+	require.Equal(t, basics.AssetHolding{Amount: 500}, wallet1.Assets[1])
+	require.Equal(t, basics.AssetHolding{Amount: 300}, wallet2.Assets[1])
+	require.Equal(t, basics.AssetHolding{Amount: 200, Frozen: true}, states["Deterministic0"].Assets[1])
+	// like on asset creation, the creator holding is not frozen by default
+	require.Equal(t, basics.AssetHolding{Amount: 1}, wallet2.Assets[2000])
+
+	params := wallet2.AppParams[2]
+	require.Equal(t, basics.TealValue{Type: basics.TealUintType, Uint: 3}, params.GlobalState["n"])
+	require.Equal(t, basics.TealValue{Type: basics.TealBytesType, Bytes: "x"}, params.GlobalState["s"])
+	require.NotEmpty(t, params.ApprovalProgram)
+	require.Equal(t, basics.StateSchema{NumUint: 1, NumByteSlice: 1}, wallet2.TotalAppSchema)
+	require.Equal(t, basics.StateSchema{NumByteSlice: 1}, wallet1.TotalAppSchema)
+	require.Equal(t, "y", wallet1.AppLocalStates[2].KeyValue["l"].Bytes)
+
+	app := states["App2"]
+	require.Equal(t, basics.AppIndex(2).Address().String(), addrs["App2"])
+	require.Equal(t, uint64(2), app.TotalBoxes)
+	require.Equal(t, uint64(len("b1v")+len("b2")+10), app.TotalBoxBytes)
+	proto := config.Consensus[protocol.ConsensusFuture]
+	require.Equal(t, app.MinBalance(&proto).Raw+5, app.MicroAlgos.Raw)
+
+	require.Equal(t, []bookkeeping.GenesisBox{
+		{App: 2, Name: []byte("b1"), Value: []byte("v")},
+		{App: 2, Name: []byte("b2"), Value: make([]byte, 10)},
+	}, genesis.Boxes)
+
+	blk, err := genesis.Block()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), blk.TxnCounter)
+}
+
+func TestGenesisCreatablesErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tests := []struct {
+		name   string
+		modify func(gd *GenesisData)
+		err    string
+	}{
+		{"unknown creator", func(gd *GenesisData) { gd.Assets[0].Creator = "Wallet3" }, "unknown creator account Wallet3"},
+		{"deterministic out of range", func(gd *GenesisData) { gd.Assets[0].Holdings[1].Account = "Deterministic1" }, "unknown holding account Deterministic1"},
+		{"duplicate index", func(gd *GenesisData) { gd.Apps[0].ID = 2000 }, "index 2000 is used by more than one"},
+		{"over supply", func(gd *GenesisData) { gd.Assets[0].Holdings[0].Amount = 1000 }, "holdings exceed the total supply"},
+		{"schema", func(gd *GenesisData) { gd.Apps[0].GlobalSchema.NumByteSlice = 0 }, "do not fit the schema"},
+		{"program", func(gd *GenesisData) { gd.Apps[0].ApprovalProgram = "int" }, "approval program"},
+		{"box size", func(gd *GenesisData) { gd.Apps[0].Boxes[0].Size = 40000 }, "box \"b1\" size of 40000"},
+		{"min balance", func(gd *GenesisData) { gd.Wallets[1].Stake = 0; gd.Wallets[0].Stake = 100 }, "genesis account Wallet2 has a balance of 0"},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			gd := creatablesGenesisData()
+			test.modify(&gd)
+			err := GenerateGenesisFiles(gd, config.Consensus, t.TempDir(), nil)
+			require.ErrorContains(t, err, test.err)
+		})
+	}
+}
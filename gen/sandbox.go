@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// SandboxNetworkName is the network name of the sandbox genesis.
+const SandboxNetworkName = "sandbox"
+
+// SandboxGenesisData describes the developer mode network of a sandbox node.
+type SandboxGenesisData struct {
+	// Seed is the input the keys of the funded accounts are derived from.
+	Seed string
+	// Accounts is the number of funded accounts.
+	Accounts int
+	// Balance is the balance of each funded account, in MicroAlgos.
+	Balance uint64
+	// Timestamp is the timestamp of the genesis block.
+	Timestamp int64
+}
+
+// SandboxAccountSeed returns the key seed of the i-th funded account of a
+// sandbox genesis. It is derived from the seed alone, so that the accounts are
+// the same for every sandbox using the same seed.
+func SandboxAccountSeed(seed string, i int) crypto.Seed {
+	return crypto.Seed(crypto.Hash([]byte(fmt.Sprintf("sandbox/%s/%d", seed, i))))
+}
+
+// SandboxAccountSecrets returns the keys of the i-th funded account of a
+// sandbox genesis.
+func SandboxAccountSecrets(seed string, i int) *crypto.SignatureSecrets {
+	return crypto.GenerateSignatureSecrets(SandboxAccountSeed(seed, i))
+}
+
+// GenerateSandboxGenesis creates the genesis of a single node, developer mode
+// network, funding data.Accounts accounts whose keys are returned in order.
+// Nothing in the genesis depends on the time or on randomness, two sandboxes
+// made from the same data have the same genesis hash.
+func GenerateSandboxGenesis(data SandboxGenesisData) (bookkeeping.Genesis, []*crypto.SignatureSecrets, error) {
+	proto := protocol.ConsensusCurrentVersion
+	params := config.Consensus[proto]
+	if data.Accounts <= 0 {
+		return bookkeeping.Genesis{}, nil, fmt.Errorf("a sandbox needs at least one funded account")
+	}
+	if data.Balance < params.MinBalance {
+		return bookkeeping.Genesis{}, nil, fmt.Errorf("account balance %d is below the minimum balance %d", data.Balance, params.MinBalance)
+	}
+	if data.Balance > (TotalMoney-2*params.MinBalance)/uint64(data.Accounts) {
+		return bookkeeping.Genesis{}, nil, fmt.Errorf("funding %d accounts with %d MicroAlgos exceeds the total money supply", data.Accounts, data.Balance)
+	}
+
+	g := bookkeeping.Genesis{
+		SchemaID:    schemaID,
+		Proto:       proto,
+		Network:     SandboxNetworkName,
+		Timestamp:   data.Timestamp,
+		FeeSink:     defaultSinkAddr.String(),
+		RewardsPool: defaultPoolAddr.String(),
+		DevMode:     true,
+		Comment:     "sandbox",
+	}
+
+	// the rewards pool only holds its minimum balance, so that no rewards are
+	// distributed.
+	g.Allocation = append(g.Allocation,
+		bookkeeping.GenesisAllocation{
+			Address: defaultPoolAddr.String(),
+			Comment: "RewardsPool",
+			State: basics.AccountData{
+				Status:     basics.NotParticipating,
+				MicroAlgos: basics.MicroAlgos{Raw: params.MinBalance},
+			},
+		},
+		bookkeeping.GenesisAllocation{
+			Address: defaultSinkAddr.String(),
+			Comment: "FeeSink",
+			State: basics.AccountData{
+				Status:     basics.NotParticipating,
+				MicroAlgos: basics.MicroAlgos{Raw: params.MinBalance},
+			},
+		},
+	)
+
+	secrets := make([]*crypto.SignatureSecrets, data.Accounts)
+	for i := range secrets {
+		secrets[i] = SandboxAccountSecrets(data.Seed, i)
+		g.Allocation = append(g.Allocation, bookkeeping.GenesisAllocation{
+			Address: basics.Address(secrets[i].SignatureVerifier).String(),
+			Comment: fmt.Sprintf("Account%d", i),
+			State: basics.AccountData{
+				Status:     basics.Offline,
+				MicroAlgos: basics.MicroAlgos{Raw: data.Balance},
+			},
+		})
+	}
+
+	return g, secrets, nil
+}
@@ -53,6 +53,16 @@ type GenesisData struct {
 	RewardsPoolBalance uint64 // Values < `ConsensusParams.MinBalance` are adjusted to `ConsensusParams.MinBalance`
 	DevMode            bool
 	Comment            string
+	// DeterministicAccounts is the number of accounts, besides the wallets, whose keys derive from
+	// their index, so that tools such as pingpong and blockreplay can sign for them.
+	DeterministicAccounts uint64 `json:",omitempty"`
+	// DeterministicAccountBalance is the balance of each of the DeterministicAccounts. Values <
+	// `ConsensusParams.MinBalance` are adjusted to `ConsensusParams.MinBalance`
+	DeterministicAccountBalance uint64 `json:",omitempty"`
+	// Assets and Apps are created at the genesis round, along with the holdings, opt ins and
+	// boxes they describe. Their creators and holders name wallets or deterministic accounts.
+	Assets []AssetData `json:",omitempty"`
+	Apps   []AppData   `json:",omitempty"`
 }
 
 // LoadGenesisData loads a GenesisData structure from a json file
@@ -22,6 +22,7 @@ require (
 	github.com/gofrs/flock v0.7.0
 	github.com/golang/snappy v0.0.4
 	github.com/google/go-querystring v1.0.0
+	github.com/google/pprof v0.0.0-20211214055906-6f57359322fd
 	github.com/gorilla/mux v1.8.0
 	github.com/jmoiron/sqlx v1.2.0
 	github.com/karalabe/usb v0.0.2
@@ -188,6 +188,56 @@ func prepareNormalizedBalancesV6(bals []encoded.BalanceRecordV6, proto config.Co
 	return
 }
 
+// prepareNormalizedBalancesV8 converts an array of catchpointFileEntryV8 into the normalizedAccountBalances of its
+// account and resource entries and the kv records of its kv entries. It also returns the merkle trie keys of all the
+// entries, in the order of the entries.
+// A resource entry becomes a partial balance of its account, as the account entry itself may be in a different chunk.
+func prepareNormalizedBalancesV8(entries []catchpointFileEntryV8, proto config.ConsensusParams) (normalizedAccountBalances []store.NormalizedAccountBalance, kvs []encoded.KVRecordV6, trieKeys [][]byte, err error) {
+	trieKeys = make([][]byte, len(entries))
+	for i := range entries {
+		entry := &entries[i]
+		switch {
+		case len(entry.AccountData) > 0:
+			var balance store.NormalizedAccountBalance
+			balance.Address = entry.Address
+			err = protocol.Decode(entry.AccountData, &balance.AccountData)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			balance.NormalizedBalance = basics.NormalizedOnlineAccountBalance(
+				balance.AccountData.Status,
+				balance.AccountData.RewardsBase,
+				balance.AccountData.MicroAlgos,
+				proto)
+			balance.EncodedAccountData = entry.AccountData
+			trieKeys[i] = store.AccountHashBuilderV6(entry.Address, &balance.AccountData, entry.AccountData)
+			balance.AccountHashes = [][]byte{trieKeys[i]}
+			normalizedAccountBalances = append(normalizedAccountBalances, balance)
+		case len(entry.ResourceData) > 0:
+			var resData store.ResourcesData
+			err = protocol.Decode(entry.ResourceData, &resData)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			trieKeys[i], err = store.ResourcesHashBuilderV6(&resData, entry.Address, entry.CreatableIndex, resData.UpdateRound, entry.ResourceData)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			normalizedAccountBalances = append(normalizedAccountBalances, store.NormalizedAccountBalance{
+				Address:          entry.Address,
+				Resources:        map[basics.CreatableIndex]store.ResourcesData{entry.CreatableIndex: resData},
+				EncodedResources: map[basics.CreatableIndex][]byte{entry.CreatableIndex: entry.ResourceData},
+				AccountHashes:    [][]byte{trieKeys[i]},
+				PartialBalance:   true,
+			})
+		default:
+			trieKeys[i] = store.KvHashBuilderV6(string(entry.Key), entry.Value)
+			kvs = append(kvs, encoded.KVRecordV6{Key: entry.Key, Value: entry.Value})
+		}
+	}
+	return
+}
+
 // makeCompactResourceDeltas takes an array of StateDeltas containing AccountDeltas ( one array entry per round ), and compacts the resource portions of the AccountDeltas into a single
 // data structure that contains all the resources deltas changes. While doing that, the function eliminate any intermediate resources changes.
 // It counts the number of changes each account get modified across the round range by specifying it in the nAcctDeltas field of the resourcesDeltas.
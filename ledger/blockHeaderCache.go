@@ -41,6 +41,17 @@ func (c *blockHeaderCache) initialize() {
 	c.lruCache.maxEntries = blockHeadersLRUCacheSize
 }
 
+// reset discards all the cached block headers.
+func (c *blockHeaderCache) reset() {
+	c.latestHeaderCache.mutex.Lock()
+	c.latestHeaderCache.blockHeaders = [latestHeaderCacheSize]bookkeeping.BlockHeader{}
+	c.latestHeaderCache.mutex.Unlock()
+
+	c.lruCache.lock.Lock()
+	c.lruCache.entries = lruHeap{}
+	c.lruCache.lock.Unlock()
+}
+
 func (c *blockHeaderCache) get(round basics.Round) (blockHeader bookkeeping.BlockHeader, exists bool) {
 	// check latestHeaderCache first
 	blockHeader, exists = c.latestHeaderCache.get(round)
@@ -46,6 +46,9 @@ type blockQueue struct {
 	lastCommitted basics.Round
 	q             []blockEntry
 
+	// earliest is the earliest round stored in the blocks database
+	earliest basics.Round
+
 	mu      deadlock.Mutex
 	cond    *sync.Cond
 	running bool
@@ -63,6 +66,10 @@ func bqInit(l *Ledger) (*blockQueue, error) {
 	err := bq.l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 		var err0 error
 		bq.lastCommitted, err0 = blockdb.BlockLatest(tx)
+		if err0 != nil {
+			return err0
+		}
+		bq.earliest, err0 = blockdb.BlockEarliest(tx)
 		return err0
 	})
 	ledgerBlockqInitMicros.AddMicrosecondsSince(start, nil)
@@ -146,8 +153,19 @@ func (bq *blockQueue) syncer() {
 			minToSave := bq.l.notifyCommit(committed)
 			bfstart := time.Now()
 			ledgerSyncBlockforgetCount.Inc(nil)
+			var earliest basics.Round
 			err = bq.l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-				return blockdb.BlockForgetBefore(tx, minToSave)
+				var err0 error
+				minToSave, err0 = bq.l.blockHistoryMinToSave(tx, committed, minToSave)
+				if err0 != nil {
+					return err0
+				}
+				err0 = blockdb.BlockForgetBefore(tx, minToSave)
+				if err0 != nil {
+					return err0
+				}
+				earliest, err0 = blockdb.BlockEarliest(tx)
+				return err0
 			})
 			ledgerSyncBlockforgetMicros.AddMicrosecondsSince(bfstart, nil)
 			if err != nil {
@@ -155,6 +173,9 @@ func (bq *blockQueue) syncer() {
 			}
 
 			bq.mu.Lock()
+			if err == nil {
+				bq.earliest = earliest
+			}
 		}
 	}
 }
@@ -180,6 +201,13 @@ func (bq *blockQueue) latestCommitted() (basics.Round, basics.Round) {
 	return bq.lastCommitted, bq.lastCommitted + basics.Round(len(bq.q))
 }
 
+// earliestRound returns the earliest round for which a block is available.
+func (bq *blockQueue) earliestRound() basics.Round {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.earliest
+}
+
 func (bq *blockQueue) putBlock(blk bookkeeping.Block, cert agreement.Certificate) error {
 	bq.mu.Lock()
 	defer bq.mu.Unlock()
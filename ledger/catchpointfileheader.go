@@ -36,4 +36,8 @@ type CatchpointFileHeader struct {
 	TotalKVs          uint64                   `codec:"kvsCount"`
 	Catchpoint        string                   `codec:"catchpoint"`
 	BlockHeaderDigest crypto.Digest            `codec:"blockHeaderDigest"`
+
+	// BalancesMerkleRoot is the root hash of the balances merkle trie, which the chunks of a CatchpointFileVersionV8
+	// file are proven against. It is bound to the catchpoint label along with the totals and the block header digest.
+	BalancesMerkleRoot crypto.Digest `codec:"balancesMerkleRoot"`
 }
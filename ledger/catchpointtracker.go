@@ -61,6 +61,9 @@ const (
 	// This version introduced accounts and resources separation. The first catchpoint
 	// round of this version is >= `reenableCatchpointsRound`.
 	CatchpointFileVersionV6 = uint64(0201)
+	// CatchpointFileVersionV8 is the catchpoint file version that orders the chunk entries by their merkle trie
+	// keys, and proves every chunk on its own against the balances merkle root of the catchpoint.
+	CatchpointFileVersionV8 = uint64(0203)
 )
 
 func catchpointStage1Encoder(w io.Writer) (io.WriteCloser, error) {
@@ -96,6 +99,9 @@ type catchpointTracker struct {
 	// enableGeneratingCatchpointFiles determines whether catchpoints files should be generated by the trackers.
 	enableGeneratingCatchpointFiles bool
 
+	// catchpointFileVersion is the version of the catchpoint data files generated by the tracker.
+	catchpointFileVersion uint64
+
 	// Prepared SQL statements for fast accounts DB lookups.
 	accountsq store.AccountsReader
 
@@ -185,6 +191,11 @@ func (ct *catchpointTracker) initialize(cfg config.Local, dbPathPrefix string) {
 	if cfg.CatchpointFileHistoryLength < -1 {
 		ct.catchpointFileHistoryLength = -1
 	}
+
+	ct.catchpointFileVersion = CatchpointFileVersionV6
+	if cfg.EnableCatchpointFileVersionV8 {
+		ct.catchpointFileVersion = CatchpointFileVersionV8
+	}
 }
 
 // GetLastCatchpointLabel retrieves the last catchpoint label that was stored to the database.
@@ -249,6 +260,10 @@ func (ct *catchpointTracker) finishFirstStageAfterCrash(dbRound basics.Round) er
 	if err != nil {
 		return err
 	}
+	err = removeCatchpointEntriesDB(filepath.Join(ct.dbDirectory, relCatchpointDataFilePath))
+	if err != nil {
+		return err
+	}
 
 	return ct.finishFirstStage(context.Background(), dbRound, 0)
 }
@@ -745,7 +760,7 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 		return err
 	}
 
-	// Make a catchpoint file.
+	// Make a catchpoint file, of the version the data file was written in.
 	header := CatchpointFileHeader{
 		Version:           CatchpointFileVersionV6,
 		BalancesRound:     accountsRound,
@@ -757,6 +772,10 @@ func (ct *catchpointTracker) createCatchpoint(ctx context.Context, accountsRound
 		Catchpoint:        label,
 		BlockHeaderDigest: blockHash,
 	}
+	if dataInfo.FileVersion == CatchpointFileVersionV8 {
+		header.Version = CatchpointFileVersionV8
+		header.BalancesMerkleRoot = dataInfo.TrieBalancesHash
+	}
 
 	relCatchpointFilePath :=
 		filepath.Join(store.CatchpointDirName, store.MakeCatchpointFilePath(round))
@@ -1091,7 +1110,7 @@ func (ct *catchpointTracker) generateCatchpointData(ctx context.Context, account
 	start := time.Now()
 	ledgerGeneratecatchpointCount.Inc(nil)
 	err = ct.dbs.Rdb.AtomicContext(ctx, func(dbCtx context.Context, tx *sql.Tx) (err error) {
-		catchpointWriter, err = makeCatchpointWriter(dbCtx, catchpointDataFilePath, tx, ResourcesPerCatchpointFileChunk)
+		catchpointWriter, err = makeCatchpointWriter(dbCtx, catchpointDataFilePath, tx, ResourcesPerCatchpointFileChunk, ct.catchpointFileVersion)
 		if err != nil {
 			return
 		}
@@ -1202,6 +1221,9 @@ func (ct *catchpointTracker) recordFirstStageInfo(ctx context.Context, tx *sql.T
 		BiggestChunkLen:  biggestChunkLen,
 		TrieBalancesHash: trieBalancesHash,
 	}
+	if ct.enableGeneratingCatchpointFiles && ct.catchpointFileVersion != CatchpointFileVersionV6 {
+		info.FileVersion = ct.catchpointFileVersion
+	}
 	return crw.InsertOrReplaceCatchpointFirstStageInfo(ctx, accountsRound, &info)
 }
 
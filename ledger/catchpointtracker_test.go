@@ -317,6 +317,59 @@ func TestRecordCatchpointFile(t *testing.T) {
 	require.Equalf(t, onlyCatchpointDirEmpty, true, "Directories: %v", emptyDirs)
 }
 
+// TestCatchpointFileVersion tests that the catchpoint files are only written in CatchpointFileVersionV8 when the
+// configuration enables it.
+func TestCatchpointFileVersion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, enableV8 := range []bool{false, true} {
+		enableV8 := enableV8
+		t.Run(fmt.Sprintf("v8=%v", enableV8), func(t *testing.T) {
+			temporaryDirectory := t.TempDir()
+
+			accts := []map[basics.Address]basics.AccountData{ledgertesting.RandomAccounts(20, true)}
+			ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion, accts)
+			defer ml.Close()
+
+			ct := &catchpointTracker{}
+			conf := config.GetDefaultLocal()
+			conf.Archival = true
+			conf.EnableCatchpointFileVersionV8 = enableV8
+			ct.initialize(conf, ".")
+			defer ct.close()
+			ct.dbDirectory = temporaryDirectory
+
+			_, err := trackerDBInitialize(ml, true, ct.dbDirectory)
+			require.NoError(t, err)
+			err = ct.loadFromDisk(ml, ml.Latest())
+			require.NoError(t, err)
+
+			round := basics.Round(2000000)
+			accountsRound := round - 1
+			require.NoError(t, ct.finishFirstStage(context.Background(), accountsRound, time.Second))
+			var info store.CatchpointFirstStageInfo
+			err = ct.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+				info, _, err = store.NewCatchpointSQLReaderWriter(tx).SelectCatchpointFirstStageInfo(ctx, accountsRound)
+				return
+			})
+			require.NoError(t, err)
+			require.NoError(t, ct.createCatchpoint(context.Background(), accountsRound, round, info, crypto.Digest{}))
+
+			names, sections := readCatchpointFileSections(t, filepath.Join(temporaryDirectory, store.CatchpointDirName, store.MakeCatchpointFilePath(round)))
+			require.Equal(t, "content.msgpack", names[0])
+			var header CatchpointFileHeader
+			require.NoError(t, protocol.Decode(sections[0], &header))
+			if enableV8 {
+				require.Equal(t, CatchpointFileVersionV8, header.Version)
+				require.Equal(t, info.TrieBalancesHash, header.BalancesMerkleRoot)
+			} else {
+				require.Equal(t, CatchpointFileVersionV6, header.Version)
+				require.Zero(t, header.BalancesMerkleRoot)
+			}
+		})
+	}
+}
+
 func BenchmarkLargeCatchpointDataWriting(b *testing.B) {
 	proto := config.Consensus[protocol.ConsensusCurrentVersion]
 
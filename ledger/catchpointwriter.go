@@ -25,9 +25,14 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/algorand/msgp/msgp"
+
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger/encoded"
 	"github.com/algorand/go-algorand/ledger/store"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
 )
 
 const (
@@ -39,6 +44,14 @@ const (
 	// 100,000 resources * 20KB/resource => roughly max 2GB per chunk if all of them are max'ed out apps.
 	// In reality most entries are asset holdings, and they are very small.
 	ResourcesPerCatchpointFileChunk = 100_000
+
+	// EntriesPerCatchpointFileChunkV8 defines the number of merkle trie entries (accounts, resources and kv pairs)
+	// that would be stored in each chunk of a CatchpointFileVersionV8 catchpoint file.
+	EntriesPerCatchpointFileChunkV8 = 16384
+
+	// catchpointEntriesDBSuffix is appended to the catchpoint data file path to name the temporary database
+	// which sorts the entries of a CatchpointFileVersionV8 file by their merkle trie keys.
+	catchpointEntriesDBSuffix = ".entries.sqlite"
 )
 
 // catchpointWriter is the struct managing the persistence of accounts data into the catchpoint file.
@@ -54,7 +67,9 @@ type catchpointWriter struct {
 	file                 *os.File
 	tar                  *tar.Writer
 	compressor           io.WriteCloser
+	version              uint64
 	chunk                catchpointFileChunkV6
+	chunkV8              catchpointFileChunkV8
 	chunkNum             uint64
 	writtenBytes         int64
 	biggestChunkLen      uint64
@@ -62,6 +77,21 @@ type catchpointWriter struct {
 	maxResourcesPerChunk int
 	accountsDone         bool
 	kvRows               kvIter
+
+	// entriesDB, entriesSorted, lastEntryKey and balancesTrie are used by the CatchpointFileVersionV8 writer,
+	// which first sorts all the entries by their merkle trie keys, and then writes them in chunks along with
+	// the range proof of each chunk.
+	entriesDB       *db.Accessor
+	entriesSorted   bool
+	entriesPerChunk int
+	lastEntryKey    []byte
+	balancesTrie    *merkletrie.Trie
+}
+
+// catchpointFileChunk is a single chunk of a catchpoint data file.
+type catchpointFileChunk interface {
+	msgp.Marshaler
+	empty() bool
 }
 
 type kvIter interface {
@@ -92,7 +122,66 @@ func (chunk catchpointFileChunkV6) empty() bool {
 	return len(chunk.Balances) == 0 && len(chunk.KVs) == 0
 }
 
-func makeCatchpointWriter(ctx context.Context, filePath string, tx *sql.Tx, maxResourcesPerChunk int) (*catchpointWriter, error) {
+// catchpointFileEntryV8 is a single entry of the balances merkle trie: an account, a single resource of an
+// account, or a kv pair.
+type catchpointFileEntryV8 struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Address        basics.Address        `codec:"a,allocbound=crypto.DigestSize"`
+	AccountData    msgp.Raw              `codec:"b"` // encoding of baseAccountData, set for an account entry
+	CreatableIndex basics.CreatableIndex `codec:"c"`
+	ResourceData   msgp.Raw              `codec:"r"` // encoding of resourcesData, set for a resource entry
+	Key            []byte                `codec:"k,allocbound=encoded.KVRecordV6MaxKeyLength"`
+	Value          []byte                `codec:"v,allocbound=encoded.KVRecordV6MaxValueLength"`
+}
+
+// catchpointFileChunkV8 holds consecutive entries of the balances merkle trie, sorted by their trie keys, and the
+// proof that these are all the trie entries between the first and the last of them.
+type catchpointFileChunkV8 struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Entries []catchpointFileEntryV8 `codec:"en,allocbound=EntriesPerCatchpointFileChunkV8"`
+	Proof   merkletrie.RangeProof   `codec:"pf"`
+}
+
+func (chunk catchpointFileChunkV8) empty() bool {
+	return len(chunk.Entries) == 0
+}
+
+// trieKey returns the key of the entry in the balances merkle trie.
+func (e *catchpointFileEntryV8) trieKey() ([]byte, error) {
+	switch {
+	case len(e.AccountData) > 0:
+		var accountData store.BaseAccountData
+		err := protocol.Decode(e.AccountData, &accountData)
+		if err != nil {
+			return nil, err
+		}
+		return store.AccountHashBuilderV6(e.Address, &accountData, e.AccountData), nil
+	case len(e.ResourceData) > 0:
+		var resourceData store.ResourcesData
+		err := protocol.Decode(e.ResourceData, &resourceData)
+		if err != nil {
+			return nil, err
+		}
+		return store.ResourcesHashBuilderV6(&resourceData, e.Address, e.CreatableIndex, resourceData.UpdateRound, e.ResourceData)
+	default:
+		return store.KvHashBuilderV6(string(e.Key), e.Value), nil
+	}
+}
+
+// removeCatchpointEntriesDB removes the temporary entries database of the given catchpoint data file, if there is one.
+func removeCatchpointEntriesDB(dataFilePath string) error {
+	for _, suffix := range []string{"", "-shm", "-wal"} {
+		err := os.Remove(dataFilePath + catchpointEntriesDBSuffix + suffix)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func makeCatchpointWriter(ctx context.Context, filePath string, tx *sql.Tx, maxResourcesPerChunk int, version uint64) (*catchpointWriter, error) {
 	arw := store.NewAccountsSQLReaderWriter(tx)
 
 	totalAccounts, err := arw.TotalAccounts(ctx)
@@ -130,15 +219,64 @@ func makeCatchpointWriter(ctx context.Context, filePath string, tx *sql.Tx, maxR
 		tar:                  tar,
 		accountsIterator:     store.MakeEncodedAccoutsBatchIter(),
 		maxResourcesPerChunk: maxResourcesPerChunk,
+		version:              version,
+		entriesPerChunk:      EntriesPerCatchpointFileChunkV8,
+	}
+	if version == CatchpointFileVersionV8 {
+		err = res.openEntriesDB(ctx)
+		if err != nil {
+			res.Abort()
+			return nil, err
+		}
 	}
 	return res, nil
 }
 
+// openEntriesDB creates the temporary database the entries of a CatchpointFileVersionV8 file are sorted in.
+func (cw *catchpointWriter) openEntriesDB(ctx context.Context) error {
+	err := removeCatchpointEntriesDB(cw.filePath)
+	if err != nil {
+		return err
+	}
+	entriesDB, err := db.MakeAccessor(cw.filePath+catchpointEntriesDBSuffix, false, false)
+	if err != nil {
+		return err
+	}
+	cw.entriesDB = &entriesDB
+	// an empty key, unlike a nil one, compares as lower than any other key.
+	cw.lastEntryKey = []byte{}
+	// the database is discarded if the writing is interrupted, so there is no point in syncing it.
+	err = cw.entriesDB.SetSynchronousMode(ctx, db.SynchronousModeOff, false)
+	if err != nil {
+		return err
+	}
+	return cw.entriesDB.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "CREATE TABLE entries (key BLOB PRIMARY KEY, data BLOB NOT NULL) WITHOUT ROWID")
+		return err
+	})
+}
+
+// closeEntriesDB closes and removes the temporary entries database, if there is one.
+func (cw *catchpointWriter) closeEntriesDB() error {
+	if cw.entriesDB == nil {
+		return nil
+	}
+	if cw.entriesDB.Handle != nil {
+		cw.entriesDB.Close()
+	}
+	cw.entriesDB = nil
+	return removeCatchpointEntriesDB(cw.filePath)
+}
+
 func (cw *catchpointWriter) Abort() error {
 	cw.accountsIterator.Close()
 	cw.tar.Close()
 	cw.compressor.Close()
 	cw.file.Close()
+	err := cw.closeEntriesDB()
+	if err != nil {
+		return err
+	}
 	return os.Remove(cw.filePath)
 }
 
@@ -157,7 +295,7 @@ func (cw *catchpointWriter) WriteStep(stepCtx context.Context) (more bool, err e
 		return
 	}
 
-	writerRequest := make(chan catchpointFileChunkV6, 1)
+	writerRequest := make(chan catchpointFileChunk, 1)
 	writerResponse := make(chan error, 2)
 	go cw.asyncWriter(writerRequest, writerResponse, cw.chunkNum)
 	defer func() {
@@ -194,6 +332,10 @@ func (cw *catchpointWriter) WriteStep(stepCtx context.Context) (more bool, err e
 				cw.kvRows.Close()
 				cw.kvRows = nil
 			}
+			closeErr := cw.closeEntriesDB()
+			if err == nil {
+				err = closeErr
+			}
 		}
 	}()
 
@@ -203,13 +345,17 @@ func (cw *catchpointWriter) WriteStep(stepCtx context.Context) (more bool, err e
 			return
 		}
 
-		if cw.chunk.empty() {
+		if cw.chunkEmpty() {
 			err = cw.readDatabaseStep(cw.ctx, cw.tx)
 			if err != nil {
 				return
 			}
-			// readDatabaseStep yielded nothing, we're done
-			if cw.chunk.empty() {
+			if cw.chunkEmpty() {
+				if cw.version == CatchpointFileVersionV8 && !cw.entriesSorted {
+					// the entries are still being sorted, there is nothing to write yet.
+					continue
+				}
+				// readDatabaseStep yielded nothing, we're done
 				return false, nil
 			}
 		}
@@ -228,22 +374,40 @@ func (cw *catchpointWriter) WriteStep(stepCtx context.Context) (more bool, err e
 		default:
 		}
 
-		// send the chunk to the asyncWriter channel
+		// send the chunk to the asyncWriter channel, and indicate that we need a readDatabaseStep
 		cw.chunkNum++
-		writerRequest <- cw.chunk
-		// indicate that we need a readDatabaseStep
-		cw.chunk = catchpointFileChunkV6{}
+		writerRequest <- cw.takeChunk()
+	}
+}
+
+// chunkEmpty returns true if there is no chunk pending to be written.
+func (cw *catchpointWriter) chunkEmpty() bool {
+	if cw.version == CatchpointFileVersionV8 {
+		return cw.chunkV8.empty()
 	}
+	return cw.chunk.empty()
 }
 
-func (cw *catchpointWriter) asyncWriter(chunks chan catchpointFileChunkV6, response chan error, chunkNum uint64) {
+// takeChunk returns the chunk pending to be written, and clears it.
+func (cw *catchpointWriter) takeChunk() catchpointFileChunk {
+	if cw.version == CatchpointFileVersionV8 {
+		chunk := cw.chunkV8
+		cw.chunkV8 = catchpointFileChunkV8{}
+		return &chunk
+	}
+	chunk := cw.chunk
+	cw.chunk = catchpointFileChunkV6{}
+	return &chunk
+}
+
+func (cw *catchpointWriter) asyncWriter(chunks chan catchpointFileChunk, response chan error, chunkNum uint64) {
 	defer close(response)
 	for chk := range chunks {
 		chunkNum++
 		if chk.empty() {
 			break
 		}
-		encodedChunk := protocol.Encode(&chk)
+		encodedChunk := protocol.Encode(chk)
 		err := cw.tar.WriteHeader(&tar.Header{
 			Name: fmt.Sprintf("balances.%d.msgpack", chunkNum),
 			Mode: 0600,
@@ -269,6 +433,16 @@ func (cw *catchpointWriter) asyncWriter(chunks chan catchpointFileChunkV6, respo
 // are evenly divisible by BalancesPerCatchpointFileChunk, it must not return an
 // empty chunk between accounts and kvs.
 func (cw *catchpointWriter) readDatabaseStep(ctx context.Context, tx *sql.Tx) error {
+	if cw.version == CatchpointFileVersionV8 {
+		if !cw.entriesSorted {
+			err := cw.sortEntriesStep(ctx, tx)
+			if err != nil || !cw.entriesSorted {
+				return err
+			}
+		}
+		return cw.readSortedEntriesStep(ctx, tx)
+	}
+
 	if !cw.accountsDone {
 		balances, numAccounts, err := cw.accountsIterator.Next(ctx, tx, BalancesPerCatchpointFileChunk, cw.maxResourcesPerChunk)
 		if err != nil {
@@ -308,6 +482,127 @@ func (cw *catchpointWriter) readDatabaseStep(ctx context.Context, tx *sql.Tx) er
 	return nil
 }
 
+// sortEntriesStep reads the next batch of accounts, or kvs once the accounts are done, and stores their merkle trie
+// entries in the entries database, keyed by their trie keys. It sets entriesSorted once all the entries are stored.
+func (cw *catchpointWriter) sortEntriesStep(ctx context.Context, tx *sql.Tx) error {
+	var entries []catchpointFileEntryV8
+	if !cw.accountsDone {
+		balances, _, err := cw.accountsIterator.Next(ctx, tx, BalancesPerCatchpointFileChunk, cw.maxResourcesPerChunk)
+		if err != nil {
+			return err
+		}
+		if len(balances) == 0 {
+			cw.accountsDone = true
+		}
+		for _, balance := range balances {
+			// an account with many resources may span multiple records; the account entry is taken from the last one.
+			if !balance.ExpectingMoreEntries {
+				entries = append(entries, catchpointFileEntryV8{Address: balance.Address, AccountData: balance.AccountData})
+			}
+			for cidx, resourceData := range balance.Resources {
+				entries = append(entries, catchpointFileEntryV8{Address: balance.Address, CreatableIndex: basics.CreatableIndex(cidx), ResourceData: resourceData})
+			}
+		}
+	} else {
+		if cw.kvRows == nil {
+			rows, err := store.MakeKVsIter(ctx, tx)
+			if err != nil {
+				return err
+			}
+			cw.kvRows = rows
+		}
+		for len(entries) < BalancesPerCatchpointFileChunk && cw.kvRows.Next() {
+			k, v, err := cw.kvRows.KeyValue()
+			if err != nil {
+				return err
+			}
+			entries = append(entries, catchpointFileEntryV8{Key: k, Value: v})
+		}
+		if len(entries) == 0 {
+			cw.entriesSorted = true
+			return nil
+		}
+	}
+
+	return cw.entriesDB.AtomicContext(ctx, func(ctx context.Context, entriesTx *sql.Tx) error {
+		insertStmt, err := entriesTx.PrepareContext(ctx, "INSERT INTO entries(key, data) VALUES(?, ?)")
+		if err != nil {
+			return err
+		}
+		defer insertStmt.Close()
+		for i := range entries {
+			key, err := entries[i].trieKey()
+			if err != nil {
+				return err
+			}
+			_, err = insertStmt.ExecContext(ctx, key, protocol.Encode(&entries[i]))
+			if err != nil {
+				return fmt.Errorf("unable to store the catchpoint entry with the trie key %x : %w", key, err)
+			}
+		}
+		return nil
+	})
+}
+
+// readSortedEntriesStep places the next entries of the entries database, in the order of their merkle trie keys, into
+// cw.chunkV8 along with their range proof.
+func (cw *catchpointWriter) readSortedEntriesStep(ctx context.Context, tx *sql.Tx) error {
+	var chunk catchpointFileChunkV8
+	var firstKey, lastKey []byte
+	err := cw.entriesDB.AtomicContext(ctx, func(ctx context.Context, entriesTx *sql.Tx) error {
+		rows, err := entriesTx.QueryContext(ctx, "SELECT key, data FROM entries WHERE key > ? ORDER BY key LIMIT ?", cw.lastEntryKey, cw.entriesPerChunk)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var key, data []byte
+			err = rows.Scan(&key, &data)
+			if err != nil {
+				return err
+			}
+			var entry catchpointFileEntryV8
+			err = protocol.Decode(data, &entry)
+			if err != nil {
+				return err
+			}
+			if firstKey == nil {
+				firstKey = key
+			}
+			lastKey = key
+			chunk.Entries = append(chunk.Entries, entry)
+		}
+		return rows.Err()
+	})
+	if err != nil || len(chunk.Entries) == 0 {
+		return err
+	}
+
+	if cw.balancesTrie == nil {
+		mc, err := store.MakeMerkleCommitter(tx, false)
+		if err != nil {
+			return err
+		}
+		cw.balancesTrie, err = merkletrie.MakeTrie(mc, store.TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+	}
+	chunk.Proof, err = cw.balancesTrie.RangeProof(firstKey, lastKey)
+	if err != nil {
+		return fmt.Errorf("unable to prove the catchpoint entries range %x - %x : %w", firstKey, lastKey, err)
+	}
+	// the trie is read only here, so evicting its pages never requires committing them.
+	_, err = cw.balancesTrie.Evict(false)
+	if err != nil {
+		return err
+	}
+
+	cw.lastEntryKey = lastKey
+	cw.chunkV8 = chunk
+	return nil
+}
+
 // hasContextDeadlineExceeded examine the given context and see if it was canceled or timed-out.
 // if it has timed out, the function returns contextExceeded=true and contextError = nil.
 // if it's a non-timeout error, the functions returns contextExceeded=false and contextError = error.
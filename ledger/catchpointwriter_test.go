@@ -131,7 +131,7 @@ func TestBasicCatchpointWriter(t *testing.T) {
 
 	readDb := ml.trackerDB().Rdb
 	err = readDb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		writer, err := makeCatchpointWriter(context.Background(), fileName, tx, ResourcesPerCatchpointFileChunk)
+		writer, err := makeCatchpointWriter(context.Background(), fileName, tx, ResourcesPerCatchpointFileChunk, CatchpointFileVersionV6)
 		if err != nil {
 			return err
 		}
@@ -185,23 +185,43 @@ func TestBasicCatchpointWriter(t *testing.T) {
 	require.Equal(t, io.EOF, err)
 }
 
-func testWriteCatchpoint(t *testing.T, rdb db.Accessor, datapath string, filepath string, maxResourcesPerChunk int) CatchpointFileHeader {
+func testWriteCatchpoint(t *testing.T, rdb db.Accessor, datapath string, filepath string, maxResourcesPerChunk int, version uint64) CatchpointFileHeader {
+	return testWriteCatchpointEntries(t, rdb, datapath, filepath, maxResourcesPerChunk, version, EntriesPerCatchpointFileChunkV8)
+}
+
+func testWriteCatchpointEntries(t *testing.T, rdb db.Accessor, datapath string, filepath string, maxResourcesPerChunk int, version uint64, entriesPerChunk int) CatchpointFileHeader {
 	var totalAccounts uint64
 	var totalChunks uint64
 	var biggestChunkLen uint64
 	var accountsRnd basics.Round
 	var totals ledgercore.AccountTotals
+	var balancesRoot crypto.Digest
 	if maxResourcesPerChunk <= 0 {
 		maxResourcesPerChunk = ResourcesPerCatchpointFileChunk
 	}
 
 	err := rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
-		writer, err := makeCatchpointWriter(context.Background(), datapath, tx, maxResourcesPerChunk)
+		writer, err := makeCatchpointWriter(context.Background(), datapath, tx, maxResourcesPerChunk, version)
 		arw := store.NewAccountsSQLReaderWriter(tx)
 
 		if err != nil {
 			return err
 		}
+		writer.entriesPerChunk = entriesPerChunk
+		if version == CatchpointFileVersionV8 {
+			committer, err := store.MakeMerkleCommitter(tx, false)
+			if err != nil {
+				return err
+			}
+			trie, err := merkletrie.MakeTrie(committer, store.TrieMemoryConfig)
+			if err != nil {
+				return err
+			}
+			balancesRoot, err = trie.RootHash()
+			if err != nil {
+				return err
+			}
+		}
 		for {
 			more, err := writer.WriteStep(context.Background())
 			require.NoError(t, err)
@@ -224,14 +244,15 @@ func testWriteCatchpoint(t *testing.T, rdb db.Accessor, datapath string, filepat
 	blockHeaderDigest := crypto.Hash([]byte{1, 2, 3})
 	catchpointLabel := fmt.Sprintf("%d#%v", blocksRound, blockHeaderDigest) // this is not a correct way to create a label, but it's good enough for this unit test
 	catchpointFileHeader := CatchpointFileHeader{
-		Version:           CatchpointFileVersionV6,
-		BalancesRound:     accountsRnd,
-		BlocksRound:       blocksRound,
-		Totals:            totals,
-		TotalAccounts:     totalAccounts,
-		TotalChunks:       totalChunks,
-		Catchpoint:        catchpointLabel,
-		BlockHeaderDigest: blockHeaderDigest,
+		Version:            version,
+		BalancesRound:      accountsRnd,
+		BlocksRound:        blocksRound,
+		Totals:             totals,
+		TotalAccounts:      totalAccounts,
+		TotalChunks:        totalChunks,
+		Catchpoint:         catchpointLabel,
+		BlockHeaderDigest:  blockHeaderDigest,
+		BalancesMerkleRoot: balancesRoot,
 	}
 	err = repackCatchpoint(
 		context.Background(), catchpointFileHeader, biggestChunkLen,
@@ -293,7 +314,7 @@ func TestCatchpointReadDatabaseOverflowSingleAccount(t *testing.T) {
 		totalResources := 0
 		totalChunks := 0
 		var expectedTotalResources int
-		cw, err := makeCatchpointWriter(context.Background(), catchpointDataFilePath, tx, maxResourcesPerChunk)
+		cw, err := makeCatchpointWriter(context.Background(), catchpointDataFilePath, tx, maxResourcesPerChunk, CatchpointFileVersionV6)
 		err = cw.tx.QueryRowContext(cw.ctx, "SELECT count(1) FROM resources").Scan(&expectedTotalResources)
 		if err != nil {
 			return err
@@ -383,7 +404,7 @@ func TestCatchpointReadDatabaseOverflowAccounts(t *testing.T) {
 		totalAccountsWritten := uint64(0)
 		totalResources := 0
 		var expectedTotalResources int
-		cw, err := makeCatchpointWriter(context.Background(), catchpointDataFilePath, tx, maxResourcesPerChunk)
+		cw, err := makeCatchpointWriter(context.Background(), catchpointDataFilePath, tx, maxResourcesPerChunk, CatchpointFileVersionV6)
 		require.NoError(t, err)
 		err = cw.tx.QueryRowContext(cw.ctx, "SELECT count(1) FROM resources").Scan(&expectedTotalResources)
 		if err != nil {
@@ -444,7 +465,7 @@ func TestFullCatchpointWriterOverflowAccounts(t *testing.T) {
 	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
 	catchpointFilePath := filepath.Join(temporaryDirectory, "15.catchpoint")
 	const maxResourcesPerChunk = 5
-	testWriteCatchpoint(t, ml.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, maxResourcesPerChunk)
+	testWriteCatchpoint(t, ml.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, maxResourcesPerChunk, CatchpointFileVersionV6)
 
 	l := testNewLedgerFromCatchpoint(t, ml.trackerDB().Rdb, catchpointFilePath)
 	defer l.Close()
@@ -566,6 +587,15 @@ func testNewLedgerFromCatchpoint(t *testing.T, catchpointWriterReadAccess db.Acc
 				break
 			}
 		}
+		if header.Name == "content.msgpack" {
+			// the header of a CatchpointFileVersionV8 file is verified against the catchpoint label
+			var fileHeader CatchpointFileHeader
+			require.NoError(t, protocol.Decode(balancesBlockBytes, &fileHeader))
+			if fileHeader.Version == CatchpointFileVersionV8 {
+				label := ledgercore.MakeCatchpointLabel(fileHeader.BlocksRound, fileHeader.BlockHeaderDigest, fileHeader.BalancesMerkleRoot, fileHeader.Totals)
+				require.NoError(t, accessor.SetLabel(context.Background(), label.String()))
+			}
+		}
 		err = accessor.ProcessStagingBalances(context.Background(), header.Name, balancesBlockBytes, &catchupProgress)
 		require.NoError(t, err)
 	}
@@ -640,7 +670,7 @@ func TestFullCatchpointWriter(t *testing.T) {
 
 	catchpointDataFilePath := filepath.Join(temporaryDirectory, "15.data")
 	catchpointFilePath := filepath.Join(temporaryDirectory, "15.catchpoint")
-	testWriteCatchpoint(t, ml.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	testWriteCatchpoint(t, ml.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0, CatchpointFileVersionV6)
 
 	l := testNewLedgerFromCatchpoint(t, ml.trackerDB().Rdb, catchpointFilePath)
 	defer l.Close()
@@ -688,7 +718,7 @@ func TestExactAccountChunk(t *testing.T) {
 	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
 	catchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
 
-	cph := testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph := testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0, CatchpointFileVersionV6)
 	require.EqualValues(t, cph.TotalChunks, 1)
 
 	l := testNewLedgerFromCatchpoint(t, dl.generator.trackerDB().Rdb, catchpointFilePath)
@@ -739,7 +769,7 @@ func TestCatchpointAfterTxns(t *testing.T) {
 	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
 	catchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
 
-	cph := testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph := testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0, CatchpointFileVersionV6)
 	require.EqualValues(t, 2, cph.TotalChunks)
 
 	l := testNewLedgerFromCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointFilePath)
@@ -755,7 +785,7 @@ func TestCatchpointAfterTxns(t *testing.T) {
 	dl.fullBlock(&newacctpay)
 
 	// Write and read back in, and ensure even the last effect exists.
-	cph = testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph = testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0, CatchpointFileVersionV6)
 	require.EqualValues(t, cph.TotalChunks, 2) // Still only 2 chunks, as last was in a recent block
 
 	// Drive home the point that `last` is _not_ included in the catchpoint by inspecting balance read from catchpoint.
@@ -771,7 +801,7 @@ func TestCatchpointAfterTxns(t *testing.T) {
 		dl.fullBlock(pay.Noted(strconv.Itoa(i)))
 	}
 
-	cph = testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph = testWriteCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0, CatchpointFileVersionV6)
 	require.EqualValues(t, cph.TotalChunks, 3)
 
 	l = testNewLedgerFromCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointFilePath)
@@ -860,7 +890,7 @@ func TestCatchpointAfterBoxTxns(t *testing.T) {
 	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
 	catchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
 
-	cph := testWriteCatchpoint(t, dl.generator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0)
+	cph := testWriteCatchpoint(t, dl.generator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0, CatchpointFileVersionV6)
 	require.EqualValues(t, 2, cph.TotalChunks)
 
 	l := testNewLedgerFromCatchpoint(t, dl.generator.trackerDB().Rdb, catchpointFilePath)
@@ -873,3 +903,221 @@ func TestCatchpointAfterBoxTxns(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, strings.Repeat("f", 24), string(v))
 }
+
+// readCatchpointFileSections returns the names and the contents of the sections of a catchpoint file.
+func readCatchpointFileSections(t *testing.T, filepath string) (names []string, sections [][]byte) {
+	fileContent, err := os.ReadFile(filepath)
+	require.NoError(t, err)
+	gzipReader, err := gzip.NewReader(bytes.NewBuffer(fileContent))
+	require.NoError(t, err)
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		section, err := io.ReadAll(tarReader)
+		require.NoError(t, err)
+		names = append(names, header.Name)
+		sections = append(sections, section)
+	}
+	return
+}
+
+// makeCatchpointV8TestLedger creates a ledger with accounts, an asset, an application and a box, and advances it so
+// that a catchpoint would include all of them.
+func makeCatchpointV8TestLedger(t *testing.T) (dl DoubleLedger, accounts []basics.Address, assetIndex basics.AssetIndex, boxApp basics.AppIndex) {
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	dl = NewDoubleLedger(t, genBalances, protocol.ConsensusFuture)
+
+	boxApp = dl.fundedApp(addrs[1], 1_000_000, boxAppSource)
+	makeBox := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[2],
+		ApplicationID: boxApp,
+	}
+	makeBox = *makeBox.Args("create", "xxx")
+	makeBox.Boxes = []transactions.BoxRef{{Index: 0, Name: []byte("xxx")}}
+	dl.fullBlock(&makeBox)
+
+	asa := txntest.Txn{
+		Type:   "acfg",
+		Sender: addrs[0],
+		AssetParams: basics.AssetParams{
+			Total:     1000000,
+			UnitName:  "oz",
+			AssetName: "Gold",
+		},
+	}
+	vb := dl.fullBlock(&asa)
+	assetIndex = vb.Block().Payset[0].ApplyData.ConfigAsset
+	optin := txntest.Txn{
+		Type:          "axfer",
+		Sender:        addrs[3],
+		XferAsset:     assetIndex,
+		AssetReceiver: addrs[3],
+	}
+	dl.fullBlock(&optin)
+
+	pay := txntest.Txn{
+		Type:   "pay",
+		Sender: addrs[0],
+		Amount: 1_000_000,
+	}
+	for i := 0; i < 100; i++ {
+		newacctpay := pay
+		newacctpay.Receiver = ledgertesting.RandomAddress()
+		accounts = append(accounts, newacctpay.Receiver)
+		dl.fullBlock(&newacctpay)
+	}
+	// advance so that the catchpoint includes all of the above
+	for i := 0; i < 40; i++ {
+		selfpay := pay
+		selfpay.Receiver = addrs[0]
+		selfpay.Note = ledgertesting.RandomNote()
+		dl.fullBlock(&selfpay)
+	}
+	return dl, accounts, assetIndex, boxApp
+}
+
+func TestCatchpointV8Writer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dl, accounts, assetIndex, boxApp := makeCatchpointV8TestLedger(t)
+	defer dl.Close()
+
+	tempDir := t.TempDir()
+	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
+	catchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
+
+	const entriesPerChunk = 16
+	cph := testWriteCatchpointEntries(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0, CatchpointFileVersionV8, entriesPerChunk)
+	require.NotZero(t, cph.BalancesMerkleRoot)
+	// the temporary entries database is removed once the data file is written
+	_, err := os.Stat(catchpointDataFilePath + catchpointEntriesDBSuffix)
+	require.True(t, os.IsNotExist(err))
+
+	// every chunk is full but the last one, and its entries are sorted by their trie keys
+	names, sections := readCatchpointFileSections(t, catchpointFilePath)
+	require.Equal(t, "content.msgpack", names[0])
+	require.EqualValues(t, len(names)-1, cph.TotalChunks)
+	var lastKey []byte
+	for i := 1; i < len(sections); i++ {
+		var chunk catchpointFileChunkV8
+		require.NoError(t, protocol.Decode(sections[i], &chunk))
+		if i < len(sections)-1 {
+			require.Len(t, chunk.Entries, entriesPerChunk)
+		}
+		for _, entry := range chunk.Entries {
+			key, err := entry.trieKey()
+			require.NoError(t, err)
+			require.Negative(t, bytes.Compare(lastKey, key))
+			lastKey = key
+		}
+	}
+
+	l := testNewLedgerFromCatchpoint(t, dl.validator.trackerDB().Rdb, catchpointFilePath)
+	defer l.Close()
+
+	for _, addr := range accounts {
+		ad, _, err := l.LookupWithoutRewards(0, addr)
+		require.NoError(t, err)
+		require.Equal(t, basics.MicroAlgos{Raw: 1_000_000}, ad.MicroAlgos)
+	}
+	creator, ok, err := l.GetCreator(basics.CreatableIndex(assetIndex), basics.AssetCreatable)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotZero(t, creator)
+	v, err := l.LookupKv(l.Latest(), logic.MakeBoxKey(boxApp, "xxx"))
+	require.NoError(t, err)
+	require.Equal(t, strings.Repeat("\x00", 24), string(v))
+}
+
+func TestCatchpointV8ChunkVerification(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dl, _, _, _ := makeCatchpointV8TestLedger(t)
+	defer dl.Close()
+
+	tempDir := t.TempDir()
+	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
+	catchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
+	cph := testWriteCatchpointEntries(t, dl.validator.trackerDB().Rdb, catchpointDataFilePath, catchpointFilePath, 0, CatchpointFileVersionV8, 16)
+	names, sections := readCatchpointFileSections(t, catchpointFilePath)
+	require.Greater(t, len(sections), 3)
+
+	var initState ledgercore.InitState
+	initState.Block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	l, err := OpenLedger(logging.TestingLog(t), t.Name()+"FromCatchpoint", true, initState, config.GetDefaultLocal())
+	require.NoError(t, err)
+	defer l.Close()
+	accessor := MakeCatchpointCatchupAccessor(l, l.log)
+	ctx := context.Background()
+	require.NoError(t, accessor.ResetStagingBalances(ctx, true))
+
+	// a header which can't be verified without a catchpoint label is rejected
+	var progress CatchpointCatchupAccessorProgress
+	err = accessor.ProcessStagingBalances(ctx, names[0], sections[0], &progress)
+	require.ErrorContains(t, err, "without a catchpoint label")
+
+	// and so is a header which does not match the catchpoint label
+	wrongLabel := ledgercore.MakeCatchpointLabel(cph.BlocksRound, cph.BlockHeaderDigest, crypto.Hash([]byte("wrong root")), cph.Totals)
+	require.NoError(t, accessor.SetLabel(ctx, wrongLabel.String()))
+	err = accessor.ProcessStagingBalances(ctx, names[0], sections[0], &progress)
+	require.ErrorContains(t, err, "does not match the catchpoint label")
+
+	label := ledgercore.MakeCatchpointLabel(cph.BlocksRound, cph.BlockHeaderDigest, cph.BalancesMerkleRoot, cph.Totals)
+	require.NoError(t, accessor.SetLabel(ctx, label.String()))
+	require.NoError(t, accessor.ProcessStagingBalances(ctx, names[0], sections[0], &progress))
+
+	for i := 1; i < len(sections); i++ {
+		if i == 2 {
+			var chunk catchpointFileChunkV8
+			require.NoError(t, protocol.Decode(sections[i], &chunk))
+
+			// an omitted entry fails the chunk verification
+			omitted := chunk
+			omitted.Entries = append(append([]catchpointFileEntryV8{}, chunk.Entries[:3]...), chunk.Entries[4:]...)
+			err = accessor.ProcessStagingBalances(ctx, names[i], protocol.Encode(&omitted), &progress)
+			require.ErrorIs(t, err, ErrCatchpointChunkProof)
+
+			// so does a modified entry
+			modified := chunk
+			modified.Entries = append([]catchpointFileEntryV8{}, chunk.Entries...)
+			if len(modified.Entries[5].Value) > 0 {
+				modified.Entries[5].Value = append([]byte{}, modified.Entries[5].Value...)
+				modified.Entries[5].Value[0]++
+			} else {
+				modified.Entries[5].Address[0]++
+			}
+			err = accessor.ProcessStagingBalances(ctx, names[i], protocol.Encode(&modified), &progress)
+			require.ErrorIs(t, err, ErrCatchpointChunkProof)
+		}
+		// the original chunk is accepted, even after the bad ones were rejected
+		require.NoError(t, accessor.ProcessStagingBalances(ctx, names[i], sections[i], &progress))
+	}
+	require.Equal(t, cph.TotalAccounts, progress.ProcessedAccounts)
+
+	require.NoError(t, accessor.BuildMerkleTrie(ctx, nil))
+	err = l.trackerDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		committer, err := store.MakeMerkleCommitter(tx, true)
+		if err != nil {
+			return err
+		}
+		trie, err := merkletrie.MakeTrie(committer, store.TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+		root, err := trie.RootHash()
+		if err != nil {
+			return err
+		}
+		require.Equal(t, cph.BalancesMerkleRoot, root)
+		return nil
+	})
+	require.NoError(t, err)
+}
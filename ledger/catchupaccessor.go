@@ -149,6 +149,10 @@ func (w *stagingWriterImpl) isShared() bool {
 	return w.wdb.IsSharedCacheConnection()
 }
 
+// ErrCatchpointChunkProof is returned when a chunk of a catchpoint file fails the verification of its merkle proof.
+// The chunk is not written in that case, so it could be fetched again from a different source.
+var ErrCatchpointChunkProof = errors.New("catchpoint file chunk does not match its merkle proof")
+
 // catchpointCatchupAccessorImpl is the concrete implementation of the CatchpointCatchupAccessor interface
 type catchpointCatchupAccessorImpl struct {
 	ledger          *Ledger
@@ -314,6 +318,9 @@ type CatchpointCatchupAccessorProgress struct {
 	// While rebuilding the trie, we don't want to force and reload (some) of these nodes into the cache for each catchpoint file chunk.
 	cachedTrie *merkletrie.Trie
 
+	// balancesRoot is the balances merkle root the chunks of a CatchpointFileVersionV8 file are verified against.
+	balancesRoot crypto.Digest
+
 	BalancesWriteDuration   time.Duration
 	CreatablesWriteDuration time.Duration
 	HashesWriteDuration     time.Duration
@@ -346,6 +353,27 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 	switch fileHeader.Version {
 	case CatchpointFileVersionV5:
 	case CatchpointFileVersionV6:
+	case CatchpointFileVersionV8:
+		// the chunks are verified against the balances merkle root of the header, so make sure it's the one
+		// the catchpoint label commits to.
+		var label string
+		label, err = c.catchpointStore.ReadCatchpointStateString(ctx, store.CatchpointStateCatchupLabel)
+		if err != nil {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to read catchpoint catchup state '%s': %v", store.CatchpointStateCatchupLabel, err)
+		}
+		// without a label, there is nothing the merkle root could be checked against.
+		if label == "" {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to verify the catchpoint file header without a catchpoint label")
+		}
+		var labelHash crypto.Digest
+		_, labelHash, err = ledgercore.ParseCatchpointLabel(label)
+		if err != nil {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to parse catchpoint label '%s': %v", label, err)
+		}
+		headerHash := ledgercore.MakeCatchpointLabel(fileHeader.BlocksRound, fileHeader.BlockHeaderDigest, fileHeader.BalancesMerkleRoot, fileHeader.Totals).Hash()
+		if headerHash != labelHash {
+			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: the catchpoint file header does not match the catchpoint label '%s'", label)
+		}
 	default:
 		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to process catchpoint - version %d is not supported", fileHeader.Version)
 	}
@@ -364,7 +392,7 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 		if err != nil {
 			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupBlockRound, err)
 		}
-		if fileHeader.Version == CatchpointFileVersionV6 {
+		if fileHeader.Version != CatchpointFileVersionV5 {
 			err = crw.WriteCatchpointStateUint64(ctx, store.CatchpointStateCatchupHashRound, uint64(fileHeader.BlocksRound))
 			if err != nil {
 				return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to write catchpoint catchup state '%s': %v", store.CatchpointStateCatchupHashRound, err)
@@ -381,6 +409,7 @@ func (c *catchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 
 		progress.TotalChunks = fileHeader.TotalChunks
 		progress.Version = fileHeader.Version
+		progress.balancesRoot = fileHeader.BalancesMerkleRoot
 		c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
 	}
 	return err
@@ -435,6 +464,28 @@ func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 			expectingMoreEntries[i] = balance.ExpectingMoreEntries
 		}
 		chunkKVs = chunk.KVs
+
+	case CatchpointFileVersionV8:
+		var chunk catchpointFileChunkV8
+		err = protocol.Decode(bytes, &chunk)
+		if err != nil {
+			return err
+		}
+
+		if len(chunk.Entries) == 0 {
+			return fmt.Errorf("processStagingBalances received a chunk with no entries")
+		}
+
+		var trieKeys [][]byte
+		normalizedAccountBalances, chunkKVs, trieKeys, err = prepareNormalizedBalancesV8(chunk.Entries, c.ledger.GenesisProto())
+		if err != nil {
+			break
+		}
+		// verify the chunk on its own before writing any of it, so that a bad chunk could be fetched again.
+		err = merkletrie.VerifyRangeProof(progress.balancesRoot, trieKeys, &chunk.Proof)
+		if err != nil {
+			return fmt.Errorf("processStagingBalances: %w : %v", ErrCatchpointChunkProof, err)
+		}
 	}
 
 	if err != nil {
@@ -444,8 +495,12 @@ func (c *catchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 	expectingSpecificAccount := c.expectingSpecificAccount
 	nextExpectedAccount := c.nextExpectedAccount
 
-	// keep track of number of resources processed for each account
+	// keep track of number of resources processed for each account. The entries of a CatchpointFileVersionV8 file are
+	// ordered by their merkle trie keys rather than by their accounts, and are verified by the chunk proofs instead.
 	for i, balance := range normalizedAccountBalances {
+		if progress.Version == CatchpointFileVersionV8 {
+			break
+		}
 		// missing resources for this account
 		if expectingSpecificAccount && balance.Address != nextExpectedAccount {
 			return fmt.Errorf("processStagingBalances received incomplete chunks for account %v", nextExpectedAccount)
@@ -594,6 +594,10 @@ type BlockEvaluator struct {
 	l LedgerForEvaluator
 
 	maxTxnBytesPerBlock int
+
+	// debugger, if set, is attached to every program evaluated for a
+	// transaction group, including the programs of inner transactions.
+	debugger logic.DebuggerHook
 }
 
 // LedgerForEvaluator defines the ledger interface needed by the evaluator.
@@ -613,6 +617,7 @@ type EvaluatorOptions struct {
 	Generate            bool
 	MaxTxnBytesPerBlock int
 	ProtoParams         *config.ConsensusParams
+	Debugger            logic.DebuggerHook
 }
 
 // StartEvaluator creates a BlockEvaluator, given a ledger and a block header
@@ -671,6 +676,7 @@ func StartEvaluator(l LedgerForEvaluator, hdr bookkeeping.BlockHeader, evalOpts
 		genesisHash:         l.GenesisHash(),
 		l:                   l,
 		maxTxnBytesPerBlock: evalOpts.MaxTxnBytesPerBlock,
+		debugger:            evalOpts.Debugger,
 	}
 
 	// Preallocate space for the payset so that we don't have to
@@ -953,6 +959,8 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 	defer cow.recycle()
 
 	evalParams := logic.NewEvalParams(txgroup, &eval.proto, &eval.specials)
+	evalParams.Debugger = eval.debugger
+	evalParams.DebugInnerTxns = eval.debugger != nil
 
 	// Evaluate each transaction in the group
 	txibs = make([]transactions.SignedTxnInBlock, 0, len(txgroup))
@@ -71,6 +71,7 @@ type Ledger struct {
 	genesisHash crypto.Digest
 
 	genesisAccounts map[basics.Address]basics.AccountData
+	genesisBoxes    map[string][]byte
 
 	genesisProto        config.ConsensusParams
 	genesisProtoVersion protocol.ConsensusVersion
@@ -92,6 +93,10 @@ type Ledger struct {
 	// verifiedTxnCache holds all the verified transactions state
 	verifiedTxnCache verify.VerifiedTransactionCache
 
+	// verifiedTxnCacheFilename is the file the verified transactions cache is saved to when the ledger is closed,
+	// and loaded from when it's opened. It's empty unless the cache persistence is enabled.
+	verifiedTxnCacheFilename string
+
 	cfg config.Local
 
 	dbPathPrefix string
@@ -116,6 +121,7 @@ func OpenLedger(
 		archival:                       cfg.Archival,
 		genesisHash:                    genesisInitState.GenesisHash,
 		genesisAccounts:                genesisInitState.Accounts,
+		genesisBoxes:                   genesisInitState.Boxes,
 		genesisProto:                   config.Consensus[genesisInitState.Block.CurrentProtocol],
 		genesisProtoVersion:            genesisInitState.Block.CurrentProtocol,
 		synchronousMode:                db.SynchronousMode(cfg.LedgerSynchronousMode),
@@ -165,15 +171,131 @@ func OpenLedger(
 		return nil, err
 	}
 
+	if cfg.PersistVerifiedTransactionsCache && !dbMem {
+		l.verifiedTxnCacheFilename = dbPathPrefix + ".verifiedtxns"
+		l.loadVerifiedTxnCache()
+	}
+
 	return l, nil
 }
 
+// loadVerifiedTxnCache loads the verified transactions cache saved by the last saveVerifiedTxnCache call, if any.
+// The file is deleted once loaded, as it would be saved again when the ledger is closed.
+func (l *Ledger) loadVerifiedTxnCache() {
+	hdr, err := l.BlockHdr(l.Latest())
+	if err != nil {
+		l.log.Warnf("loadVerifiedTxnCache: unable to retrieve the latest block header : %v", err)
+		return
+	}
+	loaded, round, err := verify.LoadVerifiedTransactionCache(l.verifiedTxnCache, l.verifiedTxnCacheFilename, l.genesisHash, hdr, l)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			l.log.Warnf("loadVerifiedTxnCache: unable to load the verified transactions cache from %s : %v", l.verifiedTxnCacheFilename, err)
+		}
+	} else {
+		l.log.Infof("loadVerifiedTxnCache: loaded %d transaction groups verified as of round %d", loaded, round)
+	}
+	err = os.Remove(l.verifiedTxnCacheFilename)
+	if err != nil && !os.IsNotExist(err) {
+		l.log.Warnf("loadVerifiedTxnCache: unable to remove %s : %v", l.verifiedTxnCacheFilename, err)
+	}
+}
+
+// saveVerifiedTxnCache saves the verified transactions cache, so that it could be loaded when the ledger is reopened.
+func (l *Ledger) saveVerifiedTxnCache() {
+	err := verify.SaveVerifiedTransactionCache(l.verifiedTxnCache, l.verifiedTxnCacheFilename, l.genesisHash, l.Latest())
+	if err != nil {
+		l.log.Warnf("saveVerifiedTxnCache: unable to save the verified transactions cache to %s : %v", l.verifiedTxnCacheFilename, err)
+	}
+}
+
 // ReloadLedger is exported for the benefit of tests in the internal
 // package. Revisit this when we rename / restructure that thing
 func (l *Ledger) ReloadLedger() error {
 	return l.reloadLedger()
 }
 
+// SaveSnapshot copies the blocks and the tracker databases of the ledger to
+// tables of the same databases, so that RestoreSnapshot can return the ledger
+// to its current state later on. The id names the snapshot and must not be in
+// use. The trackers are reloaded afterwards.
+func (l *Ledger) SaveSnapshot(id uint64) error {
+	// the blocks that were added have to be written before copying the
+	// databases
+	l.WaitForCommit(l.Latest())
+	return l.withStoppedTrackers(func() error {
+		err := l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			return db.SnapshotTables(ctx, tx, id)
+		})
+		if err != nil {
+			return err
+		}
+		err = l.trackerDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			return db.SnapshotTables(ctx, tx, id)
+		})
+		if err != nil {
+			l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error { //nolint:errcheck // already failing
+				return db.DropSnapshotTables(ctx, tx, id)
+			})
+		}
+		return err
+	})
+}
+
+// RestoreSnapshot returns the ledger to the state it had when SaveSnapshot was
+// called for id, discarding the blocks added since, and reloads the ledger.
+// The trackers only replay the blocks which they had not committed when the
+// snapshot was saved. The snapshot is kept, so that it can be restored again.
+func (l *Ledger) RestoreSnapshot(id uint64) error {
+	return l.withStoppedTrackers(func() error {
+		err := l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			return db.RestoreTables(ctx, tx, id)
+		})
+		if err != nil {
+			return err
+		}
+		err = l.trackerDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			return db.RestoreTables(ctx, tx, id)
+		})
+		// the cached headers may belong to discarded rounds
+		l.headerCache.reset()
+		return err
+	})
+}
+
+// DeleteSnapshot deletes the tables that SaveSnapshot created for id.
+func (l *Ledger) DeleteSnapshot(id uint64) error {
+	err := l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return db.DropSnapshotTables(ctx, tx, id)
+	})
+	if err != nil {
+		return err
+	}
+	return l.trackerDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return db.DropSnapshotTables(ctx, tx, id)
+	})
+}
+
+// withStoppedTrackers runs fn once the blockQ sync goroutine and the trackers
+// commits are stopped, so that fn can access the databases, and then reloads
+// the ledger.
+func (l *Ledger) withStoppedTrackers(fn func() error) error {
+	// reloadLedger closes both of them again, which is harmless.
+	if l.blockQ != nil {
+		l.blockQ.close()
+	}
+	l.trackerMu.Lock()
+	l.trackers.close()
+	err := fn()
+	l.trackerMu.Unlock()
+
+	reloadErr := l.reloadLedger()
+	if err != nil {
+		return err
+	}
+	return reloadErr
+}
+
 func (l *Ledger) reloadLedger() error {
 	// similar to the Close function, we want to start by closing the blockQ first. The
 	// blockQ is having a sync goroutine which indirectly calls other trackers. We want to eliminate that go-routine first,
@@ -378,6 +500,11 @@ func initBlocksDB(tx *sql.Tx, l *Ledger, initBlocks []bookkeeping.Block, isArchi
 // Close reclaims resources used by the ledger (namely, the database connection
 // and goroutines used by trackers).
 func (l *Ledger) Close() {
+	// save the verified transactions cache while the latest round is still available.
+	if l.verifiedTxnCacheFilename != "" && l.blockQ != nil {
+		l.saveVerifiedTxnCache()
+	}
+
 	// we shut the the blockqueue first, since it's sync goroutine dispatches calls
 	// back to the trackers.
 	if l.blockQ != nil {
@@ -419,6 +546,51 @@ func (l *Ledger) notifyCommit(r basics.Round) basics.Round {
 	return minToSave
 }
 
+// blockHistoryPruneBatch is the maximal number of blocks pruned at once according to the block history settings, so
+// that limiting the history of a node which kept many blocks doesn't hold the blocks database for long.
+const blockHistoryPruneBatch = 10000
+
+// blockHistoryMinToSave applies the BlockHistoryRetainRounds and BlockHistoryMaxDiskBytes settings to minToSave, the
+// earliest round the trackers require, and returns the earliest round to keep in the blocks database.
+func (l *Ledger) blockHistoryMinToSave(tx *sql.Tx, committed basics.Round, minToSave basics.Round) (basics.Round, error) {
+	if l.archival || (l.cfg.BlockHistoryRetainRounds == 0 && l.cfg.BlockHistoryMaxDiskBytes == 0) {
+		return minToSave, nil
+	}
+
+	earliest, err := blockdb.BlockEarliest(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	keepFrom := basics.Round(0)
+	if l.cfg.BlockHistoryRetainRounds != 0 {
+		keepFrom = (committed + 1).SubSaturate(basics.Round(l.cfg.BlockHistoryRetainRounds))
+	}
+	if l.cfg.BlockHistoryMaxDiskBytes != 0 && committed >= earliest {
+		used, err := blockdb.BlockDiskUsage(tx)
+		if err != nil {
+			return 0, err
+		}
+		if used > 0 && uint64(used) > l.cfg.BlockHistoryMaxDiskBytes {
+			// estimate the number of blocks which fit in the budget from the average size of the stored blocks.
+			stored := float64(committed - earliest + 1)
+			fit := basics.Round(stored * float64(l.cfg.BlockHistoryMaxDiskBytes) / float64(used))
+			if budgetFrom := (committed + 1).SubSaturate(fit); budgetFrom > keepFrom {
+				keepFrom = budgetFrom
+			}
+		}
+	}
+
+	// never prune the blocks which the trackers still require.
+	if keepFrom < minToSave {
+		minToSave = keepFrom
+	}
+	if minToSave > earliest+blockHistoryPruneBatch {
+		minToSave = earliest + blockHistoryPruneBatch
+	}
+	return minToSave, nil
+}
+
 // GetLastCatchpointLabel returns the latest catchpoint label that was written to the
 // database.
 func (l *Ledger) GetLastCatchpointLabel() string {
@@ -627,6 +799,12 @@ func (l *Ledger) Block(rnd basics.Round) (blk bookkeeping.Block, err error) {
 	return l.blockQ.getBlock(rnd)
 }
 
+// EarliestAvailableRound returns the earliest round for which the ledger has the block. The blocks of the earlier
+// rounds were either pruned, or never fetched after a catchpoint catchup.
+func (l *Ledger) EarliestAvailableRound() basics.Round {
+	return l.blockQ.earliestRound()
+}
+
 // BlockHdr returns the BlockHeader of the block for round rnd.
 func (l *Ledger) BlockHdr(rnd basics.Round) (blk bookkeeping.BlockHeader, err error) {
 	blk, exists := l.headerCache.get(rnd)
@@ -730,6 +908,11 @@ func (l *Ledger) GenesisAccounts() map[basics.Address]basics.AccountData {
 	return l.genesisAccounts
 }
 
+// GenesisBoxes returns the initial key/value pairs for this ledger.
+func (l *Ledger) GenesisBoxes() map[string][]byte {
+	return l.genesisBoxes
+}
+
 // BlockHdrCached returns the block header if available.
 // Expected availability range is [Latest - MaxTxnLife, Latest]
 // allowing (MaxTxnLife + 1) = 1001 rounds back loopback.
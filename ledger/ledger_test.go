@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"testing"
@@ -1513,6 +1514,96 @@ func TestLedgerReload(t *testing.T) {
 	}
 }
 
+func TestLedgerSnapshots(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, initSecrets := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	var addrs []basics.Address
+	for addr := range genesisInitState.Accounts {
+		if addr != testPoolAddr && addr != testSinkAddr {
+			addrs = append(addrs, addr)
+		}
+	}
+	sender, receiver := addrs[0], addrs[1]
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+
+	addBlocks := func(n int) (blocks []bookkeeping.Block) {
+		for i := 0; i < n; i++ {
+			tx := transactions.Transaction{
+				Type: protocol.PaymentTx,
+				Header: transactions.Header{
+					Sender:      sender,
+					Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+					FirstValid:  l.Latest(),
+					LastValid:   l.Latest() + 10,
+					GenesisID:   t.Name(),
+					GenesisHash: crypto.Hash([]byte(t.Name())),
+				},
+				PaymentTxnFields: transactions.PaymentTxnFields{
+					Receiver: receiver,
+					Amount:   basics.MicroAlgos{Raw: 1000},
+				},
+			}
+			require.NoError(t, l.appendUnvalidatedTx(t, genesisInitState.Accounts, initSecrets, tx, transactions.ApplyData{}))
+			blk, err := l.Block(l.Latest())
+			require.NoError(t, err)
+			blocks = append(blocks, blk)
+		}
+		return blocks
+	}
+	balance := func() basics.MicroAlgos {
+		data, _, _, err := l.LookupLatest(receiver)
+		require.NoError(t, err)
+		return data.MicroAlgos
+	}
+
+	addBlocks(5)
+	before := balance()
+	require.NoError(t, l.SaveSnapshot(1))
+	require.Equal(t, basics.Round(5), l.Latest())
+
+	// more blocks than the trackers keep in memory, so that the rounds
+	// following the snapshot get committed to the tracker database
+	later := addBlocks(int(cfg.MaxAcctLookback) + 5)
+	l.WaitForCommit(l.Latest())
+	after := balance()
+	require.NoError(t, l.SaveSnapshot(2))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, l.RestoreSnapshot(1))
+		require.Equal(t, basics.Round(5), l.Latest())
+		require.Equal(t, before, balance())
+		_, err = l.BlockHdr(6)
+		require.Error(t, err)
+	}
+
+	// replaying the blocks recreates the same state
+	for _, blk := range later {
+		require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+	}
+	require.Equal(t, after, balance())
+
+	require.NoError(t, l.RestoreSnapshot(1))
+	require.NoError(t, l.RestoreSnapshot(2))
+	require.Equal(t, later[len(later)-1].Round(), l.Latest())
+	require.Equal(t, after, balance())
+	hdr, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	require.Equal(t, later[len(later)-1].BlockHeader, hdr)
+
+	require.NoError(t, l.DeleteSnapshot(1))
+	require.ErrorIs(t, l.RestoreSnapshot(1), db.ErrSnapshotNotFound)
+	require.Equal(t, later[len(later)-1].Round(), l.Latest())
+}
+
 // TestGetLastCatchpointLabel tests ledger.GetLastCatchpointLabel is returning the correct value.
 func TestGetLastCatchpointLabel(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -2996,3 +3087,108 @@ func TestVotersReloadFromDiskPassRecoveryPeriod(t *testing.T) {
 	require.False(t, found)
 	require.Equal(t, beforeRemoveVotersLen, len(l.acctsOnline.voters.votersForRoundCache))
 }
+
+func TestLedgerPersistVerifiedTxnCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dbName := filepath.Join(t.TempDir(), t.Name())
+	genesisInitState, initKeys := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	const inMem = false
+	cfg := config.GetDefaultLocal()
+	cfg.PersistVerifiedTransactionsCache = true
+	log := logging.TestingLog(t)
+	l, err := OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+
+	hdr, err := l.BlockHdr(l.Latest())
+	require.NoError(t, err)
+	proto := config.Consensus[hdr.CurrentProtocol]
+	var txgroups [][]transactions.SignedTxn
+	for addr, secrets := range initKeys {
+		if addr == testPoolAddr || addr == testSinkAddr {
+			continue
+		}
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addr,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  hdr.Round + 1,
+				LastValid:   hdr.Round + 10,
+				GenesisHash: genesisInitState.GenesisHash,
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addr,
+			},
+		}
+		txgroup := []transactions.SignedTxn{tx.Sign(secrets)}
+		_, err = verify.TxnGroup(txgroup, &hdr, l.VerifiedTransactionCache(), l)
+		require.NoError(t, err)
+		txgroups = append(txgroups, txgroup)
+	}
+	specAddrs := transactions.SpecialAddresses{FeeSink: hdr.FeeSink, RewardsPool: hdr.RewardsPool}
+	require.Empty(t, l.VerifiedTransactionCache().GetUnverifiedTransactionGroups(txgroups, specAddrs, hdr.CurrentProtocol))
+
+	// the cache is saved when the ledger is closed, and loaded back when it's reopened
+	l.Close()
+	require.FileExists(t, dbName+".verifiedtxns")
+	l, err = OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	require.NoFileExists(t, dbName+".verifiedtxns")
+	require.Empty(t, l.VerifiedTransactionCache().GetUnverifiedTransactionGroups(txgroups, specAddrs, hdr.CurrentProtocol))
+	l.Close()
+
+	// unless the persistence is disabled
+	cfg.PersistVerifiedTransactionsCache = false
+	l, err = OpenLedger(log, dbName, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+	require.Len(t, l.VerifiedTransactionCache().GetUnverifiedTransactionGroups(txgroups, specAddrs, hdr.CurrentProtocol), len(txgroups))
+}
+
+func TestLedgerBlockHistoryMinToSave(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	log := logging.TestingLog(t)
+	l, err := OpenLedger(log, t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	for i := 0; i < 20; i++ {
+		blk := makeNewEmptyBlock(t, l, t.Name(), genesisInitState.Accounts)
+		require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+	}
+	committed := l.Latest()
+	l.WaitForCommit(committed)
+	require.Equal(t, basics.Round(0), l.EarliestAvailableRound())
+
+	minToSave := func(retainRounds uint64, maxDiskBytes uint64, archival bool, trackersMinToSave basics.Round) (rnd basics.Round) {
+		l.cfg.BlockHistoryRetainRounds = retainRounds
+		l.cfg.BlockHistoryMaxDiskBytes = maxDiskBytes
+		l.archival = archival
+		err := l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+			rnd, err = l.blockHistoryMinToSave(tx, committed, trackersMinToSave)
+			return
+		})
+		require.NoError(t, err)
+		return
+	}
+
+	// without a block history limit, only the blocks the trackers require are kept
+	require.Equal(t, basics.Round(18), minToSave(0, 0, false, 18))
+	// the retained rounds are kept in addition to the blocks the trackers require
+	require.Equal(t, committed+1-5, minToSave(5, 0, false, 18))
+	require.Equal(t, basics.Round(10), minToSave(5, 0, false, 10))
+	// a large budget keeps everything, while an exceeded one prunes down to the blocks the trackers require
+	require.Equal(t, basics.Round(0), minToSave(0, 1<<40, false, 18))
+	require.Equal(t, basics.Round(18), minToSave(0, 1, false, 18))
+	require.Equal(t, basics.Round(18), minToSave(100, 1, false, 18))
+	// archival ledgers ignore the block history limits
+	require.Equal(t, basics.Round(0), minToSave(5, 1, true, 0))
+
+}
@@ -27,6 +27,9 @@ type InitState struct {
 	Block       bookkeeping.Block
 	Accounts    map[basics.Address]basics.AccountData
 	GenesisHash crypto.Digest
+	// Boxes holds the key/value pairs stored at the genesis round, keyed
+	// the same way as the kvstore (see logic.MakeBoxKey).
+	Boxes map[string][]byte
 }
 
 // BlockListener represents an object that needs to get notified on new blocks.
@@ -41,6 +41,22 @@ import (
 //           |-----> (*) Msgsize
 //           |-----> (*) MsgIsZero
 //
+// catchpointFileChunkV8
+//           |-----> (*) MarshalMsg
+//           |-----> (*) CanMarshalMsg
+//           |-----> (*) UnmarshalMsg
+//           |-----> (*) CanUnmarshalMsg
+//           |-----> (*) Msgsize
+//           |-----> (*) MsgIsZero
+//
+// catchpointFileEntryV8
+//           |-----> (*) MarshalMsg
+//           |-----> (*) CanMarshalMsg
+//           |-----> (*) UnmarshalMsg
+//           |-----> (*) CanUnmarshalMsg
+//           |-----> (*) Msgsize
+//           |-----> (*) MsgIsZero
+//
 
 // MarshalMsg implements msgp.Marshaler
 func (z CatchpointCatchupState) MarshalMsg(b []byte) (o []byte) {
@@ -92,8 +108,8 @@ func (z CatchpointCatchupState) MsgIsZero() bool {
 func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(9)
-	var zb0001Mask uint16 /* 10 bits */
+	zb0001Len := uint32(10)
+	var zb0001Mask uint16 /* 11 bits */
 	if (*z).Totals.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x2
@@ -102,34 +118,38 @@ func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 		zb0001Len--
 		zb0001Mask |= 0x4
 	}
-	if (*z).BalancesRound.MsgIsZero() {
+	if (*z).BalancesMerkleRoot.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x8
 	}
-	if (*z).BlockHeaderDigest.MsgIsZero() {
+	if (*z).BalancesRound.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x10
 	}
-	if (*z).BlocksRound.MsgIsZero() {
+	if (*z).BlockHeaderDigest.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x20
 	}
-	if (*z).Catchpoint == "" {
+	if (*z).BlocksRound.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x40
 	}
-	if (*z).TotalChunks == 0 {
+	if (*z).Catchpoint == "" {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
-	if (*z).TotalKVs == 0 {
+	if (*z).TotalChunks == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x100
 	}
-	if (*z).Version == 0 {
+	if (*z).TotalKVs == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x200
 	}
+	if (*z).Version == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 	if zb0001Len != 0 {
@@ -144,36 +164,41 @@ func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 			o = msgp.AppendUint64(o, (*z).TotalAccounts)
 		}
 		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "balancesMerkleRoot"
+			o = append(o, 0xb2, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74)
+			o = (*z).BalancesMerkleRoot.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not empty
 			// string "balancesRound"
 			o = append(o, 0xad, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64)
 			o = (*z).BalancesRound.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x10) == 0 { // if not empty
+		if (zb0001Mask & 0x20) == 0 { // if not empty
 			// string "blockHeaderDigest"
 			o = append(o, 0xb1, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74)
 			o = (*z).BlockHeaderDigest.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x20) == 0 { // if not empty
+		if (zb0001Mask & 0x40) == 0 { // if not empty
 			// string "blocksRound"
 			o = append(o, 0xab, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64)
 			o = (*z).BlocksRound.MarshalMsg(o)
 		}
-		if (zb0001Mask & 0x40) == 0 { // if not empty
+		if (zb0001Mask & 0x80) == 0 { // if not empty
 			// string "catchpoint"
 			o = append(o, 0xaa, 0x63, 0x61, 0x74, 0x63, 0x68, 0x70, 0x6f, 0x69, 0x6e, 0x74)
 			o = msgp.AppendString(o, (*z).Catchpoint)
 		}
-		if (zb0001Mask & 0x80) == 0 { // if not empty
+		if (zb0001Mask & 0x100) == 0 { // if not empty
 			// string "chunksCount"
 			o = append(o, 0xab, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalChunks)
 		}
-		if (zb0001Mask & 0x100) == 0 { // if not empty
+		if (zb0001Mask & 0x200) == 0 { // if not empty
 			// string "kvsCount"
 			o = append(o, 0xa8, 0x6b, 0x76, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
 			o = msgp.AppendUint64(o, (*z).TotalKVs)
 		}
-		if (zb0001Mask & 0x200) == 0 { // if not empty
+		if (zb0001Mask & 0x400) == 0 { // if not empty
 			// string "version"
 			o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
 			o = msgp.AppendUint64(o, (*z).Version)
@@ -272,6 +297,14 @@ func (z *CatchpointFileHeader) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).BalancesMerkleRoot.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "BalancesMerkleRoot")
+				return
+			}
+		}
 		if zb0001 > 0 {
 			err = msgp.ErrTooManyArrayFields(zb0001)
 			if err != nil {
@@ -349,6 +382,12 @@ func (z *CatchpointFileHeader) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					err = msgp.WrapError(err, "BlockHeaderDigest")
 					return
 				}
+			case "balancesMerkleRoot":
+				bts, err = (*z).BalancesMerkleRoot.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "BalancesMerkleRoot")
+					return
+				}
 			default:
 				err = msgp.ErrNoField(string(field))
 				if err != nil {
@@ -369,13 +408,13 @@ func (_ *CatchpointFileHeader) CanUnmarshalMsg(z interface{}) bool {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *CatchpointFileHeader) Msgsize() (s int) {
-	s = 1 + 8 + msgp.Uint64Size + 14 + (*z).BalancesRound.Msgsize() + 12 + (*z).BlocksRound.Msgsize() + 14 + (*z).Totals.Msgsize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 9 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + len((*z).Catchpoint) + 18 + (*z).BlockHeaderDigest.Msgsize()
+	s = 1 + 8 + msgp.Uint64Size + 14 + (*z).BalancesRound.Msgsize() + 12 + (*z).BlocksRound.Msgsize() + 14 + (*z).Totals.Msgsize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 9 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + len((*z).Catchpoint) + 18 + (*z).BlockHeaderDigest.Msgsize() + 19 + (*z).BalancesMerkleRoot.Msgsize()
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *CatchpointFileHeader) MsgIsZero() bool {
-	return ((*z).Version == 0) && ((*z).BalancesRound.MsgIsZero()) && ((*z).BlocksRound.MsgIsZero()) && ((*z).Totals.MsgIsZero()) && ((*z).TotalAccounts == 0) && ((*z).TotalChunks == 0) && ((*z).TotalKVs == 0) && ((*z).Catchpoint == "") && ((*z).BlockHeaderDigest.MsgIsZero())
+	return ((*z).Version == 0) && ((*z).BalancesRound.MsgIsZero()) && ((*z).BlocksRound.MsgIsZero()) && ((*z).Totals.MsgIsZero()) && ((*z).TotalAccounts == 0) && ((*z).TotalChunks == 0) && ((*z).TotalKVs == 0) && ((*z).Catchpoint == "") && ((*z).BlockHeaderDigest.MsgIsZero()) && ((*z).BalancesMerkleRoot.MsgIsZero())
 }
 
 // MarshalMsg implements msgp.Marshaler
@@ -769,3 +808,446 @@ func (z *catchpointFileChunkV6) Msgsize() (s int) {
 func (z *catchpointFileChunkV6) MsgIsZero() bool {
 	return (len((*z).Balances) == 0) && (len((*z).KVs) == 0)
 }
+
+// MarshalMsg implements msgp.Marshaler
+func (z *catchpointFileChunkV8) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(2)
+	var zb0002Mask uint8 /* 3 bits */
+	if len((*z).Entries) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	if (*z).Proof.MsgIsZero() {
+		zb0002Len--
+		zb0002Mask |= 0x4
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "en"
+			o = append(o, 0xa2, 0x65, 0x6e)
+			if (*z).Entries == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Entries)))
+			}
+			for zb0001 := range (*z).Entries {
+				o = (*z).Entries[zb0001].MarshalMsg(o)
+			}
+		}
+		if (zb0002Mask & 0x4) == 0 { // if not empty
+			// string "pf"
+			o = append(o, 0xa2, 0x70, 0x66)
+			o = (*z).Proof.MarshalMsg(o)
+		}
+	}
+	return
+}
+
+func (_ *catchpointFileChunkV8) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*catchpointFileChunkV8)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *catchpointFileChunkV8) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			var zb0005 bool
+			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Entries")
+				return
+			}
+			if zb0004 > EntriesPerCatchpointFileChunkV8 {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(EntriesPerCatchpointFileChunkV8))
+				err = msgp.WrapError(err, "struct-from-array", "Entries")
+				return
+			}
+			if zb0005 {
+				(*z).Entries = nil
+			} else if (*z).Entries != nil && cap((*z).Entries) >= zb0004 {
+				(*z).Entries = ((*z).Entries)[:zb0004]
+			} else {
+				(*z).Entries = make([]catchpointFileEntryV8, zb0004)
+			}
+			for zb0001 := range (*z).Entries {
+				bts, err = (*z).Entries[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Entries", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			zb0002--
+			bts, err = (*z).Proof.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Proof")
+				return
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = catchpointFileChunkV8{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "en":
+				var zb0006 int
+				var zb0007 bool
+				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Entries")
+					return
+				}
+				if zb0006 > EntriesPerCatchpointFileChunkV8 {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(EntriesPerCatchpointFileChunkV8))
+					err = msgp.WrapError(err, "Entries")
+					return
+				}
+				if zb0007 {
+					(*z).Entries = nil
+				} else if (*z).Entries != nil && cap((*z).Entries) >= zb0006 {
+					(*z).Entries = ((*z).Entries)[:zb0006]
+				} else {
+					(*z).Entries = make([]catchpointFileEntryV8, zb0006)
+				}
+				for zb0001 := range (*z).Entries {
+					bts, err = (*z).Entries[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Entries", zb0001)
+						return
+					}
+				}
+			case "pf":
+				bts, err = (*z).Proof.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Proof")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *catchpointFileChunkV8) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*catchpointFileChunkV8)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *catchpointFileChunkV8) Msgsize() (s int) {
+	s = 1 + 3 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Entries {
+		s += (*z).Entries[zb0001].Msgsize()
+	}
+	s += 3 + (*z).Proof.Msgsize()
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *catchpointFileChunkV8) MsgIsZero() bool {
+	return (len((*z).Entries) == 0) && ((*z).Proof.MsgIsZero())
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *catchpointFileEntryV8) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(6)
+	var zb0001Mask uint8 /* 7 bits */
+	if (*z).Address.MsgIsZero() {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if (*z).AccountData.MsgIsZero() {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if (*z).CreatableIndex.MsgIsZero() {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if len((*z).Key) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if (*z).ResourceData.MsgIsZero() {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if len((*z).Value) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "a"
+			o = append(o, 0xa1, 0x61)
+			o = (*z).Address.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "b"
+			o = append(o, 0xa1, 0x62)
+			o = (*z).AccountData.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "c"
+			o = append(o, 0xa1, 0x63)
+			o = (*z).CreatableIndex.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not empty
+			// string "k"
+			o = append(o, 0xa1, 0x6b)
+			o = msgp.AppendBytes(o, (*z).Key)
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not empty
+			// string "r"
+			o = append(o, 0xa1, 0x72)
+			o = (*z).ResourceData.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not empty
+			// string "v"
+			o = append(o, 0xa1, 0x76)
+			o = msgp.AppendBytes(o, (*z).Value)
+		}
+	}
+	return
+}
+
+func (_ *catchpointFileEntryV8) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*catchpointFileEntryV8)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *catchpointFileEntryV8) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).Address.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Address")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).AccountData.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "AccountData")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).CreatableIndex.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "CreatableIndex")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).ResourceData.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "ResourceData")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0003 int
+			zb0003, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Key")
+				return
+			}
+			if zb0003 > encoded.KVRecordV6MaxKeyLength {
+				err = msgp.ErrOverflow(uint64(zb0003), uint64(encoded.KVRecordV6MaxKeyLength))
+				return
+			}
+			(*z).Key, bts, err = msgp.ReadBytesBytes(bts, (*z).Key)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Key")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0004 int
+			zb0004, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Value")
+				return
+			}
+			if zb0004 > encoded.KVRecordV6MaxValueLength {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(encoded.KVRecordV6MaxValueLength))
+				return
+			}
+			(*z).Value, bts, err = msgp.ReadBytesBytes(bts, (*z).Value)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Value")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = catchpointFileEntryV8{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "a":
+				bts, err = (*z).Address.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Address")
+					return
+				}
+			case "b":
+				bts, err = (*z).AccountData.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "AccountData")
+					return
+				}
+			case "c":
+				bts, err = (*z).CreatableIndex.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "CreatableIndex")
+					return
+				}
+			case "r":
+				bts, err = (*z).ResourceData.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ResourceData")
+					return
+				}
+			case "k":
+				var zb0005 int
+				zb0005, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Key")
+					return
+				}
+				if zb0005 > encoded.KVRecordV6MaxKeyLength {
+					err = msgp.ErrOverflow(uint64(zb0005), uint64(encoded.KVRecordV6MaxKeyLength))
+					return
+				}
+				(*z).Key, bts, err = msgp.ReadBytesBytes(bts, (*z).Key)
+				if err != nil {
+					err = msgp.WrapError(err, "Key")
+					return
+				}
+			case "v":
+				var zb0006 int
+				zb0006, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Value")
+					return
+				}
+				if zb0006 > encoded.KVRecordV6MaxValueLength {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(encoded.KVRecordV6MaxValueLength))
+					return
+				}
+				(*z).Value, bts, err = msgp.ReadBytesBytes(bts, (*z).Value)
+				if err != nil {
+					err = msgp.WrapError(err, "Value")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *catchpointFileEntryV8) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*catchpointFileEntryV8)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *catchpointFileEntryV8) Msgsize() (s int) {
+	s = 1 + 2 + (*z).Address.Msgsize() + 2 + (*z).AccountData.Msgsize() + 2 + (*z).CreatableIndex.Msgsize() + 2 + (*z).ResourceData.Msgsize() + 2 + msgp.BytesPrefixSize + len((*z).Key) + 2 + msgp.BytesPrefixSize + len((*z).Value)
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *catchpointFileEntryV8) MsgIsZero() bool {
+	return ((*z).Address.MsgIsZero()) && ((*z).AccountData.MsgIsZero()) && ((*z).CreatableIndex.MsgIsZero()) && ((*z).ResourceData.MsgIsZero()) && (len((*z).Key) == 0) && (len((*z).Value) == 0)
+}
@@ -0,0 +1,340 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// ProgramSource describes the TEAL source a profiled program was assembled
+// from, so that the profile can refer to source lines rather than to lines of
+// the disassembly. Source is optional, when present subroutines are named by
+// their labels in it instead of the labels made up by the disassembler.
+type ProgramSource struct {
+	Program   []byte
+	SourceMap logic.SourceMap
+	Source    string
+}
+
+// sourceLabel returns the label that line is the first instruction after, if
+// there is one.
+func sourceLabel(lines []string, line int) string {
+	for i := line - 1; i >= 0 && i < len(lines); i-- {
+		text := lines[i]
+		if comment := strings.Index(text, "//"); comment >= 0 {
+			text = text[:comment]
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		if strings.HasSuffix(text, ":") && !strings.ContainsAny(text, " \t") {
+			return strings.TrimSuffix(text, ":")
+		}
+		return ""
+	}
+	return ""
+}
+
+// Field numbers of the pprof profile.proto messages that are written.
+// See https://github.com/google/pprof/blob/main/proto/profile.proto
+const (
+	pprofProfileSampleType  = 1
+	pprofProfileSample      = 2
+	pprofProfileLocation    = 4
+	pprofProfileFunction    = 5
+	pprofProfileStringTable = 6
+	pprofProfilePeriodType  = 11
+	pprofProfilePeriod      = 12
+	pprofProfileDefaultType = 14
+
+	pprofValueTypeType = 1
+	pprofValueTypeUnit = 2
+
+	pprofSampleLocationID = 1
+	pprofSampleValue      = 2
+
+	pprofLocationID      = 1
+	pprofLocationAddress = 3
+	pprofLocationLine    = 4
+
+	pprofLineFunctionID = 1
+	pprofLineLine       = 2
+
+	pprofFunctionID         = 1
+	pprofFunctionName       = 2
+	pprofFunctionSystemName = 3
+	pprofFunctionFilename   = 4
+)
+
+// protobuf is a minimal protocol buffers encoder, sufficient for writing
+// pprof profiles.
+type protobuf struct {
+	data []byte
+}
+
+func (b *protobuf) varint(x uint64) {
+	for x >= 0x80 {
+		b.data = append(b.data, byte(x)|0x80)
+		x >>= 7
+	}
+	b.data = append(b.data, byte(x))
+}
+
+func (b *protobuf) key(field int, wireType int) {
+	b.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (b *protobuf) uint64(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+	b.key(field, 0)
+	b.varint(x)
+}
+
+func (b *protobuf) int64(field int, x int64) {
+	b.uint64(field, uint64(x))
+}
+
+func (b *protobuf) packed(field int, xs []uint64) {
+	var inner protobuf
+	for _, x := range xs {
+		inner.varint(x)
+	}
+	b.bytes(field, inner.data)
+}
+
+func (b *protobuf) bytes(field int, data []byte) {
+	b.key(field, 2)
+	b.varint(uint64(len(data)))
+	b.data = append(b.data, data...)
+}
+
+func (b *protobuf) message(field int, encode func(*protobuf)) {
+	var inner protobuf
+	encode(&inner)
+	b.bytes(field, inner.data)
+}
+
+// pprofBuilder assigns ids to the strings, functions and locations of a
+// profile while samples are added.
+type pprofBuilder struct {
+	strings   []string
+	stringIDs map[string]int64
+
+	functions   protobuf
+	functionIDs map[string]uint64
+
+	locations   protobuf
+	locationIDs map[string]uint64
+
+	samples protobuf
+}
+
+func (pb *pprofBuilder) str(s string) int64 {
+	if id, ok := pb.stringIDs[s]; ok {
+		return id
+	}
+	id := int64(len(pb.strings))
+	pb.strings = append(pb.strings, s)
+	pb.stringIDs[s] = id
+	return id
+}
+
+func (pb *pprofBuilder) function(name, systemName, filename string) uint64 {
+	key := name + "\x00" + systemName + "\x00" + filename
+	if id, ok := pb.functionIDs[key]; ok {
+		return id
+	}
+	id := uint64(len(pb.functionIDs) + 1)
+	pb.functionIDs[key] = id
+	pb.functions.message(pprofProfileFunction, func(b *protobuf) {
+		b.uint64(pprofFunctionID, id)
+		b.int64(pprofFunctionName, pb.str(name))
+		b.int64(pprofFunctionSystemName, pb.str(systemName))
+		b.int64(pprofFunctionFilename, pb.str(filename))
+	})
+	return id
+}
+
+func (pb *pprofBuilder) location(key string, address uint64, functionID uint64, line int64) uint64 {
+	if id, ok := pb.locationIDs[key]; ok {
+		return id
+	}
+	id := uint64(len(pb.locationIDs) + 1)
+	pb.locationIDs[key] = id
+	pb.locations.message(pprofProfileLocation, func(b *protobuf) {
+		b.uint64(pprofLocationID, id)
+		b.uint64(pprofLocationAddress, address)
+		b.message(pprofLocationLine, func(b *protobuf) {
+			b.uint64(pprofLineFunctionID, functionID)
+			b.int64(pprofLineLine, line)
+		})
+	})
+	return id
+}
+
+// roots returns the locations of the frames which a sample of the program of
+// pp starts with, innermost first: one for its transaction and program, and
+// for an inner transaction one for each of the programs it was issued by.
+func (pb *pprofBuilder) roots(pp *ProgramProfile) []uint64 {
+	var names []string
+	for ; pp != nil; pp = pp.Caller {
+		if pp.Caller == nil {
+			names = append(names, fmt.Sprintf("txn %d %s", pp.GroupIndex, pp.Kind))
+		} else {
+			names = append(names, fmt.Sprintf("inner txn %d %s", pp.GroupIndex, pp.Kind))
+		}
+	}
+	locations := make([]uint64, len(names))
+	var key string
+	for i := len(names) - 1; i >= 0; i-- {
+		key += "/" + names[i]
+		function := pb.function(names[i], names[i], "")
+		locations[i] = pb.location(key, 0, function, 0)
+	}
+	return locations
+}
+
+// WritePprof writes the profile in the gzipped protocol buffers format read
+// by `go tool pprof` and other pprof compatible tools. Every sample has two
+// values, the opcode cost and the number of executed instructions. The call
+// stack of a sample starts with a frame for its transaction and program,
+// preceded by the frames of the programs which issued it for an inner
+// transaction, followed by a frame for the program's top level and one per
+// subroutine.
+//
+// Programs found in sources are reported with their source file names and
+// lines, the others with the lines of their disassembly.
+func (p CostProfile) WritePprof(w io.Writer, sources ...ProgramSource) error {
+	type programSource struct {
+		file       string
+		pcToLine   map[int]int
+		sortedPCs  []int
+		lines      []string
+		hasSources bool
+	}
+	bySource := make(map[string]programSource, len(sources))
+	for _, source := range sources {
+		pcToLine, err := source.SourceMap.GetOffsetToLine()
+		if err != nil {
+			return err
+		}
+		ps := programSource{pcToLine: pcToLine, hasSources: true}
+		if source.Source != "" {
+			ps.lines = strings.Split(source.Source, "\n")
+		}
+		if len(source.SourceMap.Sources) > 0 {
+			ps.file = source.SourceMap.Sources[0]
+		}
+		for pc := range pcToLine {
+			ps.sortedPCs = append(ps.sortedPCs, pc)
+		}
+		sort.Ints(ps.sortedPCs)
+		bySource[logic.GetProgramID(source.Program)] = ps
+	}
+
+	pb := pprofBuilder{
+		stringIDs:   make(map[string]int64),
+		functionIDs: make(map[string]uint64),
+		locationIDs: make(map[string]uint64),
+	}
+	pb.str("")
+
+	for _, pp := range p.Programs {
+		source, ok := bySource[pp.ProgramID]
+		if !ok {
+			source.file = pp.ProgramID[:16] + ".teal.dis"
+		}
+		// Source lines are one based in pprof, the maps are zero based.
+		line := func(pc int) int64 {
+			if !source.hasSources {
+				return int64(pp.disassemblyLine(pc)) + 1
+			}
+			// pc may be an immediate or an instruction the assembler did
+			// not map, use the closest mapped pc before it
+			i := sort.SearchInts(source.sortedPCs, pc+1) - 1
+			if i < 0 {
+				return 0
+			}
+			return int64(source.pcToLine[source.sortedPCs[i]]) + 1
+		}
+
+		rootLocations := pb.roots(pp)
+
+		keys := make([]string, 0, len(pp.samples))
+		for key := range pp.samples {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			sample := pp.samples[key]
+			// pprof wants the innermost frame first
+			locationIDs := make([]uint64, 0, len(sample.stack)+len(rootLocations))
+			for i := len(sample.stack) - 1; i >= 0; i-- {
+				frame := sample.stack[i]
+				label := frame.label
+				if i > 0 && source.lines != nil {
+					if named := sourceLabel(source.lines, int(line(frame.entry))-1); named != "" {
+						label = named
+					}
+				}
+				functionID := pb.function(source.file+":"+label, frame.label, source.file)
+				locationKey := fmt.Sprintf("%s/%s@%d", pp.ProgramID, frame.label, frame.pc)
+				locationIDs = append(locationIDs, pb.location(locationKey, uint64(frame.pc), functionID, line(frame.pc)))
+			}
+			locationIDs = append(locationIDs, rootLocations...)
+			pb.samples.message(pprofProfileSample, func(b *protobuf) {
+				b.packed(pprofSampleLocationID, locationIDs)
+				b.packed(pprofSampleValue, []uint64{uint64(sample.cost), uint64(sample.count)})
+			})
+		}
+	}
+
+	var out protobuf
+	costType := func(b *protobuf) {
+		b.int64(pprofValueTypeType, pb.str("cost"))
+		b.int64(pprofValueTypeUnit, pb.str("opcodes"))
+	}
+	out.message(pprofProfileSampleType, costType)
+	out.message(pprofProfileSampleType, func(b *protobuf) {
+		b.int64(pprofValueTypeType, pb.str("instructions"))
+		b.int64(pprofValueTypeUnit, pb.str("count"))
+	})
+	out.data = append(out.data, pb.samples.data...)
+	out.data = append(out.data, pb.locations.data...)
+	out.data = append(out.data, pb.functions.data...)
+	out.message(pprofProfilePeriodType, costType)
+	out.int64(pprofProfilePeriod, 1)
+	out.int64(pprofProfileDefaultType, pb.str("cost"))
+	for _, s := range pb.strings {
+		out.bytes(pprofProfileStringTable, []byte(s))
+	}
+
+	gz := gzip.NewWriter(w)
+	if _, err := gz.Write(out.data); err != nil {
+		return err
+	}
+	return gz.Close()
+}
@@ -0,0 +1,243 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+)
+
+// Kinds of programs that are profiled.
+const (
+	ProgramKindLogicSig = "logicsig"
+	ProgramKindApproval = "approval"
+	ProgramKindClear    = "clear"
+)
+
+// mainFrameLabel names the top level of a program, outside of any subroutine.
+const mainFrameLabel = "main"
+
+// ProgramProfile holds the opcode cost spent by one program of a
+// transaction group, or of one of its inner transactions.
+type ProgramProfile struct {
+	// GroupIndex is the index of the transaction in the group, or in the inner
+	// group for the programs of inner transactions.
+	GroupIndex int
+	// Caller is the profile of the program which issued the inner transaction
+	// this program ran for, nil for the programs of the transaction group.
+	Caller *ProgramProfile
+	// Kind is one of ProgramKindLogicSig, ProgramKindApproval or ProgramKindClear.
+	Kind string
+	// ProgramID identifies the program, see logic.GetProgramID.
+	ProgramID string
+	// Total is the cost of the whole execution.
+	Total int
+	// PCCost is the cost spent by the instruction at each pc.
+	PCCost map[int]int
+	// FrameCost is the cost spent in each subroutine, named by its label in
+	// the disassembly, including the cost of the subroutines it calls. The
+	// top level of the program is named "main".
+	FrameCost map[string]int
+
+	disassembly string
+	pcOffset    []logic.PCOffset
+	samples     map[string]*costSample
+}
+
+// costFrame is an entry of the call stack of a sample. entry is the first pc
+// of the subroutine, pc is the location of the callsub that entered the next
+// frame, or of the sampled instruction for the innermost frame.
+type costFrame struct {
+	label string
+	entry int
+	pc    int
+}
+
+type costSample struct {
+	stack []costFrame // outermost frame first
+	cost  int
+	count int
+}
+
+func (pp *ProgramProfile) add(stack []costFrame, cost int) {
+	var key strings.Builder
+	for _, f := range stack {
+		key.WriteString(f.label)
+		key.WriteByte('@')
+		key.WriteString(strconv.Itoa(f.entry))
+		key.WriteByte(':')
+		key.WriteString(strconv.Itoa(f.pc))
+		key.WriteByte('/')
+	}
+	sample, ok := pp.samples[key.String()]
+	if !ok {
+		sample = &costSample{stack: append([]costFrame(nil), stack...)}
+		pp.samples[key.String()] = sample
+	}
+	sample.cost += cost
+	sample.count++
+
+	pp.Total += cost
+	pp.PCCost[stack[len(stack)-1].pc] += cost
+	// a recursive subroutine is only charged once per sample
+	seen := make(map[string]bool, len(stack))
+	for _, f := range stack {
+		if !seen[f.label] {
+			pp.FrameCost[f.label] += cost
+			seen[f.label] = true
+		}
+	}
+}
+
+// disassemblyLine returns the (zero based) line of pc in the disassembly of
+// the program.
+func (pp *ProgramProfile) disassemblyLine(pc int) int {
+	ds := logic.DebugState{Disassembly: pp.disassembly, PCOffset: pp.pcOffset}
+	return ds.PCToLine(pc)
+}
+
+// CostProfile is the opcode cost profile of the programs evaluated while
+// simulating a transaction group, including those of inner transactions. An
+// inner transaction's program is charged separately from the program which
+// issued it.
+type CostProfile struct {
+	Programs []*ProgramProfile
+}
+
+// programRun is the state of the profiling of a program that is running.
+type programRun struct {
+	profile   *ProgramProfile
+	mainEntry int
+	lastPC    int
+	lastCost  int
+	callsites []int
+	entries   []int
+	labels    []string
+}
+
+// costProfiler is a logic.DebuggerHook that builds a CostProfile. The cost of
+// an instruction is known when the evaluator reports the next step, so every
+// Update and the final Complete account for the previously reported pc. The
+// programs of inner transactions are registered while the program issuing
+// them is running, so the running programs form a stack.
+type costProfiler struct {
+	profile  CostProfile
+	logicSig bool
+
+	running []*programRun
+}
+
+// current returns the innermost running program, or nil.
+func (p *costProfiler) current() *programRun {
+	if len(p.running) == 0 {
+		return nil
+	}
+	return p.running[len(p.running)-1]
+}
+
+func (p *costProfiler) Register(state *logic.DebugState) error {
+	kind := ProgramKindApproval
+	if p.logicSig {
+		kind = ProgramKindLogicSig
+	} else if state.TxnGroup[state.GroupIndex].Txn.OnCompletion == transactions.ClearStateOC {
+		kind = ProgramKindClear
+	}
+	var caller *ProgramProfile
+	if run := p.current(); run != nil {
+		caller = run.profile
+	}
+	profile := &ProgramProfile{
+		GroupIndex:  state.GroupIndex,
+		Caller:      caller,
+		Kind:        kind,
+		ProgramID:   state.ExecID,
+		PCCost:      make(map[int]int),
+		FrameCost:   make(map[string]int),
+		disassembly: state.Disassembly,
+		pcOffset:    state.PCOffset,
+		samples:     make(map[string]*costSample),
+	}
+	p.profile.Programs = append(p.profile.Programs, profile)
+	p.running = append(p.running, &programRun{
+		profile:   profile,
+		mainEntry: state.PC,
+		lastPC:    -1,
+	})
+	return nil
+}
+
+func (p *costProfiler) Update(state *logic.DebugState) error {
+	run := p.current()
+	if run == nil {
+		return nil
+	}
+	run.account(state)
+
+	// The call stack changed, so the previous instruction was a callsub or
+	// a retsub. Remember where a subroutine was called from, the evaluator
+	// only reports the label of the subroutine.
+	depth := len(state.CallStack)
+	for len(run.callsites) < depth {
+		run.callsites = append(run.callsites, run.lastPC)
+		run.entries = append(run.entries, state.PC)
+	}
+	run.callsites = run.callsites[:depth]
+	run.entries = run.entries[:depth]
+	run.labels = run.labels[:0]
+	for _, frame := range state.CallStack {
+		run.labels = append(run.labels, frame.LabelName)
+	}
+
+	run.lastPC = state.PC
+	run.lastCost = state.Cost
+	return nil
+}
+
+func (p *costProfiler) Complete(state *logic.DebugState) error {
+	run := p.current()
+	if run == nil {
+		return nil
+	}
+	run.account(state)
+	p.running = p.running[:len(p.running)-1]
+	return nil
+}
+
+func (run *programRun) account(state *logic.DebugState) {
+	if run.lastPC < 0 {
+		return
+	}
+	cost := state.Cost - run.lastCost
+	if cost <= 0 {
+		// the previous instruction failed, or was not charged
+		return
+	}
+	stack := make([]costFrame, 0, len(run.callsites)+1)
+	label, entry := mainFrameLabel, run.mainEntry
+	for i, pc := range run.callsites {
+		stack = append(stack, costFrame{label: label, entry: entry, pc: pc})
+		label, entry = run.labels[i], run.entries[i]
+		if label == "" {
+			label = "sub@" + strconv.Itoa(entry)
+		}
+	}
+	stack = append(stack, costFrame{label: label, entry: entry, pc: run.lastPC})
+	run.profile.add(stack, cost)
+}
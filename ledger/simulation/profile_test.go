@@ -0,0 +1,325 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	pprofile "github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/simulation"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const profiledProgram = `#pragma version 8
+callsub double
+callsub double
+int 1
+return
+double:
+int 2
+int 3
+*
+pop
+retsub
+`
+
+func TestSimulateWithProfile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l)
+	sender := accounts[0].addr
+
+	ops, err := logic.AssembleString(profiledProgram)
+	require.NoError(t, err, ops.Errors)
+	prog := ops.Program
+
+	lsigOps, err := logic.AssembleString("#pragma version 8\nint 1\nint 2\n+\n")
+	require.NoError(t, err, lsigOps.Errors)
+	lsig := transactions.LogicSig{Logic: lsigOps.Program}
+	lsigAddr := basics.Address(logic.HashProgram(lsigOps.Program))
+
+	txgroup := []transactions.SignedTxn{
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.PaymentTx,
+				Header: makeTxnHeader(sender),
+				PaymentTxnFields: transactions.PaymentTxnFields{
+					Receiver: lsigAddr,
+					Amount:   basics.MicroAlgos{Raw: 1000000},
+				},
+			},
+		},
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.ApplicationCallTx,
+				Header: makeTxnHeader(sender),
+				ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+					ApprovalProgram:   prog,
+					ClearStateProgram: prog,
+				},
+			},
+		},
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.PaymentTx,
+				Header: makeTxnHeader(lsigAddr),
+				PaymentTxnFields: transactions.PaymentTxnFields{
+					Receiver: sender,
+				},
+			},
+			Lsig: lsig,
+		},
+	}
+	require.NoError(t, attachGroupID(txgroup))
+
+	_, _, profile, err := s.SimulateWithProfile(txgroup)
+	require.NoError(t, err)
+	require.Len(t, profile.Programs, 2)
+
+	lsigProfile := profile.Programs[0]
+	require.Equal(t, simulation.ProgramKindLogicSig, lsigProfile.Kind)
+	require.Equal(t, 2, lsigProfile.GroupIndex)
+	require.Equal(t, logic.GetProgramID(lsigOps.Program), lsigProfile.ProgramID)
+	require.Equal(t, 3, lsigProfile.Total) // pushint, pushint, +
+	require.Equal(t, map[string]int{"main": 3}, lsigProfile.FrameCost)
+
+	appProfile := profile.Programs[1]
+	require.Equal(t, simulation.ProgramKindApproval, appProfile.Kind)
+	require.Equal(t, 1, appProfile.GroupIndex)
+	// 2 callsubs, int 1, return in main, 2 x 5 instructions in double
+	require.Equal(t, 14, appProfile.Total)
+	require.Equal(t, map[string]int{"main": 14, "label1": 10}, appProfile.FrameCost)
+	pcCost := 0
+	for _, cost := range appProfile.PCCost {
+		pcCost += cost
+	}
+	require.Equal(t, appProfile.Total, pcCost)
+
+	// every instruction of double ran twice
+	var multiply int
+	for pc, line := range ops.OffsetToLine {
+		if line == 8 {
+			multiply = pc
+		}
+	}
+	require.Equal(t, 2, appProfile.PCCost[multiply])
+
+	var buf bytes.Buffer
+	err = profile.WritePprof(&buf, simulation.ProgramSource{
+		Program:   prog,
+		SourceMap: logic.GetSourceMap([]string{"approval.teal"}, ops.OffsetToLine),
+		Source:    profiledProgram,
+	})
+	require.NoError(t, err)
+
+	parsed, err := pprofile.Parse(&buf)
+	require.NoError(t, err)
+	require.NoError(t, parsed.CheckValid())
+	require.Len(t, parsed.SampleType, 2)
+	require.Equal(t, "cost", parsed.SampleType[0].Type)
+	require.Equal(t, "opcodes", parsed.SampleType[0].Unit)
+	require.Equal(t, "instructions", parsed.SampleType[1].Type)
+
+	// the samples of each program add up to its total, and start with the
+	// frame of its transaction
+	totals := make(map[string]int64)
+	for _, sample := range parsed.Sample {
+		root := sample.Location[len(sample.Location)-1].Line[0].Function.Name
+		totals[root] += sample.Value[0]
+		require.Positive(t, sample.Value[1])
+	}
+	require.Equal(t, map[string]int64{"txn 1 approval": 14, "txn 2 logicsig": 3}, totals)
+
+	// the multiplication is reported at its source line, in double, which is
+	// called twice from main
+	var multiplyCost, multiplyCount int64
+	for _, sample := range parsed.Sample {
+		leaf := sample.Location[0]
+		if leaf.Line[0].Function.Filename != "approval.teal" || leaf.Line[0].Line != 9 {
+			continue
+		}
+		require.Equal(t, uint64(multiply), leaf.Address)
+		require.Len(t, sample.Location, 3)
+		require.Equal(t, "approval.teal:double", leaf.Line[0].Function.Name)
+		require.Equal(t, "approval.teal:main", sample.Location[1].Line[0].Function.Name)
+		multiplyCost += sample.Value[0]
+		multiplyCount += sample.Value[1]
+	}
+	require.Equal(t, int64(2), multiplyCost)
+	require.Equal(t, int64(2), multiplyCount)
+
+	// the LogicSig source wasn't given, so its disassembly is referred to
+	var disassembly bool
+	for _, fn := range parsed.Function {
+		if fn.Filename == lsigProfile.ProgramID[:16]+".teal.dis" {
+			disassembly = true
+		}
+	}
+	require.True(t, disassembly)
+}
+
+const innerCreatorProgram = `#pragma version 8
+txn ApplicationID
+bz end
+itxn_begin
+int appl
+itxn_field TypeEnum
+byte 0x%[1]x
+itxn_field ApprovalProgram
+byte 0x%[1]x
+itxn_field ClearStateProgram
+itxn_submit
+end:
+int 1
+`
+
+func TestSimulateWithProfileInnerTxns(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l)
+	sender := accounts[0].addr
+
+	innerOps, err := logic.AssembleString("#pragma version 8\nint 1\nint 2\n+\n")
+	require.NoError(t, err, innerOps.Errors)
+	ops, err := logic.AssembleString(fmt.Sprintf(innerCreatorProgram, innerOps.Program))
+	require.NoError(t, err, ops.Errors)
+
+	futureAppID := basics.AppIndex(1)
+	callHeader := makeTxnHeader(sender)
+	// pays for the inner transaction
+	callHeader.Fee.Raw *= 2
+	txgroup := []transactions.SignedTxn{
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.ApplicationCallTx,
+				Header: makeTxnHeader(sender),
+				ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+					ApprovalProgram:   ops.Program,
+					ClearStateProgram: ops.Program,
+				},
+			},
+		},
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.PaymentTx,
+				Header: makeTxnHeader(sender),
+				PaymentTxnFields: transactions.PaymentTxnFields{
+					Receiver: futureAppID.Address(),
+					Amount:   basics.MicroAlgos{Raw: 1000000},
+				},
+			},
+		},
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.ApplicationCallTx,
+				Header: callHeader,
+				ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+					ApplicationID: futureAppID,
+				},
+			},
+		},
+	}
+	require.NoError(t, attachGroupID(txgroup))
+
+	_, _, profile, err := s.SimulateWithProfile(txgroup)
+	require.NoError(t, err)
+	require.Len(t, profile.Programs, 3)
+
+	caller := profile.Programs[1]
+	require.Equal(t, 2, caller.GroupIndex)
+	require.Nil(t, caller.Caller)
+	inner := profile.Programs[2]
+	require.Equal(t, 0, inner.GroupIndex)
+	require.Equal(t, simulation.ProgramKindApproval, inner.Kind)
+	require.Same(t, caller, inner.Caller)
+	require.Equal(t, logic.GetProgramID(innerOps.Program), inner.ProgramID)
+	require.Equal(t, 3, inner.Total)
+	// the inner program is not charged to its caller, for which itxn_submit
+	// costs a single opcode
+	var submit int
+	for pc, line := range ops.OffsetToLine {
+		if line == 10 {
+			submit = pc
+		}
+	}
+	require.Equal(t, 1, caller.PCCost[submit])
+	require.Equal(t, caller.Total, caller.FrameCost["main"])
+
+	var buf bytes.Buffer
+	require.NoError(t, profile.WritePprof(&buf))
+	parsed, err := pprofile.Parse(&buf)
+	require.NoError(t, err)
+	require.NoError(t, parsed.CheckValid())
+	var innerCost int64
+	for _, sample := range parsed.Sample {
+		n := len(sample.Location)
+		if sample.Location[n-1].Line[0].Function.Name != "txn 2 approval" {
+			continue
+		}
+		if n > 1 && sample.Location[n-2].Line[0].Function.Name == "inner txn 0 approval" {
+			innerCost += sample.Value[0]
+		}
+	}
+	require.Equal(t, int64(3), innerCost)
+}
+
+func TestSimulateWithProfileFailure(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	l, accounts, makeTxnHeader := prepareSimulatorTest(t)
+	defer l.Close()
+	s := simulation.MakeSimulator(l)
+	sender := accounts[0].addr
+
+	ops, err := logic.AssembleString("#pragma version 8\nint 1\nint 2\n-\n")
+	require.NoError(t, err, ops.Errors)
+
+	txgroup := []transactions.SignedTxn{
+		{
+			Txn: transactions.Transaction{
+				Type:   protocol.ApplicationCallTx,
+				Header: makeTxnHeader(sender),
+				ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+					ApprovalProgram:   ops.Program,
+					ClearStateProgram: ops.Program,
+				},
+			},
+		},
+	}
+
+	_, _, profile, err := s.SimulateWithProfile(txgroup)
+	var evalErr simulation.EvalFailureError
+	require.ErrorAs(t, err, &evalErr)
+	require.Len(t, profile.Programs, 1)
+	// the failing subtraction is not charged
+	require.Equal(t, 3, profile.Programs[0].Total)
+}
@@ -19,12 +19,15 @@ package simulation
 import (
 	"errors"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/ledger/internal"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/protocol"
 )
@@ -158,10 +161,14 @@ func (s Simulator) check(hdr bookkeeping.BlockHeader, txgroup []transactions.Sig
 	return len(missingSigs) != 0, nil
 }
 
-func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.SignedTxn) (*ledgercore.ValidatedBlock, error) {
-	// s.ledger has 'StartEvaluator' because *data.Ledger is embedded in the simulatorLedger
-	// and data.Ledger embeds *ledger.Ledger
-	eval, err := s.ledger.StartEvaluator(hdr, len(stxns), 0)
+func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.SignedTxn, debugger logic.DebuggerHook) (*ledgercore.ValidatedBlock, error) {
+	eval, err := internal.StartEvaluator(s.ledger, hdr,
+		internal.EvaluatorOptions{
+			PaysetHint: len(stxns),
+			Generate:   true,
+			Validate:   true,
+			Debugger:   debugger,
+		})
 	if err != nil {
 		return nil, err
 	}
@@ -182,8 +189,27 @@ func (s Simulator) evaluate(hdr bookkeeping.BlockHeader, stxns []transactions.Si
 	return vb, nil
 }
 
-// Simulate simulates a transaction group using the simulator. Will error if the transaction group is not well-formed.
-func (s Simulator) Simulate(txgroup []transactions.SignedTxn) (*ledgercore.ValidatedBlock, bool, error) {
+// profileLogicSigs evaluates the LogicSigs of txgroup again with the profiler
+// attached. They have already passed verification in check.
+func (s Simulator) profileLogicSigs(hdr bookkeeping.BlockHeader, txgroup []transactions.SignedTxn, profiler *costProfiler) {
+	proto := config.Consensus[hdr.CurrentProtocol]
+	specials := transactions.SpecialAddresses{FeeSink: hdr.FeeSink, RewardsPool: hdr.RewardsPool}
+	ep := logic.NewEvalParams(transactions.WrapSignedTxnsWithAD(txgroup), &proto, &specials)
+	ep.SigLedger = s.ledger
+	ep.Debugger = profiler
+
+	profiler.logicSig = true
+	defer func() { profiler.logicSig = false }()
+	for i := range txgroup {
+		if txgroup[i].Lsig.Blank() {
+			continue
+		}
+		// errors were already reported by check
+		logic.EvalSignature(i, ep) //nolint:errcheck
+	}
+}
+
+func (s Simulator) simulate(txgroup []transactions.SignedTxn, profiler *costProfiler) (*ledgercore.ValidatedBlock, bool, error) {
 	prevBlockHdr, err := s.ledger.BlockHdr(s.ledger.start)
 	if err != nil {
 		return nil, false, err
@@ -197,6 +223,27 @@ func (s Simulator) Simulate(txgroup []transactions.SignedTxn) (*ledgercore.Valid
 		return nil, false, err
 	}
 
-	vb, err := s.evaluate(hdr, txgroup)
+	var debugger logic.DebuggerHook
+	if profiler != nil {
+		s.profileLogicSigs(hdr, txgroup, profiler)
+		debugger = profiler
+	}
+
+	vb, err := s.evaluate(hdr, txgroup, debugger)
 	return vb, missingSignatures, err
 }
+
+// Simulate simulates a transaction group using the simulator. Will error if the transaction group is not well-formed.
+func (s Simulator) Simulate(txgroup []transactions.SignedTxn) (*ledgercore.ValidatedBlock, bool, error) {
+	return s.simulate(txgroup, nil)
+}
+
+// SimulateWithProfile simulates a transaction group like Simulate, and also
+// returns the opcode cost profile of the programs evaluated for it. The
+// profile is returned even if evaluation fails, covering the programs that
+// ran up to the failure.
+func (s Simulator) SimulateWithProfile(txgroup []transactions.SignedTxn) (*ledgercore.ValidatedBlock, bool, *CostProfile, error) {
+	var profiler costProfiler
+	vb, missingSignatures, err := s.simulate(txgroup, &profiler)
+	return vb, missingSignatures, &profiler.profile, err
+}
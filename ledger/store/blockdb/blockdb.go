@@ -241,6 +241,24 @@ func BlockForgetBefore(tx *sql.Tx, rnd basics.Round) error {
 	return err
 }
 
+// BlockDiskUsage returns the number of bytes used by the blocks database, not counting its free pages
+func BlockDiskUsage(tx *sql.Tx) (int64, error) {
+	var pageCount, freeCount, pageSize int64
+	err := tx.QueryRow("PRAGMA page_count").Scan(&pageCount)
+	if err != nil {
+		return 0, err
+	}
+	err = tx.QueryRow("PRAGMA freelist_count").Scan(&freeCount)
+	if err != nil {
+		return 0, err
+	}
+	err = tx.QueryRow("PRAGMA page_size").Scan(&pageSize)
+	if err != nil {
+		return 0, err
+	}
+	return (pageCount - freeCount) * pageSize, nil
+}
+
 // BlockStartCatchupStaging initializes catchup for catchpoint
 func BlockStartCatchupStaging(tx *sql.Tx, blk bookkeeping.Block) error {
 	// delete the old catchpointblocks table, if there is such.
@@ -147,6 +147,9 @@ type CatchpointFirstStageInfo struct {
 	TotalChunks uint64 `codec:"chunksCount"`
 	// BiggestChunkLen is the size in the bytes of the largest chunk, used when re-packing.
 	BiggestChunkLen uint64 `codec:"biggestChunk"`
+	// FileVersion is the version of the catchpoint data file, zero standing for CatchpointFileVersionV6.
+	// Only set when catchpoint data files are generated.
+	FileVersion uint64 `codec:"fileVersion"`
 }
 
 // NewCatchpointSQLReaderWriter creates a Catchpoint SQL reader+writer
@@ -420,6 +423,11 @@ func (cw *catchpointWriter) WriteCatchpointStagingBalances(ctx context.Context,
 		return err
 	}
 
+	updateAcctStmt, err := cw.e.PrepareContext(ctx, "UPDATE catchpointbalances SET normalizedonlinebalance = ?, data = ? WHERE rowid = ?")
+	if err != nil {
+		return err
+	}
+
 	insertRscStmt, err := cw.e.PrepareContext(ctx, "INSERT INTO catchpointresources(addrid, aidx, data) VALUES(?, ?, ?)")
 	if err != nil {
 		return err
@@ -450,6 +458,14 @@ func (cw *catchpointWriter) WriteCatchpointStagingBalances(ctx context.Context,
 				if err != nil {
 					return err
 				}
+				// the existing record may have been inserted by a partial balance which has no account data, as the
+				// resources of an account could precede the account itself. The complete balance sets the account data.
+				if !balance.PartialBalance {
+					_, err = updateAcctStmt.ExecContext(ctx, balance.NormalizedBalance, balance.EncodedAccountData, rowID)
+					if err != nil {
+						return err
+					}
+				}
 			} else {
 				return err
 			}
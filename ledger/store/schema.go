@@ -210,6 +210,22 @@ func accountsInit(tx *sql.Tx, initAccounts map[basics.Address]basics.AccountData
 				return true, err
 			}
 
+			// assets and applications created at genesis need to be found by their creator
+			for aidx := range data.AssetParams {
+				_, err = tx.Exec("INSERT INTO assetcreators (asset, creator, ctype) VALUES (?, ?, ?)",
+					aidx, addr[:], basics.AssetCreatable)
+				if err != nil {
+					return true, err
+				}
+			}
+			for aidx := range data.AppParams {
+				_, err = tx.Exec("INSERT INTO assetcreators (asset, creator, ctype) VALUES (?, ?, ?)",
+					aidx, addr[:], basics.AppCreatable)
+				if err != nil {
+					return true, err
+				}
+			}
+
 			ad := ledgercore.ToAccountData(data)
 			totals.AddAccount(proto, ad, &ot)
 		}
@@ -348,6 +364,17 @@ func accountsCreateBoxTable(ctx context.Context, tx *sql.Tx) error {
 	return nil
 }
 
+// accountsInitBoxes writes the genesis key/value pairs into the kvstore table.
+func accountsInitBoxes(tx *sql.Tx, initBoxes map[string][]byte) error {
+	for key, value := range initBoxes {
+		_, err := tx.Exec("INSERT INTO kvstore (key, value) VALUES (?, ?)", []byte(key), value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func accountsCreateTxTailTable(ctx context.Context, tx *sql.Tx) (err error) {
 	for _, stmt := range createTxTailTable {
 		_, err = tx.ExecContext(ctx, stmt)
@@ -39,6 +39,7 @@ import (
 // TrackerDBParams contains parameters for initializing trackerDB
 type TrackerDBParams struct {
 	InitAccounts      map[basics.Address]basics.AccountData
+	InitBoxes         map[string][]byte
 	InitProto         protocol.ConsensusVersion
 	GenesisHash       crypto.Digest
 	FromCatchpoint    bool
@@ -486,12 +487,19 @@ func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema6(ctx context.Context
 }
 
 // upgradeDatabaseSchema7 upgrades the database schema from version 7 to version 8.
-// adding the kvstore table for box feature support.
+// adding the kvstore table for box feature support. A new database gets the genesis
+// boxes written into it.
 func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema7(ctx context.Context, tx *sql.Tx) (err error) {
 	err = accountsCreateBoxTable(ctx, tx)
 	if err != nil {
 		return fmt.Errorf("upgradeDatabaseSchema7 unable to create kvstore through createTables : %v", err)
 	}
+	if tu.newDatabase {
+		err = accountsInitBoxes(tx, tu.InitBoxes)
+		if err != nil {
+			return fmt.Errorf("upgradeDatabaseSchema7 unable to initialize genesis boxes : %v", err)
+		}
+	}
 	return tu.setVersion(ctx, tx, 8)
 }
 
@@ -44,6 +44,7 @@ func trackerDBInitialize(l ledgerForTracker, catchpointEnabled bool, dbPathPrefi
 
 		tp := store.TrackerDBParams{
 			InitAccounts:      l.GenesisAccounts(),
+			InitBoxes:         l.GenesisBoxes(),
 			InitProto:         l.GenesisProtoVersion(),
 			GenesisHash:       l.GenesisHash(),
 			FromCatchpoint:    false,
@@ -0,0 +1,601 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package libgoal
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/avm-abi/abi"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// TransactionSigner authorizes the transactions of a group composed by an
+// AtomicTransactionComposer.
+type TransactionSigner interface {
+	// SignTransaction returns the transaction with its authorization. The
+	// transaction must not be modified.
+	SignTransaction(txn transactions.Transaction) (transactions.SignedTxn, error)
+}
+
+// AccountSigner signs transactions with keys held in memory. The keys may be
+// the ones of the account the sender was rekeyed to.
+type AccountSigner struct {
+	Secrets *crypto.SignatureSecrets
+}
+
+// SignTransaction implements TransactionSigner.
+func (s AccountSigner) SignTransaction(txn transactions.Transaction) (transactions.SignedTxn, error) {
+	return txn.Sign(s.Secrets), nil
+}
+
+// WalletSigner signs transactions with the keys of a kmd wallet. AuthAddr is
+// the address the sender was rekeyed to, if any.
+type WalletSigner struct {
+	Client       *Client
+	WalletHandle []byte
+	Password     []byte
+	AuthAddr     string
+}
+
+// SignTransaction implements TransactionSigner.
+func (s WalletSigner) SignTransaction(txn transactions.Transaction) (transactions.SignedTxn, error) {
+	return s.Client.SignTransactionWithWalletAndSigner(s.WalletHandle, s.Password, s.AuthAddr, txn)
+}
+
+// LogicSigSigner authorizes transactions with a logic signature, which is
+// either the one of a contract account or a delegated one. AuthAddr is the
+// address the sender was rekeyed to, if any.
+type LogicSigSigner struct {
+	Lsig     transactions.LogicSig
+	AuthAddr basics.Address
+}
+
+// SignTransaction implements TransactionSigner.
+func (s LogicSigSigner) SignTransaction(txn transactions.Transaction) (transactions.SignedTxn, error) {
+	stxn := transactions.SignedTxn{Txn: txn, Lsig: s.Lsig}
+	if !s.AuthAddr.IsZero() && s.AuthAddr != txn.Sender {
+		stxn.AuthAddr = s.AuthAddr
+	}
+	return stxn, nil
+}
+
+// MultisigSigner signs transactions of a multisig account, or of an account
+// rekeyed to a multisig account, with the keys of a kmd wallet. The multisig
+// account is given by its preimage, it does not need to be imported in the
+// wallet. Signers are the addresses of the wallet keys that sign, which must
+// be part of the preimage.
+type MultisigSigner struct {
+	Client       *Client
+	WalletHandle []byte
+	Password     []byte
+	Version      uint8
+	Threshold    uint8
+	PublicKeys   []crypto.PublicKey
+	Signers      []string
+}
+
+// SignTransaction implements TransactionSigner.
+func (s MultisigSigner) SignTransaction(txn transactions.Transaction) (transactions.SignedTxn, error) {
+	msigAddr, err := crypto.MultisigAddrGen(s.Version, s.Threshold, s.PublicKeys)
+	if err != nil {
+		return transactions.SignedTxn{}, err
+	}
+	if len(s.Signers) < int(s.Threshold) {
+		return transactions.SignedTxn{}, fmt.Errorf("%d signers cannot reach the multisig threshold %d", len(s.Signers), s.Threshold)
+	}
+
+	msig := crypto.MultisigPreimageFromPKs(s.Version, s.Threshold, s.PublicKeys)
+	for _, signer := range s.Signers {
+		msig, err = s.Client.MultisigSignTransactionWithWalletAndSigner(s.WalletHandle, s.Password, txn, signer, msig, basics.Address(msigAddr).String())
+		if err != nil {
+			return transactions.SignedTxn{}, err
+		}
+	}
+
+	stxn := transactions.SignedTxn{Txn: txn, Msig: msig}
+	if basics.Address(msigAddr) != txn.Sender {
+		stxn.AuthAddr = basics.Address(msigAddr)
+	}
+	return stxn, nil
+}
+
+// TransactionWithSigner is a transaction of a group composed by an
+// AtomicTransactionComposer, along with its signer.
+type TransactionWithSigner struct {
+	Txn    transactions.Transaction
+	Signer TransactionSigner
+}
+
+// MethodCallParams describes an ARC-4 ABI method call.
+type MethodCallParams struct {
+	// AppID is the application to call, or zero to create one.
+	AppID uint64
+	// Method is the signature of the method, e.g. "add(uint64,uint64)uint64".
+	Method string
+	// Args are the arguments of the method. A transaction argument is a
+	// TransactionWithSigner, which is added to the group before the call. An
+	// account reference is an address string, an application or asset
+	// reference is an uint64 id. The other arguments are values accepted by
+	// abi.Type.Encode.
+	Args []interface{}
+
+	Sender       string
+	Signer       TransactionSigner
+	OnCompletion transactions.OnCompletion
+
+	// FirstValid, LastValid and Fee are filled in as FillUnsignedTxTemplate does
+	// when they are zero.
+	FirstValid uint64
+	LastValid  uint64
+	Fee        uint64
+	Note       []byte
+	Lease      [32]byte
+
+	// The references of the call, to which the reference arguments are added.
+	Accounts      []string
+	ForeignApps   []uint64
+	ForeignAssets []uint64
+	Boxes         []transactions.BoxRef
+
+	// The programs and schemas of an application being created or updated.
+	ApprovalProg []byte
+	ClearProg    []byte
+	GlobalSchema basics.StateSchema
+	LocalSchema  basics.StateSchema
+	ExtraPages   uint32
+}
+
+// ABIMethodResult is the outcome of a method call executed by an
+// AtomicTransactionComposer.
+type ABIMethodResult struct {
+	TxID   string
+	Method string
+	// TxInfo is the confirmed application call transaction.
+	TxInfo model.PendingTransactionResponse
+	// RawReturnValue is the ABI encoded return value, and ReturnValue the
+	// decoded one. Both are nil for a void method.
+	RawReturnValue []byte
+	ReturnValue    interface{}
+	// DecodeError is set when the return value cannot be found or decoded.
+	DecodeError error
+}
+
+// ExecuteResult is the outcome of a group executed by an AtomicTransactionComposer.
+type ExecuteResult struct {
+	ConfirmedRound uint64
+	TxIDs          []string
+	MethodResults  []ABIMethodResult
+}
+
+// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
+var abiReturnPrefix = []byte{0x15, 0x1f, 0x7c, 0x75}
+
+// maxMethodAppArgs is the number of application arguments of an ARC-4 method
+// call. The first one is the method selector, arguments after the 14th are
+// encoded as a tuple in the last one.
+const maxMethodAppArgs = 16
+
+const methodArgsTupleThreshold = maxMethodAppArgs - 2
+
+var errComposerBuilt = errors.New("the transaction group has already been built")
+
+type composedMethodCall struct {
+	method  string
+	retType *abi.Type
+}
+
+// AtomicTransactionComposer collects the transactions and ABI method calls of
+// a group, along with the signer of each transaction, and assigns the group
+// ID, signs, submits and waits for the group. Once the group is built, no
+// transaction can be added to it.
+type AtomicTransactionComposer struct {
+	client      *Client
+	txns        []TransactionWithSigner
+	methodCalls map[int]composedMethodCall
+	built       bool
+	signed      []transactions.SignedTxn
+	submitted   bool
+}
+
+// MakeAtomicTransactionComposer returns an empty composer submitting its group
+// through the client.
+func (c *Client) MakeAtomicTransactionComposer() *AtomicTransactionComposer {
+	return &AtomicTransactionComposer{
+		client:      c,
+		methodCalls: make(map[int]composedMethodCall),
+	}
+}
+
+// Count returns the number of transactions of the group.
+func (atc *AtomicTransactionComposer) Count() int {
+	return len(atc.txns)
+}
+
+func (atc *AtomicTransactionComposer) checkAdd(txns []TransactionWithSigner) error {
+	if atc.built {
+		return errComposerBuilt
+	}
+	if len(atc.txns)+len(txns) > config.MaxTxGroupSize {
+		return fmt.Errorf("the transaction group cannot have more than %d transactions", config.MaxTxGroupSize)
+	}
+	for _, tws := range txns {
+		if !tws.Txn.Group.IsZero() {
+			return fmt.Errorf("transaction %s already has a group ID", tws.Txn.ID())
+		}
+		if tws.Signer == nil {
+			return fmt.Errorf("transaction %s has no signer", tws.Txn.ID())
+		}
+	}
+	return nil
+}
+
+// AddTransaction adds a transaction to the group.
+func (atc *AtomicTransactionComposer) AddTransaction(tws TransactionWithSigner) error {
+	err := atc.checkAdd([]TransactionWithSigner{tws})
+	if err != nil {
+		return err
+	}
+	atc.txns = append(atc.txns, tws)
+	return nil
+}
+
+// AddMethodCall adds an ABI method call to the group, preceded by its
+// transaction arguments.
+func (atc *AtomicTransactionComposer) AddMethodCall(params MethodCallParams) error {
+	if atc.built {
+		return errComposerBuilt
+	}
+	if params.Signer == nil {
+		return fmt.Errorf("method call %s has no signer", params.Method)
+	}
+
+	appArgs, txnArgs, retType, err := encodeMethodCall(&params)
+	if err != nil {
+		return err
+	}
+	err = atc.checkAdd(txnArgs)
+	if err != nil {
+		return err
+	}
+	if len(atc.txns)+len(txnArgs)+1 > config.MaxTxGroupSize {
+		return fmt.Errorf("the transaction group cannot have more than %d transactions", config.MaxTxGroupSize)
+	}
+
+	tx, err := atc.client.MakeUnsignedApplicationCallTx(params.AppID, appArgs, params.Accounts, params.ForeignApps, params.ForeignAssets, params.Boxes,
+		params.OnCompletion, params.ApprovalProg, params.ClearProg, params.GlobalSchema, params.LocalSchema, params.ExtraPages)
+	if err != nil {
+		return err
+	}
+	tx.Note = params.Note
+	tx.Lease = params.Lease
+	tx, err = atc.client.FillUnsignedTxTemplate(params.Sender, params.FirstValid, params.LastValid, params.Fee, tx)
+	if err != nil {
+		return err
+	}
+
+	atc.txns = append(atc.txns, txnArgs...)
+	atc.methodCalls[len(atc.txns)] = composedMethodCall{method: params.Method, retType: retType}
+	atc.txns = append(atc.txns, TransactionWithSigner{Txn: tx, Signer: params.Signer})
+	return nil
+}
+
+// encodeMethodCall returns the application arguments of a method call and its
+// transaction arguments. The reference arguments are added to the references
+// of params, which are copied first.
+func encodeMethodCall(params *MethodCallParams) (appArgs [][]byte, txnArgs []TransactionWithSigner, retType *abi.Type, err error) {
+	_, argTypes, retTypeStr, err := abi.ParseMethodSignature(params.Method)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("cannot parse method signature %s: %w", params.Method, err)
+	}
+	if len(params.Args) != len(argTypes) {
+		return nil, nil, nil, fmt.Errorf("incorrect number of arguments, method %s expected %d but got %d", params.Method, len(argTypes), len(params.Args))
+	}
+	if retTypeStr != abi.VoidReturnType {
+		theRetType, err := abi.TypeOf(retTypeStr)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("cannot cast %s to abi type: %w", retTypeStr, err)
+		}
+		retType = &theRetType
+	}
+
+	params.Accounts = append([]string(nil), params.Accounts...)
+	params.ForeignApps = append([]uint64(nil), params.ForeignApps...)
+	params.ForeignAssets = append([]uint64(nil), params.ForeignAssets...)
+
+	uint8Type, err := abi.TypeOf("uint8")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var basicTypes []abi.Type
+	var basicValues []interface{}
+	for i, argType := range argTypes {
+		arg := params.Args[i]
+		switch {
+		case abi.IsTransactionType(argType):
+			tws, ok := arg.(TransactionWithSigner)
+			if !ok {
+				return nil, nil, nil, fmt.Errorf("argument %d of method %s must be a TransactionWithSigner", i, params.Method)
+			}
+			if argType != abi.AnyTransactionType && tws.Txn.Type != protocol.TxType(argType) {
+				return nil, nil, nil, fmt.Errorf("argument %d of method %s does not match the method argument type. Expected %s, got %s", i, params.Method, argType, tws.Txn.Type)
+			}
+			txnArgs = append(txnArgs, tws)
+		case abi.IsReferenceType(argType):
+			index, err := resolveMethodReferenceArg(params, argType, arg)
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("argument %d of method %s: %w", i, params.Method, err)
+			}
+			// the reference is encoded as its index in the foreign array
+			basicTypes = append(basicTypes, uint8Type)
+			basicValues = append(basicValues, uint8(index))
+		default:
+			abiType, err := abi.TypeOf(argType)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			basicTypes = append(basicTypes, abiType)
+			basicValues = append(basicValues, arg)
+		}
+	}
+
+	// Up to 16 app arguments can be passed to an app call. The first is the
+	// method selector, and if more than 15 method arguments are present, the
+	// arguments after the 14th are placed in a tuple in the last one.
+	if len(basicTypes) > maxMethodAppArgs-1 {
+		tupleType, err := abi.MakeTupleType(basicTypes[methodArgsTupleThreshold:])
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		tupleValue := append([]interface{}(nil), basicValues[methodArgsTupleThreshold:]...)
+		basicTypes = append(basicTypes[:methodArgsTupleThreshold:methodArgsTupleThreshold], tupleType)
+		basicValues = append(basicValues[:methodArgsTupleThreshold:methodArgsTupleThreshold], tupleValue)
+	}
+
+	selector := sha512.Sum512_256([]byte(params.Method))
+	appArgs = append(appArgs, selector[0:4])
+	for i, abiType := range basicTypes {
+		encoded, err := abiType.Encode(basicValues[i])
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("cannot encode argument of method %s as %s: %w", params.Method, abiType, err)
+		}
+		appArgs = append(appArgs, encoded)
+	}
+	return appArgs, txnArgs, retType, nil
+}
+
+// resolveMethodReferenceArg returns the index of a reference argument in its
+// foreign array, adding it if it is not there yet. The sender and the called
+// application are referred to by index 0.
+func resolveMethodReferenceArg(params *MethodCallParams, argType string, arg interface{}) (int, error) {
+	switch argType {
+	case abi.AccountReferenceType:
+		addr, ok := arg.(string)
+		if !ok {
+			return 0, fmt.Errorf("an account reference must be an address string")
+		}
+		if addr == params.Sender {
+			return 0, nil
+		}
+		for i, account := range params.Accounts {
+			if account == addr {
+				return i + 1, nil
+			}
+		}
+		params.Accounts = append(params.Accounts, addr)
+		return len(params.Accounts), nil
+	case abi.ApplicationReferenceType:
+		appID, ok := arg.(uint64)
+		if !ok {
+			return 0, fmt.Errorf("an application reference must be an uint64 id")
+		}
+		if appID == params.AppID {
+			return 0, nil
+		}
+		for i, app := range params.ForeignApps {
+			if app == appID {
+				return i + 1, nil
+			}
+		}
+		params.ForeignApps = append(params.ForeignApps, appID)
+		return len(params.ForeignApps), nil
+	case abi.AssetReferenceType:
+		assetID, ok := arg.(uint64)
+		if !ok {
+			return 0, fmt.Errorf("an asset reference must be an uint64 id")
+		}
+		for i, asset := range params.ForeignAssets {
+			if asset == assetID {
+				return i, nil
+			}
+		}
+		params.ForeignAssets = append(params.ForeignAssets, assetID)
+		return len(params.ForeignAssets) - 1, nil
+	default:
+		return 0, fmt.Errorf("unknown reference type: %s", argType)
+	}
+}
+
+// BuildGroup assigns the group ID to the transactions, if there are several,
+// and returns them. No transaction can be added afterwards.
+func (atc *AtomicTransactionComposer) BuildGroup() ([]TransactionWithSigner, error) {
+	if !atc.built {
+		if len(atc.txns) == 0 {
+			return nil, errors.New("the transaction group is empty")
+		}
+		if len(atc.txns) > 1 {
+			txgroup := make([]transactions.Transaction, len(atc.txns))
+			for i, tws := range atc.txns {
+				txgroup[i] = tws.Txn
+			}
+			gid, err := atc.client.GroupID(txgroup)
+			if err != nil {
+				return nil, err
+			}
+			for i := range atc.txns {
+				atc.txns[i].Txn.Group = gid
+			}
+		}
+		atc.built = true
+	}
+	return append([]TransactionWithSigner(nil), atc.txns...), nil
+}
+
+// GatherSignatures builds the group and signs its transactions with their
+// signers.
+func (atc *AtomicTransactionComposer) GatherSignatures() ([]transactions.SignedTxn, error) {
+	if atc.signed != nil {
+		return append([]transactions.SignedTxn(nil), atc.signed...), nil
+	}
+	txns, err := atc.BuildGroup()
+	if err != nil {
+		return nil, err
+	}
+
+	signed := make([]transactions.SignedTxn, len(txns))
+	for i, tws := range txns {
+		signed[i], err = tws.Signer.SignTransaction(tws.Txn)
+		if err != nil {
+			return nil, fmt.Errorf("cannot sign transaction %d of the group: %w", i, err)
+		}
+		if signed[i].ID() != tws.Txn.ID() {
+			return nil, fmt.Errorf("the signer of transaction %d of the group modified it", i)
+		}
+	}
+	atc.signed = signed
+	return append([]transactions.SignedTxn(nil), signed...), nil
+}
+
+// Submit signs the group and broadcasts it, and returns the IDs of its
+// transactions. A group can only be submitted once.
+func (atc *AtomicTransactionComposer) Submit() ([]string, error) {
+	if atc.submitted {
+		return nil, errors.New("the transaction group has already been submitted")
+	}
+	signed, err := atc.GatherSignatures()
+	if err != nil {
+		return nil, err
+	}
+	err = atc.client.BroadcastTransactionGroup(signed)
+	if err != nil {
+		return nil, err
+	}
+	atc.submitted = true
+
+	txids := make([]string, len(signed))
+	for i := range signed {
+		txids[i] = signed[i].ID().String()
+	}
+	return txids, nil
+}
+
+// Execute submits the group, waits for it to be confirmed or to expire, and
+// returns the results of its method calls.
+func (atc *AtomicTransactionComposer) Execute() (ExecuteResult, error) {
+	txids, err := atc.Submit()
+	if err != nil {
+		return ExecuteResult{}, err
+	}
+
+	// the group expires with its first transaction to expire
+	lastValid := atc.txns[0].Txn.LastValid
+	for _, tws := range atc.txns {
+		if tws.Txn.LastValid < lastValid {
+			lastValid = tws.Txn.LastValid
+		}
+	}
+	txn, err := atc.client.waitForConfirmation(txids[0], uint64(lastValid))
+	if err != nil {
+		return ExecuteResult{}, err
+	}
+
+	result := ExecuteResult{
+		ConfirmedRound: *txn.ConfirmedRound,
+		TxIDs:          txids,
+	}
+	for i, txid := range txids {
+		call, ok := atc.methodCalls[i]
+		if !ok {
+			continue
+		}
+		methodResult := ABIMethodResult{TxID: txid, Method: call.method}
+		methodResult.TxInfo, err = atc.client.PendingTransactionInformation(txid)
+		if err != nil {
+			return result, err
+		}
+		var logs [][]byte
+		if methodResult.TxInfo.Logs != nil {
+			logs = *methodResult.TxInfo.Logs
+		}
+		methodResult.RawReturnValue, methodResult.ReturnValue, methodResult.DecodeError = decodeMethodReturn(call.retType, logs)
+		result.MethodResults = append(result.MethodResults, methodResult)
+	}
+	return result, nil
+}
+
+// decodeMethodReturn finds the return value of a method call in the last log
+// of the call and decodes it.
+func decodeMethodReturn(retType *abi.Type, logs [][]byte) (raw []byte, value interface{}, err error) {
+	if retType == nil {
+		return nil, nil, nil
+	}
+	if len(logs) == 0 || !bytes.HasPrefix(logs[len(logs)-1], abiReturnPrefix) {
+		return nil, nil, errors.New("the method call did not log a return value")
+	}
+	raw = logs[len(logs)-1][len(abiReturnPrefix):]
+	value, err = retType.Decode(raw)
+	if err != nil {
+		return raw, nil, fmt.Errorf("cannot decode the return value as %s: %w", retType, err)
+	}
+	return raw, value, nil
+}
+
+// waitForConfirmation waits for a transaction to be confirmed, and fails once
+// the transaction is removed from the pool or the ledger reaches lastValid.
+func (c *Client) waitForConfirmation(txid string, lastValid uint64) (txn model.PendingTransactionResponse, err error) {
+	stat, err := c.Status()
+	if err != nil {
+		return
+	}
+	for {
+		txn, err = c.PendingTransactionInformation(txid)
+		if err != nil {
+			return
+		}
+		if txn.ConfirmedRound != nil && *txn.ConfirmedRound > 0 {
+			return txn, nil
+		}
+		if txn.PoolError != "" {
+			return txn, fmt.Errorf("transaction %s was rejected: %s", txid, txn.PoolError)
+		}
+		if lastValid > 0 && stat.LastRound >= lastValid {
+			return txn, fmt.Errorf("transaction %s expired before being confirmed", txid)
+		}
+		stat, err = c.WaitForRound(stat.LastRound)
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,428 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package libgoal
+
+import (
+	"crypto/sha512"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/algorand/avm-abi/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/tokens"
+)
+
+func composerTestAccount(seed byte) *crypto.SignatureSecrets {
+	var s crypto.Seed
+	s[0] = seed
+	return crypto.GenerateSignatureSecrets(s)
+}
+
+func composerTestPayment(from, to basics.Address, amount uint64) transactions.Transaction {
+	return transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     from,
+			Fee:        basics.MicroAlgos{Raw: 1000},
+			FirstValid: 1,
+			LastValid:  1000,
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: to,
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+func TestEncodeMethodCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	sender := basics.Address(composerTestAccount(1).SignatureVerifier)
+	other := basics.Address(composerTestAccount(2).SignatureVerifier)
+	pay := TransactionWithSigner{
+		Txn:    composerTestPayment(sender, other, 5),
+		Signer: AccountSigner{Secrets: composerTestAccount(1)},
+	}
+
+	accounts := []string{other.String()}
+	params := MethodCallParams{
+		AppID:    7,
+		Method:   "f(uint64,pay,account,account,application,application,asset,asset,string)bool",
+		Args:     []interface{}{uint64(42), pay, sender.String(), other.String(), uint64(7), uint64(8), uint64(9), uint64(9), "hi"},
+		Sender:   sender.String(),
+		Accounts: accounts,
+	}
+	appArgs, txnArgs, retType, err := encodeMethodCall(&params)
+	require.NoError(t, err)
+
+	selector := sha512.Sum512_256([]byte(params.Method))
+	require.Equal(t, [][]byte{
+		selector[:4],
+		{0, 0, 0, 0, 0, 0, 0, 42},
+		{0}, // the sender
+		{1}, // already in the accounts
+		{0}, // the called application
+		{1},
+		{0},
+		{0}, // deduplicated
+		{0, 2, 'h', 'i'},
+	}, appArgs)
+	require.Equal(t, []TransactionWithSigner{pay}, txnArgs)
+	require.Equal(t, "bool", retType.String())
+	require.Equal(t, []uint64{8}, params.ForeignApps)
+	require.Equal(t, []uint64{9}, params.ForeignAssets)
+	// the references of the caller are not modified
+	params.Accounts[0] = sender.String()
+	require.Equal(t, other.String(), accounts[0])
+
+	// the arguments after the 14th are encoded in a tuple
+	params = MethodCallParams{
+		Method: "g(uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8,uint8)void",
+		Args:   make([]interface{}, 16),
+	}
+	for i := range params.Args {
+		params.Args[i] = uint8(i)
+	}
+	appArgs, txnArgs, retType, err = encodeMethodCall(&params)
+	require.NoError(t, err)
+	require.Len(t, appArgs, maxMethodAppArgs)
+	require.Equal(t, []byte{13}, appArgs[14])
+	require.Equal(t, []byte{14, 15}, appArgs[15])
+	require.Empty(t, txnArgs)
+	require.Nil(t, retType)
+
+	// wrong arguments
+	params = MethodCallParams{Method: "h(uint64,axfer)void", Args: []interface{}{uint64(1)}}
+	_, _, _, err = encodeMethodCall(&params)
+	require.ErrorContains(t, err, "incorrect number of arguments")
+	params.Args = []interface{}{uint64(1), pay}
+	_, _, _, err = encodeMethodCall(&params)
+	require.ErrorContains(t, err, "does not match the method argument type")
+	params.Args = []interface{}{uint64(1), pay.Txn}
+	_, _, _, err = encodeMethodCall(&params)
+	require.ErrorContains(t, err, "must be a TransactionWithSigner")
+	params = MethodCallParams{Method: "h(account)void", Args: []interface{}{uint64(1)}}
+	_, _, _, err = encodeMethodCall(&params)
+	require.ErrorContains(t, err, "must be an address string")
+}
+
+func TestComposerSignatures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	alice, bob := composerTestAccount(1), composerTestAccount(2)
+	aliceAddr, bobAddr := basics.Address(alice.SignatureVerifier), basics.Address(bob.SignatureVerifier)
+	program := []byte{0x06, 0x81, 0x01} // #pragma version 6; int 1
+	escrow := basics.Address(logic.HashProgram(program))
+
+	var c Client
+	atc := c.MakeAtomicTransactionComposer()
+	_, err := atc.BuildGroup()
+	require.Error(t, err)
+
+	txns := []TransactionWithSigner{
+		{Txn: composerTestPayment(aliceAddr, bobAddr, 1), Signer: AccountSigner{Secrets: alice}},
+		// alice is rekeyed to bob
+		{Txn: composerTestPayment(aliceAddr, bobAddr, 2), Signer: AccountSigner{Secrets: bob}},
+		{Txn: composerTestPayment(escrow, bobAddr, 3), Signer: LogicSigSigner{Lsig: transactions.LogicSig{Logic: program}}},
+		// bob is rekeyed to the escrow
+		{Txn: composerTestPayment(bobAddr, aliceAddr, 4), Signer: LogicSigSigner{Lsig: transactions.LogicSig{Logic: program}, AuthAddr: escrow}},
+	}
+	for _, tws := range txns {
+		require.NoError(t, atc.AddTransaction(tws))
+	}
+	require.Equal(t, len(txns), atc.Count())
+	require.ErrorContains(t, atc.AddTransaction(TransactionWithSigner{Txn: composerTestPayment(aliceAddr, bobAddr, 5)}), "has no signer")
+
+	signed, err := atc.GatherSignatures()
+	require.NoError(t, err)
+	require.Len(t, signed, len(txns))
+	require.ErrorIs(t, atc.AddTransaction(txns[0]), errComposerBuilt)
+
+	var group transactions.TxGroup
+	for _, tws := range txns {
+		group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(tws.Txn.ID()))
+	}
+	gid := crypto.HashObj(group)
+	for i, stxn := range signed {
+		require.Equal(t, gid, stxn.Txn.Group)
+		txn := txns[i].Txn
+		txn.Group = gid
+		require.Equal(t, txn, stxn.Txn)
+	}
+	require.True(t, alice.SignatureVerifier.Verify(signed[0].Txn, signed[0].Sig))
+	require.True(t, signed[0].AuthAddr.IsZero())
+	require.True(t, bob.SignatureVerifier.Verify(signed[1].Txn, signed[1].Sig))
+	require.Equal(t, bobAddr, signed[1].AuthAddr)
+	require.Equal(t, program, signed[2].Lsig.Logic)
+	require.True(t, signed[2].AuthAddr.IsZero())
+	require.Equal(t, escrow, signed[3].AuthAddr)
+
+	// a single transaction does not get a group ID
+	atc = c.MakeAtomicTransactionComposer()
+	require.NoError(t, atc.AddTransaction(txns[0]))
+	signed, err = atc.GatherSignatures()
+	require.NoError(t, err)
+	require.True(t, signed[0].Txn.Group.IsZero())
+
+	// a transaction which already belongs to a group cannot be added
+	atc = c.MakeAtomicTransactionComposer()
+	grouped := txns[0]
+	grouped.Txn.Group = gid
+	require.ErrorContains(t, atc.AddTransaction(grouped), "already has a group ID")
+}
+
+func TestDecodeMethodReturn(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	uint64Type, err := abi.TypeOf("uint64")
+	require.NoError(t, err)
+
+	raw, value, err := decodeMethodReturn(nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, raw)
+	require.Nil(t, value)
+
+	ret := []byte{0, 0, 0, 0, 0, 0, 1, 0}
+	logs := [][]byte{[]byte("other"), append(append([]byte(nil), abiReturnPrefix...), ret...)}
+	raw, value, err = decodeMethodReturn(&uint64Type, logs)
+	require.NoError(t, err)
+	require.Equal(t, ret, raw)
+	require.Equal(t, uint64(256), value)
+
+	_, _, err = decodeMethodReturn(&uint64Type, logs[:1])
+	require.ErrorContains(t, err, "did not log a return value")
+	_, _, err = decodeMethodReturn(&uint64Type, nil)
+	require.ErrorContains(t, err, "did not log a return value")
+
+	raw, _, err = decodeMethodReturn(&uint64Type, [][]byte{append(append([]byte(nil), abiReturnPrefix...), 1)})
+	require.ErrorContains(t, err, "cannot decode the return value")
+	require.Equal(t, []byte{1}, raw)
+}
+
+// composerTestAlgod serves the algod endpoints used to compose, submit and wait
+// for a group. Every wait for a block advances the ledger by one round. The
+// submitted group is confirmed once the ledger reaches confirmRound, if it is
+// not zero, and is rejected if poolError is set.
+type composerTestAlgod struct {
+	mu           sync.Mutex
+	round        uint64
+	confirmRound uint64
+	poolError    string
+	logs         [][]byte
+	submitted    []transactions.SignedTxn
+}
+
+func (a *composerTestAlgod) status() model.NodeStatusResponse {
+	return model.NodeStatusResponse{LastRound: a.round}
+}
+
+func (a *composerTestAlgod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var response interface{}
+	switch {
+	case r.URL.Path == "/v2/status":
+		response = a.status()
+	case strings.HasPrefix(r.URL.Path, "/v2/status/wait-for-block-after/"):
+		a.round++
+		response = a.status()
+	case r.URL.Path == "/v2/transactions/params":
+		response = model.TransactionParametersResponse{
+			ConsensusVersion: string(protocol.ConsensusCurrentVersion),
+			LastRound:        a.round,
+			MinFee:           1000,
+		}
+	case r.URL.Path == "/v2/transactions" && r.Method == http.MethodPost:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		dec := protocol.NewDecoderBytes(body)
+		for {
+			var stxn transactions.SignedTxn
+			err = dec.Decode(&stxn)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			a.submitted = append(a.submitted, stxn)
+		}
+		response = model.PostTransactionsResponse{TxId: a.submitted[0].ID().String()}
+	case strings.HasPrefix(r.URL.Path, "/v2/transactions/pending/"):
+		txn := model.PendingTransactionResponse{PoolError: a.poolError}
+		if a.confirmRound != 0 && a.round >= a.confirmRound {
+			confirmed := a.confirmRound
+			txn.ConfirmedRound = &confirmed
+			txn.Logs = &a.logs
+		}
+		response = txn
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// composerTestClient returns a client whose data directory points to algod.
+func composerTestClient(t *testing.T, algod *composerTestAlgod) *Client {
+	server := httptest.NewServer(algod)
+	t.Cleanup(server.Close)
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	dataDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "algod.net"), []byte(serverURL.Host), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, tokens.AlgodTokenFilename), []byte(strings.Repeat("a", 64)), 0600))
+	c, err := MakeClientWithBinDir(dataDir, dataDir, dataDir, DynamicClient)
+	require.NoError(t, err)
+	return &c
+}
+
+func TestComposerExecute(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	alice, bob := composerTestAccount(1), composerTestAccount(2)
+	aliceAddr, bobAddr := basics.Address(alice.SignatureVerifier), basics.Address(bob.SignatureVerifier)
+	ret := []byte{0, 0, 0, 0, 0, 0, 0, 3}
+	algod := &composerTestAlgod{
+		round:        10,
+		confirmRound: 12,
+		logs:         [][]byte{append(append([]byte(nil), abiReturnPrefix...), ret...)},
+	}
+	c := composerTestClient(t, algod)
+
+	atc := c.MakeAtomicTransactionComposer()
+	require.NoError(t, atc.AddTransaction(TransactionWithSigner{Txn: composerTestPayment(aliceAddr, bobAddr, 1), Signer: AccountSigner{Secrets: alice}}))
+	require.NoError(t, atc.AddMethodCall(MethodCallParams{
+		AppID:  7,
+		Method: "add(uint64,uint64)uint64",
+		Args:   []interface{}{uint64(1), uint64(2)},
+		Sender: aliceAddr.String(),
+		Signer: AccountSigner{Secrets: alice},
+	}))
+
+	result, err := atc.Execute()
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), result.ConfirmedRound)
+	require.Len(t, result.TxIDs, 2)
+	require.Len(t, algod.submitted, 2)
+	for i, stxn := range algod.submitted {
+		require.Equal(t, stxn.ID().String(), result.TxIDs[i])
+		require.False(t, stxn.Txn.Group.IsZero())
+		require.True(t, alice.SignatureVerifier.Verify(stxn.Txn, stxn.Sig))
+	}
+	// the validity of the call starts after the round of the suggested params
+	require.Equal(t, basics.Round(11), algod.submitted[1].Txn.FirstValid)
+
+	require.Len(t, result.MethodResults, 1)
+	methodResult := result.MethodResults[0]
+	require.Equal(t, result.TxIDs[1], methodResult.TxID)
+	require.Equal(t, "add(uint64,uint64)uint64", methodResult.Method)
+	require.NoError(t, methodResult.DecodeError)
+	require.Equal(t, ret, methodResult.RawReturnValue)
+	require.Equal(t, uint64(3), methodResult.ReturnValue)
+
+	_, err = atc.Execute()
+	require.ErrorContains(t, err, "already been submitted")
+	require.Len(t, algod.submitted, 2)
+}
+
+func TestComposerExecuteFailures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	alice, bob := composerTestAccount(1), composerTestAccount(2)
+	aliceAddr, bobAddr := basics.Address(alice.SignatureVerifier), basics.Address(bob.SignatureVerifier)
+	pay := TransactionWithSigner{Txn: composerTestPayment(aliceAddr, bobAddr, 1), Signer: AccountSigner{Secrets: alice}}
+
+	// the group is removed from the pool
+	algod := &composerTestAlgod{round: 10, poolError: "overspend"}
+	atc := composerTestClient(t, algod).MakeAtomicTransactionComposer()
+	require.NoError(t, atc.AddTransaction(pay))
+	_, err := atc.Execute()
+	require.ErrorContains(t, err, "was rejected: overspend")
+
+	// the group expires with its first transaction to expire
+	algod = &composerTestAlgod{round: 10}
+	atc = composerTestClient(t, algod).MakeAtomicTransactionComposer()
+	require.NoError(t, atc.AddTransaction(pay))
+	expiring := pay
+	expiring.Txn.LastValid = 13
+	require.NoError(t, atc.AddTransaction(expiring))
+	_, err = atc.Execute()
+	require.ErrorContains(t, err, "expired before being confirmed")
+	require.Equal(t, uint64(13), algod.round)
+}
+
+func TestWaitForConfirmation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	algod := &composerTestAlgod{round: 10, confirmRound: 11}
+	c := composerTestClient(t, algod)
+	txn, err := c.waitForConfirmation("TXID", 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), *txn.ConfirmedRound)
+	require.Equal(t, uint64(11), algod.round)
+
+	// a confirmed transaction is returned even if it is past its last round
+	txn, err = c.waitForConfirmation("TXID", 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), *txn.ConfirmedRound)
+
+	algod = &composerTestAlgod{round: 10}
+	c = composerTestClient(t, algod)
+	_, err = c.waitForConfirmation("TXID", 10)
+	require.ErrorContains(t, err, "expired before being confirmed")
+	require.Equal(t, uint64(10), algod.round)
+	_, err = c.waitForConfirmation("TXID", 12)
+	require.ErrorContains(t, err, "expired before being confirmed")
+	require.Equal(t, uint64(12), algod.round)
+
+	algod.poolError = "fee too small"
+	_, err = c.waitForConfirmation("TXID", 20)
+	require.ErrorContains(t, err, "transaction TXID was rejected: fee too small")
+	require.Equal(t, uint64(12), algod.round)
+}
@@ -852,6 +852,15 @@ func (c *Client) HealthCheck() error {
 	return err
 }
 
+// HealthDetails returns the outcomes of the health checks of the node
+func (c *Client) HealthDetails() (report model.HealthReport, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		report, err = algod.HealthDetails()
+	}
+	return
+}
+
 // WaitForRound takes a round, waits until it appears and returns its status. This function blocks.
 func (c *Client) WaitForRound(round uint64) (resp model.NodeStatusResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -220,3 +220,39 @@ func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.Mas
 	// Return the mdk from the response
 	return resp.MasterDerivationKey, nil
 }
+
+// BackupWallet returns a backup of the given wallet, encrypted with the
+// backup password
+func (c *Client) BackupWallet(wh []byte, pw []byte, backupPassword []byte) (backup []byte, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	// Export the backup
+	resp, err := kmd.BackupWallet(wh, pw, backupPassword)
+	if err != nil {
+		return
+	}
+
+	// Return the backup from the response
+	return resp.Backup, nil
+}
+
+// RestoreWallet creates a kmd wallet with the given password from a backup
+// made with BackupWallet, returning the ID of the new wallet. If name is blank,
+// the name of the backed up wallet is used.
+func (c *Client) RestoreWallet(name []byte, password []byte, backupPassword []byte, backup []byte) (wid []byte, walletName []byte, err error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return
+	}
+
+	// Restore the wallet
+	resp, err := kmd.RestoreWallet(name, defaultWalletDriver, password, backupPassword, backup)
+	if err != nil {
+		return
+	}
+
+	return []byte(resp.Wallet.ID), []byte(resp.Wallet.Name), nil
+}
@@ -205,3 +205,10 @@ func (cyclic *CyclicFileWriter) Write(p []byte) (n int, err error) {
 	cyclic.nextWrite += uint64(n)
 	return
 }
+
+// Close closes the underlying file.
+func (cyclic *CyclicFileWriter) Close() error {
+	cyclic.mu.Lock()
+	defer cyclic.mu.Unlock()
+	return cyclic.writer.Close()
+}
@@ -35,6 +35,7 @@ logger.Info("New wallet was created")
 package logging
 
 import (
+	"errors"
 	"io"
 	"runtime"
 	"runtime/debug"
@@ -153,6 +154,7 @@ type Logger interface {
 
 	EnableTelemetry(cfg TelemetryConfig) error
 	UpdateTelemetryURI(uri string) error
+	SetTelemetryToLog(toLog bool) error
 	GetTelemetryEnabled() bool
 	GetTelemetryUploadingEnabled() bool
 	Metrics(category telemetryspec.Category, metrics telemetryspec.MetricDetails, details interface{})
@@ -289,15 +291,16 @@ func (l logger) WithFields(fields Fields) Logger {
 }
 
 func (l logger) GetLevel() (lvl Level) {
-	return Level(l.entry.Logger.Level)
+	return Level(l.entry.Logger.GetLevel())
 }
 
+// SetLevel changes the level atomically, since it can change while the node is running.
 func (l logger) SetLevel(lvl Level) {
-	l.entry.Logger.Level = logrus.Level(lvl)
+	l.entry.Logger.SetLevel(logrus.Level(lvl))
 }
 
 func (l logger) IsLevelEnabled(level Level) bool {
-	return l.entry.Logger.Level >= logrus.Level(level)
+	return l.entry.Logger.GetLevel() >= logrus.Level(level)
 }
 
 func (l logger) SetOutput(w io.Writer) {
@@ -378,12 +381,26 @@ func NewWrappedLogger(l *logrus.Logger) Logger {
 }
 
 func (l logger) EnableTelemetry(cfg TelemetryConfig) (err error) {
-	if l.loggerState.telemetry != nil || (!cfg.Enable && !cfg.SendToLog) {
+	if l.loggerState.telemetry != nil || (!cfg.Enable && !cfg.SendToLog && !cfg.TelemetryToLog && len(cfg.Sinks) == 0) {
 		return nil
 	}
 	return EnableTelemetry(cfg, &l)
 }
 
+// SetTelemetryToLog changes whether the telemetry events are recorded to the log along with the SendToLog
+// of the telemetry config. The telemetry can't get enabled after the fact, so it errors if it asks to record
+// the events while the telemetry is disabled.
+func (l logger) SetTelemetryToLog(toLog bool) error {
+	if l.loggerState.telemetry == nil {
+		if toLog {
+			return errors.New("the telemetry is disabled")
+		}
+		return nil
+	}
+	l.loggerState.telemetry.setToLog(toLog)
+	return nil
+}
+
 func (l logger) UpdateTelemetryURI(uri string) (err error) {
 	err = l.loggerState.telemetry.hook.UpdateHookURI(uri)
 	if err == nil {
@@ -394,7 +411,7 @@ func (l logger) UpdateTelemetryURI(uri string) (err error) {
 
 // GetTelemetryEnabled returns true if
 // logging.config Enable, or SendToLog or config.json
-// TelemetryToLog is true.
+// TelemetryToLog is true, or if logging.config has Sinks.
 func (l logger) GetTelemetryEnabled() bool {
 	return l.loggerState.telemetry != nil
 }
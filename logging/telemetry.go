@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -85,7 +86,14 @@ func makeTelemetryState(cfg TelemetryConfig, hookFactory hookFactory) (*telemetr
 	} else {
 		telemetry.hook = new(dummyHook)
 	}
+	sinks, err := makeTelemetrySinks(cfg)
+	if err != nil {
+		telemetry.hook.Close()
+		return nil, err
+	}
+	telemetry.sinks = sinks
 	telemetry.telemetryConfig = cfg
+	telemetry.setToLog(cfg.TelemetryToLog)
 	return telemetry, nil
 }
 
@@ -237,16 +245,16 @@ func (t *telemetryState) logMetrics(l logger, category telemetryspec.Category, m
 		"metrics": metrics,
 	}).(logger)
 
-	t.logTelemetry(l, buildMessage(string(category), string(metrics.Identifier())), details)
+	t.logTelemetry(l, category, buildMessage(string(category), string(metrics.Identifier())), details)
 }
 
 func (t *telemetryState) logEvent(l logger, category telemetryspec.Category, identifier telemetryspec.Event, details interface{}) {
-	t.logTelemetry(l, buildMessage(string(category), string(identifier)), details)
+	t.logTelemetry(l, category, buildMessage(string(category), string(identifier)), details)
 }
 
 func (t *telemetryState) logStartOperation(l logger, category telemetryspec.Category, identifier telemetryspec.Operation) TelemetryOperation {
 	op := makeTelemetryOperation(t, category, identifier)
-	t.logTelemetry(l, buildMessage(string(category), string(identifier), "Start"), nil)
+	t.logTelemetry(l, category, buildMessage(string(category), string(identifier), "Start"), nil)
 	return op
 }
 
@@ -255,8 +263,8 @@ func buildMessage(args ...string) string {
 	return message
 }
 
-// logTelemetry explicitly only sends telemetry events to the cloud.
-func (t *telemetryState) logTelemetry(l logger, message string, details interface{}) {
+// logTelemetry explicitly only sends telemetry events to the cloud, and to the sinks of their category.
+func (t *telemetryState) logTelemetry(l logger, category telemetryspec.Category, message string, details interface{}) {
 	if details != nil {
 		l = l.WithFields(logrus.Fields{
 			"details": details,
@@ -273,16 +281,35 @@ func (t *telemetryState) logTelemetry(l logger, message string, details interfac
 	entry.Level = logrus.InfoLevel
 	entry.Message = message
 
-	if t.telemetryConfig.SendToLog {
+	if t.telemetryConfig.SendToLog || atomic.LoadUint32(&t.toLog) != 0 {
 		entry.Info(message)
 	}
 	t.hook.Fire(entry)
+
+	if t.sinks != nil {
+		fields := make(Fields, len(entry.Data))
+		for key, value := range entry.Data {
+			fields[key] = value
+		}
+		t.sinks.send(TelemetryEvent{Time: entry.Time, Category: category, Message: message, Fields: fields})
+	}
+}
+
+func (t *telemetryState) setToLog(toLog bool) {
+	var value uint32
+	if toLog {
+		value = 1
+	}
+	atomic.StoreUint32(&t.toLog, value)
 }
 
 func (t *telemetryState) Close() {
 	if t.hook != nil {
 		t.hook.Close()
 	}
+	if t.sinks != nil {
+		t.sinks.close()
+	}
 }
 
 func (t *telemetryState) Flush() {
@@ -49,7 +49,10 @@ type telemetryHook interface {
 type telemetryState struct {
 	history         *logBuffer
 	hook            telemetryHook
+	sinks           *telemetrySinks
 	telemetryConfig TelemetryConfig
+	// toLog is set while the TelemetryToLog setting of the node sends the telemetry events to the log
+	toLog uint32
 }
 
 // TelemetryConfig represents the configuration of Telemetry logging
@@ -64,9 +67,13 @@ type TelemetryConfig struct {
 	FilePath           string       // Path to file on disk, if any
 	ChainID            string       `json:"-"`
 	SessionGUID        string       `json:"-"`
+	TelemetryToLog     bool         `json:"-"` // the TelemetryToLog of the node config, which sends to the log along with SendToLog
 	Version            string       `json:"-"`
 	UserName           string
 	Password           string
+	// Sinks are the destinations of the telemetry events besides the telemetry service, which get the
+	// events even when the telemetry service is not enabled. They are only enabled by algod, algoh ignores them.
+	Sinks []TelemetrySinkConfig `json:",omitempty"`
 }
 
 // MarshalingTelemetryConfig is used for json serialization of the TelemetryConfig
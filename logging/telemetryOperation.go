@@ -47,5 +47,5 @@ func (op *TelemetryOperation) Stop(l logger, details interface{}) {
 		"duration": elapsed,
 	}).(logger)
 
-	op.telemetryState.logTelemetry(entry, buildMessage(string(op.category), string(op.identifier), "Stop"), details)
+	op.telemetryState.logTelemetry(entry, op.category, buildMessage(string(op.category), string(op.identifier), "Stop"), details)
 }
@@ -0,0 +1,177 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+)
+
+// TelemetrySink is a destination of the telemetry events besides the telemetry service, which lets
+// the events be kept locally or be shipped to a log pipeline of our own.
+type TelemetrySink interface {
+	// Send delivers an event. It gets called as the events are logged, possibly by several goroutines at once,
+	// so it should not block for long.
+	Send(event TelemetryEvent) error
+	// Close delivers the pending events, and releases the resources of the sink.
+	Close() error
+}
+
+// TelemetryEvent is a telemetry event, as delivered to the sinks.
+type TelemetryEvent struct {
+	Time     time.Time              `json:"time"`
+	Category telemetryspec.Category `json:"category"`
+	// Message identifies the event, as in "/Agreement/BlockAccepted".
+	Message string `json:"message"`
+	// Fields holds the details and the metrics of the event, along with the fields of the logger it was logged with.
+	Fields Fields `json:"fields"`
+}
+
+// TelemetrySinkConfig configures one of the sinks of the telemetry events. The fields following Categories
+// only apply to the sinks of the type given in parentheses.
+type TelemetrySinkConfig struct {
+	// Type is the kind of sink: "file", "syslog", "otlp", or one added by RegisterTelemetrySinkType.
+	Type string
+	// Categories restricts the sink to the events of these categories, it gets all of them when empty.
+	Categories []telemetryspec.Category `json:",omitempty"`
+
+	// Path of the file the events are appended to, one JSON object per line. A relative path is relative
+	// to the directory of the telemetry configuration file. (file)
+	Path string `json:",omitempty"`
+	// MaxSizeBytes is the size past which the file gets archived. The file grows without bounds when it's zero. (file)
+	MaxSizeBytes uint64 `json:",omitempty"`
+	// ArchivePath is where the file is moved once it's full, which may use the same templates as the
+	// LogArchiveName of config.json. It defaults to Path with an ".archive" suffix. (file)
+	ArchivePath string `json:",omitempty"`
+	// MaxArchiveAge is the age past which the archives get deleted, as parsed by time.ParseDuration. (file)
+	MaxArchiveAge string `json:",omitempty"`
+
+	// Network and Address of the syslog daemon to send the events to, the local daemon when empty. (syslog)
+	Network string `json:",omitempty"`
+	Address string `json:",omitempty"`
+	// Tag of the syslog messages, the name of the program when empty. (syslog)
+	Tag string `json:",omitempty"`
+
+	// Endpoint is the URL of the OTLP/HTTP logs endpoint of a collector, such as http://localhost:4318/v1/logs. (otlp)
+	Endpoint string `json:",omitempty"`
+	// Headers are added to the requests sent to the collector, for instance to authenticate. (otlp)
+	Headers map[string]string `json:",omitempty"`
+}
+
+// TelemetrySinkFactory makes a sink out of its configuration.
+type TelemetrySinkFactory func(cfg TelemetrySinkConfig) (TelemetrySink, error)
+
+var telemetrySinkFactoriesMu deadlock.Mutex
+var telemetrySinkFactories = map[string]TelemetrySinkFactory{
+	"file":   makeFileTelemetrySink,
+	"syslog": makeSyslogTelemetrySink,
+	"otlp":   makeOTLPTelemetrySink,
+}
+
+// RegisterTelemetrySinkType makes the sinks of the given type available to the telemetry configuration,
+// replacing the factory which was registered for the type, if any.
+func RegisterTelemetrySinkType(sinkType string, factory TelemetrySinkFactory) {
+	telemetrySinkFactoriesMu.Lock()
+	defer telemetrySinkFactoriesMu.Unlock()
+	telemetrySinkFactories[sinkType] = factory
+}
+
+// telemetrySinkRoute is a sink along with the categories of the events it gets.
+type telemetrySinkRoute struct {
+	sink TelemetrySink
+	// categories is nil when the sink gets the events of all the categories
+	categories map[telemetryspec.Category]bool
+	sinkType   string
+	// failing is set to 1 once the sink failed to deliver an event, so that we only report the first of a series of failures
+	failing uint32
+}
+
+type telemetrySinks struct {
+	mu     deadlock.Mutex
+	routes []*telemetrySinkRoute
+}
+
+// makeTelemetrySinks makes the sinks of the telemetry configuration.
+func makeTelemetrySinks(cfg TelemetryConfig) (*telemetrySinks, error) {
+	sinks := &telemetrySinks{}
+	for _, sinkCfg := range cfg.Sinks {
+		telemetrySinkFactoriesMu.Lock()
+		factory, ok := telemetrySinkFactories[sinkCfg.Type]
+		telemetrySinkFactoriesMu.Unlock()
+		if !ok {
+			sinks.close()
+			return nil, fmt.Errorf("unknown telemetry sink type '%s'", sinkCfg.Type)
+		}
+		if sinkCfg.Path != "" && !filepath.IsAbs(sinkCfg.Path) && cfg.FilePath != "" {
+			sinkCfg.Path = filepath.Join(filepath.Dir(cfg.FilePath), sinkCfg.Path)
+		}
+		sink, err := factory(sinkCfg)
+		if err != nil {
+			sinks.close()
+			return nil, fmt.Errorf("cannot make the %s telemetry sink: %w", sinkCfg.Type, err)
+		}
+		route := &telemetrySinkRoute{sink: sink, sinkType: sinkCfg.Type}
+		if len(sinkCfg.Categories) > 0 {
+			route.categories = make(map[telemetryspec.Category]bool, len(sinkCfg.Categories))
+			for _, category := range sinkCfg.Categories {
+				route.categories[category] = true
+			}
+		}
+		sinks.routes = append(sinks.routes, route)
+	}
+	return sinks, nil
+}
+
+// send delivers the event to the sinks which get its category.
+// The sinks are called without holding the lock, so that a sink which is slow to take an event holds up
+// neither the events logged by the other goroutines nor closing the sinks.
+func (s *telemetrySinks) send(event TelemetryEvent) {
+	s.mu.Lock()
+	routes := s.routes
+	s.mu.Unlock()
+	for _, route := range routes {
+		if route.categories != nil && !route.categories[event.Category] {
+			continue
+		}
+		// the sinks can't log their failures, which would go through the logger sending them the events
+		err := route.sink.Send(event)
+		if err == nil {
+			atomic.StoreUint32(&route.failing, 0)
+		} else if atomic.SwapUint32(&route.failing, 1) == 0 {
+			fmt.Fprintf(os.Stderr, "%s telemetry sink failed to deliver an event: %v\n", route.sinkType, err)
+		}
+	}
+}
+
+func (s *telemetrySinks) close() {
+	s.mu.Lock()
+	routes := s.routes
+	s.routes = nil
+	s.mu.Unlock()
+	for _, route := range routes {
+		if err := route.sink.Close(); err != nil {
+			fmt.Fprintf(os.Stderr, "%s telemetry sink failed to close: %v\n", route.sinkType, err)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"errors"
+	"io"
+	"os"
+	"time"
+)
+
+func makeFileTelemetrySink(cfg TelemetrySinkConfig) (TelemetrySink, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("no Path was configured")
+	}
+	var maxArchiveAge time.Duration
+	if cfg.MaxArchiveAge != "" {
+		var err error
+		maxArchiveAge, err = time.ParseDuration(cfg.MaxArchiveAge)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// open the file ourselves first, since the cyclic writer panics when it can't
+	file, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxSizeBytes == 0 {
+		return makeFileTelemetryWriterSink(file), nil
+	}
+	file.Close()
+
+	archivePath := cfg.ArchivePath
+	if archivePath == "" {
+		archivePath = cfg.Path + ".archive"
+	}
+	return makeFileTelemetryWriterSink(MakeCyclicFileWriter(cfg.Path, archivePath, cfg.MaxSizeBytes, maxArchiveAge)), nil
+}
+
+// makeFileTelemetryWriterSink makes a sink appending the events to the writer, one JSON object per line.
+func makeFileTelemetryWriterSink(writer io.WriteCloser) TelemetrySink {
+	return makeQueuedTelemetrySink("file", func(message []byte) error {
+		// a single write, so that a line never gets split between the file and its archive
+		_, err := writer.Write(append(message, '\n'))
+		return err
+	}, writer.Close)
+}
@@ -0,0 +1,265 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// otlpBatchSize is the number of log records we send to the collector at once
+	otlpBatchSize = 100
+	// otlpQueueDepth is the number of log records waiting to be sent, past which the new ones get dropped
+	otlpQueueDepth = 10000
+	// otlpFlushInterval is how long a log record may wait for its batch to fill up
+	otlpFlushInterval = time.Second
+	// otlpRequestTimeout bounds each of the requests to the collector
+	otlpRequestTimeout = 10 * time.Second
+	// otlpSeverityInfo is the OpenTelemetry severity number of the INFO level, the one of the telemetry events
+	otlpSeverityInfo = 9
+)
+
+var errOTLPQueueFull = errors.New("the queue of the OTLP logs exporter is full")
+
+// otlpTelemetrySink exports the events as OpenTelemetry log records, in batches sent to the OTLP/HTTP
+// logs endpoint of a collector in the JSON encoding.
+type otlpTelemetrySink struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+	resource otlpResource
+
+	records chan otlpLogRecord
+	quit    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// The following types encode the ExportLogsServiceRequest of the OTLP protocol in JSON.
+
+type otlpExportLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string         `json:"timeUnixNano"`
+	ObservedTimeUnixNano string         `json:"observedTimeUnixNano"`
+	SeverityNumber       int            `json:"severityNumber"`
+	SeverityText         string         `json:"severityText"`
+	Body                 otlpAnyValue   `json:"body"`
+	Attributes           []otlpKeyValue `json:"attributes"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+// otlpAnyValue holds one of its fields, the 64 bit integers being strings in the JSON encoding.
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func otlpString(s string) otlpAnyValue {
+	return otlpAnyValue{StringValue: &s}
+}
+
+// otlpValue converts the value of a field of an event. The values which aren't scalars are converted
+// to their JSON encoding.
+func otlpValue(value interface{}) otlpAnyValue {
+	switch v := value.(type) {
+	case string:
+		return otlpString(v)
+	case bool:
+		return otlpAnyValue{BoolValue: &v}
+	case int:
+		i := strconv.FormatInt(int64(v), 10)
+		return otlpAnyValue{IntValue: &i}
+	case int64:
+		i := strconv.FormatInt(v, 10)
+		return otlpAnyValue{IntValue: &i}
+	case uint64:
+		i := strconv.FormatUint(v, 10)
+		return otlpAnyValue{IntValue: &i}
+	case float64:
+		return otlpAnyValue{DoubleValue: &v}
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return otlpString(fmt.Sprintf("%v", value))
+	}
+	return otlpString(string(encoded))
+}
+
+func makeOTLPTelemetrySink(cfg TelemetrySinkConfig) (TelemetrySink, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("no Endpoint was configured")
+	}
+	hostname, _ := os.Hostname()
+	sink := &otlpTelemetrySink{
+		endpoint: cfg.Endpoint,
+		headers:  cfg.Headers,
+		client:   &http.Client{Timeout: otlpRequestTimeout},
+		resource: otlpResource{Attributes: []otlpKeyValue{
+			{Key: "service.name", Value: otlpString(filepath.Base(os.Args[0]))},
+			{Key: "host.name", Value: otlpString(hostname)},
+		}},
+		records: make(chan otlpLogRecord, otlpQueueDepth),
+		quit:    make(chan struct{}),
+	}
+	sink.wg.Add(1)
+	go sink.run()
+	return sink, nil
+}
+
+// Send converts the event right away, since the fields of the event may change once we return.
+func (s *otlpTelemetrySink) Send(event TelemetryEvent) error {
+	record := otlpLogRecord{
+		TimeUnixNano:         strconv.FormatInt(event.Time.UnixNano(), 10),
+		ObservedTimeUnixNano: strconv.FormatInt(time.Now().UnixNano(), 10),
+		SeverityNumber:       otlpSeverityInfo,
+		SeverityText:         "INFO",
+		Body:                 otlpString(event.Message),
+		Attributes:           []otlpKeyValue{{Key: "telemetry.category", Value: otlpString(string(event.Category))}},
+	}
+	keys := make([]string, 0, len(event.Fields))
+	for key := range event.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		record.Attributes = append(record.Attributes, otlpKeyValue{Key: key, Value: otlpValue(event.Fields[key])})
+	}
+	select {
+	case s.records <- record:
+		return nil
+	default:
+		return errOTLPQueueFull
+	}
+}
+
+func (s *otlpTelemetrySink) Close() error {
+	close(s.quit)
+	s.wg.Wait()
+	return nil
+}
+
+func (s *otlpTelemetrySink) run() {
+	defer s.wg.Done()
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+	batch := make([]otlpLogRecord, 0, otlpBatchSize)
+	failing := false
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		err := s.export(batch)
+		if err != nil && !failing {
+			fmt.Fprintf(os.Stderr, "otlp telemetry sink failed to export %d events: %v\n", len(batch), err)
+		}
+		failing = err != nil
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case record := <-s.records:
+			batch = append(batch, record)
+			if len(batch) == otlpBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-s.quit:
+			for {
+				select {
+				case record := <-s.records:
+					batch = append(batch, record)
+					if len(batch) == otlpBatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// export sends a batch of log records to the collector.
+func (s *otlpTelemetrySink) export(records []otlpLogRecord) error {
+	body, err := json.Marshal(otlpExportLogsRequest{ResourceLogs: []otlpResourceLogs{{
+		Resource: s.resource,
+		ScopeLogs: []otlpScopeLogs{{
+			Scope:      otlpScope{Name: "github.com/algorand/go-algorand/logging"},
+			LogRecords: records,
+		}},
+	}}})
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range s.headers {
+		request.Header.Set(key, value)
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, response.Body)
+	if response.StatusCode/100 != 2 {
+		return fmt.Errorf("the collector responded %s", response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// telemetrySinkQueueDepth is the number of events waiting to be written by a queued sink, past which the new ones get dropped
+const telemetrySinkQueueDepth = 10000
+
+var errTelemetrySinkQueueFull = errors.New("the queue of the telemetry sink is full")
+
+// queuedTelemetrySink writes the events, encoded in JSON, from a goroutine of its own, so that a slow disk
+// or syslog daemon holds up neither the logging nor the other sinks.
+type queuedTelemetrySink struct {
+	sinkType string
+	// write writes an encoded event, it's only called by the goroutine of the sink
+	write func(message []byte) error
+	// closeWriter is called once the pending events were written
+	closeWriter func() error
+
+	messages chan []byte
+	quit     chan struct{}
+	wg       sync.WaitGroup
+}
+
+func makeQueuedTelemetrySink(sinkType string, write func(message []byte) error, closeWriter func() error) *queuedTelemetrySink {
+	sink := &queuedTelemetrySink{
+		sinkType:    sinkType,
+		write:       write,
+		closeWriter: closeWriter,
+		messages:    make(chan []byte, telemetrySinkQueueDepth),
+		quit:        make(chan struct{}),
+	}
+	sink.wg.Add(1)
+	go sink.run()
+	return sink
+}
+
+// Send encodes the event right away, since the fields of the event may change once we return.
+func (s *queuedTelemetrySink) Send(event TelemetryEvent) error {
+	message, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	select {
+	case s.messages <- message:
+		return nil
+	default:
+		return errTelemetrySinkQueueFull
+	}
+}
+
+func (s *queuedTelemetrySink) Close() error {
+	close(s.quit)
+	s.wg.Wait()
+	return s.closeWriter()
+}
+
+func (s *queuedTelemetrySink) run() {
+	defer s.wg.Done()
+	failing := false
+	write := func(message []byte) {
+		err := s.write(message)
+		if err != nil && !failing {
+			fmt.Fprintf(os.Stderr, "%s telemetry sink failed to write an event: %v\n", s.sinkType, err)
+		}
+		failing = err != nil
+	}
+	for {
+		select {
+		case message := <-s.messages:
+			write(message)
+		case <-s.quit:
+			for {
+				select {
+				case message := <-s.messages:
+					write(message)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
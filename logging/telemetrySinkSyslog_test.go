@@ -0,0 +1,54 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+//go:build !windows
+// +build !windows
+
+package logging
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSyslogTelemetrySink(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	daemon, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer daemon.Close()
+
+	cfg := createTelemetryConfig()
+	cfg.Sinks = []TelemetrySinkConfig{{Type: "syslog", Network: "udp", Address: daemon.LocalAddr().String(), Tag: "algod-test"}}
+	l := makeSinkTestLogger(t, cfg)
+	l.Event(telemetryspec.Network, telemetryspec.ConnectPeerEvent)
+	l.CloseTelemetry()
+
+	buf := make([]byte, 4096)
+	require.NoError(t, daemon.SetReadDeadline(time.Now().Add(10*time.Second)))
+	n, _, err := daemon.ReadFrom(buf)
+	require.NoError(t, err)
+	message := string(buf[:n])
+	require.True(t, strings.Contains(message, "algod-test"), message)
+	require.True(t, strings.Contains(message, `"message":"/Network/ConnectPeer"`), message)
+}
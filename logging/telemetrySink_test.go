@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logging
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// makeSinkTestLogger makes a logger whose telemetry is only sent to the sinks of cfg.
+func makeSinkTestLogger(t *testing.T, cfg TelemetryConfig) logger {
+	l := NewLogger().(logger)
+	l.SetOutput(io.Discard)
+	telemetry, err := makeTelemetryState(cfg, createElasticHook)
+	require.NoError(t, err)
+	l.loggerState.telemetry = telemetry
+	return l
+}
+
+func readTelemetryEvents(t *testing.T, path string) []TelemetryEvent {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	var events []TelemetryEvent
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var event TelemetryEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+func TestFileTelemetrySink(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	cfg := createTelemetryConfig()
+	cfg.FilePath = filepath.Join(dir, TelemetryConfigFilename)
+	cfg.Sinks = []TelemetrySinkConfig{
+		{Type: "file", Path: "all.json"},
+		{Type: "file", Path: filepath.Join(dir, "agreement.json"), Categories: []telemetryspec.Category{telemetryspec.Agreement}},
+	}
+	l := makeSinkTestLogger(t, cfg)
+	require.True(t, l.GetTelemetryEnabled())
+	require.False(t, l.GetTelemetryUploadingEnabled())
+
+	l.EventWithDetails(telemetryspec.Agreement, telemetryspec.BlockAcceptedEvent, telemetryspec.BlockAcceptedEventDetails{Round: 5})
+	l.Event(telemetryspec.Network, telemetryspec.ConnectPeerEvent)
+	op := l.StartOperation(telemetryspec.Agreement, telemetryspec.Operation("TestOperation"))
+	op.Stop(l, nil)
+	l.CloseTelemetry()
+
+	all := readTelemetryEvents(t, filepath.Join(dir, "all.json"))
+	require.Len(t, all, 4)
+	require.Equal(t, telemetryspec.Agreement, all[0].Category)
+	require.Equal(t, "/Agreement/BlockAccepted", all[0].Message)
+	require.Equal(t, float64(5), all[0].Fields["details"].(map[string]interface{})["Round"])
+	require.Equal(t, telemetryspec.Network, all[1].Category)
+	require.Equal(t, "/Agreement/TestOperation/Start", all[2].Message)
+	require.Equal(t, "/Agreement/TestOperation/Stop", all[3].Message)
+
+	agreement := readTelemetryEvents(t, filepath.Join(dir, "agreement.json"))
+	require.Len(t, agreement, 3)
+	for _, event := range agreement {
+		require.Equal(t, telemetryspec.Agreement, event.Category)
+	}
+}
+
+func TestFileTelemetrySinkRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	cfg := createTelemetryConfig()
+	cfg.Sinks = []TelemetrySinkConfig{{Type: "file", Path: filepath.Join(dir, "telemetry.json"), MaxSizeBytes: 1024}}
+	l := makeSinkTestLogger(t, cfg)
+	for i := 0; i < 20; i++ {
+		l.Event(telemetryspec.Network, telemetryspec.ConnectPeerEvent)
+	}
+	l.CloseTelemetry()
+
+	live := readTelemetryEvents(t, filepath.Join(dir, "telemetry.json"))
+	archived := readTelemetryEvents(t, filepath.Join(dir, "telemetry.json.archive"))
+	require.NotEmpty(t, live)
+	require.NotEmpty(t, archived)
+	require.LessOrEqual(t, len(live)+len(archived), 20)
+}
+
+func TestTelemetrySinkConfigErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := createTelemetryConfig()
+	cfg.Sinks = []TelemetrySinkConfig{{Type: "carrier-pigeon"}}
+	_, err := makeTelemetryState(cfg, createElasticHook)
+	require.ErrorContains(t, err, "unknown telemetry sink type")
+
+	cfg.Sinks = []TelemetrySinkConfig{{Type: "file"}}
+	_, err = makeTelemetryState(cfg, createElasticHook)
+	require.Error(t, err)
+
+	cfg.Sinks = []TelemetrySinkConfig{{Type: "otlp"}}
+	_, err = makeTelemetryState(cfg, createElasticHook)
+	require.Error(t, err)
+}
+
+type testTelemetrySink struct {
+	events []TelemetryEvent
+	closed bool
+}
+
+func (s *testTelemetrySink) Send(event TelemetryEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *testTelemetrySink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestRegisterTelemetrySinkType(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sink := &testTelemetrySink{}
+	RegisterTelemetrySinkType("test", func(cfg TelemetrySinkConfig) (TelemetrySink, error) { return sink, nil })
+
+	cfg := createTelemetryConfig()
+	cfg.Sinks = []TelemetrySinkConfig{{Type: "test", Categories: []telemetryspec.Category{telemetryspec.Network}}}
+	l := makeSinkTestLogger(t, cfg)
+	l.Event(telemetryspec.Agreement, telemetryspec.BlockAcceptedEvent)
+	l.Event(telemetryspec.Network, telemetryspec.ConnectPeerEvent)
+	l.CloseTelemetry()
+
+	require.Len(t, sink.events, 1)
+	require.Equal(t, "/Network/ConnectPeer", sink.events[0].Message)
+	require.True(t, sink.closed)
+}
+
+func TestQueuedTelemetrySinkDropsWhenFull(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	release := make(chan struct{})
+	var written int
+	sink := makeQueuedTelemetrySink("test", func(message []byte) error {
+		<-release
+		written++
+		return nil
+	}, func() error { return nil })
+
+	// the goroutine of the sink takes the first event and is stuck writing it, so the queue fills up with the following ones
+	require.NoError(t, sink.Send(TelemetryEvent{Message: "first"}))
+	require.Eventually(t, func() bool { return len(sink.messages) == 0 }, 10*time.Second, time.Millisecond)
+	for i := 0; i < telemetrySinkQueueDepth; i++ {
+		require.NoError(t, sink.Send(TelemetryEvent{Message: "queued"}))
+	}
+	require.ErrorIs(t, sink.Send(TelemetryEvent{Message: "dropped"}), errTelemetrySinkQueueFull)
+
+	// the queued events are still written on close
+	close(release)
+	require.NoError(t, sink.Close())
+	require.Equal(t, telemetrySinkQueueDepth+1, written)
+}
+
+// blockingTelemetrySink holds up the first event it gets until released.
+type blockingTelemetrySink struct {
+	testTelemetrySink
+	blocked chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingTelemetrySink) Send(event TelemetryEvent) error {
+	if event.Message == "blocking" {
+		close(s.blocked)
+		<-s.release
+		return nil
+	}
+	return s.testTelemetrySink.Send(event)
+}
+
+func TestTelemetrySinksSendConcurrently(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sink := &blockingTelemetrySink{blocked: make(chan struct{}), release: make(chan struct{})}
+	sinks := &telemetrySinks{routes: []*telemetrySinkRoute{{sink: sink, sinkType: "test"}}}
+	done := make(chan struct{})
+	go func() {
+		sinks.send(TelemetryEvent{Message: "blocking"})
+		close(done)
+	}()
+	<-sink.blocked
+
+	// a sink slow to take an event doesn't hold up the events of the other goroutines
+	sinks.send(TelemetryEvent{Message: "other"})
+	require.Len(t, sink.events, 1)
+
+	close(sink.release)
+	<-done
+	sinks.close()
+	require.True(t, sink.closed)
+}
+
+func TestOTLPTelemetrySink(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	requests := make(chan otlpExportLogsRequest, 10)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "secret", r.Header.Get("Authorization"))
+		var request otlpExportLogsRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		requests <- request
+	}))
+	defer collector.Close()
+
+	cfg := createTelemetryConfig()
+	cfg.Sinks = []TelemetrySinkConfig{{Type: "otlp", Endpoint: collector.URL + "/v1/logs", Headers: map[string]string{"Authorization": "secret"}}}
+	l := makeSinkTestLogger(t, cfg)
+	l.EventWithDetails(telemetryspec.Agreement, telemetryspec.BlockAcceptedEvent, telemetryspec.BlockAcceptedEventDetails{Round: 5})
+	l.CloseTelemetry()
+
+	require.Len(t, requests, 1)
+	request := <-requests
+	require.Len(t, request.ResourceLogs, 1)
+	require.Len(t, request.ResourceLogs[0].ScopeLogs, 1)
+	records := request.ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 1)
+	require.Equal(t, "/Agreement/BlockAccepted", *records[0].Body.StringValue)
+	require.Equal(t, otlpSeverityInfo, records[0].SeverityNumber)
+	attributes := make(map[string]otlpAnyValue)
+	for _, kv := range records[0].Attributes {
+		attributes[kv.Key] = kv.Value
+	}
+	require.Equal(t, "Agreement", *attributes["telemetry.category"].StringValue)
+	require.Contains(t, *attributes["details"].StringValue, `"Round":5`)
+}
@@ -17,6 +17,7 @@
 package logging
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -294,6 +295,31 @@ func TestLogHook(t *testing.T) {
 	a.Equal(1, len(f.hookEntries()))
 }
 
+func TestSetTelemetryToLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	l := NewLogger().(logger)
+	l.SetOutput(&buf)
+	a.Error(l.SetTelemetryToLog(true))
+	a.NoError(l.SetTelemetryToLog(false))
+
+	cfg := createTelemetryConfig()
+	cfg.TelemetryToLog = true
+	telem, err := makeTelemetryState(cfg, createElasticHook)
+	a.NoError(err)
+	l.loggerState.telemetry = telem
+
+	l.Event(telemetryspec.ApplicationState, telemetryspec.StartupEvent)
+	a.Contains(buf.String(), string(telemetryspec.StartupEvent))
+
+	buf.Reset()
+	a.NoError(l.SetTelemetryToLog(false))
+	l.Event(telemetryspec.ApplicationState, telemetryspec.StartupEvent)
+	a.Empty(buf.String())
+}
+
 func TestLogLevels(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	runLogLevelsTest(t, logrus.DebugLevel, 7)
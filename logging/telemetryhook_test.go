@@ -19,6 +19,7 @@ package logging
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -61,7 +62,7 @@ func isDefault(cfg TelemetryConfig) bool {
 	cfg.ChainID = ""
 	cfg.Version = ""
 	defaultCfg.GUID = ""
-	return cfg == defaultCfg
+	return reflect.DeepEqual(cfg, defaultCfg)
 }
 
 func TestLoggingConfigDataDirFirst(t *testing.T) {
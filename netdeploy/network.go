@@ -58,6 +58,21 @@ type Network struct {
 // CreateNetworkFromTemplate uses the specified template to deploy a new private network
 // under the specified root directory.
 func CreateNetworkFromTemplate(name, rootDir, templateFile, binDir string, importKeys bool, nodeExitCallback nodecontrol.AlgodExitErrorCallback, consensus config.ConsensusProtocols, overrideDevMode bool) (Network, error) {
+	template, err := loadTemplate(templateFile)
+	if err != nil {
+		return Network{rootDir: rootDir, nodeExitCallback: nodeExitCallback}, err
+	}
+	if overrideDevMode {
+		template.Genesis.DevMode = true
+		if len(template.Nodes) > 0 {
+			template.Nodes[0].IsRelay = false
+		}
+	}
+	return createNetwork(name, rootDir, templateFile, template, binDir, importKeys, nodeExitCallback, consensus)
+}
+
+// createNetwork deploys a new private network from an already loaded template.
+func createNetwork(name, rootDir, templateFile string, template NetworkTemplate, binDir string, importKeys bool, nodeExitCallback nodecontrol.AlgodExitErrorCallback, consensus config.ConsensusProtocols) (Network, error) {
 	n := Network{
 		rootDir:          rootDir,
 		nodeExitCallback: nodeExitCallback,
@@ -65,16 +80,7 @@ func CreateNetworkFromTemplate(name, rootDir, templateFile, binDir string, impor
 	n.cfg.Name = name
 	n.cfg.TemplateFile = templateFile
 
-	template, err := loadTemplate(templateFile)
-	if err == nil {
-		if overrideDevMode {
-			template.Genesis.DevMode = true
-			if len(template.Nodes) > 0 {
-				template.Nodes[0].IsRelay = false
-			}
-		}
-		err = template.Validate()
-	}
+	err := template.Validate()
 	if err != nil {
 		return n, err
 	}
@@ -368,6 +374,51 @@ func (n Network) StartNode(binDir, nodeDir string, redirectOutput bool) (err err
 	return
 }
 
+// RestartNode stops a node of a started network and starts it again using the binaries
+// of binDir. A relay keeps listening on the same address, so that the other nodes can
+// reconnect to it.
+func (n Network) RestartNode(binDir, nodeName string, redirectOutput bool) error {
+	nodeDir, err := n.GetNodeDir(nodeName)
+	if err != nil {
+		return err
+	}
+	nc := nodecontrol.MakeNodeController(binDir, nodeDir)
+	args := nodecontrol.AlgodStartArgs{
+		RedirectOutput:    redirectOutput,
+		ExitErrorCallback: n.nodeExitCallback,
+	}
+
+	isRelay := false
+	for _, relayDir := range n.cfg.RelayDirs {
+		if strings.EqualFold(relayDir, nodeName) {
+			isRelay = true
+		}
+	}
+	var peers []string
+	if isRelay {
+		args.ListenIP, err = n.getRelayAddress(nc)
+		if err != nil {
+			return err
+		}
+		args.ListenIP = strings.TrimPrefix(args.ListenIP, "http://")
+		for _, peer := range n.GetPeerAddresses(binDir) {
+			if peer != args.ListenIP {
+				peers = append(peers, peer)
+			}
+		}
+	} else {
+		peers = n.GetPeerAddresses(binDir)
+	}
+	args.PeerAddress = strings.Join(peers, ";")
+
+	err = nc.StopAlgod()
+	if err != nil {
+		return err
+	}
+	_, err = nc.StartAlgod(args)
+	return err
+}
+
 // Stop the network, ensuring primary relay stops first
 // No return code - we try to kill them if we can (if we read valid PID file)
 func (n Network) Stop(binDir string) {
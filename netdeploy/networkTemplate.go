@@ -226,6 +226,11 @@ func (t NetworkTemplate) Validate() error {
 		return fmt.Errorf("invalid template: DevMode should only have a single node")
 	}
 
+	// Genesis assets and applications must refer to Genesis accounts
+	if err := t.Genesis.CheckCreatables(); err != nil {
+		return fmt.Errorf("invalid template: %v", err)
+	}
+
 	return nil
 }
 
@@ -94,4 +94,11 @@ func TestValidate(t *testing.T) {
 	template, _ = loadTemplate(filepath.Join(templateDir, "TwoNodesOneRelay1000Accounts.json"))
 	err = template.Validate()
 	a.NoError(err)
+
+	template, err = loadTemplate(filepath.Join(templateDir, "OneNodeCreatables.json"))
+	a.NoError(err)
+	a.NoError(template.Validate())
+	template.Genesis.Apps[0].OptIns[0].Account = "Wallet3"
+	err = template.Validate()
+	a.ErrorContains(err, "unknown opted in account Wallet3")
 }
@@ -0,0 +1,442 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netdeploy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	defaultRehearsalPostSwitchRounds = 5
+	defaultRehearsalMaxRounds        = 1000
+	defaultRehearsalStallTimeout     = 2 * time.Minute
+)
+
+// UpgradeRehearsal describes a consensus upgrade rehearsal: a private network is started on
+// FromProtocol using the binaries of BinDir, its nodes are restarted with other binaries as
+// scheduled by Swaps, and the network is expected to vote in and switch to ToProtocol.
+//
+// The nodes propose the upgrade on their own once the consensus parameters of FromProtocol, as
+// known to their binaries, approve ToProtocol. ConsensusFile can define both protocols, with
+// short voting and waiting periods, for every node of the network; tools/debug/genconsensusconfig
+// writes the built-in protocols in its format.
+type UpgradeRehearsal struct {
+	// Template is the network template. Its genesis consensus protocol is replaced by FromProtocol.
+	Template string
+	// ConsensusFile is an optional consensus.json, deployed to every node.
+	ConsensusFile string `json:",omitempty"`
+	FromProtocol  protocol.ConsensusVersion
+	ToProtocol    protocol.ConsensusVersion
+	// BinDir holds the binaries every node starts with.
+	BinDir string
+	Swaps  []BinarySwap `json:",omitempty"`
+	// SwitchRound is the round ToProtocol is expected to become current on. Zero expects the
+	// round announced by the block proposing the upgrade.
+	SwitchRound uint64 `json:",omitempty"`
+	// PostSwitchRounds is the number of rounds the network has to agree on after the switch.
+	PostSwitchRounds uint64 `json:",omitempty"`
+	// MaxRounds bounds the number of rounds the rehearsal waits for the switch.
+	MaxRounds uint64 `json:",omitempty"`
+	// StallTimeoutSeconds bounds the time the rehearsal waits for the next round.
+	StallTimeoutSeconds uint64 `json:",omitempty"`
+}
+
+// BinarySwap restarts a node with the binaries of BinDir once the network reaches Round.
+type BinarySwap struct {
+	Node   string
+	Round  uint64
+	BinDir string
+}
+
+// LoadUpgradeRehearsal loads an upgrade rehearsal from a json file. The relative paths it
+// contains are relative to the directory of the file.
+func LoadUpgradeRehearsal(file string) (r UpgradeRehearsal, err error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&r)
+	if err != nil {
+		return UpgradeRehearsal{}, fmt.Errorf("cannot decode upgrade rehearsal %s: %w", file, err)
+	}
+
+	baseDir := filepath.Dir(file)
+	resolve := func(path *string) {
+		if *path != "" && !filepath.IsAbs(*path) {
+			*path = filepath.Join(baseDir, *path)
+		}
+	}
+	resolve(&r.Template)
+	resolve(&r.ConsensusFile)
+	resolve(&r.BinDir)
+	for i := range r.Swaps {
+		resolve(&r.Swaps[i].BinDir)
+	}
+	return r, nil
+}
+
+// consensus returns the protocols defined by the consensus file of the rehearsal, if any.
+func (r UpgradeRehearsal) consensus() (config.ConsensusProtocols, error) {
+	if r.ConsensusFile == "" {
+		return nil, nil
+	}
+	f, err := os.Open(r.ConsensusFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	consensus := make(config.ConsensusProtocols)
+	err = json.NewDecoder(f).Decode(&consensus)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode consensus file %s: %w", r.ConsensusFile, err)
+	}
+	return consensus, nil
+}
+
+// Validate checks the rehearsal against its network template and the consensus protocols
+// the network starts with.
+func (r UpgradeRehearsal) Validate(template NetworkTemplate, consensus config.ConsensusProtocols) error {
+	if r.FromProtocol == "" || r.ToProtocol == "" || r.FromProtocol == r.ToProtocol {
+		return fmt.Errorf("invalid upgrade rehearsal: FromProtocol and ToProtocol must be two different protocols")
+	}
+	if r.BinDir == "" {
+		return fmt.Errorf("invalid upgrade rehearsal: no BinDir")
+	}
+	params, ok := consensus[r.FromProtocol]
+	if !ok {
+		return fmt.Errorf("invalid upgrade rehearsal: unknown protocol %s", r.FromProtocol)
+	}
+	if _, ok := params.ApprovedUpgrades[r.ToProtocol]; !ok && len(r.Swaps) == 0 {
+		return fmt.Errorf("invalid upgrade rehearsal: %s does not approve the upgrade to %s, and no binary swap may change that", r.FromProtocol, r.ToProtocol)
+	}
+
+	nodes := make(map[string]bool, len(template.Nodes))
+	for _, node := range template.Nodes {
+		nodes[strings.ToUpper(node.Name)] = true
+	}
+	for _, swap := range r.Swaps {
+		if !nodes[strings.ToUpper(swap.Node)] {
+			return fmt.Errorf("invalid upgrade rehearsal: swap of unknown node %s", swap.Node)
+		}
+		if swap.Round == 0 || swap.BinDir == "" {
+			return fmt.Errorf("invalid upgrade rehearsal: swap of node %s needs a Round and a BinDir", swap.Node)
+		}
+	}
+	return nil
+}
+
+// UpgradeReport is the outcome of an upgrade rehearsal.
+type UpgradeReport struct {
+	FromProtocol protocol.ConsensusVersion
+	ToProtocol   protocol.ConsensusVersion
+	// ProposalRound is the round of the first block proposing the upgrade.
+	ProposalRound uint64 `json:",omitempty"`
+	// Proposals counts the blocks proposing the upgrade; more than one means a vote failed.
+	Proposals uint64 `json:",omitempty"`
+	// VoteBefore, Approvals and Threshold describe the vote on the last proposal.
+	VoteBefore uint64 `json:",omitempty"`
+	Approvals  uint64 `json:",omitempty"`
+	Threshold  uint64
+	// ExpectedSwitchRound is the round ToProtocol was expected to become current on, and
+	// SwitchRound the one it did.
+	ExpectedSwitchRound uint64 `json:",omitempty"`
+	SwitchRound         uint64 `json:",omitempty"`
+	LastRound           uint64
+	Swaps               []SwapReport `json:",omitempty"`
+	Passed              bool
+	Failures            []string `json:",omitempty"`
+}
+
+// SwapReport describes a binary swap of an upgrade rehearsal.
+type SwapReport struct {
+	BinarySwap
+	// DoneRound is the round the network was on when the node was restarted.
+	DoneRound uint64 `json:",omitempty"`
+	Error     string `json:",omitempty"`
+}
+
+// WriteText writes the report in a human readable form.
+func (rep UpgradeReport) WriteText(w io.Writer) {
+	fmt.Fprintf(w, "Upgrade %s -> %s\n", rep.FromProtocol, rep.ToProtocol)
+	if rep.Proposals == 0 {
+		fmt.Fprintf(w, "  proposal:     none\n")
+	} else {
+		fmt.Fprintf(w, "  proposal:     round %d (%d proposals)\n", rep.ProposalRound, rep.Proposals)
+		fmt.Fprintf(w, "  vote:         %d approvals of %d needed before round %d\n", rep.Approvals, rep.Threshold, rep.VoteBefore)
+	}
+	fmt.Fprintf(w, "  switch:       round %d, expected round %d\n", rep.SwitchRound, rep.ExpectedSwitchRound)
+	for _, swap := range rep.Swaps {
+		status := fmt.Sprintf("at round %d", swap.DoneRound)
+		if swap.Error != "" {
+			status = "failed: " + swap.Error
+		} else if swap.DoneRound == 0 {
+			status = "not done"
+		}
+		fmt.Fprintf(w, "  swap:         %s to %s at round %d, %s\n", swap.Node, swap.BinDir, swap.Round, status)
+	}
+	fmt.Fprintf(w, "  last round:   %d\n", rep.LastRound)
+	if rep.Passed {
+		fmt.Fprintf(w, "PASSED\n")
+		return
+	}
+	fmt.Fprintf(w, "FAILED\n")
+	for _, failure := range rep.Failures {
+		fmt.Fprintf(w, "  - %s\n", failure)
+	}
+}
+
+// Save writes the report to a json file.
+func (rep UpgradeReport) Save(file string) error {
+	data, err := json.MarshalIndent(rep, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(data, '\n'), 0644)
+}
+
+// rehearsalNetwork is the part of a running network an upgrade rehearsal works with.
+type rehearsalNetwork interface {
+	// header blocks until the network agreed on round, and returns its block header.
+	header(round uint64) (bookkeeping.BlockHeader, error)
+	// swap restarts the node with the binaries of binDir.
+	swap(node, binDir string) error
+}
+
+// upgradeRehearsal follows the rounds of a rehearsal network, running the swaps and
+// collecting the report.
+type upgradeRehearsal struct {
+	UpgradeRehearsal
+	params config.ConsensusParams
+	report UpgradeReport
+	log    io.Writer
+}
+
+func makeUpgradeRehearsal(r UpgradeRehearsal, params config.ConsensusParams, log io.Writer) *upgradeRehearsal {
+	if r.PostSwitchRounds == 0 {
+		r.PostSwitchRounds = defaultRehearsalPostSwitchRounds
+	}
+	if r.MaxRounds == 0 {
+		r.MaxRounds = defaultRehearsalMaxRounds
+	}
+	ur := &upgradeRehearsal{
+		UpgradeRehearsal: r,
+		params:           params,
+		log:              log,
+	}
+	ur.report.FromProtocol = r.FromProtocol
+	ur.report.ToProtocol = r.ToProtocol
+	ur.report.Threshold = params.UpgradeThreshold
+	ur.report.ExpectedSwitchRound = r.SwitchRound
+	for _, swap := range r.Swaps {
+		ur.report.Swaps = append(ur.report.Swaps, SwapReport{BinarySwap: swap})
+	}
+	sort.SliceStable(ur.report.Swaps, func(i, j int) bool {
+		return ur.report.Swaps[i].Round < ur.report.Swaps[j].Round
+	})
+	return ur
+}
+
+func (ur *upgradeRehearsal) logf(format string, args ...interface{}) {
+	if ur.log != nil {
+		fmt.Fprintf(ur.log, format+"\n", args...)
+	}
+}
+
+// run follows the network until the new protocol has been current for PostSwitchRounds rounds,
+// or the rehearsal failed, and returns the report.
+func (ur *upgradeRehearsal) run(net rehearsalNetwork) UpgradeReport {
+	rep := &ur.report
+	for round := uint64(1); ; round++ {
+		hdr, err := net.header(round)
+		if err != nil {
+			rep.Failures = append(rep.Failures, err.Error())
+			break
+		}
+		rep.LastRound = round
+		ur.observe(hdr)
+
+		for i := range rep.Swaps {
+			swap := &rep.Swaps[i]
+			if swap.Round > round || swap.DoneRound != 0 || swap.Error != "" {
+				continue
+			}
+			ur.logf("round %d: restarting %s with %s", round, swap.Node, swap.BinDir)
+			err = net.swap(swap.Node, swap.BinDir)
+			if err != nil {
+				swap.Error = err.Error()
+				rep.Failures = append(rep.Failures, fmt.Sprintf("swap of %s failed: %v", swap.Node, err))
+				continue
+			}
+			swap.DoneRound = round
+		}
+
+		if rep.SwitchRound != 0 && round >= rep.SwitchRound+ur.PostSwitchRounds {
+			break
+		}
+		if rep.SwitchRound == 0 && rep.ExpectedSwitchRound != 0 && round >= rep.ExpectedSwitchRound {
+			rep.Failures = append(rep.Failures, fmt.Sprintf("%s is not current at round %d", ur.ToProtocol, round))
+			break
+		}
+		if round >= ur.MaxRounds {
+			rep.Failures = append(rep.Failures, fmt.Sprintf("no switch to %s within %d rounds", ur.ToProtocol, ur.MaxRounds))
+			break
+		}
+	}
+
+	if rep.Proposals == 0 {
+		rep.Failures = append(rep.Failures, fmt.Sprintf("no block proposed the upgrade to %s", ur.ToProtocol))
+	}
+	for _, swap := range rep.Swaps {
+		if swap.DoneRound == 0 && swap.Error == "" {
+			rep.Failures = append(rep.Failures, fmt.Sprintf("swap of %s at round %d was not done", swap.Node, swap.Round))
+		}
+	}
+	rep.Passed = len(rep.Failures) == 0
+	return *rep
+}
+
+// observe updates the report with the block header of the next round.
+func (ur *upgradeRehearsal) observe(hdr bookkeeping.BlockHeader) {
+	rep := &ur.report
+	round := uint64(hdr.Round)
+
+	if hdr.UpgradePropose == ur.ToProtocol {
+		rep.Proposals++
+		rep.ProposalRound = round
+		rep.VoteBefore = uint64(hdr.NextProtocolVoteBefore)
+		if ur.SwitchRound == 0 {
+			rep.ExpectedSwitchRound = uint64(hdr.NextProtocolSwitchOn)
+		}
+		ur.logf("round %d: upgrade to %s proposed, vote before round %d, switch on round %d", round, ur.ToProtocol, hdr.NextProtocolVoteBefore, hdr.NextProtocolSwitchOn)
+	}
+	if hdr.NextProtocol == ur.ToProtocol {
+		if rep.Approvals < ur.params.UpgradeThreshold && hdr.NextProtocolApprovals >= ur.params.UpgradeThreshold {
+			ur.logf("round %d: upgrade to %s approved", round, ur.ToProtocol)
+		}
+		rep.Approvals = hdr.NextProtocolApprovals
+	}
+	if round == rep.VoteBefore && hdr.NextProtocol != ur.ToProtocol && hdr.CurrentProtocol != ur.ToProtocol {
+		ur.logf("round %d: upgrade to %s rejected with %d approvals", round, ur.ToProtocol, rep.Approvals)
+	}
+	if hdr.CurrentProtocol == ur.ToProtocol && rep.SwitchRound == 0 {
+		rep.SwitchRound = round
+		ur.logf("round %d: switched to %s", round, ur.ToProtocol)
+		if rep.ExpectedSwitchRound != 0 && round != rep.ExpectedSwitchRound {
+			rep.Failures = append(rep.Failures, fmt.Sprintf("switched to %s on round %d instead of round %d", ur.ToProtocol, round, rep.ExpectedSwitchRound))
+		}
+	}
+}
+
+// deployedRehearsalNetwork is a started private network, followed through one of its nodes.
+type deployedRehearsalNetwork struct {
+	network      Network
+	binDir       string
+	observer     string
+	stallTimeout time.Duration
+}
+
+func (d *deployedRehearsalNetwork) header(round uint64) (bookkeeping.BlockHeader, error) {
+	deadline := time.Now().Add(d.stallTimeout)
+	var err error
+	for time.Now().Before(deadline) {
+		// the client is made again on every attempt, since the node may have been restarted
+		// on another address.
+		var client libgoal.Client
+		client, err = libgoal.MakeClientWithBinDir(d.binDir, d.observer, d.observer, libgoal.DynamicClient)
+		if err == nil {
+			var status model.NodeStatusResponse
+			status, err = client.WaitForRound(round - 1)
+			if err == nil && status.LastRound >= round {
+				var blk bookkeeping.Block
+				blk, err = client.BookkeepingBlock(round)
+				if err == nil {
+					return blk.BlockHeader, nil
+				}
+			}
+		}
+		time.Sleep(time.Second)
+	}
+	if err != nil {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("network stalled before round %d: %w", round, err)
+	}
+	return bookkeeping.BlockHeader{}, fmt.Errorf("network stalled before round %d", round)
+}
+
+func (d *deployedRehearsalNetwork) swap(node, binDir string) error {
+	return d.network.RestartNode(binDir, node, false)
+}
+
+// RehearseUpgrade creates the network of the rehearsal under rootDir, starts it and follows it
+// until the upgrade happened or failed. Progress is written to log. The network is left
+// running, so that it can be inspected, and has to be stopped by the caller.
+func RehearseUpgrade(r UpgradeRehearsal, name, rootDir string, log io.Writer) (Network, UpgradeReport, error) {
+	template, err := loadTemplate(r.Template)
+	if err != nil {
+		return Network{}, UpgradeReport{}, err
+	}
+	template.Genesis.ConsensusProtocol = r.FromProtocol
+
+	consensus, err := r.consensus()
+	if err != nil {
+		return Network{}, UpgradeReport{}, err
+	}
+	merged := config.Consensus.Merge(consensus)
+	err = r.Validate(template, merged)
+	if err != nil {
+		return Network{}, UpgradeReport{}, err
+	}
+
+	network, err := createNetwork(name, rootDir, r.Template, template, r.BinDir, true, nil, consensus)
+	if err != nil {
+		return network, UpgradeReport{}, err
+	}
+	err = network.Start(r.BinDir, false)
+	if err != nil {
+		return network, UpgradeReport{}, err
+	}
+
+	stallTimeout := defaultRehearsalStallTimeout
+	if r.StallTimeoutSeconds != 0 {
+		stallTimeout = time.Duration(r.StallTimeoutSeconds) * time.Second
+	}
+	net := &deployedRehearsalNetwork{
+		network:      network,
+		binDir:       r.BinDir,
+		observer:     network.PrimaryDataDir(),
+		stallTimeout: stallTimeout,
+	}
+	ur := makeUpgradeRehearsal(r, merged[r.FromProtocol], log)
+	return network, ur.run(net), nil
+}
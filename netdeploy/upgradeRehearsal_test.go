@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netdeploy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const rehearsalFrom, rehearsalTo = protocol.ConsensusVersion("rehearsal-from"), protocol.ConsensusVersion("rehearsal-to")
+
+func rehearsalConsensus() config.ConsensusProtocols {
+	from := config.Consensus[protocol.ConsensusFuture]
+	from.UpgradeVoteRounds = 5
+	from.UpgradeThreshold = 3
+	from.DefaultUpgradeWaitRounds = 3
+	from.ApprovedUpgrades = map[protocol.ConsensusVersion]uint64{rehearsalTo: 0}
+	to := config.Consensus[protocol.ConsensusFuture]
+	to.ApprovedUpgrades = map[protocol.ConsensusVersion]uint64{}
+	return config.ConsensusProtocols{rehearsalFrom: from, rehearsalTo: to}
+}
+
+// fakeRehearsalNetwork proposes the upgrade on round proposeAt, approves it on every round
+// of the vote, and switches on round switchAt.
+type fakeRehearsalNetwork struct {
+	proposeAt uint64
+	switchAt  uint64
+	stallAt   uint64
+	swapErr   error
+	swaps     []string
+}
+
+func (f *fakeRehearsalNetwork) header(round uint64) (hdr bookkeeping.BlockHeader, err error) {
+	if f.stallAt != 0 && round >= f.stallAt {
+		return hdr, fmt.Errorf("network stalled before round %d", round)
+	}
+	hdr.Round = basics.Round(round)
+	hdr.CurrentProtocol = rehearsalFrom
+	if f.switchAt != 0 && round >= f.switchAt {
+		hdr.CurrentProtocol = rehearsalTo
+		return hdr, nil
+	}
+	if f.proposeAt != 0 && round >= f.proposeAt {
+		if round == f.proposeAt {
+			hdr.UpgradePropose = rehearsalTo
+		}
+		hdr.NextProtocol = rehearsalTo
+		hdr.NextProtocolVoteBefore = basics.Round(f.proposeAt + 5)
+		hdr.NextProtocolSwitchOn = basics.Round(f.proposeAt + 8)
+		hdr.NextProtocolApprovals = round - f.proposeAt + 1
+	}
+	return hdr, nil
+}
+
+func (f *fakeRehearsalNetwork) swap(node, binDir string) error {
+	f.swaps = append(f.swaps, node+":"+binDir)
+	return f.swapErr
+}
+
+func TestUpgradeRehearsalRun(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	params := rehearsalConsensus()[rehearsalFrom]
+	rehearsal := UpgradeRehearsal{
+		FromProtocol: rehearsalFrom,
+		ToProtocol:   rehearsalTo,
+		Swaps: []BinarySwap{
+			{Node: "Node", Round: 4, BinDir: "new"},
+			{Node: "Primary", Round: 2, BinDir: "new"},
+		},
+	}
+
+	t.Run("passed", func(t *testing.T) {
+		net := &fakeRehearsalNetwork{proposeAt: 1, switchAt: 9}
+		var log strings.Builder
+		report := makeUpgradeRehearsal(rehearsal, params, &log).run(net)
+		require.True(t, report.Passed, report.Failures)
+		require.Equal(t, uint64(1), report.ProposalRound)
+		require.Equal(t, uint64(1), report.Proposals)
+		require.Equal(t, uint64(6), report.VoteBefore)
+		require.Equal(t, uint64(8), report.Approvals)
+		require.Equal(t, uint64(3), report.Threshold)
+		require.Equal(t, uint64(9), report.ExpectedSwitchRound)
+		require.Equal(t, uint64(9), report.SwitchRound)
+		require.Equal(t, uint64(9+defaultRehearsalPostSwitchRounds), report.LastRound)
+		require.Equal(t, []string{"Primary:new", "Node:new"}, net.swaps)
+		require.Equal(t, uint64(2), report.Swaps[0].DoneRound)
+		require.Equal(t, uint64(4), report.Swaps[1].DoneRound)
+		require.Contains(t, log.String(), "round 9: switched to rehearsal-to")
+
+		var text strings.Builder
+		report.WriteText(&text)
+		require.Contains(t, text.String(), "PASSED")
+	})
+
+	t.Run("late switch", func(t *testing.T) {
+		report := makeUpgradeRehearsal(rehearsal, params, nil).run(&fakeRehearsalNetwork{proposeAt: 1, switchAt: 11})
+		require.False(t, report.Passed)
+		require.Equal(t, []string{"rehearsal-to is not current at round 9"}, report.Failures)
+	})
+
+	t.Run("expected round", func(t *testing.T) {
+		r := rehearsal
+		r.SwitchRound = 8
+		report := makeUpgradeRehearsal(r, params, nil).run(&fakeRehearsalNetwork{proposeAt: 1, switchAt: 9})
+		require.False(t, report.Passed)
+		require.Equal(t, []string{"rehearsal-to is not current at round 8"}, report.Failures)
+	})
+
+	t.Run("no proposal", func(t *testing.T) {
+		r := rehearsal
+		r.MaxRounds = 20
+		report := makeUpgradeRehearsal(r, params, nil).run(&fakeRehearsalNetwork{})
+		require.False(t, report.Passed)
+		require.Equal(t, []string{"no switch to rehearsal-to within 20 rounds", "no block proposed the upgrade to rehearsal-to"}, report.Failures)
+	})
+
+	t.Run("stall and swap failure", func(t *testing.T) {
+		net := &fakeRehearsalNetwork{proposeAt: 1, switchAt: 9, stallAt: 3, swapErr: errors.New("no algod")}
+		report := makeUpgradeRehearsal(rehearsal, params, nil).run(net)
+		require.False(t, report.Passed)
+		require.Equal(t, []string{
+			"swap of Primary failed: no algod",
+			"network stalled before round 3",
+			"swap of Node at round 4 was not done",
+		}, report.Failures)
+		require.Equal(t, "no algod", report.Swaps[0].Error)
+
+		var text strings.Builder
+		report.WriteText(&text)
+		require.Contains(t, text.String(), "swap:         Primary to new at round 2, failed: no algod")
+		require.Contains(t, text.String(), "FAILED")
+	})
+}
+
+func TestUpgradeRehearsalLoadAndValidate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	consensus := rehearsalConsensus()
+	data, err := json.Marshal(consensus)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, config.ConfigurableConsensusProtocolsFilename), data, 0644))
+
+	templateDir, err := filepath.Abs("../test/testdata/nettemplates")
+	require.NoError(t, err)
+	plan := fmt.Sprintf(`{
+		"Template": %q,
+		"ConsensusFile": "consensus.json",
+		"FromProtocol": "rehearsal-from",
+		"ToProtocol": "rehearsal-to",
+		"BinDir": "old",
+		"Swaps": [{"Node": "Node1", "Round": 3, "BinDir": "new"}]
+	}`, filepath.Join(templateDir, "TwoNodes50EachWithRelay.json"))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rehearsal.json"), []byte(plan), 0644))
+
+	r, err := LoadUpgradeRehearsal(filepath.Join(dir, "rehearsal.json"))
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "consensus.json"), r.ConsensusFile)
+	require.Equal(t, filepath.Join(dir, "old"), r.BinDir)
+	require.Equal(t, filepath.Join(dir, "new"), r.Swaps[0].BinDir)
+
+	loaded, err := r.consensus()
+	require.NoError(t, err)
+	merged := config.Consensus.Merge(loaded)
+	template, err := loadTemplate(r.Template)
+	require.NoError(t, err)
+	require.NoError(t, r.Validate(template, merged))
+
+	bad := r
+	bad.Swaps = []BinarySwap{{Node: "Missing", Round: 3, BinDir: "new"}}
+	require.ErrorContains(t, bad.Validate(template, merged), "swap of unknown node Missing")
+
+	bad = r
+	bad.Swaps = nil
+	bad.ToProtocol = protocol.ConsensusFuture
+	require.ErrorContains(t, bad.Validate(template, merged), "does not approve the upgrade")
+
+	bad = r
+	bad.FromProtocol = "unknown"
+	require.ErrorContains(t, bad.Validate(template, merged), "unknown protocol unknown")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "typo.json"), []byte(`{"Swap": []}`), 0644))
+	_, err = LoadUpgradeRehearsal(filepath.Join(dir, "typo.json"))
+	require.ErrorContains(t, err, "unknown field")
+}
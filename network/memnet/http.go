@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package memnet
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// roundTripper serves the http requests made by a node with the handlers registered by the
+// node they are addressed to. The requests are not subject to the latency, loss or bandwidth
+// of the links, but fail when the nodes cannot reach each other.
+type roundTripper struct {
+	node *Node
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *roundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	target, err := t.node.hub.resolve(t.node, request.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+	if err = request.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	// the handlers expect a request as a server would have received it.
+	served := request.Clone(request.Context())
+	served.RequestURI = request.URL.RequestURI()
+	served.RemoteAddr = t.node.name + ":0"
+	if served.Body == nil {
+		served.Body = http.NoBody
+	}
+	recorder := &responseRecorder{header: make(http.Header)}
+	target.mu.RLock()
+	router := target.router
+	target.mu.RUnlock()
+	router.ServeHTTP(recorder, served)
+
+	if !recorder.wroteHeader {
+		recorder.WriteHeader(http.StatusOK)
+	}
+	header := recorder.finalHeader
+	header.Set("Content-Length", strconv.Itoa(recorder.body.Len()))
+	return &http.Response{
+		Status:        strconv.Itoa(recorder.status) + " " + http.StatusText(recorder.status),
+		StatusCode:    recorder.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(recorder.body.Bytes())),
+		ContentLength: int64(recorder.body.Len()),
+		Request:       request,
+	}, nil
+}
+
+// responseRecorder is the http.ResponseWriter the handlers write their response to.
+type responseRecorder struct {
+	header      http.Header
+	finalHeader http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.status = status
+	r.finalHeader = r.header.Clone()
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	return r.body.Write(data)
+}
+
+// Flush implements http.Flusher; the response is only returned once the handler is done.
+func (r *responseRecorder) Flush() {}
@@ -0,0 +1,314 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package memnet implements network.GossipNode on top of an in-memory hub, so that several
+// nodes could run within a single process. The hub delivers the messages over links whose
+// latency, loss and bandwidth could be configured, and which could be partitioned and healed.
+// Time is driven by a timers.VirtualClock, and the random decisions made on every link are
+// drawn from a generator seeded by the hub's seed, which lets a test reproduce a run.
+package memnet
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+// ErrUnreachable is returned for http requests made to a node which is stopped, or which is
+// not reachable from the requesting node.
+var ErrUnreachable = errors.New("memnet: node is unreachable")
+
+// LinkConfig describes the properties of a link carrying the messages from one node to another.
+type LinkConfig struct {
+	// Latency is the time it takes a message to reach the other end of the link.
+	Latency time.Duration
+	// Jitter is the upper bound of a random delay added to the latency of each message.
+	// A jitter may reorder the messages.
+	Jitter time.Duration
+	// Loss is the probability that a message is dropped, between 0 and 1.
+	Loss float64
+	// Bandwidth is the number of bytes per second the link could carry. The messages are
+	// queued behind each other until the link is free. Zero means unlimited.
+	Bandwidth uint64
+}
+
+// Stats counts the messages handled by the hub.
+type Stats struct {
+	// Sent is the number of messages which were put on a link.
+	Sent uint64
+	// Delivered is the number of messages which reached their destination.
+	Delivered uint64
+	// Dropped is the number of messages lost to a partition, a stopped node, the link loss or
+	// a full incoming queue.
+	Dropped uint64
+}
+
+type linkKey struct {
+	from, to string
+}
+
+type link struct {
+	config    *LinkConfig
+	rng       *rand.Rand
+	busyUntil time.Duration
+}
+
+// Hub connects the nodes of an in-memory network.
+type Hub struct {
+	clock     *timers.VirtualClock
+	seed      int64
+	genesisID string
+	log       logging.Logger
+
+	mu          deadlock.Mutex
+	nodes       map[string]*Node
+	hosts       map[string]*Node
+	defaultLink LinkConfig
+	links       map[linkKey]*link
+	// partition maps every node name to its group; nodes in different groups cannot reach
+	// each other. It is nil when the network is not partitioned.
+	partition    map[string]int
+	disconnected map[linkKey]bool
+	stats        Stats
+}
+
+// MakeHub creates a hub whose links are driven by the given clock, and whose random decisions
+// are seeded by seed.
+func MakeHub(clock *timers.VirtualClock, seed int64, genesisID string, log logging.Logger) *Hub {
+	return &Hub{
+		clock:        clock,
+		seed:         seed,
+		genesisID:    genesisID,
+		log:          log,
+		nodes:        make(map[string]*Node),
+		hosts:        make(map[string]*Node),
+		links:        make(map[linkKey]*link),
+		disconnected: make(map[linkKey]bool),
+	}
+}
+
+// AddNode creates a node attached to the hub. The name must be usable as a host name, as the
+// address of the node is http://<name>.
+func (h *Hub) AddNode(name string) (*Node, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, has := h.nodes[name]; has {
+		return nil, fmt.Errorf("memnet: node %s already exists", name)
+	}
+	n := makeNode(h, name)
+	h.nodes[name] = n
+	h.hosts[name] = n
+	return n, nil
+}
+
+// SetDefaultLink sets the configuration of the links which were not configured by SetLink.
+func (h *Hub) SetDefaultLink(config LinkConfig) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.defaultLink = config
+}
+
+// SetLink sets the configuration of the link carrying the messages from one node to the other.
+// The link in the opposite direction is not affected.
+func (h *Hub) SetLink(from, to string, config LinkConfig) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.linkLocked(from, to).config = &config
+}
+
+// Partition splits the network into the given groups of node names. Nodes which are not part
+// of any group are isolated from all the others. Messages in flight between the groups are
+// dropped when they arrive.
+func (h *Hub) Partition(groups ...[]string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.partition = make(map[string]int)
+	for i, group := range groups {
+		for _, name := range group {
+			h.partition[name] = i + 1
+		}
+	}
+}
+
+// Heal undoes the partitioning of the network, as well as the disconnections requested by
+// the nodes.
+func (h *Hub) Heal() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.partition = nil
+	h.disconnected = make(map[linkKey]bool)
+}
+
+// Stats returns the message counters of the hub.
+func (h *Hub) Stats() Stats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.stats
+}
+
+func (h *Hub) linkLocked(from, to string) *link {
+	key := linkKey{from: from, to: to}
+	l, has := h.links[key]
+	if !has {
+		// every link has its own generator, so that the decisions made on one link do
+		// not depend on the traffic of the others.
+		hash := fnv.New64a()
+		hash.Write([]byte(from + "->" + to))
+		l = &link{rng: rand.New(rand.NewSource(h.seed ^ int64(hash.Sum64())))}
+		h.links[key] = l
+	}
+	return l
+}
+
+// reachableLocked returns whether messages could flow from one node to the other.
+func (h *Hub) reachableLocked(from, to *Node) bool {
+	if from == to || !from.running || !to.running {
+		return false
+	}
+	if h.disconnected[linkKey{from: from.name, to: to.name}] {
+		return false
+	}
+	if h.partition != nil {
+		group, has := h.partition[from.name]
+		if !has || group != h.partition[to.name] {
+			return false
+		}
+	}
+	return true
+}
+
+// peersOf returns the nodes reachable from the given node, ordered by name.
+func (h *Hub) peersOf(n *Node) []*Node {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	peers := make([]*Node, 0, len(h.nodes))
+	for _, other := range h.nodes {
+		if h.reachableLocked(n, other) {
+			peers = append(peers, other)
+		}
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].name < peers[j].name })
+	return peers
+}
+
+// send puts a message on the link between the two nodes.
+func (h *Hub) send(from, to *Node, tag protocol.Tag, data []byte) {
+	h.mu.Lock()
+	if !h.reachableLocked(from, to) {
+		h.stats.Dropped++
+		h.mu.Unlock()
+		return
+	}
+	l := h.linkLocked(from.name, to.name)
+	config := h.defaultLink
+	if l.config != nil {
+		config = *l.config
+	}
+	if config.Loss > 0 && l.rng.Float64() < config.Loss {
+		h.stats.Dropped++
+		h.mu.Unlock()
+		return
+	}
+	now := h.clock.Now()
+	delay := config.Latency
+	if config.Jitter > 0 {
+		delay += time.Duration(l.rng.Int63n(int64(config.Jitter) + 1))
+	}
+	if config.Bandwidth > 0 {
+		start := now
+		if l.busyUntil > start {
+			start = l.busyUntil
+		}
+		l.busyUntil = start + time.Duration(uint64(len(data))*uint64(time.Second)/config.Bandwidth)
+		delay += l.busyUntil - now
+	}
+	h.stats.Sent++
+	h.mu.Unlock()
+
+	// the sender may reuse its buffer once the call returns.
+	payload := append([]byte(nil), data...)
+	h.clock.AfterFunc(delay, func() {
+		h.deliver(from, to, tag, payload)
+	})
+}
+
+func (h *Hub) deliver(from, to *Node, tag protocol.Tag, data []byte) {
+	h.mu.Lock()
+	if !h.reachableLocked(from, to) {
+		h.stats.Dropped++
+		h.mu.Unlock()
+		return
+	}
+	h.mu.Unlock()
+
+	if !to.enqueue(to.peer(from), tag, data) {
+		h.mu.Lock()
+		h.stats.Dropped++
+		h.mu.Unlock()
+		return
+	}
+	h.mu.Lock()
+	h.stats.Delivered++
+	h.mu.Unlock()
+}
+
+// disconnect stops the messages from flowing between the two nodes, in both directions,
+// until the network is healed or either node asks to connect again.
+func (h *Hub) disconnect(a, b string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.disconnected[linkKey{from: a, to: b}] = true
+	h.disconnected[linkKey{from: b, to: a}] = true
+}
+
+func (h *Hub) reconnect(name string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key := range h.disconnected {
+		if key.from == name || key.to == name {
+			delete(h.disconnected, key)
+		}
+	}
+}
+
+// resolve returns the node serving the given host, if it could be reached from the given node.
+func (h *Hub) resolve(from *Node, host string) (*Node, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	to, has := h.hosts[host]
+	if !has {
+		return nil, fmt.Errorf("memnet: unknown host %s", host)
+	}
+	if !h.reachableLocked(from, to) {
+		return nil, ErrUnreachable
+	}
+	return to, nil
+}
+
+func (h *Hub) setRunning(n *Node, running bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	n.running = running
+}
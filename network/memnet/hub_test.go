@@ -0,0 +1,215 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package memnet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+type received struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (r *received) handler(node string) network.TaggedMessageHandler {
+	return network.TaggedMessageHandler{
+		Tag: protocol.TxnTag,
+		MessageHandler: network.HandlerFunc(func(msg network.IncomingMessage) network.OutgoingMessage {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.messages = append(r.messages, fmt.Sprintf("%s<-%s:%s", node, msg.Sender.(*Peer).to.name, msg.Data))
+			return network.OutgoingMessage{Action: network.Ignore}
+		}),
+	}
+}
+
+func (r *received) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.messages...)
+}
+
+func makeTestHub(t *testing.T, seed int64, names ...string) (*Hub, *timers.VirtualClock, []*Node, *received) {
+	clock := timers.MakeVirtualClock()
+	hub := MakeHub(clock, seed, "test-v1", logging.TestingLog(t))
+	nodes := make([]*Node, len(names))
+	r := &received{}
+	for i, name := range names {
+		node, err := hub.AddNode(name)
+		require.NoError(t, err)
+		node.RegisterHandlers([]network.TaggedMessageHandler{r.handler(name)})
+		node.Start()
+		t.Cleanup(node.Stop)
+		nodes[i] = node
+	}
+	return hub, clock, nodes, r
+}
+
+// advance moves the clock forward and waits for the delivered messages to be handled.
+func advance(clock *timers.VirtualClock, r *received, d time.Duration, expected int) func() bool {
+	clock.Advance(d)
+	return func() bool { return len(r.get()) >= expected }
+}
+
+func TestHubLatency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	hub, clock, nodes, r := makeTestHub(t, 1, "a", "b", "c")
+	hub.SetDefaultLink(LinkConfig{Latency: 100 * time.Millisecond})
+	hub.SetLink("a", "c", LinkConfig{Latency: time.Second})
+
+	_, err := hub.AddNode("a")
+	require.Error(t, err)
+
+	require.NoError(t, nodes[0].Broadcast(context.Background(), protocol.TxnTag, []byte("1"), false, nil))
+	clock.Advance(50 * time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	require.Empty(t, r.get())
+
+	require.Eventually(t, advance(clock, r, 50*time.Millisecond, 1), time.Second, time.Millisecond)
+	require.Equal(t, []string{"b<-a:1"}, r.get())
+	require.Eventually(t, advance(clock, r, 900*time.Millisecond, 2), time.Second, time.Millisecond)
+	require.Equal(t, []string{"b<-a:1", "c<-a:1"}, r.get())
+
+	// the except peer does not receive the message.
+	peers := nodes[1].GetPeers(network.PeersConnectedOut)
+	require.Len(t, peers, 2)
+	require.Equal(t, "http://a", peers[0].(network.HTTPPeer).GetAddress())
+	require.NoError(t, nodes[1].Broadcast(context.Background(), protocol.TxnTag, []byte("2"), false, peers[0]))
+	require.Eventually(t, advance(clock, r, 100*time.Millisecond, 3), time.Second, time.Millisecond)
+	require.Equal(t, "c<-b:2", r.get()[2])
+	require.Equal(t, Stats{Sent: 3, Delivered: 3}, hub.Stats())
+}
+
+func TestHubBandwidth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	hub, clock, nodes, r := makeTestHub(t, 1, "a", "b")
+	// 1000 bytes per second, so that each message takes a second to transmit.
+	hub.SetDefaultLink(LinkConfig{Bandwidth: 1000})
+	payload := make([]byte, 1000)
+	for i := 0; i < 3; i++ {
+		nodes[0].Broadcast(context.Background(), protocol.TxnTag, payload, false, nil)
+	}
+	for i := 1; i <= 3; i++ {
+		require.Eventually(t, advance(clock, r, time.Second, i), time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		require.Len(t, r.get(), i)
+	}
+}
+
+func TestHubLossReproducible(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	run := func(seed int64) []string {
+		hub, clock, nodes, r := makeTestHub(t, seed, "a", "b", "c")
+		hub.SetDefaultLink(LinkConfig{Latency: time.Millisecond, Loss: 0.5})
+		for i := 0; i < 100; i++ {
+			nodes[i%3].Broadcast(context.Background(), protocol.TxnTag, []byte(fmt.Sprintf("%d", i)), false, nil)
+		}
+		clock.Advance(time.Second)
+		stats := hub.Stats()
+		require.Equal(t, uint64(200), stats.Sent+stats.Dropped)
+		require.NotZero(t, stats.Dropped)
+		require.Eventually(t, func() bool { return len(r.get()) == int(stats.Sent) }, time.Second, time.Millisecond)
+		return r.get()
+	}
+	first := run(7)
+	require.ElementsMatch(t, first, run(7))
+	require.NotEqual(t, len(first), 200)
+}
+
+func TestHubPartition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	hub, clock, nodes, r := makeTestHub(t, 1, "a", "b", "c")
+	hub.SetDefaultLink(LinkConfig{Latency: time.Second})
+
+	// a message in flight when the partition happens is dropped.
+	nodes[0].Broadcast(context.Background(), protocol.TxnTag, []byte("1"), false, nil)
+	hub.Partition([]string{"a", "b"})
+	require.Len(t, nodes[0].GetPeers(), 1)
+	require.Empty(t, nodes[2].GetPeers())
+	nodes[2].Broadcast(context.Background(), protocol.TxnTag, []byte("2"), false, nil)
+	require.Eventually(t, advance(clock, r, time.Second, 1), time.Second, time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, []string{"b<-a:1"}, r.get())
+
+	hub.Heal()
+	nodes[2].Broadcast(context.Background(), protocol.TxnTag, []byte("3"), false, nil)
+	require.Eventually(t, advance(clock, r, time.Second, 3), time.Second, time.Millisecond)
+	require.ElementsMatch(t, []string{"b<-a:1", "a<-c:3", "b<-c:3"}, r.get())
+
+	// a disconnected peer is unreachable until the node asks to connect again.
+	nodes[0].Disconnect(nodes[0].GetPeers()[0])
+	require.Len(t, nodes[0].GetPeers(), 1)
+	require.Len(t, nodes[1].GetPeers(), 1)
+	nodes[0].RequestConnectOutgoing(false, nil)
+	require.Len(t, nodes[0].GetPeers(), 2)
+
+	// a stopped node is unreachable.
+	nodes[2].Stop()
+	require.Len(t, nodes[0].GetPeers(), 1)
+}
+
+func TestHubHTTP(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	hub, _, nodes, _ := makeTestHub(t, 1, "a", "b")
+	nodes[1].RegisterHTTPHandler("/v1/{genesisID}/echo/{value}", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprintf(w, "%s from %s", r.URL.Path, r.RemoteAddr)
+	}))
+
+	peer := nodes[0].GetPeers()[0].(network.HTTPPeer)
+	url := peer.GetAddress() + nodes[0].SubstituteGenesisID("/v1/{genesisID}/echo/x")
+	response, err := peer.GetHTTPClient().Get(url)
+	require.NoError(t, err)
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusAccepted, response.StatusCode)
+	require.Equal(t, "text/plain", response.Header.Get("Content-Type"))
+	require.Equal(t, "/v1/test-v1/echo/x from a:0", string(body))
+
+	response, err = peer.GetHTTPClient().Get(peer.GetAddress() + "/unknown")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	hub.Partition([]string{"a"}, []string{"b"})
+	_, err = peer.GetHTTPClient().Get(url)
+	require.ErrorIs(t, err, ErrUnreachable)
+}
@@ -0,0 +1,328 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package memnet
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/gorilla/mux"
+
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// incomingQueueSize is the number of messages which could be waiting for a node to handle
+// them; further messages are dropped, as a websocket peer would drop them.
+const incomingQueueSize = 10000
+
+var _ network.GossipNode = (*Node)(nil)
+var _ network.HTTPPeer = (*Peer)(nil)
+
+// Node is a network.GossipNode attached to a Hub.
+type Node struct {
+	hub       *Hub
+	name      string
+	transport *roundTripper
+
+	// running is guarded by the hub's lock.
+	running bool
+
+	mu       deadlock.RWMutex
+	handlers map[protocol.Tag]network.MessageHandler
+	router   *mux.Router
+	peers    map[string]*Peer
+	peerData map[*Peer]map[string]interface{}
+	ready    chan struct{}
+	incoming chan network.IncomingMessage
+	quit     chan struct{}
+	wg       sync.WaitGroup
+}
+
+// Peer is the way a node refers to another node of the hub. It implements network.HTTPPeer.
+type Peer struct {
+	from *Node
+	to   *Node
+}
+
+func makeNode(hub *Hub, name string) *Node {
+	n := &Node{
+		hub:      hub,
+		name:     name,
+		handlers: make(map[protocol.Tag]network.MessageHandler),
+		router:   mux.NewRouter(),
+		peers:    make(map[string]*Peer),
+		peerData: make(map[*Peer]map[string]interface{}),
+		ready:    make(chan struct{}),
+	}
+	n.transport = &roundTripper{node: n}
+	return n
+}
+
+// Name returns the name the node was added to the hub with.
+func (n *Node) Name() string {
+	return n.name
+}
+
+// peer returns the Peer through which n refers to other.
+func (n *Node) peer(other *Node) *Peer {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	p, has := n.peers[other.name]
+	if !has {
+		p = &Peer{from: n, to: other}
+		n.peers[other.name] = p
+	}
+	return p
+}
+
+// GetAddress implements network.HTTPPeer.
+func (p *Peer) GetAddress() string {
+	return "http://" + p.to.name
+}
+
+// GetHTTPClient implements network.HTTPPeer.
+func (p *Peer) GetHTTPClient() *http.Client {
+	return &http.Client{Transport: p.from.transport}
+}
+
+// Address implements network.GossipNode.
+func (n *Node) Address() (string, bool) {
+	n.hub.mu.Lock()
+	defer n.hub.mu.Unlock()
+	return "http://" + n.name, n.running
+}
+
+// Broadcast implements network.GossipNode.
+func (n *Node) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	for _, other := range n.hub.peersOf(n) {
+		if p := n.peer(other); network.Peer(p) != except {
+			n.hub.send(n, other, tag, data)
+		}
+	}
+	return nil
+}
+
+// BroadcastArray implements network.GossipNode.
+func (n *Node) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except network.Peer) error {
+	for i := range tags {
+		n.Broadcast(ctx, tags[i], data[i], wait, except)
+	}
+	return nil
+}
+
+// Relay implements network.GossipNode. Every node of the hub relays the messages.
+func (n *Node) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	return n.Broadcast(ctx, tag, data, wait, except)
+}
+
+// RelayArray implements network.GossipNode.
+func (n *Node) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except network.Peer) error {
+	return n.BroadcastArray(ctx, tags, data, wait, except)
+}
+
+// Disconnect implements network.GossipNode.
+func (n *Node) Disconnect(badnode network.Peer) {
+	if p, ok := badnode.(*Peer); ok {
+		n.hub.disconnect(n.name, p.to.name)
+	}
+}
+
+// DisconnectPeers implements network.GossipNode.
+func (n *Node) DisconnectPeers() {
+	for _, other := range n.hub.peersOf(n) {
+		n.hub.disconnect(n.name, other.name)
+	}
+}
+
+// Ready implements network.GossipNode. The channel is closed once the node is started.
+func (n *Node) Ready() chan struct{} {
+	return n.ready
+}
+
+// RegisterHTTPHandler implements network.GossipNode.
+func (n *Node) RegisterHTTPHandler(path string, handler http.Handler) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.router.Handle(path, handler)
+}
+
+// RequestConnectOutgoing implements network.GossipNode. It undoes the disconnections of the
+// node; the partitions of the hub still apply.
+func (n *Node) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	n.hub.reconnect(n.name)
+}
+
+// GetPeers implements network.GossipNode. All the reachable nodes are returned, whatever the
+// options are.
+func (n *Node) GetPeers(options ...network.PeerOption) []network.Peer {
+	nodes := n.hub.peersOf(n)
+	peers := make([]network.Peer, len(nodes))
+	for i, other := range nodes {
+		peers[i] = n.peer(other)
+	}
+	return peers
+}
+
+// Start implements network.GossipNode.
+func (n *Node) Start() {
+	n.mu.Lock()
+	if n.quit != nil {
+		n.mu.Unlock()
+		return
+	}
+	n.incoming = make(chan network.IncomingMessage, incomingQueueSize)
+	n.quit = make(chan struct{})
+	n.wg.Add(1)
+	go n.handleIncoming(n.incoming, n.quit)
+	select {
+	case <-n.ready:
+	default:
+		close(n.ready)
+	}
+	n.mu.Unlock()
+	n.hub.setRunning(n, true)
+}
+
+// Stop implements network.GossipNode.
+func (n *Node) Stop() {
+	n.hub.setRunning(n, false)
+	n.mu.Lock()
+	if n.quit == nil {
+		n.mu.Unlock()
+		return
+	}
+	close(n.quit)
+	n.quit = nil
+	n.incoming = nil
+	n.mu.Unlock()
+	n.wg.Wait()
+}
+
+// RegisterHandlers implements network.GossipNode.
+func (n *Node) RegisterHandlers(dispatch []network.TaggedMessageHandler) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, handler := range dispatch {
+		n.handlers[handler.Tag] = handler.MessageHandler
+	}
+}
+
+// ClearHandlers implements network.GossipNode.
+func (n *Node) ClearHandlers() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.handlers = make(map[protocol.Tag]network.MessageHandler)
+}
+
+// GetRoundTripper implements network.GossipNode.
+func (n *Node) GetRoundTripper() http.RoundTripper {
+	return n.transport
+}
+
+// OnNetworkAdvance implements network.GossipNode.
+func (n *Node) OnNetworkAdvance() {}
+
+// GetHTTPRequestConnection implements network.GossipNode. There is no connection underlying
+// the requests made over the hub.
+func (n *Node) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	return nil
+}
+
+// RegisterMessageInterest implements network.GossipNode. All the messages are delivered.
+func (n *Node) RegisterMessageInterest(protocol.Tag) {}
+
+// SubstituteGenesisID implements network.GossipNode.
+func (n *Node) SubstituteGenesisID(rawURL string) string {
+	return strings.Replace(rawURL, "{genesisID}", n.hub.genesisID, -1)
+}
+
+// GetPeerData implements network.GossipNode.
+func (n *Node) GetPeerData(peer network.Peer, key string) interface{} {
+	p, ok := peer.(*Peer)
+	if !ok {
+		return nil
+	}
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.peerData[p][key]
+}
+
+// SetPeerData implements network.GossipNode.
+func (n *Node) SetPeerData(peer network.Peer, key string, value interface{}) {
+	p, ok := peer.(*Peer)
+	if !ok {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.peerData[p] == nil {
+		n.peerData[p] = make(map[string]interface{})
+	}
+	n.peerData[p][key] = value
+}
+
+// enqueue queues a message for the node to handle. It returns false if the message was dropped.
+func (n *Node) enqueue(sender *Peer, tag protocol.Tag, data []byte) bool {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.incoming == nil {
+		return false
+	}
+	select {
+	case n.incoming <- network.IncomingMessage{Sender: sender, Tag: tag, Data: data, Net: n, Received: time.Now().UnixNano()}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (n *Node) handleIncoming(incoming <-chan network.IncomingMessage, quit <-chan struct{}) {
+	defer n.wg.Done()
+	for {
+		select {
+		case msg := <-incoming:
+			n.handle(msg)
+		case <-quit:
+			return
+		}
+	}
+}
+
+func (n *Node) handle(msg network.IncomingMessage) {
+	n.mu.RLock()
+	handler, has := n.handlers[msg.Tag]
+	n.mu.RUnlock()
+	if !has {
+		return
+	}
+	out := handler.Handle(msg)
+	sender := msg.Sender.(*Peer)
+	switch out.Action {
+	case network.Disconnect:
+		n.Disconnect(sender)
+	case network.Broadcast:
+		n.Broadcast(context.Background(), out.Tag, out.Payload, false, sender)
+	case network.Respond:
+		n.hub.send(n, sender.to, out.Tag, out.Payload)
+	}
+}
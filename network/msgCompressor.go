@@ -18,6 +18,7 @@ package network
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 
@@ -80,13 +81,14 @@ func zstdCompressMsg(tbytes []byte, d []byte) ([]byte, string) {
 const MaxDecompressedMessageSize = 20 * 1024 * 1024 // some large enough value
 
 // wsPeerMsgDataConverter performs optional incoming messages conversion.
-// At the moment it only supports zstd decompression for payload proposal
+// It supports zstd decompression for payload proposal and stateful decompression for votes
 type wsPeerMsgDataConverter struct {
 	log    logging.Logger
 	origin string
 
 	// actual converter(s)
 	ppdec zstdProposalDecompressor
+	avdec *statefulVoteDecoder
 }
 
 type zstdProposalDecompressor struct {
@@ -138,6 +140,17 @@ func (c *wsPeerMsgDataConverter) convert(tag protocol.Tag, data []byte) ([]byte,
 			}
 			c.log.Warnf("peer %s supported zstd but sent non-compressed data", c.origin)
 		}
+	} else if tag == protocol.AgreementVoteTag && c.avdec != nil {
+		// every vote goes through the decoder to keep its state in sync with the peer's encoder;
+		// once the states diverged the votes are dropped until the peer resets its table.
+		res, err := c.avdec.convert(data)
+		if errors.Is(err, errVoteReferenceUnknown) {
+			c.log.Warnf("peer %s: %v, dropping its compressed votes until its table is reset", c.origin, err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("peer %s: %w", c.origin, err)
+		}
+		return res, nil
 	}
 	return data, nil
 }
@@ -153,6 +166,9 @@ func makeWsPeerMsgDataConverter(wp *wsPeer) *wsPeerMsgDataConverter {
 			active: true,
 		}
 	}
+	if wp.pfVoteCompressionSupported() {
+		c.avdec = makeStatefulVoteDecoder()
+	}
 
 	return &c
 }
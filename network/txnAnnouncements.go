@@ -0,0 +1,193 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// The announce-then-request transaction relay replaces the transaction groups sent to the peers
+// which negotiated it with TxnAdvertiseTag messages holding the digests of the groups. The receiver
+// of an announcement requests the groups it has not seen yet with a TxnRequestTag message, which
+// is answered with regular TxnTag messages. Both messages are concatenations of digests.
+// A group is identified by the crypto.Hash of its message, which is its canonical encoding.
+
+var networkTxnAnnouncementsSent = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_announcements_sent_total", Description: "number of transaction groups announced instead of being sent"})
+var networkTxnRequestsServed = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_requests_served_total", Description: "number of announced transaction groups sent upon request"})
+var networkTxnRequestsMissed = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_requests_missed_total", Description: "number of requested transaction groups no longer available"})
+var networkTxnRequestsDropped = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_requests_dropped_total", Description: "number of requested transaction groups not sent because of the response size or a full send buffer"})
+
+// maxTxnAnnouncementDigests is the maximum number of digests in a single announcement or request
+const maxTxnAnnouncementDigests = 1024
+
+// maxTxnRequestResponseSize is the maximum total size of the transaction groups sent in response to a single request
+const maxTxnRequestResponseSize = 4 * 1024 * 1024
+
+// announcedTxnCacheSize is the number of announced transaction groups kept for each of the two cache pages
+const announcedTxnCacheSize = 25000
+
+// announcedTxnCacheInterval is the maximum time a cache page collects transaction groups before being rotated
+const announcedTxnCacheInterval = 10 * time.Second
+
+// announcedTxnCache keeps the recently announced transaction groups to answer the requests of peers.
+// Like the transaction handler digest caches, it rotates two pages: the groups are kept until their
+// page is rotated out, after which the requests for them are ignored.
+type announcedTxnCache struct {
+	mu deadlock.Mutex
+
+	cur        map[crypto.Digest]announcedTxn
+	prev       map[crypto.Digest]announcedTxn
+	curStarted time.Time
+}
+
+// announcedTxn is a transaction group message as prepared for the peers not supporting the announcements,
+// so that requests are answered without copying or hashing it again
+type announcedTxn struct {
+	// msg is the tagged message
+	msg []byte
+	// digest is the digest of msg for the outgoing message filter
+	digest crypto.Digest
+}
+
+func makeAnnouncedTxnCache() *announcedTxnCache {
+	return &announcedTxnCache{
+		cur:        make(map[crypto.Digest]announcedTxn),
+		curStarted: time.Now(),
+	}
+}
+
+// put adds the transaction group data under its digest, and returns the digest
+func (c *announcedTxnCache) put(data []byte, txn announcedTxn) crypto.Digest {
+	d := crypto.Hash(data)
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.cur) >= announcedTxnCacheSize || now.Sub(c.curStarted) > announcedTxnCacheInterval {
+		c.prev = c.cur
+		c.cur = make(map[crypto.Digest]announcedTxn, len(c.prev))
+		c.curStarted = now
+	}
+	c.cur[d] = txn
+	return d
+}
+
+// get returns the transaction group message of the digest, if it is still available
+func (c *announcedTxnCache) get(d crypto.Digest) (announcedTxn, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	txn, ok := c.cur[d]
+	if !ok {
+		txn, ok = c.prev[d]
+	}
+	return txn, ok
+}
+
+// checkCanAnnounce checks if there is a transaction message and peers supporting announcements
+func checkCanAnnounce(request broadcastRequest, peers []*wsPeer) bool {
+	hasTxn := false
+	for _, tag := range request.tags {
+		if tag == protocol.TxnTag {
+			hasTxn = true
+			break
+		}
+	}
+	if !hasTxn {
+		return false
+	}
+	for _, peer := range peers {
+		if peer.pfTxnAnnouncementSupported() {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshallTxnDigests returns the body of a TxnAdvertiseTag or TxnRequestTag message for the given digests
+func MarshallTxnDigests(digests []crypto.Digest) []byte {
+	data := make([]byte, 0, len(digests)*crypto.DigestSize)
+	for i := range digests {
+		data = append(data, digests[i][:]...)
+	}
+	return data
+}
+
+// UnmarshallTxnDigests parses the body of a TxnAdvertiseTag or TxnRequestTag message
+func UnmarshallTxnDigests(data []byte) ([]crypto.Digest, error) {
+	if len(data) == 0 || len(data)%crypto.DigestSize != 0 {
+		return nil, fmt.Errorf("invalid transaction digests message length %d", len(data))
+	}
+	count := len(data) / crypto.DigestSize
+	if count > maxTxnAnnouncementDigests {
+		return nil, fmt.Errorf("too many transaction digests %d > %d", count, maxTxnAnnouncementDigests)
+	}
+	digests := make([]crypto.Digest, count)
+	for i := range digests {
+		copy(digests[i][:], data[i*crypto.DigestSize:])
+	}
+	return digests, nil
+}
+
+// handleTxnRequest sends the requested transaction groups which are still available.
+// Like the filter messages, the requests are handled by the read loop instead of the general handlers,
+// so the work is bounded: the groups were prepared when announced, at most maxTxnRequestResponseSize
+// bytes of them are sent, and they are enqueued at once without waiting for room in the send buffer.
+// The groups which are not sent are left to the TxSyncer of the peer.
+func (wp *wsPeer) handleTxnRequest(msg IncomingMessage) {
+	if wp.net.announcedTxns == nil {
+		return
+	}
+	digests, err := UnmarshallTxnDigests(msg.Data)
+	if err != nil {
+		wp.net.log.Warnf("bad transaction request from %s: %v", wp.GetAddress(), err)
+		return
+	}
+	var msgs [][]byte
+	var msgDigests []crypto.Digest
+	size := 0
+	for _, d := range digests {
+		txn, ok := wp.net.announcedTxns.get(d)
+		if !ok {
+			networkTxnRequestsMissed.Inc(nil)
+			continue
+		}
+		if size+len(txn.msg) > maxTxnRequestResponseSize {
+			networkTxnRequestsDropped.Inc(nil)
+			continue
+		}
+		size += len(txn.msg)
+		msgs = append(msgs, txn.msg)
+		msgDigests = append(msgDigests, txn.digest)
+	}
+	if len(msgs) == 0 {
+		return
+	}
+	if !wp.writeNonBlockMsgs(context.Background(), msgs, false, msgDigests, time.Now()) {
+		wp.net.log.Debugf("unable to send %d requested transaction groups to %s", len(msgs), wp.GetAddress())
+		networkTxnRequestsDropped.AddUint64(uint64(len(msgs)), nil)
+		return
+	}
+	networkTxnRequestsServed.AddUint64(uint64(len(msgs)), nil)
+}
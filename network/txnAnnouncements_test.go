@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxnDigestsMarshalling(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	digests := []crypto.Digest{crypto.Hash([]byte("a")), crypto.Hash([]byte("b"))}
+	data := MarshallTxnDigests(digests)
+	require.Len(t, data, 2*crypto.DigestSize)
+	decoded, err := UnmarshallTxnDigests(data)
+	require.NoError(t, err)
+	require.Equal(t, digests, decoded)
+
+	_, err = UnmarshallTxnDigests(nil)
+	require.Error(t, err)
+	_, err = UnmarshallTxnDigests(data[1:])
+	require.Error(t, err)
+	_, err = UnmarshallTxnDigests(make([]byte, (maxTxnAnnouncementDigests+1)*crypto.DigestSize))
+	require.Error(t, err)
+}
+
+func TestAnnouncedTxnCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := makeAnnouncedTxnCache()
+	first := []byte("first")
+	firstTxn := announcedTxn{msg: append([]byte(protocol.TxnTag), first...), digest: crypto.Hash([]byte("digest"))}
+	d := c.put(first, firstTxn)
+	require.Equal(t, crypto.Hash(first), d)
+	txn, ok := c.get(d)
+	require.True(t, ok)
+	require.Equal(t, firstTxn, txn)
+
+	// the group survives one rotation, but not two
+	c.curStarted = time.Now().Add(-2 * announcedTxnCacheInterval)
+	c.put([]byte("second"), announcedTxn{})
+	_, ok = c.get(d)
+	require.True(t, ok)
+	c.curStarted = time.Now().Add(-2 * announcedTxnCacheInterval)
+	c.put([]byte("third"), announcedTxn{})
+	_, ok = c.get(d)
+	require.False(t, ok)
+}
+
+func TestHandleTxnRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	wn := &WebsocketNetwork{log: logging.TestingLog(t), announcedTxns: makeAnnouncedTxnCache()}
+	wp := &wsPeer{wsPeerCore: wsPeerCore{net: wn}, sendBufferBulk: make(chan sendMessages, 1)}
+
+	small := []byte("small")
+	smallTxn := announcedTxn{msg: append([]byte(protocol.TxnTag), small...), digest: crypto.Hash(small)}
+	large := make([]byte, maxTxnRequestResponseSize-len(smallTxn.msg)-len(protocol.TxnTag))
+	largeTxn := announcedTxn{msg: append([]byte(protocol.TxnTag), large...)}
+	smallDigest := wn.announcedTxns.put(small, smallTxn)
+	largeDigest := wn.announcedTxns.put(large, largeTxn)
+	unknownDigest := crypto.Hash([]byte("unknown"))
+
+	// the available groups are sent at once, up to the response size
+	request := MarshallTxnDigests([]crypto.Digest{smallDigest, unknownDigest, largeDigest, smallDigest})
+	wp.handleTxnRequest(IncomingMessage{Data: request})
+	require.Len(t, wp.sendBufferBulk, 1)
+	sent := <-wp.sendBufferBulk
+	require.Len(t, sent.msgs, 2)
+	require.Equal(t, smallTxn.msg, sent.msgs[0].data)
+	require.Equal(t, smallTxn.digest, sent.msgs[0].hash)
+	require.Equal(t, largeTxn.msg, sent.msgs[1].data)
+
+	// the read loop does not wait for room in the send buffer
+	wp.sendBufferBulk <- sendMessages{}
+	wp.handleTxnRequest(IncomingMessage{Data: request})
+	require.Len(t, wp.sendBufferBulk, 1)
+	require.Empty(t, (<-wp.sendBufferBulk).msgs)
+
+	// malformed requests and unknown groups are ignored
+	wp.handleTxnRequest(IncomingMessage{Data: request[1:]})
+	wp.handleTxnRequest(IncomingMessage{Data: MarshallTxnDigests([]crypto.Digest{unknownDigest})})
+	require.Empty(t, wp.sendBufferBulk)
+}
+
+func TestCheckCanAnnounce(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	peer1 := &wsPeer{features: pfCompressedProposal}
+	peer2 := &wsPeer{features: pfTxnAnnouncement}
+	req := broadcastRequest{tags: []protocol.Tag{protocol.AgreementVoteTag}}
+	require.False(t, checkCanAnnounce(req, []*wsPeer{peer1, peer2}))
+	req.tags = []protocol.Tag{protocol.AgreementVoteTag, protocol.TxnTag}
+	require.False(t, checkCanAnnounce(req, []*wsPeer{peer1}))
+	require.True(t, checkCanAnnounce(req, []*wsPeer{peer1, peer2}))
+}
+
+// txnRequester requests all the announced transaction groups, like the transaction handler does for unseen groups
+type txnRequester struct {
+	announcements chan []byte
+}
+
+func (r *txnRequester) Handle(message IncomingMessage) OutgoingMessage {
+	r.announcements <- message.Data
+	message.Sender.(UnicastPeer).Unicast(context.Background(), message.Data, protocol.TxnRequestTag)
+	return OutgoingMessage{Action: Ignore}
+}
+
+// Set up two nodes, send transactions with and without the announcements enabled
+func TestWebsocketTxnAnnouncements(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	type testDef struct {
+		netAEnabled bool
+		netBEnabled bool
+	}
+
+	var tests []testDef = []testDef{
+		{false, false},
+		{true, false},
+		{false, true},
+		{true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("A_%v+B_%v", test.netAEnabled, test.netBEnabled), func(t *testing.T) {
+			cfgA := defaultConfig
+			cfgA.GossipFanout = 1
+			cfgA.EnableTxnAnnouncements = test.netAEnabled
+			netA := makeTestWebsocketNodeWithConfig(t, cfgA)
+			netA.Start()
+			defer netStop(t, netA, "A")
+			cfgB := defaultConfig
+			cfgB.GossipFanout = 1
+			cfgB.EnableTxnAnnouncements = test.netBEnabled
+			netB := makeTestWebsocketNodeWithConfig(t, cfgB)
+			addrA, postListen := netA.Address()
+			require.True(t, postListen)
+			netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+			netB.Start()
+			defer netStop(t, netB, "B")
+
+			messages := [][]byte{
+				[]byte("foo"),
+				[]byte("bar"),
+			}
+			matcher := newMessageMatcher(t, messages)
+			done := matcher.done
+			requester := &txnRequester{announcements: make(chan []byte, len(messages))}
+			netB.RegisterHandlers([]TaggedMessageHandler{
+				{Tag: protocol.TxnTag, MessageHandler: matcher},
+				{Tag: protocol.TxnAdvertiseTag, MessageHandler: requester},
+			})
+
+			readyTimeout := time.NewTimer(2 * time.Second)
+			waitReady(t, netA, readyTimeout.C)
+			waitReady(t, netB, readyTimeout.C)
+			require.Eventually(t, func() bool { return len(netA.GetPeers(PeersConnectedIn)) == 1 }, 2*time.Second, 10*time.Millisecond)
+			enabled := test.netAEnabled && test.netBEnabled
+			require.Equal(t, enabled, netA.GetPeers(PeersConnectedIn)[0].(*wsPeer).pfTxnAnnouncementSupported())
+
+			for _, msg := range messages {
+				netA.Broadcast(context.Background(), protocol.TxnTag, msg, false, nil)
+			}
+
+			select {
+			case <-done:
+			case <-time.After(2 * time.Second):
+				t.Errorf("timeout, count=%d, wanted %d", len(matcher.received), len(messages))
+			}
+			require.True(t, matcher.Match())
+
+			close(requester.announcements)
+			var announced [][]byte
+			for announcement := range requester.announcements {
+				announced = append(announced, announcement)
+			}
+			if enabled {
+				require.ElementsMatch(t, [][]byte{MarshallTxnDigests([]crypto.Digest{crypto.Hash(messages[0])}), MarshallTxnDigests([]crypto.Digest{crypto.Hash(messages[1])})}, announced)
+			} else {
+				require.Empty(t, announced)
+			}
+		})
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/msgp/msgp"
+)
+
+// The stateful vote codec replaces the binary fields of an agreement vote message
+// (sender addresses, proposal digests, ephemeral keys and their signatures) which
+// were already sent over the same connection with short references into a table of
+// recently seen values. The encoder lives in the peer write loop and the decoder in
+// the peer read loop: since a websocket connection delivers the messages in order,
+// both sides apply the same sequence of table updates and stay in sync without any
+// acknowledgment. The state only lives as long as the connection. Every
+// voteCompressionResetInterval messages the encoder empties its table and tells the
+// decoder to do the same. A decoder which gets a reference to a value it does not know
+// lost track of the table: it drops the stateful messages until the next reset, which
+// resynchronizes both sides, and keeps the connection.
+//
+// An encoded message starts with a mode byte. voteCodecRaw is followed by the original
+// message, which the encoder falls back to when the message is not valid msgpack.
+// voteCodecStateful is followed by a sequence of tokens, and voteCodecReset as well,
+// once the table of the decoder is emptied. Each token starts with a
+// uvarint whose two low bits select the operation and whose remaining bits hold its
+// argument:
+//   - voteTokenLiteral: the argument is a length, and that many bytes of the original message follow.
+//   - voteTokenInsert: the argument is a length, and a new bin8 value of that length follows.
+//     The decoder adds the value to its table.
+//   - voteTokenRef: the argument is the table slot of a bin8 value sent before.
+const (
+	voteCodecRaw      byte = 0
+	voteCodecStateful byte = 1
+	voteCodecReset    byte = 2
+)
+
+const (
+	voteTokenLiteral = 0
+	voteTokenInsert  = 1
+	voteTokenRef     = 2
+
+	voteTokenBits = 2
+	voteTokenMask = 1<<voteTokenBits - 1
+)
+
+// voteCompressionTableSize is the number of values each side of a connection remembers.
+const voteCompressionTableSize = 1024
+
+// voteCompressionResetInterval is the number of stateful messages after which the
+// encoder starts over with an empty table.
+const voteCompressionResetInterval = 4096
+
+// voteCompressionMaxDepth bounds the nesting of the msgpack objects the encoder walks through.
+const voteCompressionMaxDepth = 16
+
+// msgpBin8 is the msgpack prefix of binary values shorter than 256 bytes.
+const msgpBin8 = 0xc4
+
+var errVoteReferenceUnknown = errors.New("vote references an unknown value")
+
+var errVoteTableDesynced = errors.New("vote compression table is out of sync")
+
+// voteDecodingDropped returns true for the decoding errors after which the vote is
+// dropped but the connection is kept.
+func voteDecodingDropped(err error) bool {
+	return errors.Is(err, errVoteReferenceUnknown) || errors.Is(err, errVoteTableDesynced)
+}
+
+// voteValueCacheable returns true for the binary values worth remembering: addresses,
+// digests and public keys are 32 bytes long, and ephemeral key signatures are 64 bytes long.
+func voteValueCacheable(n int) bool {
+	return n == 32 || n == 64
+}
+
+// voteValueTable is a fixed size table of values, evicting the least recently used value
+// when full. The encoder and the decoder of a connection perform the same sequence of
+// insert and touch operations, and therefore assign the same slots to the same values.
+type voteValueTable struct {
+	values [][]byte
+	// prev and next link the used slots from the most to the least recently used one.
+	prev []int
+	next []int
+	head int
+	tail int
+	// index maps the values to their slots; only the encoder needs it.
+	index map[string]int
+}
+
+func makeVoteValueTable(size int, indexed bool) *voteValueTable {
+	t := &voteValueTable{
+		values: make([][]byte, 0, size),
+		prev:   make([]int, size),
+		next:   make([]int, size),
+		head:   -1,
+		tail:   -1,
+	}
+	if indexed {
+		t.index = make(map[string]int, size)
+	}
+	return t
+}
+
+// reset empties the table.
+func (t *voteValueTable) reset() {
+	t.values = t.values[:0]
+	t.head = -1
+	t.tail = -1
+	if t.index != nil {
+		t.index = make(map[string]int, cap(t.values))
+	}
+}
+
+func (t *voteValueTable) unlink(slot int) {
+	if t.prev[slot] >= 0 {
+		t.next[t.prev[slot]] = t.next[slot]
+	} else {
+		t.head = t.next[slot]
+	}
+	if t.next[slot] >= 0 {
+		t.prev[t.next[slot]] = t.prev[slot]
+	} else {
+		t.tail = t.prev[slot]
+	}
+}
+
+func (t *voteValueTable) pushFront(slot int) {
+	t.prev[slot] = -1
+	t.next[slot] = t.head
+	if t.head >= 0 {
+		t.prev[t.head] = slot
+	}
+	t.head = slot
+	if t.tail < 0 {
+		t.tail = slot
+	}
+}
+
+// insert stores the value, which the table takes ownership of, and returns its slot.
+func (t *voteValueTable) insert(value []byte) int {
+	var slot int
+	if len(t.values) < cap(t.values) {
+		slot = len(t.values)
+		t.values = append(t.values, value)
+	} else {
+		slot = t.tail
+		t.unlink(slot)
+		if t.index != nil {
+			delete(t.index, string(t.values[slot]))
+		}
+		t.values[slot] = value
+	}
+	t.pushFront(slot)
+	if t.index != nil {
+		t.index[string(value)] = slot
+	}
+	return slot
+}
+
+// get returns the value stored in the slot and marks it as the most recently used one.
+func (t *voteValueTable) get(slot int) ([]byte, bool) {
+	if slot < 0 || slot >= len(t.values) {
+		return nil, false
+	}
+	if t.head != slot {
+		t.unlink(slot)
+		t.pushFront(slot)
+	}
+	return t.values[slot], true
+}
+
+// lookup returns the slot of the value, if the table has it.
+func (t *voteValueTable) lookup(value []byte) (int, bool) {
+	slot, ok := t.index[string(value)]
+	return slot, ok
+}
+
+// statefulVoteEncoder compresses the vote messages sent over a single connection.
+// It is not safe for concurrent use; the peer write loop owns it.
+type statefulVoteEncoder struct {
+	table *voteValueTable
+	spans []int
+
+	// encoded counts the stateful messages since the table was last reset, which
+	// happens every resetInterval messages.
+	resetInterval int
+	encoded       int
+}
+
+func makeStatefulVoteEncoder() *statefulVoteEncoder {
+	return &statefulVoteEncoder{
+		table:         makeVoteValueTable(voteCompressionTableSize, true),
+		resetInterval: voteCompressionResetInterval,
+	}
+}
+
+// collectVoteSpans appends to spans the offsets in data of the cacheable bin8 values
+// of the msgpack object starting at data[pos:], and returns the offset following it.
+func collectVoteSpans(data []byte, pos int, depth int, spans []int) (int, []int, error) {
+	if depth > voteCompressionMaxDepth {
+		return 0, spans, fmt.Errorf("msgpack object is nested deeper than %d", voteCompressionMaxDepth)
+	}
+	b := data[pos:]
+	var entries int
+	switch msgp.NextType(b) {
+	case msgp.MapType:
+		sz, _, rest, err := msgp.ReadMapHeaderBytes(b)
+		if err != nil {
+			return 0, spans, err
+		}
+		entries = 2 * sz
+		b = rest
+	case msgp.ArrayType:
+		sz, _, rest, err := msgp.ReadArrayHeaderBytes(b)
+		if err != nil {
+			return 0, spans, err
+		}
+		entries = sz
+		b = rest
+	case msgp.BinType:
+		if len(b) >= 2 && b[0] == msgpBin8 && voteValueCacheable(int(b[1])) {
+			if len(b) < 2+int(b[1]) {
+				return 0, spans, msgp.ErrShortBytes
+			}
+			return pos + 2 + int(b[1]), append(spans, pos), nil
+		}
+		fallthrough
+	default:
+		rest, err := msgp.Skip(b)
+		if err != nil {
+			return 0, spans, err
+		}
+		return len(data) - len(rest), spans, nil
+	}
+
+	pos = len(data) - len(b)
+	for i := 0; i < entries; i++ {
+		var err error
+		pos, spans, err = collectVoteSpans(data, pos, depth+1, spans)
+		if err != nil {
+			return 0, spans, err
+		}
+	}
+	return pos, spans, nil
+}
+
+func appendVoteToken(out []byte, op int, arg int) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(arg)<<voteTokenBits|uint64(op))
+	return append(out, buf[:n]...)
+}
+
+func appendVoteLiteral(out []byte, literal []byte) []byte {
+	if len(literal) == 0 {
+		return out
+	}
+	out = appendVoteToken(out, voteTokenLiteral, len(literal))
+	return append(out, literal...)
+}
+
+// encode returns a concatenation of the tag and the encoding of the vote message data.
+// Neither tbytes nor data are modified.
+func (enc *statefulVoteEncoder) encode(tbytes []byte, data []byte) []byte {
+	spans := enc.spans[:0]
+	end, spans, err := collectVoteSpans(data, 0, 0, spans)
+	enc.spans = spans
+	if err != nil || end != len(data) {
+		out := make([]byte, 0, len(tbytes)+1+len(data))
+		out = append(out, tbytes...)
+		out = append(out, voteCodecRaw)
+		return append(out, data...)
+	}
+
+	mode := voteCodecStateful
+	if enc.encoded >= enc.resetInterval {
+		enc.table.reset()
+		enc.encoded = 0
+		mode = voteCodecReset
+	}
+	enc.encoded++
+
+	out := make([]byte, 0, len(tbytes)+1+len(data))
+	out = append(out, tbytes...)
+	out = append(out, mode)
+	literalStart := 0
+	for _, pos := range spans {
+		out = appendVoteLiteral(out, data[literalStart:pos])
+		value := data[pos+2 : pos+2+int(data[pos+1])]
+		literalStart = pos + 2 + len(value)
+		if slot, ok := enc.table.lookup(value); ok {
+			enc.table.get(slot)
+			out = appendVoteToken(out, voteTokenRef, slot)
+			continue
+		}
+		enc.table.insert(append([]byte(nil), value...))
+		out = appendVoteToken(out, voteTokenInsert, len(value))
+		out = append(out, value...)
+	}
+	return appendVoteLiteral(out, data[literalStart:])
+}
+
+// statefulVoteDecoder reverses the encoding of a statefulVoteEncoder on the other side
+// of the connection. It is not safe for concurrent use; the peer read loop owns it.
+type statefulVoteDecoder struct {
+	table *voteValueTable
+	// desynced is set once a message referenced a value missing from the table, the
+	// stateful messages are dropped until the encoder resets its table.
+	desynced bool
+}
+
+func makeStatefulVoteDecoder() *statefulVoteDecoder {
+	return &statefulVoteDecoder{
+		table: makeVoteValueTable(voteCompressionTableSize, false),
+	}
+}
+
+func (dec *statefulVoteDecoder) convert(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty vote message")
+	}
+	switch data[0] {
+	case voteCodecRaw:
+		return data[1:], nil
+	case voteCodecReset:
+		dec.table.reset()
+		dec.desynced = false
+	case voteCodecStateful:
+		if dec.desynced {
+			return nil, errVoteTableDesynced
+		}
+	default:
+		return nil, fmt.Errorf("unknown vote encoding %d", data[0])
+	}
+
+	out := make([]byte, 0, 2*len(data))
+	data = data[1:]
+	for len(data) > 0 {
+		token, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, fmt.Errorf("malformed vote token")
+		}
+		data = data[n:]
+		op, arg := int(token&voteTokenMask), token>>voteTokenBits
+		switch op {
+		case voteTokenLiteral:
+			if arg == 0 || arg > uint64(len(data)) {
+				return nil, fmt.Errorf("vote literal of length %d overflows the message", arg)
+			}
+			out = append(out, data[:arg]...)
+			data = data[arg:]
+		case voteTokenInsert:
+			if !voteValueCacheable(int(arg)) || arg > uint64(len(data)) {
+				return nil, fmt.Errorf("invalid vote value of length %d", arg)
+			}
+			dec.table.insert(append([]byte(nil), data[:arg]...))
+			out = append(out, msgpBin8, byte(arg))
+			out = append(out, data[:arg]...)
+			data = data[arg:]
+		case voteTokenRef:
+			var value []byte
+			ok := arg < voteCompressionTableSize
+			if ok {
+				value, ok = dec.table.get(int(arg))
+			}
+			if !ok {
+				dec.desynced = true
+				return nil, errVoteReferenceUnknown
+			}
+			out = append(out, msgpBin8, byte(len(value)))
+			out = append(out, value...)
+		default:
+			return nil, fmt.Errorf("unknown vote token %d", op)
+		}
+		if len(out) > MaxDecompressedMessageSize {
+			return nil, fmt.Errorf("vote data is too large: %d", len(out))
+		}
+	}
+	return out, nil
+}
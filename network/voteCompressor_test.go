@@ -0,0 +1,261 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testVote mirrors the layout of an agreement vote, which this package cannot import.
+type testVote struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Cred struct {
+		Proof [80]byte `codec:"pf"`
+	} `codec:"cred"`
+	R struct {
+		Sender [32]byte `codec:"snd"`
+		Round  uint64   `codec:"rnd"`
+		Period uint64   `codec:"per"`
+		Step   uint64   `codec:"step"`
+		Prop   struct {
+			OriginalProposer [32]byte `codec:"oprop"`
+			Digest           [32]byte `codec:"dig"`
+			EncDigest        [32]byte `codec:"encdig"`
+		} `codec:"prop"`
+	} `codec:"r"`
+	Sig struct {
+		Sig    [64]byte `codec:"s"`
+		PK     [32]byte `codec:"p"`
+		PK2    [32]byte `codec:"p2"`
+		PK1Sig [64]byte `codec:"p1s"`
+		PK2Sig [64]byte `codec:"p2s"`
+	} `codec:"sig"`
+}
+
+func randomTestVote(sender [32]byte, round uint64, proposal crypto.Digest) []byte {
+	var v testVote
+	rand.Read(v.Cred.Proof[:])
+	v.R.Sender = sender
+	v.R.Round = round
+	v.R.Step = 1
+	v.R.Prop.OriginalProposer = crypto.Hash(proposal[:])
+	v.R.Prop.Digest = proposal
+	v.R.Prop.EncDigest = crypto.Hash(v.R.Prop.OriginalProposer[:])
+	rand.Read(v.Sig.Sig[:])
+	v.Sig.PK = crypto.Hash(sender[:])
+	v.Sig.PK2 = crypto.Hash(v.Sig.PK[:])
+	rand.Read(v.Sig.PK1Sig[:])
+	copy(v.Sig.PK2Sig[:], sender[:])
+	return protocol.EncodeReflect(&v)
+}
+
+func testVoteRoundtrip(t *testing.T, enc *statefulVoteEncoder, dec *statefulVoteDecoder, vote []byte) int {
+	tag := []byte(protocol.AgreementVoteTag)
+	encoded := enc.encode(tag, vote)
+	require.Equal(t, tag, encoded[:len(tag)])
+	decoded, err := dec.convert(encoded[len(tag):])
+	require.NoError(t, err)
+	require.Equal(t, vote, decoded)
+	return len(encoded) - len(tag)
+}
+
+func TestStatefulVoteCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	enc := makeStatefulVoteEncoder()
+	dec := makeStatefulVoteDecoder()
+
+	senders := make([][32]byte, 20)
+	for i := range senders {
+		rand.Read(senders[i][:])
+	}
+	var rawSize, encodedSize int
+	for round := uint64(1); round <= 5; round++ {
+		proposal := crypto.Hash([]byte{byte(round)})
+		for i, sender := range senders {
+			vote := randomTestVote(sender, round, proposal)
+			n := testVoteRoundtrip(t, enc, dec, vote)
+			if round > 1 {
+				// the sender, its ephemeral keys and the signature of its batch key were sent before
+				require.Less(t, n, len(vote)-4*32, "round %d sender %d", round, i)
+			}
+			rawSize += len(vote)
+			encodedSize += n
+		}
+	}
+	require.Less(t, encodedSize, rawSize*2/3)
+
+	// fill the tables past their capacity: the evictions must be the same on both sides
+	for i := 0; i < voteCompressionTableSize; i++ {
+		var sender [32]byte
+		rand.Read(sender[:])
+		testVoteRoundtrip(t, enc, dec, randomTestVote(sender, 6, crypto.Hash([]byte{6})))
+		testVoteRoundtrip(t, enc, dec, randomTestVote(senders[i%len(senders)], 6, crypto.Hash([]byte{6})))
+	}
+	require.Len(t, enc.table.index, voteCompressionTableSize)
+	require.Equal(t, enc.table.values, dec.table.values)
+}
+
+func TestStatefulVoteCompressionFallback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	enc := makeStatefulVoteEncoder()
+	dec := makeStatefulVoteDecoder()
+
+	// messages which are not a single msgpack object are sent as they are
+	for _, data := range [][]byte{{}, {0xc1}, {0x93, 0x01}, {0x01, 0x02}} {
+		encoded := enc.encode(nil, data)
+		require.Equal(t, voteCodecRaw, encoded[0])
+		decoded, err := dec.convert(encoded)
+		require.NoError(t, err)
+		require.Equal(t, data, decoded)
+	}
+	require.Empty(t, enc.table.values)
+
+	// a decoder which missed the first vote cannot decode the second one
+	var sender [32]byte
+	rand.Read(sender[:])
+	proposal := crypto.Hash([]byte("proposal"))
+	first := enc.encode(nil, randomTestVote(sender, 1, proposal))
+	second := enc.encode(nil, randomTestVote(sender, 1, proposal))
+	_, err := makeStatefulVoteDecoder().convert(second)
+	require.ErrorIs(t, err, errVoteReferenceUnknown)
+	require.True(t, voteDecodingDropped(err))
+	_, err = dec.convert(first)
+	require.NoError(t, err)
+	_, err = dec.convert(second)
+	require.NoError(t, err)
+
+	// malformed messages
+	for _, data := range [][]byte{
+		{},
+		{3},
+		{voteCodecStateful, 0x80},
+		{voteCodecStateful, 4<<voteTokenBits | voteTokenLiteral, 1},
+		{voteCodecStateful, 3<<voteTokenBits | voteTokenInsert, 1, 2, 3},
+		{voteCodecStateful, 3},
+	} {
+		_, err := makeStatefulVoteDecoder().convert(data)
+		require.Error(t, err, "%v", data)
+		require.False(t, voteDecodingDropped(err), "%v", data)
+	}
+}
+
+func TestStatefulVoteCompressionReset(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	enc := makeStatefulVoteEncoder()
+	enc.resetInterval = 3
+	dec := makeStatefulVoteDecoder()
+
+	var sender [32]byte
+	rand.Read(sender[:])
+	proposal := crypto.Hash([]byte("proposal"))
+	votes := make([][]byte, 7)
+	encoded := make([][]byte, len(votes))
+	for i := range votes {
+		votes[i] = randomTestVote(sender, 1, proposal)
+		encoded[i] = enc.encode(nil, votes[i])
+	}
+	require.Equal(t, voteCodecStateful, encoded[2][0])
+	require.Equal(t, voteCodecReset, encoded[3][0])
+	require.Equal(t, voteCodecStateful, encoded[4][0])
+	require.Equal(t, voteCodecReset, encoded[6][0])
+	// a reset message does not refer to the values sent before
+	require.Greater(t, len(encoded[3]), len(encoded[2]))
+
+	// the decoder lost the first vote: it drops the following ones until the reset
+	_, err := dec.convert(encoded[1])
+	require.ErrorIs(t, err, errVoteReferenceUnknown)
+	_, err = dec.convert(encoded[2])
+	require.ErrorIs(t, err, errVoteTableDesynced)
+	require.True(t, voteDecodingDropped(err))
+	// raw messages do not depend on the table
+	decoded, err := dec.convert([]byte{voteCodecRaw, 1, 2})
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2}, decoded)
+
+	for i := 3; i < len(votes); i++ {
+		decoded, err = dec.convert(encoded[i])
+		require.NoError(t, err)
+		require.Equal(t, votes[i], decoded)
+	}
+	require.Equal(t, enc.table.values, dec.table.values)
+}
+
+func TestVoteDecompressionDesyncKeepsPeer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	c := wsPeerMsgDataConverter{log: logging.TestingLog(t), origin: "test", avdec: makeStatefulVoteDecoder()}
+	enc := makeStatefulVoteEncoder()
+	var sender [32]byte
+	rand.Read(sender[:])
+	proposal := crypto.Hash([]byte("proposal"))
+	enc.encode(nil, randomTestVote(sender, 1, proposal))
+
+	// a malformed vote still drops the connection
+	_, err := c.convert(protocol.AgreementVoteTag, []byte{voteCodecStateful, 0x80})
+	require.Error(t, err)
+	require.False(t, voteDecodingDropped(err))
+
+	// the read loop drops the votes, instead of the connection, until the table is reset
+	_, err = c.convert(protocol.AgreementVoteTag, enc.encode(nil, randomTestVote(sender, 1, proposal)))
+	require.True(t, voteDecodingDropped(err))
+	_, err = c.convert(protocol.AgreementVoteTag, enc.encode(nil, randomTestVote(sender, 1, proposal)))
+	require.True(t, voteDecodingDropped(err))
+}
+
+func TestVoteValueTable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	table := makeVoteValueTable(3, true)
+	require.Equal(t, 0, table.insert([]byte("a")))
+	require.Equal(t, 1, table.insert([]byte("b")))
+	require.Equal(t, 2, table.insert([]byte("c")))
+
+	// "a" becomes the most recently used value, so "b" gets evicted
+	value, ok := table.get(0)
+	require.True(t, ok)
+	require.Equal(t, []byte("a"), value)
+	require.Equal(t, 1, table.insert([]byte("d")))
+	_, ok = table.lookup([]byte("b"))
+	require.False(t, ok)
+	slot, ok := table.lookup([]byte("d"))
+	require.True(t, ok)
+	require.Equal(t, 1, slot)
+	require.Equal(t, 2, table.insert([]byte("e")))
+	require.Equal(t, 0, table.insert([]byte("f")))
+
+	_, ok = table.get(3)
+	require.False(t, ok)
+	_, ok = makeVoteValueTable(3, false).get(0)
+	require.False(t, ok)
+}
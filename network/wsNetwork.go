@@ -130,6 +130,9 @@ var networkPrioBatchesPPWithCompression = metrics.MakeCounter(metrics.MetricName
 var networkPrioBatchesPPWithoutCompression = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_pp_prio_batches_wpp_non_comp_sent_total", Description: "number of prio non-compressed batches with PP"})
 var networkPrioPPCompressedSize = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_prio_pp_compressed_size_total", Description: "cumulative size of all compressed PP"})
 var networkPrioPPNonCompressedSize = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_prio_pp_non_compressed_size_total", Description: "cumulative size of all non-compressed PP"})
+var networkVoteCompressedSize = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_vote_compressed_size_total", Description: "cumulative size of all compressed AV"})
+var networkVoteNonCompressedSize = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_vote_non_compressed_size_total", Description: "cumulative size of all AV sent to peers supporting compression, before compression"})
+var networkVoteDecompressionDropped = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_vote_decompression_dropped_total", Description: "number of compressed AV dropped because the compression table of the peer was out of sync"})
 
 // peerDisconnectionAckDuration defines the time we would wait for the peer disconnection to complete.
 const peerDisconnectionAckDuration = 5 * time.Second
@@ -345,6 +348,10 @@ type WebsocketNetwork struct {
 	peers              []*wsPeer
 	peersChangeCounter int32 // peersChangeCounter is an atomic variable that increases on each change to the peers. It helps avoiding taking the peersLock when checking if the peers list was modified.
 
+	// incomingConnectionsLimit is an atomic variable holding the number of incoming peers we accept. It starts as the
+	// IncomingConnectionsLimit of the config, and can be lowered at runtime by SetIncomingConnectionsLimit.
+	incomingConnectionsLimit int32
+
 	broadcastQueueHighPrio chan broadcastRequest
 	broadcastQueueBulk     chan broadcastRequest
 
@@ -442,6 +449,9 @@ type WebsocketNetwork struct {
 
 	// protocolVersion is an actual version announced as ProtocolVersionHeader
 	protocolVersion string
+
+	// announcedTxns keeps the transaction groups announced to peers, when EnableTxnAnnouncements is set
+	announcedTxns *announcedTxnCache
 }
 
 const (
@@ -710,6 +720,7 @@ func (wn *WebsocketNetwork) setup() {
 	wn.upgrader.WriteBufferSize = 4096
 	wn.upgrader.EnableCompression = false
 	wn.lastPeerConnectionsSent = time.Now()
+	wn.incomingConnectionsLimit = int32(wn.config.IncomingConnectionsLimit)
 	wn.router = mux.NewRouter()
 	wn.router.Handle(GossipNetworkPath, wn)
 	wn.requestsTracker = makeRequestsTracker(wn.router, wn.log, wn.config)
@@ -768,7 +779,10 @@ func (wn *WebsocketNetwork) setup() {
 	if wn.config.EnableIncomingMessageFilter {
 		wn.incomingMsgFilter = makeMessageFilter(wn.config.IncomingMessageFilterBucketCount, wn.config.IncomingMessageFilterBucketSize)
 	}
-	wn.connPerfMonitor = makeConnectionPerformanceMonitor([]Tag{protocol.AgreementVoteTag, protocol.TxnTag})
+	wn.connPerfMonitor = makeConnectionPerformanceMonitor([]Tag{protocol.AgreementVoteTag, protocol.TxnTag, protocol.TxnAdvertiseTag})
+	if wn.config.EnableTxnAnnouncements {
+		wn.announcedTxns = makeAnnouncedTxnCache()
+	}
 	wn.lastNetworkAdvance = time.Now().UTC()
 	wn.handlers.log = wn.log
 
@@ -943,6 +957,18 @@ func (wn *WebsocketNetwork) setHeaders(header http.Header) {
 	header.Set(NodeRandomHeader, wn.RandomID)
 }
 
+// peerFeatures returns the value of the PeerFeaturesHeader announced to peers
+func (wn *WebsocketNetwork) peerFeatures() string {
+	features := []string{PeerFeatureProposalCompression}
+	if wn.config.EnableVoteCompression {
+		features = append(features, PeerFeatureVoteCompression)
+	}
+	if wn.config.EnableTxnAnnouncements {
+		features = append(features, PeerFeatureTxnAnnouncement)
+	}
+	return strings.Join(features, ",")
+}
+
 // checkServerResponseVariables check that the version and random-id in the request headers matches the server ones.
 // it returns true if it's a match, and false otherwise.
 func (wn *WebsocketNetwork) checkServerResponseVariables(otherHeader http.Header, addr string) (bool, string) {
@@ -984,7 +1010,7 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 
 // checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
-	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
+	if wn.numIncomingPeers() >= int(atomic.LoadInt32(&wn.incomingConnectionsLimit)) {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
 			telemetryspec.ConnectPeerFailEventDetails{
@@ -1138,7 +1164,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 	wn.setHeaders(responseHeader)
 	responseHeader.Set(ProtocolVersionHeader, matchingVersion)
 	responseHeader.Set(GenesisHeader, wn.GenesisID)
-	responseHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	responseHeader.Set(PeerFeaturesHeader, wn.peerFeatures())
 	var challenge string
 	if wn.prioScheme != nil {
 		challenge = wn.prioScheme.NewPrioChallenge()
@@ -1489,6 +1515,25 @@ func (wn *WebsocketNetwork) preparePeerData(request broadcastRequest, prio bool,
 	return data, dataCompressed, digests, containsPrioPPTag
 }
 
+// prepareTxnAnnouncements replaces the transaction groups of the prepared data with their announcements,
+// and keeps the transaction groups in the announcements cache to serve the peers requests.
+func (wn *WebsocketNetwork) prepareTxnAnnouncements(request broadcastRequest, data [][]byte, digests []crypto.Digest) [][]byte {
+	tbytes := []byte(protocol.TxnAdvertiseTag)
+	announcements := make([][]byte, len(data))
+	for i, d := range request.data {
+		if request.tags[i] != protocol.TxnTag {
+			announcements[i] = data[i]
+			continue
+		}
+		digest := wn.announcedTxns.put(d, announcedTxn{msg: data[i], digest: digests[i]})
+		mbytes := make([]byte, 0, len(tbytes)+len(digest))
+		mbytes = append(mbytes, tbytes...)
+		announcements[i] = append(mbytes, digest[:]...)
+		networkTxnAnnouncementsSent.Inc(nil)
+	}
+	return announcements
+}
+
 // prio is set if the broadcast is a high-priority broadcast.
 func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool, peers []*wsPeer) {
 	if request.done != nil {
@@ -1504,6 +1549,10 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 
 	start := time.Now()
 	data, dataWithCompression, digests, containsPrioPPTag := wn.preparePeerData(request, prio, peers)
+	var dataWithAnnouncements [][]byte
+	if wn.announcedTxns != nil && checkCanAnnounce(request, peers) {
+		dataWithAnnouncements = wn.prepareTxnAnnouncements(request, data, digests)
+	}
 
 	// first send to all the easy outbound peers who don't block, get them started.
 	sentMessageCount := 0
@@ -1515,7 +1564,10 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 			continue
 		}
 		var ok bool
-		if peer.pfProposalCompressionSupported() && len(dataWithCompression) > 0 {
+		if peer.pfTxnAnnouncementSupported() && len(dataWithAnnouncements) > 0 {
+			// if this peer supports transaction announcements, announce the transaction groups instead of sending them
+			ok = peer.writeNonBlockMsgs(request.ctx, dataWithAnnouncements, prio, digests, request.enqueueTime)
+		} else if peer.pfProposalCompressionSupported() && len(dataWithCompression) > 0 {
 			// if this peer supports compressed proposals and compressed data batch is filled out, use it
 			ok = peer.writeNonBlockMsgs(request.ctx, dataWithCompression, prio, digests, request.enqueueTime)
 			if prio {
@@ -1574,6 +1626,18 @@ func (wn *WebsocketNetwork) numOutgoingPeers() int {
 	}
 	return count
 }
+
+// SetIncomingConnectionsLimit changes the number of incoming peers we accept. Peers above the new limit are not
+// disconnected, but no more get accepted until we go below it. The limit can't exceed the IncomingConnectionsLimit
+// the network was started with, since the listener and the file descriptor limits of the process were sized for it.
+func (wn *WebsocketNetwork) SetIncomingConnectionsLimit(limit int) error {
+	if limit < 0 || limit > wn.config.IncomingConnectionsLimit {
+		return errInvalidIncomingConnectionsLimit
+	}
+	atomic.StoreInt32(&wn.incomingConnectionsLimit, int32(limit))
+	return nil
+}
+
 func (wn *WebsocketNetwork) numIncomingPeers() int {
 	wn.peersLock.RLock()
 	defer wn.peersLock.RUnlock()
@@ -1978,10 +2042,20 @@ const PeerFeaturesHeader = "X-Algorand-Peer-Features"
 // supports proposal payload compression with zstd
 const PeerFeatureProposalCompression = "ppzstd"
 
+// PeerFeatureVoteCompression is a value for PeerFeaturesHeader indicating peer
+// supports the stateful compression of agreement votes
+const PeerFeatureVoteCompression = "avstateful"
+
+// PeerFeatureTxnAnnouncement is a value for PeerFeaturesHeader indicating peer
+// supports the announce-then-request relay of transaction groups
+const PeerFeatureTxnAnnouncement = "txann"
+
 var websocketsScheme = map[string]string{"http": "ws", "https": "wss"}
 
 var errBadAddr = errors.New("bad address")
 
+var errInvalidIncomingConnectionsLimit = errors.New("the incoming connections limit must be between zero and the one the network was started with")
+
 var errNetworkClosing = errors.New("WebsocketNetwork shutting down")
 
 var errBcastCallerCancel = errors.New("caller cancelled broadcast")
@@ -2124,7 +2198,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 	// for backward compatibility, include the ProtocolVersion header as well.
 	requestHeader.Set(ProtocolVersionHeader, wn.protocolVersion)
 	// set the features header (comma-separated list)
-	requestHeader.Set(PeerFeaturesHeader, PeerFeatureProposalCompression)
+	requestHeader.Set(PeerFeaturesHeader, wn.peerFeatures())
 	SetUserAgentHeader(requestHeader)
 	myInstanceName := wn.log.GetInstanceName()
 	requestHeader.Set(InstanceNameHeader, myInstanceName)
@@ -2475,10 +2549,12 @@ func (wn *WebsocketNetwork) postMessagesOfInterestThread() {
 		if wantTXGossip && (wn.wantTXGossip != wantTXGossipYes) {
 			wn.log.Infof("postMessagesOfInterestThread: enabling TX gossip")
 			wn.RegisterMessageInterest(protocol.TxnTag)
+			wn.RegisterMessageInterest(protocol.TxnAdvertiseTag)
 			atomic.StoreUint32(&wn.wantTXGossip, wantTXGossipYes)
 		} else if !wantTXGossip && (wn.wantTXGossip != wantTXGossipNo) {
 			wn.log.Infof("postMessagesOfInterestThread: disabling TX gossip")
 			wn.DeregisterMessageInterest(protocol.TxnTag)
+			wn.DeregisterMessageInterest(protocol.TxnAdvertiseTag)
 			atomic.StoreUint32(&wn.wantTXGossip, wantTXGossipNo)
 		}
 	}
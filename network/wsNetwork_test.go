@@ -26,6 +26,7 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"runtime"
@@ -402,6 +403,87 @@ func TestWebsocketProposalPayloadCompression(t *testing.T) {
 	}
 }
 
+// Set up two nodes, send votes with and without the stateful vote compression enabled
+func TestWebsocketVoteCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	type testDef struct {
+		netAEnabled bool
+		netBEnabled bool
+	}
+
+	var tests []testDef = []testDef{
+		{false, false},
+		{true, false},
+		{false, true},
+		{true, true},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("A_%v+B_%v", test.netAEnabled, test.netBEnabled), func(t *testing.T) {
+			cfgA := defaultConfig
+			cfgA.GossipFanout = 1
+			cfgA.EnableVoteCompression = test.netAEnabled
+			netA := makeTestWebsocketNodeWithConfig(t, cfgA)
+			netA.Start()
+			defer netStop(t, netA, "A")
+			cfgB := defaultConfig
+			cfgB.GossipFanout = 1
+			cfgB.EnableVoteCompression = test.netBEnabled
+			netB := makeTestWebsocketNodeWithConfig(t, cfgB)
+			addrA, postListen := netA.Address()
+			require.True(t, postListen)
+			netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+			netB.Start()
+			defer netStop(t, netB, "B")
+
+			var sender [32]byte
+			crypto.RandBytes(sender[:])
+			proposal := crypto.Hash([]byte("proposal"))
+			// each node receives the messages sent by the other one
+			messages := [][]byte{
+				randomTestVote(sender, 1, proposal),
+				[]byte("foo"),
+				randomTestVote(sender, 1, proposal),
+				randomTestVote(sender, 2, proposal),
+			}
+			matcherA := newMessageMatcher(t, messages)
+			netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.AgreementVoteTag, MessageHandler: matcherA}})
+			matcherB := newMessageMatcher(t, messages)
+			netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.AgreementVoteTag, MessageHandler: matcherB}})
+
+			readyTimeout := time.NewTimer(2 * time.Second)
+			waitReady(t, netA, readyTimeout.C)
+			waitReady(t, netB, readyTimeout.C)
+
+			require.Eventually(t, func() bool { return len(netA.GetPeers(PeersConnectedIn)) == 1 }, 2*time.Second, 10*time.Millisecond)
+			enabled := test.netAEnabled && test.netBEnabled
+			for _, peers := range [][]Peer{netA.GetPeers(PeersConnectedIn), netB.GetPeers(PeersConnectedOut)} {
+				require.Len(t, peers, 1)
+				peer := peers[0].(*wsPeer)
+				require.Equal(t, enabled, peer.pfVoteCompressionSupported())
+				require.Equal(t, enabled, peer.voteEncoder != nil)
+			}
+
+			matchers := []*messageMatcherHandler{matcherA, matcherB}
+			done := []chan struct{}{matcherA.done, matcherB.done}
+			for _, msg := range messages {
+				netA.Broadcast(context.Background(), protocol.AgreementVoteTag, msg, true, nil)
+				netB.Broadcast(context.Background(), protocol.AgreementVoteTag, msg, true, nil)
+			}
+
+			for i, matcher := range matchers {
+				select {
+				case <-done[i]:
+				case <-time.After(2 * time.Second):
+					t.Errorf("timeout, count=%d, wanted %d", len(matcher.received), len(messages))
+				}
+				require.True(t, matcher.Match())
+			}
+		})
+	}
+}
+
 // Repeat basic, but test a unicast
 func TestWebsocketNetworkUnicast(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -2743,3 +2825,21 @@ func TestWebsocketNetworkTelemetryTCP(t *testing.T) {
 	t.Log("closed detailsA", string(pcdA))
 	t.Log("closed detailsB", string(pcdB))
 }
+
+func TestWebsocketNetworkSetIncomingConnectionsLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := defaultConfig
+	cfg.IncomingConnectionsLimit = 10
+	wn := makeTestWebsocketNodeWithConfig(t, cfg)
+
+	require.Error(t, wn.SetIncomingConnectionsLimit(cfg.IncomingConnectionsLimit+1))
+	require.Error(t, wn.SetIncomingConnectionsLimit(-1))
+
+	status := wn.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "1.2.3.4", "", "")
+	require.Equal(t, http.StatusOK, status)
+
+	require.NoError(t, wn.SetIncomingConnectionsLimit(0))
+	status = wn.checkIncomingConnectionLimits(httptest.NewRecorder(), nil, "1.2.3.4", "", "")
+	require.Equal(t, http.StatusServiceUnavailable, status)
+}
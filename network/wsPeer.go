@@ -109,6 +109,8 @@ var defaultSendMessageTags = map[protocol.Tag]bool{
 	protocol.TopicMsgRespTag:    true,
 	protocol.MsgOfInterestTag:   true,
 	protocol.TxnTag:             true,
+	protocol.TxnAdvertiseTag:    true,
+	protocol.TxnRequestTag:      true,
 	protocol.UniCatchupReqTag:   true,
 	protocol.UniEnsBlockReqTag:  true,
 	protocol.VoteBundleTag:      true,
@@ -244,6 +246,10 @@ type wsPeer struct {
 	// peer features derived from the peer version
 	features peerFeatureFlag
 
+	// voteEncoder compresses the outgoing agreement votes when the stateful vote compression
+	// was negotiated with the peer; it is only accessed by the write loop.
+	voteEncoder *statefulVoteEncoder
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -414,6 +420,17 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 		wp.outgoingMsgFilter = makeMessageFilter(config.OutgoingMessageFilterBucketCount, config.OutgoingMessageFilterBucketSize)
 	}
 
+	// the votes are only compressed when both sides enabled it
+	if !config.EnableVoteCompression {
+		wp.features &^= pfCompressedVoteStateful
+	}
+	if wp.pfVoteCompressionSupported() {
+		wp.voteEncoder = makeStatefulVoteEncoder()
+	}
+	if !config.EnableTxnAnnouncements {
+		wp.features &^= pfTxnAnnouncement
+	}
+
 	wp.wg.Add(2)
 	go wp.readLoop()
 	go wp.writeLoop()
@@ -435,7 +452,7 @@ func (wp *wsPeer) reportReadErr(err error) {
 
 func dedupSafeTag(t protocol.Tag) bool {
 	// Votes and Transactions are the only thing we're sure it's safe to de-dup on receipt.
-	return t == protocol.AgreementVoteTag || t == protocol.TxnTag
+	return t == protocol.AgreementVoteTag || t == protocol.TxnTag || t == protocol.TxnAdvertiseTag
 }
 
 func (wp *wsPeer) readLoop() {
@@ -488,6 +505,10 @@ func (wp *wsPeer) readLoop() {
 		msg.Received = time.Now().UnixNano()
 		msg.Data = slurper.Bytes()
 		msg.Data, err = dataConverter.convert(msg.Tag, msg.Data)
+		if voteDecodingDropped(err) {
+			networkVoteDecompressionDropped.Inc(nil)
+			continue
+		}
 		if err != nil {
 			wp.reportReadErr(err)
 			return
@@ -543,6 +564,10 @@ func (wp *wsPeer) readLoop() {
 			// network maintenance message handled immediately instead of handing off to general handlers
 			wp.handleFilterMessage(msg)
 			continue
+		case protocol.TxnRequestTag:
+			// served from the network announcements cache, without handing off to general handlers
+			wp.handleTxnRequest(msg)
+			continue
 		case protocol.TxnTag:
 			atomic.AddUint64(&wp.txMessageCount, 1)
 		case protocol.AgreementVoteTag:
@@ -690,9 +715,18 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectStaleWrite
 	}
 
+	data := msg.data
+	if tag == protocol.AgreementVoteTag && wp.voteEncoder != nil {
+		// the encoder state must follow the messages actually written to the connection,
+		// so the votes are compressed here rather than when they get enqueued.
+		data = wp.voteEncoder.encode(msg.data[:len(tag)], msg.data[len(tag):])
+		networkVoteNonCompressedSize.AddUint64(uint64(len(msg.data)), nil)
+		networkVoteCompressedSize.AddUint64(uint64(len(data)), nil)
+	}
+
 	atomic.StoreInt64(&wp.intermittentOutgoingMessageEnqueueTime, msg.enqueued.UnixNano())
 	defer atomic.StoreInt64(&wp.intermittentOutgoingMessageEnqueueTime, 0)
-	err := wp.conn.WriteMessage(websocket.BinaryMessage, msg.data)
+	err := wp.conn.WriteMessage(websocket.BinaryMessage, data)
 	if err != nil {
 		if atomic.LoadInt32(&wp.didInnerClose) == 0 {
 			wp.net.log.Warn("peer write error ", err)
@@ -701,8 +735,8 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 		return disconnectWriteError
 	}
 	atomic.StoreInt64(&wp.lastPacketTime, time.Now().UnixNano())
-	networkSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
-	networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
+	networkSentBytesTotal.AddUint64(uint64(len(data)), nil)
+	networkSentBytesByTag.Add(string(tag), uint64(len(data)))
 	networkMessageSentTotal.AddUint64(1, nil)
 	networkMessageSentByTag.Add(string(tag), 1)
 	networkMessageQueueMicrosTotal.AddUint64(uint64(time.Now().Sub(msg.peerEnqueued).Nanoseconds()/1000), nil)
@@ -977,6 +1011,14 @@ func (wp *wsPeer) pfProposalCompressionSupported() bool {
 	return wp.features&pfCompressedProposal != 0
 }
 
+func (wp *wsPeer) pfVoteCompressionSupported() bool {
+	return wp.features&pfCompressedVoteStateful != 0
+}
+
+func (wp *wsPeer) pfTxnAnnouncementSupported() bool {
+	return wp.features&pfTxnAnnouncement != 0
+}
+
 func (wp *wsPeer) OnClose(f func()) {
 	if wp.closers == nil {
 		wp.closers = []func(){}
@@ -986,7 +1028,11 @@ func (wp *wsPeer) OnClose(f func()) {
 
 type peerFeatureFlag int
 
-const pfCompressedProposal peerFeatureFlag = 1
+const (
+	pfCompressedProposal peerFeatureFlag = 1 << iota
+	pfCompressedVoteStateful
+	pfTxnAnnouncement
+)
 
 // versionPeerFeatures defines protocol version when peer features were introduced
 const versionPeerFeatures = "2.2"
@@ -1027,8 +1073,13 @@ func decodePeerFeatures(version string, announcedFeatures string) peerFeatureFla
 	parts := strings.Split(announcedFeatures, ",")
 	for _, part := range parts {
 		part = strings.TrimSpace(part)
-		if part == PeerFeatureProposalCompression {
+		switch part {
+		case PeerFeatureProposalCompression:
 			features |= pfCompressedProposal
+		case PeerFeatureVoteCompression:
+			features |= pfCompressedVoteStateful
+		case PeerFeatureTxnAnnouncement:
+			features |= pfTxnAnnouncement
 		}
 	}
 	return features
@@ -172,6 +172,9 @@ func TestVersionToFeature(t *testing.T) {
 		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, "test"}, ","), pfCompressedProposal},
 		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, "test"}, ", "), pfCompressedProposal},
 		{"2.3", PeerFeatureProposalCompression, pfCompressedProposal},
+		{"2.1", PeerFeatureVoteCompression, peerFeatureFlag(0)},
+		{"2.2", PeerFeatureVoteCompression, pfCompressedVoteStateful},
+		{"2.2", strings.Join([]string{PeerFeatureProposalCompression, PeerFeatureVoteCompression}, ","), pfCompressedProposal | pfCompressedVoteStateful},
 	}
 	for i, test := range tests {
 		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
@@ -17,9 +17,19 @@
 package node
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrNotDevMode is returned by the operations only available to developer mode nodes
+var ErrNotDevMode = errors.New("the node is not in developer mode")
+
+// ErrSnapshotNotFound indicates that the requested ledger snapshot does not exist
+var ErrSnapshotNotFound = errors.New("ledger snapshot not found")
+
+// ErrInvalidDevModeArgument is returned when an argument of a developer mode operation is out of range
+var ErrInvalidDevModeArgument = errors.New("invalid developer mode argument")
+
 // Catchpoint already in progress error
 
 // CatchpointAlreadyInProgressError indicates that the requested catchpoint is already running
@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/util"
+)
+
+// HealthStatus is the outcome of a health check.
+type HealthStatus string
+
+const (
+	// HealthPass means the checked aspect of the node is healthy.
+	HealthPass HealthStatus = "pass"
+	// HealthWarn means the checked aspect of the node requires attention, but the node could
+	// still serve requests.
+	HealthWarn HealthStatus = "warn"
+	// HealthFail means the node should not be sent requests until the checked aspect recovers.
+	HealthFail HealthStatus = "fail"
+)
+
+// severity orders the statuses from the healthiest to the least healthy one.
+func (s HealthStatus) severity() int {
+	switch s {
+	case HealthPass:
+		return 0
+	case HealthWarn:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// HealthCheck evaluates a single aspect of the health of the node, and returns its status
+// along with a human readable explanation.
+type HealthCheck func() (HealthStatus, string)
+
+// HealthCheckResult is the outcome of one of the health checks of the node.
+type HealthCheckResult struct {
+	Name    string       `json:"name"`
+	Status  HealthStatus `json:"status"`
+	Message string       `json:"message,omitempty"`
+}
+
+// HealthReport aggregates the outcomes of all the health checks of the node.
+type HealthReport struct {
+	// Ready is set when none of the checks failed.
+	Ready bool `json:"ready"`
+	// Status is the least healthy status among the checks.
+	Status HealthStatus        `json:"status"`
+	Round  basics.Round        `json:"round"`
+	Checks []HealthCheckResult `json:"checks"`
+}
+
+type namedHealthCheck struct {
+	name  string
+	check HealthCheck
+}
+
+// RegisterHealthCheck adds a check to the health report of the node, replacing the check
+// which was registered under the same name, if any.
+func (node *AlgorandFullNode) RegisterHealthCheck(name string, check HealthCheck) {
+	node.healthMu.Lock()
+	defer node.healthMu.Unlock()
+	for i := range node.healthChecks {
+		if node.healthChecks[i].name == name {
+			node.healthChecks[i].check = check
+			return
+		}
+	}
+	node.healthChecks = append(node.healthChecks, namedHealthCheck{name: name, check: check})
+}
+
+// Health runs the health checks of the node, in the order they were registered.
+func (node *AlgorandFullNode) Health() HealthReport {
+	node.healthMu.Lock()
+	checks := make([]namedHealthCheck, len(node.healthChecks))
+	copy(checks, node.healthChecks)
+	node.healthMu.Unlock()
+
+	report := HealthReport{
+		Ready:  true,
+		Status: HealthPass,
+		Round:  node.ledger.Latest(),
+		Checks: make([]HealthCheckResult, 0, len(checks)),
+	}
+	for _, c := range checks {
+		status, message := c.check()
+		if status.severity() > report.Status.severity() {
+			report.Status = status
+		}
+		if status == HealthFail {
+			report.Ready = false
+		}
+		report.Checks = append(report.Checks, HealthCheckResult{Name: c.name, Status: status, Message: message})
+	}
+	return report
+}
+
+// registerDefaultHealthChecks registers the checks which every node runs, whose thresholds
+// come from the configuration of the node.
+func (node *AlgorandFullNode) registerDefaultHealthChecks() {
+	node.RegisterHealthCheck("catchpoint-catchup", node.checkCatchpointCatchup)
+	node.RegisterHealthCheck("ledger-lag", node.checkLedgerLag)
+	node.RegisterHealthCheck("peers", node.checkPeers)
+	node.RegisterHealthCheck("txpool", node.checkTxPool)
+	node.RegisterHealthCheck("disk-space", node.checkDiskSpace)
+	node.RegisterHealthCheck("participation-keys", node.checkParticipationKeys)
+}
+
+func (node *AlgorandFullNode) inCatchpointCatchup() bool {
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.catchpointCatchupService != nil
+}
+
+func (node *AlgorandFullNode) checkCatchpointCatchup() (HealthStatus, string) {
+	if node.inCatchpointCatchup() {
+		return HealthFail, "catchpoint catchup in progress"
+	}
+	return HealthPass, ""
+}
+
+func (node *AlgorandFullNode) checkLedgerLag() (HealthStatus, string) {
+	maxLag := node.Config().HealthMaxLedgerLagSeconds
+	// developer mode nodes only make blocks when they are sent transactions.
+	if maxLag == 0 || node.devMode || node.inCatchpointCatchup() {
+		return HealthPass, ""
+	}
+	latest := node.ledger.Latest()
+	hdr, err := node.ledger.BlockHdr(latest)
+	if err != nil {
+		return HealthFail, fmt.Sprintf("unable to read the header of round %d: %v", latest, err)
+	}
+	lag := time.Since(time.Unix(hdr.TimeStamp, 0)).Truncate(time.Second)
+	if lag > time.Duration(maxLag)*time.Second {
+		return HealthFail, fmt.Sprintf("round %d was made %v ago", latest, lag)
+	}
+	return HealthPass, fmt.Sprintf("round %d was made %v ago", latest, lag)
+}
+
+func (node *AlgorandFullNode) checkPeers() (HealthStatus, string) {
+	minPeers := node.Config().HealthMinPeers
+	if minPeers == 0 || node.config.DisableNetworking || node.devMode {
+		return HealthPass, ""
+	}
+	peers := uint64(len(node.net.GetPeers(network.PeersConnectedOut, network.PeersConnectedIn)))
+	if peers < minPeers {
+		return HealthFail, fmt.Sprintf("%d peers connected, at least %d required", peers, minPeers)
+	}
+	return HealthPass, fmt.Sprintf("%d peers connected", peers)
+}
+
+func (node *AlgorandFullNode) checkTxPool() (HealthStatus, string) {
+	maxPercent := node.Config().HealthMaxTxPoolFullnessPercent
+	if maxPercent == 0 || node.config.TxPoolSize <= 0 {
+		return HealthPass, ""
+	}
+	pending := uint64(node.transactionPool.PendingCount())
+	percent := pending * 100 / uint64(node.config.TxPoolSize)
+	if percent >= maxPercent {
+		return HealthFail, fmt.Sprintf("%d of %d pool slots used", pending, node.config.TxPoolSize)
+	}
+	return HealthPass, fmt.Sprintf("%d of %d pool slots used", pending, node.config.TxPoolSize)
+}
+
+func (node *AlgorandFullNode) checkDiskSpace() (HealthStatus, string) {
+	minFree := node.Config().HealthMinDiskFreeBytes
+	if minFree == 0 {
+		return HealthPass, ""
+	}
+	free, err := util.FreeDiskSpace(node.rootDir)
+	if err != nil {
+		return HealthWarn, fmt.Sprintf("unable to tell the free disk space: %v", err)
+	}
+	if free < minFree {
+		return HealthFail, fmt.Sprintf("%d bytes free in %s, at least %d required", free, node.rootDir, minFree)
+	}
+	return HealthPass, fmt.Sprintf("%d bytes free", free)
+}
+
+func (node *AlgorandFullNode) checkParticipationKeys() (HealthStatus, string) {
+	latest := node.ledger.Latest()
+	warnRounds := basics.Round(node.Config().HealthParticipationKeyWarningRounds)
+	status, message := HealthPass, ""
+	registered := 0
+	voting := make(map[basics.Address]bool)
+	var expired []account.ParticipationRecord
+	for _, record := range node.accountManager.Registry().GetAll() {
+		// only the keys which were registered on chain are used for voting.
+		if record.EffectiveFirst == 0 && record.EffectiveLast == 0 {
+			continue
+		}
+		if record.EffectiveLast < latest {
+			// a key which was replaced by a newer one no longer votes, and it doesn't matter when it expires,
+			// while a key which voted until it expired leaves its account without a key, unless it got a new one.
+			if record.EffectiveLast == record.LastValid {
+				expired = append(expired, record)
+			}
+			continue
+		}
+		registered++
+		voting[record.Account] = true
+		if record.LastValid < latest+1 {
+			return HealthFail, fmt.Sprintf("the participation key of %s expired at round %d", record.Account, record.LastValid)
+		}
+		if warnRounds != 0 && record.LastValid <= latest+warnRounds {
+			status = HealthWarn
+			message = fmt.Sprintf("the participation key of %s expires at round %d", record.Account, record.LastValid)
+		}
+	}
+	for _, record := range expired {
+		if !voting[record.Account] {
+			return HealthFail, fmt.Sprintf("the participation key of %s expired at round %d", record.Account, record.LastValid)
+		}
+	}
+	if status == HealthPass && registered > 0 {
+		message = fmt.Sprintf("%d registered participation keys", registered)
+	}
+	return status, message
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func healthCheckResult(report HealthReport, name string) (HealthCheckResult, bool) {
+	for _, result := range report.Checks {
+		if result.Name == name {
+			return result, true
+		}
+	}
+	return HealthCheckResult{}, false
+}
+
+func TestHealthReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node, _ := makeTestSandbox(t, 0)
+	node.config.HealthMinDiskFreeBytes = 0
+
+	report := node.Health()
+	require.True(t, report.Ready)
+	require.Equal(t, HealthPass, report.Status)
+	require.Equal(t, node.ledger.Latest(), report.Round)
+	for _, name := range []string{"catchpoint-catchup", "ledger-lag", "peers", "txpool", "disk-space", "participation-keys"} {
+		result, ok := healthCheckResult(report, name)
+		require.True(t, ok, name)
+		require.Equal(t, HealthPass, result.Status, name)
+	}
+
+	// a warning shows in the details, but leaves the node ready.
+	node.RegisterHealthCheck("custom", func() (HealthStatus, string) { return HealthWarn, "attention" })
+	report = node.Health()
+	require.True(t, report.Ready)
+	require.Equal(t, HealthWarn, report.Status)
+	result, ok := healthCheckResult(report, "custom")
+	require.True(t, ok)
+	require.Equal(t, HealthCheckResult{Name: "custom", Status: HealthWarn, Message: "attention"}, result)
+
+	// registering a check under the same name replaces it.
+	node.RegisterHealthCheck("custom", func() (HealthStatus, string) { return HealthPass, "" })
+	report = node.Health()
+	require.Equal(t, HealthPass, report.Status)
+	require.Len(t, report.Checks, 7)
+
+	// a failing check makes the node no longer ready.
+	node.config.HealthMinDiskFreeBytes = math.MaxUint64
+	report = node.Health()
+	require.False(t, report.Ready)
+	require.Equal(t, HealthFail, report.Status)
+	result, ok = healthCheckResult(report, "disk-space")
+	require.True(t, ok)
+	require.Equal(t, HealthFail, result.Status)
+}
+
+func TestHealthParticipationKeyRotation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node, _ := makeTestSandbox(t, 0)
+	advance := func(rounds int) basics.Round {
+		for i := 0; i < rounds; i++ {
+			_, err := node.GenerateDevModeBlock(0)
+			require.NoError(t, err)
+		}
+		return node.ledger.Latest()
+	}
+	latest := advance(3)
+	registry := node.accountManager.Registry()
+	addr := basics.Address{0x01}
+
+	// the key of the account is about to expire
+	oldID, err := registry.Insert(account.Participation{Parent: addr, FirstValid: 0, LastValid: latest + 10, KeyDilution: 1})
+	require.NoError(t, err)
+	require.NoError(t, registry.Register(oldID, 1))
+	status, message := node.checkParticipationKeys()
+	require.Equal(t, HealthWarn, status, message)
+
+	// once it's replaced, the new key is the one which is checked
+	newID, err := registry.Insert(account.Participation{Parent: addr, FirstValid: 0, LastValid: latest + 1_000_000, KeyDilution: 1})
+	require.NoError(t, err)
+	require.NoError(t, registry.Register(newID, latest))
+	status, message = node.checkParticipationKeys()
+	require.Equal(t, HealthPass, status, message)
+
+	// even after the replaced key expired
+	latest = advance(11)
+	require.Greater(t, latest, registry.Get(oldID).LastValid)
+	status, message = node.checkParticipationKeys()
+	require.Equal(t, HealthPass, status, message)
+
+	// while a key which expires without being replaced fails the check
+	otherID, err := registry.Insert(account.Participation{Parent: basics.Address{0x02}, FirstValid: 0, LastValid: latest + 1, KeyDilution: 1})
+	require.NoError(t, err)
+	require.NoError(t, registry.Register(otherID, latest))
+	status, message = node.checkParticipationKeys()
+	require.Equal(t, HealthWarn, status, message)
+	for i := 0; i < 2; i++ {
+		advance(1)
+		status, message = node.checkParticipationKeys()
+		require.Equal(t, HealthFail, status, message)
+		require.Contains(t, message, basics.Address{0x02}.String())
+	}
+}
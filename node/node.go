@@ -129,6 +129,16 @@ type AlgorandFullNode struct {
 	genesisHash crypto.Digest
 	devMode     bool // is this node operates in a developer mode ? ( benign agreement, broadcasting transaction generates a new block )
 
+	// timestampOffset, when set, makes the timestamp of each developer mode
+	// block the timestamp of the previous block plus the offset, in seconds,
+	// instead of the wall clock time. It is protected by mu.
+	timestampOffset *int64
+	// snapshots holds the rounds of the developer mode ledger snapshots, by
+	// id. The snapshots themselves are saved in the ledger databases. It is
+	// protected by mu.
+	snapshots      map[uint64]basics.Round
+	nextSnapshotID uint64
+
 	log logging.Logger
 
 	// syncStatusMu used for locking lastRoundTimestamp and hasSyncedSinceStartup
@@ -148,6 +158,19 @@ type AlgorandFullNode struct {
 	tracer messagetracer.MessageTracer
 
 	stateProofWorker *stateproof.Worker
+
+	// healthMu protects healthChecks, the checks making up the health report of the node.
+	healthMu     sync.Mutex
+	healthChecks []namedHealthCheck
+
+	// configMu protects reloadedConfig, reloadedFields and configReloadHooks.
+	configMu sync.RWMutex
+	// reloadedConfig is the configuration the node was made with, before the adjustments made for the kind of
+	// the node, along with the changes applied by ReloadConfig.
+	reloadedConfig config.Local
+	// reloadedFields are the names of the fields which were changed by ReloadConfig.
+	reloadedFields    []string
+	configReloadHooks []func(config.Local)
 }
 
 // TxnWithStatus represents information about a single transaction,
@@ -172,12 +195,50 @@ type TxnWithStatus struct {
 // MakeFull sets up an Algorand full node
 // (i.e., it returns a node that participates in consensus)
 func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis) (*AlgorandFullNode, error) {
+	return makeFull(log, rootDir, cfg, phonebookAddresses, genesis, fullNodeOptions{})
+}
+
+// InProcessOptions describe how a node running within a single process alongside
+// other nodes is wired.
+type InProcessOptions struct {
+	// Net is the network the node communicates over, instead of websockets.
+	Net network.GossipNode
+	// Clock drives the agreement timeouts, the periodic catchup, the block requests
+	// and the transaction syncs.
+	Clock timers.Clock
+	// AgreementMonitor, if set, observes the event queues of the agreement service.
+	AgreementMonitor agreement.EventsProcessingMonitor
+}
+
+// MakeInProcess sets up an Algorand full node wired as described by opts. Its
+// ledger, crash and state proof databases are kept in memory, which allows
+// running several nodes within a single process.
+func MakeInProcess(log logging.Logger, rootDir string, cfg config.Local, genesis bookkeeping.Genesis, opts InProcessOptions) (*AlgorandFullNode, error) {
+	return makeFull(log, rootDir, cfg, nil, genesis, fullNodeOptions{inMemory: true, net: opts.Net, clock: opts.Clock, monitor: opts.AgreementMonitor})
+}
+
+// fullNodeOptions are the parts of a full node which could be substituted.
+type fullNodeOptions struct {
+	// inMemory keeps the ledger, crash and state proof databases in memory.
+	inMemory bool
+	// net replaces the websocket network the node would otherwise create.
+	net network.GossipNode
+	// clock replaces the wall clock driving the agreement timeouts, the periodic
+	// catchup, the block requests and the transaction syncs.
+	clock timers.Clock
+	// monitor observes the event queues of the agreement service.
+	monitor agreement.EventsProcessingMonitor
+}
+
+// makeFull sets up an Algorand full node, substituting the parts set in opts.
+func makeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAddresses []string, genesis bookkeeping.Genesis, opts fullNodeOptions) (*AlgorandFullNode, error) {
 	node := new(AlgorandFullNode)
 	node.rootDir = rootDir
 	node.log = log.With("name", cfg.NetAddress)
 	node.genesisID = genesis.ID()
 	node.genesisHash = genesis.Hash()
 	node.devMode = genesis.DevMode
+	node.reloadedConfig = cfg
 
 	if node.devMode {
 		cfg.DisableNetworking = true
@@ -185,12 +246,16 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.config = cfg
 
 	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
-	if err != nil {
-		log.Errorf("could not create websocket node: %v", err)
-		return nil, err
+	p2pNode := opts.net
+	if p2pNode == nil {
+		wsNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
+		if err != nil {
+			log.Errorf("could not create websocket node: %v", err)
+			return nil, err
+		}
+		wsNode.SetPrioScheme(node)
+		p2pNode = wsNode
 	}
-	p2pNode.SetPrioScheme(node)
 	node.net = p2pNode
 
 	accountListener := makeTopAccountListener(log)
@@ -200,7 +265,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	ledgerPathnamePrefix := filepath.Join(genesisDir, config.LedgerFilenamePrefix)
 
 	// create initial ledger, if it doesn't exist
-	err = os.Mkdir(genesisDir, 0700)
+	err := os.Mkdir(genesisDir, 0700)
 	if err != nil && !os.IsExist(err) {
 		log.Errorf("Unable to create genesis directory: %v", err)
 		return nil, err
@@ -214,7 +279,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.cryptoPool = execpool.MakePool(node)
 	node.lowPriorityCryptoVerificationPool = execpool.MakeBacklog(node.cryptoPool, 2*node.cryptoPool.GetParallelism(), execpool.LowPriority, node)
 	node.highPriorityCryptoVerificationPool = execpool.MakeBacklog(node.cryptoPool, 2*node.cryptoPool.GetParallelism(), execpool.HighPriority, node)
-	node.ledger, err = data.LoadLedger(node.log, ledgerPathnamePrefix, false, genesis.Proto, genalloc, node.genesisID, node.genesisHash, []ledgercore.BlockListener{}, cfg)
+	node.ledger, err = data.LoadLedger(node.log, ledgerPathnamePrefix, opts.inMemory, genesis.Proto, genalloc, node.genesisID, node.genesisHash, []ledgercore.BlockListener{}, cfg)
 	if err != nil {
 		log.Errorf("Cannot initialize ledger (%s): %v", ledgerPathnamePrefix, err)
 		return nil, err
@@ -260,7 +325,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	rpcs.RegisterTxService(node.transactionPool, p2pNode, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
 
 	crashPathname := filepath.Join(genesisDir, config.CrashFilename)
-	crashAccess, err := db.MakeAccessor(crashPathname, false, false)
+	crashAccess, err := db.MakeAccessor(crashPathname, false, opts.inMemory)
 	if err != nil {
 		log.Errorf("Cannot load crash data: %v", err)
 		return nil, err
@@ -268,8 +333,10 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	blockValidator := blockValidatorImpl{l: node.ledger, verificationPool: node.highPriorityCryptoVerificationPool}
 	agreementLedger := makeAgreementLedger(node.ledger, node.net)
-	var agreementClock timers.Clock
-	if node.devMode {
+	agreementClock := opts.clock
+	if agreementClock != nil {
+		agreementClock = agreementClock.Zero()
+	} else if node.devMode {
 		agreementClock = timers.MakeFrozenClock()
 	} else {
 		agreementClock = timers.MakeMonotonicClock(time.Now())
@@ -286,12 +353,21 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		KeyManager:     node,
 		RandomSource:   node,
 		BacklogPool:    node.highPriorityCryptoVerificationPool,
+
+		EventsProcessingMonitor: opts.monitor,
 	}
 	node.agreementService = agreement.MakeService(agreementParameters)
 
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
 	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
+	if opts.clock != nil {
+		node.catchupService.SetClock(opts.clock)
+	}
 	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
+	node.txPoolSyncerService.SyncOnRequest(node.txHandler.MissedTxnRequests())
+	if opts.clock != nil {
+		node.txPoolSyncerService.SetClock(opts.clock)
+	}
 
 	registry, err := ensureParticipationDB(genesisDir, node.log)
 	if err != nil {
@@ -331,19 +407,25 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	os.Remove(oldCompactCertPath)
 
 	stateProofPathname := filepath.Join(genesisDir, config.StateProofFileName)
-	stateProofAccess, err := db.MakeAccessor(stateProofPathname, false, false)
+	stateProofAccess, err := db.MakeAccessor(stateProofPathname, false, opts.inMemory)
 	if err != nil {
 		log.Errorf("Cannot load state proof data: %v", err)
 		return nil, err
 	}
 	node.stateProofWorker = stateproof.NewWorker(stateProofAccess, node.log, node.accountManager, node.ledger.Ledger, node.net, node)
+	node.registerDefaultHealthChecks()
 
 	return node, err
 }
 
 // Config returns a copy of the node's Local configuration
 func (node *AlgorandFullNode) Config() config.Local {
-	return node.config
+	node.configMu.RLock()
+	defer node.configMu.RUnlock()
+	if len(node.reloadedFields) == 0 {
+		return node.config
+	}
+	return node.config.CopyFields(node.reloadedConfig, node.reloadedFields)
 }
 
 // Start the node: connect to peers and run the agreement service while obtaining a lock. Doesn't wait for initial sync.
@@ -472,9 +554,18 @@ func (node *AlgorandFullNode) Ledger() *data.Ledger {
 }
 
 // writeDevmodeBlock generates a new block for a devmode, and write it to the ledger.
-func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
+// The block gets the given timestamp if it is not zero, otherwise it follows the
+// timestamp offset, if one was set.
+func (node *AlgorandFullNode) writeDevmodeBlock(timestamp int64) (err error) {
+	if timestamp != 0 || node.timestampOffset != nil {
+		timestamp, err = node.devmodeBlockTimestamp(timestamp)
+		if err != nil {
+			return err
+		}
+	}
+
 	var vb *ledgercore.ValidatedBlock
-	vb, err = node.transactionPool.AssembleDevModeBlock()
+	vb, err = node.transactionPool.AssembleDevModeBlock(timestamp)
 	if err != nil || vb == nil {
 		return
 	}
@@ -484,6 +575,100 @@ func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
 	return err
 }
 
+// devmodeBlockTimestamp returns the timestamp of the next developer mode block,
+// which is the given timestamp if it is not zero, or follows the timestamp
+// offset. The timestamp has to be valid for the next block, which cannot be
+// earlier than the previous one, nor too far after it.
+func (node *AlgorandFullNode) devmodeBlockTimestamp(timestamp int64) (int64, error) {
+	prev, err := node.ledger.BlockHdr(node.ledger.Latest())
+	if err != nil {
+		return 0, err
+	}
+	if timestamp == 0 {
+		timestamp = prev.TimeStamp + *node.timestampOffset
+	}
+	// as in BlockHeader.PreCheck, blocks following a zero timestamp are not
+	// constrained
+	if prev.TimeStamp > 0 {
+		maxIncrement := config.Consensus[prev.CurrentProtocol].MaxTimestampIncrement
+		if timestamp < prev.TimeStamp {
+			return 0, fmt.Errorf("block timestamp %d is before the timestamp of the previous block %d", timestamp, prev.TimeStamp)
+		} else if timestamp > prev.TimeStamp+maxIncrement {
+			return 0, fmt.Errorf("block timestamp %d is more than %d seconds after the timestamp of the previous block %d", timestamp, maxIncrement, prev.TimeStamp)
+		}
+	}
+	return timestamp, nil
+}
+
+// SetBlockTimeStampOffset makes the timestamp of every following developer mode
+// block the timestamp of the previous block plus offset seconds. The offset
+// cannot exceed the timestamp increment the consensus protocol allows.
+func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
+	if !node.devMode {
+		return ErrNotDevMode
+	}
+	if offset < 0 {
+		return fmt.Errorf("%w: block timestamp offset %d is negative", ErrInvalidDevModeArgument, offset)
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	latest, err := node.ledger.BlockHdr(node.ledger.Latest())
+	if err != nil {
+		return err
+	}
+	if maxIncrement := config.Consensus[latest.CurrentProtocol].MaxTimestampIncrement; offset > maxIncrement {
+		return fmt.Errorf("%w: block timestamp offset %d is more than the %d seconds allowed between blocks", ErrInvalidDevModeArgument, offset, maxIncrement)
+	}
+	node.timestampOffset = &offset
+	return nil
+}
+
+// GetBlockTimeStampOffset returns the block timestamp offset of a developer mode
+// node, or nil if its blocks follow the wall clock.
+func (node *AlgorandFullNode) GetBlockTimeStampOffset() (*int64, error) {
+	if !node.devMode {
+		return nil, ErrNotDevMode
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.timestampOffset == nil {
+		return nil, nil
+	}
+	offset := *node.timestampOffset
+	return &offset, nil
+}
+
+// MaxAdvanceDevModeRounds is the largest number of blocks AdvanceDevModeRounds
+// writes, since the node lock is held until they are all written.
+const MaxAdvanceDevModeRounds = 1000
+
+// AdvanceDevModeRounds makes a developer mode node write the given number of
+// blocks, following the timestamp offset, and returns the latest round. The
+// pool of a developer mode node is emptied by every transaction group, so the
+// blocks are empty.
+func (node *AlgorandFullNode) AdvanceDevModeRounds(rounds uint64) (basics.Round, error) {
+	if !node.devMode {
+		return 0, ErrNotDevMode
+	}
+	if rounds > MaxAdvanceDevModeRounds {
+		return 0, fmt.Errorf("%w: cannot advance more than %d rounds at once", ErrInvalidDevModeArgument, MaxAdvanceDevModeRounds)
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	for i := uint64(0); i < rounds; i++ {
+		latest := node.ledger.Latest()
+		err := node.writeDevmodeBlock(0)
+		if err != nil {
+			return node.ledger.Latest(), err
+		}
+		if node.ledger.Latest() == latest {
+			return latest, fmt.Errorf("no block was written for round %d", latest+1)
+		}
+	}
+	return node.ledger.Latest(), nil
+}
+
 // BroadcastSignedTxGroup broadcasts a transaction group that has already been signed.
 func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) (err error) {
 	// in developer mode, we need to take a lock, so that each new transaction group would truly
@@ -494,7 +679,7 @@ func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.Sign
 			// if we added the transaction successfully to the transaction pool, then
 			// attempt to generate a block and write it to the ledger.
 			if err == nil {
-				err = node.writeDevmodeBlock()
+				err = node.writeDevmodeBlock(0)
 			}
 			node.mu.Unlock()
 		}()
@@ -557,6 +742,13 @@ func (node *AlgorandFullNode) Simulate(txgroup []transactions.SignedTxn) (vb *le
 	return simulator.Simulate(txgroup)
 }
 
+// SimulateWithProfile is like Simulate, and also returns the opcode cost
+// profile of the programs evaluated for the transaction group.
+func (node *AlgorandFullNode) SimulateWithProfile(txgroup []transactions.SignedTxn) (vb *ledgercore.ValidatedBlock, missingSignatures bool, profile *simulation.CostProfile, err error) {
+	simulator := simulation.MakeSimulator(node.ledger)
+	return simulator.SimulateWithProfile(txgroup)
+}
+
 // ListTxns returns SignedTxns associated with a specific account in a range of Rounds (inclusive).
 // TxnWithStatus returns the round in which a particular transaction appeared,
 // since that information is not part of the SignedTxn itself.
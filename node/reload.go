@@ -0,0 +1,117 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// incomingConnectionsLimiter is implemented by the networks whose incoming connections limit can change at runtime.
+type incomingConnectionsLimiter interface {
+	SetIncomingConnectionsLimit(limit int) error
+}
+
+// AddConfigReloadHook registers a function which gets the configuration of the node whenever ReloadConfig
+// applies some changes, for the components outside of the node to pick up the reloadable fields they use.
+func (node *AlgorandFullNode) AddConfigReloadHook(hook func(config.Local)) {
+	node.configMu.Lock()
+	defer node.configMu.Unlock()
+	node.configReloadHooks = append(node.configReloadHooks, hook)
+}
+
+// ReloadConfig applies the changes of the updated configuration which can take effect while the node is
+// running, and returns the names of the fields it applied along with the names of the changed fields which
+// only take effect once the node restarts.
+func (node *AlgorandFullNode) ReloadConfig(updated config.Local) (applied []string, restartRequired []string) {
+	node.configMu.Lock()
+	// the fields are applied one at a time, each one on top of the configuration the previous ones left
+	current := node.config.CopyFields(node.reloadedConfig, node.reloadedFields)
+	for _, field := range node.reloadedConfig.ChangedFields(updated) {
+		candidate := current.CopyFields(updated, []string{field})
+		if !config.IsRuntimeReloadable(field) || !node.applyConfigField(field, candidate) {
+			restartRequired = append(restartRequired, field)
+			continue
+		}
+		current = candidate
+		applied = append(applied, field)
+	}
+	if len(applied) == 0 {
+		node.configMu.Unlock()
+		return
+	}
+	node.reloadedConfig = node.reloadedConfig.CopyFields(updated, applied)
+	for _, field := range applied {
+		if !containsString(node.reloadedFields, field) {
+			node.reloadedFields = append(node.reloadedFields, field)
+		}
+	}
+	cfg := node.config.CopyFields(node.reloadedConfig, node.reloadedFields)
+	hooks := node.configReloadHooks
+	node.configMu.Unlock()
+
+	for _, hook := range hooks {
+		hook(cfg)
+	}
+	node.log.Infof("ReloadConfig: applied %v, restart required for %v", applied, restartRequired)
+	return
+}
+
+// applyConfigField applies the new value of a runtime reloadable field to the services of the node, and tells
+// whether it could. The updated configuration is the current one of the node with the new value of the field.
+// The fields which aren't handled here are read through Config, or by the reload hooks.
+func (node *AlgorandFullNode) applyConfigField(field string, updated config.Local) bool {
+	switch field {
+	case "BaseLoggerDebugLevel":
+		node.log.SetLevel(logging.Level(updated.BaseLoggerDebugLevel))
+	case "IncomingConnectionsLimit":
+		// the network only lowers the limit it started with, and so does the transaction backlog sized with it
+		limiter, ok := node.net.(incomingConnectionsLimiter)
+		if !ok {
+			return false
+		}
+		if err := limiter.SetIncomingConnectionsLimit(updated.IncomingConnectionsLimit); err != nil {
+			node.log.Warnf("ReloadConfig: unable to apply IncomingConnectionsLimit %d: %v", updated.IncomingConnectionsLimit, err)
+			return false
+		}
+		if err := node.txHandler.SetBacklogConfig(updated); err != nil {
+			node.log.Warnf("ReloadConfig: unable to resize the transaction backlog: %v", err)
+		}
+	case "TxBacklogServiceRateWindowSeconds", "TxBacklogReservedCapacityPerPeer", "EnableTxBacklogRateLimiting", "TxBacklogSize":
+		if err := node.txHandler.SetBacklogConfig(updated); err != nil {
+			node.log.Warnf("ReloadConfig: unable to apply %s: %v", field, err)
+			return false
+		}
+	case "TelemetryToLog":
+		if err := node.log.SetTelemetryToLog(updated.TelemetryToLog); err != nil {
+			node.log.Warnf("ReloadConfig: unable to apply TelemetryToLog: %v", err)
+			return false
+		}
+	case "CatchupParallelBlocks":
+		node.catchupService.SetParallelBlocks(updated.CatchupParallelBlocks)
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestReloadConfig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node, _ := makeTestSandbox(t, 0)
+	node.config.HealthMinDiskFreeBytes = 0
+	node.reloadedConfig.HealthMinDiskFreeBytes = 0
+	require.True(t, node.Health().Ready)
+
+	var reloaded []config.Local
+	node.AddConfigReloadHook(func(cfg config.Local) { reloaded = append(reloaded, cfg) })
+
+	// the reloads are compared with the configuration the sandbox was made with, rather than its adjusted one.
+	updated := config.GetDefaultLocal()
+	updated.NetAddress = ""
+	updated.HealthMinDiskFreeBytes = 0
+	applied, restartRequired := node.ReloadConfig(updated)
+	require.Empty(t, applied)
+	require.Empty(t, restartRequired)
+	require.Empty(t, reloaded)
+
+	updated.HealthMinDiskFreeBytes = math.MaxUint64
+	updated.CatchupParallelBlocks = 3
+	updated.MaxConnectionsPerIP++
+	applied, restartRequired = node.ReloadConfig(updated)
+	require.Equal(t, []string{"CatchupParallelBlocks", "HealthMinDiskFreeBytes"}, applied)
+	require.Equal(t, []string{"MaxConnectionsPerIP"}, restartRequired)
+
+	cfg := node.Config()
+	require.Equal(t, uint64(3), cfg.CatchupParallelBlocks)
+	require.Equal(t, uint64(math.MaxUint64), cfg.HealthMinDiskFreeBytes)
+	require.NotEqual(t, updated.MaxConnectionsPerIP, cfg.MaxConnectionsPerIP)
+	require.True(t, cfg.Archival)
+	require.Equal(t, []config.Local{cfg}, reloaded)
+	require.False(t, node.Health().Ready)
+
+	// the fields which require a restart keep being reported until then.
+	applied, restartRequired = node.ReloadConfig(updated)
+	require.Empty(t, applied)
+	require.Equal(t, []string{"MaxConnectionsPerIP"}, restartRequired)
+
+	// the transaction backlog allocated at startup can shrink, but not grow, and the telemetry disabled at
+	// startup can't be recorded to the log.
+	updated.TxBacklogSize = cfg.TxBacklogSize / 2
+	updated.TelemetryToLog = false
+	applied, restartRequired = node.ReloadConfig(updated)
+	require.Equal(t, []string{"TxBacklogSize", "TelemetryToLog"}, applied)
+	require.Equal(t, []string{"MaxConnectionsPerIP"}, restartRequired)
+	require.Equal(t, cfg.TxBacklogSize/2, node.Config().TxBacklogSize)
+
+	updated.TxBacklogSize = cfg.TxBacklogSize * 2
+	updated.TelemetryToLog = true
+	applied, restartRequired = node.ReloadConfig(updated)
+	require.Empty(t, applied)
+	require.Equal(t, []string{"MaxConnectionsPerIP", "TxBacklogSize", "TelemetryToLog"}, restartRequired)
+	require.Equal(t, cfg.TxBacklogSize/2, node.Config().TxBacklogSize)
+	require.False(t, node.Config().TelemetryToLog)
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// MakeSandbox sets up a developer mode node whose ledger only exists in memory,
+// so that every run starts over from the genesis. The ledger is archival, so that
+// every block stays available, and does not track catchpoints. The block timestamps do not
+// follow the wall clock, each block is timeOffset seconds after the previous
+// one, which makes the ledger contents reproducible. The offset cannot exceed the
+// maximal timestamp increment of the consensus protocol.
+func MakeSandbox(log logging.Logger, rootDir string, cfg config.Local, genesis bookkeeping.Genesis, timeOffset int64) (*AlgorandFullNode, error) {
+	if !genesis.DevMode {
+		return nil, ErrNotDevMode
+	}
+	if timeOffset < 0 {
+		return nil, fmt.Errorf("block timestamp offset %d is negative", timeOffset)
+	}
+	if maxIncrement := config.Consensus[genesis.Proto].MaxTimestampIncrement; timeOffset > maxIncrement {
+		return nil, fmt.Errorf("block timestamp offset %d is more than the %d seconds allowed between blocks", timeOffset, maxIncrement)
+	}
+
+	// a sandbox does not connect to any peer
+	given := cfg
+	cfg.NetAddress = ""
+	cfg.DNSBootstrapID = ""
+	cfg.Archival = true
+	cfg.CatchpointTracking = -1
+	cfg.CatchpointInterval = 0
+	cfg.IsIndexerActive = false
+	cfg.EnableDeveloperAPI = true
+
+	node, err := makeFull(log, rootDir, cfg, nil, genesis, fullNodeOptions{inMemory: true})
+	if err != nil {
+		return nil, err
+	}
+	node.timestampOffset = &timeOffset
+	// configuration reloads get compared with the configuration we were given
+	node.reloadedConfig = given
+	return node, nil
+}
+
+// GenerateDevModeBlock makes a developer mode node write a block with the
+// transactions of its pool, which may be none, and returns its round. The block
+// gets the given timestamp unless it is zero, in which case it follows the
+// timestamp offset or the wall clock. The timestamp cannot be earlier than the
+// one of the previous block.
+func (node *AlgorandFullNode) GenerateDevModeBlock(timestamp int64) (basics.Round, error) {
+	if !node.devMode {
+		return 0, ErrNotDevMode
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	err := node.writeDevmodeBlock(timestamp)
+	if err != nil {
+		return 0, err
+	}
+	return node.ledger.Latest(), nil
+}
+
+// SnapshotLedger records the current state of the ledger of a developer mode
+// node, to be restored later by RestoreLedgerSnapshot. It returns the id of the
+// snapshot and the round it was taken at. The snapshot is saved with the ledger,
+// in its databases.
+func (node *AlgorandFullNode) SnapshotLedger() (uint64, basics.Round, error) {
+	if !node.devMode {
+		return 0, 0, ErrNotDevMode
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	id := node.nextSnapshotID + 1
+	latest := node.ledger.Latest()
+	err := node.ledger.SaveSnapshot(id)
+	if err != nil {
+		return 0, 0, fmt.Errorf("cannot snapshot the ledger at round %d: %w", latest, err)
+	}
+
+	if node.snapshots == nil {
+		node.snapshots = make(map[uint64]basics.Round)
+	}
+	node.nextSnapshotID = id
+	node.snapshots[id] = latest
+	return id, latest, nil
+}
+
+// RestoreLedgerSnapshot returns the ledger of a developer mode node to the state
+// it had when the snapshot was taken, discarding the blocks added since, and
+// returns the round of the snapshot. The snapshot is kept, so that it can be
+// restored again.
+func (node *AlgorandFullNode) RestoreLedgerSnapshot(id uint64) (basics.Round, error) {
+	if !node.devMode {
+		return 0, ErrNotDevMode
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	if _, ok := node.snapshots[id]; !ok {
+		return 0, ErrSnapshotNotFound
+	}
+	err := node.ledger.RestoreSnapshot(id)
+	if err != nil {
+		return 0, fmt.Errorf("cannot restore snapshot %d: %w", id, err)
+	}
+	// the pending transactions may not be valid against the restored state
+	node.transactionPool.Reset()
+	return node.ledger.Latest(), nil
+}
+
+// DeleteLedgerSnapshot forgets a snapshot taken by SnapshotLedger.
+func (node *AlgorandFullNode) DeleteLedgerSnapshot(id uint64) error {
+	if !node.devMode {
+		return ErrNotDevMode
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	if _, ok := node.snapshots[id]; !ok {
+		return ErrSnapshotNotFound
+	}
+	err := node.ledger.DeleteSnapshot(id)
+	if err != nil {
+		return err
+	}
+	delete(node.snapshots, id)
+	return nil
+}
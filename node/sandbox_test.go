@@ -0,0 +1,217 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/gen"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const sandboxGenesisTimestamp = 1600000000
+
+func makeTestSandbox(t *testing.T, timeOffset int64) (*AlgorandFullNode, []*crypto.SignatureSecrets) {
+	genesis, secrets, err := gen.GenerateSandboxGenesis(gen.SandboxGenesisData{
+		Seed:      t.Name(),
+		Accounts:  2,
+		Balance:   1000000000,
+		Timestamp: sandboxGenesisTimestamp,
+	})
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultLocal()
+	cfg.NetAddress = ""
+	node, err := MakeSandbox(logging.TestingLog(t), t.TempDir(), cfg, genesis, timeOffset)
+	require.NoError(t, err)
+	node.Start()
+	t.Cleanup(node.Stop)
+	return node, secrets
+}
+
+func sandboxPayment(t *testing.T, node *AlgorandFullNode, from, to *crypto.SignatureSecrets, amount uint64) {
+	latest := node.ledger.Latest()
+	txn := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:      basics.Address(from.SignatureVerifier),
+			Fee:         basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid:  latest,
+			LastValid:   latest + 100,
+			GenesisID:   node.GenesisID(),
+			GenesisHash: node.GenesisHash(),
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: basics.Address(to.SignatureVerifier),
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+	require.NoError(t, node.BroadcastSignedTxGroup([]transactions.SignedTxn{txn.Sign(from)}))
+}
+
+func sandboxBalance(t *testing.T, node *AlgorandFullNode, secrets *crypto.SignatureSecrets) uint64 {
+	data, _, _, err := node.ledger.LookupLatest(basics.Address(secrets.SignatureVerifier))
+	require.NoError(t, err)
+	return data.MicroAlgos.Raw
+}
+
+func TestSandboxSnapshotRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node, secrets := makeTestSandbox(t, 1)
+	alice, bob := secrets[0], secrets[1]
+
+	sandboxPayment(t, node, alice, bob, 1000)
+	require.Equal(t, basics.Round(1), node.ledger.Latest())
+	snapshotBalance := sandboxBalance(t, node, bob)
+
+	id, rnd, err := node.SnapshotLedger()
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(1), rnd)
+
+	for i := 0; i < 3; i++ {
+		sandboxPayment(t, node, alice, bob, 1000)
+	}
+	require.Equal(t, basics.Round(4), node.ledger.Latest())
+	require.Equal(t, snapshotBalance+3000, sandboxBalance(t, node, bob))
+
+	for i := 0; i < 2; i++ {
+		rnd, err = node.RestoreLedgerSnapshot(id)
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(1), rnd)
+		require.Equal(t, basics.Round(1), node.ledger.Latest())
+		require.Equal(t, snapshotBalance, sandboxBalance(t, node, bob))
+
+		// the ledger keeps going from the snapshot
+		sandboxPayment(t, node, bob, alice, 500)
+		require.Equal(t, basics.Round(2), node.ledger.Latest())
+	}
+
+	_, err = node.RestoreLedgerSnapshot(id + 1)
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+	require.NoError(t, node.DeleteLedgerSnapshot(id))
+	_, err = node.RestoreLedgerSnapshot(id)
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+}
+
+func TestSandboxBlockTimestamps(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node, secrets := makeTestSandbox(t, 10)
+
+	sandboxPayment(t, node, secrets[0], secrets[1], 1000)
+	hdr, err := node.ledger.BlockHdr(1)
+	require.NoError(t, err)
+	require.Equal(t, int64(sandboxGenesisTimestamp+10), hdr.TimeStamp)
+
+	rnd, err := node.GenerateDevModeBlock(0)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(2), rnd)
+	hdr, err = node.ledger.BlockHdr(rnd)
+	require.NoError(t, err)
+	require.Equal(t, int64(sandboxGenesisTimestamp+20), hdr.TimeStamp)
+	blk, err := node.ledger.Block(rnd)
+	require.NoError(t, err)
+	require.Empty(t, blk.Payset)
+
+	// the timestamp is part of the header the block was evaluated for, so
+	// the block is valid
+	maxIncrement := config.Consensus[protocol.ConsensusCurrentVersion].MaxTimestampIncrement
+	_, err = node.GenerateDevModeBlock(sandboxGenesisTimestamp + 20 + maxIncrement + 1)
+	require.ErrorContains(t, err, "seconds after the timestamp of the previous block")
+	rnd, err = node.GenerateDevModeBlock(sandboxGenesisTimestamp + 20 + maxIncrement)
+	require.NoError(t, err)
+	blk, err = node.ledger.Block(rnd)
+	require.NoError(t, err)
+	require.Equal(t, int64(sandboxGenesisTimestamp+20)+maxIncrement, blk.TimeStamp)
+	prev, err := node.ledger.BlockHdr(rnd - 1)
+	require.NoError(t, err)
+	require.NoError(t, blk.BlockHeader.PreCheck(prev))
+
+	_, err = node.GenerateDevModeBlock(sandboxGenesisTimestamp)
+	require.ErrorContains(t, err, "before the timestamp of the previous block")
+
+	require.NoError(t, node.SetBlockTimeStampOffset(0))
+	offset, err := node.GetBlockTimeStampOffset()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), *offset)
+	sandboxPayment(t, node, secrets[0], secrets[1], 1000)
+	hdr, err = node.ledger.BlockHdr(node.ledger.Latest())
+	require.NoError(t, err)
+	require.Equal(t, int64(sandboxGenesisTimestamp+20)+maxIncrement, hdr.TimeStamp)
+}
+
+func TestAdvanceDevModeRounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node, _ := makeTestSandbox(t, 10)
+
+	rnd, err := node.AdvanceDevModeRounds(5)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), rnd)
+	for r := basics.Round(1); r <= rnd; r++ {
+		blk, err := node.ledger.Block(r)
+		require.NoError(t, err)
+		require.Empty(t, blk.Payset)
+		require.Equal(t, int64(sandboxGenesisTimestamp)+10*int64(r), blk.TimeStamp)
+	}
+
+	err = node.SetBlockTimeStampOffset(26)
+	require.ErrorIs(t, err, ErrInvalidDevModeArgument)
+	require.NoError(t, node.SetBlockTimeStampOffset(25))
+	rnd, err = node.AdvanceDevModeRounds(2)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(7), rnd)
+	hdr, err := node.ledger.BlockHdr(rnd)
+	require.NoError(t, err)
+	require.Equal(t, int64(sandboxGenesisTimestamp)+50+2*25, hdr.TimeStamp)
+
+	rnd, err = node.AdvanceDevModeRounds(0)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(7), rnd)
+
+	_, err = node.AdvanceDevModeRounds(MaxAdvanceDevModeRounds + 1)
+	require.ErrorIs(t, err, ErrInvalidDevModeArgument)
+	require.Equal(t, basics.Round(7), node.ledger.Latest())
+}
+
+func TestMakeSandboxTimeOffset(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesis, _, err := gen.GenerateSandboxGenesis(gen.SandboxGenesisData{
+		Seed:      t.Name(),
+		Accounts:  1,
+		Balance:   1000000000,
+		Timestamp: sandboxGenesisTimestamp,
+	})
+	require.NoError(t, err)
+
+	cfg := config.GetDefaultLocal()
+	_, err = MakeSandbox(logging.TestingLog(t), t.TempDir(), cfg, genesis, -1)
+	require.ErrorContains(t, err, "negative")
+	maxIncrement := config.Consensus[genesis.Proto].MaxTimestampIncrement
+	_, err = MakeSandbox(logging.TestingLog(t), t.TempDir(), cfg, genesis, maxIncrement+1)
+	require.ErrorContains(t, err, "allowed between blocks")
+}
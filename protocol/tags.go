@@ -34,6 +34,8 @@ const (
 	PingReplyTag       Tag = "pj"
 	ProposalPayloadTag Tag = "PP"
 	StateProofSigTag   Tag = "SP"
+	TxnAdvertiseTag    Tag = "TA"
+	TxnRequestTag      Tag = "TQ"
 	TopicMsgRespTag    Tag = "TS"
 	TxnTag             Tag = "TX"
 	UniCatchupReqTag   Tag = "UC" //Replaced by UniEnsBlockReqTag. Only for backward compatibility.
@@ -55,6 +57,8 @@ var TagList = []Tag{
 	PingReplyTag,
 	ProposalPayloadTag,
 	StateProofSigTag,
+	TxnAdvertiseTag,
+	TxnRequestTag,
 	TopicMsgRespTag,
 	TxnTag,
 	UniCatchupReqTag,
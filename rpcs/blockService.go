@@ -45,6 +45,18 @@ import (
 
 // BlockResponseContentType is the HTTP Content-Type header for a raw binary block
 const BlockResponseContentType = "application/x-algorand-block-v1"
+
+// BlockRangeResponseContentType is the HTTP Content-Type header for a range of raw binary blocks, each one prefixed by its uvarint encoded length
+const BlockRangeResponseContentType = "application/x-algorand-block-range-v1"
+
+// BlockServiceRangeHeader is the HTTP header the block service advertises the maximal number of blocks it returns per range request with
+const BlockServiceRangeHeader = "X-Algorand-Block-Range"
+
+// BlockServiceRangeCountParam is the query argument requesting the blocks of several consecutive rounds, starting with the requested round
+const BlockServiceRangeCountParam = "count"
+
+// BlockServiceMaxRangeBlocks is the maximal number of blocks returned by a single range request
+const BlockServiceMaxRangeBlocks = 64
 const blockResponseHasBlockCacheControl = "public, max-age=31536000, immutable"    // 31536000 seconds are one year.
 const blockResponseMissingBlockCacheControl = "public, max-age=1, must-revalidate" // cache for 1 second, and force revalidation afterward
 const blockServerMaxBodyLength = 512                                               // we don't really pass meaningful content here, so 512 bytes should be a safe limit
@@ -153,6 +165,7 @@ func (bs *BlockService) Stop() {
 
 // ServerHTTP returns blocks
 // Either /v{version}/{genesisID}/block/{round} or ?b={round}&v={version}
+// A ?count={count} argument requests the blocks of up to count consecutive rounds, starting with {round}.
 // Uses gorilla/mux for path argument parsing.
 func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	pathVars := mux.Vars(request)
@@ -214,6 +227,16 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 		response.WriteHeader(http.StatusBadRequest)
 		return
 	}
+	count := uint64(1)
+	if countStr := request.URL.Query().Get(BlockServiceRangeCountParam); countStr != "" {
+		count, err = strconv.ParseUint(countStr, 10, 64)
+		if err != nil || count == 0 {
+			bs.log.Debug("http block range count parse fail", countStr, err)
+			response.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	}
+	response.Header().Set(BlockServiceRangeHeader, strconv.Itoa(BlockServiceMaxRangeBlocks))
 	encodedBlockCert, err := bs.rawBlockBytes(basics.Round(round))
 	if err != nil {
 		switch err.(type) {
@@ -233,6 +256,11 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 		}
 	}
 
+	if count > 1 {
+		bs.writeBlockRange(response, basics.Round(round), count, encodedBlockCert)
+		return
+	}
+
 	response.Header().Set("Content-Type", BlockResponseContentType)
 	response.Header().Set("Content-Length", strconv.Itoa(len(encodedBlockCert)))
 	response.Header().Set("Cache-Control", blockResponseHasBlockCacheControl)
@@ -243,6 +271,46 @@ func (bs *BlockService) ServeHTTP(response http.ResponseWriter, request *http.Re
 	}
 }
 
+// writeBlockRange streams the blocks of up to count consecutive rounds, starting with the given round, whose block was
+// already retrieved. Each block is prefixed by its length, and flushed as soon as it's written so that the client can
+// start processing it; the stream stops at the first round whose block isn't available.
+func (bs *BlockService) writeBlockRange(response http.ResponseWriter, round basics.Round, count uint64, encodedBlockCert []byte) {
+	if count > BlockServiceMaxRangeBlocks {
+		count = BlockServiceMaxRangeBlocks
+	}
+	// the range might get longer once the following blocks are available, so it can't be cached for long.
+	response.Header().Set("Content-Type", BlockRangeResponseContentType)
+	response.Header().Set("Cache-Control", blockResponseMissingBlockCacheControl)
+	response.WriteHeader(http.StatusOK)
+	flusher, _ := response.(http.Flusher)
+
+	lengthBuf := make([]byte, binary.MaxVarintLen64)
+	for i := uint64(0); i < count; i++ {
+		if i > 0 {
+			var err error
+			encodedBlockCert, err = bs.rawBlockBytes(round + basics.Round(i))
+			if err != nil {
+				if _, ok := err.(ledgercore.ErrNoEntry); !ok {
+					bs.log.Warnf("writeBlockRange : failed to retrieve block %d %v", round+basics.Round(i), err)
+				}
+				return
+			}
+		}
+		n := binary.PutUvarint(lengthBuf, uint64(len(encodedBlockCert)))
+		_, err := response.Write(lengthBuf[:n])
+		if err == nil {
+			_, err = response.Write(encodedBlockCert)
+		}
+		if err != nil {
+			bs.log.Debugf("http block range write failed : %v", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+}
+
 func (bs *BlockService) processIncomingMessage(msg network.IncomingMessage) (n network.OutgoingMessage) {
 	// don't block - just stick in a slightly buffered channel if possible
 	select {
@@ -17,10 +17,13 @@
 package rpcs
 
 import (
+	"bufio"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -332,6 +335,79 @@ func TestRedirectExceptions(t *testing.T) {
 var poolAddr = basics.Address{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
 
+// TestBlockServiceRange tests the streaming of the blocks of consecutive rounds
+func TestBlockServiceRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+
+	ledger1 := makeLedger(t, "l1")
+	defer ledger1.Close()
+	for i := 0; i < 5; i++ {
+		addBlock(t, ledger1)
+	}
+
+	net1 := &httpTestPeerSource{}
+	config := config.GetDefaultLocal()
+	bs1 := MakeBlockService(log, config, ledger1, net1, "test-genesis-ID")
+
+	nodeA := &basicRPCNode{}
+	nodeA.RegisterHTTPHandler(BlockServiceBlockPath, bs1)
+	nodeA.start()
+	defer nodeA.stop()
+
+	getRange := func(round uint64, count string) (*http.Response, [][]byte) {
+		parsedURL, err := network.ParseHostOrURL(nodeA.rootURL())
+		require.NoError(t, err)
+		parsedURL.Path = FormatBlockQuery(round, parsedURL.Path, net1)
+		parsedURL.Path = strings.Replace(parsedURL.Path, "{genesisID}", "test-genesis-ID", 1)
+		parsedURL.RawQuery = BlockServiceRangeCountParam + "=" + count
+		response, err := http.Get(parsedURL.String())
+		require.NoError(t, err)
+		defer response.Body.Close()
+		if response.StatusCode != http.StatusOK {
+			return response, nil
+		}
+		require.Equal(t, BlockRangeResponseContentType, response.Header.Get("Content-Type"))
+
+		var entries [][]byte
+		reader := bufio.NewReader(response.Body)
+		for {
+			length, err := binary.ReadUvarint(reader)
+			if err == io.EOF {
+				return response, entries
+			}
+			require.NoError(t, err)
+			entry := make([]byte, length)
+			_, err = io.ReadFull(reader, entry)
+			require.NoError(t, err)
+			entries = append(entries, entry)
+		}
+	}
+
+	// the range support is advertised
+	response, entries := getRange(2, "3")
+	require.Equal(t, strconv.Itoa(BlockServiceMaxRangeBlocks), response.Header.Get(BlockServiceRangeHeader))
+	require.Len(t, entries, 3)
+	for i, entry := range entries {
+		expected, err := RawBlockBytes(ledger1, basics.Round(2+i))
+		require.NoError(t, err)
+		require.Equal(t, expected, entry)
+	}
+
+	// the stream stops at the last available block
+	_, entries = getRange(4, "10")
+	require.Len(t, entries, 2)
+
+	// missing first blocks and invalid counts fail
+	response, _ = getRange(6, "10")
+	require.Equal(t, http.StatusNotFound, response.StatusCode)
+	response, _ = getRange(1, "0")
+	require.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response, _ = getRange(1, "x")
+	require.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
 func makeLedger(t *testing.T, namePostfix string) *data.Ledger {
 	proto := config.Consensus[protocol.ConsensusCurrentVersion]
 	genesis := make(map[basics.Address]basics.AccountData)
@@ -17,6 +17,7 @@
 package rpcs
 
 import (
+	"archive/tar"
 	"compress/gzip"
 	"fmt"
 	"io"
@@ -27,6 +28,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/algorand/go-deadlock"
 	"github.com/gorilla/mux"
 
 	"github.com/algorand/go-algorand/config"
@@ -41,6 +43,13 @@ const (
 	// LedgerResponseContentType is the HTTP Content-Type header for a raw ledger block
 	LedgerResponseContentType = "application/x-algorand-ledger-v2.1"
 
+	// LedgerChunkResponseContentType is the HTTP Content-Type header for a single chunk of a catchpoint file
+	LedgerChunkResponseContentType = "application/x-algorand-ledger-chunk-v1"
+
+	// LedgerServiceChunkParameter is the query parameter naming the single chunk of the catchpoint file to be retrieved,
+	// e.g. ?chunk=balances.3.msgpack
+	LedgerServiceChunkParameter = "chunk"
+
 	ledgerServerMaxBodyLength = 512 // we don't really pass meaningful content here, so 512 bytes should be a safe limit
 
 	// LedgerServiceLedgerPath is the path to register LedgerService as a handler for when using gorilla/mux
@@ -52,8 +61,25 @@ const (
 
 	// expectedWorstUploadSpeedBytesPerSecond defines the worst-case scenario upload speed we expect to get while uploading a catchpoint file
 	expectedWorstUploadSpeedBytesPerSecond = 20 * 1024
+
+	// maxConcurrentChunkRequests is the number of single chunk requests served at once. Serving a chunk requires
+	// decompressing the catchpoint file up to that chunk, so further requests are turned away until one completes.
+	maxConcurrentChunkRequests = 4
+
+	// chunkRequestRetryAfter is the number of seconds a client turned away from a single chunk request is asked to
+	// wait before retrying.
+	chunkRequestRetryAfter = 5
+
+	// maxIndexedCatchpoints is the number of catchpoint files whose chunk locations are kept.
+	maxIndexedCatchpoints = 4
 )
 
+// catchpointChunkLocation is the offset and the size of the content of a chunk in the decompressed catchpoint file
+type catchpointChunkLocation struct {
+	offset int64
+	size   int64
+}
+
 // LedgerService represents the Ledger RPC API
 type LedgerService struct {
 	// running is non-zero once the service is running, and zero when it's not running. it needs to be at a 32-bit aligned address for RasPI support.
@@ -63,6 +89,14 @@ type LedgerService struct {
 	net           network.GossipNode
 	enableService bool
 	stopping      sync.WaitGroup
+
+	// chunkRequests holds a token for each single chunk request being served
+	chunkRequests chan struct{}
+
+	// chunkIndexes are the locations of the chunks of the recently requested catchpoint files, by their round, so
+	// that the tar headers of a catchpoint file are only read once.
+	chunkIndexesMu deadlock.Mutex
+	chunkIndexes   map[uint64]map[string]catchpointChunkLocation
 }
 
 // MakeLedgerService creates a LedgerService around the provider Ledger and registers it with the HTTP router
@@ -72,6 +106,8 @@ func MakeLedgerService(config config.Local, ledger *data.Ledger, net network.Gos
 		genesisID:     genesisID,
 		net:           net,
 		enableService: config.EnableLedgerService,
+		chunkRequests: make(chan struct{}, maxConcurrentChunkRequests),
+		chunkIndexes:  make(map[uint64]map[string]catchpointChunkLocation),
 	}
 	// the underlying gorilla/mux doesn't support "unregister", so we're forced to implement it ourselves.
 	if service.enableService {
@@ -172,6 +208,16 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		response.Write([]byte(fmt.Sprintf("specified round number could not be parsed using base 36 : %v", err)))
 		return
 	}
+	chunkName := request.URL.Query().Get(LedgerServiceChunkParameter)
+	if chunkName != "" {
+		if !ls.acquireChunkRequest() {
+			response.Header().Set("Retry-After", strconv.Itoa(chunkRequestRetryAfter))
+			response.WriteHeader(http.StatusServiceUnavailable)
+			response.Write([]byte("too many catchpoint file chunk requests"))
+			return
+		}
+		defer ls.releaseChunkRequest()
+	}
 	cs, err := ls.ledger.GetCatchpointStream(basics.Round(round))
 	if err != nil {
 		switch err.(type) {
@@ -203,6 +249,11 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Warnf("LedgerService.ServeHTTP unable to set connection timeout")
 	}
 
+	if chunkName != "" {
+		ls.serveChunk(response, cs, round, chunkName)
+		return
+	}
+
 	response.Header().Set("Content-Type", LedgerResponseContentType)
 	requestedCompressedResponse := strings.Contains(request.Header.Get("Accept-Encoding"), "gzip")
 	if requestedCompressedResponse {
@@ -226,3 +277,129 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Infof("LedgerService.ServeHTTP : unable to write decompressed catchpoint file for round %d, written bytes %d : %v", round, written, err)
 	}
 }
+
+// acquireChunkRequest returns whether a single chunk request can be served now, in which case releaseChunkRequest
+// needs to be called once it was served.
+func (ls *LedgerService) acquireChunkRequest() bool {
+	select {
+	case ls.chunkRequests <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (ls *LedgerService) releaseChunkRequest() {
+	<-ls.chunkRequests
+}
+
+// chunkIndex returns the chunk locations of the catchpoint file of the given round, if they are known
+func (ls *LedgerService) chunkIndex(round uint64) (index map[string]catchpointChunkLocation, ok bool) {
+	ls.chunkIndexesMu.Lock()
+	defer ls.chunkIndexesMu.Unlock()
+	index, ok = ls.chunkIndexes[round]
+	return
+}
+
+func (ls *LedgerService) setChunkIndex(round uint64, index map[string]catchpointChunkLocation) {
+	ls.chunkIndexesMu.Lock()
+	defer ls.chunkIndexesMu.Unlock()
+	for r := range ls.chunkIndexes {
+		if len(ls.chunkIndexes) < maxIndexedCatchpoints {
+			break
+		}
+		delete(ls.chunkIndexes, r)
+	}
+	ls.chunkIndexes[round] = index
+}
+
+// countingReader counts the bytes read from the underlying reader
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (cr *countingReader) Read(p []byte) (n int, err error) {
+	n, err = cr.reader.Read(p)
+	cr.count += int64(n)
+	return
+}
+
+// serveChunk writes a single chunk of the catchpoint file, so that a client which failed to verify a chunk it received
+// from another peer could retrieve just that chunk. The first request for a catchpoint file reads all of its tar
+// headers, and records where each chunk is; later requests skip straight to the chunk they ask for, and requests for
+// chunks which aren't in the file are answered without reading it.
+func (ls *LedgerService) serveChunk(response http.ResponseWriter, cs io.Reader, round uint64, chunkName string) {
+	index, indexed := ls.chunkIndex(round)
+	var location catchpointChunkLocation
+	if indexed {
+		var ok bool
+		location, ok = index[chunkName]
+		if !ok {
+			response.WriteHeader(http.StatusNotFound)
+			response.Write([]byte(fmt.Sprintf("catchpoint file for round %d has no chunk '%s'", round, chunkName)))
+			return
+		}
+	}
+
+	decompressedGzip, err := gzip.NewReader(cs)
+	if err != nil {
+		logging.Base().Warnf("LedgerService.serveChunk : failed to decompress catchpoint %d %v", round, err)
+		response.WriteHeader(http.StatusInternalServerError)
+		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be decompressed due to internal error : %v", round, err)))
+		return
+	}
+	defer decompressedGzip.Close()
+
+	if indexed {
+		_, err = io.CopyN(io.Discard, decompressedGzip, location.offset)
+		if err != nil {
+			logging.Base().Warnf("LedgerService.serveChunk : failed to read catchpoint %d %v", round, err)
+			response.WriteHeader(http.StatusInternalServerError)
+			response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be read due to internal error : %v", round, err)))
+			return
+		}
+		ls.writeChunk(response, decompressedGzip, round, chunkName, location.size)
+		return
+	}
+
+	// read the whole file to index its chunks, writing the requested one once it's found.
+	counter := &countingReader{reader: decompressedGzip}
+	tarReader := tar.NewReader(counter)
+	index = make(map[string]catchpointChunkLocation)
+	found := false
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			logging.Base().Warnf("LedgerService.serveChunk : failed to read catchpoint %d %v", round, err)
+			if !found {
+				response.WriteHeader(http.StatusInternalServerError)
+				response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be read due to internal error : %v", round, err)))
+			}
+			return
+		}
+		index[header.Name] = catchpointChunkLocation{offset: counter.count, size: header.Size}
+		if header.Name == chunkName {
+			found = true
+			ls.writeChunk(response, tarReader, round, chunkName, header.Size)
+		}
+	}
+	ls.setChunkIndex(round, index)
+	if !found {
+		response.WriteHeader(http.StatusNotFound)
+		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d has no chunk '%s'", round, chunkName)))
+	}
+}
+
+// writeChunk writes the content of a chunk from the given reader
+func (ls *LedgerService) writeChunk(response http.ResponseWriter, chunk io.Reader, round uint64, chunkName string, size int64) {
+	response.Header().Set("Content-Type", LedgerChunkResponseContentType)
+	response.Header().Set("Content-Length", strconv.FormatInt(size, 10))
+	written, err := io.CopyN(response, chunk, size)
+	if err != nil {
+		logging.Base().Infof("LedgerService.serveChunk : unable to write chunk %s of catchpoint file for round %d, written bytes %d : %v", chunkName, round, written, err)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestCatchpointStream(t *testing.T, files map[string][]byte, order []string) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, name := range order {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(files[name]))}))
+		_, err := tarWriter.Write(files[name])
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	require.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func TestLedgerServiceServeChunk(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	files := map[string][]byte{
+		"content.msgpack":    []byte("header"),
+		"balances.1.msgpack": []byte("first chunk"),
+		"balances.2.msgpack": []byte("second chunk"),
+	}
+	stream := makeTestCatchpointStream(t, files, []string{"content.msgpack", "balances.1.msgpack", "balances.2.msgpack"})
+	ls := &LedgerService{chunkIndexes: make(map[uint64]map[string]catchpointChunkLocation)}
+
+	// the first request indexes the chunks of the catchpoint file, while the next ones use the index
+	for i := 0; i < 2; i++ {
+		for _, name := range []string{"balances.2.msgpack", "content.msgpack", "balances.1.msgpack"} {
+			recorder := httptest.NewRecorder()
+			ls.serveChunk(recorder, bytes.NewReader(stream), 100, name)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			require.Equal(t, LedgerChunkResponseContentType, recorder.Header().Get("Content-Type"))
+			require.Equal(t, files[name], recorder.Body.Bytes())
+		}
+		index, ok := ls.chunkIndex(100)
+		require.True(t, ok)
+		require.Len(t, index, len(files))
+	}
+
+	// a chunk which is not part of the catchpoint file is known to be missing without reading the file
+	recorder := httptest.NewRecorder()
+	ls.serveChunk(recorder, bytes.NewReader(nil), 100, "balances.3.msgpack")
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+
+	// and is looked for in a catchpoint file which was not indexed yet
+	recorder = httptest.NewRecorder()
+	ls.serveChunk(recorder, bytes.NewReader(stream), 200, "balances.3.msgpack")
+	require.Equal(t, http.StatusNotFound, recorder.Code)
+
+	// a stream which is not a gzip stream
+	recorder = httptest.NewRecorder()
+	ls.serveChunk(recorder, bytes.NewReader([]byte("not a catchpoint")), 300, "balances.1.msgpack")
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	// only the chunks of the recently requested catchpoint files are kept
+	for round := uint64(400); round < 400+maxIndexedCatchpoints; round++ {
+		ls.serveChunk(httptest.NewRecorder(), bytes.NewReader(stream), round, "content.msgpack")
+	}
+	require.Len(t, ls.chunkIndexes, maxIndexedCatchpoints)
+}
+
+func TestLedgerServiceChunkRequestsLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ls := &LedgerService{chunkRequests: make(chan struct{}, maxConcurrentChunkRequests)}
+	for i := 0; i < maxConcurrentChunkRequests; i++ {
+		require.True(t, ls.acquireChunkRequest())
+	}
+	require.False(t, ls.acquireChunkRequest())
+	ls.releaseChunkRequest()
+	require.True(t, ls.acquireChunkRequest())
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/util/bloom"
+	"github.com/algorand/go-algorand/util/timers"
 )
 
 // PendingTxAggregate is a container of pending transactions
@@ -55,8 +56,16 @@ type TxSyncer struct {
 	wg           sync.WaitGroup
 	log          logging.Logger
 	httpSync     *HTTPTxSync
+	syncRequests <-chan struct{}
+
+	// clock, if set, paces the syncs and times them out instead of the wall clock
+	clock timers.Clock
 }
 
+// minRequestedSyncInterval is the minimum time between the start of a sync and the start of a sync
+// requested through the syncRequests channel
+const minRequestedSyncInterval = 2 * time.Second
+
 // MakeTxSyncer returns a TxSyncer
 func MakeTxSyncer(pool PendingTxAggregate, clientSource network.GossipNode, txHandler data.SolicitedTxHandler, syncInterval time.Duration, syncTimeout time.Duration, serverResponseSize int) *TxSyncer {
 	return &TxSyncer{
@@ -70,6 +79,28 @@ func MakeTxSyncer(pool PendingTxAggregate, clientSource network.GossipNode, txHa
 	}
 }
 
+// SyncOnRequest makes the syncer also sync whenever the requests channel is signaled, without waiting
+// for its sync interval, but not more often than every minRequestedSyncInterval. The transaction handler
+// signals it when announced transaction groups requested from peers were not received.
+// It must be called before Start.
+func (syncer *TxSyncer) SyncOnRequest(requests <-chan struct{}) {
+	syncer.syncRequests = requests
+}
+
+// SetClock makes the syncer pace and time out its syncs on the given clock rather than on the wall
+// clock, such as when the node runs on a simulated clock. It must be called before Start.
+func (syncer *TxSyncer) SetClock(clock timers.Clock) {
+	syncer.clock = clock
+}
+
+// zeroClock returns the clock of the syncer, or the wall clock if it has none, zeroed at the current time.
+func (syncer *TxSyncer) zeroClock() timers.Clock {
+	if syncer.clock == nil {
+		return timers.MakeMonotonicClock(time.Now())
+	}
+	return syncer.clock.Zero()
+}
+
 // Start begins periodically syncing after the canStart chanel indicates it can begin
 func (syncer *TxSyncer) Start(canStart chan struct{}) {
 	syncer.wg.Add(1)
@@ -81,16 +112,26 @@ func (syncer *TxSyncer) Start(canStart chan struct{}) {
 			return
 		case <-canStart:
 		}
+		var lastSync timers.Clock
 		for {
 			select {
 			case <-syncer.ctx.Done():
 				return
-			case <-time.After(syncer.syncInterval):
-				err := syncer.sync()
-				if err != nil {
-					syncer.log.Warnf("problem syncing transactions %v", err)
+			case <-syncer.zeroClock().TimeoutAt(syncer.syncInterval):
+			case <-syncer.syncRequests:
+				if lastSync != nil && lastSync.Since() < minRequestedSyncInterval {
+					select {
+					case <-syncer.ctx.Done():
+						return
+					case <-lastSync.TimeoutAt(minRequestedSyncInterval):
+					}
 				}
 			}
+			lastSync = syncer.zeroClock()
+			err := syncer.sync()
+			if err != nil {
+				syncer.log.Warnf("problem syncing transactions %v", err)
+			}
 		}
 	}()
 }
@@ -118,7 +159,7 @@ func (syncer *TxSyncer) syncFromClient(client TxSyncClient) error {
 		filter.Set(txid[:])
 	}
 
-	ctx, cf := context.WithTimeout(syncer.ctx, syncer.syncTimeout)
+	ctx, cf := timers.WithTimeout(syncer.ctx, syncer.clock, syncer.syncTimeout)
 	defer cf()
 	txgroups, err := client.Sync(ctx, filter)
 	if err != nil {
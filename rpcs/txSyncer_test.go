@@ -353,6 +353,43 @@ func TestStartAndStop(t *testing.T) {
 	require.Equal(t, int32(1), atomic.LoadInt32(&handler.messageCounter))
 }
 
+func TestSyncOnRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pool := makeMockPendingTxAggregate(1)
+	nodeA := basicRPCNode{}
+	txservice := makeTxService(pool, "test genesisID", config.GetDefaultLocal().TxPoolSize, config.GetDefaultLocal().TxSyncServeResponseSize)
+	nodeA.RegisterHTTPHandler(TxServiceHTTPPath, txservice)
+	nodeA.start()
+	nodeAURL := nodeA.rootURL()
+
+	runner := mockRunner{failWithNil: false, failWithError: false, txgroups: pool.PendingTxGroups()[len(pool.PendingTxGroups())-1:], done: make(chan *rpc.Call)}
+	client := mockRPCClient{client: &runner, rootURL: nodeAURL, log: logging.TestingLog(t)}
+	clientAgg := mockClientAggregator{peers: []network.Peer{&client}}
+	handler := mockHandler{}
+
+	syncerPool := makeMockPendingTxAggregate(0)
+	syncer := MakeTxSyncer(syncerPool, &clientAgg, &handler, time.Hour, time.Second, config.GetDefaultLocal().TxSyncServeResponseSize)
+	syncer.log = logging.TestingLog(t)
+	requests := make(chan struct{}, 1)
+	syncer.SyncOnRequest(requests)
+
+	canStart := make(chan struct{})
+	close(canStart)
+	syncer.Start(canStart)
+	defer syncer.Stop()
+
+	// a request syncs right away instead of waiting for the interval
+	requests <- struct{}{}
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&handler.messageCounter) == 1 }, time.Second, 10*time.Millisecond)
+
+	// the following request waits for minRequestedSyncInterval
+	start := time.Now()
+	requests <- struct{}{}
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&handler.messageCounter) == 2 }, 2*minRequestedSyncInterval, 10*time.Millisecond)
+	require.GreaterOrEqual(t, time.Since(start), minRequestedSyncInterval/2)
+}
+
 func TestStartAndQuit(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
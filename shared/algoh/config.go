@@ -17,6 +17,7 @@
 package algoh
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/algorand/go-algorand/util/codecs"
@@ -32,6 +33,50 @@ type HostConfig struct {
 	DeadManTimeSec int64
 	StatusDelayMS  int64
 	StallDelayMS   int64
+	// DeadManReadinessCheck makes the deadman also trigger when the node reported it was not ready, such as
+	// when its ledger is far behind, for DeadManTimeSec, even though it keeps receiving blocks.
+	DeadManReadinessCheck bool
+
+	// RestartOnFailure makes algoh restart algod, rather than exit, when algod exits with an error or fails one
+	// of the HealthChecks. algod exiting cleanly, such as on goal node stop, still ends algoh.
+	RestartOnFailure bool
+	// RestartBackoffMinSec is the delay before restarting algod after a failure. It doubles with every consecutive
+	// failure, up to RestartBackoffMaxSec; failures stop being consecutive once algod ran for RestartBackoffMaxSec.
+	RestartBackoffMinSec int64
+	RestartBackoffMaxSec int64
+	// MaxRestarts is how many times algod can be restarted within RestartWindowSec before algoh gives up and exits.
+	MaxRestarts      int64
+	RestartWindowSec int64
+	// HealthChecks are the conditions under which algoh considers algod failed.
+	HealthChecks []HealthCheck
+
+	// CrashBundles makes algoh save a bundle of artifacts every time algod fails, for debugging it later.
+	CrashBundles bool
+	// CrashBundleDir is where the bundles are kept, relative to the data directory unless absolute.
+	CrashBundleDir string
+	// MaxCrashBundles and CrashBundleRetentionHours limit how many bundles are kept, and for how long. Zero means
+	// no limit.
+	MaxCrashBundles           int64
+	CrashBundleRetentionHours int64
+	// CrashBundleLogTailBytes is how much of the end of node.log goes into a bundle.
+	CrashBundleLogTailBytes int64
+}
+
+const (
+	// HealthCheckNoNewBlock fails when the node made no progress, neither adding blocks to its ledger nor
+	// catching up from a catchpoint, for TimeoutSec.
+	HealthCheckNoNewBlock = "no-new-block"
+	// HealthCheckNoPeers fails when the node had fewer than MinPeers peers connected for TimeoutSec.
+	HealthCheckNoPeers = "no-peers"
+)
+
+// HealthCheck is a condition algoh keeps checking algod for.
+type HealthCheck struct {
+	// Type is HealthCheckNoNewBlock or HealthCheckNoPeers.
+	Type       string
+	TimeoutSec int64
+	// MinPeers is the number of peers HealthCheckNoPeers requires, 1 when left zero.
+	MinPeers int64
 }
 
 var defaultConfig = HostConfig{
@@ -40,6 +85,20 @@ var defaultConfig = HostConfig{
 	DeadManTimeSec: 120,
 	StatusDelayMS:  500,
 	StallDelayMS:   60 * 1000,
+
+	DeadManReadinessCheck: false,
+
+	RestartOnFailure:     false,
+	RestartBackoffMinSec: 10,
+	RestartBackoffMaxSec: 10 * 60,
+	MaxRestarts:          5,
+	RestartWindowSec:     60 * 60,
+
+	CrashBundles:              true,
+	CrashBundleDir:            "crash-bundles",
+	MaxCrashBundles:           10,
+	CrashBundleRetentionHours: 7 * 24,
+	CrashBundleLogTailBytes:   4 * 1024 * 1024,
 }
 
 // LoadConfigFromFile loads the configuration from the specified file, merging into the default configuration.
@@ -49,6 +108,27 @@ func LoadConfigFromFile(file string) (cfg HostConfig, err error) {
 	return cfg, err
 }
 
+// Validate checks the restart policy and health checks of the configuration.
+func (cfg HostConfig) Validate() error {
+	if cfg.RestartOnFailure && cfg.RestartBackoffMinSec > cfg.RestartBackoffMaxSec {
+		return fmt.Errorf("RestartBackoffMinSec (%d) exceeds RestartBackoffMaxSec (%d)", cfg.RestartBackoffMinSec, cfg.RestartBackoffMaxSec)
+	}
+	for i, check := range cfg.HealthChecks {
+		switch check.Type {
+		case HealthCheckNoNewBlock, HealthCheckNoPeers:
+		default:
+			return fmt.Errorf("HealthChecks[%d] has unknown type %q", i, check.Type)
+		}
+		if check.TimeoutSec <= 0 {
+			return fmt.Errorf("HealthChecks[%d] (%s) needs a positive TimeoutSec", i, check.Type)
+		}
+		if check.MinPeers < 0 {
+			return fmt.Errorf("HealthChecks[%d] (%s) has negative MinPeers", i, check.Type)
+		}
+	}
+	return nil
+}
+
 // Save pretty-prints the configuration into the the specified file.
 func (cfg HostConfig) Save(file string) error {
 	prettyPrint := true
@@ -91,6 +91,9 @@ type PpConfig struct {
 	WeightAsset       float64
 	WeightApp         float64
 	WeightNFTCreation float64
+
+	// Scenario, when set, replaces the rate and the run times above with its timed phases
+	Scenario *Scenario `json:",omitempty"`
 }
 
 // DefaultConfig object for Ping Pong
@@ -203,6 +206,11 @@ func (cfg *PpConfig) Check() error {
 	if cfg.DeterministicKeys && (cfg.GeneratedAccountsOffset+uint64(cfg.NumPartAccounts) > cfg.GeneratedAccountsCount) {
 		return fmt.Errorf("(GeneratedAccountsOffset %d) + (NumPartAccounts %d) > (GeneratedAccountsCount %d)", cfg.GeneratedAccountsOffset, cfg.NumPartAccounts, cfg.GeneratedAccountsCount)
 	}
+	if cfg.Scenario != nil {
+		if err := cfg.Scenario.check(*cfg); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
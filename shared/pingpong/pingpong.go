@@ -149,6 +149,10 @@ type WorkerState struct {
 	refreshPos   int
 
 	client *libgoal.Client
+
+	// scenario is set while running the scenario of the configuration
+	scenario *scenarioRunner
+	report   *Report
 }
 
 // returns the number of boxes per app
@@ -527,6 +531,23 @@ func listSufficientAccounts(accounts map[string]*pingPongAccount, minimumAmount
 
 var logPeriod = 5 * time.Second
 
+// fromToLists returns the senders and the receivers of the next transactions
+func (pps *WorkerState) fromToLists() (fromList, toList []string) {
+	minimumAmount := pps.cfg.MinAccountFunds + (pps.cfg.MaxAmt+pps.cfg.MaxFee)*2
+	fromList = listSufficientAccounts(pps.accounts, minimumAmount, pps.cfg.SrcAccount)
+	// in group tests txns are sent back and forth, so both parties need funds
+	if pps.cfg.GroupSize == 1 {
+		minimumAmount = 0
+		toList = listSufficientAccounts(pps.accounts, minimumAmount, pps.cfg.SrcAccount)
+	} else {
+		// same selection with another shuffle
+		toList = make([]string, len(fromList))
+		copy(toList, fromList)
+		rand.Shuffle(len(toList), func(i, j int) { toList[i], toList[j] = toList[j], toList[i] })
+	}
+	return
+}
+
 // RunPingPong starts ping pong process
 func (pps *WorkerState) RunPingPong(ctx context.Context, ac *libgoal.Client) {
 	// Infinite loop given:
@@ -549,6 +570,11 @@ func (pps *WorkerState) RunPingPong(ctx context.Context, ac *libgoal.Client) {
 	ac.SetSuggestedParamsCacheAge(200 * time.Millisecond)
 	pps.client = ac
 
+	if pps.cfg.Scenario != nil {
+		pps.runScenario(ctx, ac)
+		return
+	}
+
 	var runTime time.Duration
 	if pps.cfg.RunTime > 0 {
 		runTime = pps.cfg.RunTime
@@ -594,20 +620,7 @@ func (pps *WorkerState) RunPingPong(ctx context.Context, ac *libgoal.Client) {
 				return
 			}
 
-			minimumAmount := pps.cfg.MinAccountFunds + (pps.cfg.MaxAmt+pps.cfg.MaxFee)*2
-			fromList := listSufficientAccounts(pps.accounts, minimumAmount, pps.cfg.SrcAccount)
-			// in group tests txns are sent back and forth, so both parties need funds
-			var toList []string
-			if pps.cfg.GroupSize == 1 {
-				minimumAmount = 0
-				toList = listSufficientAccounts(pps.accounts, minimumAmount, pps.cfg.SrcAccount)
-			} else {
-				// same selection with another shuffle
-				toList = make([]string, len(fromList))
-				copy(toList, fromList)
-				rand.Shuffle(len(toList), func(i, j int) { toList[i], toList[j] = toList[j], toList[i] })
-			}
-
+			fromList, toList := pps.fromToLists()
 			sent, succeeded, err := pps.sendFromTo(fromList, toList, ac, &nextSendTime)
 			totalSent += sent
 			totalSucceeded += succeeded
@@ -694,6 +707,9 @@ func (pps *WorkerState) sendFromTo(
 	belowMinBalanceAccounts := make(map[string] /*basics.Address*/ bool)
 
 	for i, from := range fromList {
+		if pps.scenario != nil && !pps.scenario.update(pps, time.Now()) {
+			return
+		}
 
 		// keep going until the balances of at least 20% of the accounts is too low.
 		if len(belowMinBalanceAccounts)*5 > len(fromList) {
@@ -773,7 +789,9 @@ func (pps *WorkerState) sendFromTo(
 
 			sentCount++
 			pps.schedule(1)
+			submitted := time.Now()
 			_, sendErr = client.BroadcastTransaction(stxn)
+			pps.trackSubmission([]transactions.SignedTxn{stxn}, submitted, sendErr)
 		} else {
 			// Generate txn group
 
@@ -843,7 +861,9 @@ func (pps *WorkerState) sendFromTo(
 
 			sentCount += uint64(len(txGroup))
 			pps.schedule(len(txGroup))
+			submitted := time.Now()
 			sendErr = client.BroadcastTransactionGroup(stxGroup)
+			pps.trackSubmission(stxGroup, submitted, sendErr)
 		}
 
 		if sendErr != nil {
@@ -905,8 +925,7 @@ func (pps *WorkerState) constructTxn(from, to string, fee uint64, client *libgoa
 
 	// weighted random selection of traffic type
 	// TODO: construct*Txn() have the same signature, make this data structures and loop over them?
-	totalWeight := pps.cfg.WeightPayment + pps.cfg.WeightAsset + pps.cfg.WeightApp
-	target := rand.Float64() * totalWeight
+	target := rand.Float64() * pps.totalWeight()
 	if target < pps.cfg.WeightAsset && pps.cfg.NumAsset > 0 {
 		txn, sender, update, err = pps.constructAssetTxn(from, to, fee, client, noteField, lease)
 		if err != errNotOptedIn {
@@ -954,6 +973,16 @@ weightdone:
 	return
 }
 
+// totalWeight returns the sum of the weights of the traffic types. The NFT creations are only part of the
+// weighted mix while a scenario runs.
+func (pps *WorkerState) totalWeight() float64 {
+	totalWeight := pps.cfg.WeightPayment + pps.cfg.WeightAsset + pps.cfg.WeightApp
+	if pps.scenario != nil {
+		totalWeight += pps.cfg.WeightNFTCreation
+	}
+	return totalWeight
+}
+
 type txnUpdate interface {
 	apply(pps *WorkerState)
 }
@@ -0,0 +1,213 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pingpong
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// LatencyReport summarizes the delays between the submissions of transactions and their
+// confirmations, in seconds.
+type LatencyReport struct {
+	Mean float64
+	P50  float64
+	P90  float64
+	P99  float64
+	Max  float64
+}
+
+// TxnReport counts the outcomes of transactions. The transactions which were accepted by algod
+// are either confirmed, expired once past their last valid round, or still pending when the
+// run was interrupted.
+type TxnReport struct {
+	Submitted uint64
+	Confirmed uint64
+	Rejected  uint64
+	Expired   uint64
+	// Rejections counts the rejected transactions by reason
+	Rejections map[string]uint64 `json:",omitempty"`
+	// Latency of the confirmed transactions
+	Latency LatencyReport
+}
+
+// PhaseReport summarizes the transactions sent during a phase of a scenario
+type PhaseReport struct {
+	Name string
+	// Seconds the phase lasted
+	Seconds float64
+	TxnReport
+	SubmittedPerSec float64
+	ConfirmedPerSec float64
+	// Types reports the transactions of each type of the phase, such as "pay" or "appl"
+	Types map[string]*TxnReport
+}
+
+// Report is the outcome of a scenario
+type Report struct {
+	Phases []PhaseReport
+	Total  PhaseReport
+}
+
+// percentile returns the p-th percentile of the sorted durations, using the nearest rank
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func makeLatencyReport(latencies []time.Duration) LatencyReport {
+	if len(latencies) == 0 {
+		return LatencyReport{}
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var sum time.Duration
+	for _, l := range sorted {
+		sum += l
+	}
+	return LatencyReport{
+		Mean: (sum / time.Duration(len(sorted))).Seconds(),
+		P50:  percentile(sorted, 50).Seconds(),
+		P90:  percentile(sorted, 90).Seconds(),
+		P99:  percentile(sorted, 99).Seconds(),
+		Max:  sorted[len(sorted)-1].Seconds(),
+	}
+}
+
+// add accumulates the samples of s into the counters of r, and returns the latencies of both
+func (r *TxnReport) add(s *txnSamples, latencies []time.Duration) []time.Duration {
+	r.Submitted += s.submitted
+	r.Confirmed += s.confirmed
+	r.Rejected += s.rejected
+	r.Expired += s.expired
+	for reason, count := range s.rejections {
+		if r.Rejections == nil {
+			r.Rejections = make(map[string]uint64)
+		}
+		r.Rejections[reason] += count
+	}
+	return append(latencies, s.latencies...)
+}
+
+// makePhaseReport reports the samples of the phases, which lasted for the given duration
+func makePhaseReport(name string, duration time.Duration, phases []map[string]*txnSamples) PhaseReport {
+	report := PhaseReport{
+		Name:    name,
+		Seconds: duration.Seconds(),
+		Types:   make(map[string]*TxnReport),
+	}
+	typeLatencies := make(map[string][]time.Duration)
+	var latencies []time.Duration
+	for _, samples := range phases {
+		for typ, s := range samples {
+			tr := report.Types[typ]
+			if tr == nil {
+				tr = &TxnReport{}
+				report.Types[typ] = tr
+			}
+			typeLatencies[typ] = tr.add(s, typeLatencies[typ])
+			latencies = report.TxnReport.add(s, latencies)
+		}
+	}
+	for typ, tr := range report.Types {
+		tr.Latency = makeLatencyReport(typeLatencies[typ])
+	}
+	report.Latency = makeLatencyReport(latencies)
+	if duration > 0 {
+		report.SubmittedPerSec = float64(report.Submitted) / duration.Seconds()
+		report.ConfirmedPerSec = float64(report.Confirmed) / duration.Seconds()
+	}
+	return report
+}
+
+// report summarizes the samples of the tracker. Each of the phases lasted for the given duration.
+func (t *confirmationTracker) report(scenario Scenario, durations []time.Duration) *Report {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	report := &Report{}
+	var total time.Duration
+	for i, phase := range scenario.Phases {
+		var duration time.Duration
+		if i < len(durations) {
+			duration = durations[i]
+		}
+		total += duration
+		name := phase.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		report.Phases = append(report.Phases, makePhaseReport(name, duration, t.samples[i:i+1]))
+	}
+	report.Total = makePhaseReport("total", total, t.samples)
+	return report
+}
+
+// Save writes the report to a file as json
+func (r *Report) Save(file string) error {
+	return codecs.SaveObjectToFile(file, r, true)
+}
+
+func (r *TxnReport) writeText(w io.Writer, indent string) {
+	fmt.Fprintf(w, "%s%d submitted, %d confirmed, %d rejected, %d expired\n", indent, r.Submitted, r.Confirmed, r.Rejected, r.Expired)
+	if r.Confirmed > 0 {
+		fmt.Fprintf(w, "%s  latency: mean %.2fs p50 %.2fs p90 %.2fs p99 %.2fs max %.2fs\n", indent, r.Latency.Mean, r.Latency.P50, r.Latency.P90, r.Latency.P99, r.Latency.Max)
+	}
+	reasons := make([]string, 0, len(r.Rejections))
+	for reason := range r.Rejections {
+		reasons = append(reasons, reason)
+	}
+	sort.Strings(reasons)
+	for _, reason := range reasons {
+		fmt.Fprintf(w, "%s  rejected %d: %s\n", indent, r.Rejections[reason], reason)
+	}
+}
+
+func (r *PhaseReport) writeText(w io.Writer) {
+	fmt.Fprintf(w, "%s: %.1fs, %.2f submitted/s, %.2f confirmed/s\n", r.Name, r.Seconds, r.SubmittedPerSec, r.ConfirmedPerSec)
+	r.TxnReport.writeText(w, "  ")
+	types := make([]string, 0, len(r.Types))
+	for typ := range r.Types {
+		types = append(types, typ)
+	}
+	sort.Strings(types)
+	for _, typ := range types {
+		fmt.Fprintf(w, "  %s:\n", typ)
+		r.Types[typ].writeText(w, "    ")
+	}
+}
+
+// WriteText writes the report in a human readable form
+func (r *Report) WriteText(w io.Writer) {
+	for i := range r.Phases {
+		r.Phases[i].writeText(w)
+	}
+	fmt.Fprintln(w, strings.Repeat("-", 40))
+	r.Total.writeText(w)
+}
@@ -0,0 +1,276 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pingpong
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+)
+
+// ScenarioDuration is a duration which is written as a string, such as "90s" or "5m", in
+// scenario files.
+type ScenarioDuration time.Duration
+
+// MarshalText implements encoding.TextMarshaler
+func (d ScenarioDuration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *ScenarioDuration) UnmarshalText(text []byte) error {
+	duration, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = ScenarioDuration(duration)
+	return nil
+}
+
+// ScenarioMix sets the relative weights of the kinds of transactions sent during a phase of a
+// scenario. They have the meaning of the weights of PpConfig.
+type ScenarioMix struct {
+	WeightPayment     float64
+	WeightAsset       float64
+	WeightApp         float64
+	WeightNFTCreation float64
+}
+
+// ScenarioPhase describes the traffic sent during a period of a scenario. A ramp is a phase
+// whose rate changes from TxnPerSec to EndTxnPerSec, a steady phase has a constant rate, and a
+// burst is a short phase with a high rate.
+type ScenarioPhase struct {
+	// Name identifies the phase in the report
+	Name string
+	// Duration of the phase
+	Duration ScenarioDuration
+	// TxnPerSec is the rate of the phase, or the one it starts with when EndTxnPerSec is set
+	TxnPerSec uint64
+	// EndTxnPerSec, when set, makes the rate change linearly from TxnPerSec to EndTxnPerSec over the phase
+	EndTxnPerSec uint64 `json:",omitempty"`
+	// Mix, when set, replaces the traffic mix of the previous phase, which is the one of the
+	// configuration for the first phase.
+	Mix *ScenarioMix `json:",omitempty"`
+}
+
+// Scenario is the sequence of phases of traffic of a pingpong run. Unlike a run of a plain
+// configuration, a scenario tracks the transactions it sends until they are confirmed, and
+// reports the throughput and latency of each phase.
+type Scenario struct {
+	Phases []ScenarioPhase
+}
+
+// LoadScenarioFromFile reads a scenario from a json file
+func LoadScenarioFromFile(file string) (scenario Scenario, err error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&scenario)
+	return scenario, err
+}
+
+// Duration returns the total duration of the phases of the scenario
+func (s Scenario) Duration() (total time.Duration) {
+	for _, phase := range s.Phases {
+		total += time.Duration(phase.Duration)
+	}
+	return
+}
+
+// rate returns the rate of transactions of the phase, the given time into the phase.
+func (phase ScenarioPhase) rate(elapsed time.Duration) uint64 {
+	rate := phase.TxnPerSec
+	if phase.EndTxnPerSec != 0 && phase.Duration > 0 {
+		progress := float64(elapsed) / float64(phase.Duration)
+		if progress > 1 {
+			progress = 1
+		}
+		start, end := float64(phase.TxnPerSec), float64(phase.EndTxnPerSec)
+		rate = uint64(start + (end-start)*progress + 0.5)
+	}
+	if rate == 0 {
+		// a ramp up from no traffic still sends some transactions at its very start.
+		rate = 1
+	}
+	return rate
+}
+
+// check returns an error if the scenario can't be run with the given configuration, whose
+// setup provides the assets, apps and NFTs which the phases send transactions for.
+func (s Scenario) check(cfg PpConfig) error {
+	if len(s.Phases) == 0 {
+		return errors.New("scenario has no phases")
+	}
+	for i, phase := range s.Phases {
+		name := phase.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+		}
+		if phase.Duration <= 0 {
+			return fmt.Errorf("scenario phase %s: duration must be positive", name)
+		}
+		if phase.TxnPerSec == 0 && phase.EndTxnPerSec == 0 {
+			return fmt.Errorf("scenario phase %s: TxnPerSec or EndTxnPerSec must be set", name)
+		}
+		if phase.Mix == nil {
+			continue
+		}
+		mix := phase.Mix
+		if mix.WeightPayment < 0 || mix.WeightAsset < 0 || mix.WeightApp < 0 || mix.WeightNFTCreation < 0 {
+			return fmt.Errorf("scenario phase %s: weights can't be negative", name)
+		}
+		if mix.WeightPayment+mix.WeightAsset+mix.WeightApp+mix.WeightNFTCreation == 0 {
+			return fmt.Errorf("scenario phase %s: the mix has no positive weight", name)
+		}
+		if mix.WeightAsset > 0 && cfg.NumAsset == 0 {
+			return fmt.Errorf("scenario phase %s: asset transactions require NumAsset", name)
+		}
+		if mix.WeightApp > 0 && cfg.NumApp == 0 {
+			return fmt.Errorf("scenario phase %s: app transactions require NumApp", name)
+		}
+		if mix.WeightNFTCreation > 0 && cfg.NftAsaPerSecond == 0 {
+			return fmt.Errorf("scenario phase %s: NFT creations require NftAsaPerSecond", name)
+		}
+	}
+	return nil
+}
+
+// scenarioRunner follows the phases of a scenario while pingpong sends its transactions
+type scenarioRunner struct {
+	scenario Scenario
+	tracker  *confirmationTracker
+	start    time.Time
+	end      time.Time
+	// phase is the index of the current phase, -1 before the first one started
+	phase int
+}
+
+// update applies the rate and the mix of the phase of the scenario at the given time to the
+// configuration of pps, and returns false once all the phases are over.
+func (r *scenarioRunner) update(pps *WorkerState, now time.Time) bool {
+	elapsed := now.Sub(r.start)
+	phase := 0
+	for phase < len(r.scenario.Phases) && elapsed >= time.Duration(r.scenario.Phases[phase].Duration) {
+		elapsed -= time.Duration(r.scenario.Phases[phase].Duration)
+		phase++
+	}
+	if phase == len(r.scenario.Phases) {
+		return false
+	}
+	for r.phase < phase {
+		r.phase++
+		p := r.scenario.Phases[r.phase]
+		if p.Mix != nil {
+			pps.cfg.WeightPayment = p.Mix.WeightPayment
+			pps.cfg.WeightAsset = p.Mix.WeightAsset
+			pps.cfg.WeightApp = p.Mix.WeightApp
+			pps.cfg.WeightNFTCreation = p.Mix.WeightNFTCreation
+		}
+		if r.phase == phase && !pps.cfg.Quiet {
+			fmt.Printf("starting scenario phase %d/%d %s\n", r.phase+1, len(r.scenario.Phases), p.Name)
+		}
+	}
+	pps.cfg.TxnPerSec = r.scenario.Phases[phase].rate(elapsed)
+	return true
+}
+
+// durations returns how long each of the phases lasted, the last ones being cut short, or not
+// started at all, when the run was interrupted.
+func (r *scenarioRunner) durations() []time.Duration {
+	durations := make([]time.Duration, len(r.scenario.Phases))
+	start := r.start
+	for i, phase := range r.scenario.Phases {
+		end := start.Add(time.Duration(phase.Duration))
+		if end.After(r.end) {
+			end = r.end
+		}
+		if end.After(start) {
+			durations[i] = end.Sub(start)
+		}
+		start = start.Add(time.Duration(phase.Duration))
+	}
+	return durations
+}
+
+// trackSubmission records the outcome of the broadcast of a transaction group when running a scenario
+func (pps *WorkerState) trackSubmission(group []transactions.SignedTxn, at time.Time, err error) {
+	if pps.scenario != nil {
+		pps.scenario.tracker.submitted(pps.scenario.phase, group, at, err)
+	}
+}
+
+// runScenario sends the traffic of the phases of the scenario of the configuration, then waits
+// for the transactions it sent to be either confirmed or expired, and reports their outcome.
+func (pps *WorkerState) runScenario(ctx context.Context, ac *libgoal.Client) {
+	scenario := *pps.cfg.Scenario
+	tracker := makeConfirmationTracker(ac, len(scenario.Phases))
+	stop := make(chan struct{})
+	trackerDone := make(chan struct{})
+	go func() {
+		defer close(trackerDone)
+		tracker.run(ctx, stop)
+	}()
+
+	runner := &scenarioRunner{scenario: scenario, tracker: tracker, start: time.Now(), phase: -1}
+	pps.scenario = runner
+	pps.nextSendTime = runner.start
+	refreshTime := runner.start.Add(pps.cfg.RefreshTime)
+	fmt.Printf("running a scenario of %d phases for %v\n", len(scenario.Phases), scenario.Duration())
+
+	nextSendTime := runner.start
+	for ctx.Err() == nil && runner.update(pps, time.Now()) {
+		fromList, toList := pps.fromToLists()
+		_, _, err := pps.sendFromTo(fromList, toList, ac, &nextSendTime)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "error sending transactions, sleeping .5 seconds: %v\n", err)
+			pps.nextSendTime = time.Now().Add(500 * time.Millisecond)
+			pps.schedule(1)
+		}
+
+		if pps.cfg.RefreshTime > 0 && time.Now().After(refreshTime) {
+			err = pps.refreshAccounts(ac)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "error refreshing: %v\n", err)
+			}
+			refreshTime = refreshTime.Add(pps.cfg.RefreshTime)
+		}
+	}
+	runner.end = time.Now()
+	pps.scenario = nil
+
+	close(stop)
+	fmt.Printf("waiting for %d pending transactions to be confirmed or expired\n", tracker.pendingCount())
+	<-trackerDone
+	pps.report = tracker.report(scenario, runner.durations())
+}
+
+// Report returns the report of the scenario which was run by RunPingPong, or nil if the
+// configuration had no scenario.
+func (pps *WorkerState) Report() *Report {
+	return pps.report
+}
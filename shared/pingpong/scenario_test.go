@@ -0,0 +1,254 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pingpong
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLoadScenario(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), "scenario.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"Phases": [
+		{"Name": "ramp", "Duration": "1m", "TxnPerSec": 10, "EndTxnPerSec": 100},
+		{"Name": "apps", "Duration": "90s", "TxnPerSec": 50, "Mix": {"WeightApp": 1}}
+	]}`), 0644))
+	scenario, err := LoadScenarioFromFile(path)
+	require.NoError(t, err)
+	require.Len(t, scenario.Phases, 2)
+	require.Equal(t, ScenarioDuration(time.Minute), scenario.Phases[0].Duration)
+	require.Equal(t, uint64(100), scenario.Phases[0].EndTxnPerSec)
+	require.Equal(t, &ScenarioMix{WeightApp: 1}, scenario.Phases[1].Mix)
+	require.Equal(t, 150*time.Second, scenario.Duration())
+
+	cfg := DefaultConfig
+	cfg.Scenario = &scenario
+	require.ErrorContains(t, cfg.Check(), "app transactions require NumApp")
+	cfg.NumApp = 1
+	require.NoError(t, cfg.Check())
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"Phases": [{"Duration": "1 minute"}]}`), 0644))
+	_, err = LoadScenarioFromFile(path)
+	require.Error(t, err)
+	require.NoError(t, os.WriteFile(path, []byte(`{"Phases": [{"Duration": "1m", "Tps": 10}]}`), 0644))
+	_, err = LoadScenarioFromFile(path)
+	require.Error(t, err)
+}
+
+func TestScenarioCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := DefaultConfig
+	require.ErrorContains(t, Scenario{}.check(cfg), "no phases")
+	require.ErrorContains(t, Scenario{Phases: []ScenarioPhase{{TxnPerSec: 1}}}.check(cfg), "duration must be positive")
+	require.ErrorContains(t, Scenario{Phases: []ScenarioPhase{{Duration: ScenarioDuration(time.Second)}}}.check(cfg), "TxnPerSec or EndTxnPerSec must be set")
+	mix := &ScenarioMix{}
+	require.ErrorContains(t, Scenario{Phases: []ScenarioPhase{{Duration: ScenarioDuration(time.Second), TxnPerSec: 1, Mix: mix}}}.check(cfg), "no positive weight")
+	mix.WeightPayment = -1
+	require.ErrorContains(t, Scenario{Phases: []ScenarioPhase{{Duration: ScenarioDuration(time.Second), TxnPerSec: 1, Mix: mix}}}.check(cfg), "can't be negative")
+	require.NoError(t, Scenario{Phases: []ScenarioPhase{{Duration: ScenarioDuration(time.Second), EndTxnPerSec: 1}}}.check(cfg))
+}
+
+func TestScenarioRunnerUpdate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := DefaultConfig
+	cfg.WeightPayment = 1
+	pps := NewPingpong(cfg)
+	start := time.Now()
+	runner := &scenarioRunner{
+		scenario: Scenario{Phases: []ScenarioPhase{
+			{Name: "ramp", Duration: ScenarioDuration(10 * time.Second), EndTxnPerSec: 100},
+			{Name: "burst", Duration: ScenarioDuration(time.Second), TxnPerSec: 1000, Mix: &ScenarioMix{WeightApp: 1}},
+			{Name: "steady", Duration: ScenarioDuration(5 * time.Second), TxnPerSec: 50},
+		}},
+		start: start,
+		phase: -1,
+	}
+	pps.cfg.Quiet = true
+
+	require.True(t, runner.update(pps, start))
+	require.Equal(t, 0, runner.phase)
+	require.Equal(t, uint64(1), pps.cfg.TxnPerSec)
+	require.True(t, runner.update(pps, start.Add(5*time.Second)))
+	require.Equal(t, uint64(50), pps.cfg.TxnPerSec)
+	require.Equal(t, float64(1), pps.cfg.WeightPayment)
+
+	require.True(t, runner.update(pps, start.Add(10500*time.Millisecond)))
+	require.Equal(t, 1, runner.phase)
+	require.Equal(t, uint64(1000), pps.cfg.TxnPerSec)
+	require.Equal(t, float64(0), pps.cfg.WeightPayment)
+	require.Equal(t, float64(1), pps.cfg.WeightApp)
+
+	// the mix of the burst stays for the following phase
+	require.True(t, runner.update(pps, start.Add(12*time.Second)))
+	require.Equal(t, 2, runner.phase)
+	require.Equal(t, uint64(50), pps.cfg.TxnPerSec)
+	require.Equal(t, float64(1), pps.cfg.WeightApp)
+
+	require.False(t, runner.update(pps, start.Add(16*time.Second)))
+
+	runner.end = start.Add(13 * time.Second)
+	require.Equal(t, []time.Duration{10 * time.Second, time.Second, 2 * time.Second}, runner.durations())
+}
+
+func TestTotalWeight(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := DefaultConfig
+	cfg.WeightPayment = 1
+	cfg.WeightApp = 2
+	cfg.WeightNFTCreation = 4
+	pps := NewPingpong(cfg)
+
+	// the NFT creations only get their weight within a scenario.
+	require.Equal(t, float64(3), pps.totalWeight())
+	pps.scenario = &scenarioRunner{}
+	require.Equal(t, float64(7), pps.totalWeight())
+}
+
+type testConfirmationSource struct {
+	blocks []bookkeeping.Block
+}
+
+func (s *testConfirmationSource) Status() (model.NodeStatusResponse, error) {
+	return model.NodeStatusResponse{}, nil
+}
+
+func (s *testConfirmationSource) WaitForRound(round uint64) (model.NodeStatusResponse, error) {
+	return model.NodeStatusResponse{LastRound: uint64(len(s.blocks))}, nil
+}
+
+func (s *testConfirmationSource) BookkeepingBlock(round uint64) (bookkeeping.Block, error) {
+	if round == 0 || round > uint64(len(s.blocks)) {
+		return bookkeeping.Block{}, errors.New("no such block")
+	}
+	return s.blocks[round-1], nil
+}
+
+func makeTestBlock(t *testing.T, round basics.Round, txns ...transactions.SignedTxn) bookkeeping.Block {
+	var block bookkeeping.Block
+	block.BlockHeader.Round = round
+	block.BlockHeader.GenesisID = "test"
+	block.BlockHeader.GenesisHash = crypto.Digest{1}
+	block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	for _, stxn := range txns {
+		stib, err := block.EncodeSignedTxn(stxn, transactions.ApplyData{})
+		require.NoError(t, err)
+		block.Payset = append(block.Payset, stib)
+	}
+	return block
+}
+
+func makeTestTxn(typ protocol.TxType, note byte, lastValid basics.Round) transactions.SignedTxn {
+	var stxn transactions.SignedTxn
+	stxn.Txn.Type = typ
+	stxn.Txn.Note = []byte{note}
+	stxn.Txn.GenesisID = "test"
+	stxn.Txn.GenesisHash = crypto.Digest{1}
+	stxn.Txn.FirstValid = 1
+	stxn.Txn.LastValid = lastValid
+	return stxn
+}
+
+func TestConfirmationTracker(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pay1 := makeTestTxn(protocol.PaymentTx, 1, 5)
+	pay2 := makeTestTxn(protocol.PaymentTx, 2, 2)
+	appl := makeTestTxn(protocol.ApplicationCallTx, 3, 5)
+	rejected := makeTestTxn(protocol.PaymentTx, 4, 5)
+
+	source := &testConfirmationSource{}
+	tracker := makeConfirmationTracker(source, 2)
+	start := time.Now()
+	tracker.submitted(0, []transactions.SignedTxn{pay1}, start, nil)
+	tracker.submitted(0, []transactions.SignedTxn{pay2}, start, nil)
+	tracker.submitted(1, []transactions.SignedTxn{appl}, start, nil)
+	tracker.submitted(1, []transactions.SignedTxn{rejected}, start, errors.New("HTTP 400 Bad Request: TransactionPool.Remember: transaction XYZ: overspend (account ABC)"))
+	require.Equal(t, 3, tracker.pendingCount())
+
+	source.blocks = []bookkeeping.Block{
+		makeTestBlock(t, 1, pay1),
+		makeTestBlock(t, 2),
+		makeTestBlock(t, 3, appl),
+	}
+	stop := make(chan struct{})
+	close(stop)
+	tracker.run(context.Background(), stop)
+	require.Equal(t, 0, tracker.pendingCount())
+
+	report := tracker.report(Scenario{Phases: []ScenarioPhase{{Name: "first"}, {}}}, []time.Duration{2 * time.Second, time.Second})
+	require.Len(t, report.Phases, 2)
+	first := report.Phases[0]
+	require.Equal(t, "first", first.Name)
+	require.Equal(t, uint64(2), first.Submitted)
+	require.Equal(t, uint64(1), first.Confirmed)
+	require.Equal(t, uint64(1), first.Expired)
+	require.Equal(t, 0.5, first.ConfirmedPerSec)
+	require.Contains(t, first.Types, "pay")
+	require.Greater(t, first.Types["pay"].Latency.Max, float64(0))
+
+	second := report.Phases[1]
+	require.Equal(t, "#2", second.Name)
+	require.Equal(t, uint64(1), second.Types["appl"].Confirmed)
+	require.Equal(t, uint64(1), second.Types["pay"].Rejected)
+	require.Equal(t, map[string]uint64{"overspend": 1}, second.Types["pay"].Rejections)
+
+	require.Equal(t, uint64(4), report.Total.Submitted)
+	require.Equal(t, uint64(2), report.Total.Confirmed)
+	require.Equal(t, uint64(1), report.Total.Rejected)
+	require.Equal(t, uint64(1), report.Total.Expired)
+	require.Equal(t, 3.0, report.Total.Seconds)
+}
+
+func TestLatencyReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var latencies []time.Duration
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Second)
+	}
+	report := makeLatencyReport(latencies)
+	require.Equal(t, LatencyReport{Mean: 50.5, P50: 50, P90: 90, P99: 99, Max: 100}, report)
+	require.Equal(t, LatencyReport{}, makeLatencyReport(nil))
+}
+
+func TestRejectionReason(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, "txn dead", RejectionReason(errors.New("HTTP 400 Bad Request: txn dead: round 10 outside of 1--5")))
+	require.Equal(t, "transaction pool full", RejectionReason(errors.New("TransactionPool.checkPendingQueueSize: transaction pool have reached capacity")))
+	long := RejectionReason(errors.New(string(make([]byte, 200))))
+	require.Len(t, long, maxRejectionReasonLen)
+}
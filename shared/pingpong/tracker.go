@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pingpong
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// confirmationSource provides the blocks in which the confirmationTracker looks for the
+// transactions it tracks. It is implemented by libgoal.Client.
+type confirmationSource interface {
+	Status() (model.NodeStatusResponse, error)
+	WaitForRound(round uint64) (model.NodeStatusResponse, error)
+	BookkeepingBlock(round uint64) (bookkeeping.Block, error)
+}
+
+// txnSubmission is a transaction which was accepted by algod and isn't confirmed yet
+type txnSubmission struct {
+	phase     int
+	txnType   string
+	submitted time.Time
+	lastValid basics.Round
+}
+
+// txnSamples accumulates the outcomes of the transactions of a kind sent during a phase
+type txnSamples struct {
+	submitted  uint64
+	confirmed  uint64
+	rejected   uint64
+	expired    uint64
+	rejections map[string]uint64
+	latencies  []time.Duration
+}
+
+// confirmationTracker follows the transactions sent during a scenario until they are
+// confirmed by the ledger, or until they can't be confirmed anymore.
+type confirmationTracker struct {
+	source confirmationSource
+
+	mu      sync.Mutex
+	pending map[transactions.Txid]txnSubmission
+	// samples of each phase, by transaction type
+	samples []map[string]*txnSamples
+}
+
+func makeConfirmationTracker(source confirmationSource, phases int) *confirmationTracker {
+	t := &confirmationTracker{
+		source:  source,
+		pending: make(map[transactions.Txid]txnSubmission),
+		samples: make([]map[string]*txnSamples, phases),
+	}
+	for i := range t.samples {
+		t.samples[i] = make(map[string]*txnSamples)
+	}
+	return t
+}
+
+// txnType returns the kind of a transaction, as named in the report
+func txnType(txn transactions.Transaction) string {
+	return string(txn.Type)
+}
+
+func (t *confirmationTracker) phaseSamples(phase int, txnType string) *txnSamples {
+	s := t.samples[phase][txnType]
+	if s == nil {
+		s = &txnSamples{rejections: make(map[string]uint64)}
+		t.samples[phase][txnType] = s
+	}
+	return s
+}
+
+// submitted records the outcome of the broadcast of a transaction group, sent at the given time
+func (t *confirmationTracker) submitted(phase int, group []transactions.SignedTxn, at time.Time, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var reason string
+	if err != nil {
+		reason = RejectionReason(err)
+	}
+	for _, stxn := range group {
+		typ := txnType(stxn.Txn)
+		s := t.phaseSamples(phase, typ)
+		s.submitted++
+		if err != nil {
+			s.rejected++
+			s.rejections[reason]++
+			continue
+		}
+		t.pending[stxn.ID()] = txnSubmission{phase: phase, txnType: typ, submitted: at, lastValid: stxn.Txn.LastValid}
+	}
+}
+
+// observeBlock confirms the pending transactions of the block, which was seen at the given time,
+// and expires the ones which can't be included in a later block.
+func (t *confirmationTracker) observeBlock(block bookkeeping.Block, at time.Time) error {
+	payset, err := block.DecodePaysetFlat()
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, stxn := range payset {
+		txid := stxn.ID()
+		sub, ok := t.pending[txid]
+		if !ok {
+			continue
+		}
+		delete(t.pending, txid)
+		s := t.phaseSamples(sub.phase, sub.txnType)
+		s.confirmed++
+		s.latencies = append(s.latencies, at.Sub(sub.submitted))
+	}
+	for txid, sub := range t.pending {
+		if sub.lastValid <= block.Round() {
+			delete(t.pending, txid)
+			t.phaseSamples(sub.phase, sub.txnType).expired++
+		}
+	}
+	return nil
+}
+
+func (t *confirmationTracker) pendingCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.pending)
+}
+
+// run follows the blocks added to the ledger until ctx is done, or until stop is closed and
+// all the submitted transactions are either confirmed or expired.
+func (t *confirmationTracker) run(ctx context.Context, stop <-chan struct{}) {
+	var round uint64
+	for {
+		status, err := t.source.Status()
+		if err == nil {
+			round = status.LastRound
+			break
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "error getting the status of the node: %v\n", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-stop:
+			if t.pendingCount() == 0 {
+				return
+			}
+		default:
+		}
+
+		status, err := t.source.WaitForRound(round)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "error waiting for round %d: %v\n", round+1, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		for round < status.LastRound {
+			block, err := t.source.BookkeepingBlock(round + 1)
+			if err == nil {
+				err = t.observeBlock(block, time.Now())
+			}
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "error reading block %d: %v\n", round+1, err)
+				break
+			}
+			round++
+		}
+	}
+}
+
+// rejectionReasons are the errors algod reports for the transactions it rejects, by the part
+// of their message which identifies them.
+var rejectionReasons = []struct {
+	match  string
+	reason string
+}{
+	{"transaction pool have reached capacity", "transaction pool full"},
+	{"below threshold", "fee below pool threshold"},
+	{"which is less than the minimum", "fee below minimum"},
+	{"overspend", "overspend"},
+	{"txn dead", "txn dead"},
+	{"already in ledger", "already in ledger"},
+	{"rejected by logic", "rejected by logic"},
+	{"logic eval error", "logic eval error"},
+	{"missing from", "asset or app missing"},
+	{"has not opted in", "not opted in"},
+	{"underflow", "balance underflow"},
+}
+
+// maxRejectionReasonLen bounds the length of the reasons which aren't in rejectionReasons
+const maxRejectionReasonLen = 80
+
+// RejectionReason returns the reason of the rejection of a transaction, in a form which
+// doesn't depend on the transaction, so that rejections can be counted by reason.
+func RejectionReason(err error) string {
+	msg := err.Error()
+	for _, r := range rejectionReasons {
+		if strings.Contains(msg, r.match) {
+			return r.reason
+		}
+	}
+	if len(msg) > maxRejectionReasonLen {
+		msg = msg[:maxRejectionReasonLen]
+	}
+	return msg
+}
@@ -0,0 +1,124 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package kmdtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWalletBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate three keys, and delete the second one
+	var generated []string
+	for i := 0; i < 3; i++ {
+		resp, err := f.Client.GenerateKey([]byte(walletHandleToken))
+		a.NoError(err)
+		generated = append(generated, resp.Address)
+	}
+	_, err := f.Client.DeleteKey([]byte(walletHandleToken), []byte(f.WalletPassword), generated[1])
+	a.NoError(err)
+
+	// Import a key generated outside of kmd
+	seed := crypto.Seed{}
+	crypto.RandBytes(seed[:])
+	secrets := crypto.GenerateSignatureSecrets(seed)
+	importResp, err := f.Client.ImportKey([]byte(walletHandleToken), crypto.PrivateKey(secrets.SK))
+	a.NoError(err)
+
+	// Import a multisig preimage
+	pks := []crypto.PublicKey{addrToPK(t, generated[0]), crypto.PublicKey(secrets.SignatureVerifier)}
+	msigResp, err := f.Client.ImportMultisigAddr([]byte(walletHandleToken), 1, 2, pks)
+	a.NoError(err)
+
+	// The backup requires the wallet password, and a separate backup
+	// passphrase that can't be blank
+	backupPassword := []byte("backup passphrase")
+	_, err = f.Client.BackupWallet([]byte(walletHandleToken), []byte("wrong"), backupPassword)
+	a.Error(err)
+	_, err = f.Client.BackupWallet([]byte(walletHandleToken), []byte(f.WalletPassword), nil)
+	a.Error(err)
+	backupResp, err := f.Client.BackupWallet([]byte(walletHandleToken), []byte(f.WalletPassword), backupPassword)
+	a.NoError(err)
+	backup := backupResp.Backup
+	a.NotEmpty(backup)
+
+	// The backed up wallet name is taken
+	_, err = f.Client.RestoreWallet(nil, "sqlite", []byte(f.WalletPassword), backupPassword, backup)
+	a.Error(err)
+
+	// The backup can't be decrypted without the backup passphrase, in
+	// particular not with the wallet password, or once tampered with
+	restoredPassword := []byte("restored password")
+	_, err = f.Client.RestoreWallet([]byte("restored"), "sqlite", restoredPassword, []byte(f.WalletPassword), backup)
+	a.Error(err)
+	_, err = f.Client.RestoreWallet([]byte("restored"), "sqlite", restoredPassword, nil, backup)
+	a.Error(err)
+	tampered := append([]byte(nil), backup...)
+	tampered[len(tampered)-1] ^= 1
+	_, err = f.Client.RestoreWallet([]byte("restored"), "sqlite", restoredPassword, backupPassword, tampered)
+	a.Error(err)
+
+	// Restore the wallet under a new name and password
+	restoreResp, err := f.Client.RestoreWallet([]byte("restored"), "sqlite", restoredPassword, backupPassword, backup)
+	a.NoError(err)
+	a.Equal("restored", restoreResp.Wallet.Name)
+	initResp, err := f.Client.InitWallet([]byte(restoreResp.Wallet.ID), restoredPassword)
+	a.NoError(err)
+	restoredHandleToken := []byte(initResp.WalletHandleToken)
+
+	// The restored wallet has the same keys and multisig preimages
+	listResp, err := f.Client.ListKeys(restoredHandleToken)
+	a.NoError(err)
+	a.ElementsMatch([]string{generated[0], generated[2], importResp.Address}, listResp.Addresses)
+	exportReq := kmdapi.APIV1POSTKeyExportRequest{
+		WalletHandleToken: string(restoredHandleToken),
+		Address:           importResp.Address,
+		WalletPassword:    string(restoredPassword),
+	}
+	exportResp := kmdapi.APIV1POSTKeyExportResponse{}
+	err = f.Client.DoV1Request(exportReq, &exportResp)
+	a.NoError(err)
+	a.Equal(basics.Address(secrets.SignatureVerifier).GetUserAddress(), importResp.Address)
+	a.Equal(crypto.PrivateKey(secrets.SK), crypto.PrivateKey(exportResp.PrivateKey))
+	msigListResp, err := f.Client.ListMultisigAddrs(restoredHandleToken)
+	a.NoError(err)
+	a.Equal([]string{msigResp.Address}, msigListResp.Addresses)
+
+	// Both wallets derive the same next key
+	resp0, err := f.Client.GenerateKey([]byte(walletHandleToken))
+	a.NoError(err)
+	resp1, err := f.Client.GenerateKey(restoredHandleToken)
+	a.NoError(err)
+	a.Equal(resp0.Address, resp1.Address)
+	a.NotContains(generated, resp1.Address)
+}
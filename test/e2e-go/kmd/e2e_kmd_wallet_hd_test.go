@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package kmdtest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/framework/fixtures"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func hdAddress(t *testing.T, seed []byte, account uint32, index uint32) string {
+	key, err := crypto.BIP32Ed25519MasterKey(seed).DerivePath(crypto.BIP44Path(account, index))
+	require.NoError(t, err)
+	pub, err := key.Public()
+	require.NoError(t, err)
+	return basics.Address(pub.PublicKey).String()
+}
+
+func TestHDWallet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	f.Setup(t)
+	defer f.Shutdown()
+
+	pw := []byte(f.WalletPassword)
+	seed := make([]byte, 64)
+	crypto.RandBytes(seed)
+
+	// Only the hd driver accepts seeds
+	_, err := f.Client.CreateWallet([]byte("notHD"), "sqlite", pw, crypto.MasterDerivationKey{})
+	a.NoError(err)
+	_, err = f.Client.CreateHDWallet([]byte("short"), pw, seed[:8])
+	a.Error(err)
+
+	createResp, err := f.Client.CreateHDWallet([]byte("hd"), pw, seed)
+	a.NoError(err)
+	a.Equal("hd", createResp.Wallet.DriverName)
+	initResp, err := f.Client.InitWallet([]byte(createResp.Wallet.ID), pw)
+	a.NoError(err)
+	handle := []byte(initResp.WalletHandleToken)
+
+	// Keys are derived along m/44'/283'/account'/0/index
+	resp0, err := f.Client.GenerateKey(handle)
+	a.NoError(err)
+	a.Equal(hdAddress(t, seed, 0, 0), resp0.Address)
+	resp1, err := f.Client.GenerateKey(handle)
+	a.NoError(err)
+	a.Equal(hdAddress(t, seed, 0, 1), resp1.Address)
+	resp2, err := f.Client.GenerateKeyAtPath(handle, 2, 7)
+	a.NoError(err)
+	a.Equal(hdAddress(t, seed, 2, 7), resp2.Address)
+	_, err = f.Client.GenerateKeyAtPath(handle, 2, 7)
+	a.Error(err)
+	_, err = f.Client.GenerateKeyAtPath(handle, 0, crypto.BIP32HardenedOffset)
+	a.Error(err)
+
+	// Derived keys sign transactions
+	pk := addrToPK(t, resp2.Address)
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     basics.Address(pk),
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+	}
+	signResp, err := f.Client.SignTransaction(handle, pw, crypto.PublicKey{}, tx)
+	a.NoError(err)
+	var stx transactions.SignedTxn
+	a.NoError(protocol.Decode(signResp.SignedTransaction, &stx))
+	a.True(crypto.SignatureVerifier(pk).Verify(tx, stx.Sig))
+
+	// A watch-only wallet derives the same public keys from the account key
+	accountResp, err := f.Client.ExportAccountKey(handle, pw, 0)
+	a.NoError(err)
+	a.Len(accountResp.AccountKey, 64)
+	watchResp, err := f.Client.CreateWatchOnlyWallet([]byte("watch"), pw, 0, accountResp.AccountKey)
+	a.NoError(err)
+	watchInitResp, err := f.Client.InitWallet([]byte(watchResp.Wallet.ID), pw)
+	a.NoError(err)
+	watchHandle := []byte(watchInitResp.WalletHandleToken)
+
+	watch0, err := f.Client.GenerateKey(watchHandle)
+	a.NoError(err)
+	a.Equal(resp0.Address, watch0.Address)
+	watch1, err := f.Client.GenerateKeyAtPath(watchHandle, 0, 1)
+	a.NoError(err)
+	a.Equal(resp1.Address, watch1.Address)
+	_, err = f.Client.GenerateKeyAtPath(watchHandle, 2, 7)
+	a.Error(err)
+
+	// But can't sign
+	tx.Sender = basics.Address(addrToPK(t, watch0.Address))
+	_, err = f.Client.SignTransaction(watchHandle, pw, crypto.PublicKey{}, tx)
+	a.Error(err)
+	_, err = f.Client.ExportMasterDerivationKey(watchHandle, pw)
+	a.Error(err)
+}
@@ -0,0 +1,454 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package netsim runs networks of full nodes within a single process. The nodes communicate
+// over an in-memory hub whose links could be slowed down, made lossy and partitioned, and
+// their agreement timeouts are driven by a virtual clock, which lets the consensus and catchup
+// scenarios that would take minutes on a real network run in seconds. The keys and the link
+// decisions derive from a seed, so that a failing scenario could be run again.
+//
+// The virtual clock drives the link delays of the hub, the agreement timeouts, the periodic
+// catchup and its block request timeouts, and the transaction syncs. The rest of the node keeps
+// using the wall clock: the catchpoint catchup and its downloads, the pauses of the fetchers
+// when no peer is available, the transaction handler and its backlog, the ledger flushes, and
+// the timestamps of the received messages. Scenarios which depend on these timers run at wall
+// clock speed, and aren't reproducible from the seed alone.
+package netsim
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/memnet"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+// ErrTimeout is returned by RunUntil when the condition was not met within the virtual time limit.
+var ErrTimeout = errors.New("netsim: condition not met within the time limit")
+
+const (
+	defaultStep         = 5 * time.Millisecond
+	defaultTick         = time.Millisecond
+	defaultKeyLastValid = basics.Round(3000)
+	nodeStake           = 10_000_000_000
+
+	// quietTicks is the number of ticks without any activity after which the network is
+	// considered idle, and the clock jumps to the next timeout.
+	quietTicks = 10
+)
+
+// Config describes a simulated network.
+type Config struct {
+	// RootDir is the directory the data directories of the nodes are created in.
+	RootDir string
+	// Nodes is the number of participating nodes, each of which holds the same stake.
+	Nodes int
+	// Seed determines the accounts of the nodes and the decisions made on the links.
+	Seed int64
+	// Link is the configuration of all the links between the nodes.
+	Link memnet.LinkConfig
+	// Proto is the consensus protocol of the genesis; it defaults to Protocol.
+	Proto protocol.ConsensusVersion
+	// Step is the amount of virtual time which passes for every Tick of wall time while
+	// messages flow between the nodes. It defaults to 5ms per millisecond. Once the nodes
+	// are idle, the clock jumps to the next timeout instead.
+	Step time.Duration
+	// Tick defaults to a millisecond.
+	Tick time.Duration
+	// KeyLastValid is the last round the participation keys of the nodes are valid for.
+	KeyLastValid basics.Round
+	// Log is the logger of the nodes; it defaults to the base logger.
+	Log logging.Logger
+	// ConfigureNode, if set, may modify the configuration of every node before it is created.
+	ConfigureNode func(index int, cfg *config.Local)
+}
+
+// activityMonitor observes the event queues of the agreement services of the nodes, which
+// tells whether they are still processing the events of the current virtual time.
+type activityMonitor struct {
+	mu      sync.Mutex
+	updates uint64
+	queues  map[string]int
+}
+
+func (m *activityMonitor) UpdateEventsQueue(queueName string, queueLength int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.updates++
+	m.queues[queueName] += queueLength
+}
+
+// nodeMonitor reports the event queues of a single node to the activityMonitor.
+type nodeMonitor struct {
+	m      *activityMonitor
+	name   string
+	mu     sync.Mutex
+	queues map[string]int
+}
+
+func (nm *nodeMonitor) UpdateEventsQueue(queueName string, queueLength int) {
+	nm.mu.Lock()
+	delta := queueLength - nm.queues[queueName]
+	nm.queues[queueName] = queueLength
+	nm.mu.Unlock()
+	nm.m.UpdateEventsQueue(queueName, delta)
+}
+
+// state returns a counter which changes whenever an event is processed, and whether any
+// event is waiting to be processed.
+func (m *activityMonitor) state() (uint64, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, length := range m.queues {
+		if length > 0 {
+			return m.updates, true
+		}
+	}
+	return m.updates, false
+}
+
+// Network is a set of full nodes connected through an in-memory hub.
+type Network struct {
+	cfg      Config
+	clock    *timers.VirtualClock
+	monitor  *activityMonitor
+	hub      *memnet.Hub
+	genesis  bookkeeping.Genesis
+	accounts []*crypto.SignatureSecrets
+	nodes    []*node.AlgorandFullNode
+	names    []string
+	running  bool
+}
+
+// MakeNetwork creates the accounts, participation keys and nodes of a network. The nodes are
+// not started.
+func MakeNetwork(cfg Config) (*Network, error) {
+	if cfg.RootDir == "" {
+		return nil, errors.New("netsim: no root directory")
+	}
+	if cfg.Nodes < 1 {
+		return nil, fmt.Errorf("netsim: a network needs at least one node, not %d", cfg.Nodes)
+	}
+	if cfg.Proto == "" {
+		cfg.Proto = Protocol
+	}
+	if cfg.Step == 0 {
+		cfg.Step = defaultStep
+	}
+	if cfg.Tick == 0 {
+		cfg.Tick = defaultTick
+	}
+	if cfg.KeyLastValid == 0 {
+		cfg.KeyLastValid = defaultKeyLastValid
+	}
+	if cfg.Log == nil {
+		cfg.Log = logging.Base()
+	}
+	proto, ok := config.Consensus[cfg.Proto]
+	if !ok {
+		return nil, fmt.Errorf("netsim: unknown consensus protocol %s", cfg.Proto)
+	}
+
+	n := &Network{
+		cfg:     cfg,
+		clock:   timers.MakeVirtualClock(),
+		monitor: &activityMonitor{queues: make(map[string]int)},
+	}
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	var sink, pool crypto.Seed
+	rng.Read(sink[:])
+	rng.Read(pool[:])
+	n.genesis = bookkeeping.Genesis{
+		SchemaID:    fmt.Sprintf("netsim-%d", cfg.Seed),
+		Network:     config.Devtestnet,
+		Proto:       cfg.Proto,
+		FeeSink:     basics.Address(crypto.GenerateSignatureSecrets(sink).SignatureVerifier).String(),
+		RewardsPool: basics.Address(crypto.GenerateSignatureSecrets(pool).SignatureVerifier).String(),
+		Timestamp:   time.Now().Unix(),
+	}
+	n.genesis.Allocation = append(n.genesis.Allocation,
+		bookkeeping.GenesisAllocation{Address: n.genesis.FeeSink, Comment: "fee sink", State: basics.AccountData{Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: proto.MinBalance}}},
+		bookkeeping.GenesisAllocation{Address: n.genesis.RewardsPool, Comment: "rewards pool", State: basics.AccountData{Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: proto.MinBalance}}},
+	)
+	n.hub = memnet.MakeHub(n.clock, cfg.Seed, n.genesis.ID(), cfg.Log)
+	n.hub.SetDefaultLink(cfg.Link)
+
+	// the participation keys are written to the data directories before the nodes are
+	// created, as the genesis has to hold the keys of all the nodes.
+	for i := 0; i < cfg.Nodes; i++ {
+		var seed crypto.Seed
+		rng.Read(seed[:])
+		secrets := crypto.GenerateSignatureSecrets(seed)
+		address := basics.Address(secrets.SignatureVerifier)
+		name := nodeName(i)
+		genesisDir := filepath.Join(cfg.RootDir, name, n.genesis.ID())
+		if err := os.MkdirAll(genesisDir, 0700); err != nil {
+			return nil, err
+		}
+		partAccess, err := db.MakeAccessor(filepath.Join(genesisDir, config.PartKeyFilename(name, 0, uint64(cfg.KeyLastValid))), false, false)
+		if err != nil {
+			return nil, err
+		}
+		part, err := account.FillDBWithParticipationKeys(partAccess, address, 0, cfg.KeyLastValid, proto.DefaultKeyDilution)
+		partAccess.Close()
+		if err != nil {
+			return nil, err
+		}
+		state := basics.AccountData{
+			Status:          basics.Online,
+			MicroAlgos:      basics.MicroAlgos{Raw: nodeStake},
+			SelectionID:     part.VRFSecrets().PK,
+			VoteID:          part.VotingSecrets().OneTimeSignatureVerifier,
+			VoteFirstValid:  0,
+			VoteLastValid:   cfg.KeyLastValid,
+			VoteKeyDilution: proto.DefaultKeyDilution,
+		}
+		if proto.EnableStateProofKeyregCheck {
+			state.StateProofID = part.StateProofVerifier().Commitment
+		}
+		n.genesis.Allocation = append(n.genesis.Allocation, bookkeeping.GenesisAllocation{Address: address.String(), Comment: name, State: state})
+		n.accounts = append(n.accounts, secrets)
+	}
+
+	for i := 0; i < cfg.Nodes; i++ {
+		if _, err := n.makeNode(); err != nil {
+			n.Stop()
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+func nodeName(index int) string {
+	return fmt.Sprintf("node-%d", index)
+}
+
+func (n *Network) makeNode() (int, error) {
+	index := len(n.nodes)
+	name := nodeName(index)
+	rootDir := filepath.Join(n.cfg.RootDir, name)
+	if err := os.MkdirAll(rootDir, 0700); err != nil {
+		return 0, err
+	}
+	cfg := config.GetDefaultLocal()
+	cfg.NetAddress = name
+	cfg.Archival = true
+	cfg.EnableBlockService = true
+	cfg.EnableLedgerService = true
+	cfg.CatchpointTracking = -1
+	cfg.CatchpointInterval = 0
+	cfg.ProposalAssemblyTime = 50 * time.Millisecond
+	if n.cfg.ConfigureNode != nil {
+		n.cfg.ConfigureNode(index, &cfg)
+	}
+	net, err := n.hub.AddNode(name)
+	if err != nil {
+		return 0, err
+	}
+	opts := node.InProcessOptions{
+		Net:              net,
+		Clock:            n.clock.Clock(),
+		AgreementMonitor: &nodeMonitor{m: n.monitor, name: name, queues: make(map[string]int)},
+	}
+	fullNode, err := node.MakeInProcess(n.cfg.Log.With("node", name), rootDir, cfg, n.genesis, opts)
+	if err != nil {
+		return 0, err
+	}
+	n.nodes = append(n.nodes, fullNode)
+	n.names = append(n.names, name)
+	return index, nil
+}
+
+// AddNode adds a node holding no stake to the network, such as a node joining late which
+// has to catch up. The node is started if the network is running.
+func (n *Network) AddNode() (int, error) {
+	index, err := n.makeNode()
+	if err != nil {
+		return 0, err
+	}
+	if n.running {
+		n.nodes[index].Start()
+	}
+	return index, nil
+}
+
+// Start starts all the nodes.
+func (n *Network) Start() {
+	for _, fullNode := range n.nodes {
+		fullNode.Start()
+	}
+	n.running = true
+}
+
+// Stop stops all the nodes. A network cannot be started again once stopped.
+func (n *Network) Stop() {
+	for _, fullNode := range n.nodes {
+		fullNode.Stop()
+	}
+	n.running = false
+}
+
+// Len returns the number of nodes in the network.
+func (n *Network) Len() int {
+	return len(n.nodes)
+}
+
+// Node returns the node of the given index.
+func (n *Network) Node(index int) *node.AlgorandFullNode {
+	return n.nodes[index]
+}
+
+// Name returns the name of the node of the given index within the hub.
+func (n *Network) Name(index int) string {
+	return n.names[index]
+}
+
+// Account returns the secrets of the account holding the stake of the node of the given index.
+func (n *Network) Account(index int) *crypto.SignatureSecrets {
+	return n.accounts[index]
+}
+
+// Genesis returns the genesis of the network.
+func (n *Network) Genesis() bookkeeping.Genesis {
+	return n.genesis
+}
+
+// Hub returns the hub connecting the nodes, whose links could be configured.
+func (n *Network) Hub() *memnet.Hub {
+	return n.hub
+}
+
+// Clock returns the virtual clock driving the network.
+func (n *Network) Clock() *timers.VirtualClock {
+	return n.clock
+}
+
+// Partition splits the network into the given groups of node indexes. Nodes which are not part
+// of any group are isolated.
+func (n *Network) Partition(groups ...[]int) {
+	named := make([][]string, len(groups))
+	for i, group := range groups {
+		for _, index := range group {
+			named[i] = append(named[i], n.names[index])
+		}
+	}
+	n.hub.Partition(named...)
+}
+
+// Heal undoes any partitioning of the network.
+func (n *Network) Heal() {
+	n.hub.Heal()
+}
+
+// Round returns the latest round of the ledger of the node of the given index.
+func (n *Network) Round(index int) basics.Round {
+	return n.nodes[index].Ledger().Latest()
+}
+
+// MinRound returns the latest round of the least advanced node among the given ones, or among
+// all the nodes if none is given.
+func (n *Network) MinRound(indexes ...int) basics.Round {
+	if len(indexes) == 0 {
+		for i := range n.nodes {
+			indexes = append(indexes, i)
+		}
+	}
+	min := n.Round(indexes[0])
+	for _, index := range indexes[1:] {
+		if round := n.Round(index); round < min {
+			min = round
+		}
+	}
+	return min
+}
+
+// Run lets the network run for the given amount of virtual time.
+func (n *Network) Run(d time.Duration) {
+	n.RunUntil(func() bool { return false }, d)
+}
+
+// RunUntil lets the network run until cond holds. The virtual clock advances by Step every
+// Tick while the nodes are busy, and jumps to the next timeout, such as the end of an
+// agreement step, once they have been idle for a few ticks. It returns ErrTimeout if cond
+// does not hold once limit virtual time has passed.
+func (n *Network) RunUntil(cond func() bool, limit time.Duration) error {
+	deadline := n.clock.Now() + limit
+	activity, _ := n.activity()
+	quiet := 0
+	for !cond() {
+		now := n.clock.Now()
+		if now >= deadline {
+			return ErrTimeout
+		}
+		time.Sleep(n.cfg.Tick)
+		current, busy := n.activity()
+		if current != activity || busy {
+			activity = current
+			quiet = 0
+		} else {
+			quiet++
+		}
+		step := n.cfg.Step
+		if quiet >= quietTicks {
+			quiet = 0
+			if next, ok := n.clock.Next(); ok {
+				step = next - now
+			}
+		}
+		if now+step > deadline {
+			step = deadline - now
+		}
+		n.clock.Advance(step)
+	}
+	return nil
+}
+
+// activity returns a counter which changes whenever a message is sent or delivered, an
+// agreement event is processed or a block is written, and whether the agreement services
+// have events waiting to be processed.
+func (n *Network) activity() (uint64, bool) {
+	stats := n.hub.Stats()
+	updates, busy := n.monitor.state()
+	counter := stats.Sent + stats.Delivered + stats.Dropped + updates
+	for _, fullNode := range n.nodes {
+		counter += uint64(fullNode.Ledger().Latest())
+	}
+	return counter, busy
+}
+
+// WaitForRound lets the network run until all the given nodes, or all the nodes if none is
+// given, have reached the round.
+func (n *Network) WaitForRound(round basics.Round, limit time.Duration, indexes ...int) error {
+	err := n.RunUntil(func() bool { return n.MinRound(indexes...) >= round }, limit)
+	if err != nil {
+		return fmt.Errorf("waiting for round %d, the nodes are at %d: %w", round, n.MinRound(indexes...), err)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netsim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/memnet"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestNetwork(t *testing.T, nodes int, seed int64, link memnet.LinkConfig) *Network {
+	log := logging.TestingLog(t)
+	log.SetLevel(logging.Warn)
+	n, err := MakeNetwork(Config{
+		RootDir: t.TempDir(),
+		Nodes:   nodes,
+		Seed:    seed,
+		Link:    link,
+		Log:     log,
+	})
+	require.NoError(t, err)
+	t.Cleanup(n.Stop)
+	return n
+}
+
+func TestNetworkLiveness(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	n := makeTestNetwork(t, 4, 1, memnet.LinkConfig{Latency: 50 * time.Millisecond, Jitter: 20 * time.Millisecond})
+	n.Start()
+	start := time.Now()
+	require.NoError(t, n.WaitForRound(10, 10*time.Minute))
+	t.Logf("reached round 10 after %v of virtual time in %v", n.Clock().Now(), time.Since(start))
+
+	// all the nodes agree on the blocks.
+	for rnd := 1; rnd <= 10; rnd++ {
+		expected, err := n.Node(0).Ledger().BlockHdr(basics.Round(rnd))
+		require.NoError(t, err)
+		for i := 1; i < n.Len(); i++ {
+			hdr, err := n.Node(i).Ledger().BlockHdr(basics.Round(rnd))
+			require.NoError(t, err)
+			require.Equal(t, expected.Hash(), hdr.Hash())
+		}
+	}
+}
+
+func TestNetworkPartition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	n := makeTestNetwork(t, 5, 2, memnet.LinkConfig{Latency: 50 * time.Millisecond})
+	n.Start()
+	require.NoError(t, n.WaitForRound(2, 5*time.Minute))
+
+	// the majority holds four fifths of the stake, which is enough to keep making progress,
+	// while the isolated node stalls.
+	n.Partition([]int{0, 1, 2, 3}, []int{4})
+	stalled := n.Round(4)
+	require.NoError(t, n.WaitForRound(stalled+5, 5*time.Minute, 0, 1, 2, 3))
+	require.LessOrEqual(t, n.Round(4), stalled+1)
+
+	// once healed, the isolated node catches up with the majority.
+	n.Heal()
+	target := n.MinRound(0, 1, 2, 3) + 2
+	require.NoError(t, n.WaitForRound(target, 10*time.Minute))
+}
+
+func TestNetworkLateNode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	n := makeTestNetwork(t, 3, 3, memnet.LinkConfig{Latency: 20 * time.Millisecond})
+	n.Start()
+	require.NoError(t, n.WaitForRound(5, 5*time.Minute))
+
+	index, err := n.AddNode()
+	require.NoError(t, err)
+	require.NoError(t, n.WaitForRound(n.MinRound(0, 1, 2)+1, 5*time.Minute, index))
+
+	expected, err := n.Node(0).Ledger().BlockHdr(5)
+	require.NoError(t, err)
+	hdr, err := n.Node(index).Ledger().BlockHdr(5)
+	require.NoError(t, err)
+	require.Equal(t, expected.Hash(), hdr.Hash())
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netsim
+
+import (
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Protocol is the default consensus protocol of simulated networks. It is the current protocol
+// with committees a tenth of their size, since verifying the credentials of a vote takes time in
+// proportion to its weight, and all the nodes of a network share the CPUs of a single process.
+// Its thresholds are two thirds of the committee sizes, so that a network keeps making progress
+// while a fifth of its stake is partitioned away.
+const Protocol = protocol.ConsensusVersion("netsim")
+
+// keyDilution of the participation keys of the Protocol, which keeps the generation of the
+// one-time signature keys of every batch cheap.
+const keyDilution = 100
+
+func init() {
+	params := config.Consensus[protocol.ConsensusCurrentVersion]
+	params.ApprovedUpgrades = map[protocol.ConsensusVersion]uint64{}
+	params.DefaultKeyDilution = keyDilution
+	params.SoftCommitteeSize, params.SoftCommitteeThreshold = committee(params.SoftCommitteeSize)
+	params.CertCommitteeSize, params.CertCommitteeThreshold = committee(params.CertCommitteeSize)
+	params.NextCommitteeSize, params.NextCommitteeThreshold = committee(params.NextCommitteeSize)
+	params.LateCommitteeSize, params.LateCommitteeThreshold = committee(params.LateCommitteeSize)
+	params.RedoCommitteeSize, params.RedoCommitteeThreshold = committee(params.RedoCommitteeSize)
+	params.DownCommitteeSize, params.DownCommitteeThreshold = committee(params.DownCommitteeSize)
+	config.Consensus[Protocol] = params
+}
+
+// committee returns the size and threshold of a committee of the Protocol, given the size of
+// the committee in the current protocol.
+func committee(size uint64) (uint64, uint64) {
+	size /= 10
+	return size, (2*size + 2) / 3
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// this file contains table snapshots that can be applied to any sqlite database.
+
+// snapshotTablePrefix starts the names of the tables holding snapshots.
+const snapshotTablePrefix = "snapshot"
+
+// ErrSnapshotNotFound is returned when restoring a snapshot which has no tables.
+var ErrSnapshotNotFound = errors.New("snapshot not found")
+
+func snapshotTableName(id uint64, table string) string {
+	return fmt.Sprintf("%s%d_%s", snapshotTablePrefix, id, table)
+}
+
+// listTables returns the names of the tables of the database, except sqlite's
+// own, whose name starts with prefix, or does not start with snapshotTablePrefix
+// if prefix is empty.
+func listTables(ctx context.Context, q Queryable, prefix string) ([]string, error) {
+	rows, err := q.QueryContext(ctx, "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite\\_%' ESCAPE '\\' ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var name string
+		err = rows.Scan(&name)
+		if err != nil {
+			return nil, err
+		}
+		if prefix == "" && !strings.HasPrefix(name, snapshotTablePrefix) ||
+			prefix != "" && strings.HasPrefix(name, prefix) {
+			tables = append(tables, name)
+		}
+	}
+	return tables, rows.Err()
+}
+
+// SnapshotTables copies the contents of every table of the database to a table
+// of the same database named after the snapshot id, so that RestoreTables can
+// bring them back. Snapshot tables are not part of later snapshots. The id must
+// not be in use.
+func SnapshotTables(ctx context.Context, e Executable, id uint64) error {
+	tables, err := listTables(ctx, e, "")
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		_, err = e.ExecContext(ctx, fmt.Sprintf("CREATE TABLE %q AS SELECT * FROM %q", snapshotTableName(id, table), table))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RestoreTables replaces the contents of the tables of the database by the
+// contents they had when SnapshotTables was called for id. The snapshot is
+// kept.
+func RestoreTables(ctx context.Context, e Executable, id uint64) error {
+	prefix := snapshotTableName(id, "")
+	snapshots, err := listTables(ctx, e, prefix)
+	if err != nil {
+		return err
+	}
+	if len(snapshots) == 0 {
+		return ErrSnapshotNotFound
+	}
+	for _, snapshot := range snapshots {
+		table := strings.TrimPrefix(snapshot, prefix)
+		_, err = e.ExecContext(ctx, fmt.Sprintf("DELETE FROM %q", table))
+		if err != nil {
+			return err
+		}
+		_, err = e.ExecContext(ctx, fmt.Sprintf("INSERT INTO %q SELECT * FROM %q", table, snapshot))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropSnapshotTables deletes the tables created by SnapshotTables for id.
+func DropSnapshotTables(ctx context.Context, e Executable, id uint64) error {
+	snapshots, err := listTables(ctx, e, snapshotTableName(id, ""))
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		_, err = e.ExecContext(ctx, fmt.Sprintf("DROP TABLE %q", snapshot))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
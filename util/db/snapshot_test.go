@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSnapshotTables(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	acc, err := MakeAccessor(t.Name(), false, true)
+	require.NoError(t, err)
+	defer acc.Close()
+
+	values := func() (values []int) {
+		err := acc.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+			rows, err := tx.QueryContext(ctx, "SELECT v FROM t ORDER BY v")
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var v int
+				if err := rows.Scan(&v); err != nil {
+					return err
+				}
+				values = append(values, v)
+			}
+			return rows.Err()
+		})
+		require.NoError(t, err)
+		return values
+	}
+	query := func(q string) func(ctx context.Context, tx *sql.Tx) error {
+		return func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, q)
+			return err
+		}
+	}
+
+	require.NoError(t, acc.Atomic(query("CREATE TABLE t (v INTEGER PRIMARY KEY)")))
+	require.NoError(t, acc.Atomic(query("INSERT INTO t VALUES (1), (2)")))
+	require.NoError(t, acc.Atomic(func(ctx context.Context, tx *sql.Tx) error { return SnapshotTables(ctx, tx, 1) }))
+	require.NoError(t, acc.Atomic(query("INSERT INTO t VALUES (3)")))
+	// the tables of snapshot 1 are not part of snapshot 2
+	require.NoError(t, acc.Atomic(func(ctx context.Context, tx *sql.Tx) error { return SnapshotTables(ctx, tx, 2) }))
+	require.NoError(t, acc.Atomic(query("DELETE FROM t WHERE v = 1")))
+	require.Equal(t, []int{2, 3}, values())
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, acc.Atomic(func(ctx context.Context, tx *sql.Tx) error { return RestoreTables(ctx, tx, 1) }))
+		require.Equal(t, []int{1, 2}, values())
+	}
+	require.NoError(t, acc.Atomic(func(ctx context.Context, tx *sql.Tx) error { return RestoreTables(ctx, tx, 2) }))
+	require.Equal(t, []int{1, 2, 3}, values())
+
+	require.NoError(t, acc.Atomic(func(ctx context.Context, tx *sql.Tx) error { return DropSnapshotTables(ctx, tx, 1) }))
+	err = acc.Atomic(func(ctx context.Context, tx *sql.Tx) error { return RestoreTables(ctx, tx, 1) })
+	require.ErrorIs(t, err, ErrSnapshotNotFound)
+	require.NoError(t, acc.Atomic(func(ctx context.Context, tx *sql.Tx) error { return RestoreTables(ctx, tx, 2) }))
+	require.Equal(t, []int{1, 2, 3}, values())
+}
@@ -121,6 +121,14 @@ var (
 	TransactionMessagesDupCanonical = MetricName{Name: "algod_transaction_messages_dropped_dup_canonical", Description: "Number of transaction messages dropped after canonical re-encoding"}
 	// TransactionMessagesBacklogSize "Number of transaction messages in the TX handler backlog queue"
 	TransactionMessagesBacklogSize = MetricName{Name: "algod_transaction_messages_backlog_size", Description: "Number of transaction messages in the TX handler backlog queue"}
+	// TransactionAnnouncementsHandled "Number of transaction group announcements handled"
+	TransactionAnnouncementsHandled = MetricName{Name: "algod_transaction_announcements_handled", Description: "Number of transaction group announcements handled"}
+	// TransactionAnnouncementsDup "Number of announced transaction groups not requested because they were seen or requested before"
+	TransactionAnnouncementsDup = MetricName{Name: "algod_transaction_announcements_dropped_dup", Description: "Number of announced transaction groups not requested because they were seen or requested before"}
+	// TransactionAnnouncementsRequested "Number of announced transaction groups requested from peers"
+	TransactionAnnouncementsRequested = MetricName{Name: "algod_transaction_announcements_requested", Description: "Number of announced transaction groups requested from peers"}
+	// TransactionAnnouncementsMissed "Number of requested transaction groups not received in time"
+	TransactionAnnouncementsMissed = MetricName{Name: "algod_transaction_announcements_missed", Description: "Number of requested transaction groups not received in time"}
 
 	// TransactionGroupTxSyncHandled "Number of transaction groups handled via txsync"
 	TransactionGroupTxSyncHandled = MetricName{Name: "algod_transaction_group_txsync_handled", Description: "Number of transaction groups handled via txsync"}
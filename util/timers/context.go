@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CancelAfter calls cancel once the timeout passed on the given clock, or on the wall clock if
+// clock is nil. The returned reset function starts the timeout over, and stop ends the timer.
+func CancelAfter(clock Clock, timeout time.Duration, cancel func()) (reset func(), stop func()) {
+	if clock == nil {
+		timer := time.AfterFunc(timeout, cancel)
+		return func() { timer.Reset(timeout) }, func() { timer.Stop() }
+	}
+	// the timeouts are set by the calling goroutine, so that they start when CancelAfter or
+	// reset are called rather than when the goroutine below gets to run.
+	var mu sync.Mutex
+	deadline := clock.Zero().TimeoutAt(timeout)
+	wake := make(chan struct{}, 1)
+	done := make(chan struct{})
+	go func() {
+		for {
+			mu.Lock()
+			current := deadline
+			mu.Unlock()
+			select {
+			case <-current:
+				mu.Lock()
+				expired := current == deadline
+				mu.Unlock()
+				if expired {
+					cancel()
+					return
+				}
+			case <-wake:
+			case <-done:
+				return
+			}
+		}
+	}()
+	var stopOnce sync.Once
+	reset = func() {
+		mu.Lock()
+		deadline = clock.Zero().TimeoutAt(timeout)
+		mu.Unlock()
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+	stop = func() {
+		stopOnce.Do(func() { close(done) })
+	}
+	return reset, stop
+}
+
+// WithTimeout is context.WithTimeout, with the timeout measured on the given clock, or on the
+// wall clock if clock is nil. When measured on a clock, the context expiring reports
+// context.Canceled rather than context.DeadlineExceeded.
+func WithTimeout(ctx context.Context, clock Clock, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if clock == nil {
+		return context.WithTimeout(ctx, timeout)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	_, stop := CancelAfter(clock, timeout, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// VirtualClock is a source of time which only moves forward when told to do so. It
+// lets a test run the timeouts of many components, such as the agreement services of a
+// whole network of nodes, as fast as they could be processed while keeping the order in
+// which they fire.
+type VirtualClock struct {
+	mu      sync.Mutex
+	now     time.Duration
+	seq     uint64
+	pending virtualEvents
+}
+
+type virtualEvent struct {
+	at  time.Duration
+	seq uint64
+	ch  chan time.Time
+	fn  func()
+}
+
+// virtualEvents is a min-heap of events ordered by the time they are due at, and then by
+// the order in which they were scheduled.
+type virtualEvents []virtualEvent
+
+func (e virtualEvents) Len() int { return len(e) }
+func (e virtualEvents) Less(i, j int) bool {
+	if e[i].at != e[j].at {
+		return e[i].at < e[j].at
+	}
+	return e[i].seq < e[j].seq
+}
+func (e virtualEvents) Swap(i, j int)       { e[i], e[j] = e[j], e[i] }
+func (e *virtualEvents) Push(x interface{}) { *e = append(*e, x.(virtualEvent)) }
+func (e *virtualEvents) Pop() interface{} {
+	old := *e
+	event := old[len(old)-1]
+	*e = old[:len(old)-1]
+	return event
+}
+
+// MakeVirtualClock creates a new virtual clock, which starts at time zero.
+func MakeVirtualClock() *VirtualClock {
+	return &VirtualClock{}
+}
+
+// Now returns the amount of virtual time which has passed since the clock was created.
+func (v *VirtualClock) Now() time.Duration {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.now
+}
+
+// After returns a channel which receives the virtual time once d has passed.
+func (v *VirtualClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	v.mu.Lock()
+	at := v.now + d
+	v.mu.Unlock()
+	v.scheduleAt(at, ch, nil)
+	return ch
+}
+
+// AfterFunc calls f once d has passed. If d is not positive, f is called right away;
+// otherwise it is called by the goroutine advancing the clock.
+func (v *VirtualClock) AfterFunc(d time.Duration, f func()) {
+	v.mu.Lock()
+	at := v.now + d
+	v.mu.Unlock()
+	v.scheduleAt(at, nil, f)
+}
+
+// scheduleAt schedules an event at the given virtual time, or fires it right away if
+// that time has already passed.
+func (v *VirtualClock) scheduleAt(at time.Duration, ch chan time.Time, fn func()) {
+	v.mu.Lock()
+	if at <= v.now {
+		v.mu.Unlock()
+		if ch != nil {
+			ch <- virtualTime(at)
+		} else {
+			fn()
+		}
+		return
+	}
+	v.seq++
+	heap.Push(&v.pending, virtualEvent{at: at, seq: v.seq, ch: ch, fn: fn})
+	v.mu.Unlock()
+}
+
+// Advance moves the clock d forward, firing every timeout which becomes due, in order.
+func (v *VirtualClock) Advance(d time.Duration) {
+	v.mu.Lock()
+	target := v.now + d
+	v.mu.Unlock()
+	v.advanceTo(target)
+}
+
+// Next returns the virtual time the earliest pending timeout is due at, if there is one.
+func (v *VirtualClock) Next() (time.Duration, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if len(v.pending) == 0 {
+		return 0, false
+	}
+	return v.pending[0].at, true
+}
+
+func (v *VirtualClock) advanceTo(target time.Duration) {
+	for {
+		v.mu.Lock()
+		if len(v.pending) == 0 || v.pending[0].at > target {
+			if target > v.now {
+				v.now = target
+			}
+			v.mu.Unlock()
+			return
+		}
+		event := heap.Pop(&v.pending).(virtualEvent)
+		if event.at > v.now {
+			v.now = event.at
+		}
+		v.mu.Unlock()
+
+		// the callbacks are made without holding the lock, so that they could schedule
+		// further events.
+		if event.ch != nil {
+			event.ch <- virtualTime(event.at)
+		} else {
+			event.fn()
+		}
+	}
+}
+
+// Clock returns a Clock zeroed at the current virtual time, whose timeouts are fired by
+// advancing the virtual clock.
+func (v *VirtualClock) Clock() Clock {
+	return &virtualClockView{clock: v, zero: v.Now()}
+}
+
+// virtualTime converts a virtual time into the time.Time sent over the timeout channels.
+func virtualTime(d time.Duration) time.Time {
+	return time.Unix(0, 0).Add(d)
+}
+
+// virtualClockView implements Clock on top of a VirtualClock.
+type virtualClockView struct {
+	clock    *VirtualClock
+	zero     time.Duration
+	timeouts map[time.Duration]<-chan time.Time
+}
+
+// Zero returns a new Clock reset to the current virtual time.
+func (c *virtualClockView) Zero() Clock {
+	return c.clock.Clock()
+}
+
+// Since returns the virtual time which has passed since the clock was zeroed.
+func (c *virtualClockView) Since() time.Duration {
+	return c.clock.Now() - c.zero
+}
+
+// TimeoutAt returns a channel that will signal when the virtual duration has elapsed.
+func (c *virtualClockView) TimeoutAt(delta time.Duration) <-chan time.Time {
+	if c.timeouts == nil {
+		c.timeouts = make(map[time.Duration]<-chan time.Time)
+	}
+	timeoutCh, ok := c.timeouts[delta]
+	if ok {
+		return timeoutCh
+	}
+	// the channels are closed rather than written to, as they might be received from
+	// more than once.
+	timeout := make(chan time.Time)
+	c.clock.scheduleAt(c.zero+delta, nil, func() { close(timeout) })
+	timeoutCh = timeout
+	c.timeouts[delta] = timeoutCh
+	return timeoutCh
+}
+
+// Encode implements Clock.Encode.
+func (c *virtualClockView) Encode() []byte {
+	return protocol.EncodeReflect(int64(c.zero))
+}
+
+// Decode implements Clock.Decode. The decoded clock is driven by the same virtual clock.
+func (c *virtualClockView) Decode(data []byte) (Clock, error) {
+	var zero int64
+	err := protocol.DecodeReflect(data, &zero)
+	if err != nil {
+		return nil, err
+	}
+	return &virtualClockView{clock: c.clock, zero: time.Duration(zero)}, nil
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package timers
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestVirtualClockOrder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	v := MakeVirtualClock()
+	var fired []int
+	v.AfterFunc(3*time.Second, func() { fired = append(fired, 3) })
+	v.AfterFunc(time.Second, func() {
+		fired = append(fired, 1)
+		// events scheduled by a callback fire within the same advance if they are due.
+		v.AfterFunc(time.Second, func() { fired = append(fired, 2) })
+	})
+	v.AfterFunc(3*time.Second, func() { fired = append(fired, 4) })
+	v.AfterFunc(0, func() { fired = append(fired, 0) })
+	ch := v.After(5 * time.Second)
+
+	v.Advance(500 * time.Millisecond)
+	require.Equal(t, []int{0}, fired)
+	require.Equal(t, 500*time.Millisecond, v.Now())
+
+	v.Advance(3 * time.Second)
+	require.Equal(t, []int{0, 1, 2, 3, 4}, fired)
+	require.Equal(t, 3500*time.Millisecond, v.Now())
+	require.False(t, polled(ch))
+
+	next, ok := v.Next()
+	require.True(t, ok)
+	require.Equal(t, 5*time.Second, next)
+	v.Advance(next - v.Now())
+	require.True(t, polled(ch))
+	_, ok = v.Next()
+	require.False(t, ok)
+}
+
+func TestVirtualClockView(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	v := MakeVirtualClock()
+	v.Advance(time.Minute)
+	c := v.Clock()
+	require.Equal(t, time.Duration(0), c.Since())
+
+	ch := c.TimeoutAt(2 * time.Second)
+	require.Equal(t, ch, c.TimeoutAt(2*time.Second))
+	v.Advance(time.Second)
+	require.Equal(t, time.Second, c.Since())
+	require.False(t, polled(ch))
+	v.Advance(time.Second)
+	require.True(t, polled(ch))
+	// a closed channel keeps firing
+	require.True(t, polled(ch))
+	require.True(t, polled(c.TimeoutAt(time.Second)))
+
+	// a decoded clock keeps its zero point
+	decoded, err := c.Decode(c.Encode())
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Second, decoded.Since())
+	ch = decoded.TimeoutAt(3 * time.Second)
+	require.False(t, polled(ch))
+	v.Advance(time.Second)
+	require.True(t, polled(ch))
+
+	zeroed := c.Zero()
+	require.Equal(t, time.Duration(0), zeroed.Since())
+}
+
+func TestVirtualClockWithTimeout(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	v := MakeVirtualClock()
+	ctx, cancel := WithTimeout(context.Background(), v.Clock(), time.Second)
+	defer cancel()
+	v.Advance(500 * time.Millisecond)
+	require.NoError(t, ctx.Err())
+	v.Advance(500 * time.Millisecond)
+	require.Eventually(t, func() bool { return ctx.Err() != nil }, time.Second, time.Millisecond)
+
+	// a reset timeout starts over
+	var cancelled int32
+	reset, stop := CancelAfter(v.Clock(), time.Second, func() { atomic.StoreInt32(&cancelled, 1) })
+	defer stop()
+	v.Advance(800 * time.Millisecond)
+	reset()
+	v.Advance(800 * time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	require.Zero(t, atomic.LoadInt32(&cancelled))
+	v.Advance(200 * time.Millisecond)
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&cancelled) == 1 }, time.Second, time.Millisecond)
+
+	// while a stopped one never fires
+	_, stop = CancelAfter(v.Clock(), time.Second, func() { atomic.StoreInt32(&cancelled, 2) })
+	stop()
+	v.Advance(2 * time.Second)
+	time.Sleep(10 * time.Millisecond)
+	require.Equal(t, int32(1), atomic.LoadInt32(&cancelled))
+}
@@ -0,0 +1,379 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Scoped tokens are named API tokens restricted to an allow-list of endpoints,
+// with an optional expiry and rate limit. They live in a JSON file next to the
+// regular token files, which only stores the hashes of the tokens: a token is
+// only shown once, when it gets created. The daemons reload the file when it
+// changes, so that new and revoked tokens take effect without a restart.
+const (
+	AlgodScopedTokensFilename = "algod.tokens.json"
+	KmdScopedTokensFilename   = "kmd.tokens.json"
+)
+
+// scopedTokensReloadInterval is the minimum time between two checks of the scoped tokens file
+const scopedTokensReloadInterval = time.Second
+
+// ScopeAlias is a named set of endpoint patterns which can be used as a scope
+type ScopeAlias struct {
+	Patterns []string
+	// Admin aliases cover endpoints which require the admin token
+	Admin bool
+}
+
+// ScopeAliases are the predefined scopes, which expand to their endpoint patterns
+var ScopeAliases = map[string]ScopeAlias{
+	"read": {Patterns: []string{"GET *"}},
+	"submit": {Patterns: []string{
+		"POST /v2/transactions",
+		"GET /v2/transactions/params",
+		"GET /v2/transactions/pending*",
+		"GET /v2/status*",
+	}},
+	"participation": {Patterns: []string{"* /v2/participation*"}, Admin: true},
+}
+
+// Errors returned when a scoped token does not allow a request
+var (
+	ErrScopedTokenUnknown     = errors.New("unknown API token")
+	ErrScopedTokenExpired     = errors.New("API token expired")
+	ErrScopedTokenRevoked     = errors.New("API token revoked")
+	ErrScopedTokenForbidden   = errors.New("API token is not allowed to use this endpoint")
+	ErrScopedTokenRateLimited = errors.New("API token rate limit exceeded")
+)
+
+// ScopedToken is the description of a scoped token, as stored in the scoped tokens file
+type ScopedToken struct {
+	Name string `json:"name"`
+	// Hash is the hex encoded SHA512/256 hash of the token
+	Hash string `json:"hash"`
+	// Scopes are either scope aliases or endpoint patterns. An endpoint pattern
+	// is an optional HTTP method, or *, followed by a path. The path matches
+	// itself, or any path starting with its prefix if it ends with *. Paths are
+	// matched against the routes of the endpoints, which name their path
+	// parameters like /v2/accounts/:address.
+	Scopes []string `json:"scopes"`
+	// Admin tokens may reach the endpoints which require the admin token
+	Admin bool `json:"admin,omitempty"`
+	// Expires is the unix time after which the token is rejected, if not zero
+	Expires int64 `json:"expires,omitempty"`
+	// RateLimit is the number of requests allowed per second, if not zero
+	RateLimit uint64 `json:"rate-limit,omitempty"`
+	Revoked   bool   `json:"revoked,omitempty"`
+}
+
+type scopedTokensFile struct {
+	Tokens []ScopedToken `json:"tokens"`
+}
+
+type endpointPattern struct {
+	method string
+	path   string
+	prefix bool
+}
+
+func parseEndpointPattern(pattern string) (endpointPattern, error) {
+	fields := strings.Fields(pattern)
+	var p endpointPattern
+	switch len(fields) {
+	case 1:
+		p.method, p.path = "*", fields[0]
+	case 2:
+		p.method, p.path = strings.ToUpper(fields[0]), fields[1]
+	default:
+		return p, fmt.Errorf("invalid endpoint pattern '%s'", pattern)
+	}
+	if p.path != "*" && !strings.HasPrefix(p.path, "/") {
+		return p, fmt.Errorf("endpoint pattern '%s' does not start with / or *", pattern)
+	}
+	if strings.HasSuffix(p.path, "*") {
+		p.path = strings.TrimSuffix(p.path, "*")
+		p.prefix = true
+	}
+	return p, nil
+}
+
+func (p endpointPattern) match(method string, path string) bool {
+	if p.method != "*" && p.method != method {
+		return false
+	}
+	if p.prefix {
+		return strings.HasPrefix(path, p.path)
+	}
+	return path == p.path
+}
+
+// expandScopes expands the scope aliases and parses the endpoint patterns of the scopes.
+// It also returns whether any of the aliases requires an admin token.
+func expandScopes(scopes []string) (patterns []endpointPattern, admin bool, err error) {
+	for _, scope := range scopes {
+		strs := []string{scope}
+		if alias, ok := ScopeAliases[scope]; ok {
+			strs = alias.Patterns
+			admin = admin || alias.Admin
+		}
+		for _, str := range strs {
+			var p endpointPattern
+			p, err = parseEndpointPattern(str)
+			if err != nil {
+				return nil, false, err
+			}
+			patterns = append(patterns, p)
+		}
+	}
+	return
+}
+
+func hashScopedToken(token string) string {
+	h := sha512.Sum512_256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// LoadScopedTokens reads the scoped tokens file of the data dir. A missing file has no tokens.
+func LoadScopedTokens(dataDir, filename string) ([]ScopedToken, error) {
+	data, err := os.ReadFile(tokenFilepath(dataDir, filename))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var f scopedTokensFile
+	err = json.Unmarshal(data, &f)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", filename, err)
+	}
+	return f.Tokens, nil
+}
+
+// writeScopedTokens replaces the scoped tokens file of the data dir
+func writeScopedTokens(dataDir, filename string, scopedTokens []ScopedToken) error {
+	data, err := json.MarshalIndent(scopedTokensFile{Tokens: scopedTokens}, "", "\t")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first, so that a running daemon never reads a partial file
+	filepath := tokenFilepath(dataDir, filename)
+	err = os.WriteFile(filepath+".tmp", data, 0600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(filepath+".tmp", filepath)
+}
+
+// CreateScopedToken generates a new scoped token and adds it to the scoped tokens file of the data dir.
+// The returned token is not stored anywhere, only its hash is.
+func CreateScopedToken(dataDir, filename string, desc ScopedToken) (string, error) {
+	if desc.Name == "" {
+		return "", fmt.Errorf("scoped token name cannot be empty")
+	}
+	if len(desc.Scopes) == 0 {
+		return "", fmt.Errorf("scoped token %s has no scopes", desc.Name)
+	}
+	_, admin, err := expandScopes(desc.Scopes)
+	if err != nil {
+		return "", err
+	}
+	desc.Admin = desc.Admin || admin
+	desc.Revoked = false
+
+	scopedTokens, err := LoadScopedTokens(dataDir, filename)
+	if err != nil {
+		return "", err
+	}
+	for _, st := range scopedTokens {
+		if st.Name == desc.Name {
+			return "", fmt.Errorf("scoped token %s already exists", desc.Name)
+		}
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return "", err
+	}
+	desc.Hash = hashScopedToken(token)
+	return token, writeScopedTokens(dataDir, filename, append(scopedTokens, desc))
+}
+
+// RevokeScopedToken marks the named scoped token as revoked. Revoked tokens are kept in the file,
+// so that their names are not reused by mistake.
+func RevokeScopedToken(dataDir, filename, name string) error {
+	scopedTokens, err := LoadScopedTokens(dataDir, filename)
+	if err != nil {
+		return err
+	}
+	for i := range scopedTokens {
+		if scopedTokens[i].Name == name {
+			scopedTokens[i].Revoked = true
+			return writeScopedTokens(dataDir, filename, scopedTokens)
+		}
+	}
+	return fmt.Errorf("scoped token %s not found", name)
+}
+
+type scopedTokenEntry struct {
+	ScopedToken
+	patterns []endpointPattern
+}
+
+// tokenBucket implements the rate limit of a scoped token
+type tokenBucket struct {
+	available float64
+	last      time.Time
+}
+
+// ScopedTokenStore checks the requests made with scoped tokens. It reloads the
+// scoped tokens file of its data dir when the file changes.
+type ScopedTokenStore struct {
+	dataDir  string
+	filename string
+
+	mu        sync.Mutex
+	entries   map[string]*scopedTokenEntry
+	buckets   map[string]*tokenBucket
+	lastCheck time.Time
+	modTime   time.Time
+	size      int64
+}
+
+// MakeScopedTokenStore makes a ScopedTokenStore for the scoped tokens file of the data dir
+func MakeScopedTokenStore(dataDir, filename string) *ScopedTokenStore {
+	return &ScopedTokenStore{
+		dataDir:  dataDir,
+		filename: filename,
+		entries:  make(map[string]*scopedTokenEntry),
+		buckets:  make(map[string]*tokenBucket),
+	}
+}
+
+// maybeReload reloads the scoped tokens file if it has changed since it was loaded.
+// A file which fails to load leaves the previous tokens in place.
+func (s *ScopedTokenStore) maybeReload(now time.Time) {
+	if now.Sub(s.lastCheck) < scopedTokensReloadInterval && !s.lastCheck.IsZero() {
+		return
+	}
+	s.lastCheck = now
+
+	var modTime time.Time
+	var size int64
+	info, err := os.Stat(tokenFilepath(s.dataDir, s.filename))
+	if err == nil {
+		modTime, size = info.ModTime(), info.Size()
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return
+	}
+	if modTime.Equal(s.modTime) && size == s.size {
+		return
+	}
+
+	scopedTokens, err := LoadScopedTokens(s.dataDir, s.filename)
+	if err != nil {
+		return
+	}
+	entries := make(map[string]*scopedTokenEntry, len(scopedTokens))
+	for _, st := range scopedTokens {
+		patterns, _, err := expandScopes(st.Scopes)
+		if err != nil {
+			// A token with invalid scopes is not allowed anything
+			patterns = nil
+		}
+		entries[st.Hash] = &scopedTokenEntry{ScopedToken: st, patterns: patterns}
+	}
+	s.entries = entries
+	s.modTime, s.size = modTime, size
+}
+
+// Authorize checks that the token allows the request with the given method
+// and path, on an endpoint which requires the admin token if admin is set.
+func (s *ScopedTokenStore) Authorize(token string, method string, path string, admin bool) error {
+	return s.authorize(token, method, path, admin, time.Now())
+}
+
+func (s *ScopedTokenStore) authorize(token string, method string, path string, admin bool, now time.Time) error {
+	hash := hashScopedToken(token)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maybeReload(now)
+
+	entry, ok := s.entries[hash]
+	if !ok {
+		return ErrScopedTokenUnknown
+	}
+	if entry.Revoked {
+		return ErrScopedTokenRevoked
+	}
+	if entry.Expires != 0 && now.Unix() >= entry.Expires {
+		return ErrScopedTokenExpired
+	}
+	if admin && !entry.Admin {
+		return ErrScopedTokenForbidden
+	}
+	allowed := false
+	for _, p := range entry.patterns {
+		if p.match(method, path) {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return ErrScopedTokenForbidden
+	}
+
+	if entry.RateLimit != 0 {
+		limit := float64(entry.RateLimit)
+		bucket, ok := s.buckets[entry.Name]
+		if !ok {
+			bucket = &tokenBucket{available: limit, last: now}
+			s.buckets[entry.Name] = bucket
+		}
+		bucket.available += now.Sub(bucket.last).Seconds() * limit
+		if bucket.available > limit {
+			bucket.available = limit
+		}
+		bucket.last = now
+		if bucket.available < 1 {
+			return ErrScopedTokenRateLimited
+		}
+		bucket.available--
+	}
+	return nil
+}
+
+// SortedScopeAliases returns the names of the scope aliases, sorted
+func SortedScopeAliases() []string {
+	names := make([]string, 0, len(ScopeAliases))
+	for name := range ScopeAliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2019-2023 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tokens
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestEndpointPatterns(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	tests := []struct {
+		pattern string
+		method  string
+		path    string
+		match   bool
+	}{
+		{"GET *", "GET", "/v2/status", true},
+		{"GET *", "POST", "/v2/transactions", false},
+		{"get /v2/status", "GET", "/v2/status", true},
+		{"GET /v2/status", "GET", "/v2/status/wait-for-block-after/1", false},
+		{"GET /v2/status*", "GET", "/v2/status/wait-for-block-after/1", true},
+		{"/v2/accounts/*", "POST", "/v2/accounts/ABC", true},
+		{"* /v2/participation*", "DELETE", "/v2/participation/XYZ", true},
+		{"* /v2/participation*", "GET", "/v2/status", false},
+	}
+	for _, test := range tests {
+		p, err := parseEndpointPattern(test.pattern)
+		require.NoError(t, err, test.pattern)
+		require.Equal(t, test.match, p.match(test.method, test.path), "%s %s %s", test.pattern, test.method, test.path)
+	}
+
+	for _, invalid := range []string{"", "GET v2/status", "GET /v2/status extra"} {
+		_, err := parseEndpointPattern(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestScopedTokensCreateRevoke(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	store := MakeScopedTokenStore(dir, AlgodScopedTokensFilename)
+	now := time.Now()
+
+	// No file, no tokens
+	require.ErrorIs(t, store.authorize("unknown", "GET", "/v2/status", false, now), ErrScopedTokenUnknown)
+
+	_, err := CreateScopedToken(dir, AlgodScopedTokensFilename, ScopedToken{Name: "noscope"})
+	require.Error(t, err)
+	_, err = CreateScopedToken(dir, AlgodScopedTokensFilename, ScopedToken{Name: "badscope", Scopes: []string{"GET v2"}})
+	require.Error(t, err)
+
+	reader, err := CreateScopedToken(dir, AlgodScopedTokensFilename, ScopedToken{Name: "reader", Scopes: []string{"read"}})
+	require.NoError(t, err)
+	_, err = CreateScopedToken(dir, AlgodScopedTokensFilename, ScopedToken{Name: "reader", Scopes: []string{"read"}})
+	require.Error(t, err)
+	participation, err := CreateScopedToken(dir, AlgodScopedTokensFilename, ScopedToken{Name: "participation", Scopes: []string{"participation"}})
+	require.NoError(t, err)
+
+	// Only the hashes are stored
+	data, err := os.ReadFile(tokenFilepath(dir, AlgodScopedTokensFilename))
+	require.NoError(t, err)
+	require.NotContains(t, string(data), reader)
+	scopedTokens, err := LoadScopedTokens(dir, AlgodScopedTokensFilename)
+	require.NoError(t, err)
+	require.Len(t, scopedTokens, 2)
+	require.False(t, scopedTokens[0].Admin)
+	require.True(t, scopedTokens[1].Admin)
+
+	now = now.Add(scopedTokensReloadInterval)
+	require.NoError(t, store.authorize(reader, "GET", "/v2/accounts/ABC", false, now))
+	require.ErrorIs(t, store.authorize(reader, "POST", "/v2/transactions", false, now), ErrScopedTokenForbidden)
+	require.ErrorIs(t, store.authorize(reader, "GET", "/v2/participation", true, now), ErrScopedTokenForbidden)
+	require.NoError(t, store.authorize(participation, "POST", "/v2/participation", true, now))
+	require.ErrorIs(t, store.authorize(participation, "GET", "/v2/status", false, now), ErrScopedTokenForbidden)
+
+	// Revocation takes effect once the file gets reloaded
+	require.Error(t, RevokeScopedToken(dir, AlgodScopedTokensFilename, "missing"))
+	require.NoError(t, RevokeScopedToken(dir, AlgodScopedTokensFilename, "reader"))
+	now = now.Add(scopedTokensReloadInterval)
+	require.ErrorIs(t, store.authorize(reader, "GET", "/v2/status", false, now), ErrScopedTokenRevoked)
+	require.NoError(t, store.authorize(participation, "GET", "/v2/participation", true, now))
+
+	// A broken file leaves the previous tokens in place
+	require.NoError(t, os.WriteFile(tokenFilepath(dir, AlgodScopedTokensFilename), []byte("{"), 0600))
+	now = now.Add(scopedTokensReloadInterval)
+	require.NoError(t, store.authorize(participation, "GET", "/v2/participation", true, now))
+}
+
+func TestScopedTokensExpiryRateLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	store := MakeScopedTokenStore(dir, KmdScopedTokensFilename)
+	now := time.Now()
+
+	expiring, err := CreateScopedToken(dir, KmdScopedTokensFilename, ScopedToken{Name: "expiring", Scopes: []string{"*"}, Expires: now.Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	limited, err := CreateScopedToken(dir, KmdScopedTokensFilename, ScopedToken{Name: "limited", Scopes: []string{"*"}, RateLimit: 2})
+	require.NoError(t, err)
+
+	require.NoError(t, store.authorize(expiring, "POST", "/v1/key", false, now))
+	require.ErrorIs(t, store.authorize(expiring, "POST", "/v1/key", false, now.Add(time.Hour)), ErrScopedTokenExpired)
+
+	require.NoError(t, store.authorize(limited, "GET", "/v1/wallets", false, now))
+	require.NoError(t, store.authorize(limited, "GET", "/v1/wallets", false, now))
+	require.ErrorIs(t, store.authorize(limited, "GET", "/v1/wallets", false, now), ErrScopedTokenRateLimited)
+	now = now.Add(500 * time.Millisecond)
+	require.NoError(t, store.authorize(limited, "GET", "/v1/wallets", false, now))
+	require.ErrorIs(t, store.authorize(limited, "GET", "/v1/wallets", false, now), ErrScopedTokenRateLimited)
+}
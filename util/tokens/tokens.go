@@ -62,8 +62,8 @@ func writeAPITokenToDisk(dataDir, tokenFilename, apiToken string) error {
 	return os.WriteFile(filepath, []byte(apiToken), 0644)
 }
 
-// GenerateAPIToken writes a cryptographically secure APIToken to disk
-func GenerateAPIToken(dataDir, tokenFilename string) (string, error) {
+// generateToken returns a cryptographically secure APIToken
+func generateToken() (string, error) {
 	// Random bytes will be converted to hex to make token
 	var entropyLen = (minimumAPITokenLength + 1) / 2
 	tokenBytes := make([]byte, entropyLen)
@@ -82,6 +82,15 @@ func GenerateAPIToken(dataDir, tokenFilename string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("generated invalid token: %v", err)
 	}
+	return hexToken, nil
+}
+
+// GenerateAPIToken writes a cryptographically secure APIToken to disk
+func GenerateAPIToken(dataDir, tokenFilename string) (string, error) {
+	hexToken, err := generateToken()
+	if err != nil {
+		return "", err
+	}
 
 	// Persist the token to disk
 	return hexToken, writeAPITokenToDisk(dataDir, tokenFilename, hexToken)
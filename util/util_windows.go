@@ -18,8 +18,11 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"syscall"
 	"time"
+
+	"golang.org/x/sys/windows"
 )
 
 /* misc */
@@ -59,6 +62,20 @@ func GetCurrentProcessTimes() (utime int64, stime int64, err error) {
 	return
 }
 
+// FreeDiskSpace returns the number of bytes available to the current user on the volume holding path.
+func FreeDiskSpace(path string) (uint64, error) {
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, fmt.Errorf("FreeDiskSpace() err: %w", err)
+	}
+	var available uint64
+	err = windows.GetDiskFreeSpaceEx(pathPtr, &available, nil, nil)
+	if err != nil {
+		return 0, fmt.Errorf("FreeDiskSpace() err: %w", err)
+	}
+	return available, nil
+}
+
 func filetimeToDuration(ft *syscall.Filetime) time.Duration {
 	n := int64(ft.HighDateTime)<<32 + int64(ft.LowDateTime) // in 100-nanosecond intervals
 	return time.Duration(n * 100)